@@ -3,6 +3,7 @@ package upgrades
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
@@ -47,11 +48,11 @@ var Upgrade_0_0_11 = Upgrade{
 			// Validate with: `pocketd q tokenomics params --node=https://testnet-validated-validator-rpc.poktroll.com/`
 			tokenomicsParams := tokenomicstypes.Params{
 				MintAllocationPercentages: tokenomicstypes.MintAllocationPercentages{
-					Dao:         0.1,
-					Proposer:    0.05,
-					Supplier:    0.7,
-					SourceOwner: 0.15,
-					Application: 0.0,
+					Dao:         math.LegacyMustNewDecFromStr("0.1"),
+					Proposer:    math.LegacyMustNewDecFromStr("0.05"),
+					Supplier:    math.LegacyMustNewDecFromStr("0.7"),
+					SourceOwner: math.LegacyMustNewDecFromStr("0.15"),
+					Application: math.LegacyZeroDec(),
 				},
 				DaoRewardAddress: AlphaTestNetPnfAddress,
 			}
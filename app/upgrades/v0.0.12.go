@@ -35,7 +35,7 @@ var Upgrade_0_0_12 = Upgrade{
 		const (
 			supplierStakingFee                = 1000000 // uPOKT
 			serviceTargetNumRelays            = 100     // num relays
-			tokenomicsGlobalInflationPerClaim = 0.1     // % of the claim amount
+			tokenomicsGlobalInflationPerClaim = "0.1"   // % of the claim amount
 		)
 
 		applyNewParameters := func(ctx context.Context) (err error) {
@@ -73,7 +73,7 @@ var Upgrade_0_0_12 = Upgrade{
 			// Verify via:
 			// $ pocketd q tokenomics params --node=...
 			tokenomicsParams := keepers.TokenomicsKeeper.GetParams(ctx)
-			tokenomicsParams.GlobalInflationPerClaim = tokenomicsGlobalInflationPerClaim
+			tokenomicsParams.GlobalInflationPerClaim = math.LegacyMustNewDecFromStr(tokenomicsGlobalInflationPerClaim)
 			err = keepers.TokenomicsKeeper.SetParams(ctx, tokenomicsParams)
 			if err != nil {
 				logger.Error("Failed to set tokenomics params", "error", err)
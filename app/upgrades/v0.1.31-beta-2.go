@@ -57,7 +57,7 @@ var Upgrade_0_1_31_Beta_2 = Upgrade{
 			tokenomicsParams := keepers.TokenomicsKeeper.GetParams(ctx)
 
 			// Set mint_ratio to default (1.0 = no deflation) if it's zero
-			if tokenomicsParams.MintRatio == 0 {
+			if tokenomicsParams.MintRatio.IsNil() || tokenomicsParams.MintRatio.IsZero() {
 				tokenomicsParams.MintRatio = tokenomicstypes.DefaultMintRatio
 				logger.Info("PIP-41: Setting default mint_ratio to 1.0")
 			}
@@ -63,7 +63,7 @@ var Upgrade_0_1_31 = Upgrade{
 
 			// Set mint_ratio to default (1.0 = no deflation) if it's zero
 			// This ensures backward compatibility for existing chains
-			if tokenomicsParams.MintRatio == 0 {
+			if tokenomicsParams.MintRatio.IsNil() || tokenomicsParams.MintRatio.IsZero() {
 				tokenomicsParams.MintRatio = tokenomicstypes.DefaultMintRatio
 				logger.Info("PIP-41: Setting default mint_ratio to 1.0 (governance can activate deflation)")
 			}
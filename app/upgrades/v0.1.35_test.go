@@ -3,6 +3,7 @@ package upgrades_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/stretchr/testify/require"
 
@@ -123,13 +124,13 @@ func TestUpgrade_0_1_35_ReportsAntiCollusionInvariant(t *testing.T) {
 
 	// Drive the round-trip factor to exactly 1.0: everything burned comes straight back to
 	// the colluding supplier, making self-dealing free.
-	params.MintRatio = 1.0
+	params.MintRatio = math.LegacyOneDec()
 	params.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         0,
-		Proposer:    0,
-		Supplier:    1.0,
-		SourceOwner: 0,
-		Application: 0,
+		Dao:         math.LegacyZeroDec(),
+		Proposer:    math.LegacyZeroDec(),
+		Supplier:    math.LegacyOneDec(),
+		SourceOwner: math.LegacyZeroDec(),
+		Application: math.LegacyZeroDec(),
 	}
 
 	// The handler validates params before writing them; that validation MUST NOT trip on
@@ -3,11 +3,16 @@ package upgrades
 import (
 	"context"
 
+	cosmoslog "cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/pokt-network/poktroll/app/keepers"
+	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 )
 
 // TODO_NEXT_UPGRADE: Rename NEXT with the appropriate next
@@ -19,7 +24,16 @@ const (
 
 // Upgrade_NEXT handles the upgrade to release `vNEXT`.
 // This upgrade adds:
-// - ...
+//   - The service module relay mining difficulty strategy params, set to their defaults.
+//     The default "ema" strategy and alpha compute the same difficulties as before the upgrade.
+//   - The migration of the tokenomics float64 percentages and ratios to decimals.
+//     Every decimal is set to the shortest decimal representation of its former float64 (e.g. 0.975).
+//   - The tokenomics settlement history retention param, set to its default.
+//     It is absent from the params stored before the upgrade, where it decodes as 0 (i.e. disabled).
+//   - The supplier stake history, initialized with the current stake of every supplier.
+//     Stake weighted session supplier selection uses the stakes at the session start height.
+//   - The proof module missing proof penalty ratios, set to their (zero) defaults.
+//     They are decimals, which are unset (i.e. invalid) in the params stored before the upgrade.
 var Upgrade_NEXT = Upgrade{
 	PlanName: Upgrade_NEXT_PlanName,
 	// No KVStore migrations in this upgrade.
@@ -37,7 +51,108 @@ var Upgrade_NEXT = Upgrade{
 		// 3. Update the upgrade handler here accordingly
 		// Ref: https://github.com/pokt-network/poktroll/compare/vPREV..vNEXT
 
+		// Add the service module relay mining difficulty strategy params.
+		// Verify via:
+		// $ pocketd q service params --node=...
+		applyNewServiceParams := func(ctx context.Context, logger cosmoslog.Logger) error {
+			serviceParams := keepers.ServiceKeeper.GetParams(ctx)
+			serviceParams.RelayMiningDifficultyStrategy = servicetypes.DefaultRelayMiningDifficultyStrategy
+			serviceParams.RelayMiningDifficultyEmaAlphaBps = servicetypes.DefaultRelayMiningDifficultyEmaAlphaBps
+			serviceParams.RelayMiningDifficultyDeadbandBps = servicetypes.DefaultRelayMiningDifficultyDeadbandBps
+			serviceParams.RelayMiningDifficultyMaxStepBps = servicetypes.DefaultRelayMiningDifficultyMaxStepBps
+			serviceParams.MinServiceTargetNumRelays = servicetypes.DefaultMinServiceTargetNumRelays
+			serviceParams.MaxServiceTargetNumRelays = servicetypes.DefaultMaxServiceTargetNumRelays
+
+			if err := keepers.ServiceKeeper.SetParams(ctx, serviceParams); err != nil {
+				logger.Error("Failed to set service params", "error", err)
+				return err
+			}
+			logger.Info("Successfully updated service params", "new_params", serviceParams)
+
+			return nil
+		}
+
+		// Migrate the tokenomics float64 params to decimals.
+		// Verify via:
+		// $ pocketd q tokenomics params --node=...
+		migrateTokenomicsDecParams := func(ctx context.Context, logger cosmoslog.Logger) error {
+			tokenomicsParams, err := keepers.TokenomicsKeeper.MigrateLegacyFloat64Params(ctx)
+			if err != nil {
+				logger.Error("Failed to migrate tokenomics params to decimals", "error", err)
+				return err
+			}
+			tokenomicsParams.LogAntiCollusionInvariantViolation(logger)
+			logger.Info("Successfully migrated tokenomics params to decimals", "new_params", tokenomicsParams)
+
+			return nil
+		}
+
+		// Set the tokenomics settlement history retention, which is not in the stored params.
+		// Verify via:
+		// $ pocketd q tokenomics params --node=...
+		applyNewTokenomicsParams := func(ctx context.Context, logger cosmoslog.Logger) error {
+			tokenomicsParams := keepers.TokenomicsKeeper.GetParams(ctx)
+			tokenomicsParams.SettlementHistoryRetentionBlocks = tokenomicstypes.DefaultSettlementHistoryRetentionBlocks
+
+			if err := keepers.TokenomicsKeeper.SetParams(ctx, tokenomicsParams); err != nil {
+				logger.Error("Failed to set tokenomics params", "error", err)
+				return err
+			}
+			logger.Info("Successfully updated tokenomics params", "new_params", tokenomicsParams)
+
+			return nil
+		}
+
+		// Set the proof module missing proof penalty ratios, which are not in the stored params.
+		// Verify via:
+		// $ pocketd q proof params --node=...
+		applyNewProofParams := func(ctx context.Context, logger cosmoslog.Logger) error {
+			proofParams := keepers.ProofKeeper.GetParams(ctx)
+			if proofParams.ProofMissingPenaltyClaimRatio.IsNil() {
+				proofParams.ProofMissingPenaltyClaimRatio = prooftypes.DefaultProofMissingPenaltyClaimRatio
+			}
+			if proofParams.ProofMissingPenaltyStakeRatio.IsNil() {
+				proofParams.ProofMissingPenaltyStakeRatio = prooftypes.DefaultProofMissingPenaltyStakeRatio
+			}
+
+			if err := keepers.ProofKeeper.SetParams(ctx, proofParams); err != nil {
+				logger.Error("Failed to set proof params", "error", err)
+				return err
+			}
+			logger.Info("Successfully updated proof params", "new_params", proofParams)
+
+			return nil
+		}
+
+		// Record the current stake of every supplier in the supplier stake history.
+		// Verify via:
+		// $ pocketd q session get-session <app> <service> --node=...
+		migrateSupplierStakeHistory := func(ctx context.Context, logger cosmoslog.Logger) {
+			keepers.SupplierKeeper.MigrateSupplierStakeHistory(ctx)
+			logger.Info("Successfully recorded the supplier stake history")
+		}
+
 		return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			logger := cosmostypes.UnwrapSDKContext(ctx).Logger()
+
+			if err := applyNewServiceParams(ctx, logger); err != nil {
+				return vm, err
+			}
+
+			if err := migrateTokenomicsDecParams(ctx, logger); err != nil {
+				return vm, err
+			}
+
+			if err := applyNewTokenomicsParams(ctx, logger); err != nil {
+				return vm, err
+			}
+
+			if err := applyNewProofParams(ctx, logger); err != nil {
+				return vm, err
+			}
+
+			migrateSupplierStakeHistory(ctx, logger)
+
 			return vm, nil
 		}
 	},
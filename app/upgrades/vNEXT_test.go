@@ -0,0 +1,50 @@
+package upgrades_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/pokt-network/poktroll/testutil/keeper"
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+// TestUpgrade_NEXT_SetsSettlementHistoryRetentionBlocks asserts that the tokenomics
+// params come out of the vNEXT upgrade with the default settlement history retention.
+//
+// The param is absent from the params stored before the upgrade, so it decodes as 0,
+// which disables the settlement history. The float64 to decimals migration only fills
+// the decimal params, so without the dedicated step the feature would stay silently off
+// on every upgraded network.
+//
+// As in v0.1.35_test.go, the step is replicated inline rather than invoked through
+// the handler (which needs a fully wired *keepers.Keepers).
+func TestUpgrade_NEXT_SetsSettlementHistoryRetentionBlocks(t *testing.T) {
+	k, ctx := testkeeper.TokenomicsKeeper(t)
+
+	// Pre-state: simulate params stored by a pre-vNEXT binary, where the new field is
+	// absent and therefore deserializes to the proto3 zero value.
+	preParams := k.GetParams(ctx)
+	preParams.SettlementHistoryRetentionBlocks = 0
+	require.NoError(t, k.SetParams(ctx, preParams))
+
+	// The decimals migration leaves the retention unset.
+	migratedParams, err := k.MigrateLegacyFloat64Params(ctx)
+	require.NoError(t, err)
+	require.Zero(t, migratedParams.SettlementHistoryRetentionBlocks,
+		"test setup: the decimals migration must not be what sets the retention")
+
+	// Replicate the new tokenomics params step from the vNEXT upgrade handler.
+	tokenomicsParams := k.GetParams(ctx)
+	tokenomicsParams.SettlementHistoryRetentionBlocks = tokenomicstypes.DefaultSettlementHistoryRetentionBlocks
+	require.NoError(t, k.SetParams(ctx, tokenomicsParams))
+
+	postParams := k.GetParams(ctx)
+	require.Equal(t, tokenomicstypes.DefaultSettlementHistoryRetentionBlocks, postParams.SettlementHistoryRetentionBlocks,
+		"the upgrade must enable the settlement history with the default retention")
+	require.NoError(t, postParams.ValidateBasic())
+
+	// The other migrated params are unchanged.
+	postParams.SettlementHistoryRetentionBlocks = 0
+	require.True(t, migratedParams.Equal(postParams))
+}
@@ -2,6 +2,7 @@ package faucet
 
 import (
 	"errors"
+	"strings"
 
 	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -73,6 +74,9 @@ func setupViper(cmd *cobra.Command) error {
 	// Bind all viper values to environment variables prefixed with the envPrefix.
 	// See: https://github.com/spf13/viper?tab=readme-ov-file#working-with-environment-variables
 	viper.SetEnvPrefix(envPrefix)
+	// Nested config keys (e.g. rate_limit.window) are bound to environment
+	// variables with underscores (e.g. FAUCET_RATE_LIMIT_WINDOW).
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	viper.AutomaticEnv()
 
 	// Bind the listen address flag to the viper config.
@@ -121,9 +125,20 @@ func setViperConfig() error {
 // - signing_key_name
 // - supported_send_coins
 // - create_accounts_only
+// - rate_limit (disabled by default)
+//
+// DEV_NOTE: Nested keys MUST have a default for viper to consider their
+// environment variables when unmarshaling.
 func setViperDefaults() {
 	viper.SetDefault("listen_address", "")
 	viper.SetDefault("signing_key_name", "faucet")
 	viper.SetDefault("supported_send_coins", "1mact")
 	viper.SetDefault("create_accounts_only", "false")
+	viper.SetDefault("rate_limit.window", "0s")
+	viper.SetDefault("rate_limit.max_requests_per_recipient", 0)
+	viper.SetDefault("rate_limit.max_requests_per_ip", 0)
+	viper.SetDefault("rate_limit.max_requests_total", 0)
+	viper.SetDefault("rate_limit.ledger_path", "")
+	viper.SetDefault("rate_limit.client_ip_header", "")
+	viper.SetDefault("rate_limit.trusted_proxies", []string{})
 }
@@ -30,6 +30,11 @@ func ServeCmd() *cobra.Command {
 The faucet server exposes a configurable REST HTTP endpoint to the faucet client.
 It uses the configured Pocket Network RPC endpoint, keyring, and signing key to send transactions.
 
+Funding requests can be rate limited per recipient address, per client IP and in total
+within a sliding time window (see the rate_limit config). Rate limited requests receive a
+429 Too Many Requests response with a Retry-After header. The granted requests are recorded
+in the rate_limit.ledger_path file, if set, so that the rate limits survive restarts.
+
 For more information, see: https://dev.poktroll.com/operate/faucet
 // TODO_UP_NEXT(@bryanchriswhite): update docs URL once known.`,
 		Example: `# Option 1: Using a config file
@@ -39,6 +44,14 @@ pocketd faucet serve --listen-address 0.0.0.0:8080 --config ./faucet_config.yaml
 FAUCET_LISTEN_ADDRESS=0.0.0.0:8080 \
 FAUCET_SUPPORTED_SEND_TOKENS_="100upokt,1mact" \
 FAUCET_SIGNING_KEY_NAME=faucet \
+pocketd faucet serve
+
+# Option 3: Rate limiting funding requests to 1 per recipient and 5 per IP each day
+FAUCET_LISTEN_ADDRESS=0.0.0.0:8080 \
+FAUCET_RATE_LIMIT_WINDOW=24h \
+FAUCET_RATE_LIMIT_MAX_REQUESTS_PER_RECIPIENT=1 \
+FAUCET_RATE_LIMIT_MAX_REQUESTS_PER_IP=5 \
+FAUCET_RATE_LIMIT_LEDGER_PATH=$HOME/.pocket/faucet_rate_limit_ledger.json \
 pocketd faucet serve`,
 		PreRunE: preRunServe,
 		RunE:    runServe,
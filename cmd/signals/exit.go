@@ -28,6 +28,13 @@ func ExitWithCodeIfNonZero(_ *cobra.Command, _ []string) {
 // GoOnExitSignal calls the given callback when the process receives an interrupt or terminate signal.
 // It sets up a goroutine that listens for OS signals and invokes the callback
 func GoOnExitSignal(logger polylog.Logger, onInterrupt func()) {
+	// DEV_NOTE: SIGKILL cannot be trapped, so we don't listen for it.
+	goOnExitSignals(logger, onInterrupt, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGQUIT, syscall.SIGABRT)
+}
+
+// goOnExitSignals calls the given callback when the process receives one of the given signals.
+// It sets up a goroutine that listens for OS signals and invokes the callback
+func goOnExitSignals(logger polylog.Logger, onInterrupt func(), exitSignals ...os.Signal) {
 	go func() {
 		// Set up sigCh to receive when this process receives an interrupt or
 		// terminate signal.
@@ -35,8 +42,7 @@ func GoOnExitSignal(logger polylog.Logger, onInterrupt func()) {
 		sigCh := make(chan os.Signal, 5)
 
 		// Register the signals we want to listen for.
-		// DEV_NOTE: SIGKILL cannot be trapped, so we don't listen for it.
-		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGQUIT, syscall.SIGABRT)
+		signal.Notify(sigCh, exitSignals...)
 
 		// Block until we receive an interrupt or kill signal (OS-agnostic)
 		sig := <-sigCh
@@ -0,0 +1,30 @@
+package signals
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+// GoOnExitOrReloadSignal behaves like GoOnExitSignal except that a SIGHUP signal
+// calls the given onReload callback instead of shutting down the process.
+//
+// - onReload is called sequentially, once per received SIGHUP signal
+// - SIGHUP signals received while onReload is running are coalesced into a single reload
+func GoOnExitOrReloadSignal(logger polylog.Logger, onInterrupt func(), onReload func()) {
+	go func() {
+		// A single slot buffer coalesces the SIGHUP signals received during a reload.
+		reloadSigCh := make(chan os.Signal, 1)
+		signal.Notify(reloadSigCh, syscall.SIGHUP)
+
+		for sig := range reloadSigCh {
+			logger.Info().Msgf("🔄 Received signal %s, reloading configuration...", sig)
+			onReload()
+		}
+	}()
+
+	// DEV_NOTE: SIGKILL cannot be trapped, so we don't listen for it.
+	goOnExitSignals(logger, onInterrupt, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGABRT)
+}
@@ -50,12 +50,12 @@ Feature: Tokenomics Namespace
         # Configure tokenomics parameters to explicitly set inflation and distribution
         And the "tokenomics" module parameters are set as follows
             | name                                             | value | type  |
-            | global_inflation_per_claim                       | 0.1   | float |
-            | mint_equals_burn_claim_distribution.dao          | 0.1   | float |
-            | mint_equals_burn_claim_distribution.proposer     | 0.05  | float |
-            | mint_equals_burn_claim_distribution.supplier     | 0.7   | float |
-            | mint_equals_burn_claim_distribution.source_owner | 0.15  | float |
-            | mint_equals_burn_claim_distribution.application  | 0.0   | float |
+            | global_inflation_per_claim                       | 0.1   | dec   |
+            | mint_equals_burn_claim_distribution.dao          | 0.1   | dec   |
+            | mint_equals_burn_claim_distribution.proposer     | 0.05  | dec   |
+            | mint_equals_burn_claim_distribution.supplier     | 0.7   | dec   |
+            | mint_equals_burn_claim_distribution.source_owner | 0.15  | dec   |
+            | mint_equals_burn_claim_distribution.application  | 0.0   | dec   |
         And all "tokenomics" module params should be updated
 
         # Start servicing relays
@@ -112,17 +112,17 @@ Feature: Tokenomics Namespace
         And the "tokenomics" module parameters are set as follows
             | name                                             | value | type  |
             | dao_reward_address                               | pokt1eeeksh2tvkh7wzmfrljnhw4wrhs55lcuvmekkw | string |
-            | mint_allocation_percentages.dao                  | 0.1   | float |
-            | mint_allocation_percentages.proposer             | 0.05  | float |
-            | mint_allocation_percentages.supplier             | 0.7   | float |
-            | mint_allocation_percentages.source_owner         | 0.15  | float |
-            | mint_allocation_percentages.application          | 0.0   | float |
-            | global_inflation_per_claim                       | 0     | float |
-            | mint_equals_burn_claim_distribution.dao          | 0.0   | float |
-            | mint_equals_burn_claim_distribution.proposer     | 0.0   | float |
-            | mint_equals_burn_claim_distribution.supplier     | 1.0   | float |
-            | mint_equals_burn_claim_distribution.source_owner | 0.0   | float |
-            | mint_equals_burn_claim_distribution.application  | 0.0   | float |
+            | mint_allocation_percentages.dao                  | 0.1   | dec   |
+            | mint_allocation_percentages.proposer             | 0.05  | dec   |
+            | mint_allocation_percentages.supplier             | 0.7   | dec   |
+            | mint_allocation_percentages.source_owner         | 0.15  | dec   |
+            | mint_allocation_percentages.application          | 0.0   | dec   |
+            | global_inflation_per_claim                       | 0     | dec   |
+            | mint_equals_burn_claim_distribution.dao          | 0.0   | dec   |
+            | mint_equals_burn_claim_distribution.proposer     | 0.0   | dec   |
+            | mint_equals_burn_claim_distribution.supplier     | 1.0   | dec   |
+            | mint_equals_burn_claim_distribution.source_owner | 0.0   | dec   |
+            | mint_equals_burn_claim_distribution.application  | 0.0   | dec   |
         And all "tokenomics" module params should be updated
 
         # Configure shared parameters
@@ -168,17 +168,17 @@ Feature: Tokenomics Namespace
         And the "tokenomics" module parameters are set as follows
             | name                                             | value | type  |
             | dao_reward_address                               | pokt1eeeksh2tvkh7wzmfrljnhw4wrhs55lcuvmekkw | string |
-            | mint_allocation_percentages.dao                  | 0.2   | float |
-            | mint_allocation_percentages.proposer             | 0.05  | float |
-            | mint_allocation_percentages.supplier             | 0.60  | float |
-            | mint_allocation_percentages.source_owner         | 0.15  | float |
-            | mint_allocation_percentages.application          | 0.0   | float |
-            | global_inflation_per_claim                       | 0     | float |
-            | mint_equals_burn_claim_distribution.dao          | 0.1   | float |
-            | mint_equals_burn_claim_distribution.proposer     | 0.05  | float |
-            | mint_equals_burn_claim_distribution.supplier     | 0.70  | float |
-            | mint_equals_burn_claim_distribution.source_owner | 0.15  | float |
-            | mint_equals_burn_claim_distribution.application  | 0.0   | float |
+            | mint_allocation_percentages.dao                  | 0.2   | dec   |
+            | mint_allocation_percentages.proposer             | 0.05  | dec   |
+            | mint_allocation_percentages.supplier             | 0.60  | dec   |
+            | mint_allocation_percentages.source_owner         | 0.15  | dec   |
+            | mint_allocation_percentages.application          | 0.0   | dec   |
+            | global_inflation_per_claim                       | 0     | dec   |
+            | mint_equals_burn_claim_distribution.dao          | 0.1   | dec   |
+            | mint_equals_burn_claim_distribution.proposer     | 0.05  | dec   |
+            | mint_equals_burn_claim_distribution.supplier     | 0.70  | dec   |
+            | mint_equals_burn_claim_distribution.source_owner | 0.15  | dec   |
+            | mint_equals_burn_claim_distribution.application  | 0.0   | dec   |
         And all "tokenomics" module params should be updated
 
         # Configure proof parameters to ensure proof is required
@@ -262,13 +262,13 @@ Feature: Tokenomics Namespace
         And the "tokenomics" module parameters are set as follows
             | name                                             | value | type  |
             | dao_reward_address                               | pokt1eeeksh2tvkh7wzmfrljnhw4wrhs55lcuvmekkw | string |
-            | mint_ratio                                       | 0.975 | float |
-            | global_inflation_per_claim                       | 0     | float |
-            | mint_equals_burn_claim_distribution.dao          | 0.1   | float |
-            | mint_equals_burn_claim_distribution.proposer     | 0.0   | float |
-            | mint_equals_burn_claim_distribution.supplier     | 0.9   | float |
-            | mint_equals_burn_claim_distribution.source_owner | 0.0   | float |
-            | mint_equals_burn_claim_distribution.application  | 0.0   | float |
+            | mint_ratio                                       | 0.975 | dec   |
+            | global_inflation_per_claim                       | 0     | dec   |
+            | mint_equals_burn_claim_distribution.dao          | 0.1   | dec   |
+            | mint_equals_burn_claim_distribution.proposer     | 0.0   | dec   |
+            | mint_equals_burn_claim_distribution.supplier     | 0.9   | dec   |
+            | mint_equals_burn_claim_distribution.source_owner | 0.0   | dec   |
+            | mint_equals_burn_claim_distribution.application  | 0.0   | dec   |
         And all "tokenomics" module params should be updated
 
         # Configure proof parameters - no proof required for simplicity
@@ -37,7 +37,7 @@ func (s *suite) parseParamsTable(table gocuke.DataTable) paramsAnyMap {
 	paramsMap := make(paramsAnyMap)
 
 	// Track complex parameter fields for aggregation
-	complexParams := make(map[string]map[string]float64)
+	complexParams := make(map[string]map[string]math.LegacyDec)
 
 	// NB: skip the header row.
 	for rowIdx := 1; rowIdx < table.NumRows(); rowIdx++ {
@@ -51,11 +51,11 @@ func (s *suite) parseParamsTable(table gocuke.DataTable) paramsAnyMap {
 				fieldName := parts[1]
 
 				if complexParams[complexParamName] == nil {
-					complexParams[complexParamName] = make(map[string]float64)
+					complexParams[complexParamName] = make(map[string]math.LegacyDec)
 				}
 
 				// Store the field value
-				complexParams[complexParamName][fieldName] = param.value.(float64)
+				complexParams[complexParamName][fieldName] = param.value.(math.LegacyDec)
 			}
 		} else {
 			paramsMap[param.name] = param
@@ -66,11 +66,11 @@ func (s *suite) parseParamsTable(table gocuke.DataTable) paramsAnyMap {
 		switch complexParamName {
 		case "mint_equals_burn_claim_distribution":
 			distribution := tokenomicstypes.MintEqualsBurnClaimDistribution{
-				Dao:         fields["dao"],
-				Proposer:    fields["proposer"],
-				Supplier:    fields["supplier"],
-				SourceOwner: fields["source_owner"],
-				Application: fields["application"],
+				Dao:         getDecFieldOrZero(fields, "dao"),
+				Proposer:    getDecFieldOrZero(fields, "proposer"),
+				Supplier:    getDecFieldOrZero(fields, "supplier"),
+				SourceOwner: getDecFieldOrZero(fields, "source_owner"),
+				Application: getDecFieldOrZero(fields, "application"),
 			}
 			paramsMap[tokenomicstypes.ParamMintEqualsBurnClaimDistribution] = paramAny{
 				name:    tokenomicstypes.ParamMintEqualsBurnClaimDistribution,
@@ -79,11 +79,11 @@ func (s *suite) parseParamsTable(table gocuke.DataTable) paramsAnyMap {
 			}
 		case "mint_allocation_percentages":
 			allocation := tokenomicstypes.MintAllocationPercentages{
-				Dao:         fields["dao"],
-				Proposer:    fields["proposer"],
-				Supplier:    fields["supplier"],
-				SourceOwner: fields["source_owner"],
-				Application: fields["application"],
+				Dao:         getDecFieldOrZero(fields, "dao"),
+				Proposer:    getDecFieldOrZero(fields, "proposer"),
+				Supplier:    getDecFieldOrZero(fields, "supplier"),
+				SourceOwner: getDecFieldOrZero(fields, "source_owner"),
+				Application: getDecFieldOrZero(fields, "application"),
 			}
 			paramsMap[tokenomicstypes.ParamMintAllocationPercentages] = paramAny{
 				name:    tokenomicstypes.ParamMintAllocationPercentages,
@@ -96,6 +96,17 @@ func (s *suite) parseParamsTable(table gocuke.DataTable) paramsAnyMap {
 	return paramsMap
 }
 
+// getDecFieldOrZero returns the decimal value of the given field of a complex
+// param, or 0 if the params table does not set it.
+func getDecFieldOrZero(fields map[string]math.LegacyDec, fieldName string) math.LegacyDec {
+	fieldValue, ok := fields[fieldName]
+	if !ok {
+		return math.LegacyZeroDec()
+	}
+
+	return fieldValue
+}
+
 // parseParam parses a row of a gocuke.DataTable into a paramName and a paramAny.
 func (s *suite) parseParam(table gocuke.DataTable, rowIdx int) paramAny {
 	s.Helper()
@@ -118,6 +129,11 @@ func (s *suite) parseParam(table gocuke.DataTable, rowIdx int) paramAny {
 		require.NoError(s, err)
 
 		paramValue = floatValue
+	case "dec":
+		decValue, err := math.LegacyNewDecFromStr(table.Cell(rowIdx, paramValueColIdx).String())
+		require.NoError(s, err)
+
+		paramValue = decValue
 	case "coin":
 		coinAmount := table.Cell(rowIdx, paramValueColIdx).Int64()
 		coinValue := cosmostypes.NewCoin(pocket.DenomuPOKT, math.NewInt(coinAmount))
@@ -175,13 +191,15 @@ func (s *suite) newTokenomicsMsgUpdateParams(params paramsAnyMap) cosmostypes.Ms
 		case tokenomicstypes.ParamMintAllocationPercentages:
 			msgUpdateParams.Params.MintAllocationPercentages = paramValue.value.(tokenomicstypes.MintAllocationPercentages)
 		case tokenomicstypes.ParamGlobalInflationPerClaim:
-			msgUpdateParams.Params.GlobalInflationPerClaim = paramValue.value.(float64)
+			msgUpdateParams.Params.GlobalInflationPerClaim = paramValue.value.(math.LegacyDec)
 		case tokenomicstypes.ParamMintEqualsBurnClaimDistribution:
 			msgUpdateParams.Params.MintEqualsBurnClaimDistribution = paramValue.value.(tokenomicstypes.MintEqualsBurnClaimDistribution)
 		case tokenomicstypes.ParamMintRatio:
-			msgUpdateParams.Params.MintRatio = paramValue.value.(float64)
+			msgUpdateParams.Params.MintRatio = paramValue.value.(math.LegacyDec)
 		case tokenomicstypes.ParamOverservicingBonusMultiplier:
 			msgUpdateParams.Params.OverservicingBonusMultiplier = paramValue.value.(uint64)
+		case tokenomicstypes.ParamSettlementHistoryRetentionBlocks:
+			msgUpdateParams.Params.SettlementHistoryRetentionBlocks = paramValue.value.(uint64)
 		default:
 			s.Fatalf("ERROR: unexpected %q type param name %q", paramValue.typeStr, paramName)
 		}
@@ -207,6 +225,14 @@ func (s *suite) newProofMsgUpdateParams(params paramsAnyMap) cosmostypes.Msg {
 			msgUpdateParams.Params.ProofMissingPenalty = paramValue.value.(*cosmostypes.Coin)
 		case prooftypes.ParamProofSubmissionFee:
 			msgUpdateParams.Params.ProofSubmissionFee = paramValue.value.(*cosmostypes.Coin)
+		case prooftypes.ParamProofMissingPenaltyMode:
+			msgUpdateParams.Params.ProofMissingPenaltyMode = paramValue.value.(string)
+		case prooftypes.ParamProofMissingPenaltyClaimRatio:
+			msgUpdateParams.Params.ProofMissingPenaltyClaimRatio = paramValue.value.(math.LegacyDec)
+		case prooftypes.ParamProofMissingPenaltyStakeRatio:
+			msgUpdateParams.Params.ProofMissingPenaltyStakeRatio = paramValue.value.(math.LegacyDec)
+		case prooftypes.ParamProofMissingPenaltyMax:
+			msgUpdateParams.Params.ProofMissingPenaltyMax = paramValue.value.(*cosmostypes.Coin)
 		default:
 			s.Fatalf("ERROR: unexpected %q type param name %q", paramValue.typeStr, paramName)
 		}
@@ -363,12 +389,12 @@ func (s *suite) newTokenomicsMsgUpdateParam(authority string, param paramAny) (m
 				AsString: param.value.(string),
 			},
 		})
-	case "float":
+	case "dec":
 		msg = proto.Message(&tokenomicstypes.MsgUpdateParam{
 			Authority: authority,
 			Name:      param.name,
-			AsType: &tokenomicstypes.MsgUpdateParam_AsFloat{
-				AsFloat: param.value.(float64),
+			AsType: &tokenomicstypes.MsgUpdateParam_AsDec{
+				AsDec: param.value.(math.LegacyDec).String(),
 			},
 		})
 	case "uint64":
@@ -412,6 +438,14 @@ func (s *suite) newProofMsgUpdateParam(authority string, param paramAny) (msg pr
 				AsCoin: param.value.(*cosmostypes.Coin),
 			},
 		})
+	case "string":
+		msg = proto.Message(&prooftypes.MsgUpdateParam{
+			Authority: authority,
+			Name:      param.name,
+			AsType: &prooftypes.MsgUpdateParam_AsString{
+				AsString: param.value.(string),
+			},
+		})
 	default:
 		s.Fatalf("unexpected param type %q for %s module", param.typeStr, prooftypes.ModuleName)
 	}
@@ -41,7 +41,7 @@ Feature: Service Compute Units Session Pin
         # amount & the assertion below is a direct read of the cupr that was applied.
         And the "tokenomics" module parameters are set as follows
             | name                       | value | type  |
-            | global_inflation_per_claim | 0     | float |
+            | global_inflation_per_claim | 0     | dec   |
         And all "tokenomics" module params should be updated
 
         # Serve the session at cupr 100.
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/math"
 	cometcli "github.com/cometbft/cometbft/libs/cli"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -361,7 +362,7 @@ func (s *suite) assertExpectedModuleParamsUpdated(moduleName string) {
 
 		globalInflationPerClaim, ok := paramsMap[tokenomicstypes.ParamGlobalInflationPerClaim]
 		if ok {
-			params.GlobalInflationPerClaim = globalInflationPerClaim.value.(float64)
+			params.GlobalInflationPerClaim = globalInflationPerClaim.value.(math.LegacyDec)
 		}
 
 		mintAllocationPercentages, ok := paramsMap[tokenomicstypes.ParamMintAllocationPercentages]
@@ -377,7 +378,7 @@ func (s *suite) assertExpectedModuleParamsUpdated(moduleName string) {
 		// PIP-41: Handle mint_ratio parameter for deflationary mint mechanism
 		mintRatio, ok := paramsMap[tokenomicstypes.ParamMintRatio]
 		if ok {
-			params.MintRatio = mintRatio.value.(float64)
+			params.MintRatio = mintRatio.value.(math.LegacyDec)
 		}
 
 		// Settlement budget redistribution (v0.1.35).
@@ -386,6 +387,11 @@ func (s *suite) assertExpectedModuleParamsUpdated(moduleName string) {
 			params.OverservicingBonusMultiplier = overservicingBonusMultiplier.value.(uint64)
 		}
 
+		settlementHistoryRetentionBlocks, ok := paramsMap[tokenomicstypes.ParamSettlementHistoryRetentionBlocks]
+		if ok {
+			params.SettlementHistoryRetentionBlocks = settlementHistoryRetentionBlocks.value.(uint64)
+		}
+
 		assertUpdatedParams(s,
 			[]byte(res.Stdout),
 			&tokenomicstypes.QueryParamsResponse{
@@ -417,6 +423,26 @@ func (s *suite) assertExpectedModuleParamsUpdated(moduleName string) {
 			params.ProofSubmissionFee = proofSubmissionFee.value.(*cosmostypes.Coin)
 		}
 
+		proofMissingPenaltyMode, ok := paramsMap[prooftypes.ParamProofMissingPenaltyMode]
+		if ok {
+			params.ProofMissingPenaltyMode = proofMissingPenaltyMode.value.(string)
+		}
+
+		proofMissingPenaltyClaimRatio, ok := paramsMap[prooftypes.ParamProofMissingPenaltyClaimRatio]
+		if ok {
+			params.ProofMissingPenaltyClaimRatio = proofMissingPenaltyClaimRatio.value.(math.LegacyDec)
+		}
+
+		proofMissingPenaltyStakeRatio, ok := paramsMap[prooftypes.ParamProofMissingPenaltyStakeRatio]
+		if ok {
+			params.ProofMissingPenaltyStakeRatio = proofMissingPenaltyStakeRatio.value.(math.LegacyDec)
+		}
+
+		proofMissingPenaltyMax, ok := paramsMap[prooftypes.ParamProofMissingPenaltyMax]
+		if ok {
+			params.ProofMissingPenaltyMax = proofMissingPenaltyMax.value.(*cosmostypes.Coin)
+		}
+
 		assertUpdatedParams(s,
 			[]byte(res.Stdout),
 			&prooftypes.QueryParamsResponse{
@@ -85,6 +85,10 @@ params_tokenomics_update_dao_reward_address: ## Update the tokenomics module dao
 params_tokenomics_update_global_inflation_per_claim: ## Update the tokenomics module global_inflation_per_claim param
 	pocketd tx authz exec ./tools/scripts/params_templates/tokenomics_3_global_inflation_per_claim.json $(PARAM_FLAGS)
 
+.PHONY: params_tokenomics_update_settlement_history_retention_blocks
+params_tokenomics_update_settlement_history_retention_blocks: ## Update the tokenomics module settlement_history_retention_blocks param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/tokenomics_settlement_history_retention_blocks.json $(PARAM_FLAGS)
+
 #####################
 ### Service Module ###
 ######################
@@ -105,6 +109,30 @@ params_service_update_add_service_fee: ## Update the service module add_service_
 params_service_update_target_num_relays: ## Update the service module target_num_relays param
 	pocketd tx authz exec ./tools/scripts/params_templates/service_2_target_num_relays.json $(PARAM_FLAGS)
 
+.PHONY: params_service_update_relay_mining_difficulty_strategy
+params_service_update_relay_mining_difficulty_strategy: ## Update the service module relay_mining_difficulty_strategy param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/service_relay_mining_difficulty_strategy.json $(PARAM_FLAGS)
+
+.PHONY: params_service_update_relay_mining_difficulty_ema_alpha_bps
+params_service_update_relay_mining_difficulty_ema_alpha_bps: ## Update the service module relay_mining_difficulty_ema_alpha_bps param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/service_relay_mining_difficulty_ema_alpha_bps.json $(PARAM_FLAGS)
+
+.PHONY: params_service_update_relay_mining_difficulty_deadband_bps
+params_service_update_relay_mining_difficulty_deadband_bps: ## Update the service module relay_mining_difficulty_deadband_bps param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/service_relay_mining_difficulty_deadband_bps.json $(PARAM_FLAGS)
+
+.PHONY: params_service_update_relay_mining_difficulty_max_step_bps
+params_service_update_relay_mining_difficulty_max_step_bps: ## Update the service module relay_mining_difficulty_max_step_bps param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/service_relay_mining_difficulty_max_step_bps.json $(PARAM_FLAGS)
+
+.PHONY: params_service_update_min_service_target_num_relays
+params_service_update_min_service_target_num_relays: ## Update the service module min_service_target_num_relays param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/service_min_service_target_num_relays.json $(PARAM_FLAGS)
+
+.PHONY: params_service_update_max_service_target_num_relays
+params_service_update_max_service_target_num_relays: ## Update the service module max_service_target_num_relays param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/service_max_service_target_num_relays.json $(PARAM_FLAGS)
+
 ####################
 ### Proof Module ###
 ###################
@@ -133,6 +161,22 @@ params_proof_update_proof_missing_penalty: ## Update the proof module proof_miss
 params_proof_update_proof_submission_fee: ## Update the proof module proof_submission_fee param
 	pocketd tx authz exec ./tools/scripts/params_templates/proof_4_proof_submission_fee.json $(PARAM_FLAGS)
 
+.PHONY: params_proof_update_proof_missing_penalty_mode
+params_proof_update_proof_missing_penalty_mode: ## Update the proof module proof_missing_penalty_mode param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/proof_proof_missing_penalty_mode.json $(PARAM_FLAGS)
+
+.PHONY: params_proof_update_proof_missing_penalty_claim_ratio
+params_proof_update_proof_missing_penalty_claim_ratio: ## Update the proof module proof_missing_penalty_claim_ratio param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/proof_proof_missing_penalty_claim_ratio.json $(PARAM_FLAGS)
+
+.PHONY: params_proof_update_proof_missing_penalty_stake_ratio
+params_proof_update_proof_missing_penalty_stake_ratio: ## Update the proof module proof_missing_penalty_stake_ratio param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/proof_proof_missing_penalty_stake_ratio.json $(PARAM_FLAGS)
+
+.PHONY: params_proof_update_proof_missing_penalty_max
+params_proof_update_proof_missing_penalty_max: ## Update the proof module proof_missing_penalty_max param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/proof_proof_missing_penalty_max.json $(PARAM_FLAGS)
+
 #####################
 ### Shared Module ###
 ####################
@@ -261,6 +305,14 @@ params_session_update_all: ## Update the session module params
 params_session_update_num_suppliers_per_session: ## Update the session module num_suppliers_per_session param
 	pocketd tx authz exec ./tools/scripts/params_templates/session_1_num_suppliers_per_session.json $(PARAM_FLAGS)
 
+.PHONY: params_session_update_supplier_selection_mode
+params_session_update_supplier_selection_mode: ## Update the session module supplier_selection_mode param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/session_supplier_selection_mode.json $(PARAM_FLAGS)
+
+.PHONY: params_session_update_supplier_selection_stake_cap_upokt
+params_session_update_supplier_selection_stake_cap_upokt: ## Update the session module supplier_selection_stake_cap_upokt param
+	pocketd tx authz exec ./tools/scripts/params/params_templates/session_supplier_selection_stake_cap_upokt.json $(PARAM_FLAGS)
+
 ####################
 ### Migration Module ###
 ####################
@@ -7,12 +7,17 @@ import (
 	"github.com/pokt-network/poktroll/pkg/cache"
 )
 
-// EvictionPolicy determines which values to remove when the number of keys in the cache exceeds maxKeys.
+// EvictionPolicy determines which values to remove when the number of keys in the
+// cache exceeds maxKeys, or the cumulative size of its values exceeds maxValueSize.
 type EvictionPolicy int64
 
 const (
+	// FirstInFirstOut evicts the key whose value was set the longest time ago.
 	FirstInFirstOut = EvictionPolicy(iota)
+	// LeastRecentlyUsed evicts the key whose value was set or retrieved the longest time ago.
 	LeastRecentlyUsed
+	// LeastFrequentlyUsed evicts the key whose value was set or retrieved the fewest
+	// times, breaking ties by evicting the least recently used one.
 	LeastFrequentlyUsed
 )
 
@@ -37,16 +42,25 @@ type keyValueCacheConfig struct {
 	// hold before it starts evicting.
 	maxKeys int64
 
+	// maxValueSize is the maximum cumulative size, in bytes, of all values in the
+	// cache before it starts evicting. Values larger than maxValueSize are not cached.
+	// It is only supported for string, []byte and types implementing Size() int
+	// (e.g. protobuf messages). If 0, the size of the values is not limited.
+	maxValueSize int64
+
 	// TODO_CONSIDERATION:
 	//
-	// maxValueSize is the maximum cumulative size of all values in the cache.
-	// maxValueSize int64
 	// maxCacheSize is the maximum cumulative size of all keys AND values in the cache.
 	// maxCacheSize int64
 
-	// evictionPolicy determines which values to remove when number of keys in the cache exceeds maxKeys.
+	// evictionPolicy determines which values to remove when the number of keys in the
+	// cache exceeds maxKeys, or the cumulative size of its values exceeds maxValueSize.
 	evictionPolicy EvictionPolicy
 
+	// name identifies the cache in its hit, miss and eviction metrics.
+	// If empty, the name of the cached value type is used.
+	name string
+
 	// ttl is how long values should remain valid in the cache. Items older than the
 	// ttl MAY NOT be evicted immediately, but are NEVER considered as cache hits.
 	ttl time.Duration
@@ -72,16 +86,32 @@ type KeyValueCacheOptionFn func(keyValueConfigI) error
 // Validate ensures that the keyValueCacheConfig isn't configured with incompatible options.
 func (cfg *keyValueCacheConfig) Validate() error {
 	switch cfg.evictionPolicy {
-	case FirstInFirstOut:
-	// TODO_IMPROVE: support LeastRecentlyUsed and LeastFrequentlyUsed policies.
+	case FirstInFirstOut, LeastRecentlyUsed, LeastFrequentlyUsed:
 	default:
 		return cache.ErrKeyValueCacheConfigValidation.Wrapf("eviction policy %d not imlemented", cfg.evictionPolicy)
 	}
+
+	if cfg.maxKeys < 0 {
+		return cache.ErrKeyValueCacheConfigValidation.Wrapf("maxKeys MUST be >= 0, got: %d", cfg.maxKeys)
+	}
+
+	if cfg.maxValueSize < 0 {
+		return cache.ErrKeyValueCacheConfigValidation.Wrapf("maxValueSize MUST be >= 0, got: %d", cfg.maxValueSize)
+	}
+
 	return nil
 }
 
+// hasCapacityLimit returns true if keys are evicted when the cache reaches maxKeys or maxValueSize.
+func (cfg *keyValueCacheConfig) hasCapacityLimit() bool {
+	return cfg.maxKeys > 0 || cfg.maxValueSize > 0
+}
+
 // Validate ensures that the historicalKeyValueCacheConfig isn't configured with incompatible options.
 func (cfg *historicalKeyValueCacheConfig) Validate() error {
+	if err := cfg.keyValueCacheConfig.Validate(); err != nil {
+		return err
+	}
 
 	if cfg.maxVersionAge < 0 {
 		return cache.ErrKeyValueCacheConfigValidation.Wrapf("maxVersionAge MUST be >= 0, got: %d", cfg.maxVersionAge)
@@ -99,6 +129,16 @@ func WithMaxKeys(maxKeys int64) KeyValueCacheOptionFn {
 	}
 }
 
+// WithMaxValueSize sets the maximum cumulative size, in bytes, of all the values the
+// cache will hold before evicting according to the configured eviction policy.
+// The cached value type MUST be a string, a []byte or implement Size() int.
+func WithMaxValueSize(maxValueSize int64) KeyValueCacheOptionFn {
+	return func(cfg keyValueConfigI) error {
+		cfg.SetMaxValueSize(maxValueSize)
+		return nil
+	}
+}
+
 // WithEvictionPolicy sets the eviction policy.
 func WithEvictionPolicy(policy EvictionPolicy) KeyValueCacheOptionFn {
 	return func(cfg keyValueConfigI) error {
@@ -117,6 +157,14 @@ func WithTTL(ttl time.Duration) KeyValueCacheOptionFn {
 	}
 }
 
+// WithName sets the name identifying the cache in its hit, miss and eviction metrics.
+func WithName(name string) KeyValueCacheOptionFn {
+	return func(cfg keyValueConfigI) error {
+		cfg.SetName(name)
+		return nil
+	}
+}
+
 // WithNoTTL effectively disables the cache. Useful for testing.
 func WithNoTTL() KeyValueCacheOptionFn {
 	return func(cfg keyValueConfigI) error {
@@ -145,14 +193,20 @@ func WithMaxVersionAge(numRetainedVersions int64) KeyValueCacheOptionFn {
 // struct in the historical key/value (and/or other) cache(s).
 type keyValueConfigI interface {
 	SetMaxKeys(maxKeys int64)
+	SetMaxValueSize(maxValueSize int64)
 	SetEvictionPolicy(policy EvictionPolicy)
 	SetTTL(ttl time.Duration)
+	SetName(name string)
 }
 
 func (cfg *keyValueCacheConfig) SetMaxKeys(maxKeys int64) {
 	cfg.maxKeys = maxKeys
 }
 
+func (cfg *keyValueCacheConfig) SetMaxValueSize(maxValueSize int64) {
+	cfg.maxValueSize = maxValueSize
+}
+
 func (cfg *keyValueCacheConfig) SetEvictionPolicy(policy EvictionPolicy) {
 	cfg.evictionPolicy = policy
 }
@@ -163,3 +217,28 @@ func (cfg *keyValueCacheConfig) SetTTL(ttl time.Duration) {
 func (cfg *keyValueCacheConfig) GetTTL() time.Duration {
 	return cfg.ttl
 }
+
+func (cfg *keyValueCacheConfig) SetName(name string) {
+	cfg.name = name
+}
+
+// validateValueType ensures that the cached value type T supports the configured options.
+func validateValueType[T any](cfg *keyValueCacheConfig) error {
+	if cfg.maxValueSize > 0 && !isValueSizeSupported[T]() {
+		var zero T
+		return cache.ErrKeyValueCacheConfigValidation.Wrapf(
+			"maxValueSize requires a string, []byte or Size() int value type, got: %T", zero,
+		)
+	}
+	return nil
+}
+
+// getCacheName returns the configured cache name, or the name of the value type T if not set.
+func getCacheName[T any](cfg *keyValueCacheConfig) string {
+	if cfg.name != "" {
+		return cfg.name
+	}
+
+	var zero T
+	return fmt.Sprintf("%T", zero)
+}
@@ -0,0 +1,213 @@
+package memory
+
+import (
+	"container/list"
+	"fmt"
+)
+
+// evictionQueue tracks the cached keys in the order in which they are evicted
+// according to an eviction policy. All its operations are O(1).
+// It is NOT safe for concurrent use; the caller MUST synchronize access.
+type evictionQueue interface {
+	// onSet records that a value was set (added or updated) for the given key.
+	onSet(key string)
+
+	// onGet records that the value of the given key was retrieved (i.e. a cache hit).
+	onGet(key string)
+
+	// remove stops tracking the given key.
+	remove(key string)
+
+	// next returns the next key to evict, skipping protectedKey (i.e. the key
+	// which is being set). It returns false if there is no other key to evict.
+	next(protectedKey string) (string, bool)
+
+	// clear stops tracking all the keys.
+	clear()
+}
+
+// newEvictionQueue returns the evictionQueue implementing the given eviction policy.
+func newEvictionQueue(policy EvictionPolicy) evictionQueue {
+	switch policy {
+	case FirstInFirstOut:
+		return newListEvictionQueue(false)
+	case LeastRecentlyUsed:
+		return newListEvictionQueue(true)
+	case LeastFrequentlyUsed:
+		return newLFUEvictionQueue()
+	default:
+		// DEV_NOTE: This SHOULD NEVER happen, KeyValueCacheConfig#Validate, SHOULD prevent it.
+		panic(fmt.Sprintf("unsupported eviction policy: %d", policy))
+	}
+}
+
+var _ evictionQueue = (*listEvictionQueue)(nil)
+
+// listEvictionQueue implements the FirstInFirstOut and LeastRecentlyUsed eviction
+// policies by keeping the keys in a list, from the next to evict to the last to evict.
+// A key is moved to the back of the list when its value is set and, for the
+// LeastRecentlyUsed policy, when it is retrieved.
+type listEvictionQueue struct {
+	// moveOnGet is true if the keys are moved to the back of the list when retrieved.
+	moveOnGet bool
+	keys      *list.List
+	elements  map[string]*list.Element
+}
+
+// newListEvictionQueue returns an empty listEvictionQueue.
+func newListEvictionQueue(moveOnGet bool) *listEvictionQueue {
+	return &listEvictionQueue{
+		moveOnGet: moveOnGet,
+		keys:      list.New(),
+		elements:  make(map[string]*list.Element),
+	}
+}
+
+func (q *listEvictionQueue) onSet(key string) {
+	if element, exists := q.elements[key]; exists {
+		q.keys.MoveToBack(element)
+		return
+	}
+	q.elements[key] = q.keys.PushBack(key)
+}
+
+func (q *listEvictionQueue) onGet(key string) {
+	if !q.moveOnGet {
+		return
+	}
+	if element, exists := q.elements[key]; exists {
+		q.keys.MoveToBack(element)
+	}
+}
+
+func (q *listEvictionQueue) remove(key string) {
+	if element, exists := q.elements[key]; exists {
+		q.keys.Remove(element)
+		delete(q.elements, key)
+	}
+}
+
+func (q *listEvictionQueue) next(protectedKey string) (string, bool) {
+	for element := q.keys.Front(); element != nil; element = element.Next() {
+		if key := element.Value.(string); key != protectedKey {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func (q *listEvictionQueue) clear() {
+	q.keys.Init()
+	q.elements = make(map[string]*list.Element)
+}
+
+var _ evictionQueue = (*lfuEvictionQueue)(nil)
+
+// lfuEvictionQueue implements the LeastFrequentlyUsed eviction policy. Keys which
+// are used (i.e. set or retrieved) the least are evicted first; ties are broken
+// by evicting the least recently used key.
+//
+// It keeps a list of frequency buckets sorted by ascending use count, each holding
+// the list of keys with that use count, from the least to the most recently used.
+// See: http://dhruvbird.com/lfu.pdf
+type lfuEvictionQueue struct {
+	buckets *list.List
+	entries map[string]*lfuEntry
+}
+
+// lfuBucket holds the keys which have been used useCount times.
+type lfuBucket struct {
+	useCount uint64
+	keys     *list.List
+}
+
+// lfuEntry locates a key in the lfuEvictionQueue.
+type lfuEntry struct {
+	bucketElement *list.Element
+	keyElement    *list.Element
+}
+
+// newLFUEvictionQueue returns an empty lfuEvictionQueue.
+func newLFUEvictionQueue() *lfuEvictionQueue {
+	return &lfuEvictionQueue{
+		buckets: list.New(),
+		entries: make(map[string]*lfuEntry),
+	}
+}
+
+func (q *lfuEvictionQueue) onSet(key string) {
+	if _, exists := q.entries[key]; exists {
+		q.incrementUseCount(key)
+		return
+	}
+
+	// New keys always have the lowest use count, so they go to the first bucket.
+	bucketElement := q.buckets.Front()
+	if bucketElement == nil || bucketElement.Value.(*lfuBucket).useCount != 1 {
+		bucketElement = q.buckets.PushFront(&lfuBucket{useCount: 1, keys: list.New()})
+	}
+
+	q.entries[key] = &lfuEntry{
+		bucketElement: bucketElement,
+		keyElement:    bucketElement.Value.(*lfuBucket).keys.PushBack(key),
+	}
+}
+
+func (q *lfuEvictionQueue) onGet(key string) {
+	if _, exists := q.entries[key]; exists {
+		q.incrementUseCount(key)
+	}
+}
+
+func (q *lfuEvictionQueue) remove(key string) {
+	entry, exists := q.entries[key]
+	if !exists {
+		return
+	}
+
+	q.removeFromBucket(entry)
+	delete(q.entries, key)
+}
+
+func (q *lfuEvictionQueue) next(protectedKey string) (string, bool) {
+	// The protected key is at most skipped once, so at most the first two keys are visited.
+	for bucketElement := q.buckets.Front(); bucketElement != nil; bucketElement = bucketElement.Next() {
+		bucket := bucketElement.Value.(*lfuBucket)
+		for keyElement := bucket.keys.Front(); keyElement != nil; keyElement = keyElement.Next() {
+			if key := keyElement.Value.(string); key != protectedKey {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (q *lfuEvictionQueue) clear() {
+	q.buckets.Init()
+	q.entries = make(map[string]*lfuEntry)
+}
+
+// incrementUseCount moves the given tracked key to the bucket of the next use count.
+func (q *lfuEvictionQueue) incrementUseCount(key string) {
+	entry := q.entries[key]
+	bucketElement := entry.bucketElement
+	nextUseCount := bucketElement.Value.(*lfuBucket).useCount + 1
+
+	nextBucketElement := bucketElement.Next()
+	if nextBucketElement == nil || nextBucketElement.Value.(*lfuBucket).useCount != nextUseCount {
+		nextBucketElement = q.buckets.InsertAfter(&lfuBucket{useCount: nextUseCount, keys: list.New()}, bucketElement)
+	}
+
+	q.removeFromBucket(entry)
+	entry.bucketElement = nextBucketElement
+	entry.keyElement = nextBucketElement.Value.(*lfuBucket).keys.PushBack(key)
+}
+
+// removeFromBucket removes the given entry from its bucket, removing the bucket if it becomes empty.
+func (q *lfuEvictionQueue) removeFromBucket(entry *lfuEntry) {
+	bucket := entry.bucketElement.Value.(*lfuBucket)
+	bucket.keys.Remove(entry.keyElement)
+	if bucket.keys.Len() == 0 {
+		q.buckets.Remove(entry.bucketElement)
+	}
+}
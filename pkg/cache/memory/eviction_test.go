@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvictionQueue_Next(t *testing.T) {
+	tests := []struct {
+		desc   string
+		policy EvictionPolicy
+		// ops are applied in order; each is "set:<key>", "get:<key>" or "remove:<key>".
+		ops                   []string
+		expectedEvictionOrder []string
+	}{
+		{
+			desc:                  "FIFO ignores gets",
+			policy:                FirstInFirstOut,
+			ops:                   []string{"set:a", "set:b", "set:c", "get:a"},
+			expectedEvictionOrder: []string{"a", "b", "c"},
+		},
+		{
+			desc:                  "FIFO re-set moves to back",
+			policy:                FirstInFirstOut,
+			ops:                   []string{"set:a", "set:b", "set:c", "set:a"},
+			expectedEvictionOrder: []string{"b", "c", "a"},
+		},
+		{
+			desc:                  "LRU gets move to back",
+			policy:                LeastRecentlyUsed,
+			ops:                   []string{"set:a", "set:b", "set:c", "get:a", "get:b"},
+			expectedEvictionOrder: []string{"c", "a", "b"},
+		},
+		{
+			desc:                  "LRU remove",
+			policy:                LeastRecentlyUsed,
+			ops:                   []string{"set:a", "set:b", "set:c", "remove:a"},
+			expectedEvictionOrder: []string{"b", "c"},
+		},
+		{
+			desc:                  "LFU evicts the least used first",
+			policy:                LeastFrequentlyUsed,
+			ops:                   []string{"set:a", "set:b", "set:c", "get:a", "get:a", "get:c"},
+			expectedEvictionOrder: []string{"b", "c", "a"},
+		},
+		{
+			desc:                  "LFU breaks ties by recency",
+			policy:                LeastFrequentlyUsed,
+			ops:                   []string{"set:a", "set:b", "get:b", "get:a"},
+			expectedEvictionOrder: []string{"b", "a"},
+		},
+		{
+			desc:                  "LFU new keys are evicted before used ones",
+			policy:                LeastFrequentlyUsed,
+			ops:                   []string{"set:a", "get:a", "set:b"},
+			expectedEvictionOrder: []string{"b", "a"},
+		},
+		{
+			desc:                  "LFU remove",
+			policy:                LeastFrequentlyUsed,
+			ops:                   []string{"set:a", "set:b", "get:b", "remove:a", "set:c"},
+			expectedEvictionOrder: []string{"c", "b"},
+		},
+		{
+			desc:                  "untracked keys are ignored",
+			policy:                LeastFrequentlyUsed,
+			ops:                   []string{"get:a", "remove:b", "set:c"},
+			expectedEvictionOrder: []string{"c"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			queue := newEvictionQueue(test.policy)
+			applyEvictionQueueOps(t, queue, test.ops)
+
+			evictionOrder := make([]string, 0, len(test.expectedEvictionOrder))
+			for {
+				key, ok := queue.next("")
+				if !ok {
+					break
+				}
+				evictionOrder = append(evictionOrder, key)
+				queue.remove(key)
+			}
+			require.Equal(t, test.expectedEvictionOrder, evictionOrder)
+		})
+	}
+}
+
+func TestEvictionQueue_NextSkipsProtectedKey(t *testing.T) {
+	for _, policy := range []EvictionPolicy{FirstInFirstOut, LeastRecentlyUsed, LeastFrequentlyUsed} {
+		queue := newEvictionQueue(policy)
+		applyEvictionQueueOps(t, queue, []string{"set:a", "set:b"})
+
+		key, ok := queue.next("a")
+		require.True(t, ok)
+		require.Equal(t, "b", key)
+
+		queue.remove("b")
+		_, ok = queue.next("a")
+		require.False(t, ok)
+
+		queue.clear()
+		_, ok = queue.next("")
+		require.False(t, ok)
+	}
+}
+
+// applyEvictionQueueOps applies the given "<op>:<key>" operations to the queue.
+func applyEvictionQueueOps(t *testing.T, queue evictionQueue, ops []string) {
+	t.Helper()
+
+	for _, op := range ops {
+		switch op[:len(op)-2] {
+		case "set":
+			queue.onSet(op[len(op)-1:])
+		case "get":
+			queue.onGet(op[len(op)-1:])
+		case "remove":
+			queue.remove(op[len(op)-1:])
+		default:
+			t.Fatalf("unknown eviction queue op %q", op)
+		}
+	}
+}
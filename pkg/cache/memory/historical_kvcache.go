@@ -1,7 +1,6 @@
 package memory
 
 import (
-	"fmt"
 	"sort"
 	"sync"
 	"time"
@@ -15,11 +14,22 @@ var _ cache.HistoricalKeyValueCache[any] = (*historicalKeyValueCache[any])(nil)
 // with support for tracking multiple value versions for a given key.
 type historicalKeyValueCache[T any] struct {
 	config historicalKeyValueCacheConfig
+	// name identifies the cache in its metrics.
+	name string
 
-	// valuesMu is used to protect values AND valueHistories from concurrent access.
+	// valuesMu is used to protect valueHistories, valuesSize AND evictionQueue from concurrent access.
 	valuesMu sync.RWMutex
 	// valueHistories holds the cached historical values.
 	valueHistories map[string]cacheValueHistory[T]
+	// valuesSize is the cumulative size of all the cached value versions, if maxValueSize is configured.
+	valuesSize int64
+
+	// evictionQueue orders the cached keys according to the configured eviction policy.
+	// It is nil if neither maxKeys nor maxValueSize is configured.
+	evictionQueue evictionQueue
+	// evictionQueueMu serializes the eviction queue updates of concurrent cache
+	// hits, which only hold the valuesMu read lock.
+	evictionQueueMu sync.Mutex
 }
 
 // cacheValueHistory maintains:
@@ -34,6 +44,8 @@ type cacheValueHistory[T any] struct {
 	// versionToValueMap is a map from a version number to the cached value at
 	// that version number.
 	versionToValueMap map[int64]cacheValue[T]
+	// size is the cumulative size of all the cached value versions, if maxValueSize is configured.
+	size int64
 }
 
 // NewHistoricalKeyValueCache creates a new historicalKeyValueCache with
@@ -52,10 +64,21 @@ func NewHistoricalKeyValueCache[T any](opts ...KeyValueCacheOptionFn) (*historic
 		return nil, err
 	}
 
-	return &historicalKeyValueCache[T]{
+	if err := validateValueType[T](&config.keyValueCacheConfig); err != nil {
+		return nil, err
+	}
+
+	historicalCache := &historicalKeyValueCache[T]{
 		valueHistories: make(map[string]cacheValueHistory[T]),
 		config:         config,
-	}, nil
+		name:           getCacheName[T](&config.keyValueCacheConfig),
+	}
+
+	if config.hasCapacityLimit() {
+		historicalCache.evictionQueue = newEvictionQueue(config.evictionPolicy)
+	}
+
+	return historicalCache, nil
 }
 
 // GetVersion retrieves the value from the cache with the given key and version.
@@ -64,7 +87,9 @@ func (c *historicalKeyValueCache[T]) GetVersion(key string, version int64) (T, b
 	c.valuesMu.RLock()
 	defer c.valuesMu.RUnlock()
 
-	return c.getVersion(key, version)
+	value, isCached := c.getVersion(key, version)
+	c.recordLookup(key, isCached)
+	return value, isCached
 }
 
 // getVersion retrieves the value from the cache with the given key for the given
@@ -92,6 +117,15 @@ func (c *historicalKeyValueCache[T]) GetVersionLTE(key string, maxVersion int64)
 	c.valuesMu.RLock()
 	defer c.valuesMu.RUnlock()
 
+	value, isCached := c.getVersionLTE(key, maxVersion)
+	c.recordLookup(key, isCached)
+	return value, isCached
+}
+
+// getVersionLTE retrieves the value from the cache with the given key, as of the
+// nearest version <= maxVersion.
+// It is NOT safe to call concurrently; i.e., the caller MUST hold the valuesMu lock.
+func (c *historicalKeyValueCache[T]) getVersionLTE(key string, maxVersion int64) (T, bool) {
 	var zero T
 	valueHistory, exists := c.valueHistories[key]
 	if !exists {
@@ -131,13 +165,17 @@ func (c *historicalKeyValueCache[T]) GetLatestVersion(key string) (T, bool) {
 	var zero T
 	version := c.getLatestVersionNumber(key)
 	if version == -1 {
+		c.recordLookup(key, false)
 		return zero, false
 	}
 
-	return c.getVersion(key, version)
+	value, isCached := c.getVersion(key, version)
+	c.recordLookup(key, isCached)
+	return value, isCached
 }
 
 // SetVersion adds or updates the historical value in the cache for the given key and version number.
+// If maxValueSize is configured, values larger than it are not cached.
 func (c *historicalKeyValueCache[T]) SetVersion(key string, value T, version int64) error {
 	c.valuesMu.Lock()
 	defer c.valuesMu.Unlock()
@@ -157,6 +195,15 @@ func (c *historicalKeyValueCache[T]) SetVersion(key string, value T, version int
 		return cache.ErrNoOverwrite.Wrapf("version: %d", version)
 	}
 
+	var valueSize int64
+	if c.config.maxValueSize > 0 {
+		valueSize = getValueSize(value)
+		if valueSize > c.config.maxValueSize {
+			return nil
+		}
+	}
+	previousValueHistorySize := valueHistory.size
+
 	// Update sortedDescVersions and ensure the list is sorted in descending order.
 	valueHistory.sortedDescVersions = append(valueHistory.sortedDescVersions, version)
 	sort.Slice(valueHistory.sortedDescVersions, func(i, j int) bool {
@@ -180,6 +227,7 @@ func (c *historicalKeyValueCache[T]) SetVersion(key string, value T, version int
 				break
 			}
 
+			valueHistory.size -= valueHistory.versionToValueMap[cachedVersion].size
 			delete(valueHistory.versionToValueMap, cachedVersion)
 		}
 	}
@@ -187,66 +235,65 @@ func (c *historicalKeyValueCache[T]) SetVersion(key string, value T, version int
 	valueHistory.versionToValueMap[version] = cacheValue[T]{
 		value:    value,
 		cachedAt: time.Now(),
+		size:     valueSize,
 	}
+	valueHistory.size += valueSize
 
 	c.valueHistories[key] = valueHistory
+	c.valuesSize += valueHistory.size - previousValueHistorySize
 
-	// Evict after adding the new key/value.
-	if err := c.evictKey(); err != nil {
-		return err
+	if c.evictionQueue != nil {
+		c.evictionQueue.onSet(key)
 	}
 
+	// Evict after adding the new key/value.
+	c.evictKeys(key)
+
 	return nil
 }
 
-// evictKey removes one key/value pair (and all its versions) from the cache,
-// to make space for a new one, according to the configured eviction policy.
-func (c *historicalKeyValueCache[T]) evictKey() error {
-	isMaxKeysConfigured := c.config.maxKeys > 0
-	cacheMaxKeysReached := int64(len(c.valueHistories)) > c.config.maxKeys
-	if !isMaxKeysConfigured || !cacheMaxKeysReached {
-		return nil
+// evictKeys removes key/value pairs (and all their versions) from the cache, according
+// to the configured eviction policy, until it is within its maxKeys and maxValueSize limits.
+// The given key, whose value was just set, is never evicted.
+// DEV_NOTE: The cache MAY therefore remain above maxValueSize if the versions of
+// the given key alone exceed it, until they are pruned according to maxVersionAge.
+// It is NOT safe to call concurrently; i.e., the caller MUST hold the valuesMu lock.
+func (c *historicalKeyValueCache[T]) evictKeys(setKey string) {
+	if c.evictionQueue == nil {
+		return
 	}
 
-	switch c.config.evictionPolicy {
-	case FirstInFirstOut:
-		var (
-			first      = true
-			oldestKey  string
-			oldestTime time.Time
-		)
-		for key, valueHistory := range c.valueHistories {
-			latestVersion := valueHistory.sortedDescVersions[0]
-			value, exists := valueHistory.versionToValueMap[latestVersion]
-			if !exists {
-				return cache.ErrCacheInternal.Wrapf(
-					"expected value history for key %s to contain version %d but it did not 💣",
-					key, latestVersion,
-				)
-			}
-
-			if first || value.cachedAt.Before(oldestTime) {
-				oldestKey = key
-				oldestTime = value.cachedAt
-			}
-			first = false
+	for c.isOverCapacity() {
+		evictedKey, ok := c.evictionQueue.next(setKey)
+		if !ok {
+			return
 		}
-		delete(c.valueHistories, oldestKey)
-		return nil
-
-	case LeastRecentlyUsed:
-		// TODO_IMPROVE: Implement LRU eviction
-		// This will require tracking access times
-		panic("LRU eviction not implemented")
-
-	case LeastFrequentlyUsed:
-		// TODO_IMPROVE: Implement LFU eviction
-		// This will require tracking access times
-		panic("LFU eviction not implemented")
-
-	default:
-		// DEV_NOTE: This SHOULD NEVER happen, KeyValueCacheConfig#Validate, SHOULD prevent it.
-		panic(fmt.Sprintf("unsupported eviction policy: %d", c.config.evictionPolicy))
+
+		c.valuesSize -= c.valueHistories[evictedKey].size
+		delete(c.valueHistories, evictedKey)
+		c.evictionQueue.remove(evictedKey)
+		CacheEvictionsTotal.With(cacheNameLabel, c.name).Add(1)
+	}
+}
+
+// isOverCapacity returns true if the cache exceeds its maxKeys or maxValueSize limits.
+// It is NOT safe to call concurrently; i.e., the caller MUST hold the valuesMu lock.
+func (c *historicalKeyValueCache[T]) isOverCapacity() bool {
+	isMaxKeysExceeded := c.config.maxKeys > 0 && int64(len(c.valueHistories)) > c.config.maxKeys
+	isMaxValueSizeExceeded := c.config.maxValueSize > 0 && c.valuesSize > c.config.maxValueSize
+	return isMaxKeysExceeded || isMaxValueSizeExceeded
+}
+
+// recordLookup records a cache hit or miss of the given key in the cache metrics
+// and, on hits, in the eviction queue.
+// The caller MUST hold the valuesMu (read) lock.
+func (c *historicalKeyValueCache[T]) recordLookup(key string, isHit bool) {
+	recordCacheLookup(c.name, isHit)
+
+	if isHit && c.evictionQueue != nil {
+		c.evictionQueueMu.Lock()
+		c.evictionQueue.onGet(key)
+		c.evictionQueueMu.Unlock()
 	}
 }
 
@@ -107,6 +107,88 @@ func TestMemoryHistoricalKeyValueCache(t *testing.T) {
 		require.Equal(t, "value3", val)
 	})
 
+	t.Run("LRU eviction", func(t *testing.T) {
+		kvcache, err := NewHistoricalKeyValueCache[string](
+			WithMaxKeys(2),
+			WithEvictionPolicy(LeastRecentlyUsed),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, kvcache.SetVersion("key1", "value1", 10))
+		require.NoError(t, kvcache.SetVersion("key2", "value2", 20))
+
+		// Retrieving any version of key1 makes key2 the least recently used.
+		_, isCached := kvcache.GetVersionLTE("key1", 15)
+		require.True(t, isCached)
+
+		require.NoError(t, kvcache.SetVersion("key3", "value3", 30))
+
+		_, isCached = kvcache.GetLatestVersion("key2")
+		require.False(t, isCached)
+		_, isCached = kvcache.GetLatestVersion("key1")
+		require.True(t, isCached)
+		_, isCached = kvcache.GetLatestVersion("key3")
+		require.True(t, isCached)
+	})
+
+	t.Run("LFU eviction", func(t *testing.T) {
+		kvcache, err := NewHistoricalKeyValueCache[string](
+			WithMaxKeys(2),
+			WithEvictionPolicy(LeastFrequentlyUsed),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, kvcache.SetVersion("key1", "value1", 10))
+		require.NoError(t, kvcache.SetVersion("key2", "value2", 20))
+
+		// Setting new versions of key2 counts as using it.
+		require.NoError(t, kvcache.SetVersion("key2", "value2", 21))
+		require.NoError(t, kvcache.SetVersion("key2", "value2", 22))
+
+		require.NoError(t, kvcache.SetVersion("key3", "value3", 30))
+
+		_, isCached := kvcache.GetLatestVersion("key1")
+		require.False(t, isCached)
+		_, isCached = kvcache.GetLatestVersion("key2")
+		require.True(t, isCached)
+		_, isCached = kvcache.GetLatestVersion("key3")
+		require.True(t, isCached)
+	})
+
+	t.Run("max value size eviction", func(t *testing.T) {
+		kvcache, err := NewHistoricalKeyValueCache[string](
+			WithMaxValueSize(10),
+			WithMaxVersionAge(1),
+		)
+		require.NoError(t, err)
+
+		// The size of a key accounts for all its cached versions.
+		require.NoError(t, kvcache.SetVersion("key1", "123", 10))
+		require.NoError(t, kvcache.SetVersion("key1", "123", 11))
+		require.NoError(t, kvcache.SetVersion("key2", "123", 20))
+
+		// Pruned versions are no longer accounted for.
+		require.NoError(t, kvcache.SetVersion("key1", "123", 12))
+		_, isCached := kvcache.GetVersion("key1", 10)
+		require.False(t, isCached)
+		_, isCached = kvcache.GetLatestVersion("key2")
+		require.True(t, isCached)
+
+		// Exceeding the max value size evicts the key set the longest time ago.
+		require.NoError(t, kvcache.SetVersion("key3", "1234", 30))
+		_, isCached = kvcache.GetLatestVersion("key2")
+		require.False(t, isCached)
+		_, isCached = kvcache.GetLatestVersion("key1")
+		require.True(t, isCached)
+		_, isCached = kvcache.GetLatestVersion("key3")
+		require.True(t, isCached)
+
+		// Values larger than the max value size are not cached.
+		require.NoError(t, kvcache.SetVersion("key4", "12345678901", 40))
+		_, isCached = kvcache.GetLatestVersion("key4")
+		require.False(t, isCached)
+	})
+
 	t.Run("historical cache ignores TTL expiration", func(t *testing.T) {
 		cache, err := NewHistoricalKeyValueCache[string](
 			WithMaxVersionAge(100),
@@ -1,7 +1,6 @@
 package memory
 
 import (
-	"fmt"
 	"sync"
 	"time"
 
@@ -13,11 +12,22 @@ var _ cache.KeyValueCache[any] = (*keyValueCache[any])(nil)
 // keyValueCache provides a concurrency-safe in-memory key/value cache implementation.
 type keyValueCache[T any] struct {
 	config keyValueCacheConfig
+	// name identifies the cache in its metrics.
+	name string
 
-	// valuesMu is used to protect values AND valueHistories from concurrent access.
+	// valuesMu is used to protect values, valuesSize AND evictionQueue from concurrent access.
 	valuesMu sync.RWMutex
 	// values holds the cached values.
 	values map[string]cacheValue[T]
+	// valuesSize is the cumulative size of the cached values, if maxValueSize is configured.
+	valuesSize int64
+
+	// evictionQueue orders the cached keys according to the configured eviction policy.
+	// It is nil if neither maxKeys nor maxValueSize is configured.
+	evictionQueue evictionQueue
+	// evictionQueueMu serializes the eviction queue updates of concurrent cache
+	// hits, which only hold the valuesMu read lock.
+	evictionQueueMu sync.Mutex
 }
 
 // cacheValue wraps cached values with a cachedAt for later comparison against
@@ -25,6 +35,8 @@ type keyValueCache[T any] struct {
 type cacheValue[T any] struct {
 	value    T
 	cachedAt time.Time
+	// size is the size of value, if maxValueSize is configured.
+	size int64
 }
 
 // NewKeyValueCache creates a new keyValueCache with the configuration generated
@@ -42,10 +54,21 @@ func NewKeyValueCache[T any](opts ...KeyValueCacheOptionFn) (*keyValueCache[T],
 		return nil, err
 	}
 
-	return &keyValueCache[T]{
+	if err := validateValueType[T](&config); err != nil {
+		return nil, err
+	}
+
+	kvCache := &keyValueCache[T]{
 		values: make(map[string]cacheValue[T]),
 		config: config,
-	}, nil
+		name:   getCacheName[T](&config),
+	}
+
+	if config.hasCapacityLimit() {
+		kvCache.evictionQueue = newEvictionQueue(config.evictionPolicy)
+	}
+
+	return kvCache, nil
 }
 
 // Get retrieves the value from the cache with the given key.
@@ -56,6 +79,7 @@ func (c *keyValueCache[T]) Get(key string) (T, bool) {
 
 	cachedValue, exists := c.values[key]
 	if !exists {
+		recordCacheLookup(c.name, false)
 		return zero, false
 	}
 
@@ -66,24 +90,49 @@ func (c *keyValueCache[T]) Get(key string) (T, bool) {
 		// - Next Set() call will overwrite the value
 		// - If values aren't subsequently set, maxKeys config will eventually trigger
 		//   pruning of TTL-expired values
+		recordCacheLookup(c.name, false)
 		return zero, false
 	}
 
+	if c.evictionQueue != nil {
+		c.evictionQueueMu.Lock()
+		c.evictionQueue.onGet(key)
+		c.evictionQueueMu.Unlock()
+	}
+
+	recordCacheLookup(c.name, true)
 	return cachedValue.value, true
 }
 
 // Set adds or updates the value in the cache for the given key.
+// If maxValueSize is configured, values larger than it are not cached.
 func (c *keyValueCache[T]) Set(key string, value T) {
 	c.valuesMu.Lock()
 	defer c.valuesMu.Unlock()
 
+	var valueSize int64
+	if c.config.maxValueSize > 0 {
+		valueSize = getValueSize(value)
+		if valueSize > c.config.maxValueSize {
+			// Do not keep the stale value of the key, which the caller is replacing.
+			c.deleteKey(key)
+			return
+		}
+	}
+
+	c.valuesSize += valueSize - c.values[key].size
 	c.values[key] = cacheValue[T]{
 		value:    value,
 		cachedAt: time.Now(),
+		size:     valueSize,
+	}
+
+	if c.evictionQueue != nil {
+		c.evictionQueue.onSet(key)
 	}
 
 	// Evict after adding the new key/value.
-	c.evictKey()
+	c.evictKeys(key)
 }
 
 // Delete removes a value from the cache.
@@ -91,7 +140,7 @@ func (c *keyValueCache[T]) Delete(key string) {
 	c.valuesMu.Lock()
 	defer c.valuesMu.Unlock()
 
-	delete(c.values, key)
+	c.deleteKey(key)
 }
 
 // Clear removes all values from the cache.
@@ -100,46 +149,46 @@ func (c *keyValueCache[T]) Clear() {
 	defer c.valuesMu.Unlock()
 
 	c.values = make(map[string]cacheValue[T])
+	c.valuesSize = 0
+	if c.evictionQueue != nil {
+		c.evictionQueue.clear()
+	}
 }
 
-// evictKey removes one key/value pair from the cache, to make space for a new one,
-// according to the configured eviction policy.
-func (c *keyValueCache[T]) evictKey() {
-	isMaxKeysConfigured := c.config.maxKeys > 0
-	cacheMaxKeysReached := int64(len(c.values)) > c.config.maxKeys
-	if !isMaxKeysConfigured || !cacheMaxKeysReached {
-		return
+// deleteKey removes the given key and its value from the cache.
+// It is NOT safe to call concurrently; i.e., the caller MUST hold the valuesMu lock.
+func (c *keyValueCache[T]) deleteKey(key string) {
+	c.valuesSize -= c.values[key].size
+	delete(c.values, key)
+	if c.evictionQueue != nil {
+		c.evictionQueue.remove(key)
 	}
+}
 
-	switch c.config.evictionPolicy {
-	case FirstInFirstOut:
-		var (
-			first      = true
-			oldestKey  string
-			oldestTime time.Time
-		)
-		for key, value := range c.values {
-			if first || value.cachedAt.Before(oldestTime) {
-				oldestKey = key
-				oldestTime = value.cachedAt
-			}
-			first = false
-		}
-		delete(c.values, oldestKey)
+// evictKeys removes key/value pairs from the cache, according to the configured
+// eviction policy, until it is within its maxKeys and maxValueSize limits.
+// The given key, whose value was just set, is never evicted.
+// It is NOT safe to call concurrently; i.e., the caller MUST hold the valuesMu lock.
+func (c *keyValueCache[T]) evictKeys(setKey string) {
+	if c.evictionQueue == nil {
 		return
+	}
 
-	// DEV_NOTE: The following cases SHOULD NEVER happen, KeyValueCacheConfig#Validate, SHOULD prevent it.
-	case LeastRecentlyUsed:
-		// TODO_IMPROVE: Implement LRU eviction
-		// This will require tracking access times
-		panic("LRU eviction not implemented")
-
-	case LeastFrequentlyUsed:
-		// TODO_IMPROVE: Implement LFU eviction
-		// This will require tracking access times
-		panic("LFU eviction not implemented")
+	for c.isOverCapacity() {
+		evictedKey, ok := c.evictionQueue.next(setKey)
+		if !ok {
+			return
+		}
 
-	default:
-		panic(fmt.Sprintf("unsupported eviction policy: %d", c.config.evictionPolicy))
+		c.deleteKey(evictedKey)
+		CacheEvictionsTotal.With(cacheNameLabel, c.name).Add(1)
 	}
 }
+
+// isOverCapacity returns true if the cache exceeds its maxKeys or maxValueSize limits.
+// It is NOT safe to call concurrently; i.e., the caller MUST hold the valuesMu lock.
+func (c *keyValueCache[T]) isOverCapacity() bool {
+	isMaxKeysExceeded := c.config.maxKeys > 0 && int64(len(c.values)) > c.config.maxKeys
+	isMaxValueSizeExceeded := c.config.maxValueSize > 0 && c.valuesSize > c.config.maxValueSize
+	return isMaxKeysExceeded || isMaxValueSizeExceeded
+}
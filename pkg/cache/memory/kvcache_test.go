@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/cache"
 )
 
 // TestMemoryKeyValueCache exercises the basic cache functionality.
@@ -86,6 +88,97 @@ func TestMemoryKeyValueCache(t *testing.T) {
 		require.Equal(t, "value3", val)
 	})
 
+	t.Run("LRU eviction", func(t *testing.T) {
+		cache, err := NewKeyValueCache[string](
+			WithMaxKeys(2),
+			WithEvictionPolicy(LeastRecentlyUsed),
+		)
+		require.NoError(t, err)
+
+		cache.Set("key1", "value1")
+		cache.Set("key2", "value2")
+
+		// Retrieving key1 makes key2 the least recently used.
+		_, isCached := cache.Get("key1")
+		require.True(t, isCached)
+
+		cache.Set("key3", "value3")
+
+		_, isCached = cache.Get("key2")
+		require.False(t, isCached)
+		_, isCached = cache.Get("key1")
+		require.True(t, isCached)
+		_, isCached = cache.Get("key3")
+		require.True(t, isCached)
+	})
+
+	t.Run("LFU eviction", func(t *testing.T) {
+		cache, err := NewKeyValueCache[string](
+			WithMaxKeys(2),
+			WithEvictionPolicy(LeastFrequentlyUsed),
+		)
+		require.NoError(t, err)
+
+		cache.Set("key1", "value1")
+		cache.Set("key2", "value2")
+
+		// key1 is used more frequently than key2.
+		for i := 0; i < 3; i++ {
+			_, isCached := cache.Get("key1")
+			require.True(t, isCached)
+		}
+		_, isCached := cache.Get("key2")
+		require.True(t, isCached)
+
+		// The new key is never evicted in favor of more frequently used keys.
+		cache.Set("key3", "value3")
+
+		_, isCached = cache.Get("key2")
+		require.False(t, isCached)
+		_, isCached = cache.Get("key1")
+		require.True(t, isCached)
+		_, isCached = cache.Get("key3")
+		require.True(t, isCached)
+	})
+
+	t.Run("max value size eviction", func(t *testing.T) {
+		cache, err := NewKeyValueCache[string](
+			WithMaxValueSize(10),
+			WithEvictionPolicy(FirstInFirstOut),
+		)
+		require.NoError(t, err)
+
+		cache.Set("key1", "1234")
+		cache.Set("key2", "1234")
+
+		// Updating a value only accounts for its new size.
+		cache.Set("key2", "12345")
+		_, isCached := cache.Get("key1")
+		require.True(t, isCached)
+
+		// Exceeding the max value size evicts the oldest values.
+		cache.Set("key3", "12345")
+		_, isCached = cache.Get("key1")
+		require.False(t, isCached)
+		_, isCached = cache.Get("key2")
+		require.True(t, isCached)
+
+		// Values larger than the max value size are not cached, and replace the previous value.
+		cache.Set("key2", "12345678901")
+		_, isCached = cache.Get("key2")
+		require.False(t, isCached)
+		val, isCached := cache.Get("key3")
+		require.True(t, isCached)
+		require.Equal(t, "12345", val)
+
+		// Deleted values are no longer accounted for.
+		cache.Delete("key3")
+		cache.Set("key4", "1234567890")
+		val, isCached = cache.Get("key4")
+		require.True(t, isCached)
+		require.Equal(t, "1234567890", val)
+	})
+
 	t.Run("no TTL", func(t *testing.T) {
 		cache, err := NewKeyValueCache[string](WithNoTTL())
 		require.NoError(t, err)
@@ -99,6 +192,16 @@ func TestMemoryKeyValueCache(t *testing.T) {
 
 // TestKeyValueCache_ErrorCases exercises various error conditions
 func TestKeyValueCache_ErrorCases(t *testing.T) {
+	t.Run("max value size of unsized value type", func(t *testing.T) {
+		_, err := NewKeyValueCache[int](WithMaxValueSize(10))
+		require.ErrorIs(t, err, cache.ErrKeyValueCacheConfigValidation)
+	})
+
+	t.Run("negative max value size", func(t *testing.T) {
+		_, err := NewKeyValueCache[string](WithMaxValueSize(-1))
+		require.ErrorIs(t, err, cache.ErrKeyValueCacheConfigValidation)
+	})
+
 	t.Run("zero values", func(t *testing.T) {
 		cache, err := NewKeyValueCache[string]()
 		require.NoError(t, err)
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	cacheSubsystem = "cache"
+
+	cacheHitsTotal      = "hits_total"
+	cacheMissesTotal    = "misses_total"
+	cacheEvictionsTotal = "evictions_total"
+
+	// cacheNameLabel is the label identifying the cache, see: WithName.
+	cacheNameLabel = "cache"
+)
+
+var (
+	// CacheHitsTotal is a Counter metric for the number of values found in the cache.
+	// It is labeled by the cache name.
+	CacheHitsTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: cacheSubsystem,
+		Name:      cacheHitsTotal,
+		Help:      "Total number of cache hits, labeled by cache name.",
+	}, []string{cacheNameLabel})
+
+	// CacheMissesTotal is a Counter metric for the number of values not found in
+	// the cache, including TTL-expired values.
+	// It is labeled by the cache name.
+	CacheMissesTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: cacheSubsystem,
+		Name:      cacheMissesTotal,
+		Help:      "Total number of cache misses, labeled by cache name.",
+	}, []string{cacheNameLabel})
+
+	// CacheEvictionsTotal is a Counter metric for the number of keys evicted to keep
+	// the cache within its maxKeys and maxValueSize limits.
+	// It is labeled by the cache name.
+	//
+	// Usage:
+	// - A high eviction rate relative to the hits suggests that the cache is too small.
+	CacheEvictionsTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: cacheSubsystem,
+		Name:      cacheEvictionsTotal,
+		Help:      "Total number of keys evicted from the cache, labeled by cache name.",
+	}, []string{cacheNameLabel})
+)
+
+// recordCacheLookup increments the hit or miss counter of the named cache.
+func recordCacheLookup(cacheName string, isHit bool) {
+	if isHit {
+		CacheHitsTotal.With(cacheNameLabel, cacheName).Add(1)
+		return
+	}
+	CacheMissesTotal.With(cacheNameLabel, cacheName).Add(1)
+}
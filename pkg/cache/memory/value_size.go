@@ -0,0 +1,39 @@
+package memory
+
+// valueSizer is implemented by values which know their size in bytes
+// (e.g. the protobuf generated types).
+type valueSizer interface {
+	Size() int
+}
+
+// isValueSizeSupported returns true if the size of values of type T can be
+// determined by getValueSize.
+func isValueSizeSupported[T any]() bool {
+	var zero T
+	switch any(zero).(type) {
+	case string, []byte, valueSizer:
+		return true
+	}
+
+	// The protobuf generated types implement Size with a pointer receiver.
+	_, isPtrSizer := any(&zero).(valueSizer)
+	return isPtrSizer
+}
+
+// getValueSize returns the size in bytes of the given value.
+// It returns 0 if isValueSizeSupported is false for type T.
+func getValueSize[T any](value T) int64 {
+	switch typedValue := any(value).(type) {
+	case string:
+		return int64(len(typedValue))
+	case []byte:
+		return int64(len(typedValue))
+	case valueSizer:
+		return int64(typedValue.Size())
+	}
+
+	if sizer, isPtrSizer := any(&value).(valueSizer); isPtrSizer {
+		return int64(sizer.Size())
+	}
+	return 0
+}
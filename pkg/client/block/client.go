@@ -49,13 +49,15 @@ const (
 // committed block events which are mapped to Block objects.
 //
 // This lightly wraps the EventsReplayClient[Block] generic to correctly mock
-// the interface.
+// the interface. The given options configure this EventsReplayClient, e.g. to
+// backfill the blocks committed since a persisted height cursor.
 //
 // Required dependencies:
 // - client.BlockQueryClient
 func NewBlockClient(
 	ctx context.Context,
 	deps depinject.Config,
+	replayClientOpts ...events.ReplayClientOption,
 ) (_ client.BlockClient, err error) {
 	ctx, cancel := context.WithCancel(ctx)
 
@@ -74,6 +76,7 @@ func NewBlockClient(
 		cometNewBlockHeaderQuery,
 		UnmarshalNewBlock,
 		defaultBlocksReplayLimit,
+		replayClientOpts...,
 	)
 	if err != nil {
 		cancel()
@@ -0,0 +1,161 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	cometclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// getResultEventHeight returns the height of the given event and whether every
+// event of this height matching its query was emitted before or with it.
+// It returns false if the height of the event is unknown.
+func getResultEventHeight(resultEvent *coretypes.ResultEvent) (height int64, isHeightComplete, ok bool) {
+	switch data := resultEvent.Data.(type) {
+	case types.EventDataNewBlockHeader:
+		return data.Header.Height, true, true
+	case types.EventDataNewBlock:
+		if data.Block == nil {
+			return 0, false, false
+		}
+		return data.Block.Height, true, true
+	case types.EventDataTx:
+		// Other transactions of the same block may still be emitted.
+		return data.Height, false, true
+	default:
+		return 0, false, false
+	}
+}
+
+// getResultEventTxHash returns the hash of the transaction of the given event,
+// if it is a transaction event.
+func getResultEventTxHash(resultEvent *coretypes.ResultEvent) (string, bool) {
+	data, ok := resultEvent.Data.(types.EventDataTx)
+	if !ok {
+		return "", false
+	}
+
+	return fmt.Sprintf("%X", types.Tx(data.Tx).Hash()), true
+}
+
+// getBlockResultEvents rebuilds, from the block and block_results of the given
+// height, the events CometBFT emitted at this height which match the given query.
+// The events are returned in the order CometBFT emits them: the new block, the
+// new block header, then the transactions in the order of the block.
+// A nil query matches every event.
+func getBlockResultEvents(
+	ctx context.Context,
+	cometClient cometclient.Client,
+	eventsQuery *query.Query,
+	height int64,
+) ([]coretypes.ResultEvent, error) {
+	blockRes, err := cometClient.Block(ctx, &height)
+	if err != nil {
+		return nil, ErrEventsBackfill.Wrapf("getting block %d: %s", height, err)
+	}
+
+	blockResultsRes, err := cometClient.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, ErrEventsBackfill.Wrapf("getting block results %d: %s", height, err)
+	}
+
+	block := blockRes.Block
+	if block == nil {
+		return nil, ErrEventsBackfill.Wrapf("block %d not found", height)
+	}
+
+	txs := block.Data.Txs
+	if len(txs) != len(blockResultsRes.TxsResults) {
+		return nil, ErrEventsBackfill.Wrapf(
+			"block %d has %d transactions but %d transaction results",
+			height, len(txs), len(blockResultsRes.TxsResults),
+		)
+	}
+
+	candidateEvents := make([]coretypes.ResultEvent, 0, len(txs)+2)
+
+	newBlockEvents := getCompositeEvents(blockResultsRes.FinalizeBlockEvents)
+	newBlockEvents[types.EventTypeKey] = append(newBlockEvents[types.EventTypeKey], types.EventNewBlock)
+	candidateEvents = append(candidateEvents, coretypes.ResultEvent{
+		Data: types.EventDataNewBlock{
+			Block:   block,
+			BlockID: blockRes.BlockID,
+			ResultFinalizeBlock: abci.ResponseFinalizeBlock{
+				Events:                blockResultsRes.FinalizeBlockEvents,
+				TxResults:             blockResultsRes.TxsResults,
+				ValidatorUpdates:      blockResultsRes.ValidatorUpdates,
+				ConsensusParamUpdates: blockResultsRes.ConsensusParamUpdates,
+				AppHash:               blockResultsRes.AppHash,
+			},
+		},
+		Events: newBlockEvents,
+	})
+
+	candidateEvents = append(candidateEvents, coretypes.ResultEvent{
+		Data:   types.EventDataNewBlockHeader{Header: block.Header},
+		Events: map[string][]string{types.EventTypeKey: {types.EventNewBlockHeader}},
+	})
+
+	for txIndex, tx := range txs {
+		txResult := blockResultsRes.TxsResults[txIndex]
+		if txResult == nil {
+			return nil, ErrEventsBackfill.Wrapf("block %d has no result for transaction %d", height, txIndex)
+		}
+
+		txEvents := getCompositeEvents(txResult.Events)
+		txEvents[types.EventTypeKey] = append(txEvents[types.EventTypeKey], types.EventTx)
+		txEvents[types.TxHashKey] = append(txEvents[types.TxHashKey], fmt.Sprintf("%X", tx.Hash()))
+		txEvents[types.TxHeightKey] = append(txEvents[types.TxHeightKey], strconv.FormatInt(height, 10))
+
+		candidateEvents = append(candidateEvents, coretypes.ResultEvent{
+			Data: types.EventDataTx{TxResult: abci.TxResult{
+				Height: height,
+				Index:  uint32(txIndex),
+				Tx:     tx,
+				Result: *txResult,
+			}},
+			Events: txEvents,
+		})
+	}
+
+	resultEvents := make([]coretypes.ResultEvent, 0, len(candidateEvents))
+	for _, candidateEvent := range candidateEvents {
+		isMatch, err := eventsQuery.Matches(candidateEvent.Events)
+		if err != nil {
+			return nil, ErrEventsBackfill.Wrapf("matching events of block %d: %s", height, err)
+		}
+		if !isMatch {
+			continue
+		}
+		resultEvents = append(resultEvents, candidateEvent)
+	}
+
+	return resultEvents, nil
+}
+
+// getCompositeEvents flattens the given ABCI events into the composite keys
+// (i.e. "<event type>.<attribute key>") the subscription queries are matched against.
+func getCompositeEvents(events []abci.Event) map[string][]string {
+	compositeEvents := make(map[string][]string)
+	for _, event := range events {
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+
+			compositeKey := fmt.Sprintf("%s.%s", event.Type, attr.Key)
+			compositeEvents[compositeKey] = append(compositeEvents[compositeKey], attr.Value)
+		}
+	}
+
+	return compositeEvents
+}
@@ -9,4 +9,10 @@
 // provide the latest event data to the caller, even if the connection to the
 // EventsQueryClient is lost and re-established, without the caller having to
 // re-subscribe to the EventsQueryClient.
+//
+// The events missed while the subscription is re-established are backfilled
+// through block_results queries before the live ones are published. With an
+// EventsHeightCursorStore, the height up to which the events were published is
+// persisted so that the events emitted while the client was not running are
+// backfilled on startup as well.
 package events
@@ -0,0 +1,98 @@
+package events
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+)
+
+// heightCursorFileExt is the extension of the files holding the height cursors.
+const heightCursorFileExt = ".json"
+
+// Enforce the EventsHeightCursorStore interface is implemented by the fileHeightCursorStore type.
+var _ client.EventsHeightCursorStore = (*fileHeightCursorStore)(nil)
+
+// fileHeightCursorStore is an EventsHeightCursorStore persisting the cursor of
+// each query in its own file of a directory.
+type fileHeightCursorStore struct {
+	dirPath string
+
+	// mu serializes the writes of the cursor files.
+	mu sync.Mutex
+}
+
+// heightCursor is the content of a height cursor file.
+type heightCursor struct {
+	// Query is the subscription query of the cursor, kept for operators
+	// inspecting the store since the file name is a hash of it.
+	Query  string `json:"query"`
+	Height int64  `json:"height"`
+}
+
+// NewFileHeightCursorStore returns an EventsHeightCursorStore persisting the
+// height cursors in the given directory, which is created if needed.
+func NewFileHeightCursorStore(dirPath string) (client.EventsHeightCursorStore, error) {
+	if dirPath == "" {
+		return nil, ErrEventsHeightCursor.Wrap("empty height cursor store directory path")
+	}
+
+	if err := os.MkdirAll(dirPath, 0o755); err != nil {
+		return nil, ErrEventsHeightCursor.Wrapf("creating height cursor store directory %q: %s", dirPath, err)
+	}
+
+	return &fileHeightCursorStore{dirPath: dirPath}, nil
+}
+
+// GetHeight returns the height of the cursor of the given query, and whether
+// it was found.
+func (store *fileHeightCursorStore) GetHeight(queryString string) (int64, bool, error) {
+	cursorBz, err := os.ReadFile(store.getCursorPath(queryString))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, ErrEventsHeightCursor.Wrapf("reading height cursor of query %q: %s", queryString, err)
+	}
+
+	cursor := heightCursor{}
+	if err = json.Unmarshal(cursorBz, &cursor); err != nil {
+		return 0, false, ErrEventsHeightCursor.Wrapf("decoding height cursor of query %q: %s", queryString, err)
+	}
+
+	return cursor.Height, true, nil
+}
+
+// SetHeight atomically sets the height of the cursor of the given query.
+func (store *fileHeightCursorStore) SetHeight(queryString string, height int64) error {
+	cursorBz, err := json.Marshal(heightCursor{Query: queryString, Height: height})
+	if err != nil {
+		return ErrEventsHeightCursor.Wrapf("encoding height cursor of query %q: %s", queryString, err)
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	// Write to a temporary file first so that a crash never leaves a truncated cursor.
+	cursorPath := store.getCursorPath(queryString)
+	tmpCursorPath := cursorPath + ".tmp"
+	if err = os.WriteFile(tmpCursorPath, cursorBz, 0o600); err != nil {
+		return ErrEventsHeightCursor.Wrapf("writing height cursor of query %q: %s", queryString, err)
+	}
+	if err = os.Rename(tmpCursorPath, cursorPath); err != nil {
+		return ErrEventsHeightCursor.Wrapf("writing height cursor of query %q: %s", queryString, err)
+	}
+
+	return nil
+}
+
+// getCursorPath returns the path of the file holding the cursor of the given query.
+func (store *fileHeightCursorStore) getCursorPath(queryString string) string {
+	queryHash := sha256.Sum256([]byte(queryString))
+	return filepath.Join(store.dirPath, hex.EncodeToString(queryHash[:])+heightCursorFileExt)
+}
@@ -0,0 +1,45 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/client/events"
+)
+
+func TestFileHeightCursorStore(t *testing.T) {
+	dirPath := t.TempDir()
+	blocksQuery := "tm.event='NewBlockHeader'"
+	txsQuery := "tm.event='Tx'"
+
+	store, err := events.NewFileHeightCursorStore(dirPath)
+	require.NoError(t, err)
+
+	_, found, err := store.GetHeight(blocksQuery)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, store.SetHeight(blocksQuery, 10))
+	require.NoError(t, store.SetHeight(blocksQuery, 11))
+	require.NoError(t, store.SetHeight(txsQuery, 5))
+
+	// The cursors are persisted across store instances.
+	store, err = events.NewFileHeightCursorStore(dirPath)
+	require.NoError(t, err)
+
+	height, found, err := store.GetHeight(blocksQuery)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(11), height)
+
+	height, found, err = store.GetHeight(txsQuery)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(5), height)
+}
+
+func TestFileHeightCursorStore_EmptyPath(t *testing.T) {
+	_, err := events.NewFileHeightCursorStore("")
+	require.ErrorIs(t, err, events.ErrEventsHeightCursor)
+}
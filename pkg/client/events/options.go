@@ -0,0 +1,65 @@
+package events
+
+import (
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+)
+
+const (
+	// DefaultMaxBackfillBlocks is the default maximum number of blocks whose events
+	// are backfilled at once when a gap is detected.
+	DefaultMaxBackfillBlocks = 1000
+
+	// DefaultBackfillInterval is the default period at which the events emitted
+	// since the cursor height are backfilled.
+	DefaultBackfillInterval = 30 * time.Second
+)
+
+// ReplayClientOption configures the optional behaviors of an EventsReplayClient.
+// It is not generic so that the same options apply to replay clients of any event type.
+type ReplayClientOption func(*replayClientConfig)
+
+// replayClientConfig holds the optional behaviors of an EventsReplayClient.
+type replayClientConfig struct {
+	// heightCursorStore persists the height up to which the events were published,
+	// if any, so that the events emitted while the client was not running are
+	// backfilled on startup.
+	heightCursorStore client.EventsHeightCursorStore
+
+	// maxBackfillBlocks is the maximum number of blocks whose events are backfilled
+	// at once when a gap is detected. The newest blocks of larger gaps are deferred
+	// to the next backfills.
+	maxBackfillBlocks int64
+
+	// backfillInterval is the period at which the events emitted since the cursor
+	// height are backfilled. It retries the failed backfills and advances the
+	// cursor height of the queries whose events do not occur in every block
+	// (e.g. transactions).
+	backfillInterval time.Duration
+}
+
+// WithHeightCursorStore sets the store persisting the height cursor of the
+// replay client. When set, the events emitted since the persisted height are
+// backfilled on startup.
+func WithHeightCursorStore(store client.EventsHeightCursorStore) ReplayClientOption {
+	return func(config *replayClientConfig) {
+		config.heightCursorStore = store
+	}
+}
+
+// WithMaxBackfillBlocks sets the maximum number of blocks whose events are
+// backfilled at once when a gap is detected.
+func WithMaxBackfillBlocks(maxBackfillBlocks int64) ReplayClientOption {
+	return func(config *replayClientConfig) {
+		config.maxBackfillBlocks = maxBackfillBlocks
+	}
+}
+
+// WithBackfillInterval sets the period at which the events emitted since the
+// cursor height are backfilled.
+func WithBackfillInterval(backfillInterval time.Duration) ReplayClientOption {
+	return func(config *replayClientConfig) {
+		config.backfillInterval = backfillInterval
+	}
+}
@@ -2,8 +2,10 @@ package events
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/depinject"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
 	cometclient "github.com/cometbft/cometbft/rpc/client"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 
@@ -11,11 +13,22 @@ import (
 	"github.com/pokt-network/poktroll/pkg/observable"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
 	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
 )
 
-// subscriptionReplayClient is the name of the subscription client used to subscribe
-// to events via the CometBFT WebSocket connection.
-const subscriptionReplayClient = "replay-client"
+const (
+	// subscriptionReplayClient is the name of the subscription client used to subscribe
+	// to events via the CometBFT WebSocket connection.
+	subscriptionReplayClient = "replay-client"
+
+	// resubscribeInitialRetryDelay is the delay before the first attempt to
+	// re-establish a closed subscription. It doubles after each failed attempt.
+	resubscribeInitialRetryDelay = time.Second
+
+	// resubscribeMaxRetryDelay is the maximum delay between two attempts to
+	// re-establish a closed subscription.
+	resubscribeMaxRetryDelay = 30 * time.Second
+)
 
 // Enforce the EventsReplayClient interface is implemented by the replayClient type.
 var _ client.EventsReplayClient[any] = (*replayClient[any])(nil)
@@ -56,6 +69,23 @@ type replayClient[T any] struct {
 
 	// replayEventTypeObsCh is the channel used to publish events of type T
 	replayEventTypeObsCh chan<- T
+
+	// config holds the optional behaviors of the replay client.
+	config replayClientConfig
+
+	// eventsQuery is the parsed queryString, used to select the backfilled events.
+	// It is nil, matching every event, if queryString is empty.
+	eventsQuery *query.Query
+
+	// cursorHeight:
+	// - Height up to which every event was published, 0 until known
+	// - Loaded from the height cursor store, if any, or set by the first event received
+	// - Only accessed by the goPublishEvents goroutine once the client is created
+	cursorHeight int64
+
+	// txHashHeights holds the heights of the transaction events published above
+	// cursorHeight, by transaction hash, to avoid publishing them twice.
+	txHashHeights map[string]int64
 }
 
 // NewEventsReplayClient creates a new EventsReplayClient from the given dependencies
@@ -64,6 +94,11 @@ type replayClient[T any] struct {
 //     result into the type defined by the EventsReplayClient's generic type parameter.
 //   - The replayObsBufferSize is the replay buffer size of the replay observable
 //     which is notified of new events.
+//   - The events missed while reconnecting are backfilled through block_results
+//     queries. With a height cursor store (see WithHeightCursorStore), so are the
+//     events emitted while the client was not running.
+//   - The events emitted since the cursor height are also periodically backfilled
+//     (see WithBackfillInterval), retrying the failed backfills.
 //
 // Required dependencies:
 //   - cometClient: cometbft/rpc/client/http.HTTP
@@ -73,6 +108,7 @@ func NewEventsReplayClient[T any](
 	queryString string,
 	newEventFn NewEventsFn[T],
 	replayObsBufferSize int,
+	opts ...ReplayClientOption,
 ) (client.EventsReplayClient[T], error) {
 
 	// Initialize the replay client
@@ -80,6 +116,15 @@ func NewEventsReplayClient[T any](
 		queryString:         queryString,
 		eventDecoder:        newEventFn,
 		replayObsBufferSize: replayObsBufferSize,
+		config: replayClientConfig{
+			maxBackfillBlocks: DefaultMaxBackfillBlocks,
+			backfillInterval:  DefaultBackfillInterval,
+		},
+		txHashHeights: make(map[string]int64),
+	}
+
+	for _, opt := range opts {
+		opt(&rClient.config)
 	}
 
 	// Inject dependencies
@@ -87,6 +132,18 @@ func NewEventsReplayClient[T any](
 		return nil, err
 	}
 
+	if len(queryString) != 0 {
+		eventsQuery, err := query.New(queryString)
+		if err != nil {
+			return nil, ErrEventsSubscribe.Wrapf("invalid query %q: %s", queryString, err)
+		}
+		rClient.eventsQuery = eventsQuery
+	}
+
+	if err := rClient.loadCursorHeight(ctx); err != nil {
+		return nil, err
+	}
+
 	// Create a new replay observable and publish channel for event type T with
 	// a buffer size matching that provided during the EventsReplayClient
 	// construction.
@@ -105,11 +162,44 @@ func NewEventsReplayClient[T any](
 	rClient.replayEventTypeObsCh = replayEventTypeObsCh
 
 	// Concurrently publish events to the observable emitted by replayEventTypeObsCh.
-	go rClient.goPublishEvents(resultEventCh)
+	go rClient.goPublishEvents(ctx, resultEventCh)
 
 	return rClient, nil
 }
 
+// loadCursorHeight loads the cursor height from the height cursor store, if any.
+// A query without a persisted cursor starts at the latest height, so that the
+// events emitted from now on are backfilled after a restart.
+func (rClient *replayClient[T]) loadCursorHeight(ctx context.Context) error {
+	store := rClient.config.heightCursorStore
+	if store == nil {
+		return nil
+	}
+
+	height, found, err := store.GetHeight(rClient.queryString)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		rClient.cursorHeight = height
+		rClient.logger.Info().Msgf(
+			"🔖 Resuming events of query %q from height %d.",
+			rClient.queryString,
+			height,
+		)
+		return nil
+	}
+
+	status, err := rClient.cometClient.Status(ctx)
+	if err != nil {
+		return ErrEventsHeightCursor.Wrapf("getting the latest height: %s", err)
+	}
+	rClient.setCursorHeight(status.SyncInfo.LatestBlockHeight)
+
+	return nil
+}
+
 // EventsSequence returns a new ReplayObservable, with the buffer size provided
 // during the EventsReplayClient construction, which is notified when new
 // events are received by the encapsulated EventsQueryClient.
@@ -129,43 +219,266 @@ func (rClient *replayClient[T]) LastNEvents(ctx context.Context, n int) []T {
 
 // goPublishEvents is a goroutine that listens for new events from the CometBFT
 // subscription and publishes them to the replay observable.
-func (rClient *replayClient[T]) goPublishEvents(resultEventCh <-chan coretypes.ResultEvent) {
-	go func() {
-		// The subscription is established ONCE, in NewEventsReplayClient. When this channel
-		// closes there is no reconnect: this goroutine returns & the replay observable keeps
-		// its stale buffer while receiving nothing ever again. Every consumer then blocks
-		// until its own timeout with no indication of why.
-		//
-		// That is reachable in practice: CometBFT terminates subscribers which cannot keep
-		// up (see experimental_subscription_buffer_size), and Subscribe is called without an
-		// outCapacity, so it defaults to 1.
-		//
-		// This log makes the failure identify itself instead of surfacing as an unexplained
-		// hang somewhere downstream. It is NOT a fix — reconnecting with backoff is
-		// TODO_TECHDEBT below.
-		//
-		// TODO_TECHDEBT: Re-establish the subscription (with backoff) rather than giving up.
-		// This client backs the RelayMiner's block & tx clients, so giving up means the
-		// miner silently stops observing new blocks — no session boundaries, no claim/proof
-		// windows — until the process is restarted.
-		defer func() {
-			rClient.logger.Error().Msgf(
-				"🚨 event subscription for query %q CLOSED; no further events will be observed "+
-					"on this client and it will NOT reconnect. Restart the process to recover.",
+//   - The events missed since the cursor height, e.g. while the client was not
+//     running or was reconnecting, are backfilled before the live ones.
+//   - The events emitted since the cursor height are periodically backfilled, so
+//     that the failed backfills are retried and that the cursor height of the
+//     queries whose events do not occur in every block follows the new blocks.
+//   - The subscription is re-established, with backoff, whenever it closes.
+func (rClient *replayClient[T]) goPublishEvents(
+	ctx context.Context,
+	resultEventCh <-chan coretypes.ResultEvent,
+) {
+	for {
+		rClient.backfillMissedEvents(ctx)
+
+		// Process events until the subscription closes or the context is canceled
+		if !rClient.publishLiveEvents(ctx, resultEventCh) {
+			return
+		}
+
+		// CometBFT terminates the subscribers which cannot keep up (see
+		// experimental_subscription_buffer_size), and a websocket connection may
+		// break. The events missed meanwhile are backfilled once re-subscribed.
+		rClient.logger.Warn().Msgf(
+			"⚠️ Event subscription for query %q closed at height %d. 🔄 Re-establishing it.",
+			rClient.queryString,
+			rClient.cursorHeight,
+		)
+
+		if resultEventCh = rClient.resubscribe(ctx); resultEventCh == nil {
+			return
+		}
+	}
+}
+
+// publishLiveEvents publishes the events received from the given subscription
+// until it closes. It returns false if the context was canceled meanwhile.
+func (rClient *replayClient[T]) publishLiveEvents(
+	ctx context.Context,
+	resultEventCh <-chan coretypes.ResultEvent,
+) bool {
+	backfillTicker := time.NewTicker(rClient.config.backfillInterval)
+	defer backfillTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-backfillTicker.C:
+			rClient.backfillMissedEvents(ctx)
+		case resultEvent, ok := <-resultEventCh:
+			if !ok {
+				return ctx.Err() == nil
+			}
+
+			// The events of the blocks since the cursor height were not all
+			// published, e.g. because the subscription could not keep up or a
+			// backfill failed: they are backfilled before the received one.
+			// Until they are, the received event is left to the next backfills
+			// so that the events are published in order.
+			height, _, hasHeight := getResultEventHeight(&resultEvent)
+			if hasHeight && rClient.cursorHeight > 0 && height > rClient.cursorHeight+1 &&
+				!rClient.backfillHeights(ctx, rClient.cursorHeight+1, height-1) {
+				continue
+			}
+
+			rClient.publishResultEvent(ctx, &resultEvent)
+		}
+	}
+}
+
+// resubscribe re-establishes the subscription, retrying with an exponential
+// backoff until it succeeds. It returns nil if the context was canceled meanwhile.
+func (rClient *replayClient[T]) resubscribe(ctx context.Context) <-chan coretypes.ResultEvent {
+	retryDelay := resubscribeInitialRetryDelay
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retryDelay):
+		}
+
+		// Release the closed subscription: CometBFT clients reject the duplicate ones.
+		_ = rClient.cometClient.Unsubscribe(ctx, subscriptionReplayClient, rClient.queryString)
+
+		resultEventCh, err := rClient.cometClient.Subscribe(ctx, subscriptionReplayClient, rClient.queryString)
+		if err == nil {
+			rClient.logger.Info().Msgf("✅ Event subscription for query %q re-established.", rClient.queryString)
+			return resultEventCh
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		rClient.logger.Error().Err(err).Msgf(
+			"❌ Failed to re-establish the event subscription for query %q. 🔄 Retrying in %s.",
+			rClient.queryString,
+			retryDelay,
+		)
+		retryDelay = min(2*retryDelay, resubscribeMaxRetryDelay)
+	}
+}
+
+// backfillMissedEvents publishes the events emitted between the cursor height
+// and the latest height, if the cursor height is known.
+func (rClient *replayClient[T]) backfillMissedEvents(ctx context.Context) {
+	if rClient.cursorHeight == 0 {
+		return
+	}
+
+	status, err := rClient.cometClient.Status(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			rClient.logger.Error().Err(err).Msgf(
+				"❌ Failed to get the latest height, events of query %q after height %d may have been missed.",
 				rClient.queryString,
+				rClient.cursorHeight,
 			)
-		}()
-
-		// Process events until connection breaks or context is canceled
-		for resultEvent := range resultEventCh {
-			// Attempt to decode the raw event bytes into the target type T
-			event, err := rClient.eventDecoder(&resultEvent)
-			if err != nil {
-				rClient.logger.Error().Err(err).Msgf("❌ Event decoding failed! 🔄 Skipping and moving to the next event.")
-				continue
+		}
+		return
+	}
+
+	latestHeight := status.SyncInfo.LatestBlockHeight
+	if latestHeight <= rClient.cursorHeight {
+		return
+	}
+
+	rClient.backfillHeights(ctx, rClient.cursorHeight+1, latestHeight)
+}
+
+// backfillHeights publishes the events of the given heights range which were
+// not published yet, fetching them through block_results queries, and advances
+// the cursor height up to the last height whose events were all published.
+//   - Only the first maxBackfillBlocks heights of larger ranges are backfilled,
+//     the next ones are deferred to the next backfills.
+//   - A failed height is not skipped: it is retried by the next backfills.
+//
+// It returns true if the events of the whole range were published.
+func (rClient *replayClient[T]) backfillHeights(ctx context.Context, fromHeight, toHeight int64) bool {
+	numMissedBlocks := toHeight - fromHeight + 1
+	relayer.CaptureEventsReplayGap(rClient.queryString, numMissedBlocks)
+
+	backfillToHeight := toHeight
+	if numMissedBlocks > rClient.config.maxBackfillBlocks {
+		backfillToHeight = fromHeight + rClient.config.maxBackfillBlocks - 1
+		rClient.logger.Warn().Msgf(
+			"⚠️ %d blocks missed by the event subscription for query %q, above the %d backfilled at once. "+
+				"Events of heights %d to %d are deferred to the next backfills.",
+			numMissedBlocks,
+			rClient.queryString,
+			rClient.config.maxBackfillBlocks,
+			backfillToHeight+1,
+			toHeight,
+		)
+		relayer.CaptureEventsReplayDeferredBlocks(rClient.queryString, toHeight-backfillToHeight)
+	}
+
+	rClient.logger.Info().Msgf(
+		"🔄 Backfilling events of query %q from height %d to %d.",
+		rClient.queryString,
+		fromHeight,
+		backfillToHeight,
+	)
+
+	startTime := time.Now()
+	defer relayer.CaptureEventsReplayBackfillDuration(rClient.queryString, startTime)
+
+	for height := fromHeight; height <= backfillToHeight; height++ {
+		resultEvents, err := getBlockResultEvents(ctx, rClient.cometClient, rClient.eventsQuery, height)
+		if err != nil {
+			if ctx.Err() != nil {
+				return false
 			}
 
-			rClient.replayEventTypeObsCh <- event
+			rClient.logger.Error().Err(err).Msgf(
+				"❌ Failed to backfill events of query %q, events of heights %d to %d are deferred to the next backfills.",
+				rClient.queryString,
+				height,
+				toHeight,
+			)
+			relayer.CaptureEventsReplayDeferredBlocks(rClient.queryString, toHeight-height+1)
+			return false
+		}
+
+		for i := range resultEvents {
+			resultEvents[i].Query = rClient.queryString
+			rClient.publishResultEvent(ctx, &resultEvents[i])
+		}
+		if ctx.Err() != nil {
+			return false
 		}
-	}()
+
+		// Every event of the height was published.
+		rClient.setCursorHeight(height)
+	}
+
+	return backfillToHeight == toHeight
+}
+
+// publishResultEvent decodes and publishes the given event, unless it was
+// already published, and advances the cursor height accordingly.
+func (rClient *replayClient[T]) publishResultEvent(ctx context.Context, resultEvent *coretypes.ResultEvent) {
+	height, isHeightComplete, hasHeight := getResultEventHeight(resultEvent)
+	if hasHeight && height <= rClient.cursorHeight {
+		// Already published, e.g. backfilled.
+		return
+	}
+
+	txHash, isTx := getResultEventTxHash(resultEvent)
+	if isTx {
+		if _, isPublished := rClient.txHashHeights[txHash]; isPublished {
+			return
+		}
+		rClient.txHashHeights[txHash] = height
+	}
+
+	// Attempt to decode the raw event bytes into the target type T
+	event, err := rClient.eventDecoder(resultEvent)
+	if err != nil {
+		rClient.logger.Error().Err(err).Msgf("❌ Event decoding failed! 🔄 Skipping and moving to the next event.")
+	} else {
+		select {
+		case rClient.replayEventTypeObsCh <- event:
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	if !hasHeight {
+		return
+	}
+
+	// Other transactions of the same block may still be received.
+	if !isHeightComplete {
+		height--
+	}
+	rClient.setCursorHeight(height)
+}
+
+// setCursorHeight advances the height up to which every event was published,
+// persisting it if a height cursor store is configured.
+func (rClient *replayClient[T]) setCursorHeight(height int64) {
+	if height <= rClient.cursorHeight {
+		return
+	}
+
+	rClient.cursorHeight = height
+	for txHash, txHeight := range rClient.txHashHeights {
+		if txHeight <= height {
+			delete(rClient.txHashHeights, txHash)
+		}
+	}
+
+	if rClient.config.heightCursorStore == nil {
+		return
+	}
+
+	if err := rClient.config.heightCursorStore.SetHeight(rClient.queryString, height); err != nil {
+		rClient.logger.Error().Err(err).Msgf(
+			"❌ Failed to persist the height cursor %d of query %q.",
+			height,
+			rClient.queryString,
+		)
+	}
 }
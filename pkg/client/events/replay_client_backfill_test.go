@@ -0,0 +1,353 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+)
+
+const (
+	testBlocksQuery = "tm.event='NewBlockHeader'"
+	testTxsQuery    = "tm.event='Tx' AND message.sender='pokt1sender'"
+
+	// backfillTestTimeout accounts for the delay before re-subscribing.
+	backfillTestTimeout = 5 * time.Second
+)
+
+func TestReplayClient_BackfillsFromHeightCursor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	store, err := events.NewFileHeightCursorStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.SetHeight(testBlocksQuery, 10))
+
+	liveEventCh := make(chan coretypes.ResultEvent, 10)
+	cometClient := newBackfillTestCometClient(t, 12, nil, nil)
+	cometClient.EXPECT().
+		Subscribe(gomock.Any(), gomock.Any(), testBlocksQuery).
+		Return(liveEventCh, nil).
+		Times(1)
+
+	publishedEvents := newBackfillTestReplayClient(t, ctx, cometClient, testBlocksQuery, events.WithHeightCursorStore(store))
+
+	// The live block 12 was already backfilled.
+	liveEventCh <- newHeaderResultEvent(12)
+	liveEventCh <- newHeaderResultEvent(13)
+
+	publishedEvents.requireEvents(t, "block:11", "block:12", "block:13")
+	require.Eventually(t, func() bool {
+		height, _, _ := store.GetHeight(testBlocksQuery)
+		return height == 13
+	}, backfillTestTimeout, 10*time.Millisecond)
+}
+
+func TestReplayClient_ResubscribesAndBackfillsMissedBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	firstLiveEventCh := make(chan coretypes.ResultEvent, 10)
+	secondLiveEventCh := make(chan coretypes.ResultEvent, 10)
+
+	cometClient := newBackfillTestCometClient(t, 7, nil, nil)
+	gomock.InOrder(
+		cometClient.EXPECT().
+			Subscribe(gomock.Any(), gomock.Any(), testBlocksQuery).
+			Return(firstLiveEventCh, nil),
+		cometClient.EXPECT().
+			Unsubscribe(gomock.Any(), gomock.Any(), testBlocksQuery).
+			Return(nil),
+		cometClient.EXPECT().
+			Subscribe(gomock.Any(), gomock.Any(), testBlocksQuery).
+			Return(secondLiveEventCh, nil),
+	)
+
+	publishedEvents := newBackfillTestReplayClient(t, ctx, cometClient, testBlocksQuery)
+
+	// The subscription closes after block 5: blocks 6 and 7 are missed.
+	firstLiveEventCh <- newHeaderResultEvent(5)
+	close(firstLiveEventCh)
+	secondLiveEventCh <- newHeaderResultEvent(8)
+
+	publishedEvents.requireEvents(t, "block:5", "block:6", "block:7", "block:8")
+}
+
+func TestReplayClient_BackfillsMatchingTxs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	store, err := events.NewFileHeightCursorStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.SetHeight(testTxsQuery, 10))
+
+	// Only the second transaction of block 11 matches the query.
+	blockTxs := map[int64][]*abci.ExecTxResult{
+		11: {
+			newTxResult("pokt1other"),
+			newTxResult("pokt1sender"),
+		},
+	}
+
+	liveEventCh := make(chan coretypes.ResultEvent, 10)
+	cometClient := newBackfillTestCometClient(t, 11, blockTxs, nil)
+	cometClient.EXPECT().
+		Subscribe(gomock.Any(), gomock.Any(), testTxsQuery).
+		Return(liveEventCh, nil).
+		Times(1)
+
+	publishedEvents := newBackfillTestReplayClient(t, ctx, cometClient, testTxsQuery, events.WithHeightCursorStore(store))
+
+	// The live transaction of block 11 was already backfilled.
+	liveEventCh <- newTxResultEvent(11, 1, blockTxs[11][1])
+	liveEventCh <- newTxResultEvent(12, 0, newTxResult("pokt1sender"))
+
+	publishedEvents.requireEvents(t, "tx:11/1", "tx:12/0")
+}
+
+func TestReplayClient_RetriesFailedBackfillFromHeightCursor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	store, err := events.NewFileHeightCursorStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.SetHeight(testBlocksQuery, 10))
+
+	// Block 12 fails to be fetched until the node recovers.
+	var isNodeRecovered atomic.Bool
+	getBlockErr := func(height int64) error {
+		if height == 12 && !isNodeRecovered.Load() {
+			return errors.New("node unavailable")
+		}
+		return nil
+	}
+
+	liveEventCh := make(chan coretypes.ResultEvent, 10)
+	cometClient := newBackfillTestCometClient(t, 13, nil, getBlockErr)
+	cometClient.EXPECT().
+		Subscribe(gomock.Any(), gomock.Any(), testBlocksQuery).
+		Return(liveEventCh, nil).
+		Times(1)
+
+	publishedEvents := newBackfillTestReplayClient(
+		t, ctx, cometClient, testBlocksQuery,
+		events.WithHeightCursorStore(store),
+		events.WithBackfillInterval(100*time.Millisecond),
+	)
+
+	// The backfill stops at the failed block 12: the cursor does not move past it,
+	// and the live block 14 is left to the next backfills.
+	liveEventCh <- newHeaderResultEvent(14)
+	publishedEvents.requireEvents(t, "block:11")
+	require.Eventually(t, func() bool {
+		height, _, _ := store.GetHeight(testBlocksQuery)
+		return height == 11
+	}, backfillTestTimeout, 10*time.Millisecond)
+
+	// Once the node recovers, the backfill is retried from the failed block 12.
+	isNodeRecovered.Store(true)
+	liveEventCh <- newHeaderResultEvent(15)
+
+	publishedEvents.requireEvents(t, "block:11", "block:12", "block:13", "block:14", "block:15")
+	require.Eventually(t, func() bool {
+		height, _, _ := store.GetHeight(testBlocksQuery)
+		return height == 15
+	}, backfillTestTimeout, 10*time.Millisecond)
+}
+
+func TestReplayClient_DefersBlocksAboveMaxBackfillBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	store, err := events.NewFileHeightCursorStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.SetHeight(testBlocksQuery, 10))
+
+	liveEventCh := make(chan coretypes.ResultEvent, 10)
+	cometClient := newBackfillTestCometClient(t, 14, nil, nil)
+	cometClient.EXPECT().
+		Subscribe(gomock.Any(), gomock.Any(), testBlocksQuery).
+		Return(liveEventCh, nil).
+		Times(1)
+
+	publishedEvents := newBackfillTestReplayClient(
+		t, ctx, cometClient, testBlocksQuery,
+		events.WithHeightCursorStore(store),
+		events.WithMaxBackfillBlocks(2),
+		events.WithBackfillInterval(100*time.Millisecond),
+	)
+
+	// The missed blocks above the first 2 ones are not skipped, but deferred to
+	// the next backfills.
+	publishedEvents.requireEvents(t, "block:11", "block:12", "block:13", "block:14")
+	require.Eventually(t, func() bool {
+		height, _, _ := store.GetHeight(testBlocksQuery)
+		return height == 14
+	}, backfillTestTimeout, 10*time.Millisecond)
+}
+
+// newBackfillTestReplayClient creates a replay client whose published events
+// are recorded, described by their block or transaction.
+func newBackfillTestReplayClient(
+	t *testing.T,
+	ctx context.Context,
+	cometClient *mockclient.MockClient,
+	queryString string,
+	opts ...events.ReplayClientOption,
+) *publishedEventsRecorder {
+	t.Helper()
+
+	// The events are recorded as they are decoded, right before being published:
+	// observing them would be subject to the replay observable re-notifying the
+	// values published while subscribing.
+	recorder := &publishedEventsRecorder{}
+	deps := depinject.Supply(cometClient, polyzero.NewLogger())
+	_, err := events.NewEventsReplayClient[string](
+		ctx,
+		deps,
+		queryString,
+		recorder.describeResultEvent,
+		100,
+		opts...,
+	)
+	require.NoError(t, err)
+
+	return recorder
+}
+
+// newBackfillTestCometClient returns a comet client mock whose latest height is
+// the given one, and whose blocks hold the given transaction results.
+// The blocks for which getBlockErr, if any, returns an error fail to be fetched.
+func newBackfillTestCometClient(
+	t *testing.T,
+	latestHeight int64,
+	blockTxs map[int64][]*abci.ExecTxResult,
+	getBlockErr func(height int64) error,
+) *mockclient.MockClient {
+	t.Helper()
+
+	cometClient := mockclient.NewMockClient(gomock.NewController(t))
+	cometClient.EXPECT().
+		Status(gomock.Any()).
+		Return(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: latestHeight}}, nil).
+		AnyTimes()
+	cometClient.EXPECT().
+		Block(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+			if getBlockErr != nil {
+				if err := getBlockErr(*height); err != nil {
+					return nil, err
+				}
+			}
+
+			block := &types.Block{Header: types.Header{Height: *height}}
+			for txIndex := range blockTxs[*height] {
+				block.Data.Txs = append(block.Data.Txs, newTestTx(*height, txIndex))
+			}
+			return &coretypes.ResultBlock{Block: block}, nil
+		}).
+		AnyTimes()
+	cometClient.EXPECT().
+		BlockResults(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+			return &coretypes.ResultBlockResults{Height: *height, TxsResults: blockTxs[*height]}, nil
+		}).
+		AnyTimes()
+
+	return cometClient
+}
+
+// publishedEventsRecorder records the events decoded by a replay client.
+type publishedEventsRecorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+// describeResultEvent decodes a block header or transaction event into a
+// description of its block or transaction, and records it.
+func (recorder *publishedEventsRecorder) describeResultEvent(resultEvent *coretypes.ResultEvent) (string, error) {
+	var event string
+	switch data := resultEvent.Data.(type) {
+	case types.EventDataNewBlockHeader:
+		event = fmt.Sprintf("block:%d", data.Header.Height)
+	case types.EventDataTx:
+		event = fmt.Sprintf("tx:%s", data.Tx)
+	default:
+		return "", fmt.Errorf("unexpected event data %T", resultEvent.Data)
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.events = append(recorder.events, event)
+
+	return event, nil
+}
+
+// requireEvents requires exactly the given events to be published, in order.
+func (recorder *publishedEventsRecorder) requireEvents(t *testing.T, expectedEvents ...string) {
+	t.Helper()
+
+	getEvents := func() []string {
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+		return slices.Clone(recorder.events)
+	}
+
+	require.Eventually(t, func() bool {
+		return len(getEvents()) >= len(expectedEvents)
+	}, backfillTestTimeout, 10*time.Millisecond)
+
+	// Leave some time for unexpected events to be published.
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, expectedEvents, getEvents())
+}
+
+// newHeaderResultEvent returns the event of the block header of the given height.
+func newHeaderResultEvent(height int64) coretypes.ResultEvent {
+	return coretypes.ResultEvent{
+		Query:  testBlocksQuery,
+		Data:   types.EventDataNewBlockHeader{Header: types.Header{Height: height}},
+		Events: map[string][]string{types.EventTypeKey: {types.EventNewBlockHeader}},
+	}
+}
+
+// newTxResultEvent returns the event of the transaction of the given height and index.
+func newTxResultEvent(height int64, txIndex int, txResult *abci.ExecTxResult) coretypes.ResultEvent {
+	return coretypes.ResultEvent{
+		Query: testTxsQuery,
+		Data: types.EventDataTx{TxResult: abci.TxResult{
+			Height: height,
+			Index:  uint32(txIndex),
+			Tx:     newTestTx(height, txIndex),
+			Result: *txResult,
+		}},
+	}
+}
+
+// newTxResult returns the result of a transaction sent by the given address.
+func newTxResult(sender string) *abci.ExecTxResult {
+	return &abci.ExecTxResult{Events: []abci.Event{{
+		Type:       "message",
+		Attributes: []abci.EventAttribute{{Key: "sender", Value: sender}},
+	}}}
+}
+
+// newTestTx returns the bytes of the transaction of the given height and index.
+func newTestTx(height int64, txIndex int) types.Tx {
+	return types.Tx(fmt.Sprintf("%d/%d", height, txIndex))
+}
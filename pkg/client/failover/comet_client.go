@@ -0,0 +1,328 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+// Enforce the cometclient.Client interface is implemented by the CometClient type.
+var _ cometclient.Client = (*CometClient)(nil)
+
+// NewCometNodeClientFn creates a CometBFT RPC client connected to the given node URL.
+type NewCometNodeClientFn func(nodeURL *url.URL) (cometclient.Client, error)
+
+// CometClient is a CometBFT RPC client spreading its calls over several pocket
+// node endpoints:
+//   - Requests are sent to the active endpoint and retried on the other ones
+//     if it cannot be reached.
+//   - Endpoints are periodically health and lag checked, the active one being
+//     replaced by the first healthy one in configuration order once it fails.
+//   - Websocket subscriptions are re-established on the active endpoint after
+//     a failover and the events missed in between are backfilled, when supported
+//     by the subscription query (see subscription.backfill).
+//
+// Methods which are not overridden are served by the client of the first endpoint.
+// The endpoints' clients are only used for HTTP requests and are never started.
+type CometClient struct {
+	cometclient.Client
+
+	logger        polylog.Logger
+	pool          *nodePool[cometclient.Client]
+	newNodeClient NewCometNodeClientFn
+
+	mu sync.Mutex
+	// isRunning is true between Start and Stop.
+	isRunning bool
+	// cometLogger is the logger given to the node clients, including the ones
+	// created by the subscriptions.
+	cometLogger cometlog.Logger
+	// subscriptions are the active websocket subscriptions, by subscriber and query.
+	subscriptions map[subscriptionKey]*subscription
+}
+
+// NewCometClient creates a CometClient for the given node URLs, in order of
+// preference, and health checks them until the context is done.
+// As for the CometBFT HTTP client, it MUST be started before subscribing to events.
+func NewCometClient(
+	ctx context.Context,
+	logger polylog.Logger,
+	role NodeRole,
+	config HealthCheckConfig,
+	nodeURLs []*url.URL,
+) (*CometClient, error) {
+	return newCometClient(ctx, logger, role, config, nodeURLs, newCometNodeClient)
+}
+
+// newCometClient creates a CometClient whose node clients are created by newNodeClient.
+func newCometClient(
+	ctx context.Context,
+	logger polylog.Logger,
+	role NodeRole,
+	config HealthCheckConfig,
+	nodeURLs []*url.URL,
+	newNodeClient NewCometNodeClientFn,
+) (*CometClient, error) {
+	pool, err := newNodePool(logger, role, config, nodeURLs, newNodeClient)
+	if err != nil {
+		return nil, err
+	}
+
+	cometClient := &CometClient{
+		Client:        pool.nodes[0].client,
+		logger:        logger.With("component", "failover_comet_client"),
+		pool:          pool,
+		newNodeClient: newNodeClient,
+		subscriptions: make(map[subscriptionKey]*subscription),
+	}
+	pool.startHealthChecks(ctx, cometClient.probeNode)
+
+	return cometClient, nil
+}
+
+// newCometNodeClient creates the CometBFT HTTP client of the given node URL.
+func newCometNodeClient(nodeURL *url.URL) (cometclient.Client, error) {
+	nodeClient, err := sdkclient.NewClientFromNode(nodeURL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeClient, nil
+}
+
+// Start allows subscribing to events.
+// The endpoints' websocket connections are established per subscription, so
+// that re-establishing a stalled one does not affect the other subscriptions.
+func (c *CometClient) Start() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.isRunning = true
+	return nil
+}
+
+// Stop closes the subscriptions along with their websocket connections.
+func (c *CometClient) Stop() error {
+	c.mu.Lock()
+	c.isRunning = false
+	subscriptions := c.subscriptions
+	c.subscriptions = make(map[subscriptionKey]*subscription)
+	c.mu.Unlock()
+
+	for _, sub := range subscriptions {
+		sub.close()
+	}
+
+	return nil
+}
+
+// IsRunning returns true between Start and Stop.
+func (c *CometClient) IsRunning() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.isRunning
+}
+
+// SetLogger sets the CometBFT logger of every endpoint client.
+func (c *CometClient) SetLogger(logger cometlog.Logger) {
+	c.mu.Lock()
+	c.cometLogger = logger
+	c.mu.Unlock()
+
+	for _, node := range c.pool.nodes {
+		c.pool.nodeClient(node).SetLogger(logger)
+	}
+}
+
+func (c *CometClient) ABCIInfo(ctx context.Context) (*coretypes.ResultABCIInfo, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultABCIInfo, error) {
+		return nodeClient.ABCIInfo(ctx)
+	})
+}
+
+func (c *CometClient) ABCIQuery(
+	ctx context.Context,
+	path string,
+	data bytes.HexBytes,
+) (*coretypes.ResultABCIQuery, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultABCIQuery, error) {
+		return nodeClient.ABCIQuery(ctx, path, data)
+	})
+}
+
+func (c *CometClient) ABCIQueryWithOptions(
+	ctx context.Context,
+	path string,
+	data bytes.HexBytes,
+	opts cometclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultABCIQuery, error) {
+		return nodeClient.ABCIQueryWithOptions(ctx, path, data, opts)
+	})
+}
+
+// BroadcastTxCommit broadcasts the transaction, retrying on the other endpoints
+// if the active one cannot be reached.
+// Broadcasting a transaction which already reached a mempool is rejected by the
+// nodes, so retrying cannot lead to the transaction being included twice.
+func (c *CometClient) BroadcastTxCommit(ctx context.Context, tx types.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultBroadcastTxCommit, error) {
+		return nodeClient.BroadcastTxCommit(ctx, tx)
+	})
+}
+
+// BroadcastTxAsync broadcasts the transaction, retrying on the other endpoints
+// if the active one cannot be reached.
+func (c *CometClient) BroadcastTxAsync(ctx context.Context, tx types.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultBroadcastTx, error) {
+		return nodeClient.BroadcastTxAsync(ctx, tx)
+	})
+}
+
+// BroadcastTxSync broadcasts the transaction, retrying on the other endpoints
+// if the active one cannot be reached.
+func (c *CometClient) BroadcastTxSync(ctx context.Context, tx types.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultBroadcastTx, error) {
+		return nodeClient.BroadcastTxSync(ctx, tx)
+	})
+}
+
+func (c *CometClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultStatus, error) {
+		return nodeClient.Status(ctx)
+	})
+}
+
+func (c *CometClient) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultBlock, error) {
+		return nodeClient.Block(ctx, height)
+	})
+}
+
+func (c *CometClient) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultBlockResults, error) {
+		return nodeClient.BlockResults(ctx, height)
+	})
+}
+
+func (c *CometClient) Header(ctx context.Context, height *int64) (*coretypes.ResultHeader, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultHeader, error) {
+		return nodeClient.Header(ctx, height)
+	})
+}
+
+func (c *CometClient) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultTx, error) {
+		return nodeClient.Tx(ctx, hash, prove)
+	})
+}
+
+func (c *CometClient) TxSearch(
+	ctx context.Context,
+	query string,
+	prove bool,
+	page, perPage *int,
+	orderBy string,
+) (*coretypes.ResultTxSearch, error) {
+	return callWithFailover(ctx, c.pool, func(nodeClient cometclient.Client) (*coretypes.ResultTxSearch, error) {
+		return nodeClient.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	})
+}
+
+// probeNode checks that the given node is reachable and synced, returning its
+// latest block height.
+func (c *CometClient) probeNode(ctx context.Context, node *poolNode[cometclient.Client]) (int64, error) {
+	status, err := c.pool.nodeClient(node).Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if status.SyncInfo.CatchingUp {
+		return 0, ErrFailoverNodeCatchingUp.Wrapf("height %d", status.SyncInfo.LatestBlockHeight)
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// createNodeClient creates a new client for the given node, using the CometBFT
+// logger set on the CometClient, if any.
+// It is used by subscriptions to get a websocket connection of their own.
+func (c *CometClient) createNodeClient(node *poolNode[cometclient.Client]) (cometclient.Client, error) {
+	nodeClient, err := c.newNodeClient(node.url)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	cometLogger := c.cometLogger
+	c.mu.Unlock()
+
+	if cometLogger != nil {
+		nodeClient.SetLogger(cometLogger)
+	}
+
+	return nodeClient, nil
+}
+
+// callWithFailover calls fn with the client of each candidate node of the pool,
+// in order, until one of them can be reached.
+// Errors returned by a reachable node (e.g. a failed query or an invalid
+// transaction) are returned as is, without trying the other nodes.
+func callWithFailover[C, R any](
+	ctx context.Context,
+	pool *nodePool[C],
+	fn func(nodeClient C) (R, error),
+) (R, error) {
+	var (
+		zero     R
+		nodeErrs error
+	)
+
+	for _, node := range pool.candidateNodes() {
+		res, err := fn(pool.nodeClient(node))
+		if err == nil {
+			return res, nil
+		}
+
+		if !isUnreachableNodeErr(ctx, err) {
+			return zero, err
+		}
+
+		pool.reportFailure(node, err)
+		nodeErrs = errors.Join(nodeErrs, err)
+	}
+
+	return zero, ErrFailoverNodesUnavailable.Wrap(nodeErrs.Error())
+}
+
+// isUnreachableNodeErr returns true if err is a transport error, meaning that
+// the node could not be reached rather than rejecting the request.
+// Errors caused by the given context being done are not transport errors.
+func isUnreachableNodeErr(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var (
+		urlErr *url.Error
+		netErr net.Error
+	)
+
+	return errors.As(err, &urlErr) ||
+		errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,369 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+)
+
+const (
+	testHeaderQuery = "tm.event='NewBlockHeader'"
+	testTxQuery     = "tm.event='Tx' AND message.sender='pokt1supplier'"
+	testSubscriber  = "test-subscriber"
+)
+
+func TestCometClient_RetriesUnreachableNodes(t *testing.T) {
+	cometClient, nodes := newTestCometClient(t, 2)
+	ctx := context.Background()
+
+	nodes[0].setStatusErr(&url.Error{Op: "Post", URL: "http://node-0:26657", Err: errors.New("connection refused")})
+	nodes[1].setHeight(42)
+
+	status, err := cometClient.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), status.SyncInfo.LatestBlockHeight)
+	require.Equal(t, cometClient.pool.nodes[1], cometClient.pool.activeNode())
+
+	// Errors returned by a reachable node are not retried on the other ones.
+	nodes[1].setStatusErr(errors.New("internal error"))
+	_, err = cometClient.Status(ctx)
+	require.EqualError(t, err, "internal error")
+	require.Equal(t, cometClient.pool.nodes[1], cometClient.pool.activeNode())
+}
+
+func TestCometClient_HeadersSubscription_BackfillsOnFailover(t *testing.T) {
+	cometClient, nodes := newTestCometClient(t, 2)
+	nodes[0].setHeight(10)
+	nodes[1].setHeight(10)
+
+	eventsCh, err := cometClient.Subscribe(context.Background(), testSubscriber, testHeaderQuery)
+	require.NoError(t, err)
+
+	nodes[0].emit(t, newTestHeaderEvent(11))
+	requireHeaderEvents(t, eventsCh, 11)
+
+	// The first node becomes unreachable while the second one keeps producing blocks.
+	nodes[0].setStatusErr(&url.Error{Op: "Post", URL: "http://node-0:26657", Err: errors.New("connection refused")})
+	nodes[1].setHeight(14)
+	require.NoError(t, cometClient.pool.checkNodesHealth(context.Background(), cometClient.probeNode))
+
+	// The headers emitted while failing over are backfilled from the second node.
+	requireHeaderEvents(t, eventsCh, 12, 13, 14)
+	require.Eventually(t, nodes[1].isSubscribed, time.Second, 10*time.Millisecond)
+	require.False(t, nodes[0].isSubscribed())
+
+	// Headers already forwarded are skipped.
+	nodes[1].emit(t, newTestHeaderEvent(14))
+	nodes[1].emit(t, newTestHeaderEvent(15))
+	requireHeaderEvents(t, eventsCh, 15)
+
+	require.NoError(t, cometClient.Unsubscribe(context.Background(), testSubscriber, testHeaderQuery))
+	_, isOpen := <-eventsCh
+	require.False(t, isOpen)
+	require.False(t, nodes[1].isSubscribed())
+}
+
+func TestCometClient_HeadersSubscription_BackfillsDroppedHeaders(t *testing.T) {
+	cometClient, nodes := newTestCometClient(t, 1)
+	nodes[0].setHeight(10)
+
+	eventsCh, err := cometClient.Subscribe(context.Background(), testSubscriber, testHeaderQuery)
+	require.NoError(t, err)
+
+	nodes[0].emit(t, newTestHeaderEvent(11))
+	nodes[0].emit(t, newTestHeaderEvent(14))
+	requireHeaderEvents(t, eventsCh, 11, 12, 13, 14)
+
+	require.NoError(t, cometClient.Stop())
+	_, isOpen := <-eventsCh
+	require.False(t, isOpen)
+}
+
+func TestCometClient_TxsSubscription_BackfillsOnFailover(t *testing.T) {
+	cometClient, nodes := newTestCometClient(t, 2)
+	nodes[0].setHeight(10)
+	nodes[1].setHeight(10)
+
+	eventsCh, err := cometClient.Subscribe(context.Background(), testSubscriber, testTxQuery)
+	require.NoError(t, err)
+
+	nodes[0].emit(t, newTestTxEvent(11, "tx-a"))
+	requireTxEvents(t, eventsCh, "tx-a")
+
+	// The second node indexed a transaction of the same block, which was not
+	// received before failing over, and one of the next block.
+	nodes[1].setHeight(12)
+	nodes[1].setTxs(
+		newTestTxResult(11, "tx-a"),
+		newTestTxResult(11, "tx-b"),
+		newTestTxResult(12, "tx-c"),
+	)
+	cometClient.pool.reportFailure(cometClient.pool.nodes[0], errors.New("connection refused"))
+
+	requireTxEvents(t, eventsCh, "tx-b", "tx-c")
+	require.Equal(t,
+		"tx.height>=11 AND tx.height<=12 AND message.sender='pokt1supplier'",
+		nodes[1].getTxSearchQuery(),
+	)
+
+	// Transactions already forwarded are skipped.
+	require.Eventually(t, nodes[1].isSubscribed, time.Second, 10*time.Millisecond)
+	nodes[1].emit(t, newTestTxEvent(12, "tx-c"))
+	nodes[1].emit(t, newTestTxEvent(13, "tx-d"))
+	requireTxEvents(t, eventsCh, "tx-d")
+}
+
+func TestCometClient_SubscribeRequiresRunningClient(t *testing.T) {
+	cometClient, _ := newTestCometClient(t, 1)
+	require.NoError(t, cometClient.Stop())
+
+	_, err := cometClient.Subscribe(context.Background(), testSubscriber, testHeaderQuery)
+	require.ErrorIs(t, err, ErrFailoverSubscription)
+}
+
+func TestGetSubscriptionKind(t *testing.T) {
+	require.Equal(t, subscriptionKindNewBlockHeader, getSubscriptionKind("tm.event = 'NewBlockHeader'"))
+	require.Equal(t, subscriptionKindTx, getSubscriptionKind(testTxQuery))
+	require.Equal(t, subscriptionKindOther, getSubscriptionKind("tm.event='NewBlock'"))
+}
+
+// testCometNode is a CometBFT client of a test node, whose height, transactions
+// and events are controlled by the test.
+type testCometNode struct {
+	cometclient.Client
+
+	mu            sync.Mutex
+	height        int64
+	statusErr     error
+	txs           []*coretypes.ResultTx
+	txSearchQuery string
+	isRunning     bool
+	eventsCh      chan coretypes.ResultEvent
+}
+
+func (node *testCometNode) Start() error {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.isRunning = true
+	return nil
+}
+
+func (node *testCometNode) Stop() error {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.isRunning = false
+	node.eventsCh = nil
+	return nil
+}
+
+func (node *testCometNode) IsRunning() bool {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	return node.isRunning
+}
+
+func (node *testCometNode) SetLogger(cometlog.Logger) {}
+
+func (node *testCometNode) Status(context.Context) (*coretypes.ResultStatus, error) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	if node.statusErr != nil {
+		return nil, node.statusErr
+	}
+
+	return &coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: node.height},
+	}, nil
+}
+
+func (node *testCometNode) Header(_ context.Context, height *int64) (*coretypes.ResultHeader, error) {
+	return &coretypes.ResultHeader{Header: &types.Header{Height: *height}}, nil
+}
+
+func (node *testCometNode) TxSearch(
+	_ context.Context,
+	query string,
+	_ bool,
+	_, _ *int,
+	_ string,
+) (*coretypes.ResultTxSearch, error) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.txSearchQuery = query
+	return &coretypes.ResultTxSearch{Txs: node.txs, TotalCount: len(node.txs)}, nil
+}
+
+func (node *testCometNode) Subscribe(
+	context.Context,
+	string, string,
+	...int,
+) (<-chan coretypes.ResultEvent, error) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.eventsCh = make(chan coretypes.ResultEvent, subscriptionBufferSize)
+	return node.eventsCh, nil
+}
+
+func (node *testCometNode) Unsubscribe(context.Context, string, string) error {
+	return nil
+}
+
+func (node *testCometNode) setHeight(height int64) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.height = height
+}
+
+func (node *testCometNode) setStatusErr(err error) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.statusErr = err
+}
+
+func (node *testCometNode) setTxs(txs ...*coretypes.ResultTx) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.txs = txs
+}
+
+func (node *testCometNode) getTxSearchQuery() string {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	return node.txSearchQuery
+}
+
+func (node *testCometNode) isSubscribed() bool {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	return node.eventsCh != nil
+}
+
+// emit sends the event to the subscription established on the node.
+func (node *testCometNode) emit(t *testing.T, event coretypes.ResultEvent) {
+	t.Helper()
+
+	node.mu.Lock()
+	eventsCh := node.eventsCh
+	node.mu.Unlock()
+
+	require.NotNil(t, eventsCh, "no subscription established on the node")
+	eventsCh <- event
+}
+
+// newTestCometClient creates a started CometClient over numNodes test nodes.
+func newTestCometClient(t *testing.T, numNodes int) (*CometClient, []*testCometNode) {
+	t.Helper()
+
+	nodes := make([]*testCometNode, numNodes)
+	nodeURLs := make([]*url.URL, numNodes)
+	for i := range nodes {
+		nodes[i] = &testCometNode{}
+		nodeURLs[i] = &url.URL{Scheme: "http", Host: fmt.Sprintf("node-%d:26657", i)}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	cometClient, err := newCometClient(
+		ctx,
+		polyzero.NewLogger(),
+		NodeRoleQueryRPC,
+		HealthCheckConfig{Interval: time.Hour, MaxBlockLag: 3},
+		nodeURLs,
+		func(nodeURL *url.URL) (cometclient.Client, error) {
+			index, err := newTestNodeClient(nodeURL)
+			if err != nil {
+				return nil, err
+			}
+			return nodes[index], nil
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, cometClient.Start())
+	t.Cleanup(func() { _ = cometClient.Stop() })
+
+	return cometClient, nodes
+}
+
+func newTestHeaderEvent(height int64) coretypes.ResultEvent {
+	return coretypes.ResultEvent{
+		Query: testHeaderQuery,
+		Data:  types.EventDataNewBlockHeader{Header: types.Header{Height: height}},
+	}
+}
+
+func newTestTxEvent(height int64, tx string) coretypes.ResultEvent {
+	return coretypes.ResultEvent{
+		Query: testTxQuery,
+		Data: types.EventDataTx{TxResult: abci.TxResult{
+			Height: height,
+			Tx:     []byte(tx),
+		}},
+	}
+}
+
+func newTestTxResult(height int64, tx string) *coretypes.ResultTx {
+	return &coretypes.ResultTx{
+		Hash:   types.Tx(tx).Hash(),
+		Height: height,
+		Tx:     types.Tx(tx),
+	}
+}
+
+// requireHeaderEvents asserts that the next events are the block headers of
+// the given heights.
+func requireHeaderEvents(t *testing.T, eventsCh <-chan coretypes.ResultEvent, heights ...int64) {
+	t.Helper()
+
+	for _, height := range heights {
+		event := requireEvent(t, eventsCh)
+		require.Equal(t, height, event.Data.(types.EventDataNewBlockHeader).Header.Height)
+	}
+}
+
+// requireTxEvents asserts that the next events are the given transactions.
+func requireTxEvents(t *testing.T, eventsCh <-chan coretypes.ResultEvent, txs ...string) {
+	t.Helper()
+
+	for _, tx := range txs {
+		event := requireEvent(t, eventsCh)
+		require.Equal(t, tx, string(event.Data.(types.EventDataTx).Tx))
+	}
+}
+
+func requireEvent(t *testing.T, eventsCh <-chan coretypes.ResultEvent) coretypes.ResultEvent {
+	t.Helper()
+
+	select {
+	case event, isOpen := <-eventsCh:
+		require.True(t, isOpen, "events channel closed")
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for event")
+		return coretypes.ResultEvent{}
+	}
+}
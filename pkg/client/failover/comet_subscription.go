@@ -0,0 +1,613 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cometclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// subscriptionBufferSize is the capacity of the event channels of the
+	// subscriptions established on the endpoints.
+	// The CometBFT HTTP client drops the events it cannot deliver, so it is
+	// larger than its default capacity of 1.
+	subscriptionBufferSize = 100
+
+	// backfillTxsPageSize is the number of transactions requested per page when
+	// backfilling the events of a transaction subscription.
+	backfillTxsPageSize = 100
+
+	// resubscribeRetryDelay is the delay between two attempts to re-establish a
+	// subscription when no endpoint accepts it.
+	resubscribeRetryDelay = time.Second
+)
+
+// subscriptionKind determines whether and how the events missed by a
+// subscription can be backfilled.
+type subscriptionKind int
+
+const (
+	// subscriptionKindOther subscriptions cannot be backfilled.
+	subscriptionKindOther subscriptionKind = iota
+	// subscriptionKindNewBlockHeader subscriptions are backfilled by fetching the
+	// missed block headers.
+	subscriptionKindNewBlockHeader
+	// subscriptionKindTx subscriptions are backfilled by searching the
+	// transactions matching the query in the missed blocks.
+	subscriptionKindTx
+)
+
+// subscriptionKey identifies a subscription, as for the CometBFT HTTP client.
+type subscriptionKey struct {
+	subscriber string
+	query      string
+}
+
+// subscription forwards the events of a subscription established on the active
+// endpoint, re-establishing it on failover and backfilling the missed events.
+//
+// Its fields, but the immutable ones, are only accessed by the forwarding goroutine.
+type subscription struct {
+	client *CometClient
+	key    subscriptionKey
+	kind   subscriptionKind
+
+	// outCh is the channel returned to the subscriber. It is closed once the
+	// subscription is cancelled.
+	outCh chan coretypes.ResultEvent
+	// ctx is cancelled to close the subscription.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// doneCh is closed once the forwarding goroutine returned.
+	doneCh chan struct{}
+
+	// node is the endpoint the subscription is currently established on.
+	node *poolNode[cometclient.Client]
+	// nodeClient is the client owning the websocket connection of the subscription.
+	nodeClient cometclient.Client
+	// upstreamCh receives the events of the endpoint subscription. It is nil
+	// while the subscription needs to be re-established.
+	upstreamCh <-chan coretypes.ResultEvent
+
+	// coveredHeight is the height up to which all the events were forwarded,
+	// 0 if unknown.
+	coveredHeight int64
+	// txHashHeights maps the hashes of the transaction events forwarded above
+	// coveredHeight to their height, to avoid forwarding them twice.
+	txHashHeights map[string]int64
+}
+
+// Subscribe subscribes to the events matching the query on the active endpoint.
+// The subscription is re-established on the new active endpoint on failover,
+// or if its websocket connection closes or stalls, and the events missed
+// in-between are backfilled for block header and transaction queries.
+// The returned channel is closed once unsubscribed or when the client stops.
+func (c *CometClient) Subscribe(
+	ctx context.Context,
+	subscriber, query string,
+	outCapacity ...int,
+) (<-chan coretypes.ResultEvent, error) {
+	if !c.IsRunning() {
+		return nil, ErrFailoverSubscription.Wrap("client is not running")
+	}
+
+	key := subscriptionKey{subscriber: subscriber, query: query}
+	c.mu.Lock()
+	_, isSubscribed := c.subscriptions[key]
+	c.mu.Unlock()
+	if isSubscribed {
+		return nil, ErrFailoverSubscription.Wrapf("%q is already subscribed to %q", subscriber, query)
+	}
+
+	// Mirror the CometBFT HTTP client default capacity.
+	outChCapacity := 1
+	if len(outCapacity) > 0 {
+		outChCapacity = outCapacity[0]
+	}
+
+	subCtx, cancel := context.WithCancel(context.Background())
+	sub := &subscription{
+		client:        c,
+		key:           key,
+		kind:          getSubscriptionKind(query),
+		outCh:         make(chan coretypes.ResultEvent, outChCapacity),
+		ctx:           subCtx,
+		cancel:        cancel,
+		doneCh:        make(chan struct{}),
+		txHashHeights: make(map[string]int64),
+	}
+
+	// The events are forwarded from the height the subscription is established at.
+	// The height is retrieved before subscribing so that the events emitted while
+	// subscribing are not skipped.
+	if sub.kind != subscriptionKindOther {
+		if status, err := c.Status(ctx); err == nil {
+			sub.coveredHeight = status.SyncInfo.LatestBlockHeight
+		}
+	}
+
+	if err := sub.subscribeUpstream(ctx); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if _, isSubscribed = c.subscriptions[key]; !isSubscribed {
+		c.subscriptions[key] = sub
+	}
+	c.mu.Unlock()
+	if isSubscribed {
+		sub.unsubscribeUpstream()
+		cancel()
+		return nil, ErrFailoverSubscription.Wrapf("%q is already subscribed to %q", subscriber, query)
+	}
+
+	go sub.goForwardEvents()
+
+	return sub.outCh, nil
+}
+
+// Unsubscribe closes the subscription of the subscriber to the query.
+func (c *CometClient) Unsubscribe(_ context.Context, subscriber, query string) error {
+	key := subscriptionKey{subscriber: subscriber, query: query}
+
+	c.mu.Lock()
+	sub, isSubscribed := c.subscriptions[key]
+	delete(c.subscriptions, key)
+	c.mu.Unlock()
+
+	if !isSubscribed {
+		return ErrFailoverSubscription.Wrapf("%q is not subscribed to %q", subscriber, query)
+	}
+
+	sub.close()
+	return nil
+}
+
+// UnsubscribeAll closes all the subscriptions of the subscriber.
+func (c *CometClient) UnsubscribeAll(_ context.Context, subscriber string) error {
+	var subscriptions []*subscription
+
+	c.mu.Lock()
+	for key, sub := range c.subscriptions {
+		if key.subscriber == subscriber {
+			subscriptions = append(subscriptions, sub)
+			delete(c.subscriptions, key)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, sub := range subscriptions {
+		sub.close()
+	}
+
+	return nil
+}
+
+// close cancels the subscription and waits for its forwarding goroutine to
+// unsubscribe from the endpoint and close the subscriber's channel.
+func (sub *subscription) close() {
+	sub.cancel()
+	<-sub.doneCh
+}
+
+// goForwardEvents forwards the events of the endpoint subscription to the
+// subscriber until the subscription is cancelled, re-establishing it whenever
+// the active endpoint changes or its websocket connection closes or stalls.
+// It is intended to be run in a goroutine.
+func (sub *subscription) goForwardEvents() {
+	defer close(sub.doneCh)
+	defer close(sub.outCh)
+	defer sub.unsubscribeUpstream()
+
+	logger := sub.client.logger.With("query", sub.key.query)
+
+	failoverCh, releaseFailoverCh := sub.client.pool.subscribeFailovers()
+	defer releaseFailoverCh()
+
+	stallCheckTicker := time.NewTicker(sub.client.pool.config.Interval)
+	defer stallCheckTicker.Stop()
+
+	for {
+		if sub.upstreamCh == nil && !sub.resubscribe() {
+			return
+		}
+
+		select {
+		case <-sub.ctx.Done():
+			return
+
+		case <-failoverCh:
+			if activeNode := sub.client.pool.activeNode(); activeNode != sub.node {
+				logger.Info().Msgf(
+					"🔀 Moving subscription from pocket node %q to %q",
+					sub.node.label, activeNode.label,
+				)
+				sub.unsubscribeUpstream()
+			}
+
+		case <-stallCheckTicker.C:
+			if sub.isStalled() {
+				logger.Warn().Msgf(
+					"🐢 Subscription on pocket node %q stalled at height %d, re-establishing it",
+					sub.node.label, sub.coveredHeight,
+				)
+				sub.unsubscribeUpstream()
+			}
+
+		case event, ok := <-sub.upstreamCh:
+			if !ok {
+				logger.Warn().Msgf("⚠️ Subscription closed by pocket node %q, re-establishing it", sub.node.label)
+				sub.unsubscribeUpstream()
+				continue
+			}
+
+			if !sub.forwardEvent(event) {
+				return
+			}
+		}
+	}
+}
+
+// subscribeUpstream establishes the subscription on the first candidate
+// endpoint accepting it, using a websocket connection of its own.
+func (sub *subscription) subscribeUpstream(ctx context.Context) error {
+	var nodeErrs error
+	for _, node := range sub.client.pool.candidateNodes() {
+		nodeClient, err := sub.client.createNodeClient(node)
+		if err != nil {
+			return err
+		}
+
+		if err = nodeClient.Start(); err == nil {
+			var upstreamCh <-chan coretypes.ResultEvent
+			upstreamCh, err = nodeClient.Subscribe(ctx, sub.key.subscriber, sub.key.query, subscriptionBufferSize)
+			if err == nil {
+				sub.node = node
+				sub.nodeClient = nodeClient
+				sub.upstreamCh = upstreamCh
+				return nil
+			}
+
+			_ = nodeClient.Stop()
+
+			// The endpoint was reached but rejected the subscription (e.g. an invalid query).
+			if !isUnreachableNodeErr(ctx, err) {
+				return err
+			}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		sub.client.pool.reportFailure(node, err)
+		nodeErrs = errors.Join(nodeErrs, err)
+	}
+
+	return ErrFailoverNodesUnavailable.Wrap(nodeErrs.Error())
+}
+
+// unsubscribeUpstream closes the endpoint subscription, if any, along with its
+// websocket connection.
+func (sub *subscription) unsubscribeUpstream() {
+	if sub.upstreamCh == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sub.client.pool.config.Interval)
+	defer cancel()
+
+	// The endpoint may be unreachable: unsubscribing is best effort and the
+	// connection is closed anyway.
+	_ = sub.nodeClient.Unsubscribe(ctx, sub.key.subscriber, sub.key.query)
+	_ = sub.nodeClient.Stop()
+
+	sub.nodeClient = nil
+	sub.upstreamCh = nil
+}
+
+// resubscribe re-establishes the subscription, retrying until an endpoint
+// accepts it, then backfills the events missed in-between.
+// It returns false if the subscription was cancelled meanwhile.
+func (sub *subscription) resubscribe() bool {
+	logger := sub.client.logger.With("query", sub.key.query)
+
+	for {
+		err := sub.subscribeUpstream(sub.ctx)
+		if err == nil {
+			break
+		}
+		logger.Warn().Err(err).Msgf("⚠️ Failed to re-establish subscription, retrying in %s", resubscribeRetryDelay)
+
+		select {
+		case <-sub.ctx.Done():
+			return false
+		case <-time.After(resubscribeRetryDelay):
+		}
+	}
+
+	logger.Info().Msgf("🔁 Subscription re-established on pocket node %q", sub.node.label)
+
+	if sub.kind == subscriptionKindOther {
+		logger.Warn().Msg("⚠️ Events emitted while the subscription was re-established may have been missed: only block header and transaction subscriptions are backfilled")
+		return true
+	}
+
+	return sub.backfill()
+}
+
+// isStalled returns true if the endpoint of a block header subscription is
+// more than MaxBlockLag blocks ahead of the last forwarded header, meaning that
+// its websocket connection stopped delivering events without being closed.
+// Transaction subscriptions legitimately receive no events for long periods,
+// so they are never considered stalled.
+func (sub *subscription) isStalled() bool {
+	if sub.kind != subscriptionKindNewBlockHeader || sub.upstreamCh == nil || sub.coveredHeight == 0 {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(sub.ctx, sub.client.pool.config.Interval)
+	defer cancel()
+
+	// Unreachable endpoints are detected by the health checks.
+	status, err := sub.client.pool.nodeClient(sub.node).Status(ctx)
+	if err != nil {
+		return false
+	}
+
+	return status.SyncInfo.LatestBlockHeight-sub.coveredHeight > sub.client.pool.config.MaxBlockLag
+}
+
+// forwardEvent forwards a live event to the subscriber, skipping the ones
+// already forwarded and backfilling the block headers missed right before it.
+// It returns false if the subscription was cancelled meanwhile.
+func (sub *subscription) forwardEvent(event coretypes.ResultEvent) bool {
+	switch data := event.Data.(type) {
+	case types.EventDataNewBlockHeader:
+		height := data.Header.Height
+		if sub.coveredHeight > 0 {
+			// Already forwarded, e.g. backfilled after a failover.
+			if height <= sub.coveredHeight {
+				return true
+			}
+
+			// The endpoint dropped some headers, e.g. while its websocket connection
+			// was being re-established by the CometBFT client.
+			if height > sub.coveredHeight+1 && !sub.backfillHeaders(sub.coveredHeight+1, height-1) {
+				return false
+			}
+		}
+
+		if !sub.send(event) {
+			return false
+		}
+		sub.coveredHeight = height
+
+	case types.EventDataTx:
+		height := data.Height
+		if height <= sub.coveredHeight {
+			return true
+		}
+
+		txHash := string(types.Tx(data.Tx).Hash())
+		if _, isForwarded := sub.txHashHeights[txHash]; isForwarded {
+			return true
+		}
+
+		if !sub.send(event) {
+			return false
+		}
+		sub.txHashHeights[txHash] = height
+
+		// Other transactions of the same block may still be received.
+		sub.setTxsCoveredHeight(height - 1)
+
+	default:
+		return sub.send(event)
+	}
+
+	return true
+}
+
+// backfill forwards the events emitted between the covered height and the
+// latest height of the endpoint the subscription was re-established on.
+// It returns false if the subscription was cancelled meanwhile.
+func (sub *subscription) backfill() bool {
+	if sub.coveredHeight == 0 {
+		return true
+	}
+
+	status, err := sub.client.pool.nodeClient(sub.node).Status(sub.ctx)
+	if err != nil {
+		if sub.ctx.Err() != nil {
+			return false
+		}
+		sub.client.logger.Error().Err(ErrFailoverBackfill.Wrap(err.Error())).Msgf(
+			"❌ Failed to get the latest height of pocket node %q, events of query %q may have been missed",
+			sub.node.label, sub.key.query,
+		)
+		return true
+	}
+
+	latestHeight := status.SyncInfo.LatestBlockHeight
+	if latestHeight <= sub.coveredHeight {
+		return true
+	}
+
+	switch sub.kind {
+	case subscriptionKindNewBlockHeader:
+		return sub.backfillHeaders(sub.coveredHeight+1, latestHeight)
+	case subscriptionKindTx:
+		return sub.backfillTxs(sub.coveredHeight+1, latestHeight)
+	default:
+		return true
+	}
+}
+
+// backfillHeaders forwards the block headers of the given heights range.
+// It returns false if the subscription was cancelled meanwhile.
+func (sub *subscription) backfillHeaders(fromHeight, toHeight int64) bool {
+	nodeClient := sub.client.pool.nodeClient(sub.node)
+
+	for height := fromHeight; height <= toHeight; height++ {
+		headerHeight := height
+		headerRes, err := nodeClient.Header(sub.ctx, &headerHeight)
+		if err != nil {
+			if sub.ctx.Err() != nil {
+				return false
+			}
+			sub.client.logger.Error().Err(ErrFailoverBackfill.Wrap(err.Error())).Msgf(
+				"❌ Failed to backfill block headers %d to %d from pocket node %q",
+				height, toHeight, sub.node.label,
+			)
+			return true
+		}
+
+		event := coretypes.ResultEvent{
+			Query:  sub.key.query,
+			Data:   types.EventDataNewBlockHeader{Header: *headerRes.Header},
+			Events: map[string][]string{types.EventTypeKey: {types.EventNewBlockHeader}},
+		}
+		if !sub.send(event) {
+			return false
+		}
+		sub.coveredHeight = height
+	}
+
+	return true
+}
+
+// backfillTxs forwards the transactions matching the subscription query in the
+// given heights range which were not forwarded yet.
+// It returns false if the subscription was cancelled meanwhile.
+func (sub *subscription) backfillTxs(fromHeight, toHeight int64) bool {
+	nodeClient := sub.client.pool.nodeClient(sub.node)
+	searchQuery := getTxSearchQuery(sub.key.query, fromHeight, toHeight)
+
+	for page, numTxs := 1, 0; ; page++ {
+		searchPage, perPage := page, backfillTxsPageSize
+		searchRes, err := nodeClient.TxSearch(sub.ctx, searchQuery, false, &searchPage, &perPage, "asc")
+		if err != nil {
+			if sub.ctx.Err() != nil {
+				return false
+			}
+			sub.client.logger.Error().Err(ErrFailoverBackfill.Wrap(err.Error())).Msgf(
+				"❌ Failed to backfill transactions %q of heights %d to %d from pocket node %q",
+				searchQuery, fromHeight, toHeight, sub.node.label,
+			)
+			return true
+		}
+
+		for _, txRes := range searchRes.Txs {
+			txHash := string(types.Tx(txRes.Tx).Hash())
+			if _, isForwarded := sub.txHashHeights[txHash]; isForwarded {
+				continue
+			}
+
+			event := coretypes.ResultEvent{
+				Query: sub.key.query,
+				Data: types.EventDataTx{TxResult: abci.TxResult{
+					Height: txRes.Height,
+					Index:  txRes.Index,
+					Tx:     txRes.Tx,
+					Result: txRes.TxResult,
+				}},
+				Events: map[string][]string{
+					types.EventTypeKey: {types.EventTx},
+					types.TxHashKey:    {txRes.Hash.String()},
+					types.TxHeightKey:  {strconv.FormatInt(txRes.Height, 10)},
+				},
+			}
+			if !sub.send(event) {
+				return false
+			}
+			sub.txHashHeights[txHash] = txRes.Height
+		}
+
+		numTxs += len(searchRes.Txs)
+		if len(searchRes.Txs) == 0 || numTxs >= searchRes.TotalCount {
+			break
+		}
+	}
+
+	sub.setTxsCoveredHeight(toHeight)
+	return true
+}
+
+// setTxsCoveredHeight sets the covered height of a transaction subscription,
+// forgetting the hashes of the transactions forwarded up to it.
+func (sub *subscription) setTxsCoveredHeight(height int64) {
+	if height <= sub.coveredHeight {
+		return
+	}
+
+	sub.coveredHeight = height
+	for txHash, txHeight := range sub.txHashHeights {
+		if txHeight <= height {
+			delete(sub.txHashHeights, txHash)
+		}
+	}
+}
+
+// send sends the event to the subscriber.
+// It returns false if the subscription was cancelled before it was received.
+func (sub *subscription) send(event coretypes.ResultEvent) bool {
+	select {
+	case sub.outCh <- event:
+		return true
+	case <-sub.ctx.Done():
+		return false
+	}
+}
+
+// getSubscriptionKind returns the kind of subscription of the given query,
+// based on its tm.event condition.
+func getSubscriptionKind(query string) subscriptionKind {
+	for _, condition := range getQueryConditions(query) {
+		switch condition {
+		case fmt.Sprintf("%s='%s'", types.EventTypeKey, types.EventNewBlockHeader):
+			return subscriptionKindNewBlockHeader
+		case fmt.Sprintf("%s='%s'", types.EventTypeKey, types.EventTx):
+			return subscriptionKindTx
+		}
+	}
+
+	return subscriptionKindOther
+}
+
+// getTxSearchQuery returns the transaction search query matching the events of
+// the given transaction subscription query in the given heights range.
+// The tm.event condition is dropped since it is not indexed.
+func getTxSearchQuery(query string, fromHeight, toHeight int64) string {
+	conditions := []string{
+		fmt.Sprintf("%s>=%d", types.TxHeightKey, fromHeight),
+		fmt.Sprintf("%s<=%d", types.TxHeightKey, toHeight),
+	}
+	for _, condition := range strings.Split(query, " AND ") {
+		if strings.HasPrefix(strings.TrimSpace(condition), types.EventTypeKey) {
+			continue
+		}
+		conditions = append(conditions, strings.TrimSpace(condition))
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+// getQueryConditions returns the conditions of the given query, without spaces.
+func getQueryConditions(query string) []string {
+	conditions := strings.Split(query, " AND ")
+	for i, condition := range conditions {
+		conditions[i] = strings.ReplaceAll(condition, " ", "")
+	}
+
+	return conditions
+}
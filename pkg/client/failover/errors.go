@@ -0,0 +1,13 @@
+package failover
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	codespace = "failover"
+
+	ErrFailoverNoNodes          = sdkerrors.Register(codespace, 1, "no pocket node endpoints")
+	ErrFailoverNodeCatchingUp   = sdkerrors.Register(codespace, 2, "pocket node is catching up")
+	ErrFailoverNodesUnavailable = sdkerrors.Register(codespace, 3, "no pocket node endpoint is available")
+	ErrFailoverBackfill         = sdkerrors.Register(codespace, 4, "failed to backfill missed events")
+	ErrFailoverSubscription     = sdkerrors.Register(codespace, 5, "invalid subscription")
+)
@@ -0,0 +1,7 @@
+// Package failover provides CometBFT RPC and gRPC clients spreading over several
+// pocket node endpoints of the same role. The endpoints are periodically health
+// and lag checked, and the clients automatically fail over to the next healthy
+// endpoint when the one in use stalls, lags behind or fails. Event subscriptions
+// are re-established on the new endpoint and the blocks (and transactions) missed
+// in-between are backfilled so that subscribers do not observe any gap.
+package failover
@@ -0,0 +1,136 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/gogoproto/grpc"
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+// Enforce the grpc.ClientConn interface is implemented by the GRPCConn type.
+var _ grpc.ClientConn = (*GRPCConn)(nil)
+
+// GRPCConn is a gRPC client connection spreading its calls over several pocket
+// node endpoints:
+//   - Unary calls are sent to the active endpoint and retried on the other ones
+//     if it is unavailable.
+//   - Endpoints are periodically health and lag checked, the active one being
+//     replaced by the first healthy one in configuration order once it fails.
+//
+// Streams are opened on the active endpoint and are not failed over.
+type GRPCConn struct {
+	pool *nodePool[grpc.ClientConn]
+}
+
+// NewGRPCConn creates a GRPCConn over the given connections, in order of
+// preference, and health checks them until the context is done.
+// The node URLs are only used to identify the connections in logs and metrics.
+func NewGRPCConn(
+	ctx context.Context,
+	logger polylog.Logger,
+	role NodeRole,
+	config HealthCheckConfig,
+	nodeURLs []*url.URL,
+	nodeConns []grpc.ClientConn,
+) (*GRPCConn, error) {
+	if len(nodeURLs) != len(nodeConns) {
+		return nil, ErrFailoverNoNodes.Wrapf(
+			"got %d node URLs for %d connections",
+			len(nodeURLs), len(nodeConns),
+		)
+	}
+
+	connIndex := 0
+	pool, err := newNodePool(logger, role, config, nodeURLs, func(*url.URL) (grpc.ClientConn, error) {
+		nodeConn := nodeConns[connIndex]
+		connIndex++
+		return nodeConn, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grpcConn := &GRPCConn{pool: pool}
+	pool.startHealthChecks(ctx, grpcConn.probeNode)
+
+	return grpcConn, nil
+}
+
+// Invoke performs a unary call on the active endpoint, retrying on the other
+// ones if it is unavailable.
+func (conn *GRPCConn) Invoke(
+	ctx context.Context,
+	method string,
+	args, reply any,
+	opts ...googlegrpc.CallOption,
+) error {
+	var nodeErrs error
+	for _, node := range conn.pool.candidateNodes() {
+		err := conn.pool.nodeClient(node).Invoke(ctx, method, args, reply, opts...)
+		if err == nil {
+			return nil
+		}
+
+		if !isUnavailableGRPCErr(ctx, err) {
+			return err
+		}
+
+		conn.pool.reportFailure(node, err)
+		nodeErrs = errors.Join(nodeErrs, err)
+	}
+
+	return ErrFailoverNodesUnavailable.Wrap(nodeErrs.Error())
+}
+
+// NewStream opens a stream on the active endpoint.
+func (conn *GRPCConn) NewStream(
+	ctx context.Context,
+	desc *googlegrpc.StreamDesc,
+	method string,
+	opts ...googlegrpc.CallOption,
+) (googlegrpc.ClientStream, error) {
+	return conn.pool.nodeClient(conn.pool.activeNode()).NewStream(ctx, desc, method, opts...)
+}
+
+// probeNode checks that the given node is reachable and synced, returning its
+// latest block height.
+func (conn *GRPCConn) probeNode(ctx context.Context, node *poolNode[grpc.ClientConn]) (int64, error) {
+	serviceClient := cmtservice.NewServiceClient(conn.pool.nodeClient(node))
+
+	syncingRes, err := serviceClient.GetSyncing(ctx, &cmtservice.GetSyncingRequest{})
+	if err != nil {
+		return 0, err
+	}
+	if syncingRes.GetSyncing() {
+		return 0, ErrFailoverNodeCatchingUp
+	}
+
+	latestBlockRes, err := serviceClient.GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return latestBlockRes.GetSdkBlock().GetHeader().Height, nil
+}
+
+// isUnavailableGRPCErr returns true if err means that the node could not be
+// reached rather than rejecting the call.
+// Errors caused by the given context being done are not considered.
+func isUnavailableGRPCErr(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if grpcStatus, ok := status.FromError(err); ok {
+		return grpcStatus.Code() == codes.Unavailable
+	}
+
+	return isUnreachableNodeErr(ctx, err)
+}
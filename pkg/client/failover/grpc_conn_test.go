@@ -0,0 +1,87 @@
+package failover
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/grpc"
+	"github.com/stretchr/testify/require"
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+)
+
+func TestGRPCConn_RetriesUnavailableNodes(t *testing.T) {
+	conns := []*testGRPCConn{
+		{err: status.Error(codes.Unavailable, "connection refused")},
+		{},
+	}
+	grpcConn := newTestGRPCConn(t, conns...)
+
+	require.NoError(t, grpcConn.Invoke(context.Background(), "/test.Query/Params", nil, nil))
+	require.Equal(t, 1, conns[0].numCalls)
+	require.Equal(t, 1, conns[1].numCalls)
+	require.Equal(t, grpcConn.pool.nodes[1], grpcConn.pool.activeNode())
+
+	// Errors returned by a reachable node are not retried on the other ones.
+	conns[1].err = status.Error(codes.NotFound, "not found")
+	err := grpcConn.Invoke(context.Background(), "/test.Query/Params", nil, nil)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, 1, conns[0].numCalls)
+	require.Equal(t, 2, conns[1].numCalls)
+}
+
+func TestGRPCConn_AllNodesUnavailable(t *testing.T) {
+	grpcConn := newTestGRPCConn(t,
+		&testGRPCConn{err: status.Error(codes.Unavailable, "connection refused")},
+		&testGRPCConn{err: status.Error(codes.Unavailable, "connection refused")},
+	)
+
+	err := grpcConn.Invoke(context.Background(), "/test.Query/Params", nil, nil)
+	require.ErrorIs(t, err, ErrFailoverNodesUnavailable)
+}
+
+// testGRPCConn is a gRPC connection failing its calls with the configured error.
+type testGRPCConn struct {
+	grpc.ClientConn
+
+	err      error
+	numCalls int
+}
+
+func (conn *testGRPCConn) Invoke(context.Context, string, any, any, ...googlegrpc.CallOption) error {
+	conn.numCalls++
+	return conn.err
+}
+
+// newTestGRPCConn creates a GRPCConn over the given test connections.
+func newTestGRPCConn(t *testing.T, conns ...*testGRPCConn) *GRPCConn {
+	t.Helper()
+
+	nodeURLs := make([]*url.URL, len(conns))
+	nodeConns := make([]grpc.ClientConn, len(conns))
+	for i, conn := range conns {
+		nodeURLs[i] = &url.URL{Scheme: "tcp", Host: fmt.Sprintf("node-%d:9090", i)}
+		nodeConns[i] = conn
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	grpcConn, err := NewGRPCConn(
+		ctx,
+		polyzero.NewLogger(),
+		NodeRoleQueryGRPC,
+		HealthCheckConfig{Interval: time.Hour},
+		nodeURLs,
+		nodeConns,
+	)
+	require.NoError(t, err)
+
+	return grpcConn
+}
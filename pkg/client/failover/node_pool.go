@@ -0,0 +1,349 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+)
+
+const (
+	// DefaultHealthCheckInterval is the default period at which the pocket node
+	// endpoints are health and lag checked.
+	DefaultHealthCheckInterval = 10 * time.Second
+
+	// DefaultMaxBlockLag is the default number of blocks a pocket node endpoint
+	// may lag behind the most advanced one before it is considered unhealthy.
+	DefaultMaxBlockLag = 3
+)
+
+// NodeRole identifies the purpose of a set of pocket node endpoints in logs and metrics.
+type NodeRole string
+
+const (
+	NodeRoleQueryRPC  NodeRole = "query_rpc"
+	NodeRoleQueryGRPC NodeRole = "query_grpc"
+	NodeRoleTxRPC     NodeRole = "tx_rpc"
+)
+
+// HealthCheckConfig holds the thresholds the pocket node endpoints are checked against.
+// Zero fields fall back to their defaults.
+type HealthCheckConfig struct {
+	// Interval is the period at which the endpoints are health and lag checked.
+	Interval time.Duration
+	// MaxBlockLag is the number of blocks an endpoint may lag behind the most
+	// advanced endpoint before it is considered unhealthy.
+	MaxBlockLag int64
+}
+
+// withDefaults returns the health check config with its zero fields set to their defaults.
+func (config HealthCheckConfig) withDefaults() HealthCheckConfig {
+	if config.Interval <= 0 {
+		config.Interval = DefaultHealthCheckInterval
+	}
+	if config.MaxBlockLag <= 0 {
+		config.MaxBlockLag = DefaultMaxBlockLag
+	}
+	return config
+}
+
+// nodeProbeFn checks that a pocket node endpoint is able to serve requests and
+// returns the height of its latest block.
+type nodeProbeFn[C any] func(ctx context.Context, node *poolNode[C]) (height int64, err error)
+
+// poolNode is a pocket node endpoint of a nodePool along with its health state.
+type poolNode[C any] struct {
+	url *url.URL
+
+	// label identifies the node in logs and metrics. It omits the URL path,
+	// query and credentials, which may embed secrets such as API keys.
+	label string
+
+	// The fields below are protected by the pool's mutex.
+	//
+	// client is the client connected to the node.
+	client C
+	// isHealthy is the result of the last health and lag check. Nodes are
+	// healthy until a check or a request fails.
+	isHealthy bool
+	// height is the latest block height reported by the last successful check.
+	height int64
+}
+
+// nodePool tracks the health of the pocket node endpoints of a given role and
+// selects the one requests are sent to.
+//
+// The selection is sticky: the active node is kept as long as it is healthy,
+// even if a node with a higher priority recovered, to avoid needlessly
+// re-establishing subscriptions. Once it is unhealthy, the first healthy node
+// in configuration order becomes active.
+// If no node is healthy, the active one is used anyway rather than failing
+// requests without trying.
+type nodePool[C any] struct {
+	logger polylog.Logger
+	role   NodeRole
+	config HealthCheckConfig
+	nodes  []*poolNode[C]
+
+	mu sync.Mutex
+	// activeIndex is the index of the node requests are sent to.
+	activeIndex int
+	// failoverObservers are notified each time the active node changes.
+	failoverObservers map[chan struct{}]struct{}
+}
+
+// newNodePool creates a node pool of the given role with a node per URL,
+// using the client returned by newClient for each of them.
+func newNodePool[C any](
+	logger polylog.Logger,
+	role NodeRole,
+	config HealthCheckConfig,
+	nodeURLs []*url.URL,
+	newClient func(nodeURL *url.URL) (C, error),
+) (*nodePool[C], error) {
+	if len(nodeURLs) == 0 {
+		return nil, ErrFailoverNoNodes.Wrapf("role %s", role)
+	}
+
+	pool := &nodePool[C]{
+		logger:            logger.With("role", string(role)),
+		role:              role,
+		config:            config.withDefaults(),
+		nodes:             make([]*poolNode[C], 0, len(nodeURLs)),
+		failoverObservers: make(map[chan struct{}]struct{}),
+	}
+
+	for _, nodeURL := range nodeURLs {
+		client, err := newClient(nodeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		node := &poolNode[C]{
+			url:       nodeURL,
+			label:     nodeURL.Scheme + "://" + nodeURL.Host,
+			client:    client,
+			isHealthy: true,
+		}
+		pool.nodes = append(pool.nodes, node)
+		relayer.CapturePocketNodeHealth(string(role), node.label, true)
+	}
+
+	return pool, nil
+}
+
+// activeNode returns the node requests are currently sent to.
+func (pool *nodePool[C]) activeNode() *poolNode[C] {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	return pool.nodes[pool.activeIndex]
+}
+
+// nodeClient returns the current client of the given node.
+func (pool *nodePool[C]) nodeClient(node *poolNode[C]) C {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	return node.client
+}
+
+// setNodeClient replaces the client of the given node, e.g. after its
+// connection was re-established.
+func (pool *nodePool[C]) setNodeClient(node *poolNode[C], client C) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	node.client = client
+}
+
+// nodeHeight returns the latest block height reported by the given node during
+// its last successful check.
+func (pool *nodePool[C]) nodeHeight(node *poolNode[C]) int64 {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	return node.height
+}
+
+// candidateNodes returns the nodes to try a request on, in order: the active
+// node, the other healthy nodes and finally the unhealthy ones.
+func (pool *nodePool[C]) candidateNodes() []*poolNode[C] {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	activeNode := pool.nodes[pool.activeIndex]
+	candidates := make([]*poolNode[C], 0, len(pool.nodes))
+	candidates = append(candidates, activeNode)
+	for _, node := range pool.nodes {
+		if node != activeNode && node.isHealthy {
+			candidates = append(candidates, node)
+		}
+	}
+	for _, node := range pool.nodes {
+		if node != activeNode && !node.isHealthy {
+			candidates = append(candidates, node)
+		}
+	}
+
+	return candidates
+}
+
+// reportFailure marks the given node as unhealthy after a request to it failed,
+// failing over to another node if it was the active one.
+func (pool *nodePool[C]) reportFailure(node *poolNode[C], err error) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if node.isHealthy {
+		pool.logger.Warn().Err(err).Msgf("⚠️ Pocket node %q failed a request", node.label)
+		relayer.CapturePocketNodeHealth(string(pool.role), node.label, false)
+	}
+	node.isHealthy = false
+
+	pool.failoverIfActiveUnhealthy()
+}
+
+// subscribeFailovers returns a channel notified each time the active node
+// changes, along with a function releasing it.
+// Notifications are coalesced: a single one is pending at most.
+func (pool *nodePool[C]) subscribeFailovers() (<-chan struct{}, func()) {
+	failoverCh := make(chan struct{}, 1)
+
+	pool.mu.Lock()
+	pool.failoverObservers[failoverCh] = struct{}{}
+	pool.mu.Unlock()
+
+	return failoverCh, func() {
+		pool.mu.Lock()
+		delete(pool.failoverObservers, failoverCh)
+		pool.mu.Unlock()
+	}
+}
+
+// startHealthChecks periodically checks the health of the pool's nodes until
+// the context is done. Pools with a single node are not checked since there
+// is no other node to fail over to.
+func (pool *nodePool[C]) startHealthChecks(ctx context.Context, probe nodeProbeFn[C]) {
+	if len(pool.nodes) < 2 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(pool.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = pool.checkNodesHealth(ctx, probe)
+			}
+		}
+	}()
+}
+
+// checkNodesHealth probes every node of the pool, considers unhealthy the ones
+// failing their probe or lagging more than MaxBlockLag blocks behind the most
+// advanced one, and fails over if the active node is unhealthy.
+// It returns an error only if none of the nodes is healthy.
+func (pool *nodePool[C]) checkNodesHealth(ctx context.Context, probe nodeProbeFn[C]) error {
+	heights := make([]int64, len(pool.nodes))
+	probeErrs := make([]error, len(pool.nodes))
+
+	var wg sync.WaitGroup
+	for i, node := range pool.nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, pool.config.Interval)
+			defer cancel()
+			heights[i], probeErrs[i] = probe(probeCtx, node)
+		}()
+	}
+	wg.Wait()
+
+	maxHeight := int64(0)
+	for i := range pool.nodes {
+		if probeErrs[i] == nil {
+			maxHeight = max(maxHeight, heights[i])
+		}
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	var unhealthyErrs []error
+	for i, node := range pool.nodes {
+		err := probeErrs[i]
+		if err == nil && maxHeight-heights[i] > pool.config.MaxBlockLag {
+			err = ErrFailoverNodesUnavailable.Wrapf(
+				"lagging %d blocks behind (height %d, max height %d)",
+				maxHeight-heights[i], heights[i], maxHeight,
+			)
+		}
+
+		isHealthy := err == nil
+		if !isHealthy {
+			unhealthyErrs = append(unhealthyErrs, err)
+		}
+		if probeErrs[i] == nil {
+			node.height = heights[i]
+		}
+
+		if isHealthy != node.isHealthy {
+			if isHealthy {
+				pool.logger.Info().Msgf("✅ Pocket node %q is healthy again", node.label)
+			} else {
+				pool.logger.Warn().Err(err).Msgf("⚠️ Pocket node %q failed its health check", node.label)
+			}
+		}
+		node.isHealthy = isHealthy
+		relayer.CapturePocketNodeHealth(string(pool.role), node.label, isHealthy)
+	}
+
+	pool.failoverIfActiveUnhealthy()
+
+	if len(unhealthyErrs) == len(pool.nodes) {
+		return ErrFailoverNodesUnavailable.Wrap(errors.Join(unhealthyErrs...).Error())
+	}
+
+	return nil
+}
+
+// failoverIfActiveUnhealthy makes the first healthy node active if the active
+// one is unhealthy, and notifies the failover observers.
+// It MUST be called with the pool's mutex held.
+func (pool *nodePool[C]) failoverIfActiveUnhealthy() {
+	activeNode := pool.nodes[pool.activeIndex]
+	if activeNode.isHealthy {
+		return
+	}
+
+	for i, node := range pool.nodes {
+		if !node.isHealthy {
+			continue
+		}
+
+		pool.logger.Warn().Msgf(
+			"🔀 Failing over from pocket node %q to %q",
+			activeNode.label, node.label,
+		)
+		relayer.CapturePocketNodeFailover(string(pool.role), activeNode.label, node.label)
+		pool.activeIndex = i
+
+		for failoverCh := range pool.failoverObservers {
+			select {
+			case failoverCh <- struct{}{}:
+			default:
+			}
+		}
+		return
+	}
+}
@@ -0,0 +1,133 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+)
+
+func TestNodePool_FailsOverToFirstHealthyNode(t *testing.T) {
+	pool, probe := newTestNodePool(t, 3)
+	ctx := context.Background()
+
+	// The first node lags too far behind: the second one becomes active.
+	probe.setHeights(10, 20, 20)
+	require.NoError(t, pool.checkNodesHealth(ctx, probe.probe))
+	require.Equal(t, pool.nodes[1], pool.activeNode())
+	require.Equal(t, []*poolNode[int]{pool.nodes[1], pool.nodes[2], pool.nodes[0]}, pool.candidateNodes())
+
+	// The first node caught up: the selection is sticky.
+	probe.setHeights(20, 20, 20)
+	require.NoError(t, pool.checkNodesHealth(ctx, probe.probe))
+	require.Equal(t, pool.nodes[1], pool.activeNode())
+
+	// The active node fails: the first healthy node in configuration order becomes active.
+	pool.reportFailure(pool.nodes[1], errors.New("connection refused"))
+	require.Equal(t, pool.nodes[0], pool.activeNode())
+	require.Equal(t, []*poolNode[int]{pool.nodes[0], pool.nodes[2], pool.nodes[1]}, pool.candidateNodes())
+}
+
+func TestNodePool_ToleratesMaxBlockLag(t *testing.T) {
+	pool, probe := newTestNodePool(t, 2)
+
+	probe.setHeights(17, 20)
+	require.NoError(t, pool.checkNodesHealth(context.Background(), probe.probe))
+	require.Equal(t, pool.nodes[0], pool.activeNode())
+	require.Equal(t, int64(17), pool.nodeHeight(pool.nodes[0]))
+}
+
+func TestNodePool_FailsOpenWhenAllNodesAreUnhealthy(t *testing.T) {
+	pool, probe := newTestNodePool(t, 2)
+
+	failoverCh, releaseFailoverCh := pool.subscribeFailovers()
+	defer releaseFailoverCh()
+
+	probe.setErrs(errors.New("unreachable"), ErrFailoverNodeCatchingUp)
+	err := pool.checkNodesHealth(context.Background(), probe.probe)
+	require.ErrorIs(t, err, ErrFailoverNodesUnavailable)
+
+	// The active node is kept and every node is still tried.
+	require.Equal(t, pool.nodes[0], pool.activeNode())
+	require.Equal(t, []*poolNode[int]{pool.nodes[0], pool.nodes[1]}, pool.candidateNodes())
+	require.Empty(t, failoverCh)
+
+	// The failover observers are notified once a node recovers.
+	probe.setErrs(errors.New("unreachable"), nil)
+	require.NoError(t, pool.checkNodesHealth(context.Background(), probe.probe))
+	require.Equal(t, pool.nodes[1], pool.activeNode())
+	require.Len(t, failoverCh, 1)
+}
+
+func TestNodePool_NoNodes(t *testing.T) {
+	_, err := newNodePool(polyzero.NewLogger(), NodeRoleQueryRPC, HealthCheckConfig{}, nil, newTestNodeClient)
+	require.ErrorIs(t, err, ErrFailoverNoNodes)
+}
+
+// testNodeProbe is a node probe returning configurable heights and errors.
+type testNodeProbe struct {
+	mu      sync.Mutex
+	heights []int64
+	errs    []error
+}
+
+func (p *testNodeProbe) setHeights(heights ...int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.heights = heights
+	p.errs = make([]error, len(heights))
+}
+
+func (p *testNodeProbe) setErrs(errs ...error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.errs = errs
+}
+
+func (p *testNodeProbe) probe(_ context.Context, node *poolNode[int]) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.heights[node.client], p.errs[node.client]
+}
+
+// newTestNodePool creates a node pool of numNodes nodes whose clients are
+// their index, along with the probe controlling their health.
+func newTestNodePool(t *testing.T, numNodes int) (*nodePool[int], *testNodeProbe) {
+	t.Helper()
+
+	nodeURLs := make([]*url.URL, numNodes)
+	for i := range nodeURLs {
+		nodeURLs[i] = &url.URL{Scheme: "http", Host: fmt.Sprintf("node-%d:26657", i)}
+	}
+
+	pool, err := newNodePool(
+		polyzero.NewLogger(),
+		NodeRoleQueryRPC,
+		HealthCheckConfig{Interval: time.Hour, MaxBlockLag: 3},
+		nodeURLs,
+		newTestNodeClient,
+	)
+	require.NoError(t, err)
+
+	probe := &testNodeProbe{}
+	probe.setHeights(make([]int64, numNodes)...)
+
+	return pool, probe
+}
+
+// newTestNodeClient returns the index of the node in the test node URLs as its client.
+func newTestNodeClient(nodeURL *url.URL) (int, error) {
+	var index int
+	_, err := fmt.Sscanf(nodeURL.Hostname(), "node-%d", &index)
+	return index, err
+}
@@ -177,6 +177,17 @@ type EventsReplayClient[T any] interface {
 	LastNEvents(ctx context.Context, n int) []T
 }
 
+// EventsHeightCursorStore persists, for each events subscription query, the height
+// up to which its events were published by an EventsReplayClient.
+// It allows the events emitted while the client was not running to be backfilled.
+type EventsHeightCursorStore interface {
+	// GetHeight returns the height of the cursor of the given query, and whether
+	// it was found.
+	GetHeight(queryString string) (height int64, found bool, err error)
+	// SetHeight sets the height of the cursor of the given query.
+	SetHeight(queryString string, height int64) error
+}
+
 // BlockReplayObservable is a defined type which is a replay observable of type Block.
 // NB: This cannot be an alias due to gomock's lack of support for generic types.
 type BlockReplayObservable EventsObservable[Block]
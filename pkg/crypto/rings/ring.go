@@ -50,3 +50,37 @@ func pointsFromPublicKeys(
 
 	return points, nil
 }
+
+// GetRingSignatureKeyImage returns the key image of the given serialized ring
+// signature.
+//
+// The key image only depends on the signer's private key: it is the same for
+// all the signatures produced by the same signer, whatever the ring, without
+// revealing which ring member the signer is. This makes it usable to tell
+// apart the gateways (or applications) signing relay requests.
+//
+// DEV_NOTE: The signature itself is NOT verified, which is left to the
+// ring client's VerifyRelayRequestSignature.
+func GetRingSignatureKeyImage(signature []byte) ([]byte, error) {
+	// A serialized ring signature starts with the ring size (4 bytes) and the
+	// challenge scalar (32 bytes), followed by the key image point.
+	// See ring.RingSig.Serialize.
+	const keyImageOffset = 4 + 32
+	keyImageEnd := keyImageOffset + ringCurve.CompressedPointSize()
+	if len(signature) < keyImageEnd {
+		return nil, ErrRingClientInvalidRelayRequestSignature.Wrapf(
+			"ring signature too short: got %d bytes, expected at least %d",
+			len(signature),
+			keyImageEnd,
+		)
+	}
+
+	keyImage := signature[keyImageOffset:keyImageEnd]
+	if _, err := ringCurve.DecodeToPoint(keyImage); err != nil {
+		return nil, ErrRingClientInvalidRelayRequestSignature.Wrapf(
+			"invalid ring signature key image: %s", err,
+		)
+	}
+
+	return keyImage, nil
+}
@@ -11,12 +11,16 @@ import (
 	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	cosmostx "github.com/cosmos/cosmos-sdk/client/tx"
+	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/cobra"
 
 	"github.com/pokt-network/poktroll/cmd/flags"
 	"github.com/pokt-network/poktroll/pkg/cache/memory"
 	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/client/block"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/client/failover"
 	"github.com/pokt-network/poktroll/pkg/client/query"
 	querycache "github.com/pokt-network/poktroll/pkg/client/query/cache"
 	"github.com/pokt-network/poktroll/pkg/client/supplier"
@@ -69,11 +73,15 @@ func NewSupplyLoggerFromCtx(ctx context.Context) SupplierFn {
 	}
 }
 
-// NewSupplyCometClientFn supplies a depinject config with an
-// comet HTTP client from the given queryNodeRPCURL.
-func NewSupplyCometClientFn(queryNodeRPCURL *url.URL) SupplierFn {
+// NewSupplyCometClientFn supplies a depinject config with a comet client
+// failing over between the given queryNodeRPCURLs, in order of preference,
+// according to the given healthCheckConfig.
+func NewSupplyCometClientFn(
+	queryNodeRPCURLs []*url.URL,
+	healthCheckConfig failover.HealthCheckConfig,
+) SupplierFn {
 	return func(
-		_ context.Context,
+		ctx context.Context,
 		deps depinject.Config,
 		_ *cobra.Command,
 	) (depinject.Config, error) {
@@ -85,8 +93,15 @@ func NewSupplyCometClientFn(queryNodeRPCURL *url.URL) SupplierFn {
 			return nil, err
 		}
 
-		// Convert the query node RPC URL to a comet client
-		cometClient, err := sdkclient.NewClientFromNode(queryNodeRPCURL.String())
+		// Convert the query node RPC URLs to a comet client which health checks
+		// them for as long as the context is not done.
+		cometClient, err := failover.NewCometClient(
+			ctx,
+			logger,
+			failover.NodeRoleQueryRPC,
+			healthCheckConfig,
+			queryNodeRPCURLs,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -112,7 +127,12 @@ func NewSupplyCometClientFn(queryNodeRPCURL *url.URL) SupplierFn {
 }
 
 // NewSupplyBlockClientFn supplies a depinject config with a blockClient.
-func NewSupplyBlockClientFn(queryNodeRPCURL *url.URL) SupplierFn {
+// The given options configure the events replay client of the block client,
+// e.g. to resume from a persisted height cursor.
+func NewSupplyBlockClientFn(
+	queryNodeRPCURL *url.URL,
+	replayClientOpts ...events.ReplayClientOption,
+) SupplierFn {
 	return func(
 		ctx context.Context,
 		deps depinject.Config,
@@ -120,7 +140,7 @@ func NewSupplyBlockClientFn(queryNodeRPCURL *url.URL) SupplierFn {
 	) (depinject.Config, error) {
 
 		// Requires a query client to be supplied to the deps
-		blockClient, err := block.NewBlockClient(ctx, deps)
+		blockClient, err := block.NewBlockClient(ctx, deps, replayClientOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -129,40 +149,88 @@ func NewSupplyBlockClientFn(queryNodeRPCURL *url.URL) SupplierFn {
 	}
 }
 
+// KeyringDecoratorFn wraps the keyring of a client context, e.g. to delegate the
+// signing of some of its keys to remote signing daemons.
+type KeyringDecoratorFn func(cosmoskeyring.Keyring) cosmoskeyring.Keyring
+
 // NewSupplyQueryClientContextFn supplies a depinject config with a query
 //
-//	ClientContext, a GRPC client connection, and a keyring from the given queryNodeGRPCURL.
-func NewSupplyQueryClientContextFn(queryNodeGRPCURL *url.URL) SupplierFn {
+//	ClientContext, a GRPC client connection, and a keyring from the given queryNodeGRPCURLs.
+//
+// The supplied GRPC client connection fails over between the given
+// queryNodeGRPCURLs, in order of preference, according to the given
+// healthCheckConfig. The supplied ClientContext is the one of the first URL,
+// its CometBFT RPC requests (e.g. the chain ID check) being sent through the
+// failing over comet client, which MUST already be supplied (see NewSupplyCometClientFn).
+// The supplied keyring is the client context's one, wrapped by the given
+// keyringDecorators, if any.
+func NewSupplyQueryClientContextFn(
+	queryNodeGRPCURLs []*url.URL,
+	healthCheckConfig failover.HealthCheckConfig,
+	keyringDecorators ...KeyringDecoratorFn,
+) SupplierFn {
 	return func(
 		ctx context.Context,
 		deps depinject.Config,
 		cmd *cobra.Command,
 	) (depinject.Config, error) {
+		// Inject the logger and the comet client from the deps
+		var (
+			logger      polylog.Logger
+			cometClient *failover.CometClient
+		)
+		if err := depinject.Inject(deps, &logger, &cometClient); err != nil {
+			return nil, err
+		}
+
 		// Temporarily store the flag's current value to be restored later, after
-		// the client context has been created with queryNodeGRPCURL.
+		// the client contexts have been created with queryNodeGRPCURLs.
 		tmpGRPC, err := cmd.Flags().GetString(cosmosflags.FlagGRPC)
 		if err != nil {
 			return nil, err
 		}
 
-		// Set --grpc-addr flag to the pocketQueryNodeURL for the client context
-		// This flag is read by sdkclient.GetClientQueryContext.
-		// Cosmos-SDK is expecting a GRPC address formatted as <hostname>[:<port>],
-		// so we only need to set the Host parameter of the URL to cosmosflags.FlagGRPC value.
-		if err = cmd.Flags().Set(cosmosflags.FlagGRPC, queryNodeGRPCURL.Host); err != nil {
-			return nil, err
+		// Create a client context, along with its GRPC connection, per URL.
+		queryClientCtxs := make([]sdkclient.Context, 0, len(queryNodeGRPCURLs))
+		queryClientConns := make([]grpc.ClientConn, 0, len(queryNodeGRPCURLs))
+		for _, queryNodeGRPCURL := range queryNodeGRPCURLs {
+			// Set --grpc-addr flag to the pocketQueryNodeURL for the client context
+			// This flag is read by sdkclient.GetClientQueryContext.
+			// Cosmos-SDK is expecting a GRPC address formatted as <hostname>[:<port>],
+			// so we only need to set the Host parameter of the URL to cosmosflags.FlagGRPC value.
+			if err = cmd.Flags().Set(cosmosflags.FlagGRPC, queryNodeGRPCURL.Host); err != nil {
+				return nil, err
+			}
+
+			// NB: Currently, the implementations of GetClientTxContext() and
+			// GetClientQueryContext() are identical, allowing for their interchangeable
+			// use in both querying and transaction operations. However, in order to support
+			// independent configuration of client contexts for distinct querying and
+			// transacting purposes.
+			// For example, txs could be dispatched to a validator while queries
+			// could be handled by a full-node.
+			nodeClientCtx, ctxErr := sdkclient.GetClientQueryContext(cmd)
+			if ctxErr != nil {
+				return nil, ctxErr
+			}
+			queryClientCtxs = append(queryClientCtxs, nodeClientCtx)
+			queryClientConns = append(queryClientConns, nodeClientCtx)
 		}
 
-		// NB: Currently, the implementations of GetClientTxContext() and
-		// GetClientQueryContext() are identical, allowing for their interchangeable
-		// use in both querying and transaction operations. However, in order to support
-		// independent configuration of client contexts for distinct querying and
-		// transacting purposes.
-		// For example, txs could be dispatched to a validator while queries
-		// could be handled by a full-node.
-		queryClientCtx, err := sdkclient.GetClientQueryContext(cmd)
-		if err != nil {
-			return nil, err
+		// Send the CometBFT RPC requests through the comet client which fails over
+		// between the query node RPC URLs, rather than through the client bound
+		// to the --node flag, so that an unreachable first node does not prevent
+		// the RelayMiner from starting.
+		queryClientCtx := queryClientCtxs[0].WithClient(cometClient)
+		for _, decorateKeyring := range keyringDecorators {
+			queryClientCtx = queryClientCtx.WithKeyring(decorateKeyring(queryClientCtx.Keyring))
+		}
+
+		// The client context GRPC connection is bound to the first query node GRPC URL.
+		// When several are configured, the client context queries are sent through
+		// the failing over comet client instead, using ABCI queries.
+		if len(queryNodeGRPCURLs) > 1 {
+			queryClientCtx = queryClientCtx.WithGRPCClient(nil)
 		}
 
 		// Get the chain ID from the configured query client context.
@@ -180,9 +248,23 @@ func NewSupplyQueryClientContextFn(queryNodeGRPCURL *url.URL) SupplierFn {
 			)
 		}
 
+		// Spread the GRPC calls over the client contexts' connections, health
+		// checking them for as long as the context is not done.
+		queryClientConn, err := failover.NewGRPCConn(
+			ctx,
+			logger,
+			failover.NodeRoleQueryGRPC,
+			healthCheckConfig,
+			queryNodeGRPCURLs,
+			queryClientConns,
+		)
+		if err != nil {
+			return nil, err
+		}
+
 		deps = depinject.Configs(deps, depinject.Supply(
 			query.Context(queryClientCtx),
-			query.NewGRPCClientWithDebugMetrics(queryClientCtx),
+			query.NewGRPCClientWithDebugMetrics(queryClientConn),
 			queryClientCtx.Keyring,
 		))
 
@@ -197,17 +279,34 @@ func NewSupplyQueryClientContextFn(queryNodeGRPCURL *url.URL) SupplierFn {
 }
 
 // NewSupplyTxClientContextFn supplies a depinject config with a TxClientContext
-// from the given txNodeGRPCURL.
+// broadcasting transactions through a comet client failing over between the
+// given txNodeRPCURLs, in order of preference, according to the given healthCheckConfig.
+// The transactions are signed with the client context's keyring, wrapped by the
+// given keyringDecorators, if any.
 // TODO_TECHDEBT(#256): Remove this function once the as we may no longer
 // need to supply a TxClientContext to the RelayMiner.
 func NewSupplyTxClientContextFn(
-	queryNodeGRPCURL *url.URL,
-	txNodeRPCURL *url.URL,
+	queryNodeGRPCURLs []*url.URL,
+	txNodeRPCURLs []*url.URL,
+	healthCheckConfig failover.HealthCheckConfig,
+	keyringDecorators ...KeyringDecoratorFn,
 ) SupplierFn {
-	return func(_ context.Context,
+	return func(ctx context.Context,
 		deps depinject.Config,
 		cmd *cobra.Command,
 	) (depinject.Config, error) {
+		// Inject the logger from the deps
+		var logger polylog.Logger
+		if err := depinject.Inject(deps, &logger); err != nil {
+			return nil, err
+		}
+
+		if len(queryNodeGRPCURLs) == 0 || len(txNodeRPCURLs) == 0 {
+			return nil, failover.ErrFailoverNoNodes.Wrap("tx client context requires query node grpc and tx node rpc urls")
+		}
+		queryNodeGRPCURL := queryNodeGRPCURLs[0]
+		txNodeRPCURL := txNodeRPCURLs[0]
+
 		// Temporarily store the flag's current value to be restored later, after
 		// the client context has been created with txNodeRPCURL.
 		tmpNode, err := cmd.Flags().GetString(cosmosflags.FlagNode)
@@ -257,6 +356,32 @@ func NewSupplyTxClientContextFn(
 		if err != nil {
 			return nil, err
 		}
+		for _, decorateKeyring := range keyringDecorators {
+			txClientCtx = txClientCtx.WithKeyring(decorateKeyring(txClientCtx.Keyring))
+		}
+
+		// Broadcast the transactions through a comet client which health checks
+		// the tx node RPC URLs for as long as the context is not done.
+		txCometClient, err := failover.NewCometClient(
+			ctx,
+			logger,
+			failover.NodeRoleTxRPC,
+			healthCheckConfig,
+			txNodeRPCURLs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		txClientCtx = txClientCtx.WithClient(txCometClient)
+
+		// The client context GRPC connection, used to query the signing accounts,
+		// is bound to the first query node GRPC URL.
+		// When several are configured, the accounts are queried through the
+		// failing over comet client instead, using ABCI queries.
+		if len(queryNodeGRPCURLs) > 1 {
+			txClientCtx = txClientCtx.WithGRPCClient(nil)
+		}
+
 		deps = depinject.Configs(deps, depinject.Supply(
 			txtypes.Context(txClientCtx),
 		))
@@ -385,37 +510,57 @@ func NewSupplySupplierClientsFn(signingKeyNames []string, gasSettingStr string)
 		deps depinject.Config,
 		cmd *cobra.Command,
 	) (depinject.Config, error) {
-		// Set up the tx client options for the suppliers.
-		txClientOptions, err := GetTxClientGasAndFeesOptionsFromFlags(cmd, gasSettingStr)
+		suppliers, err := NewSupplierClients(ctx, deps, cmd, signingKeyNames, gasSettingStr)
 		if err != nil {
 			return nil, err
 		}
 
-		suppliers := supplier.NewSupplierClientMap()
-		for _, signingKeyName := range signingKeyNames {
-			txClientOptions = append(txClientOptions, tx.WithSigningKeyName(signingKeyName))
-			txClientDepinjectConfig, err := newSupplyTxClientsFn(
-				ctx,
-				deps,
-				txClientOptions...,
-			)
-			if err != nil {
-				return nil, err
-			}
+		return depinject.Configs(deps, depinject.Supply(suppliers)), nil
+	}
+}
 
-			supplierClient, err := supplier.NewSupplierClient(
-				txClientDepinjectConfig,
-				supplier.WithSigningKeyName(signingKeyName),
-			)
-			if err != nil {
-				return nil, err
-			}
+// NewSupplierClients constructs a SupplierClientMap with a SupplierClient for
+// each of the given signing key names, keyed by their operator address.
+// It is used by NewSupplySupplierClientsFn and to add suppliers to a running
+// RelayMiner (e.g. when its config is reloaded).
+func NewSupplierClients(
+	ctx context.Context,
+	deps depinject.Config,
+	cmd *cobra.Command,
+	signingKeyNames []string,
+	gasSettingStr string,
+) (*supplier.SupplierClientMap, error) {
+	// Set up the tx client options for the suppliers.
+	txClientOptions, err := GetTxClientGasAndFeesOptionsFromFlags(cmd, gasSettingStr)
+	if err != nil {
+		return nil, err
+	}
+
+	suppliers := supplier.NewSupplierClientMap()
+	for _, signingKeyName := range signingKeyNames {
+		txClientOptions = append(txClientOptions, tx.WithSigningKeyName(signingKeyName))
+		txClientDepinjectConfig, err := newSupplyTxClientsFn(
+			ctx,
+			deps,
+			txClientOptions...,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-			// Making sure we use addresses as keys.
-			suppliers.SupplierClients[supplierClient.OperatorAddress()] = supplierClient
+		supplierClient, err := supplier.NewSupplierClient(
+			txClientDepinjectConfig,
+			supplier.WithSigningKeyName(signingKeyName),
+		)
+		if err != nil {
+			return nil, err
 		}
-		return depinject.Configs(deps, depinject.Supply(suppliers)), nil
+
+		// Making sure we use addresses as keys.
+		suppliers.SupplierClients[supplierClient.OperatorAddress()] = supplierClient
 	}
+
+	return suppliers, nil
 }
 
 // NewSupplySharedQueryClientFn returns a function which constructs a
@@ -780,10 +925,15 @@ func NewSupplyRelayerProxyFn(
 // Parameters:
 //   - smtStorePath: Path to the sessions store
 //   - smtPersistenceDisabled: Flag to disable SMT persistence
+//   - minedRelaysWALConfig: Flush thresholds of the mined relays write-ahead logs
 //
 // Returns:
 //   - config.SupplierFn: Supplier function for dependency injection
-func NewSupplyRelayerSessionsManagerFn(smtStorePath string, smtPersistenceDisabled bool) SupplierFn {
+func NewSupplyRelayerSessionsManagerFn(
+	smtStorePath string,
+	smtPersistenceDisabled bool,
+	minedRelaysWALConfig session.MinedRelaysWALConfig,
+) SupplierFn {
 	return func(
 		ctx context.Context,
 		deps depinject.Config,
@@ -793,6 +943,7 @@ func NewSupplyRelayerSessionsManagerFn(smtStorePath string, smtPersistenceDisabl
 			deps,
 			session.WithStoresDirectoryPath(smtStorePath),
 			session.WithDisableSMTPersistence(smtPersistenceDisabled),
+			session.WithMinedRelaysWALConfig(minedRelaysWALConfig),
 		)
 		if err != nil {
 			return nil, err
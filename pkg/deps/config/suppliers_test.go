@@ -0,0 +1,148 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	"github.com/cometbft/cometbft/p2p"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/client/failover"
+	"github.com/pokt-network/poktroll/pkg/client/query"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+)
+
+const testChainID = "pocket-test"
+
+func TestNewSupplyQueryClientContextFn_FirstQueryNodeUnreachable(t *testing.T) {
+	tests := []struct {
+		desc          string
+		nodeChainID   string
+		expectedErrFn func(t require.TestingT, err error, msgAndArgs ...interface{})
+	}{
+		{
+			desc:          "the chain ID is checked against the first reachable node",
+			nodeChainID:   testChainID,
+			expectedErrFn: require.NoError,
+		},
+		{
+			desc:          "the chain ID mismatch of the first reachable node is reported",
+			nodeChainID:   "pocket-other",
+			expectedErrFn: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			t.Cleanup(cancelCtx)
+
+			unreachableNodeURL := newUnreachableNodeURL(t)
+			reachableNodeURL := newTestCometRPCNodeURL(t, test.nodeChainID)
+			healthCheckConfig := failover.HealthCheckConfig{Interval: time.Hour}
+
+			cmd := newTestQueryClientCmd(t)
+			deps := depinject.Supply(polylog.Logger(polyzero.NewLogger()))
+
+			deps, err := NewSupplyCometClientFn(
+				[]*url.URL{unreachableNodeURL, reachableNodeURL},
+				healthCheckConfig,
+			)(ctx, deps, cmd)
+			require.NoError(t, err)
+
+			deps, err = NewSupplyQueryClientContextFn(
+				[]*url.URL{unreachableNodeURL, reachableNodeURL},
+				healthCheckConfig,
+			)(ctx, deps, cmd)
+			test.expectedErrFn(t, err)
+			if err != nil {
+				return
+			}
+
+			var queryClientCtx query.Context
+			require.NoError(t, depinject.Inject(deps, &queryClientCtx))
+			require.Equal(t, testChainID, queryClientCtx.ChainID)
+		})
+	}
+}
+
+// newTestQueryClientCmd returns a command with the flags read to create a query
+// client context, and an in-memory keyring.
+func newTestQueryClientCmd(t *testing.T) *cobra.Command {
+	t.Helper()
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	clientCtx := sdkclient.Context{}.
+		WithCodec(cdc).
+		WithKeyring(cosmoskeyring.NewInMemory(cdc))
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String(cosmosflags.FlagGRPC, "", "")
+	cmd.Flags().Bool(cosmosflags.FlagGRPCInsecure, true, "")
+	cosmosflags.AddTxFlagsToCmd(cmd)
+	require.NoError(t, cmd.Flags().Set(cosmosflags.FlagChainID, testChainID))
+	cmd.SetContext(context.WithValue(context.Background(), sdkclient.ClientContextKey, &clientCtx))
+
+	return cmd
+}
+
+// newUnreachableNodeURL returns the URL of a node refusing connections.
+func newUnreachableNodeURL(t *testing.T) *url.URL {
+	t.Helper()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	nodeURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	return nodeURL
+}
+
+// newTestCometRPCNodeURL returns the URL of a CometBFT RPC node reporting the
+// given chain ID in its status.
+func newTestCometRPCNodeURL(t *testing.T, chainID string) *url.URL {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request rpctypes.RPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var response rpctypes.RPCResponse
+		switch request.Method {
+		case "status":
+			response = rpctypes.NewRPCSuccessResponse(request.ID, &coretypes.ResultStatus{
+				NodeInfo: p2p.DefaultNodeInfo{Network: chainID},
+			})
+		default:
+			response = rpctypes.RPCMethodNotFoundError(request.ID)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+
+	nodeURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	return nodeURL
+}
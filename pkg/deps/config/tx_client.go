@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/failover"
 	"github.com/pokt-network/poktroll/pkg/client/tx"
 	"github.com/pokt-network/poktroll/pkg/client/tx/types"
 )
@@ -51,7 +52,7 @@ func GetTxClientFromFlags(
 	// because BlockClient depends on CometClient via EventsReplayClient
 	deps, err := SupplyConfig(ctx, cmd, []SupplierFn{
 		NewSupplyLoggerFromCtx(ctx),
-		NewSupplyCometClientFn(queryNodeRPCUrl),
+		NewSupplyCometClientFn([]*url.URL{queryNodeRPCUrl}, failover.HealthCheckConfig{}),
 		NewSupplyBlockClientFn(queryNodeRPCUrl),
 	})
 	if err != nil {
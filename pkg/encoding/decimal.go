@@ -4,10 +4,15 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+
+	"cosmossdk.io/math"
 )
 
+// legacyDecPrecisionMultiplier is 10^LegacyPrecision, the denominator of every math.LegacyDec.
+var legacyDecPrecisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(math.LegacyPrecision), nil)
+
 // Float64ToRat converts a float64 to a big.Rat for precise decimal arithmetic.
-// TODO_FUTURE: Consider switching to string representations for tokenomics % allocations
+// TODO_FUTURE: Consider switching to string representations for proof % params
 // since CosmosSDK will deprecate float64 values with zero copy encoding of scalar values.
 // Ref: https://docs.cosmos.network/main/build/rfc/rfc-002-zero-copy-encoding
 func Float64ToRat(f float64) (*big.Rat, error) {
@@ -23,3 +28,36 @@ func Float64ToRat(f float64) (*big.Rat, error) {
 
 	return ratio, nil
 }
+
+// Float64ToLegacyDec converts a float64 to the math.LegacyDec of its shortest
+// decimal representation, i.e. the decimal Float64ToRat converts it to:
+// - Float64ToLegacyDec(0.975) == 0.975, and NOT 0.97499999999999997779...
+//
+// Floats whose shortest decimal representation has more than math.LegacyPrecision
+// decimal places (e.g. 1e-20) are rounded to math.LegacyPrecision decimal places.
+// It is intended for migrating float64 params to decimals.
+func Float64ToLegacyDec(f float64) (math.LegacyDec, error) {
+	dec, err := math.LegacyNewDecFromStr(strconv.FormatFloat(f, 'f', -1, 64))
+	if err == nil {
+		return dec, nil
+	}
+
+	dec, err = math.LegacyNewDecFromStr(strconv.FormatFloat(f, 'f', math.LegacyPrecision, 64))
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("error converting float64 to math.LegacyDec: %f: %w", f, err)
+	}
+
+	return dec, nil
+}
+
+// LegacyDecToRat converts a math.LegacyDec to the big.Rat of the exact same value.
+// Unlike float64, a math.LegacyDec is a decimal: the conversion is lossless and
+// every implementation of the protocol can reproduce it.
+// An unset (i.e. nil) math.LegacyDec converts to 0.
+func LegacyDecToRat(dec math.LegacyDec) *big.Rat {
+	if dec.IsNil() {
+		return new(big.Rat)
+	}
+
+	return new(big.Rat).SetFrac(dec.BigInt(), legacyDecPrecisionMultiplier)
+}
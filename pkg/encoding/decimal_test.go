@@ -0,0 +1,71 @@
+package encoding_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/encoding"
+)
+
+func TestFloat64ToLegacyDec(t *testing.T) {
+	tests := []struct {
+		desc        string
+		float       float64
+		expectedDec string
+	}{
+		{desc: "zero", float: 0, expectedDec: "0.000000000000000000"},
+		{desc: "one", float: 1, expectedDec: "1.000000000000000000"},
+		{desc: "0.1 is not 0.1000000000000000055...", float: 0.1, expectedDec: "0.100000000000000000"},
+		{desc: "0.975 is not 0.9749999999999999778...", float: 0.975, expectedDec: "0.975000000000000000"},
+		{desc: "0.7 is not 0.6999999999999999556...", float: 0.7, expectedDec: "0.700000000000000000"},
+		{desc: "negative", float: -0.15, expectedDec: "-0.150000000000000000"},
+		{desc: "more than 18 decimal places: rounded", float: 1.5e-19, expectedDec: "0.000000000000000000"},
+		{desc: "more than 18 decimal places: rounded up", float: 5e-19, expectedDec: "0.000000000000000001"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			dec, err := encoding.Float64ToLegacyDec(test.float)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedDec, dec.String())
+		})
+	}
+}
+
+func TestLegacyDecToRat(t *testing.T) {
+	tests := []struct {
+		desc        string
+		dec         math.LegacyDec
+		expectedRat *big.Rat
+	}{
+		{desc: "unset", dec: math.LegacyDec{}, expectedRat: big.NewRat(0, 1)},
+		{desc: "zero", dec: math.LegacyZeroDec(), expectedRat: big.NewRat(0, 1)},
+		{desc: "one", dec: math.LegacyOneDec(), expectedRat: big.NewRat(1, 1)},
+		{desc: "0.1", dec: math.LegacyMustNewDecFromStr("0.1"), expectedRat: big.NewRat(1, 10)},
+		{desc: "0.975", dec: math.LegacyMustNewDecFromStr("0.975"), expectedRat: big.NewRat(39, 40)},
+		{desc: "smallest decimal", dec: math.LegacySmallestDec(), expectedRat: big.NewRat(1, 1e18)},
+		{desc: "negative", dec: math.LegacyMustNewDecFromStr("-2.5"), expectedRat: big.NewRat(-5, 2)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			rat := encoding.LegacyDecToRat(test.dec)
+			require.Zerof(t, test.expectedRat.Cmp(rat), "expected %s, got %s", test.expectedRat, rat)
+		})
+	}
+}
+
+func TestLegacyDecToRat_MatchesFloat64ToRat(t *testing.T) {
+	// Params migrated from float64 to decimals MUST keep settling the exact same amounts.
+	for _, float := range []float64{0, 0.025, 0.1, 0.15, 0.7, 0.975, 1} {
+		expectedRat, err := encoding.Float64ToRat(float)
+		require.NoError(t, err)
+
+		dec, err := encoding.Float64ToLegacyDec(float)
+		require.NoError(t, err)
+		require.Zerof(t, expectedRat.Cmp(encoding.LegacyDecToRat(dec)), "float %v", float)
+	}
+}
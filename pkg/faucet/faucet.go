@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -31,6 +32,10 @@ var denomRouteTemplate = fmt.Sprintf("/{%s}/{%s}", denomRouteDenomParamName, den
 type Server struct {
 	config  *Config
 	handler *chi.Mux
+
+	// rateLimiter enforces the configured funding request rate limits.
+	// - nil if no rate limit is configured
+	rateLimiter *rateLimiter
 }
 
 // NewFaucetServer constructs a new Server using the provided options.
@@ -44,6 +49,14 @@ func NewFaucetServer(ctx context.Context, opts ...FaucetOptionFn) (*Server, erro
 		opt(faucet)
 	}
 
+	if faucet.config.RateLimit.IsEnabled() {
+		rateLimiter, err := newRateLimiter(faucet.config.RateLimit)
+		if err != nil {
+			return nil, err
+		}
+		faucet.rateLimiter = rateLimiter
+	}
+
 	handleDenomRequest := faucet.newHandleDenomPOSTRequest(ctx)
 	faucet.handler.Post(denomRouteTemplate, handleDenomRequest)
 
@@ -142,9 +155,35 @@ func (srv *Server) newHandleDenomPOSTRequest(ctx context.Context) http.HandlerFu
 			return
 		}
 
+		// Only count the requests which would be funded against the rate limits.
+		// The reserved request is released if the funds are not sent, so that
+		// failing requests do not consume the rate limits.
+		releaseRateLimit := func() {}
+		if srv.rateLimiter != nil {
+			clientIP := srv.rateLimiter.getClientIP(req)
+			reservedAt := time.Now()
+			retryAfter, isGranted, ledgerErr := srv.rateLimiter.reserve(reservedAt, recipientAddress.String(), clientIP)
+			if ledgerErr != nil {
+				// The request is still funded; failing to persist the ledger only
+				// means that this request is not counted after a restart.
+				logger.Error().Err(ledgerErr).Msg("unable to persist the rate limit ledger")
+			}
+			if !isGranted {
+				respondTooManyRequests(logger.With("client_ip", clientIP), res, retryAfter)
+				return
+			}
+
+			releaseRateLimit = func() {
+				if releaseErr := srv.rateLimiter.release(reservedAt, recipientAddress.String(), clientIP); releaseErr != nil {
+					logger.Error().Err(releaseErr).Msg("unable to persist the rate limit ledger")
+				}
+			}
+		}
+
 		// If the address doesn't exist onchain, send it tokens.
 		fundResponse, sendErr := srv.SendDenom(ctx, logger, denom, recipientAddress)
 		if sendErr != nil {
+			releaseRateLimit()
 			respondBadRequest(logger, res, sendErr)
 			return
 		}
@@ -157,6 +196,7 @@ func (srv *Server) newHandleDenomPOSTRequest(ctx context.Context) http.HandlerFu
 
 		// If CheckTx fails, return a 400 Bad Request response with the fund response.
 		if fundResponse.Code != 0 {
+			releaseRateLimit()
 			respondBadRequest(logger, res, errors.New(string(fundResponseJSON)))
 			return
 		}
@@ -287,6 +327,18 @@ func respondNotModified(logger polylog.Logger, res http.ResponseWriter, recipien
 	}
 }
 
+// respondTooManyRequests writes a 429 Too Many Requests response with a Retry-After
+// header indicating when the funding request may be retried.
+func respondTooManyRequests(logger polylog.Logger, res http.ResponseWriter, retryAfter time.Duration) {
+	logger.Warn().Msgf("rate limited funding request, retry after %s", retryAfter)
+
+	res.Header().Set("Retry-After", formatRetryAfter(retryAfter))
+	res.WriteHeader(http.StatusTooManyRequests)
+	if _, err := fmt.Fprintf(res, "rate limit exceeded, retry after %s", retryAfter.Round(time.Second)); err != nil {
+		logger.Error().Err(err).Send()
+	}
+}
+
 // respondNotFound writes a 404 Not Found response and logs the error.
 func respondNotFound(logger polylog.Logger, req *http.Request, res http.ResponseWriter, err error) {
 	logger.Error().Err(err).Send()
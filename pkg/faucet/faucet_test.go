@@ -2,6 +2,7 @@ package faucet_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -30,12 +31,13 @@ import (
 )
 
 const (
-	testListenAddress   = "127.0.0.1:42069"
-	testTimeoutDuration = time.Second
-	mockTxHash          = "0000000000000000000000000000000000000000000000000000000000000000"
-	testSendUPOKT       = "100000000000upokt"
-	testSendMACT        = "1mact"
-	testFeeUPOKT        = "1upokt"
+	testListenAddress          = "127.0.0.1:42069"
+	testRateLimitListenAddress = "127.0.0.1:42070"
+	testTimeoutDuration        = time.Second
+	mockTxHash                 = "0000000000000000000000000000000000000000000000000000000000000000"
+	testSendUPOKT              = "100000000000upokt"
+	testSendMACT               = "1mact"
+	testFeeUPOKT               = "1upokt"
 
 	testSigningKeyName     = "faucet"
 	testSigningKeyMnemonic = "baby advance work soap slow exclude blur humble lucky rough teach wide chuckle captain rack laundry butter main very cannon donate armor dress follow"
@@ -170,6 +172,98 @@ func TestNewFaucet(t *testing.T) {
 	}
 }
 
+func TestNewFaucet_RateLimitReleasedOnSendFailure(t *testing.T) {
+	// Ensure the CLI logger is set up.
+	logger.LogOutput = flags.DefaultLogOutput
+	cmd, err := (&cobra.Command{}).ExecuteC()
+	require.NoError(t, err)
+
+	err = logger.PreRunESetup(cmd, []string{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	config, err := faucet.NewFaucetConfig(
+		clientCtx,
+		testSigningKeyName,
+		testRateLimitListenAddress,
+		[]string{testSendUPOKT},
+		false,
+	)
+	require.NoError(t, err)
+
+	// The first transaction fails to be broadcast, the following ones succeed.
+	msgsPerTx := new([][]cosmostypes.Msg)
+	*msgsPerTx = make([][]cosmostypes.Msg, 0)
+	signAndBroadcastSuccess := newSignAndBroadcastSuccess(t, msgsPerTx)
+	numSignAndBroadcastCalls := 0
+	signAndBroadcastFailFirst := func(
+		ctx context.Context,
+		msgs ...cosmostypes.Msg,
+	) (*cosmostypes.TxResponse, either.AsyncError) {
+		numSignAndBroadcastCalls++
+		if numSignAndBroadcastCalls == 1 {
+			return nil, either.SyncErr(errors.New("broadcast failed"))
+		}
+		return signAndBroadcastSuccess(ctx, msgs...)
+	}
+	txClient := newTxClientMock(t, signAndBroadcastFailFirst, 2)
+
+	testRecipientAddress := cosmostypes.MustAccAddressFromBech32(sample.AccAddressBech32())
+	ctrl := gomock.NewController(t)
+	bankQueryClient := mockclient.NewMockBankGRPCQueryClient(ctrl)
+
+	faucetServer, err := faucet.NewFaucetServer(
+		ctx,
+		faucet.WithConfig(config),
+		faucet.WithRateLimitConfig(faucet.RateLimitConfig{
+			Window:                  time.Hour,
+			MaxRequestsPerRecipient: 1,
+		}),
+		faucet.WithTxClient(txClient),
+		faucet.WithBankQueryClient(bankQueryClient),
+	)
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+	go func() {
+		asyncErr := faucetServer.Serve(ctx)
+		errCh <- asyncErr
+	}()
+
+	// Wait a tick for the faucet to start listening.
+	time.Sleep(100 * time.Millisecond)
+
+	requestURL := fmt.Sprintf("http://%s/upokt/%s", config.ListenAddress, testRecipientAddress)
+	postFundingRequest := func() int {
+		res, postErr := http.DefaultClient.Post(requestURL, "application/json", nil)
+		require.NoError(t, postErr)
+		require.NoError(t, res.Body.Close())
+		return res.StatusCode
+	}
+
+	// The failed funding request does not consume the recipient rate limit.
+	require.Equal(t, http.StatusBadRequest, postFundingRequest())
+	require.Empty(t, *msgsPerTx)
+
+	require.Equal(t, http.StatusAccepted, postFundingRequest())
+	require.Len(t, *msgsPerTx, 1)
+
+	// The successful funding request does.
+	require.Equal(t, http.StatusTooManyRequests, postFundingRequest())
+	require.Len(t, *msgsPerTx, 1)
+
+	cancel()
+
+	select {
+	case <-time.After(testTimeoutDuration):
+		t.Fatal("Timed out waiting for faucet to shutdown")
+
+	case err = <-errCh:
+		require.NoError(t, err)
+	}
+}
+
 // signAndBroadcastFn is a function which signs and broadcasts the given msgs.
 type signAndBroadcastFn func(context.Context, ...cosmostypes.Msg) (*cosmostypes.TxResponse, either.AsyncError)
 
@@ -41,6 +41,11 @@ type Config struct {
 	// - Format: "host:port"
 	ListenAddress string `mapstructure:"listen_address"`
 
+	// RateLimit limits the number of funding requests per recipient address,
+	// per client IP and in total within a sliding time window.
+	// - Optional: funding requests are not rate limited if no limit is set
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+
 	// ############################################################
 	// ### Internal Configs - Ignored if present in config file ###
 	// ############################################################
@@ -98,6 +103,7 @@ func NewFaucetConfig(
 // - SigningKeyName must be set
 // - SupportedSendCoins must include at least one valid coin string (e.g. "1upokt")
 // - ListenAddress must be a valid "host:port" string
+// - RateLimit window must be positive if any rate limit is set
 func (config *Config) Validate() error {
 	if config.SigningKeyName == "" {
 		return fmt.Errorf("signing key name MUST be set")
@@ -111,6 +117,10 @@ func (config *Config) Validate() error {
 	if err := config.validateListenAddress(config.ListenAddress); err != nil {
 		return err
 	}
+
+	if err := config.RateLimit.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -176,6 +186,13 @@ func WithTxClient(txClient client.TxClient) FaucetOptionFn {
 	}
 }
 
+// WithRateLimitConfig sets the faucet server's funding request rate limits.
+func WithRateLimitConfig(rateLimitConfig RateLimitConfig) FaucetOptionFn {
+	return func(faucet *Server) {
+		faucet.config.RateLimit = rateLimitConfig
+	}
+}
+
 // bankGRPCQueryClient defines the interface to the bank module's gRPC query client.
 // - Exposes only the methods required by the faucet.
 type bankGRPCQueryClient interface {
@@ -107,6 +107,19 @@ func TestNewConfig_Validate_Error(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("unable to parse send coins"),
 		},
+		{
+			desc: "rate limit without window",
+			config: &faucet.Config{
+				SigningKeyName:     testSigningKeyName,
+				ListenAddress:      testListenAddress,
+				SupportedSendCoins: testSupportedSendCoins,
+				CreateAccountsOnly: false,
+				RateLimit: faucet.RateLimitConfig{
+					MaxRequestsPerRecipient: 1,
+				},
+			},
+			expectedErr: fmt.Errorf("rate limit window MUST be positive when a rate limit is set"),
+		},
 	}
 
 	for _, test := range testCases {
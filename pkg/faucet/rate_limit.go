@@ -0,0 +1,340 @@
+package faucet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitTotalKey is the ledger key of the grants counted against MaxRequestsTotal.
+	rateLimitTotalKey = "total"
+	// rateLimitRecipientKeyPrefix prefixes the ledger keys of the grants counted against MaxRequestsPerRecipient.
+	rateLimitRecipientKeyPrefix = "recipient/"
+	// rateLimitIPKeyPrefix prefixes the ledger keys of the grants counted against MaxRequestsPerIP.
+	rateLimitIPKeyPrefix = "ip/"
+)
+
+// RateLimitConfig defines the faucet funding request rate limits.
+// Each limit is the maximum number of funding requests granted within the
+// sliding time Window; a limit of 0 disables it.
+type RateLimitConfig struct {
+	// Window is the sliding time window over which the funding requests are counted (e.g. "24h").
+	Window time.Duration `mapstructure:"window"`
+
+	// MaxRequestsPerRecipient limits the funding requests granted to the same recipient address.
+	MaxRequestsPerRecipient uint64 `mapstructure:"max_requests_per_recipient"`
+
+	// MaxRequestsPerIP limits the funding requests granted to the same client IP.
+	MaxRequestsPerIP uint64 `mapstructure:"max_requests_per_ip"`
+
+	// MaxRequestsTotal limits the funding requests granted across all recipients and clients.
+	MaxRequestsTotal uint64 `mapstructure:"max_requests_total"`
+
+	// LedgerPath is the file in which the granted funding requests are persisted,
+	// so that the rate limits survive faucet restarts.
+	// - Optional: the ledger is only kept in memory if empty
+	LedgerPath string `mapstructure:"ledger_path"`
+
+	// ClientIPHeader is the HTTP header from which the client IP is read (e.g. "X-Forwarded-For").
+	// - Optional: the request remote address is used if empty
+	// - MUST only be set when the faucet is behind a proxy which sets this header;
+	//   otherwise, clients can spoof their IP.
+	ClientIPHeader string `mapstructure:"client_ip_header"`
+
+	// TrustedProxies are the IPs or CIDR ranges (e.g. "10.0.0.0/8") of the proxies
+	// which append the client IP header entries.
+	// - The client IP is the right-most header entry which is not a trusted proxy
+	// - Optional: the right-most entry (i.e. appended by the closest proxy) is used if empty
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+}
+
+// IsEnabled returns true if at least one rate limit is configured.
+func (config *RateLimitConfig) IsEnabled() bool {
+	return config.MaxRequestsPerRecipient > 0 ||
+		config.MaxRequestsPerIP > 0 ||
+		config.MaxRequestsTotal > 0
+}
+
+// Validate checks that the window is set if any rate limit is configured and that
+// the trusted proxies are valid IPs or CIDR ranges.
+func (config *RateLimitConfig) Validate() error {
+	if config.IsEnabled() && config.Window <= 0 {
+		return fmt.Errorf("rate limit window MUST be positive when a rate limit is set, got %s", config.Window)
+	}
+	if _, err := config.parseTrustedProxies(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parseTrustedProxies parses the trusted proxies, each single IP being parsed as
+// a CIDR range containing only itself.
+func (config *RateLimitConfig) parseTrustedProxies() ([]netip.Prefix, error) {
+	trustedProxies := make([]netip.Prefix, 0, len(config.TrustedProxies))
+	for _, trustedProxy := range config.TrustedProxies {
+		if trustedProxyPrefix, err := netip.ParsePrefix(trustedProxy); err == nil {
+			trustedProxies = append(trustedProxies, trustedProxyPrefix.Masked())
+			continue
+		}
+
+		trustedProxyAddr, err := netip.ParseAddr(trustedProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: MUST be an IP or a CIDR range", trustedProxy)
+		}
+		trustedProxies = append(trustedProxies, netip.PrefixFrom(trustedProxyAddr.Unmap(), trustedProxyAddr.Unmap().BitLen()))
+	}
+	return trustedProxies, nil
+}
+
+// rateLimitLedger records the time of the funding requests granted within the
+// rate limit window, by ledger key.
+type rateLimitLedger struct {
+	Grants map[string][]time.Time `json:"grants"`
+}
+
+// rateLimiter enforces the configured rate limits, persisting its ledger to
+// RateLimitConfig.LedgerPath (if set) every time a funding request is granted.
+type rateLimiter struct {
+	config RateLimitConfig
+
+	// trustedProxies are the parsed RateLimitConfig.TrustedProxies.
+	trustedProxies []netip.Prefix
+
+	// ledgerMu protects ledger, which is read and updated by concurrent requests.
+	ledgerMu sync.Mutex
+	ledger   rateLimitLedger
+}
+
+// newRateLimiter constructs a rateLimiter for the given config, loading its
+// ledger from config.LedgerPath if the file exists.
+func newRateLimiter(config RateLimitConfig) (*rateLimiter, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	trustedProxies, err := config.parseTrustedProxies()
+	if err != nil {
+		return nil, err
+	}
+
+	limiter := &rateLimiter{
+		config:         config,
+		trustedProxies: trustedProxies,
+		ledger:         rateLimitLedger{Grants: make(map[string][]time.Time)},
+	}
+
+	if config.LedgerPath == "" {
+		return limiter, nil
+	}
+
+	ledgerBz, err := os.ReadFile(config.LedgerPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return limiter, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to read rate limit ledger %q: %w", config.LedgerPath, err)
+	}
+
+	if err = json.Unmarshal(ledgerBz, &limiter.ledger); err != nil {
+		return nil, fmt.Errorf("unable to parse rate limit ledger %q: %w", config.LedgerPath, err)
+	}
+	if limiter.ledger.Grants == nil {
+		limiter.ledger.Grants = make(map[string][]time.Time)
+	}
+
+	return limiter, nil
+}
+
+// reserve grants a funding request to recipientAddress from clientIP at the given
+// time if none of the rate limits is reached, recording it in the ledger.
+// Otherwise, it returns how long to wait until the request would be granted.
+// An error is returned, along with the granted request, if the ledger cannot be persisted.
+func (limiter *rateLimiter) reserve(
+	now time.Time,
+	recipientAddress string,
+	clientIP string,
+) (retryAfter time.Duration, isGranted bool, err error) {
+	limiter.ledgerMu.Lock()
+	defer limiter.ledgerMu.Unlock()
+
+	limiter.pruneExpiredGrants(now)
+
+	isLimited := false
+	limitedKeys := limiter.getLimitedKeys(recipientAddress, clientIP)
+	for ledgerKey, maxRequests := range limitedKeys {
+		grants := limiter.ledger.Grants[ledgerKey]
+		if uint64(len(grants)) < maxRequests {
+			continue
+		}
+
+		// The limit is reached until enough of the oldest grants expire for
+		// the number of grants to fall below it.
+		isLimited = true
+		expiringGrant := grants[uint64(len(grants))-maxRequests]
+		retryAfter = max(retryAfter, expiringGrant.Add(limiter.config.Window).Sub(now))
+	}
+	if isLimited {
+		return retryAfter, false, nil
+	}
+
+	for ledgerKey := range limitedKeys {
+		limiter.ledger.Grants[ledgerKey] = append(limiter.ledger.Grants[ledgerKey], now)
+	}
+
+	return 0, true, limiter.persistLedger()
+}
+
+// release cancels the funding request to recipientAddress from clientIP granted
+// at the given time by reserve (e.g. because the funds could not be sent), so that
+// it is no longer counted against the rate limits.
+// An error is returned if the ledger cannot be persisted.
+func (limiter *rateLimiter) release(
+	grantedAt time.Time,
+	recipientAddress string,
+	clientIP string,
+) error {
+	limiter.ledgerMu.Lock()
+	defer limiter.ledgerMu.Unlock()
+
+	for ledgerKey := range limiter.getLimitedKeys(recipientAddress, clientIP) {
+		grants := limiter.ledger.Grants[ledgerKey]
+
+		// Remove the latest matching grant; concurrent grants may have been appended after it.
+		for grantIdx := len(grants) - 1; grantIdx >= 0; grantIdx-- {
+			if !grants[grantIdx].Equal(grantedAt) {
+				continue
+			}
+
+			grants = slices.Delete(grants, grantIdx, grantIdx+1)
+			break
+		}
+
+		if len(grants) == 0 {
+			delete(limiter.ledger.Grants, ledgerKey)
+			continue
+		}
+		limiter.ledger.Grants[ledgerKey] = grants
+	}
+
+	return limiter.persistLedger()
+}
+
+// getLimitedKeys returns the max number of requests of every rate limited ledger
+// key a funding request to recipientAddress from clientIP is counted against.
+func (limiter *rateLimiter) getLimitedKeys(recipientAddress, clientIP string) map[string]uint64 {
+	limitedKeys := make(map[string]uint64)
+	if limiter.config.MaxRequestsPerRecipient > 0 {
+		limitedKeys[rateLimitRecipientKeyPrefix+recipientAddress] = limiter.config.MaxRequestsPerRecipient
+	}
+	if limiter.config.MaxRequestsPerIP > 0 {
+		limitedKeys[rateLimitIPKeyPrefix+clientIP] = limiter.config.MaxRequestsPerIP
+	}
+	if limiter.config.MaxRequestsTotal > 0 {
+		limitedKeys[rateLimitTotalKey] = limiter.config.MaxRequestsTotal
+	}
+	return limitedKeys
+}
+
+// pruneExpiredGrants removes the grants which are older than the rate limit window.
+// The grants of each ledger key are in chronological order.
+// It MUST be called with ledgerMu held.
+func (limiter *rateLimiter) pruneExpiredGrants(now time.Time) {
+	windowStart := now.Add(-limiter.config.Window)
+	for ledgerKey, grants := range limiter.ledger.Grants {
+		numExpiredGrants := 0
+		for numExpiredGrants < len(grants) && !grants[numExpiredGrants].After(windowStart) {
+			numExpiredGrants++
+		}
+
+		if numExpiredGrants == len(grants) {
+			delete(limiter.ledger.Grants, ledgerKey)
+			continue
+		}
+		limiter.ledger.Grants[ledgerKey] = grants[numExpiredGrants:]
+	}
+}
+
+// persistLedger atomically writes the ledger to the configured ledger path, if any.
+// It MUST be called with ledgerMu held.
+func (limiter *rateLimiter) persistLedger() error {
+	if limiter.config.LedgerPath == "" {
+		return nil
+	}
+
+	ledgerBz, err := json.Marshal(limiter.ledger)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a crash never leaves a truncated ledger.
+	tmpLedgerPath := limiter.config.LedgerPath + ".tmp"
+	if err = os.MkdirAll(filepath.Dir(limiter.config.LedgerPath), 0o755); err != nil {
+		return err
+	}
+	if err = os.WriteFile(tmpLedgerPath, ledgerBz, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpLedgerPath, limiter.config.LedgerPath)
+}
+
+// getClientIP returns the IP of the client which sent the given request, read from
+// the configured client IP header if set and present, or from its remote address.
+//
+// Proxies append the address they received the request from to the header
+// (e.g. X-Forwarded-For: <client>, <proxy1>, ...), so only the entries appended by
+// the trusted proxies can be relied upon: the client IP is the right-most entry
+// which is not a trusted proxy.
+func (limiter *rateLimiter) getClientIP(req *http.Request) string {
+	if limiter.config.ClientIPHeader != "" {
+		if headerValue := req.Header.Get(limiter.config.ClientIPHeader); headerValue != "" {
+			forwardedIPs := strings.Split(headerValue, ",")
+			for i := len(forwardedIPs) - 1; i >= 0; i-- {
+				forwardedIP := strings.TrimSpace(forwardedIPs[i])
+				if i > 0 && limiter.isTrustedProxy(forwardedIP) {
+					continue
+				}
+				return forwardedIP
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// isTrustedProxy returns true if the given IP is one of the trusted proxies.
+func (limiter *rateLimiter) isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, trustedProxy := range limiter.trustedProxies {
+		if trustedProxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatRetryAfter formats the given duration as the number of seconds expected by
+// the Retry-After HTTP header, rounding up to at least 1 second.
+func formatRetryAfter(retryAfter time.Duration) string {
+	return fmt.Sprintf("%d", int64(max(1, math.Ceil(retryAfter.Seconds()))))
+}
@@ -0,0 +1,301 @@
+package faucet
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRateLimitWindow = time.Hour
+	testRecipientA      = "pokt1recipienta"
+	testRecipientB      = "pokt1recipientb"
+	testClientIPA       = "10.0.0.1"
+	testClientIPB       = "10.0.0.2"
+)
+
+var testRateLimitStartTime = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	tests := []struct {
+		desc string
+		// reservations are made one minute apart, starting at testRateLimitStartTime.
+		reservations []testReservation
+		config       RateLimitConfig
+	}{
+		{
+			desc: "per recipient limit",
+			config: RateLimitConfig{
+				Window:                  testRateLimitWindow,
+				MaxRequestsPerRecipient: 2,
+			},
+			reservations: []testReservation{
+				{testRecipientA, testClientIPA, true},
+				{testRecipientB, testClientIPA, true},
+				{testRecipientA, testClientIPB, true},
+				{testRecipientA, testClientIPB, false},
+				{testRecipientB, testClientIPB, true},
+			},
+		},
+		{
+			desc: "per IP limit",
+			config: RateLimitConfig{
+				Window:           testRateLimitWindow,
+				MaxRequestsPerIP: 1,
+			},
+			reservations: []testReservation{
+				{testRecipientA, testClientIPA, true},
+				{testRecipientB, testClientIPA, false},
+				{testRecipientB, testClientIPB, true},
+			},
+		},
+		{
+			desc: "total limit",
+			config: RateLimitConfig{
+				Window:           testRateLimitWindow,
+				MaxRequestsTotal: 2,
+			},
+			reservations: []testReservation{
+				{testRecipientA, testClientIPA, true},
+				{testRecipientB, testClientIPB, true},
+				{testRecipientB, testClientIPA, false},
+			},
+		},
+		{
+			desc: "limited requests are not counted",
+			config: RateLimitConfig{
+				Window:                  testRateLimitWindow,
+				MaxRequestsPerRecipient: 1,
+				MaxRequestsTotal:        2,
+			},
+			reservations: []testReservation{
+				{testRecipientA, testClientIPA, true},
+				{testRecipientA, testClientIPA, false},
+				{testRecipientA, testClientIPA, false},
+				{testRecipientB, testClientIPA, true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			limiter, err := newRateLimiter(test.config)
+			require.NoError(t, err)
+
+			for i, reservation := range test.reservations {
+				now := testRateLimitStartTime.Add(time.Duration(i) * time.Minute)
+				retryAfter, isGranted, err := limiter.reserve(now, reservation.recipientAddress, reservation.clientIP)
+				require.NoError(t, err)
+				require.Equalf(t, reservation.isGranted, isGranted, "reservation %d", i)
+
+				if isGranted {
+					require.Zero(t, retryAfter)
+				} else {
+					require.Positive(t, retryAfter)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiter_RetryAfter(t *testing.T) {
+	limiter, err := newRateLimiter(RateLimitConfig{
+		Window:                  testRateLimitWindow,
+		MaxRequestsPerRecipient: 2,
+		MaxRequestsPerIP:        1,
+	})
+	require.NoError(t, err)
+
+	reserveAt := func(offset time.Duration, recipientAddress, clientIP string) (time.Duration, bool) {
+		retryAfter, isGranted, reserveErr := limiter.reserve(testRateLimitStartTime.Add(offset), recipientAddress, clientIP)
+		require.NoError(t, reserveErr)
+		return retryAfter, isGranted
+	}
+
+	_, isGranted := reserveAt(0, testRecipientA, testClientIPA)
+	require.True(t, isGranted)
+	_, isGranted = reserveAt(10*time.Minute, testRecipientA, testClientIPB)
+	require.True(t, isGranted)
+
+	// The recipient limit is reached until its oldest grant expires.
+	retryAfter, isGranted := reserveAt(20*time.Minute, testRecipientA, "10.0.0.3")
+	require.False(t, isGranted)
+	require.Equal(t, 40*time.Minute, retryAfter)
+
+	// The longest wait of all the reached limits is returned.
+	retryAfter, isGranted = reserveAt(30*time.Minute, testRecipientA, testClientIPB)
+	require.False(t, isGranted)
+	require.Equal(t, 40*time.Minute, retryAfter)
+
+	// The request is granted once the oldest recipient grant expires.
+	_, isGranted = reserveAt(testRateLimitWindow, testRecipientA, "10.0.0.3")
+	require.True(t, isGranted)
+}
+
+func TestRateLimiter_PersistentLedger(t *testing.T) {
+	config := RateLimitConfig{
+		Window:                  testRateLimitWindow,
+		MaxRequestsPerRecipient: 1,
+		LedgerPath:              filepath.Join(t.TempDir(), "ledger", "rate_limit_ledger.json"),
+	}
+
+	limiter, err := newRateLimiter(config)
+	require.NoError(t, err)
+
+	_, isGranted, err := limiter.reserve(testRateLimitStartTime, testRecipientA, testClientIPA)
+	require.NoError(t, err)
+	require.True(t, isGranted)
+
+	// Simulate a restart by loading the ledger into a new rate limiter.
+	restartedLimiter, err := newRateLimiter(config)
+	require.NoError(t, err)
+
+	retryAfter, isGranted, err := restartedLimiter.reserve(testRateLimitStartTime.Add(time.Minute), testRecipientA, testClientIPA)
+	require.NoError(t, err)
+	require.False(t, isGranted)
+	require.Equal(t, testRateLimitWindow-time.Minute, retryAfter)
+
+	// Expired grants are pruned and no longer limit the requests.
+	_, isGranted, err = restartedLimiter.reserve(testRateLimitStartTime.Add(testRateLimitWindow), testRecipientA, testClientIPA)
+	require.NoError(t, err)
+	require.True(t, isGranted)
+}
+
+func TestRateLimiter_GetClientIP(t *testing.T) {
+	tests := []struct {
+		desc             string
+		clientIPHeader   string
+		trustedProxies   []string
+		headerValue      string
+		remoteAddr       string
+		expectedClientIP string
+	}{
+		{
+			desc:             "remote address",
+			remoteAddr:       "10.0.0.1:54321",
+			expectedClientIP: "10.0.0.1",
+		},
+		{
+			desc:             "header is ignored if not configured",
+			headerValue:      "10.0.0.2",
+			remoteAddr:       "10.0.0.1:54321",
+			expectedClientIP: "10.0.0.1",
+		},
+		{
+			desc:             "last forwarded address without trusted proxies",
+			clientIPHeader:   "X-Forwarded-For",
+			headerValue:      "10.0.0.2, 172.16.0.1",
+			remoteAddr:       "10.0.0.1:54321",
+			expectedClientIP: "172.16.0.1",
+		},
+		{
+			desc:             "spoofed forwarded address is ignored",
+			clientIPHeader:   "X-Forwarded-For",
+			trustedProxies:   []string{"172.16.0.0/12"},
+			headerValue:      "1.2.3.4, 10.0.0.2, 172.16.0.1",
+			remoteAddr:       "172.16.0.2:54321",
+			expectedClientIP: "10.0.0.2",
+		},
+		{
+			desc:             "trusted proxy IPs are skipped",
+			clientIPHeader:   "X-Forwarded-For",
+			trustedProxies:   []string{"172.16.0.1", "172.16.0.2"},
+			headerValue:      "10.0.0.2, 172.16.0.2, 172.16.0.1",
+			remoteAddr:       "172.16.0.3:54321",
+			expectedClientIP: "10.0.0.2",
+		},
+		{
+			desc:             "first forwarded address if all are trusted proxies",
+			clientIPHeader:   "X-Forwarded-For",
+			trustedProxies:   []string{"172.16.0.0/12"},
+			headerValue:      "172.16.0.2, 172.16.0.1",
+			remoteAddr:       "172.16.0.3:54321",
+			expectedClientIP: "172.16.0.2",
+		},
+		{
+			desc:             "remote address if header is missing",
+			clientIPHeader:   "X-Forwarded-For",
+			remoteAddr:       "10.0.0.1:54321",
+			expectedClientIP: "10.0.0.1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			limiter, err := newRateLimiter(RateLimitConfig{
+				ClientIPHeader: test.clientIPHeader,
+				TrustedProxies: test.trustedProxies,
+			})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/upokt/pokt1recipient", nil)
+			require.NoError(t, err)
+			req.RemoteAddr = test.remoteAddr
+			if test.headerValue != "" {
+				req.Header.Set("X-Forwarded-For", test.headerValue)
+			}
+
+			require.Equal(t, test.expectedClientIP, limiter.getClientIP(req))
+		})
+	}
+}
+
+func TestRateLimiter_InvalidTrustedProxy(t *testing.T) {
+	_, err := newRateLimiter(RateLimitConfig{
+		ClientIPHeader: "X-Forwarded-For",
+		TrustedProxies: []string{"not-an-ip"},
+	})
+	require.ErrorContains(t, err, "invalid trusted proxy")
+}
+
+func TestRateLimiter_Release(t *testing.T) {
+	config := RateLimitConfig{
+		Window:                  testRateLimitWindow,
+		MaxRequestsPerRecipient: 1,
+		MaxRequestsTotal:        2,
+		LedgerPath:              filepath.Join(t.TempDir(), "ledger.json"),
+	}
+	limiter, err := newRateLimiter(config)
+	require.NoError(t, err)
+
+	_, isGranted, err := limiter.reserve(testRateLimitStartTime, testRecipientA, testClientIPA)
+	require.NoError(t, err)
+	require.True(t, isGranted)
+
+	// Releasing the granted request frees its rate limit slots.
+	require.NoError(t, limiter.release(testRateLimitStartTime, testRecipientA, testClientIPA))
+
+	_, isGranted, err = limiter.reserve(testRateLimitStartTime.Add(time.Minute), testRecipientA, testClientIPA)
+	require.NoError(t, err)
+	require.True(t, isGranted)
+
+	// The release is persisted: only the last grant is counted after a restart.
+	restartedLimiter, err := newRateLimiter(config)
+	require.NoError(t, err)
+
+	retryAfter, isGranted, err := restartedLimiter.reserve(testRateLimitStartTime.Add(2*time.Minute), testRecipientA, testClientIPA)
+	require.NoError(t, err)
+	require.False(t, isGranted)
+	require.Equal(t, testRateLimitWindow-time.Minute, retryAfter)
+
+	_, isGranted, err = restartedLimiter.reserve(testRateLimitStartTime.Add(2*time.Minute), testRecipientB, testClientIPA)
+	require.NoError(t, err)
+	require.True(t, isGranted)
+}
+
+func TestFormatRetryAfter(t *testing.T) {
+	require.Equal(t, "1", formatRetryAfter(0))
+	require.Equal(t, "1", formatRetryAfter(100*time.Millisecond))
+	require.Equal(t, "60", formatRetryAfter(time.Minute))
+	require.Equal(t, "61", formatRetryAfter(time.Minute+time.Millisecond))
+}
+
+// testReservation is a funding request reservation and whether it is expected to be granted.
+type testReservation struct {
+	recipientAddress string
+	clientIP         string
+	isGranted        bool
+}
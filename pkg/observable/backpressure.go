@@ -0,0 +1,97 @@
+package observable
+
+import "time"
+
+// BackpressureStrategy determines what an observer does with a notification
+// when its buffer is full (i.e. its subscriber is not consuming fast enough).
+type BackpressureStrategy int
+
+const (
+	// BackpressureBlock blocks the observable until the subscriber makes room for
+	// the notification. A slow subscriber stalls the fan-out to all the other
+	// observers of the observable. It is the default strategy.
+	BackpressureBlock BackpressureStrategy = iota
+	// BackpressureDropNewest drops the notification being sent, keeping the ones
+	// already buffered.
+	BackpressureDropNewest
+	// BackpressureDropOldest drops the oldest buffered notification to make room
+	// for the one being sent.
+	BackpressureDropOldest
+	// BackpressureBlockWithTimeout blocks the observable until the subscriber
+	// makes room for the notification, or drops it once the timeout is reached.
+	BackpressureBlockWithTimeout
+)
+
+// String returns the name of the backpressure strategy, as used in metric labels.
+func (strategy BackpressureStrategy) String() string {
+	switch strategy {
+	case BackpressureBlock:
+		return "block"
+	case BackpressureDropNewest:
+		return "drop_newest"
+	case BackpressureDropOldest:
+		return "drop_oldest"
+	case BackpressureBlockWithTimeout:
+		return "block_with_timeout"
+	default:
+		return "unknown"
+	}
+}
+
+// DefaultSubscriptionName is the name identifying the subscriptions which were
+// not given one, in the dropped notifications metrics.
+const DefaultSubscriptionName = "unnamed"
+
+// SubscribeConfig holds the settings of a subscription (i.e. of its observer).
+type SubscribeConfig struct {
+	// Name identifies the subscription in the dropped notifications metrics.
+	Name string
+	// Backpressure is the strategy applied when the observer's buffer is full.
+	Backpressure BackpressureStrategy
+	// BackpressureTimeout is how long BackpressureBlockWithTimeout blocks for
+	// before dropping a notification.
+	BackpressureTimeout time.Duration
+}
+
+// SubscribeOption configures a subscription.
+type SubscribeOption func(*SubscribeConfig)
+
+// NewSubscribeConfig returns the subscription settings resulting from applying
+// the given options to the defaults (i.e. an unnamed, blocking subscription).
+func NewSubscribeConfig(opts ...SubscribeOption) SubscribeConfig {
+	config := SubscribeConfig{
+		Name:         DefaultSubscriptionName,
+		Backpressure: BackpressureBlock,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// WithSubscriptionName sets the name identifying the subscription in the
+// dropped notifications metrics.
+func WithSubscriptionName(name string) SubscribeOption {
+	return func(config *SubscribeConfig) {
+		config.Name = name
+	}
+}
+
+// WithBackpressure sets the strategy applied when the observer's buffer is full.
+// Use WithBackpressureTimeout for BackpressureBlockWithTimeout.
+func WithBackpressure(strategy BackpressureStrategy) SubscribeOption {
+	return func(config *SubscribeConfig) {
+		config.Backpressure = strategy
+	}
+}
+
+// WithBackpressureTimeout makes the observer block for at most the given timeout
+// when its buffer is full, dropping the notification afterwards
+// (i.e. BackpressureBlockWithTimeout).
+func WithBackpressureTimeout(timeout time.Duration) SubscribeOption {
+	return func(config *SubscribeConfig) {
+		config.Backpressure = BackpressureBlockWithTimeout
+		config.BackpressureTimeout = timeout
+	}
+}
@@ -15,13 +15,16 @@ type ForEachFn[V any] func(ctx context.Context, src V)
 // notification received from the observable. If the transformFn returns a skip
 // bool of true, the notification is skipped and not emitted to the resulting
 // observable.
+// The given subscribe options configure the subscription to the source observable
+// (e.g. its backpressure strategy, should transformFn be slower than the source).
 func Map[S, D any](
 	ctx context.Context,
 	srcObservable observable.Observable[S],
 	transformFn MapFn[S, D],
+	subscribeOpts ...observable.SubscribeOption,
 ) observable.Observable[D] {
 	dstObservable, dstProducer := NewObservable[D]()
-	srcObserver := srcObservable.Subscribe(ctx)
+	srcObserver := srcObservable.Subscribe(ctx, subscribeOpts...)
 
 	go goMapTransformNotification(
 		ctx,
@@ -94,13 +97,15 @@ func MapParallel[S, D any](
 // MapExpand transforms the given observable by applying the given transformFn to
 // each notification received from the observable, similar to Map; however, the
 // transformFn returns a slice of output notifications for each input notification.
+// The given subscribe options configure the subscription to the source observable.
 func MapExpand[S, D any](
 	ctx context.Context,
 	srcObservable observable.Observable[S],
 	transformFn MapFn[S, []D],
+	subscribeOpts ...observable.SubscribeOption,
 ) observable.Observable[D] {
 	dstObservable, dstPublishCh := NewObservable[D]()
-	srcObserver := srcObservable.Subscribe(ctx)
+	srcObserver := srcObservable.Subscribe(ctx, subscribeOpts...)
 
 	go goMapTransformNotification(
 		ctx,
@@ -123,14 +128,16 @@ func MapExpand[S, D any](
 // observable.
 // The resulting observable will receive the last replayBufferCap
 // number of values published to the source observable before receiving new values.
+// The given subscribe options configure the subscription to the source observable.
 func MapReplay[S, D any](
 	ctx context.Context,
 	replayBufferCap int,
 	srcObservable observable.Observable[S],
 	transformFn MapFn[S, D],
+	subscribeOpts ...observable.SubscribeOption,
 ) observable.ReplayObservable[D] {
 	dstObservable, dstProducer := NewReplayObservable[D](ctx, replayBufferCap)
-	srcObserver := srcObservable.Subscribe(ctx)
+	srcObserver := srcObservable.Subscribe(ctx, subscribeOpts...)
 
 	go goMapTransformNotification(
 		ctx,
@@ -149,10 +156,12 @@ func MapReplay[S, D any](
 // observable, similar to Map; however, ForEach does not publish to a destination
 // observable. ForEach is useful for side effects and is a terminal observable
 // operator.
+// The given subscribe options configure the subscription to the source observable.
 func ForEach[V any](
 	ctx context.Context,
 	srcObservable observable.Observable[V],
 	forEachFn ForEachFn[V],
+	subscribeOpts ...observable.SubscribeOption,
 ) {
 	Map(
 		ctx, srcObservable,
@@ -162,6 +171,7 @@ func ForEach[V any](
 			// No downstream observers; SHOULD always skip.
 			return zeroValue[V](), true
 		},
+		subscribeOpts...,
 	)
 }
 
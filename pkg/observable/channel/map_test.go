@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/pkg/observable"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
 )
 
@@ -74,6 +75,47 @@ func TestMap_Word_BytesToPalindrome(t *testing.T) {
 	}
 }
 
+func TestMap_BackpressureDropNewest_DoesNotStallOtherObservers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	srcObservable, srcPublishCh := channel.NewObservable[int](
+		channel.WithSubscribeBufferSize[int](1),
+	)
+
+	// The slow Map blocks on its first notification until the test is done,
+	// it drops the notifications it can't buffer instead of stalling the source.
+	unblockSlowMap := make(chan struct{})
+	t.Cleanup(func() { close(unblockSlowMap) })
+	channel.Map(ctx, srcObservable,
+		func(_ context.Context, value int) (int, bool) {
+			<-unblockSlowMap
+			return value, false
+		},
+		observable.WithSubscriptionName("slow_map"),
+		observable.WithBackpressure(observable.BackpressureDropNewest),
+	)
+
+	fastObserver := srcObservable.Subscribe(ctx)
+
+	const numValues = 10
+	go func() {
+		for value := range numValues {
+			srcPublishCh <- value
+		}
+	}()
+
+	// The fast observer receives all the values despite the slow Map.
+	for expectedValue := range numValues {
+		select {
+		case value := <-fastObserver.Ch():
+			require.Equal(t, expectedValue, value)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for value %d", expectedValue)
+		}
+	}
+}
+
 // Palindrome is a word that is spelled the same forwards and backwards.
 // It's used as an example of a type that can be mapped from one observable
 // and has no real utility outside of this test.
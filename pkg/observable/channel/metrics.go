@@ -0,0 +1,44 @@
+package channel
+
+import (
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pokt-network/poktroll/pkg/observable"
+)
+
+const (
+	observableSubsystem = "observable"
+
+	notificationsDroppedTotal = "notifications_dropped_total"
+
+	// subscriptionNameLabel is the label identifying the subscription, see:
+	// observable.WithSubscriptionName.
+	subscriptionNameLabel = "subscription"
+	// backpressureLabel is the label identifying the backpressure strategy which
+	// dropped the notification.
+	backpressureLabel = "backpressure"
+)
+
+// NotificationsDroppedTotal is a Counter metric for the number of notifications
+// dropped by the observers' backpressure strategies because their subscribers
+// were not consuming fast enough.
+// It is labeled by the subscription name and the backpressure strategy.
+//
+// Usage:
+// - A steadily increasing count identifies a subscriber which can't keep up with
+// its observable, whose buffer size or processing might need to be revisited.
+var NotificationsDroppedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+	Subsystem: observableSubsystem,
+	Name:      notificationsDroppedTotal,
+	Help:      "Total number of notifications dropped by slow subscribers, labeled by subscription name and backpressure strategy.",
+}, []string{subscriptionNameLabel, backpressureLabel})
+
+// recordDroppedNotification increments the dropped notifications counter of the
+// named subscription.
+func recordDroppedNotification(subscriptionName string, strategy observable.BackpressureStrategy) {
+	NotificationsDroppedTotal.With(
+		subscriptionNameLabel, subscriptionName,
+		backpressureLabel, strategy.String(),
+	).Add(1)
+}
@@ -95,8 +95,12 @@ func WithSubscribeBufferSize[V any](size int) option[V] {
 }
 
 // Subscribe returns an observer which is notified when the publishCh channel
-// receives a value.
-func (obs *channelObservable[V]) Subscribe(ctx context.Context) observable.Observer[V] {
+// receives a value. The given options configure the observer (e.g. its
+// backpressure strategy).
+func (obs *channelObservable[V]) Subscribe(
+	ctx context.Context,
+	opts ...observable.SubscribeOption,
+) observable.Observer[V] {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -110,7 +114,7 @@ func (obs *channelObservable[V]) Subscribe(ctx context.Context) observable.Obser
 
 	// Create a new observer and add it to the list of observers to be notified
 	// when publishCh receives a new value.
-	observer := NewObserver[V](ctx, removeAndCancel, obs.subscribeBufferSize)
+	observer := NewObserver[V](ctx, removeAndCancel, obs.subscribeBufferSize, opts...)
 	obs.add(observer)
 
 	// asynchronously wait for the context to be done and then unsubscribe
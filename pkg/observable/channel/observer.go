@@ -43,16 +43,21 @@ type channelObserver[V any] struct {
 	// isClosed indicates whether the observer has been isClosed. It's set in
 	// unsubscribe; isClosed observers can't be reused.
 	isClosed bool
+	// config holds the subscription settings, notably the backpressure strategy
+	// applied when observerCh is full. Its zero value blocks until there is room.
+	config observable.SubscribeConfig
 }
 
 type UnsubscribeFunc[V any] func(toRemove observable.Observer[V])
 
 // NewObserver creates a new observer with the given context, unsubscribe callback,
-// and channel buffer size. A bufferSize <= 0 falls back to defaultSubscribeBufferSize.
+// channel buffer size and subscription options.
+// A bufferSize <= 0 falls back to defaultSubscribeBufferSize.
 func NewObserver[V any](
 	ctx context.Context,
 	onUnsubscribe UnsubscribeFunc[V],
 	bufferSize int,
+	opts ...observable.SubscribeOption,
 ) *channelObserver[V] {
 	if bufferSize <= 0 {
 		bufferSize = defaultSubscribeBufferSize
@@ -63,6 +68,7 @@ func NewObserver[V any](
 		observerMu:    new(sync.RWMutex),
 		observerCh:    make(chan V, bufferSize),
 		onUnsubscribe: onUnsubscribe,
+		config:        observable.NewSubscribeConfig(opts...),
 	}
 }
 
@@ -114,15 +120,34 @@ func (obsvr *channelObserver[V]) unsubscribe() {
 
 // notify is called by observable to send a msg on the observer's channel.
 // We can't use channelObserver#Ch because it's intended to be a
-// receive-only channel. The channel will block if it is full (determined by the buffer
-// size)
-// if the channel's buffer is full, we will retry after sendRetryInterval/s.
+// receive-only channel. What happens when the channel's buffer is full is
+// determined by the observer's backpressure strategy.
+func (obsvr *channelObserver[V]) notify(value V) {
+	switch obsvr.config.Backpressure {
+	case observable.BackpressureDropNewest:
+		obsvr.notifyOrDropNewest(value)
+	case observable.BackpressureDropOldest:
+		obsvr.notifyOrDropOldest(value)
+	case observable.BackpressureBlockWithTimeout:
+		timeoutTimer := time.NewTimer(obsvr.config.BackpressureTimeout)
+		defer timeoutTimer.Stop()
+		obsvr.notifyBlocking(value, timeoutTimer.C)
+	default:
+		// A nil timeout channel never receives, blocking until the value is sent.
+		obsvr.notifyBlocking(value, nil)
+	}
+}
+
+// notifyBlocking sends the value on the observer's channel, blocking while it is
+// full until timeoutCh receives, in which case the value is dropped.
+// If the channel's buffer is full, we will retry after sendRetryInterval/s.
 // The other half is spent holding the read-lock and waiting for the (full) channel
 // to be ready to receive.
-func (obsvr *channelObserver[V]) notify(value V) {
+func (obsvr *channelObserver[V]) notifyBlocking(value V, timeoutCh <-chan time.Time) {
 	defer obsvr.observerMu.RUnlock() // defer releasing a read lock
 
 	sendRetryTicker := time.NewTicker(sendRetryInterval)
+	defer sendRetryTicker.Stop()
 	for {
 		// observerMu must remain read-locked until the value is sent on observerCh
 		// in the event that it would be isClosed concurrently (i.e. this observer
@@ -141,18 +166,73 @@ func (obsvr *channelObserver[V]) notify(value V) {
 		case obsvr.observerCh <- value:
 			// if observerCh has space in its buffer, the value is written to it
 			return
+		case <-timeoutCh:
+			// the subscriber didn't make room for the value in time, drop it.
+			obsvr.recordDropped()
+			return
 		// if the context isn't done and channel is full (i.e. blocking),
 		// release the read-lock to give write-lockers a turn. This case
 		// continues the loop, re-read-locking and trying again.
 		case <-sendRetryTicker.C:
-			// TODO_IMPROVE: this is where we would implement
-			// some backpressure strategy. It would be good to have a simple fail-
-			// safe strategy that can be used by default; e.g. dropping the oldest
-			// value if its buffer is full.
-
 			// This case implies that the (read) lock was acquired, so it must
 			// be unlocked before continuing the send retry loop.
 			obsvr.observerMu.RUnlock()
 		}
 	}
 }
+
+// notifyOrDropNewest sends the value on the observer's channel without blocking,
+// dropping it if the channel is full.
+func (obsvr *channelObserver[V]) notifyOrDropNewest(value V) {
+	// The read-lock is only held for the duration of a non-blocking send,
+	// which doesn't prevent write-lockers from getting their turn.
+	obsvr.observerMu.RLock()
+	defer obsvr.observerMu.RUnlock()
+
+	if obsvr.isClosed || obsvr.ctx.Err() != nil {
+		return
+	}
+
+	select {
+	case obsvr.observerCh <- value:
+	default:
+		obsvr.recordDropped()
+	}
+}
+
+// notifyOrDropOldest sends the value on the observer's channel without blocking,
+// dropping the oldest buffered values until there is room for it.
+func (obsvr *channelObserver[V]) notifyOrDropOldest(value V) {
+	obsvr.observerMu.RLock()
+	defer obsvr.observerMu.RUnlock()
+
+	if obsvr.isClosed || obsvr.ctx.Err() != nil {
+		return
+	}
+
+	for {
+		select {
+		case obsvr.observerCh <- value:
+			return
+		default:
+		}
+
+		// The channel is full, evict its oldest value. The subscriber may have
+		// received it concurrently, in which case nothing is dropped and the
+		// send is retried.
+		select {
+		case <-obsvr.observerCh:
+			obsvr.recordDropped()
+		default:
+		}
+	}
+}
+
+// recordDropped records a notification dropped by the observer's backpressure strategy.
+func (obsvr *channelObserver[V]) recordDropped() {
+	subscriptionName := obsvr.config.Name
+	if subscriptionName == "" {
+		subscriptionName = observable.DefaultSubscriptionName
+	}
+	recordDroppedNotification(subscriptionName, obsvr.config.Backpressure)
+}
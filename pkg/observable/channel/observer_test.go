@@ -87,3 +87,88 @@ func TestObserver_ConcurrentUnsubscribe(t *testing.T) {
 	require.Equal(t, true, obsvr.isClosed)
 	require.True(t, onUnsubscribeCalled)
 }
+
+func TestObserver_Backpressure(t *testing.T) {
+	tests := []struct {
+		desc           string
+		subscribeOpts  []observable.SubscribeOption
+		expectedValues []int
+	}{
+		{
+			desc:           "drop newest keeps the buffered values",
+			subscribeOpts:  []observable.SubscribeOption{observable.WithBackpressure(observable.BackpressureDropNewest)},
+			expectedValues: []int{1, 2},
+		},
+		{
+			desc:           "drop oldest keeps the most recent values",
+			subscribeOpts:  []observable.SubscribeOption{observable.WithBackpressure(observable.BackpressureDropOldest)},
+			expectedValues: []int{3, 4},
+		},
+		{
+			desc:           "block with timeout drops the values not received in time",
+			subscribeOpts:  []observable.SubscribeOption{observable.WithBackpressureTimeout(10 * time.Millisecond)},
+			expectedValues: []int{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			onUnsubscribe := func(toRemove observable.Observer[int]) {}
+			obsvr := NewObserver[int](ctx, onUnsubscribe, 2, test.subscribeOpts...)
+
+			// Notify more values than the observer's buffer can hold without
+			// receiving any: none of the notifications should block indefinitely.
+			notifyDone := make(chan struct{})
+			go func() {
+				for value := 1; value <= 4; value++ {
+					obsvr.notify(value)
+				}
+				close(notifyDone)
+			}()
+
+			select {
+			case <-notifyDone:
+			case <-time.After(time.Second):
+				t.Fatal("timed out waiting for the notifications to be sent or dropped")
+			}
+
+			obsvr.Unsubscribe()
+
+			var actualValues []int
+			for value := range obsvr.Ch() {
+				actualValues = append(actualValues, value)
+			}
+			require.Equal(t, test.expectedValues, actualValues)
+		})
+	}
+}
+
+func TestObserver_Backpressure_BlockByDefault(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	onUnsubscribe := func(toRemove observable.Observer[int]) {}
+	obsvr := NewObserver[int](ctx, onUnsubscribe, 1)
+
+	obsvr.notify(1)
+
+	// The observer's buffer is full, the next notification blocks until it's received.
+	notifyDone := make(chan struct{})
+	go func() {
+		obsvr.notify(2)
+		close(notifyDone)
+	}()
+
+	select {
+	case <-notifyDone:
+		t.Fatal("expected the notification to block while the observer's buffer is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.Equal(t, 1, <-obsvr.Ch())
+	require.Equal(t, 2, <-obsvr.Ch())
+	<-notifyDone
+}
@@ -121,8 +121,11 @@ func (ro *replayObservable[V]) Last(ctx context.Context, n int) []V {
 // receives a value.
 // It replays the values stored in the replay buffer in the order of their arrival
 // before emitting new values.
-func (ro *replayObservable[V]) Subscribe(ctx context.Context) observable.Observer[V] {
-	return ro.SubscribeFromLatestBufferedOffset(ctx, ro.replayBufferCap)
+func (ro *replayObservable[V]) Subscribe(
+	ctx context.Context,
+	opts ...observable.SubscribeOption,
+) observable.Observer[V] {
+	return ro.SubscribeFromLatestBufferedOffset(ctx, ro.replayBufferCap, opts...)
 }
 
 // SubscribeFromLatestBufferedOffset returns an observer which is initially notified of
@@ -135,9 +138,13 @@ func (ro *replayObservable[V]) Subscribe(ctx context.Context) observable.Observe
 // the observer is notified of all elements in the replayBuffer, starting from the beginning.
 //
 // Passing 0 for offset is equivalent to calling Subscribe() on a non-replay observable.
+//
+// The given options configure the returned observer. Its backpressure strategy
+// applies to both the replayed and the real-time values.
 func (ro *replayObservable[V]) SubscribeFromLatestBufferedOffset(
 	ctx context.Context,
 	endOffset int,
+	opts ...observable.SubscribeOption,
 ) observable.Observer[V] {
 	obs, ch := NewObservable[V]()
 	ctx, cancel := context.WithCancel(ctx)
@@ -179,7 +186,7 @@ func (ro *replayObservable[V]) SubscribeFromLatestBufferedOffset(
 		}
 	}()
 
-	return obs.Subscribe(ctx)
+	return obs.Subscribe(ctx, opts...)
 }
 
 // UnsubscribeAll unsubscribes all observers from the replay observable.
@@ -387,3 +387,41 @@ func accumulateValues[V any](
 		}
 	}
 }
+
+func TestReplayObservable_SubscribeWithBackpressure(t *testing.T) {
+	const (
+		numValues        = 100
+		subscribeBufSize = 50
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	replayObs, replayPublishCh := channel.NewReplayObservable[int](ctx, numValues)
+	for value := range numValues {
+		replayPublishCh <- value
+	}
+	require.Eventually(t, func() bool {
+		return replayObs.GetReplayBufferSize() == numValues
+	}, time.Second, time.Millisecond)
+
+	// Subscribe without receiving: the replayed values which don't fit in the
+	// observer's buffer are dropped, the oldest first.
+	observer := replayObs.Subscribe(ctx,
+		observable.WithBackpressure(observable.BackpressureDropOldest),
+	)
+
+	// Wait for all the buffered values to be replayed.
+	time.Sleep(50 * time.Millisecond)
+
+	var expectedValues []int
+	for value := numValues - subscribeBufSize; value < numValues; value++ {
+		expectedValues = append(expectedValues, value)
+	}
+
+	var actualValues []int
+	for len(observer.Ch()) > 0 {
+		actualValues = append(actualValues, <-observer.Ch())
+	}
+	require.Equal(t, expectedValues, actualValues)
+}
@@ -21,7 +21,9 @@ type ReplayObservable[V any] interface {
 	// the observer is notified of all elements in the replayBuffer, starting from the beginning.
 	//
 	// Passing 0 for offset is equivalent to calling Subscribe() on a non-replay observable.
-	SubscribeFromLatestBufferedOffset(ctx context.Context, offset int) Observer[V]
+	//
+	// The given options configure the subscription (e.g. its backpressure strategy).
+	SubscribeFromLatestBufferedOffset(ctx context.Context, offset int, opts ...SubscribeOption) Observer[V]
 	// Last synchronously returns the last n values from the replay buffer with
 	// LIFO ordering
 	Last(ctx context.Context, n int) []V
@@ -36,7 +38,8 @@ type Observable[V any] interface {
 	// Subscribe returns an observer which is notified when the publishCh channel
 	// receives a value.
 	// The order the values published by the subscription is FIFO.
-	Subscribe(context.Context) Observer[V]
+	// The given options configure the subscription (e.g. its backpressure strategy).
+	Subscribe(ctx context.Context, opts ...SubscribeOption) Observer[V]
 	// UnsubscribeAll unsubscribes and removes all observers from the observable.
 	UnsubscribeAll()
 }
@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Client is a client of the RelayMiner admin API.
+type Client struct {
+	baseUrl    string
+	authToken  string
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the admin API served at the given address
+// (format: 'hostname:port' or 'http://hostname:port'), authenticating its
+// requests with the given bearer token.
+func NewClient(addr string, authToken string) *Client {
+	baseUrl := addr
+	if !strings.Contains(baseUrl, "://") {
+		baseUrl = "http://" + baseUrl
+	}
+
+	return &Client{
+		baseUrl:    strings.TrimSuffix(baseUrl, "/"),
+		authToken:  authToken,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// ListSessions returns the session trees held by the RelayMiner.
+func (c *Client) ListSessions(ctx context.Context) ([]Session, error) {
+	var sessions []Session
+	if err := c.do(ctx, http.MethodGet, SessionsPath, &sessions); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// ListPendingTxs returns the claim and proof txs broadcast by the RelayMiner
+// but not committed yet.
+func (c *Client) ListPendingTxs(ctx context.Context) ([]PendingTx, error) {
+	var pendingTxs []PendingTx
+	if err := c.do(ctx, http.MethodGet, PendingTxsPath, &pendingTxs); err != nil {
+		return nil, err
+	}
+
+	return pendingTxs, nil
+}
+
+// RetrySession retries the failed claim or proof of the given session.
+func (c *Client) RetrySession(ctx context.Context, supplierOperatorAddress, sessionId string) error {
+	retryPath := fmt.Sprintf(
+		RetrySessionPathFmt,
+		url.PathEscape(supplierOperatorAddress),
+		url.PathEscape(sessionId),
+	)
+
+	return c.do(ctx, http.MethodPost, retryPath, nil)
+}
+
+// ReloadConfig reloads the RelayMiner config file and applies its changes to the
+// running RelayMiner.
+func (c *Client) ReloadConfig(ctx context.Context) error {
+	return c.do(ctx, http.MethodPost, ConfigReloadPath, nil)
+}
+
+// do sends a request to the given admin API path and decodes the response body
+// into the given value, unless it is nil.
+func (c *Client) do(ctx context.Context, method, path string, value any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseUrl+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.authToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		var errResp errorResponse
+		body, _ := io.ReadAll(resp.Body)
+		if err := json.Unmarshal(body, &errResp); err != nil || errResp.Error == "" {
+			errResp.Error = strings.TrimSpace(string(body))
+		}
+		return fmt.Errorf("admin API responded with status %d: %s", resp.StatusCode, errResp.Error)
+	}
+
+	if value == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(value)
+}
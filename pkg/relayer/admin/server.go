@@ -0,0 +1,223 @@
+package admin
+
+import (
+	"cmp"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/pokt-network/smt"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/session"
+)
+
+const (
+	// SessionsPath lists the session trees held by the RelayMiner.
+	SessionsPath = "/sessions"
+	// PendingTxsPath lists the claim and proof txs broadcast but not committed yet.
+	PendingTxsPath = "/sessions/pending"
+	// RetrySessionPathFmt retries the failed claim or proof of a session, given
+	// its supplier operator address and session ID.
+	RetrySessionPathFmt = "/sessions/%s/%s/retry"
+	// ConfigReloadPath reloads the RelayMiner config file.
+	ConfigReloadPath = "/config/reload"
+)
+
+// ReloadConfigFn reloads the RelayMiner config file and applies its changes to
+// the running RelayMiner.
+type ReloadConfigFn func(ctx context.Context) error
+
+// Serve starts the admin API server on the given address. Every request must
+// be authenticated with the given bearer token.
+// The server is stopped when the given context is done.
+func Serve(
+	ctx context.Context,
+	logger polylog.Logger,
+	addr string,
+	authToken string,
+	sessionsManager relayer.RelayerSessionsManager,
+	reloadConfig ReloadConfigFn,
+) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{Handler: NewHandler(logger, authToken, sessionsManager, reloadConfig)}
+
+	go func() {
+		// Create a context-specific logger to avoid concurrent access issues
+		logger := logger.With("service", "admin", "endpoint", addr)
+		logger.Info().Msg("serving the admin API")
+		if err := server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error().Err(err).Msg("admin server unexpectedly closed")
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		// Create a context-specific logger to avoid concurrent access issues
+		logger := logger.With("service", "admin", "endpoint", addr)
+		logger.Info().Msg("stopping the admin API server")
+		_ = server.Close()
+	}()
+
+	return nil
+}
+
+// NewHandler returns the handler of the admin API, rejecting the requests not
+// authenticated with the given bearer token.
+func NewHandler(
+	logger polylog.Logger,
+	authToken string,
+	sessionsManager relayer.RelayerSessionsManager,
+	reloadConfig ReloadConfigFn,
+) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+SessionsPath, func(w http.ResponseWriter, req *http.Request) {
+		writeJSON(w, http.StatusOK, listSessions(sessionsManager))
+	})
+	mux.HandleFunc("GET "+PendingTxsPath, func(w http.ResponseWriter, req *http.Request) {
+		writeJSON(w, http.StatusOK, listPendingTxs(sessionsManager))
+	})
+	mux.HandleFunc(
+		"POST "+SessionsPath+"/{supplier_operator_address}/{session_id}/retry",
+		func(w http.ResponseWriter, req *http.Request) {
+			supplierOperatorAddress := req.PathValue("supplier_operator_address")
+			sessionId := req.PathValue("session_id")
+
+			err := sessionsManager.RetrySession(req.Context(), supplierOperatorAddress, sessionId)
+			if err != nil {
+				logger.Warn().Err(err).
+					Str("supplier_operator_address", supplierOperatorAddress).
+					Str("session_id", sessionId).
+					Msg("admin API failed to retry the session")
+				writeJSON(w, retryErrorStatusCode(err), errorResponse{Error: err.Error()})
+				return
+			}
+
+			w.WriteHeader(http.StatusAccepted)
+		},
+	)
+	mux.HandleFunc("POST "+ConfigReloadPath, func(w http.ResponseWriter, req *http.Request) {
+		if err := reloadConfig(req.Context()); err != nil {
+			logger.Warn().Err(err).Msg("admin API failed to reload the config")
+			writeJSON(w, http.StatusUnprocessableEntity, errorResponse{Error: err.Error()})
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return authenticate(authToken, mux)
+}
+
+// authenticate wraps the given handler so that requests lacking the given
+// bearer token are rejected.
+func authenticate(authToken string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestToken, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(requestToken), []byte(authToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeJSON(w, http.StatusUnauthorized, errorResponse{Error: "invalid or missing bearer token"})
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// listSessions returns all the session trees held by the sessions manager,
+// sorted by supplier operator address, session end height and session ID.
+func listSessions(sessionsManager relayer.RelayerSessionsManager) []Session {
+	snapshots := sessionsManager.SessionTreesSnapshots()
+
+	sessions := make([]Session, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		sessions = append(sessions, sessionFromSnapshot(snapshot))
+	}
+
+	slices.SortFunc(sessions, func(a, b Session) int {
+		return cmp.Or(
+			cmp.Compare(a.SupplierOperatorAddress, b.SupplierOperatorAddress),
+			cmp.Compare(a.SessionEndHeight, b.SessionEndHeight),
+			cmp.Compare(a.SessionId, b.SessionId),
+		)
+	})
+
+	return sessions
+}
+
+// listPendingTxs returns the claim and proof txs of the session trees held by
+// the sessions manager which are broadcast but not committed yet.
+func listPendingTxs(sessionsManager relayer.RelayerSessionsManager) []PendingTx {
+	pendingTxs := make([]PendingTx, 0)
+	for _, adminSession := range listSessions(sessionsManager) {
+		switch adminSession.State {
+		case relayer.SessionLifecycleStateClaimPending:
+			pendingTxs = append(pendingTxs, PendingTx{TxType: TxTypeClaim, Session: adminSession})
+		case relayer.SessionLifecycleStateProofPending:
+			pendingTxs = append(pendingTxs, PendingTx{TxType: TxTypeProof, Session: adminSession})
+		}
+	}
+
+	return pendingTxs
+}
+
+// sessionFromSnapshot returns the admin API representation of the given session
+// tree snapshot.
+// The relays and compute units are the ones of the claim once the session tree
+// is flushed, or the ones accumulated so far otherwise.
+func sessionFromSnapshot(snapshot relayer.SessionTreeSnapshot) Session {
+	sessionHeader := snapshot.Tree.GetSessionHeader()
+
+	root := smt.MerkleSumRoot(snapshot.Tree.GetClaimRoot())
+	if root == nil {
+		root = snapshot.Tree.GetSMSTRoot()
+	}
+
+	// Roots that cannot be decoded (e.g. empty trees) are reported as having no relays.
+	numRelays, _ := root.Count()
+	numComputeUnits, _ := root.Sum()
+
+	return Session{
+		SupplierOperatorAddress: snapshot.SupplierOperatorAddress,
+		ServiceId:               sessionHeader.GetServiceId(),
+		ApplicationAddress:      sessionHeader.GetApplicationAddress(),
+		SessionId:               snapshot.SessionID,
+		SessionStartHeight:      sessionHeader.GetSessionStartBlockHeight(),
+		SessionEndHeight:        snapshot.SessionEndHeight,
+		NumRelays:               numRelays,
+		NumComputeUnits:         numComputeUnits,
+		State:                   snapshot.Tree.GetLifecycleState(),
+	}
+}
+
+// retryErrorStatusCode returns the HTTP status code of the given session retry error.
+func retryErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, session.ErrSessionTreeNotFound),
+		errors.Is(err, session.ErrSessionSupplierClientNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, session.ErrSessionTreeNotRetryable),
+		errors.Is(err, session.ErrSessionRetryWindowClosed):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeJSON writes the given value as the JSON body of a response with the
+// given status code.
+func writeJSON(w http.ResponseWriter, statusCode int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,238 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/admin"
+	"github.com/pokt-network/poktroll/pkg/relayer/session"
+	"github.com/pokt-network/poktroll/testutil/mockrelayer"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+const testAuthToken = "test_auth_token"
+
+func TestAdminAPI_Unauthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	sessionsManager := mockrelayer.NewMockRelayerSessionsManager(ctrl)
+
+	server := httptest.NewServer(admin.NewHandler(polyzero.NewLogger(), testAuthToken, sessionsManager, noopReloadConfig))
+	t.Cleanup(server.Close)
+
+	for _, authToken := range []string{"", "wrong_auth_token"} {
+		_, err := admin.NewClient(server.URL, authToken).ListSessions(context.Background())
+		require.ErrorContains(t, err, "status 401")
+	}
+}
+
+func TestAdminAPI_ListSessions(t *testing.T) {
+	supplierOperatorAddress := sample.AccAddressBech32()
+	activeTree := newTestSessionTree(t, supplierOperatorAddress, "session_active", 10, 2)
+	claimPendingTree := newTestSessionTree(t, supplierOperatorAddress, "session_claim_pending", 20, 3)
+	claimPendingTree.SetLifecycleState(relayer.SessionLifecycleStateClaimPending)
+	proofPendingTree := newTestSessionTree(t, supplierOperatorAddress, "session_proof_pending", 30, 1)
+	proofPendingTree.SetLifecycleState(relayer.SessionLifecycleStateProofPending)
+
+	ctrl := gomock.NewController(t)
+	sessionsManager := mockrelayer.NewMockRelayerSessionsManager(ctrl)
+	sessionsManager.EXPECT().
+		SessionTreesSnapshots().
+		Return([]relayer.SessionTreeSnapshot{
+			newTestSnapshot(supplierOperatorAddress, proofPendingTree),
+			newTestSnapshot(supplierOperatorAddress, activeTree),
+			newTestSnapshot(supplierOperatorAddress, claimPendingTree),
+		}).
+		AnyTimes()
+
+	server := httptest.NewServer(admin.NewHandler(polyzero.NewLogger(), testAuthToken, sessionsManager, noopReloadConfig))
+	t.Cleanup(server.Close)
+	adminClient := admin.NewClient(server.URL, testAuthToken)
+
+	sessions, err := adminClient.ListSessions(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+
+	// Sessions are sorted by session end height.
+	require.Equal(t, "session_active", sessions[0].SessionId)
+	require.Equal(t, uint64(2), sessions[0].NumRelays)
+	require.Equal(t, uint64(2), sessions[0].NumComputeUnits)
+	require.Equal(t, relayer.SessionLifecycleStateActive, sessions[0].State)
+	require.Equal(t, "session_claim_pending", sessions[1].SessionId)
+	require.Equal(t, uint64(3), sessions[1].NumRelays)
+	require.Equal(t, relayer.SessionLifecycleStateClaimPending, sessions[1].State)
+	require.Equal(t, "session_proof_pending", sessions[2].SessionId)
+	require.Equal(t, relayer.SessionLifecycleStateProofPending, sessions[2].State)
+
+	pendingTxs, err := adminClient.ListPendingTxs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pendingTxs, 2)
+	require.Equal(t, admin.TxTypeClaim, pendingTxs[0].TxType)
+	require.Equal(t, "session_claim_pending", pendingTxs[0].SessionId)
+	require.Equal(t, admin.TxTypeProof, pendingTxs[1].TxType)
+	require.Equal(t, "session_proof_pending", pendingTxs[1].SessionId)
+}
+
+func TestAdminAPI_RetrySession(t *testing.T) {
+	supplierOperatorAddress := sample.AccAddressBech32()
+
+	tests := []struct {
+		desc               string
+		retryErr           error
+		expectedErrContain string
+	}{
+		{
+			desc: "retry accepted",
+		},
+		{
+			desc:               "unknown session",
+			retryErr:           session.ErrSessionTreeNotFound,
+			expectedErrContain: "status 404",
+		},
+		{
+			desc:               "session not in a failed state",
+			retryErr:           session.ErrSessionTreeNotRetryable,
+			expectedErrContain: "status 409",
+		},
+		{
+			desc:               "claim or proof window closed",
+			retryErr:           session.ErrSessionRetryWindowClosed,
+			expectedErrContain: "status 409",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			sessionsManager := mockrelayer.NewMockRelayerSessionsManager(ctrl)
+			sessionsManager.EXPECT().
+				RetrySession(gomock.Any(), supplierOperatorAddress, "session_failed").
+				Return(test.retryErr).
+				Times(1)
+
+			server := httptest.NewServer(admin.NewHandler(polyzero.NewLogger(), testAuthToken, sessionsManager, noopReloadConfig))
+			t.Cleanup(server.Close)
+
+			err := admin.NewClient(server.URL, testAuthToken).
+				RetrySession(context.Background(), supplierOperatorAddress, "session_failed")
+			if test.expectedErrContain != "" {
+				require.ErrorContains(t, err, test.expectedErrContain)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAdminAPI_MethodNotAllowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	sessionsManager := mockrelayer.NewMockRelayerSessionsManager(ctrl)
+
+	server := httptest.NewServer(admin.NewHandler(polyzero.NewLogger(), testAuthToken, sessionsManager, noopReloadConfig))
+	t.Cleanup(server.Close)
+
+	req, err := http.NewRequest(http.MethodDelete, server.URL+admin.SessionsPath, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+testAuthToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestAdminAPI_ReloadConfig(t *testing.T) {
+	tests := []struct {
+		desc               string
+		reloadErr          error
+		expectedErrContain string
+	}{
+		{
+			desc: "reload applied",
+		},
+		{
+			desc:               "invalid config",
+			reloadErr:          errors.New("invalid config"),
+			expectedErrContain: "status 422: invalid config",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			sessionsManager := mockrelayer.NewMockRelayerSessionsManager(ctrl)
+
+			numReloads := 0
+			reloadConfig := func(context.Context) error {
+				numReloads++
+				return test.reloadErr
+			}
+
+			server := httptest.NewServer(admin.NewHandler(polyzero.NewLogger(), testAuthToken, sessionsManager, reloadConfig))
+			t.Cleanup(server.Close)
+
+			// Unauthenticated requests must not trigger a reload.
+			err := admin.NewClient(server.URL, "wrong_auth_token").ReloadConfig(context.Background())
+			require.ErrorContains(t, err, "status 401")
+			require.Equal(t, 0, numReloads)
+
+			err = admin.NewClient(server.URL, testAuthToken).ReloadConfig(context.Background())
+			require.Equal(t, 1, numReloads)
+			if test.expectedErrContain != "" {
+				require.ErrorContains(t, err, test.expectedErrContain)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// noopReloadConfig is the config reload function of the tests not exercising it.
+func noopReloadConfig(context.Context) error { return nil }
+
+// newTestSessionTree returns an in-memory session tree with the given number of
+// relays, each weighing one compute unit.
+func newTestSessionTree(
+	t *testing.T,
+	supplierOperatorAddress string,
+	sessionId string,
+	sessionEndHeight int64,
+	numRelays int,
+) relayer.SessionTree {
+	t.Helper()
+
+	sessionHeader := &sessiontypes.SessionHeader{
+		SessionId:               sessionId,
+		ApplicationAddress:      sample.AccAddressBech32(),
+		ServiceId:               "svc1",
+		SessionStartBlockHeight: sessionEndHeight - 9,
+		SessionEndBlockHeight:   sessionEndHeight,
+	}
+
+	sessionTree, err := session.NewSessionTree(polyzero.NewLogger(), sessionHeader, supplierOperatorAddress, t.TempDir(), true, session.MinedRelaysWALConfig{})
+	require.NoError(t, err)
+
+	for i := range numRelays {
+		key := []byte{byte(i)}
+		require.NoError(t, sessionTree.Update(key, key, 1))
+	}
+
+	return sessionTree
+}
+
+func newTestSnapshot(supplierOperatorAddress string, sessionTree relayer.SessionTree) relayer.SessionTreeSnapshot {
+	return relayer.SessionTreeSnapshot{
+		SupplierOperatorAddress: supplierOperatorAddress,
+		SessionEndHeight:        sessionTree.GetSessionHeader().GetSessionEndBlockHeight(),
+		SessionID:               sessionTree.GetSessionHeader().GetSessionId(),
+		Tree:                    sessionTree,
+	}
+}
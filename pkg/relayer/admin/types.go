@@ -0,0 +1,41 @@
+// Package admin provides the RelayMiner operator admin API and its client.
+//
+// - Lists the session trees held by the RelayMiner along with their claim/proof lifecycle state
+// - Lists the claim and proof txs broadcast but not committed yet
+// - Retries the failed claim or proof of a session
+package admin
+
+import "github.com/pokt-network/poktroll/pkg/relayer"
+
+const (
+	// TxTypeClaim is the type of the pending txs creating a claim.
+	TxTypeClaim = "claim"
+	// TxTypeProof is the type of the pending txs submitting a proof.
+	TxTypeProof = "proof"
+)
+
+// Session is the admin API representation of a session tree held by the RelayMiner.
+type Session struct {
+	SupplierOperatorAddress string                        `json:"supplier_operator_address"`
+	ServiceId               string                        `json:"service_id"`
+	ApplicationAddress      string                        `json:"application_address"`
+	SessionId               string                        `json:"session_id"`
+	SessionStartHeight      int64                         `json:"session_start_height"`
+	SessionEndHeight        int64                         `json:"session_end_height"`
+	NumRelays               uint64                        `json:"num_relays"`
+	NumComputeUnits         uint64                        `json:"num_compute_units"`
+	State                   relayer.SessionLifecycleState `json:"state"`
+}
+
+// PendingTx is the admin API representation of a claim or proof tx which has
+// been broadcast but not committed yet.
+type PendingTx struct {
+	// TxType is either TxTypeClaim or TxTypeProof.
+	TxType string `json:"tx_type"`
+	Session
+}
+
+// errorResponse is the body of the admin API error responses.
+type errorResponse struct {
+	Error string `json:"error"`
+}
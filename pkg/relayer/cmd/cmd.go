@@ -54,5 +54,8 @@ For help, run: relayminer --help
 
 	cmd.AddCommand(startCmd())
 	cmd.AddCommand(relayCmd())
+	cmd.AddCommand(sessionsCmd())
+	cmd.AddCommand(walCmd())
+	cmd.AddCommand(remoteSignerCmd())
 	return cmd
 }
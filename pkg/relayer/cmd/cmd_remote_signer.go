@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+
+	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/cmd/flags"
+	"github.com/pokt-network/poktroll/cmd/logger"
+	"github.com/pokt-network/poktroll/cmd/signals"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/signer/remote"
+)
+
+var (
+	// flagRemoteSignerListenAddress is the address the signing daemon listens on.
+	flagRemoteSignerListenAddress string
+	// flagRemoteSignerKeysFile is the path of the signing daemon's keys file.
+	flagRemoteSignerKeysFile string
+	// flagRemoteSignerTLSCAFile, flagRemoteSignerTLSCertFile and flagRemoteSignerTLSKeyFile
+	// are the mutual TLS files of the signing daemon, required for tcp:// addresses.
+	flagRemoteSignerTLSCAFile   string
+	flagRemoteSignerTLSCertFile string
+	flagRemoteSignerTLSKeyFile  string
+)
+
+// remoteSignerCmd defines the `remote-signer` subcommand, running the reference
+// signing daemon that the RelayMiner remote_signers delegate signing to.
+func remoteSignerCmd() *cobra.Command {
+	cmdRemoteSigner := &cobra.Command{
+		Use:   "remote-signer --listen-address <address> --keys-file <path>",
+		Short: "Run a signing daemon holding the RelayMiner signing keys",
+		Long: `Run the reference signing daemon holding signing keys on behalf of RelayMiners.
+
+It allows the supplier operator keys to be kept off the RelayMiner hosts: the
+RelayMiners configured with a 'remote_signers' entry pointing to this daemon
+request it to sign the relay responses and the claim and proof transactions.
+
+The keys are loaded from a YAML file, which must only be accessible by its owner,
+mapping each key name to its hex encoded secp256k1 private key:
+
+    supplier1: 2d00ef074d9b51e46886dc9a1df11e7b986611d0f336bdcf1f0adce3e037ec0a
+
+The daemon listens on either:
+- A Unix socket (unix:///<socket_path>), only accessible by the user running it
+- A TCP address (tcp://<host>:<port>), which requires mutual TLS: the RelayMiner
+  client certificates must be signed by the --tls-ca-file certificate authority`,
+		Example: `  $ pocketd relayminer remote-signer --listen-address unix:///run/pocket/signer.sock --keys-file ~/.pocket/signer_keys.yaml
+  $ pocketd relayminer remote-signer --listen-address tcp://0.0.0.0:8900 --keys-file ~/.pocket/signer_keys.yaml \
+      --tls-ca-file ca.crt --tls-cert-file signer.crt --tls-key-file signer.key`,
+		RunE: runRemoteSigner,
+	}
+
+	// Global logger flags
+	// DEV_NOTE: Since the root command runs logger.PreRunESetup(), we need to ensure that the log level and output flags are registered on this subcommand.
+	cmdRemoteSigner.PersistentFlags().StringVar(&logger.LogLevel, cosmosflags.FlagLogLevel, "info", flags.FlagLogLevelUsage)
+	cmdRemoteSigner.PersistentFlags().StringVar(&logger.LogOutput, flags.FlagLogOutput, flags.DefaultLogOutput, flags.FlagLogOutputUsage)
+
+	cmdRemoteSigner.Flags().StringVar(&flagRemoteSignerListenAddress, FlagListenAddress, DefaultFlagListenAddress, FlagListenAddressUsage)
+	cmdRemoteSigner.Flags().StringVar(&flagRemoteSignerKeysFile, FlagKeysFile, DefaultFlagKeysFile, FlagKeysFileUsage)
+	cmdRemoteSigner.Flags().StringVar(&flagRemoteSignerTLSCAFile, FlagTLSCAFile, DefaultFlagTLSFile, FlagTLSCAFileUsage)
+	cmdRemoteSigner.Flags().StringVar(&flagRemoteSignerTLSCertFile, FlagTLSCertFile, DefaultFlagTLSFile, FlagTLSCertFileUsage)
+	cmdRemoteSigner.Flags().StringVar(&flagRemoteSignerTLSKeyFile, FlagTLSKeyFile, DefaultFlagTLSFile, FlagTLSKeyFileUsage)
+
+	_ = cmdRemoteSigner.MarkFlagRequired(FlagListenAddress)
+	_ = cmdRemoteSigner.MarkFlagRequired(FlagKeysFile)
+
+	return cmdRemoteSigner
+}
+
+// runRemoteSigner serves the signing daemon until an exit signal is received.
+func runRemoteSigner(cmd *cobra.Command, _ []string) error {
+	ctx, cancelCtx := context.WithCancel(cmd.Context())
+	defer cancelCtx()
+
+	logger := polylog.Ctx(cmd.Context())
+	signals.GoOnExitSignal(logger, cancelCtx)
+
+	privKeys, err := remote.LoadKeysFile(flagRemoteSignerKeysFile)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not load the signing keys")
+		return err
+	}
+
+	tlsFiles := remote.TLSFiles{
+		CAFile:   flagRemoteSignerTLSCAFile,
+		CertFile: flagRemoteSignerTLSCertFile,
+		KeyFile:  flagRemoteSignerTLSKeyFile,
+	}
+
+	return remote.NewServer(logger, privKeys).Serve(ctx, flagRemoteSignerListenAddress, tlsFiles)
+}
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer/admin"
+	relayerconfig "github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+var (
+	// Custom flags for 'pocketd relayminer sessions' subcommands
+	flagSessionsConfigPath     string // RelayMiner config file path
+	flagSessionsAdminAddr      string // Admin API address
+	flagSessionsAdminAuthToken string // Admin API bearer token
+)
+
+// sessionsCmd defines the `sessions` subcommand for inspecting the sessions of
+// a running RelayMiner through its admin API.
+//
+// - Lists the session trees and their claim/proof lifecycle state
+// - Lists the claim and proof txs broadcast but not committed yet
+// - Retries the failed claim or proof of a session
+func sessionsCmd() *cobra.Command {
+	cmdSessions := &cobra.Command{
+		Use:   "sessions",
+		Short: "Inspect the sessions of a running RelayMiner and retry their failed claims or proofs",
+		Long: `Inspect the sessions of a running RelayMiner through its admin API.
+
+The RelayMiner admin API must be enabled in the RelayMiner config ('admin' section).
+
+The admin API address and auth token are read from:
+- The '--admin-addr' and '--admin-auth-token' flags, if provided
+- The 'admin' section of the RelayMiner config file passed with '--config' otherwise
+
+For more info, run 'sessions --help'.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	cmdSessions.PersistentFlags().StringVar(&flagSessionsConfigPath, FlagConfig, DefaultFlagConfig, FlagSessionsConfigUsage)
+	cmdSessions.PersistentFlags().StringVar(&flagSessionsAdminAddr, FlagAdminAddr, DefaultFlagAdminAddr, FlagAdminAddrUsage)
+	cmdSessions.PersistentFlags().StringVar(&flagSessionsAdminAuthToken, FlagAdminAuthToken, DefaultFlagAdminAuthToken, FlagAdminAuthTokenUsage)
+
+	cmdSessions.AddCommand(sessionsListCmd())
+	cmdSessions.AddCommand(sessionsPendingCmd())
+	cmdSessions.AddCommand(sessionsRetryCmd())
+
+	return cmdSessions
+}
+
+// sessionsListCmd defines the `sessions list` subcommand.
+func sessionsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the sessions held by a running RelayMiner",
+		Long: `List the session trees held by a running RelayMiner along with their
+number of relays, compute units and claim/proof lifecycle state.
+
+Lifecycle states:
+- active: the session accumulates relays
+- claiming: the session waits for its claim window to open
+- claim_pending: the claim tx is broadcast but not committed yet
+- claimed: the claim is committed, the session waits for its proof window to open
+- proof_pending: the proof tx is broadcast but not committed yet
+- claim_failed / proof_failed: the claim / proof failed and can be retried`,
+		Example: `  $ pocketd relayminer sessions list --config ./relayminer_config.yaml`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			adminClient, err := newAdminClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			sessions, err := adminClient.ListSessions(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "SUPPLIER\tSERVICE\tAPPLICATION\tSESSION_ID\tEND_HEIGHT\tRELAYS\tCOMPUTE_UNITS\tSTATE")
+			for _, session := range sessions {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%s\n",
+					session.SupplierOperatorAddress,
+					session.ServiceId,
+					session.ApplicationAddress,
+					session.SessionId,
+					session.SessionEndHeight,
+					session.NumRelays,
+					session.NumComputeUnits,
+					session.State,
+				)
+			}
+
+			return w.Flush()
+		},
+	}
+}
+
+// sessionsPendingCmd defines the `sessions pending` subcommand.
+func sessionsPendingCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "pending",
+		Short:   "List the claim and proof txs broadcast by a running RelayMiner but not committed yet",
+		Example: `  $ pocketd relayminer sessions pending --config ./relayminer_config.yaml`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			adminClient, err := newAdminClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pendingTxs, err := adminClient.ListPendingTxs(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "TX_TYPE\tSUPPLIER\tSERVICE\tSESSION_ID\tEND_HEIGHT\tRELAYS\tCOMPUTE_UNITS")
+			for _, pendingTx := range pendingTxs {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\n",
+					pendingTx.TxType,
+					pendingTx.SupplierOperatorAddress,
+					pendingTx.ServiceId,
+					pendingTx.SessionId,
+					pendingTx.SessionEndHeight,
+					pendingTx.NumRelays,
+					pendingTx.NumComputeUnits,
+				)
+			}
+
+			return w.Flush()
+		},
+	}
+}
+
+// sessionsRetryCmd defines the `sessions retry` subcommand.
+func sessionsRetryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry <supplier_operator_address> <session_id>",
+		Short: "Retry the failed claim or proof of a session",
+		Long: `Retry the failed claim or proof of a session held by a running RelayMiner.
+
+- A session in the 'claim_failed' state goes through the claim and proof submission again
+- A session in the 'proof_failed' state goes through the proof submission again
+
+The retry is rejected if the claim (resp. proof) window of the session is closed.`,
+		Example: `  $ pocketd relayminer sessions retry pokt19a3t4yunp0dlpfjrp7qwnzwlrzd5fzs2gjaaaj <session_id> --config ./relayminer_config.yaml`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			adminClient, err := newAdminClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err = adminClient.RetrySession(cmd.Context(), args[0], args[1]); err != nil {
+				return err
+			}
+
+			fmt.Printf("Retrying session %s of supplier %s\n", args[1], args[0])
+			return nil
+		},
+	}
+}
+
+// newAdminClient returns a client of the RelayMiner admin API using the address
+// and auth token provided as flags, falling back to the RelayMiner config file.
+func newAdminClient(cmd *cobra.Command) (*admin.Client, error) {
+	adminAddr := flagSessionsAdminAddr
+	adminAuthToken := flagSessionsAdminAuthToken
+
+	if flagSessionsConfigPath != "" {
+		configContent, err := os.ReadFile(flagSessionsConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not read config file from %s: %w", flagSessionsConfigPath, err)
+		}
+
+		relayMinerConfig, err := relayerconfig.ParseRelayMinerConfigs(polylog.Ctx(cmd.Context()), configContent)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse config file from %s: %w", flagSessionsConfigPath, err)
+		}
+
+		if adminAddr == "" {
+			adminAddr = relayMinerConfig.Admin.Addr
+		}
+		if adminAuthToken == "" {
+			adminAuthToken = relayMinerConfig.Admin.AuthToken
+		}
+	}
+
+	if adminAddr == "" || adminAuthToken == "" {
+		return nil, fmt.Errorf(
+			"the admin API address and auth token must be provided, either with the --%s and --%s flags or with the --%s flag",
+			FlagAdminAddr, FlagAdminAuthToken, FlagConfig,
+		)
+	}
+
+	return admin.NewClient(adminAddr, adminAuthToken), nil
+}
@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync/atomic"
+	"time"
 
+	"cosmossdk.io/depinject"
 	"github.com/cosmos/cosmos-sdk/client"
 	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -17,6 +20,7 @@ import (
 	"github.com/pokt-network/poktroll/cmd/signals"
 	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/admin"
 	relayerconfig "github.com/pokt-network/poktroll/pkg/relayer/config"
 )
 
@@ -86,7 +90,16 @@ func runRelayer(cmd *cobra.Command, _ []string) error {
 	logger := polylog.Ctx(cmd.Context())
 
 	// --- Signal handling ---
-	signals.GoOnExitSignal(logger, cancelCtx)
+	// SIGHUP reloads the config file instead of stopping the relay miner.
+	var configReloader atomic.Pointer[relayMinerConfigReloader]
+	signals.GoOnExitOrReloadSignal(logger, cancelCtx, func() {
+		reloader := configReloader.Load()
+		if reloader == nil {
+			logger.Warn().Msg("Relay miner is not initialized yet, ignoring config reload")
+			return
+		}
+		reloader.reloadOnSignal()
+	})
 
 	// Read relay miner config file
 	configContent, err := os.ReadFile(relayMinerConfigPath)
@@ -211,6 +224,14 @@ _TODO_TECHDEBT(@olshansky): Delete this banner once v0.1.31 is live_
 		return err
 	}
 
+	// --- Initialize the config reloader ---
+	reloader, err := newRelayMinerConfigReloader(ctx, cmd, deps, relayMinerConfigPath, relayMinerConfig)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not initialize config reloader")
+		return err
+	}
+	configReloader.Store(reloader)
+
 	// --- Serve metrics endpoint if enabled ---
 	if relayMinerConfig.Metrics.Enabled {
 		err = relayMiner.ServeMetrics(relayMinerConfig.Metrics.Addr)
@@ -237,10 +258,64 @@ _TODO_TECHDEBT(@olshansky): Delete this banner once v0.1.31 is live_
 		}
 	}
 
+	// --- Serve admin API if enabled ---
+	if relayMinerConfig.Admin.Enabled {
+		var relayerSessionsManager relayer.RelayerSessionsManager
+		if err = depinject.Inject(deps, &relayerSessionsManager); err != nil {
+			logger.Error().Err(err).Msg("Could not get the relayer sessions manager for the admin endpoint")
+			return err
+		}
+
+		err = admin.Serve(
+			ctx, logger,
+			relayMinerConfig.Admin.Addr,
+			relayMinerConfig.Admin.AuthToken,
+			relayerSessionsManager,
+			reloader.reload,
+		)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not start admin endpoint")
+			return err
+		}
+	}
+
 	// --- Start the relay miner ---
+	return startRelayMinerUntilStopped(ctx, logger, relayMiner, relayMinerConfig)
+}
+
+// stoppableRelayMiner is the subset of the relay miner methods used to run it
+// until it is stopped (e.g. by an exit signal canceling its context).
+type stoppableRelayMiner interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context, stopTimeout, drainTimeout time.Duration) error
+}
+
+// startRelayMinerUntilStopped starts the relay miner and blocks until ctx is
+// canceled (e.g. by an exit signal) or the relay miner fails. It then gracefully
+// stops the relay miner, draining its mining pipeline and persisting its session
+// trees within the shutdown timeouts of the given config.
+func startRelayMinerUntilStopped(
+	ctx context.Context,
+	logger polylog.Logger,
+	relayMiner stoppableRelayMiner,
+	relayMinerConfig *relayerconfig.RelayMinerConfig,
+) error {
 	logger.Info().Msg("Starting relay miner...")
 
-	err = relayMiner.Start(ctx)
+	err := relayMiner.Start(ctx)
+
+	// --- Gracefully stop the relay miner ---
+	// Drain the mining pipeline and persist the session trees, whether the relay
+	// miner stopped on an exit signal or failed.
+	stopErr := relayMiner.Stop(
+		context.WithoutCancel(ctx),
+		relayMinerConfig.ShutdownStopTimeout,
+		relayMinerConfig.ShutdownDrainTimeout,
+	)
+	if stopErr != nil {
+		logger.Warn().Err(stopErr).Msg("Relay miner did not stop gracefully")
+	}
+
 	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		logger.Error().Err(err).Msg("Could not start relay miner")
 		return err
@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	"github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/pokt-network/poktroll/cmd/signals"
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/supplier"
+	"github.com/pokt-network/poktroll/pkg/crypto/protocol"
+	"github.com/pokt-network/poktroll/pkg/observable"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	relayerconfig "github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/pkg/relayer/miner"
+	"github.com/pokt-network/poktroll/pkg/relayer/session"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/mockrelayer"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/testutil/testclient/testblock"
+	"github.com/pokt-network/poktroll/testutil/testclient/testqueryclients"
+	"github.com/pokt-network/poktroll/testutil/testpolylog"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// TestStartRelayMinerUntilStopped_SIGTERMPersistsBufferedRelays runs a relay miner
+// made of a mock relayer proxy, a miner and a relayer sessions manager until it
+// receives a SIGTERM, right after relays were served, and verifies that all of
+// them are recovered from the session trees' WAL on restart.
+func TestStartRelayMinerUntilStopped_SIGTERMPersistsBufferedRelays(t *testing.T) {
+	const numServedRelays = 200
+
+	logger, ctx := testpolylog.NewLoggerWithCtx(context.Background(), polyzero.DebugLevel)
+
+	service := sharedtypes.Service{Id: "svc", ComputeUnitsPerRelay: 1}
+	testqueryclients.AddToExistingServices(t, service)
+	testqueryclients.SetServiceRelayDifficultyTargetHash(t, service.Id, protocol.BaseRelayDifficultyHashBz)
+
+	supplierOperatorAddress := sample.AccAddressBech32()
+	sessionHeader := &sessiontypes.SessionHeader{
+		ApplicationAddress:      sample.AccAddressBech32(),
+		ServiceId:               service.Id,
+		SessionId:               "sessionId",
+		SessionStartBlockHeight: 1,
+		SessionEndBlockHeight:   int64(sharedtypes.DefaultNumBlocksPerSession),
+	}
+
+	storesDirectoryPath := t.TempDir()
+	sessionsManagerDeps := newTestRelayerSessionsManagerDeps(t, logger)
+
+	// Wire the relay miner pipeline as runRelayer does.
+	servedRelaysObs, servedRelaysPublishCh := channel.NewObservable[*servicetypes.Relay]()
+	relayerProxyStartedCh := make(chan struct{})
+	relayerProxy := newTestRelayerProxy(t, relayer.RelaysObservable(servedRelaysObs), servedRelaysPublishCh, relayerProxyStartedCh)
+	relayMiner, err := relayer.NewRelayMiner(ctx, depinject.Supply(
+		relayerProxy,
+		newTestMiner(t, logger),
+		newTestRelayerSessionsManager(t, sessionsManagerDeps, storesDirectoryPath),
+	))
+	require.NoError(t, err)
+
+	// Register a SIGTERM handler before runRelayer's one is set up, so that the
+	// signal sent below can never terminate the test process.
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+	signals.GoOnExitOrReloadSignal(logger, cancelCtx, func() {})
+
+	relayMinerConfig := &relayerconfig.RelayMinerConfig{
+		ShutdownStopTimeout:  5 * time.Second,
+		ShutdownDrainTimeout: 20 * time.Second,
+	}
+	relayMinerErrCh := make(chan error, 1)
+	go func() {
+		relayMinerErrCh <- startRelayMinerUntilStopped(ctx, logger, relayMiner, relayMinerConfig)
+	}()
+
+	select {
+	case <-relayerProxyStartedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the relay miner to start")
+	}
+
+	// Serve relays then terminate the relay miner without waiting for them to be mined.
+	for i := range numServedRelays {
+		servedRelaysPublishCh <- newTestServedRelay(sessionHeader, supplierOperatorAddress, i)
+	}
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+
+	select {
+	case err = <-relayMinerErrCh:
+		require.NoError(t, err)
+	case <-time.After(30 * time.Second):
+		t.Fatal("timed out waiting for the relay miner to stop")
+	}
+
+	// Restart the relayer sessions manager and verify that every served relay was
+	// recovered from the session tree's WAL.
+	restartedSessionsManager := newTestRelayerSessionsManager(t, sessionsManagerDeps, storesDirectoryPath)
+	minedRelaysObs, _ := channel.NewObservable[*relayer.MinedRelay]()
+	restartedSessionsManager.InsertRelays(relayer.MinedRelaysObservable(minedRelaysObs))
+	require.NoError(t, restartedSessionsManager.Start(context.Background()))
+	t.Cleanup(restartedSessionsManager.Stop)
+
+	var sessionTree relayer.SessionTree
+	for _, snapshot := range restartedSessionsManager.SessionTreesSnapshots() {
+		if snapshot.SupplierOperatorAddress == supplierOperatorAddress &&
+			snapshot.SessionID == sessionHeader.SessionId {
+			sessionTree = snapshot.Tree
+		}
+	}
+	require.NotNil(t, sessionTree)
+
+	count, err := sessionTree.GetSMSTRoot().Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(numServedRelays), count)
+}
+
+// newTestRelayerProxy returns a mock RelayerProxy serving the relays published to
+// servedRelaysPublishCh until the context given to Start is done.
+// It closes relayerProxyStartedCh once started.
+func newTestRelayerProxy(
+	t *testing.T,
+	servedRelaysObs relayer.RelaysObservable,
+	servedRelaysPublishCh chan<- *servicetypes.Relay,
+	relayerProxyStartedCh chan struct{},
+) relayer.RelayerProxy {
+	t.Helper()
+
+	relayerProxy := mockrelayer.NewMockRelayerProxy(gomock.NewController(t))
+	relayerProxy.EXPECT().ServedRelays().Return(servedRelaysObs).Times(1)
+	relayerProxy.EXPECT().
+		Start(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			close(relayerProxyStartedCh)
+			<-ctx.Done()
+			return nil
+		}).
+		Times(1)
+	relayerProxy.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
+	relayerProxy.EXPECT().
+		CloseServedRelays().
+		Do(func() { close(servedRelaysPublishCh) }).
+		Times(1)
+	relayerProxy.EXPECT().NumForwardedServedRelays().Return(uint64(0)).AnyTimes()
+	relayerProxy.EXPECT().NumDroppedServedRelays().Return(uint64(0)).AnyTimes()
+
+	return relayerProxy
+}
+
+// newTestMiner returns a miner mining every relay of the test service.
+func newTestMiner(t *testing.T, logger polylog.Logger) relayer.Miner {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	blockClient := mockclient.NewMockBlockClient(ctrl)
+	blockClient.EXPECT().
+		GetChainVersion().
+		DoAndReturn(func() *version.Version {
+			chainVersion, err := version.NewVersion("v0.1.25")
+			require.NoError(t, err)
+			return chainVersion
+		}).
+		AnyTimes()
+
+	deps := depinject.Supply(
+		testqueryclients.NewTestServiceQueryClient(t),
+		mockrelayer.NewMockRelayMeter(ctrl),
+		blockClient,
+		logger,
+	)
+	mnr, err := miner.NewMiner(deps)
+	require.NoError(t, err)
+
+	return mnr
+}
+
+// newTestRelayerSessionsManager returns a relayer sessions manager persisting its
+// session trees in storesDirectoryPath.
+func newTestRelayerSessionsManager(
+	t *testing.T,
+	deps depinject.Config,
+	storesDirectoryPath string,
+) relayer.RelayerSessionsManager {
+	t.Helper()
+
+	relayerSessionsManager, err := session.NewRelayerSessions(deps, session.WithStoresDirectoryPath(storesDirectoryPath))
+	require.NoError(t, err)
+
+	return relayerSessionsManager
+}
+
+// newTestRelayerSessionsManagerDeps returns the dependencies of a relayer sessions
+// manager whose chain is at the first block of the test session, and which manages
+// no supplier so that no claim or proof is submitted.
+func newTestRelayerSessionsManagerDeps(t *testing.T, logger polylog.Logger) depinject.Config {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	blockHash := make([]byte, 32)
+	block := testblock.NewAnyTimesBlock(t, blockHash, 1)
+	blocksObs, _ := channel.NewReplayObservable[client.Block](context.Background(), 1)
+
+	blockClient := mockclient.NewMockBlockClient(ctrl)
+	blockClient.EXPECT().LastBlock(gomock.Any()).Return(block).AnyTimes()
+	blockClient.EXPECT().
+		CommittedBlocksSequence(gomock.Any()).
+		Return(observable.Observable[client.Block](blocksObs)).
+		AnyTimes()
+	blockClient.EXPECT().Close().AnyTimes()
+
+	return depinject.Supply(
+		blockClient,
+		mockclient.NewMockCometRPC(ctrl),
+		supplier.NewSupplierClientMap(),
+		testqueryclients.NewTestSharedQueryClient(t),
+		testqueryclients.NewTestServiceQueryClient(t),
+		mockclient.NewMockProofQueryClient(ctrl),
+		testqueryclients.NewTestBankQueryClientWithBalance(t, 1000000),
+		logger,
+	)
+}
+
+// newTestServedRelay returns the i-th relay served by the given supplier in the
+// given session.
+func newTestServedRelay(
+	sessionHeader *sessiontypes.SessionHeader,
+	supplierOperatorAddress string,
+	i int,
+) *servicetypes.Relay {
+	return &servicetypes.Relay{
+		Req: &servicetypes.RelayRequest{
+			Meta: servicetypes.RelayRequestMetadata{
+				SessionHeader:           sessionHeader,
+				SupplierOperatorAddress: supplierOperatorAddress,
+				Signature:               []byte("application_signature"),
+			},
+			Payload: []byte(fmt.Sprintf("request_%d", i)),
+		},
+		Res: &servicetypes.RelayResponse{
+			Meta: servicetypes.RelayResponseMetadata{
+				SessionHeader:             sessionHeader,
+				SupplierOperatorSignature: []byte("supplier_operator_signature"),
+			},
+			Payload: []byte(fmt.Sprintf("response_%d", i)),
+		},
+	}
+}
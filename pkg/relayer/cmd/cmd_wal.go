@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/pkg/relayer/session"
+)
+
+// walCmd defines the `wal` subcommand for inspecting the mined relays
+// write-ahead logs (WAL) persisted by a RelayMiner.
+//
+// - Reports the entries, total weight and format version of each WAL
+// - Detects the corrupted WALs (e.g. torn writes after a crash)
+func walCmd() *cobra.Command {
+	cmdWAL := &cobra.Command{
+		Use:   "wal",
+		Short: "Inspect and verify the mined relays write-ahead logs of a RelayMiner",
+		Long: `Inspect and verify the mined relays write-ahead logs (WAL) of a RelayMiner.
+
+Each session tree persists its mined relays in a WAL file located at:
+<smt_store_path>/mined_relays/<supplier_operator_address>/<session_id>.wal
+
+The given path is either a WAL file or a directory (e.g. the 'smt_store_path'
+of the RelayMiner config) which is searched, recursively, for WAL files.
+
+The WAL files are only read: a corrupted WAL is truncated (i.e. repaired) by the
+RelayMiner itself when it replays it on restart.
+
+For more info, run 'wal --help'.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	cmdWAL.AddCommand(walInspectCmd())
+	cmdWAL.AddCommand(walVerifyCmd())
+
+	return cmdWAL
+}
+
+// walInspectCmd defines the `wal inspect` subcommand.
+func walInspectCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "inspect <path>",
+		Short: "Report the entries, total weight and corruption of mined relays WALs",
+		Long: `Report, for each mined relays WAL at the given path:
+- Its format version (0 for the legacy WALs with no header nor checksums)
+- Its number of valid entries (i.e. mined relays) and their total weight (compute units)
+- Its valid and total sizes, in bytes
+- The first corrupted entry, if any: the entries following it are not replayed`,
+		Example: `  $ pocketd relayminer wal inspect ~/.pocket/smt
+  $ pocketd relayminer wal inspect ~/.pocket/smt/mined_relays/pokt19a3t4yunp0dlpfjrp7qwnzwlrzd5fzs2gjaaaj/<session_id>.wal`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := scanMinedRelaysLogs(args[0], true)
+			return err
+		},
+	}
+}
+
+// walVerifyCmd defines the `wal verify` subcommand.
+func walVerifyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify <path>",
+		Short: "Verify the integrity of mined relays WALs",
+		Long: `Verify the integrity of each mined relays WAL at the given path.
+
+Exits with an error if any of them is corrupted.`,
+		Example: `  $ pocketd relayminer wal verify ~/.pocket/smt`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			numCorruptedLogs, err := scanMinedRelaysLogs(args[0], false)
+			if err != nil {
+				return err
+			}
+
+			if numCorruptedLogs > 0 {
+				return fmt.Errorf("%d corrupted mined relays WAL(s) found at %s", numCorruptedLogs, args[0])
+			}
+
+			fmt.Println("All mined relays WALs are valid")
+			return nil
+		},
+	}
+}
+
+// scanMinedRelaysLogs scans the mined relays WALs at the given path, printing
+// a report line for each of them, and returns the number of corrupted ones.
+// If detailed is true, the sizes and corruption cause of each WAL are printed too.
+func scanMinedRelaysLogs(path string, detailed bool) (numCorruptedLogs int, err error) {
+	minedRelaysLogFilePaths, err := session.FindMinedRelaysLogs(path)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(minedRelaysLogFilePaths) == 0 {
+		return 0, fmt.Errorf("no mined relays WAL found at %s", path)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if detailed {
+		fmt.Fprintln(w, "FILE\tVERSION\tENTRIES\tTOTAL_WEIGHT\tVALID_BYTES\tSIZE_BYTES\tSTATUS")
+	} else {
+		fmt.Fprintln(w, "FILE\tENTRIES\tTOTAL_WEIGHT\tSTATUS")
+	}
+
+	for _, minedRelaysLogFilePath := range minedRelaysLogFilePaths {
+		report, err := session.ScanMinedRelaysLog(
+			minedRelaysLogFilePath,
+			func(_, _ []byte, _ uint64) error { return nil },
+		)
+		if err != nil {
+			return 0, fmt.Errorf("could not read mined relays WAL %s: %w", minedRelaysLogFilePath, err)
+		}
+
+		status := "ok"
+		if report.IsCorrupted() {
+			numCorruptedLogs++
+			status = "corrupted"
+			if detailed {
+				status = fmt.Sprintf("corrupted: %s", report.CorruptionErr)
+			}
+		}
+
+		if detailed {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%s\n",
+				minedRelaysLogFilePath,
+				report.FormatVersion,
+				report.NumEntries,
+				report.TotalWeight,
+				report.ValidSizeBytes,
+				report.FileSizeBytes,
+				status,
+			)
+		} else {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%s\n",
+				minedRelaysLogFilePath,
+				report.NumEntries,
+				report.TotalWeight,
+				status,
+			)
+		}
+	}
+
+	return numCorruptedLogs, w.Flush()
+}
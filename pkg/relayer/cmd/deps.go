@@ -11,10 +11,13 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/pokt-network/poktroll/cmd/flags"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/client/failover"
 	"github.com/pokt-network/poktroll/pkg/client/query"
 	"github.com/pokt-network/poktroll/pkg/client/query/cache"
 	"github.com/pokt-network/poktroll/pkg/deps/config"
 	relayerconfig "github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/pkg/relayer/session"
 	apptypes "github.com/pokt-network/poktroll/x/application/types"
 	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
@@ -38,9 +41,13 @@ func setupRelayerDependencies(
 	cmd *cobra.Command,
 	relayMinerConfig *relayerconfig.RelayMinerConfig,
 ) (deps depinject.Config, err error) {
-	queryNodeRPCUrl := relayMinerConfig.PocketNode.QueryNodeRPCUrl
-	queryNodeGRPCUrl := relayMinerConfig.PocketNode.QueryNodeGRPCUrl
-	txNodeRPCUrl := relayMinerConfig.PocketNode.TxNodeRPCUrl
+	queryNodeRPCUrls := relayMinerConfig.PocketNode.QueryNodeRPCUrls
+	queryNodeGRPCUrls := relayMinerConfig.PocketNode.QueryNodeGRPCUrls
+	txNodeRPCUrls := relayMinerConfig.PocketNode.TxNodeRPCUrls
+	pocketNodeHealthCheckConfig := failover.HealthCheckConfig{
+		Interval:    relayMinerConfig.PocketNode.HealthCheckInterval,
+		MaxBlockLag: relayMinerConfig.PocketNode.MaxBlockLag,
+	}
 
 	nodeRPCURL, err := cmd.Flags().GetString(cosmosflags.FlagNode)
 	if err != nil {
@@ -52,7 +59,7 @@ func setupRelayerDependencies(
 		return nil, err
 	}
 
-	// Override config file's `QueryNodeGRPCUrl` with `--grpc-addr` flag if specified.
+	// Override config file's `QueryNodeGRPCUrl` and `QueryNodeGRPCUrls` with `--grpc-addr` flag if specified.
 	if nodeGRPCURL != flags.OmittedDefaultFlagValue {
 		if err = cmd.Flags().Set(cosmosflags.FlagGRPC, nodeGRPCURL); err != nil {
 			return nil, err
@@ -62,13 +69,13 @@ func setupRelayerDependencies(
 		if parseErr != nil {
 			return nil, fmt.Errorf("failed to parse grpc query URL: %w", parseErr)
 		}
-		queryNodeGRPCUrl = parsedFlagNodeGRPCUrl
+		queryNodeGRPCUrls = []*url.URL{parsedFlagNodeGRPCUrl}
 	} else {
 		// TODO_TECHDEBT(#1444): Delete this once #1444 is fixed and merged.
-		_ = cmd.Flags().Set(cosmosflags.FlagGRPC, queryNodeGRPCUrl.String())
+		_ = cmd.Flags().Set(cosmosflags.FlagGRPC, queryNodeGRPCUrls[0].String())
 	}
 
-	// Override config file's `QueryNodeUrl` and `txNodeRPCUrl` with `--node` flag if specified.
+	// Override config file's `QueryNodeUrl(s)` and `txNodeRPCUrl(s)` with `--node` flag if specified.
 	if nodeRPCURL != flags.DefaultNodeRPCURL {
 		if err = cmd.Flags().Set(cosmosflags.FlagNode, nodeRPCURL); err != nil {
 			return nil, err
@@ -78,23 +85,46 @@ func setupRelayerDependencies(
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse rpc query URL: %w", err)
 		}
-		queryNodeRPCUrl = parsedFlagNodeRPCUrl
-		txNodeRPCUrl = parsedFlagNodeRPCUrl
+		queryNodeRPCUrls = []*url.URL{parsedFlagNodeRPCUrl}
+		txNodeRPCUrls = []*url.URL{parsedFlagNodeRPCUrl}
 	} else {
 		// TODO_TECHDEBT(#1444): Delete this once #1444 is fixed and merged.
-		_ = cmd.Flags().Set(cosmosflags.FlagNode, queryNodeRPCUrl.String())
+		_ = cmd.Flags().Set(cosmosflags.FlagNode, queryNodeRPCUrls[0].String())
+	}
+
+	// The keys held by remote signers are signed with through the keyrings of
+	// both the query and the tx client contexts.
+	keyringDecorators, err := newRemoteSignersKeyringDecorators(ctx, relayMinerConfig.RemoteSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	// Persist the height up to which the blocks were observed, if configured, so
+	// that the blocks committed while the RelayMiner was not running are backfilled.
+	var blockReplayClientOpts []events.ReplayClientOption
+	if relayMinerConfig.EventsCursorStorePath != "" {
+		eventsCursorStore, cursorStoreErr := events.NewFileHeightCursorStore(relayMinerConfig.EventsCursorStorePath)
+		if cursorStoreErr != nil {
+			return nil, cursorStoreErr
+		}
+		blockReplayClientOpts = append(blockReplayClientOpts, events.WithHeightCursorStore(eventsCursorStore))
 	}
 
 	signingKeyNames := uniqueSigningKeyNames(relayMinerConfig)
 	servicesConfigMap := relayMinerConfig.Servers
 	smtStorePath := relayMinerConfig.SmtStorePath
+	minedRelaysWALConfig := session.MinedRelaysWALConfig{
+		MaxBufferedBytes: relayMinerConfig.MinedRelaysWAL.MaxBufferedBytes,
+		FlushInterval:    relayMinerConfig.MinedRelaysWAL.FlushInterval,
+		WriteQueueSize:   relayMinerConfig.MinedRelaysWAL.WriteQueueSize,
+	}
 
 	supplierFuncs := []config.SupplierFn{
 		config.NewSupplyLoggerFromCtx(ctx),
-		config.NewSupplyCometClientFn(queryNodeRPCUrl),                    // leaf
-		config.NewSupplyBlockClientFn(queryNodeRPCUrl),                    // leaf
-		config.NewSupplyQueryClientContextFn(queryNodeGRPCUrl),            // leaf
-		config.NewSupplyTxClientContextFn(queryNodeGRPCUrl, txNodeRPCUrl), // leaf
+		config.NewSupplyCometClientFn(queryNodeRPCUrls, pocketNodeHealthCheckConfig),                                           // leaf
+		config.NewSupplyBlockClientFn(queryNodeRPCUrls[0], blockReplayClientOpts...),                                           // leaf
+		config.NewSupplyQueryClientContextFn(queryNodeGRPCUrls, pocketNodeHealthCheckConfig, keyringDecorators...),             // leaf
+		config.NewSupplyTxClientContextFn(queryNodeGRPCUrls, txNodeRPCUrls, pocketNodeHealthCheckConfig, keyringDecorators...), // leaf
 
 		// Setup params caches (clear on new sessions).
 		// TODO_TECHDEBT(@red-0ne): Params cache should only be cleared when params change.
@@ -164,7 +194,7 @@ func setupRelayerDependencies(
 		config.NewSupplySupplierClientsFn(signingKeyNames, cosmosflags.GasFlagAuto),
 		config.NewSupplyRelayAuthenticatorFn(signingKeyNames),
 		config.NewSupplyRelayerProxyFn(servicesConfigMap, relayMinerConfig.Ping.Enabled, relayMinerConfig.ServedRelaysBufferSize),
-		config.NewSupplyRelayerSessionsManagerFn(smtStorePath, relayMinerConfig.DisableSMTPersistence),
+		config.NewSupplyRelayerSessionsManagerFn(smtStorePath, relayMinerConfig.DisableSMTPersistence, minedRelaysWALConfig),
 	}
 
 	return config.SupplyConfig(ctx, cmd, supplierFuncs)
@@ -32,7 +32,33 @@ const (
 	FlagConfigUsage   = "(Required) The path to the relayminer config file"
 	DefaultFlagConfig = ""
 
+	FlagSessionsConfigUsage = "(Optional) The path to the relayminer config file to read the admin API address and auth token from"
+
+	FlagAdminAddr        = "admin-addr"
+	FlagAdminAddrUsage   = "(Optional) The address of the RelayMiner admin API (e.g. localhost:8083), overriding the config file one"
+	DefaultFlagAdminAddr = ""
+
+	FlagAdminAuthToken        = "admin-auth-token"
+	FlagAdminAuthTokenUsage   = "(Optional) The bearer token of the RelayMiner admin API, overriding the config file one"
+	DefaultFlagAdminAuthToken = ""
+
 	FlagCount        = "count"
 	FlagCountUsage   = "(Optional) Number of requests to send (default: 1)"
 	DefaultFlagCount = 1
+
+	FlagListenAddress        = "listen-address"
+	FlagListenAddressUsage   = "(Required) The address the signing daemon listens on: unix:///<socket_path> or tcp://<host>:<port>"
+	DefaultFlagListenAddress = ""
+
+	FlagKeysFile        = "keys-file"
+	FlagKeysFileUsage   = "(Required) The path to the YAML file mapping the signing key names to their hex encoded secp256k1 private keys"
+	DefaultFlagKeysFile = ""
+
+	FlagTLSCAFile        = "tls-ca-file"
+	FlagTLSCAFileUsage   = "(Optional) The CA the client certificates are verified against, required for tcp:// listen addresses"
+	FlagTLSCertFile      = "tls-cert-file"
+	FlagTLSCertFileUsage = "(Optional) The certificate presented to the clients, required for tcp:// listen addresses"
+	FlagTLSKeyFile       = "tls-key-file"
+	FlagTLSKeyFileUsage  = "(Optional) The private key of the --tls-cert-file certificate, required for tcp:// listen addresses"
+	DefaultFlagTLSFile   = ""
 )
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/depinject"
+	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/deps/config"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	relayerconfig "github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// signalReloadTimeout bounds the time a SIGHUP triggered config reload waits for
+// the relay servers it stops to complete their in-flight relays.
+const signalReloadTimeout = 30 * time.Second
+
+// supplierClientAdder is implemented by the relayer sessions manager to start the
+// claim/proof lifecycle of the suppliers added by a config reload.
+//
+// DEV_NOTE: It is not part of the relayer.RelayerSessionsManager interface since
+// the pkg/relayer package cannot import pkg/client without an import cycle.
+type supplierClientAdder interface {
+	AddSupplierClient(ctx context.Context, supplierClient client.SupplierClient)
+}
+
+// relayMinerConfigReloader re-reads the RelayMiner config file and applies its
+// changes to the running RelayMiner without restarting it:
+//   - Starts, stops or restarts only the relay servers whose config changed
+//   - Adds or removes the supplier signing keys used to sign relay responses
+//   - Starts the claim/proof lifecycle of the added suppliers
+//
+// The session trees of the running suppliers are left untouched, including the
+// ones of the removed suppliers which still get claimed and proven.
+// The config sections which cannot be applied without a restart (e.g. pocket_node)
+// are reported but not applied.
+type relayMinerConfigReloader struct {
+	// mu serializes the reloads triggered by SIGHUP signals and the admin API.
+	mu sync.Mutex
+
+	// relayMinerCtx is the context of the running RelayMiner, which the supplier
+	// clients added by a reload live in.
+	relayMinerCtx context.Context
+	logger        polylog.Logger
+	cmd           *cobra.Command
+	deps          depinject.Config
+	configPath    string
+
+	// startConfig is the config the RelayMiner was started with, which the
+	// non-reloadable sections of the reloaded configs are compared against.
+	startConfig *relayerconfig.RelayMinerConfig
+	// runningConfig is the last successfully applied config.
+	runningConfig *relayerconfig.RelayMinerConfig
+
+	relayerProxy       relayer.RelayerProxy
+	relayAuthenticator relayer.RelayAuthenticator
+	sessionsManager    supplierClientAdder
+}
+
+// newRelayMinerConfigReloader returns a reloader of the config file at the given
+// path, applying its changes to the RelayMiner components provided by deps.
+func newRelayMinerConfigReloader(
+	relayMinerCtx context.Context,
+	cmd *cobra.Command,
+	deps depinject.Config,
+	configPath string,
+	relayMinerConfig *relayerconfig.RelayMinerConfig,
+) (*relayMinerConfigReloader, error) {
+	reloader := &relayMinerConfigReloader{
+		relayMinerCtx: relayMinerCtx,
+		cmd:           cmd,
+		deps:          deps,
+		configPath:    configPath,
+		startConfig:   relayMinerConfig,
+		runningConfig: relayMinerConfig,
+	}
+
+	var relayerSessionsManager relayer.RelayerSessionsManager
+	if err := depinject.Inject(
+		deps,
+		&reloader.logger,
+		&reloader.relayerProxy,
+		&reloader.relayAuthenticator,
+		&relayerSessionsManager,
+	); err != nil {
+		return nil, err
+	}
+
+	sessionsManager, ok := relayerSessionsManager.(supplierClientAdder)
+	if !ok {
+		return nil, fmt.Errorf("relayer sessions manager %T cannot add suppliers", relayerSessionsManager)
+	}
+	reloader.sessionsManager = sessionsManager
+
+	return reloader, nil
+}
+
+// reloadOnSignal reloads the config on behalf of a SIGHUP signal. Failures are
+// logged since the RelayMiner keeps running with its current config.
+func (r *relayMinerConfigReloader) reloadOnSignal() {
+	ctx, cancel := context.WithTimeout(r.relayMinerCtx, signalReloadTimeout)
+	defer cancel()
+
+	if err := r.reload(ctx); err != nil {
+		r.logger.Error().Err(err).Msg("❌ Could not reload the config, the RelayMiner keeps running with its current config")
+	}
+}
+
+// reload re-reads the config file and applies its changes.
+// The given context bounds the graceful shutdown of the stopped relay servers.
+// Nothing is applied if the config is invalid or references unknown signing keys.
+func (r *relayMinerConfigReloader) reload(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	configContent, err := os.ReadFile(r.configPath)
+	if err != nil {
+		return fmt.Errorf("could not read config file from %s: %w", r.configPath, err)
+	}
+
+	reloadedConfig, err := relayerconfig.ParseRelayMinerConfigs(r.logger, configContent)
+	if err != nil {
+		return fmt.Errorf("could not parse config file from %s: %w", r.configPath, err)
+	}
+
+	if changedSections := relayerconfig.NonReloadableChanges(r.startConfig, reloadedConfig); len(changedSections) > 0 {
+		r.logger.Warn().
+			Str("config_sections", strings.Join(changedSections, ",")).
+			Msg("⚠️ Changed config sections require a RelayMiner restart to be applied")
+	}
+
+	runningSigningKeyNames := uniqueSigningKeyNames(r.runningConfig)
+	reloadedSigningKeyNames := uniqueSigningKeyNames(reloadedConfig)
+	addedSigningKeyNames := slices.DeleteFunc(
+		slices.Clone(reloadedSigningKeyNames),
+		func(signingKeyName string) bool { return slices.Contains(runningSigningKeyNames, signingKeyName) },
+	)
+
+	// Build the supplier clients of the added signing keys before applying anything
+	// since it fails if the signing keys are missing from the keyring.
+	// RelayMiner always uses tx simulation for gas estimation (see setupRelayerDependencies).
+	addedSuppliers, err := config.NewSupplierClients(
+		r.relayMinerCtx,
+		r.deps,
+		r.cmd,
+		addedSigningKeyNames,
+		cosmosflags.GasFlagAuto,
+	)
+	if err != nil {
+		return fmt.Errorf("could not create the supplier clients of the added signing keys: %w", err)
+	}
+
+	if err = r.relayAuthenticator.SetSigningKeyNames(reloadedSigningKeyNames); err != nil {
+		return err
+	}
+
+	if err = r.relayerProxy.UpdateServerConfigs(ctx, reloadedConfig.Servers); err != nil {
+		// Restore the running signing keys to keep them consistent with the running servers.
+		if restoreErr := r.relayAuthenticator.SetSigningKeyNames(runningSigningKeyNames); restoreErr != nil {
+			r.logger.Error().Err(restoreErr).Msg("could not restore the running signing keys")
+		}
+		return err
+	}
+
+	for _, supplierClient := range addedSuppliers.SupplierClients {
+		r.sessionsManager.AddSupplierClient(r.relayMinerCtx, supplierClient)
+	}
+
+	r.runningConfig = reloadedConfig
+
+	r.logger.Info().
+		Int("num_servers", len(reloadedConfig.Servers)).
+		Int("num_signing_keys", len(reloadedSigningKeyNames)).
+		Int("num_added_signing_keys", len(addedSigningKeyNames)).
+		Msg("✅ RelayMiner config reloaded")
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+
+	"github.com/pokt-network/poktroll/pkg/deps/config"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	relayerconfig "github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/pkg/signer/remote"
+)
+
+// newRemoteSignersKeyringDecorators returns the keyring decorators delegating the
+// signing of the keys held by the given remote signers to them, for both the
+// relay responses and the claim and proof transactions.
+//
+// - Returns no decorator if no remote signer is configured
+// - Closes the connections to the remote signers once the context is done
+func newRemoteSignersKeyringDecorators(
+	ctx context.Context,
+	remoteSignersConfig []*relayerconfig.RelayMinerRemoteSignerConfig,
+) ([]config.KeyringDecoratorFn, error) {
+	if len(remoteSignersConfig) == 0 {
+		return nil, nil
+	}
+
+	logger := polylog.Ctx(ctx)
+
+	remoteSignerClients := make([]*remote.Client, 0, len(remoteSignersConfig))
+	remoteKeyClients := make(map[string]*remote.Client)
+	for _, remoteSignerConfig := range remoteSignersConfig {
+		clientConfig := remote.ClientConfig{
+			Address: remoteSignerConfig.Address,
+			Timeout: remoteSignerConfig.Timeout,
+		}
+		if remoteSignerConfig.TLS != nil {
+			clientConfig.TLS = remote.TLSFiles{
+				CAFile:     remoteSignerConfig.TLS.CAFile,
+				CertFile:   remoteSignerConfig.TLS.CertFile,
+				KeyFile:    remoteSignerConfig.TLS.KeyFile,
+				ServerName: remoteSignerConfig.TLS.ServerName,
+			}
+		}
+
+		remoteSignerClient, err := remote.NewClient(clientConfig)
+		if err != nil {
+			closeRemoteSignerClients(logger, remoteSignerClients)
+			return nil, err
+		}
+		remoteSignerClients = append(remoteSignerClients, remoteSignerClient)
+
+		for _, keyName := range remoteSignerConfig.KeyNames {
+			remoteKeyClients[keyName] = remoteSignerClient
+		}
+
+		logger.Info().
+			Str("remote_signer_address", remoteSignerConfig.Address).
+			Str("key_names", strings.Join(remoteSignerConfig.KeyNames, ",")).
+			Msg("signing keys delegated to remote signer")
+	}
+
+	go func() {
+		<-ctx.Done()
+		closeRemoteSignerClients(logger, remoteSignerClients)
+	}()
+
+	return []config.KeyringDecoratorFn{
+		func(kr cosmoskeyring.Keyring) cosmoskeyring.Keyring {
+			return remote.NewKeyring(kr, remoteKeyClients)
+		},
+	}, nil
+}
+
+// closeRemoteSignerClients closes the connections to the given remote signers.
+func closeRemoteSignerClients(logger polylog.Logger, remoteSignerClients []*remote.Client) {
+	for _, remoteSignerClient := range remoteSignerClients {
+		if err := remoteSignerClient.Close(); err != nil {
+			logger.Warn().Err(err).Msg("failed to close the remote signer connection")
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// parseErrorPolicyConfig validates the error_policy sub-section of a service
+// config and returns its hydrated counterpart.
+// The error policy only applies to synchronous relays, it is rejected for any
+// backend url scheme other than "http" and "https".
+func parseErrorPolicyConfig(
+	backendUrl *url.URL,
+	yamlErrorPolicyConfig YAMLRelayMinerErrorPolicyConfig,
+) (*RelayMinerErrorPolicyConfig, error) {
+	if backendUrl.Scheme != "http" && backendUrl.Scheme != "https" {
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"error_policy is only supported by http backends, got backend url scheme %q",
+			backendUrl.Scheme,
+		)
+	}
+
+	rules := make([]*RelayMinerErrorPolicyRule, 0, len(yamlErrorPolicyConfig.Rules))
+	ruleNames := make(map[string]struct{}, len(yamlErrorPolicyConfig.Rules))
+	for ruleIdx, yamlRule := range yamlErrorPolicyConfig.Rules {
+		rule, err := parseErrorPolicyRule(ruleIdx, yamlRule)
+		if err != nil {
+			return nil, err
+		}
+
+		// Rule names label the error policy metrics, they must be unique.
+		if _, ok := ruleNames[rule.Name]; ok {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"duplicate error_policy rule name %q",
+				rule.Name,
+			)
+		}
+		ruleNames[rule.Name] = struct{}{}
+
+		rules = append(rules, rule)
+	}
+
+	return &RelayMinerErrorPolicyConfig{Rules: rules}, nil
+}
+
+// parseErrorPolicyRule validates the entry at the given index of the rules list
+// of an error policy config and returns its hydrated counterpart.
+func parseErrorPolicyRule(
+	ruleIdx int,
+	yamlRule YAMLRelayMinerErrorPolicyRule,
+) (*RelayMinerErrorPolicyRule, error) {
+	name := yamlRule.Name
+	if name == "" {
+		name = fmt.Sprintf("rule_%d", ruleIdx)
+	}
+
+	yamlMatch := yamlRule.Match
+	if len(yamlMatch.StatusCodes) == 0 &&
+		len(yamlMatch.JSONRPCErrorCodes) == 0 &&
+		yamlMatch.BodyPattern == "" {
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"error_policy rule %q requires at least one of status_codes, jsonrpc_error_codes or body_pattern",
+			name,
+		)
+	}
+
+	for _, statusCode := range yamlMatch.StatusCodes {
+		if statusCode < 100 || statusCode > 599 {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"error_policy rule %q has an invalid status code %d",
+				name,
+				statusCode,
+			)
+		}
+	}
+
+	var bodyPattern *regexp.Regexp
+	if yamlMatch.BodyPattern != "" {
+		var err error
+		if bodyPattern, err = regexp.Compile(yamlMatch.BodyPattern); err != nil {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"error_policy rule %q has an invalid body_pattern %q: %s",
+				name,
+				yamlMatch.BodyPattern,
+				err.Error(),
+			)
+		}
+	}
+
+	action := ErrorPolicyAction(yamlRule.Action)
+	switch action {
+	case "":
+		action = ErrorPolicyActionPassThrough
+	case ErrorPolicyActionPassThrough, ErrorPolicyActionRetry:
+	case ErrorPolicyActionMask:
+		// The client does not receive the signed backend response of masked
+		// relays, which therefore cannot be claimed.
+		if yamlRule.RewardEligible != nil && *yamlRule.RewardEligible {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"error_policy rule %q cannot make masked relays reward eligible",
+				name,
+			)
+		}
+	default:
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"error_policy rule %q has an invalid action %q, expected one of %q, %q or %q",
+			name,
+			yamlRule.Action,
+			ErrorPolicyActionPassThrough,
+			ErrorPolicyActionMask,
+			ErrorPolicyActionRetry,
+		)
+	}
+
+	return &RelayMinerErrorPolicyRule{
+		Name:              name,
+		StatusCodes:       yamlMatch.StatusCodes,
+		JSONRPCErrorCodes: yamlMatch.JSONRPCErrorCodes,
+		BodyPattern:       bodyPattern,
+		Action:            action,
+		RewardEligible:    yamlRule.RewardEligible,
+	}, nil
+}
@@ -13,4 +13,7 @@ var (
 	ErrRelayMinerConfigInvalidServer         = sdkerrors.Register(codespace, 2106, "invalid server in RelayMiner config")
 	ErrRelayMinerConfigInvalidRequestTimeout = sdkerrors.Register(codespace, 2107, "invalid request timeout specified in RelayMiner config")
 	ErrRelayMinerConfigInvalidMaxBodySize    = sdkerrors.Register(codespace, 2108, "invalid max body size specified in RelayMiner config")
+	ErrRelayMinerConfigInvalidRateLimiting   = sdkerrors.Register(codespace, 2109, "invalid rate limiting in RelayMiner config")
+	ErrRelayMinerConfigInvalidAdmin          = sdkerrors.Register(codespace, 2110, "invalid admin API in RelayMiner config")
+	ErrRelayMinerConfigInvalidRemoteSigner   = sdkerrors.Register(codespace, 2111, "invalid remote signer in RelayMiner config")
 )
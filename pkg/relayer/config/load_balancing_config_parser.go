@@ -0,0 +1,139 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+// parseSupplierServiceBackends returns the backends of a service config.
+// Either a single backend_url or a list of backends can be configured, but not
+// both. All the backends must share the same URL scheme so that they can be
+// served by the same server type and used interchangeably.
+func parseSupplierServiceBackends(
+	yamlSupplierServiceConfig YAMLRelayMinerSupplierServiceConfig,
+) ([]*RelayMinerSupplierServiceBackend, error) {
+	yamlBackends := yamlSupplierServiceConfig.Backends
+
+	switch {
+	case len(yamlSupplierServiceConfig.BackendUrl) > 0 && len(yamlBackends) > 0:
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrap(
+			"backend_url and backends are mutually exclusive",
+		)
+	case len(yamlBackends) == 0:
+		yamlBackends = []YAMLRelayMinerSupplierServiceBackend{
+			{Url: yamlSupplierServiceConfig.BackendUrl},
+		}
+	}
+
+	backends := make([]*RelayMinerSupplierServiceBackend, 0, len(yamlBackends))
+	seenBackendUrls := make(map[string]struct{}, len(yamlBackends))
+	for _, yamlBackend := range yamlBackends {
+		// Check if the supplier backend url is empty
+		if len(yamlBackend.Url) == 0 {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrap("empty supplier backend url")
+		}
+
+		// Check if the supplier backend url is a valid URL
+		backendUrl, err := url.Parse(yamlBackend.Url)
+		if err != nil {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"invalid supplier backend url %s",
+				err.Error(),
+			)
+		}
+
+		if backendUrl.Scheme == "" {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"missing scheme in supplier backend url %s",
+				yamlBackend.Url,
+			)
+		}
+
+		if len(backends) > 0 && backendUrl.Scheme != backends[0].Url.Scheme {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"backend url %q scheme differs from the other backends scheme %q",
+				yamlBackend.Url,
+				backends[0].Url.Scheme,
+			)
+		}
+
+		if _, ok := seenBackendUrls[backendUrl.String()]; ok {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"duplicate backend url %q",
+				yamlBackend.Url,
+			)
+		}
+		seenBackendUrls[backendUrl.String()] = struct{}{}
+
+		weight := yamlBackend.Weight
+		if weight == 0 {
+			weight = 1
+		}
+
+		backends = append(backends, &RelayMinerSupplierServiceBackend{
+			Url:    backendUrl,
+			Weight: weight,
+		})
+	}
+
+	return backends, nil
+}
+
+// parseLoadBalancingConfig validates the load_balancing sub-section of a service
+// config having numBackends backends and returns its hydrated counterpart,
+// falling back to the defaults for the unset fields.
+func parseLoadBalancingConfig(
+	yamlLoadBalancingConfig YAMLRelayMinerLoadBalancingConfig,
+	numBackends int,
+) (*RelayMinerLoadBalancingConfig, error) {
+	strategy := LoadBalancingStrategy(yamlLoadBalancingConfig.Strategy)
+	switch strategy {
+	case "":
+		strategy = LoadBalancingStrategyRoundRobin
+	case LoadBalancingStrategyRoundRobin,
+		LoadBalancingStrategyWeighted,
+		LoadBalancingStrategyLeastInflight:
+	default:
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"unsupported load balancing strategy %q",
+			strategy,
+		)
+	}
+
+	healthCheckIntervalSeconds := yamlLoadBalancingConfig.HealthCheckIntervalSeconds
+	if healthCheckIntervalSeconds == 0 {
+		healthCheckIntervalSeconds = DefaultBackendHealthCheckIntervalSeconds
+	}
+
+	failureThreshold := yamlLoadBalancingConfig.CircuitBreakerFailureThreshold
+	if failureThreshold == 0 {
+		failureThreshold = DefaultCircuitBreakerFailureThreshold
+	}
+
+	cooldownSeconds := yamlLoadBalancingConfig.CircuitBreakerCooldownSeconds
+	if cooldownSeconds == 0 {
+		cooldownSeconds = DefaultCircuitBreakerCooldownSeconds
+	}
+
+	// Each retry is sent to a different backend, so by default a relay is tried
+	// once on every backend before replying with an error.
+	maxRetries := uint64(numBackends - 1)
+	if yamlLoadBalancingConfig.MaxRetries != nil {
+		maxRetries = *yamlLoadBalancingConfig.MaxRetries
+		if maxRetries >= uint64(numBackends) {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"max_retries (%d) must be lower than the number of backends (%d)",
+				maxRetries,
+				numBackends,
+			)
+		}
+	}
+
+	return &RelayMinerLoadBalancingConfig{
+		Strategy:                       strategy,
+		HealthCheckInterval:            time.Duration(healthCheckIntervalSeconds) * time.Second,
+		CircuitBreakerFailureThreshold: failureThreshold,
+		CircuitBreakerCooldown:         time.Duration(cooldownSeconds) * time.Second,
+		MaxRetries:                     maxRetries,
+	}, nil
+}
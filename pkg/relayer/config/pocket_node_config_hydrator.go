@@ -1,6 +1,9 @@
 package config
 
-import "net/url"
+import (
+	"net/url"
+	"time"
+)
 
 // HydratePocketNodeUrls populates the pocket node fields of the RelayMinerConfig
 // that are relevant to the "pocket_node" section in the config file.
@@ -9,48 +12,103 @@ func (relayMinerConfig *RelayMinerConfig) HydratePocketNodeUrls(
 ) error {
 	relayMinerConfig.PocketNode = &RelayMinerPocketNodeConfig{}
 
-	if len(yamlPocketNodeConfig.TxNodeRPCUrl) == 0 {
+	txNodeRPCUrls, err := parsePocketNodeUrls(
+		"tx node rpc",
+		yamlPocketNodeConfig.TxNodeRPCUrl,
+		yamlPocketNodeConfig.TxNodeRPCUrls,
+	)
+	if err != nil {
+		return err
+	}
+	if len(txNodeRPCUrls) == 0 {
 		return ErrRelayMinerConfigInvalidNodeUrl.Wrap("tx node rpc url is required")
 	}
+	relayMinerConfig.PocketNode.TxNodeRPCUrls = txNodeRPCUrls
 
-	// Check if the pocket node rpc url is a valid URL
-	txNodeRPCUrl, err := url.Parse(yamlPocketNodeConfig.TxNodeRPCUrl)
+	queryNodeRPCUrls, err := parsePocketNodeUrls(
+		"query node rpc",
+		yamlPocketNodeConfig.QueryNodeRPCUrl,
+		yamlPocketNodeConfig.QueryNodeRPCUrls,
+	)
 	if err != nil {
-		return ErrRelayMinerConfigInvalidNodeUrl.Wrapf(
-			"invalid tx node rpc url %s",
-			err.Error(),
-		)
-	}
-	relayMinerConfig.PocketNode.TxNodeRPCUrl = txNodeRPCUrl
-
-	// If the query node rpc url is empty, use the tx node rpc url
-	if len(yamlPocketNodeConfig.QueryNodeRPCUrl) == 0 {
-		relayMinerConfig.PocketNode.QueryNodeRPCUrl = relayMinerConfig.PocketNode.TxNodeRPCUrl
-	} else {
-		// If the query node rpc url is not empty, make sure it is a valid URL
-		queryNodeRPCUrl, parseErr := url.Parse(yamlPocketNodeConfig.QueryNodeRPCUrl)
-		if parseErr != nil {
-			return ErrRelayMinerConfigInvalidNodeUrl.Wrapf(
-				"invalid query node rpc url %s",
-				parseErr.Error(),
-			)
-		}
-		relayMinerConfig.PocketNode.QueryNodeRPCUrl = queryNodeRPCUrl
+		return err
 	}
+	// If no query node rpc url is provided, use the tx node rpc urls
+	if len(queryNodeRPCUrls) == 0 {
+		queryNodeRPCUrls = txNodeRPCUrls
+	}
+	relayMinerConfig.PocketNode.QueryNodeRPCUrls = queryNodeRPCUrls
 
-	if len(yamlPocketNodeConfig.QueryNodeGRPCUrl) == 0 {
+	queryNodeGRPCUrls, err := parsePocketNodeUrls(
+		"query node grpc",
+		yamlPocketNodeConfig.QueryNodeGRPCUrl,
+		yamlPocketNodeConfig.QueryNodeGRPCUrls,
+	)
+	if err != nil {
+		return err
+	}
+	if len(queryNodeGRPCUrls) == 0 {
 		return ErrRelayMinerConfigInvalidNodeUrl.Wrap("query node grpc url is required")
 	}
+	relayMinerConfig.PocketNode.QueryNodeGRPCUrls = queryNodeGRPCUrls
 
-	// Check if the query node grpc url is a valid URL
-	queryNodeGRPCUrl, err := url.Parse(yamlPocketNodeConfig.QueryNodeGRPCUrl)
-	if err != nil {
-		return ErrRelayMinerConfigInvalidNodeUrl.Wrapf(
-			"invalid query node grpc url %s",
-			err.Error(),
-		)
+	// The single URL fields hold the preferred endpoint of each role.
+	relayMinerConfig.PocketNode.TxNodeRPCUrl = txNodeRPCUrls[0]
+	relayMinerConfig.PocketNode.QueryNodeRPCUrl = queryNodeRPCUrls[0]
+	relayMinerConfig.PocketNode.QueryNodeGRPCUrl = queryNodeGRPCUrls[0]
+
+	healthCheckIntervalSeconds := yamlPocketNodeConfig.HealthCheckIntervalSeconds
+	if healthCheckIntervalSeconds == 0 {
+		healthCheckIntervalSeconds = DefaultPocketNodeHealthCheckIntervalSeconds
+	}
+	relayMinerConfig.PocketNode.HealthCheckInterval = time.Duration(healthCheckIntervalSeconds) * time.Second
+
+	maxBlockLag := yamlPocketNodeConfig.MaxBlockLag
+	if maxBlockLag == 0 {
+		maxBlockLag = DefaultPocketNodeMaxBlockLag
 	}
-	relayMinerConfig.PocketNode.QueryNodeGRPCUrl = queryNodeGRPCUrl
+	relayMinerConfig.PocketNode.MaxBlockLag = int64(maxBlockLag)
 
 	return nil
 }
+
+// parsePocketNodeUrls parses the endpoints of a pocket node role: its single URL,
+// if any, followed by its list of URLs.
+func parsePocketNodeUrls(role string, nodeUrl string, nodeUrls []string) ([]*url.URL, error) {
+	rawUrls := make([]string, 0, len(nodeUrls)+1)
+	if len(nodeUrl) != 0 {
+		rawUrls = append(rawUrls, nodeUrl)
+	}
+	rawUrls = append(rawUrls, nodeUrls...)
+
+	parsedUrls := make([]*url.URL, 0, len(rawUrls))
+	seenUrls := make(map[string]struct{}, len(rawUrls))
+	for _, rawUrl := range rawUrls {
+		if len(rawUrl) == 0 {
+			return nil, ErrRelayMinerConfigInvalidNodeUrl.Wrapf("empty %s url", role)
+		}
+
+		// Check if the pocket node url is a valid URL
+		parsedUrl, err := url.Parse(rawUrl)
+		if err != nil {
+			return nil, ErrRelayMinerConfigInvalidNodeUrl.Wrapf(
+				"invalid %s url %s",
+				role,
+				err.Error(),
+			)
+		}
+
+		if _, isDuplicate := seenUrls[parsedUrl.String()]; isDuplicate {
+			return nil, ErrRelayMinerConfigInvalidNodeUrl.Wrapf(
+				"duplicate %s url %q",
+				role,
+				parsedUrl.Redacted(),
+			)
+		}
+		seenUrls[parsedUrl.String()] = struct{}{}
+
+		parsedUrls = append(parsedUrls, parsedUrl)
+	}
+
+	return parsedUrls, nil
+}
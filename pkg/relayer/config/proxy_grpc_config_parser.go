@@ -0,0 +1,66 @@
+package config
+
+import (
+	"net"
+	"net/url"
+)
+
+// parseGRPCServerConfig populates the server fields of the target structure that
+// are relevant to the "grpc" type.
+// This function alters the target RelayMinerServerConfig structure as a side effect.
+func (serverConfig *RelayMinerServerConfig) parseGRPCServerConfig(
+	yamlSupplierConfig YAMLRelayMinerSupplierConfig,
+) error {
+	listenUrl, err := url.Parse(yamlSupplierConfig.ListenUrl)
+	if err != nil {
+		return ErrRelayMinerConfigInvalidServer.Wrapf(
+			"invalid relay miner server listen address %s",
+			err.Error(),
+		)
+	}
+
+	// Unlike http, grpc has no well-known default port: require an explicit one.
+	if _, port, splitErr := net.SplitHostPort(listenUrl.Host); splitErr != nil || port == "" {
+		return ErrRelayMinerConfigInvalidServer.Wrapf(
+			"grpc relay miner server listen address %q must be of the form host:port",
+			listenUrl.Host,
+		)
+	}
+
+	serverConfig.ListenAddress = listenUrl.Host
+	return nil
+}
+
+// ensureCompatibleSupplierBackends ensures that all the backends of the given
+// supplier can be proxied to by the server: gRPC servers frame gRPC calls, which
+// only gRPC backends can serve, while http servers forward http payloads which
+// gRPC backends cannot serve.
+func (serverConfig *RelayMinerServerConfig) ensureCompatibleSupplierBackends(
+	supplierConfig *RelayMinerSupplierConfig,
+) error {
+	isGRPCServer := serverConfig.ServerType == RelayMinerServerTypeGRPC
+
+	serviceConfigs := []*RelayMinerSupplierServiceConfig{supplierConfig.ServiceConfig}
+	for _, rpcTypeServiceConfig := range supplierConfig.RPCTypeServiceConfigs {
+		serviceConfigs = append(serviceConfigs, rpcTypeServiceConfig)
+	}
+
+	for _, serviceConfig := range serviceConfigs {
+		if IsGRPCBackendUrl(serviceConfig.BackendUrl) != isGRPCServer {
+			return ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"backend url %q of service %q cannot be served by the server listening on %q",
+				serviceConfig.BackendUrl.String(),
+				supplierConfig.ServiceId,
+				serverConfig.ListenAddress,
+			)
+		}
+	}
+
+	return nil
+}
+
+// IsGRPCBackendUrl returns true if the given backend url points to a gRPC
+// service backend (i.e. "grpc" for plaintext or "grpcs" for TLS).
+func IsGRPCBackendUrl(backendUrl *url.URL) bool {
+	return backendUrl.Scheme == "grpc" || backendUrl.Scheme == "grpcs"
+}
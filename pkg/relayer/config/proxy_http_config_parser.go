@@ -27,27 +27,26 @@ func (serverConfig *RelayMinerServerConfig) parseHTTPServerConfig(
 }
 
 // parseSupplierBackendUrl populates the supplier fields of the target structure
-// that are relevant to "http" and "https" backend url service configurations.
+// that are relevant to "http" and "https" backend url service configurations,
+// given the service config backends parsed by parseSupplierServiceBackends.
 // This function alters the target RelayMinerSupplierServiceConfig structure
 // as a side effect.
 func (supplierServiceConfig *RelayMinerSupplierServiceConfig) parseSupplierBackendUrl(
 	yamlSupplierServiceConfig YAMLRelayMinerSupplierServiceConfig,
+	backends []*RelayMinerSupplierServiceBackend,
 ) error {
-	// Check if the supplier backend url is empty
-	if len(yamlSupplierServiceConfig.BackendUrl) == 0 {
-		return ErrRelayMinerConfigInvalidSupplier.Wrap("empty supplier backend url")
-	}
-
-	// Check if the supplier backend url is a valid URL
-	supplierServiceBackendUrl, err := url.Parse(yamlSupplierServiceConfig.BackendUrl)
+	loadBalancingConfig, err := parseLoadBalancingConfig(
+		yamlSupplierServiceConfig.LoadBalancing,
+		len(backends),
+	)
 	if err != nil {
-		return ErrRelayMinerConfigInvalidSupplier.Wrapf(
-			"invalid supplier backend url %s",
-			err.Error(),
-		)
+		return err
 	}
 
+	supplierServiceBackendUrl := backends[0].Url
 	supplierServiceConfig.BackendUrl = supplierServiceBackendUrl
+	supplierServiceConfig.Backends = backends
+	supplierServiceConfig.LoadBalancing = loadBalancingConfig
 	supplierServiceConfig.ForwardPocketHeaders = yamlSupplierServiceConfig.ForwardPocketHeaders
 
 	// If the Authentication section is not empty, populate the supplier service
@@ -64,5 +63,42 @@ func (supplierServiceConfig *RelayMinerSupplierServiceConfig) parseSupplierBacke
 		supplierServiceConfig.Headers = yamlSupplierServiceConfig.Headers
 	}
 
+	// If the Metering section is not empty, populate the websocket metering fields
+	if yamlSupplierServiceConfig.Metering != (YAMLRelayMinerWebsocketMeteringConfig{}) {
+		meteringConfig, err := parseWebsocketMeteringConfig(
+			supplierServiceBackendUrl,
+			yamlSupplierServiceConfig.Metering,
+		)
+		if err != nil {
+			return err
+		}
+		supplierServiceConfig.Metering = meteringConfig
+	}
+
+	// If the ResponseCache section is not empty, populate the response cache fields
+	if yamlSupplierServiceConfig.ResponseCache.MaxSize != "" ||
+		len(yamlSupplierServiceConfig.ResponseCache.Rules) > 0 {
+		responseCacheConfig, err := parseResponseCacheConfig(
+			supplierServiceBackendUrl,
+			yamlSupplierServiceConfig.ResponseCache,
+		)
+		if err != nil {
+			return err
+		}
+		supplierServiceConfig.ResponseCache = responseCacheConfig
+	}
+
+	// If the ErrorPolicy section is not empty, populate the error policy fields
+	if len(yamlSupplierServiceConfig.ErrorPolicy.Rules) > 0 {
+		errorPolicyConfig, err := parseErrorPolicyConfig(
+			supplierServiceBackendUrl,
+			yamlSupplierServiceConfig.ErrorPolicy,
+		)
+		if err != nil {
+			return err
+		}
+		supplierServiceConfig.ErrorPolicy = errorPolicyConfig
+	}
+
 	return nil
 }
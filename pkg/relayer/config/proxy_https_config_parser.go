@@ -0,0 +1,106 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+// parseHTTPSServerConfig populates the server fields of the target structure that
+// are relevant to the "https" type, in addition to the ones populated by
+// parseHTTPServerConfig.
+// This function alters the target RelayMinerServerConfig structure as a side effect.
+func (serverConfig *RelayMinerServerConfig) parseHTTPSServerConfig(
+	yamlSupplierConfig YAMLRelayMinerSupplierConfig,
+) error {
+	yamlTLSConfig := yamlSupplierConfig.TLS
+
+	// Both the certificate and its private key are required to terminate TLS.
+	if yamlTLSConfig.CertFile == "" || yamlTLSConfig.KeyFile == "" {
+		return ErrRelayMinerConfigInvalidServer.Wrapf(
+			"both tls.cert_file and tls.key_file are required for https listen url %q",
+			yamlSupplierConfig.ListenUrl,
+		)
+	}
+
+	// Fail early if any of the files cannot be accessed. Their content is
+	// validated by the server when it loads them.
+	for _, filePath := range []string{
+		yamlTLSConfig.CertFile,
+		yamlTLSConfig.KeyFile,
+		yamlTLSConfig.ClientCAFile,
+	} {
+		if filePath == "" {
+			continue
+		}
+		if _, err := os.Stat(filePath); err != nil {
+			return ErrRelayMinerConfigInvalidServer.Wrapf(
+				"unable to access tls file %q: %s",
+				filePath, err.Error(),
+			)
+		}
+	}
+
+	// Requiring a client certificate without any CA to verify it against
+	// would reject every gateway.
+	if yamlTLSConfig.RequireClientCert && yamlTLSConfig.ClientCAFile == "" {
+		return ErrRelayMinerConfigInvalidServer.Wrapf(
+			"tls.require_client_cert is set but tls.client_ca_file is empty for listen url %q",
+			yamlSupplierConfig.ListenUrl,
+		)
+	}
+
+	serverConfig.TLS = &RelayMinerServerTLSConfig{
+		CertFile:          yamlTLSConfig.CertFile,
+		KeyFile:           yamlTLSConfig.KeyFile,
+		ClientCAFile:      yamlTLSConfig.ClientCAFile,
+		RequireClientCert: yamlTLSConfig.RequireClientCert,
+		ReloadInterval:    getTLSReloadInterval(yamlTLSConfig),
+	}
+
+	return nil
+}
+
+// ensureCompatibleTLSConfig returns an error if the TLS section of a supplier
+// sharing this server's listen url contradicts the server's TLS configuration.
+// Suppliers which omit the TLS section inherit the server's one, which is resolved
+// from any supplier sharing the listen url, regardless of their order
+// (see getTLSSupplierConfigs).
+func (serverConfig *RelayMinerServerConfig) ensureCompatibleTLSConfig(
+	yamlSupplierConfig YAMLRelayMinerSupplierConfig,
+) error {
+	yamlTLSConfig := yamlSupplierConfig.TLS
+	if yamlTLSConfig == (YAMLRelayMinerServerTLSConfig{}) {
+		return nil
+	}
+
+	if serverConfig.TLS == nil {
+		return ErrRelayMinerConfigInvalidServer.Wrapf(
+			"tls section provided for non-https listen url %q",
+			yamlSupplierConfig.ListenUrl,
+		)
+	}
+
+	if yamlTLSConfig.CertFile != serverConfig.TLS.CertFile ||
+		yamlTLSConfig.KeyFile != serverConfig.TLS.KeyFile ||
+		yamlTLSConfig.ClientCAFile != serverConfig.TLS.ClientCAFile ||
+		yamlTLSConfig.RequireClientCert != serverConfig.TLS.RequireClientCert ||
+		getTLSReloadInterval(yamlTLSConfig) != serverConfig.TLS.ReloadInterval {
+		return ErrRelayMinerConfigInvalidServer.Wrapf(
+			"conflicting tls sections for listen url %q",
+			yamlSupplierConfig.ListenUrl,
+		)
+	}
+
+	return nil
+}
+
+// getTLSReloadInterval returns the certificate reload interval of the given TLS
+// section, falling back to DefaultTLSReloadIntervalSeconds when it is omitted.
+func getTLSReloadInterval(yamlTLSConfig YAMLRelayMinerServerTLSConfig) time.Duration {
+	reloadIntervalSeconds := yamlTLSConfig.ReloadIntervalSeconds
+	if reloadIntervalSeconds == 0 {
+		reloadIntervalSeconds = DefaultTLSReloadIntervalSeconds
+	}
+
+	return time.Duration(reloadIntervalSeconds) * time.Second
+}
@@ -0,0 +1,76 @@
+package config
+
+import "maps"
+
+// parseRateLimitingConfig validates a rate limiting section and returns its
+// hydrated counterpart.
+// The limits left unset are inherited from the given defaults, if any, and
+// the application overrides are merged with the default ones, the section's
+// taking precedence.
+func parseRateLimitingConfig(
+	yamlRateLimitingConfig YAMLRelayMinerRateLimitingConfig,
+	defaultRateLimitingConfig *RelayMinerRateLimitingConfig,
+) (*RelayMinerRateLimitingConfig, error) {
+	rateLimitingConfig := &RelayMinerRateLimitingConfig{
+		ApplicationOverrides: make(map[string]RelayMinerRateLimit),
+	}
+	if defaultRateLimitingConfig != nil {
+		rateLimitingConfig.PerApplication = defaultRateLimitingConfig.PerApplication
+		rateLimitingConfig.PerGateway = defaultRateLimitingConfig.PerGateway
+		maps.Copy(rateLimitingConfig.ApplicationOverrides, defaultRateLimitingConfig.ApplicationOverrides)
+	}
+
+	if yamlRateLimitingConfig.PerApplication != nil {
+		rateLimit, err := parseRateLimit(*yamlRateLimitingConfig.PerApplication, "per_application")
+		if err != nil {
+			return nil, err
+		}
+		rateLimitingConfig.PerApplication = rateLimit
+	}
+
+	if yamlRateLimitingConfig.PerGateway != nil {
+		rateLimit, err := parseRateLimit(*yamlRateLimitingConfig.PerGateway, "per_gateway")
+		if err != nil {
+			return nil, err
+		}
+		rateLimitingConfig.PerGateway = rateLimit
+	}
+
+	for appAddress, yamlRateLimit := range yamlRateLimitingConfig.ApplicationOverrides {
+		if len(appAddress) == 0 {
+			return nil, ErrRelayMinerConfigInvalidRateLimiting.Wrap(
+				"empty application address in application_overrides",
+			)
+		}
+
+		rateLimit, err := parseRateLimit(yamlRateLimit, "application_overrides."+appAddress)
+		if err != nil {
+			return nil, err
+		}
+		rateLimitingConfig.ApplicationOverrides[appAddress] = rateLimit
+	}
+
+	return rateLimitingConfig, nil
+}
+
+// parseRateLimit validates the rate limit found at the given path of a rate
+// limiting section and returns its hydrated counterpart.
+func parseRateLimit(yamlRateLimit YAMLRelayMinerRateLimit, path string) (RelayMinerRateLimit, error) {
+	if yamlRateLimit.Burst > 0 && yamlRateLimit.RequestsPerSecond == 0 {
+		return RelayMinerRateLimit{}, ErrRelayMinerConfigInvalidRateLimiting.Wrapf(
+			"%s: burst requires requests_per_second to be set",
+			path,
+		)
+	}
+
+	burst := yamlRateLimit.Burst
+	if burst == 0 {
+		burst = yamlRateLimit.RequestsPerSecond
+	}
+
+	return RelayMinerRateLimit{
+		RequestsPerSecond:     yamlRateLimit.RequestsPerSecond,
+		Burst:                 burst,
+		MaxConcurrentRequests: yamlRateLimit.MaxConcurrentRequests,
+	}, nil
+}
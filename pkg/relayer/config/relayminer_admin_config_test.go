@@ -0,0 +1,98 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// baseAdminConfig is a minimal valid RelayMiner config whose admin section is
+// provided by each test case.
+const baseAdminConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+%s
+suppliers:
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: http://anvil:8545
+`
+
+func Test_ParseRelayMinerConfigs_Admin(t *testing.T) {
+	tests := []struct {
+		desc      string
+		adminYAML string
+
+		expectedErr   error
+		expectedAdmin *config.RelayMinerAdminConfig
+	}{
+		{
+			desc:          "valid: no admin API",
+			expectedAdmin: &config.RelayMinerAdminConfig{},
+		},
+		{
+			desc: "valid: disabled admin API without addr nor auth_token",
+			adminYAML: `
+admin:
+  enabled: false
+`,
+			expectedAdmin: &config.RelayMinerAdminConfig{},
+		},
+		{
+			desc: "valid: enabled admin API",
+			adminYAML: `
+admin:
+  enabled: true
+  addr: localhost:8083
+  auth_token: secret
+`,
+			expectedAdmin: &config.RelayMinerAdminConfig{
+				Enabled:   true,
+				Addr:      "localhost:8083",
+				AuthToken: "secret",
+			},
+		},
+		{
+			desc: "invalid: enabled admin API without addr",
+			adminYAML: `
+admin:
+  enabled: true
+  auth_token: secret
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidAdmin,
+		},
+		{
+			desc: "invalid: enabled admin API without auth_token",
+			adminYAML: `
+admin:
+  enabled: true
+  addr: localhost:8083
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidAdmin,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(fmt.Sprintf(baseAdminConfig, test.adminYAML))
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedAdmin, cfg.Admin)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// RelayMinerServerConfigsDiff lists the listen urls (i.e. the RelayMinerConfig.Servers
+// keys) of the relay servers which differ between a running and a reloaded RelayMiner config.
+type RelayMinerServerConfigsDiff struct {
+	// Added are the listen urls of the servers only present in the reloaded config.
+	Added []string
+	// Removed are the listen urls of the servers only present in the running config.
+	Removed []string
+	// Changed are the listen urls of the servers present in both configs
+	// but whose configuration differs.
+	Changed []string
+}
+
+// IsEmpty returns true if the running and reloaded relay servers configs are the same.
+func (diff RelayMinerServerConfigsDiff) IsEmpty() bool {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Changed) == 0
+}
+
+// DiffServerConfigs returns the relay servers that have to be started, stopped
+// or restarted to go from the running to the reloaded servers configs.
+//
+// The supplier signing key names are not part of the comparison since they are
+// not used by the relay servers but by the relay authenticator: changing them
+// does not require restarting a relay server.
+func DiffServerConfigs(
+	runningServerConfigs map[string]*RelayMinerServerConfig,
+	reloadedServerConfigs map[string]*RelayMinerServerConfig,
+) RelayMinerServerConfigsDiff {
+	diff := RelayMinerServerConfigsDiff{}
+
+	for listenUrl, reloadedServerConfig := range reloadedServerConfigs {
+		runningServerConfig, ok := runningServerConfigs[listenUrl]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, listenUrl)
+		case !reflect.DeepEqual(
+			withoutSigningKeyNames(runningServerConfig),
+			withoutSigningKeyNames(reloadedServerConfig),
+		):
+			diff.Changed = append(diff.Changed, listenUrl)
+		}
+	}
+
+	for listenUrl := range runningServerConfigs {
+		if _, ok := reloadedServerConfigs[listenUrl]; !ok {
+			diff.Removed = append(diff.Removed, listenUrl)
+		}
+	}
+
+	// Sort the listen urls to make the diff (and its logging) deterministic.
+	slices.Sort(diff.Added)
+	slices.Sort(diff.Removed)
+	slices.Sort(diff.Changed)
+
+	return diff
+}
+
+// NonReloadableChanges returns the names of the RelayMiner config sections which
+// differ between the running and the reloaded configs but cannot be applied
+// without restarting the RelayMiner.
+//
+// The relay servers (i.e. suppliers and their services) and the supplier signing
+// keys are the only sections applied by a config reload.
+func NonReloadableChanges(runningConfig, reloadedConfig *RelayMinerConfig) []string {
+	sections := map[string][2]any{
+		"pocket_node":                    {runningConfig.PocketNode, reloadedConfig.PocketNode},
+		"smt_store_path":                 {runningConfig.SmtStorePath, reloadedConfig.SmtStorePath},
+		"disable_smt_persistence":        {runningConfig.DisableSMTPersistence, reloadedConfig.DisableSMTPersistence},
+		"mined_relays_wal":               {runningConfig.MinedRelaysWAL, reloadedConfig.MinedRelaysWAL},
+		"metrics":                        {runningConfig.Metrics, reloadedConfig.Metrics},
+		"pprof":                          {runningConfig.Pprof, reloadedConfig.Pprof},
+		"ping":                           {runningConfig.Ping, reloadedConfig.Ping},
+		"admin":                          {runningConfig.Admin, reloadedConfig.Admin},
+		"enable_over_servicing":          {runningConfig.EnableOverServicing, reloadedConfig.EnableOverServicing},
+		"served_relays_buffer_size":      {runningConfig.ServedRelaysBufferSize, reloadedConfig.ServedRelaysBufferSize},
+		"mining_pipeline_buffer_size":    {runningConfig.MiningPipelineBufferSize, reloadedConfig.MiningPipelineBufferSize},
+		"mining_workers":                 {runningConfig.MiningWorkers, reloadedConfig.MiningWorkers},
+		"shutdown_stop_timeout_seconds":  {runningConfig.ShutdownStopTimeout, reloadedConfig.ShutdownStopTimeout},
+		"shutdown_drain_timeout_seconds": {runningConfig.ShutdownDrainTimeout, reloadedConfig.ShutdownDrainTimeout},
+		"remote_signers":                 {runningConfig.RemoteSigners, reloadedConfig.RemoteSigners},
+		"events_cursor_store_path":       {runningConfig.EventsCursorStorePath, reloadedConfig.EventsCursorStorePath},
+	}
+
+	changedSections := make([]string, 0)
+	for _, section := range slices.Sorted(maps.Keys(sections)) {
+		if !reflect.DeepEqual(sections[section][0], sections[section][1]) {
+			changedSections = append(changedSections, section)
+		}
+	}
+
+	return changedSections
+}
+
+// withoutSigningKeyNames returns a copy of the given server config whose
+// suppliers have no signing key names.
+func withoutSigningKeyNames(serverConfig *RelayMinerServerConfig) *RelayMinerServerConfig {
+	serverConfigCopy := *serverConfig
+	serverConfigCopy.SupplierConfigsMap = make(map[string]*RelayMinerSupplierConfig, len(serverConfig.SupplierConfigsMap))
+	for serviceId, supplierConfig := range serverConfig.SupplierConfigsMap {
+		supplierConfigCopy := *supplierConfig
+		supplierConfigCopy.SigningKeyNames = nil
+		serverConfigCopy.SupplierConfigsMap[serviceId] = &supplierConfigCopy
+	}
+
+	return &serverConfigCopy
+}
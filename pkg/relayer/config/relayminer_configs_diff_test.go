@@ -0,0 +1,216 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// baseDiffConfig is a minimal valid RelayMiner config whose top level sections
+// and suppliers are provided by each test case.
+const baseDiffConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+%s
+suppliers:
+%s
+`
+
+// runningDiffSuppliers are the suppliers of the running config of every test case.
+const runningDiffSuppliers = `
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: http://anvil:8545
+  - service_id: svc2
+    listen_url: http://127.0.0.1:8081
+    service_config:
+      backend_url: http://ollama:8080
+`
+
+func Test_DiffServerConfigs(t *testing.T) {
+	tests := []struct {
+		desc              string
+		reloadedSuppliers string
+
+		expectedDiff config.RelayMinerServerConfigsDiff
+	}{
+		{
+			desc:              "unchanged servers",
+			reloadedSuppliers: runningDiffSuppliers,
+			expectedDiff:      config.RelayMinerServerConfigsDiff{},
+		},
+		{
+			desc: "added server",
+			reloadedSuppliers: runningDiffSuppliers + `
+  - service_id: svc3
+    listen_url: http://127.0.0.1:8082
+    service_config:
+      backend_url: http://anvil:8545
+`,
+			expectedDiff: config.RelayMinerServerConfigsDiff{
+				Added: []string{"http://127.0.0.1:8082"},
+			},
+		},
+		{
+			desc: "removed server",
+			reloadedSuppliers: `
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: http://anvil:8545
+`,
+			expectedDiff: config.RelayMinerServerConfigsDiff{
+				Removed: []string{"http://127.0.0.1:8081"},
+			},
+		},
+		{
+			desc: "changed backend url",
+			reloadedSuppliers: `
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: http://anvil:8546
+  - service_id: svc2
+    listen_url: http://127.0.0.1:8081
+    service_config:
+      backend_url: http://ollama:8080
+`,
+			expectedDiff: config.RelayMinerServerConfigsDiff{
+				Changed: []string{"http://127.0.0.1:8080"},
+			},
+		},
+		{
+			desc: "service added to a running server",
+			reloadedSuppliers: runningDiffSuppliers + `
+  - service_id: svc3
+    listen_url: http://127.0.0.1:8081
+    service_config:
+      backend_url: http://anvil:8545
+`,
+			expectedDiff: config.RelayMinerServerConfigsDiff{
+				Changed: []string{"http://127.0.0.1:8081"},
+			},
+		},
+		{
+			desc: "rotated signing key does not restart the server",
+			reloadedSuppliers: `
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    signing_key_names: [supplier2]
+    service_config:
+      backend_url: http://anvil:8545
+  - service_id: svc2
+    listen_url: http://127.0.0.1:8081
+    service_config:
+      backend_url: http://ollama:8080
+`,
+			expectedDiff: config.RelayMinerServerConfigsDiff{},
+		},
+		{
+			desc: "added, removed and changed servers",
+			reloadedSuppliers: `
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: http://anvil:8546
+  - service_id: svc2
+    listen_url: http://127.0.0.1:8082
+    service_config:
+      backend_url: http://ollama:8080
+`,
+			expectedDiff: config.RelayMinerServerConfigsDiff{
+				Added:   []string{"http://127.0.0.1:8082"},
+				Removed: []string{"http://127.0.0.1:8081"},
+				Changed: []string{"http://127.0.0.1:8080"},
+			},
+		},
+	}
+
+	runningConfig := parseDiffConfig(t, "", runningDiffSuppliers)
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			reloadedConfig := parseDiffConfig(t, "", test.reloadedSuppliers)
+
+			diff := config.DiffServerConfigs(runningConfig.Servers, reloadedConfig.Servers)
+			require.Equal(t, test.expectedDiff, diff)
+			require.Equal(t, len(test.expectedDiff.Added)+len(test.expectedDiff.Removed)+len(test.expectedDiff.Changed) == 0, diff.IsEmpty())
+		})
+	}
+}
+
+func Test_NonReloadableChanges(t *testing.T) {
+	tests := []struct {
+		desc              string
+		reloadedSections  string
+		reloadedSuppliers string
+
+		expectedChangedSections []string
+	}{
+		{
+			desc:                    "unchanged config",
+			reloadedSuppliers:       runningDiffSuppliers,
+			expectedChangedSections: []string{},
+		},
+		{
+			desc: "reloadable changes only",
+			reloadedSections: `
+default_request_timeout_seconds: 30
+enable_eager_relay_request_validation: true
+`,
+			reloadedSuppliers: `
+  - service_id: svc3
+    listen_url: http://127.0.0.1:8082
+    signing_key_names: [supplier2]
+    service_config:
+      backend_url: http://anvil:8545
+`,
+			expectedChangedSections: []string{},
+		},
+		{
+			desc: "non-reloadable changes",
+			reloadedSections: `
+metrics:
+  enabled: true
+  addr: localhost:9090
+mining_workers: 4
+`,
+			reloadedSuppliers:       runningDiffSuppliers,
+			expectedChangedSections: []string{"metrics", "mining_workers"},
+		},
+	}
+
+	runningConfig := parseDiffConfig(t, "", runningDiffSuppliers)
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			reloadedConfig := parseDiffConfig(t, test.reloadedSections, test.reloadedSuppliers)
+
+			changedSections := config.NonReloadableChanges(runningConfig, reloadedConfig)
+			require.Equal(t, test.expectedChangedSections, changedSections)
+		})
+	}
+}
+
+// parseDiffConfig parses the base diff config with the given top level sections
+// and suppliers.
+func parseDiffConfig(t *testing.T, sections, suppliers string) *config.RelayMinerConfig {
+	t.Helper()
+
+	normalized := yaml.NormalizeYAMLIndentation(fmt.Sprintf(baseDiffConfig, sections, suppliers))
+
+	relayMinerConfig, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+	require.NoError(t, err)
+
+	return relayMinerConfig
+}
@@ -30,6 +30,64 @@ const DefaultServedRelaysBufferSize uint64 = 1_000
 // the mining pipeline. Matches the historical hardcoded subscribe buffer.
 const DefaultMiningPipelineBufferSize uint64 = 50
 
+// DefaultShutdownStopTimeoutSeconds is the fallback duration the RelayMiner waits
+// on shutdown for the relays being served to complete.
+const DefaultShutdownStopTimeoutSeconds uint64 = 30
+
+// DefaultShutdownDrainTimeoutSeconds is the fallback duration the RelayMiner waits
+// on shutdown for the mining pipeline to be drained into the session trees.
+const DefaultShutdownDrainTimeoutSeconds uint64 = 30
+
+// DefaultRemoteSignerTimeoutSeconds is the fallback duration a request to a
+// remote signer may take before failing.
+const DefaultRemoteSignerTimeoutSeconds uint64 = 5
+
+// DefaultTLSReloadIntervalSeconds is the fallback interval at which "https" servers
+// check their certificate, key and client CA files for rotation.
+const DefaultTLSReloadIntervalSeconds uint64 = 60
+
+// DefaultBackendHealthCheckIntervalSeconds is the fallback interval at which the
+// backends of a service config with more than one backend are health checked.
+const DefaultBackendHealthCheckIntervalSeconds uint64 = 10
+
+// DefaultPocketNodeHealthCheckIntervalSeconds is the fallback interval at which the
+// pocket node endpoints are health and lag checked when more than one is configured.
+const DefaultPocketNodeHealthCheckIntervalSeconds uint64 = 10
+
+// DefaultPocketNodeMaxBlockLag is the fallback number of blocks a pocket node
+// endpoint may lag behind the most advanced one before it is failed over.
+const DefaultPocketNodeMaxBlockLag uint64 = 3
+
+// DefaultCircuitBreakerFailureThreshold is the fallback number of consecutive
+// failed requests after which a backend is taken out of rotation.
+const DefaultCircuitBreakerFailureThreshold uint64 = 5
+
+// DefaultCircuitBreakerCooldownSeconds is the fallback duration a backend is
+// taken out of rotation for after reaching the circuit breaker failure threshold.
+const DefaultCircuitBreakerCooldownSeconds uint64 = 30
+
+// DefaultResponseCacheMaxSize is the fallback maximum cumulative size of the
+// responses cached by a service config with a response_cache section.
+const DefaultResponseCacheMaxSize = "10MB"
+
+// DefaultResponseCacheFinalityDepth is the fallback number of blocks a block
+// must be below the chain head for the responses referencing it to be cached.
+// It covers the reorgs of the most common EVM chains (e.g. Ethereum finalizes
+// after 2 epochs of 32 blocks).
+const DefaultResponseCacheFinalityDepth uint64 = 64
+
+// DefaultMinedRelaysWALMaxBufferedBytes is the fallback size of the in-memory
+// buffer of mined relays above which it is flushed to the write-ahead log.
+const DefaultMinedRelaysWALMaxBufferedBytes uint64 = 10_000_000
+
+// DefaultMinedRelaysWALFlushIntervalSeconds is the fallback cadence at which the
+// in-memory buffer of mined relays is flushed to the write-ahead log.
+const DefaultMinedRelaysWALFlushIntervalSeconds uint64 = 10
+
+// DefaultMinedRelaysWALWriteQueueSize is the fallback number of flushed buffers
+// waiting to be written to the write-ahead log.
+const DefaultMinedRelaysWALWriteQueueSize uint64 = 100
+
 // DefaultMinedRelaysStorePath is the default path for the mined relays storage.
 // It is used when the deprecated :memory: or :memory_pebble: values are found in the config.
 const DefaultMinedRelaysStorePath = ".pocket/smt"
@@ -112,10 +170,32 @@ func ParseRelayMinerConfigs(logger polylog.Logger, configContent []byte) (*Relay
 			Msg("Deprecated smt_store_path value detected. Using default persistent storage path. Please update your config file.")
 	}
 
+	// EventsCursorStorePath is optional: the block events height cursor is not
+	// persisted when unset.
+	relayMinerConfig.EventsCursorStorePath = yamlRelayMinerConfig.EventsCursorStorePath
+
 	// DisableSmtPersistence controls whether the SMT Write-Ahead Log (WAL) and
 	// recovery mechanisms are disabled.
 	relayMinerConfig.DisableSMTPersistence = yamlRelayMinerConfig.DisableSMTPersistence
 
+	// Mined relays WAL thresholds. Fall back to the historical hardcoded values
+	// when unset (0) so existing configs behave identically.
+	minedRelaysWAL := yamlRelayMinerConfig.MinedRelaysWAL
+	if minedRelaysWAL.MaxBufferedBytes == 0 {
+		minedRelaysWAL.MaxBufferedBytes = DefaultMinedRelaysWALMaxBufferedBytes
+	}
+	if minedRelaysWAL.FlushIntervalSeconds == 0 {
+		minedRelaysWAL.FlushIntervalSeconds = DefaultMinedRelaysWALFlushIntervalSeconds
+	}
+	if minedRelaysWAL.WriteQueueSize == 0 {
+		minedRelaysWAL.WriteQueueSize = DefaultMinedRelaysWALWriteQueueSize
+	}
+	relayMinerConfig.MinedRelaysWAL = &RelayMinerMinedRelaysWALConfig{
+		MaxBufferedBytes: int(minedRelaysWAL.MaxBufferedBytes),
+		FlushInterval:    time.Duration(minedRelaysWAL.FlushIntervalSeconds) * time.Second,
+		WriteQueueSize:   int(minedRelaysWAL.WriteQueueSize),
+	}
+
 	// EnableOverServicing is a flag that indicates whether the relay miner
 	// should enable over-servicing for the relays it serves.
 	//
@@ -147,6 +227,24 @@ func ParseRelayMinerConfigs(logger polylog.Logger, configContent []byte) (*Relay
 	// 0 means "auto" (GOMAXPROCS); resolved at miner construction time.
 	relayMinerConfig.MiningWorkers = int(yamlRelayMinerConfig.MiningWorkers)
 
+	if yamlRelayMinerConfig.ShutdownStopTimeoutSeconds == 0 {
+		yamlRelayMinerConfig.ShutdownStopTimeoutSeconds = DefaultShutdownStopTimeoutSeconds
+	}
+	relayMinerConfig.ShutdownStopTimeout = time.Duration(yamlRelayMinerConfig.ShutdownStopTimeoutSeconds) * time.Second
+
+	if yamlRelayMinerConfig.ShutdownDrainTimeoutSeconds == 0 {
+		yamlRelayMinerConfig.ShutdownDrainTimeoutSeconds = DefaultShutdownDrainTimeoutSeconds
+	}
+	relayMinerConfig.ShutdownDrainTimeout = time.Duration(yamlRelayMinerConfig.ShutdownDrainTimeoutSeconds) * time.Second
+
+	// Rate limiting is disabled unless configured, either for all the suppliers
+	// or for some of them.
+	defaultRateLimiting, err := parseRateLimitingConfig(yamlRelayMinerConfig.DefaultRateLimiting, nil)
+	if err != nil {
+		return nil, err
+	}
+	relayMinerConfig.DefaultRateLimiting = defaultRateLimiting
+
 	// No additional validation on metrics. The server would fail to start if they are invalid
 	// which is the intended behaviour.
 	relayMinerConfig.Metrics = &RelayMinerMetricsConfig{
@@ -164,6 +262,29 @@ func ParseRelayMinerConfigs(logger polylog.Logger, configContent []byte) (*Relay
 		Addr:    yamlRelayMinerConfig.Ping.Addr,
 	}
 
+	// The admin API can retry claims and proofs, so it requires an address and
+	// an auth token to be explicitly configured.
+	if yamlRelayMinerConfig.Admin.Enabled {
+		if yamlRelayMinerConfig.Admin.Addr == "" {
+			return nil, ErrRelayMinerConfigInvalidAdmin.Wrap("admin addr is required when the admin API is enabled")
+		}
+		if yamlRelayMinerConfig.Admin.AuthToken == "" {
+			return nil, ErrRelayMinerConfigInvalidAdmin.Wrap("admin auth_token is required when the admin API is enabled")
+		}
+	}
+	relayMinerConfig.Admin = &RelayMinerAdminConfig{
+		Enabled:   yamlRelayMinerConfig.Admin.Enabled,
+		Addr:      yamlRelayMinerConfig.Admin.Addr,
+		AuthToken: yamlRelayMinerConfig.Admin.AuthToken,
+	}
+
+	// The remote signers hold the signing keys which are not in the keyring.
+	remoteSigners, err := parseRemoteSignersConfig(yamlRelayMinerConfig.RemoteSigners)
+	if err != nil {
+		return nil, err
+	}
+	relayMinerConfig.RemoteSigners = remoteSigners
+
 	// Hydrate the pocket node urls
 	if err := relayMinerConfig.HydratePocketNodeUrls(&yamlRelayMinerConfig.PocketNode); err != nil {
 		return nil, err
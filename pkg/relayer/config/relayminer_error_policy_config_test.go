@@ -0,0 +1,220 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// baseErrorPolicyConfig is a minimal valid RelayMiner config whose default
+// service config backend and error_policy sections are provided by each test case.
+const baseErrorPolicyConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+suppliers:
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: %s
+%s`
+
+// expectedErrorPolicyRule is the expected hydrated error policy rule, whose
+// body pattern is compared by its source.
+type expectedErrorPolicyRule struct {
+	name              string
+	statusCodes       []int
+	jsonRPCErrorCodes []int64
+	bodyPattern       string
+	action            config.ErrorPolicyAction
+	rewardEligible    *bool
+}
+
+func Test_ParseRelayMinerConfigs_ErrorPolicy(t *testing.T) {
+	rewardEligible := true
+	rewardIneligible := false
+
+	tests := []struct {
+		desc            string
+		backendUrl      string
+		errorPolicyYAML string
+
+		expectedErr   error
+		expectedRules []expectedErrorPolicyRule
+	}{
+		{
+			desc:          "valid: no error policy",
+			backendUrl:    "http://anvil:8545",
+			expectedRules: nil,
+		},
+		{
+			desc:       "valid: rules with defaults",
+			backendUrl: "http://anvil:8545",
+			errorPolicyYAML: `
+      error_policy:
+        rules:
+          - match:
+              status_codes: [429]
+            action: retry
+          - name: execution_reverted
+            match:
+              jsonrpc_error_codes: [3, -32000]
+              body_pattern: "(?i)execution reverted"
+            reward_eligible: true
+          - name: internal_errors
+            match:
+              status_codes: [500, 502]
+            action: mask
+            reward_eligible: false
+`,
+			expectedRules: []expectedErrorPolicyRule{
+				{
+					name:        "rule_0",
+					statusCodes: []int{429},
+					action:      config.ErrorPolicyActionRetry,
+				},
+				{
+					name:              "execution_reverted",
+					jsonRPCErrorCodes: []int64{3, -32000},
+					bodyPattern:       "(?i)execution reverted",
+					action:            config.ErrorPolicyActionPassThrough,
+					rewardEligible:    &rewardEligible,
+				},
+				{
+					name:           "internal_errors",
+					statusCodes:    []int{500, 502},
+					action:         config.ErrorPolicyActionMask,
+					rewardEligible: &rewardIneligible,
+				},
+			},
+		},
+		{
+			desc:       "invalid: rule without match criteria",
+			backendUrl: "http://anvil:8545",
+			errorPolicyYAML: `
+      error_policy:
+        rules:
+          - action: mask
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: unknown action",
+			backendUrl: "http://anvil:8545",
+			errorPolicyYAML: `
+      error_policy:
+        rules:
+          - match:
+              status_codes: [503]
+            action: ignore
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: status code out of range",
+			backendUrl: "http://anvil:8545",
+			errorPolicyYAML: `
+      error_policy:
+        rules:
+          - match:
+              status_codes: [700]
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: body pattern",
+			backendUrl: "http://anvil:8545",
+			errorPolicyYAML: `
+      error_policy:
+        rules:
+          - match:
+              body_pattern: "(unclosed"
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: reward eligible masked relays",
+			backendUrl: "http://anvil:8545",
+			errorPolicyYAML: `
+      error_policy:
+        rules:
+          - match:
+              status_codes: [503]
+            action: mask
+            reward_eligible: true
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: duplicate rule name",
+			backendUrl: "http://anvil:8545",
+			errorPolicyYAML: `
+      error_policy:
+        rules:
+          - name: backend_errors
+            match:
+              status_codes: [502]
+          - name: backend_errors
+            match:
+              status_codes: [503]
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: websocket backend",
+			backendUrl: "ws://anvil:8546",
+			errorPolicyYAML: `
+      error_policy:
+        rules:
+          - match:
+              status_codes: [503]
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(
+				fmt.Sprintf(baseErrorPolicyConfig, test.backendUrl, test.errorPolicyYAML),
+			)
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			serviceConfig := cfg.Servers["http://127.0.0.1:8080"].SupplierConfigsMap["svc1"].ServiceConfig
+			if test.expectedRules == nil {
+				require.Nil(t, serviceConfig.ErrorPolicy)
+				return
+			}
+
+			require.Len(t, serviceConfig.ErrorPolicy.Rules, len(test.expectedRules))
+			for i, expectedRule := range test.expectedRules {
+				rule := serviceConfig.ErrorPolicy.Rules[i]
+				require.Equal(t, expectedRule.name, rule.Name)
+				require.Equal(t, expectedRule.statusCodes, rule.StatusCodes)
+				require.Equal(t, expectedRule.jsonRPCErrorCodes, rule.JSONRPCErrorCodes)
+				require.Equal(t, expectedRule.action, rule.Action)
+				require.Equal(t, expectedRule.rewardEligible, rule.RewardEligible)
+
+				if expectedRule.bodyPattern == "" {
+					require.Nil(t, rule.BodyPattern)
+				} else {
+					require.Equal(t, expectedRule.bodyPattern, rule.BodyPattern.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// eventsCursorConfigBase is a valid RelayMiner config to which the events
+// cursor section under test is appended.
+const eventsCursorConfigBase = `
+pocket_node:
+  query_node_grpc_url: tcp://127.0.0.1:9090
+  tx_node_rpc_url: tcp://127.0.0.1:26657
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+suppliers:
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8545
+    service_config:
+      backend_url: http://127.0.0.1:8546
+`
+
+func Test_ParseRelayMinerConfigs_EventsCursorStorePath(t *testing.T) {
+	tests := []struct {
+		desc                          string
+		eventsCursor                  string
+		expectedEventsCursorStorePath string
+	}{
+		{
+			desc:                          "events cursor store path is not set",
+			eventsCursor:                  "",
+			expectedEventsCursorStorePath: "",
+		},
+		{
+			desc:                          "events cursor store path is set",
+			eventsCursor:                  "events_cursor_store_path: /tmp/pocket/events_cursor",
+			expectedEventsCursorStorePath: "/tmp/pocket/events_cursor",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(eventsCursorConfigBase + test.eventsCursor)
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			require.NoError(t, err)
+			require.Equal(t, test.expectedEventsCursorStorePath, cfg.EventsCursorStorePath)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// baseGRPCConfig is a minimal valid RelayMiner config whose suppliers section is
+// provided by each test case.
+const baseGRPCConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+suppliers:
+%s`
+
+func Test_ParseRelayMinerConfigs_GRPCServer(t *testing.T) {
+	tests := []struct {
+		desc          string
+		suppliersYAML string
+
+		expectedErr        error
+		expectedBackendUrl string
+	}{
+		{
+			desc: "valid: grpc server with plaintext backend",
+			suppliersYAML: `
+  - service_id: svc1
+    listen_url: grpc://127.0.0.1:9443
+    service_config:
+      backend_url: grpc://127.0.0.1:9090
+`,
+			expectedBackendUrl: "grpc://127.0.0.1:9090",
+		},
+		{
+			desc: "valid: grpc server with TLS backend",
+			suppliersYAML: `
+  - service_id: svc1
+    listen_url: grpc://127.0.0.1:9443
+    service_config:
+      backend_url: grpcs://grpc.example.com:443
+`,
+			expectedBackendUrl: "grpcs://grpc.example.com:443",
+		},
+		{
+			desc: "invalid: grpc server without port",
+			suppliersYAML: `
+  - service_id: svc1
+    listen_url: grpc://127.0.0.1
+    service_config:
+      backend_url: grpc://127.0.0.1:9090
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+		},
+		{
+			desc: "invalid: grpc server with http backend",
+			suppliersYAML: `
+  - service_id: svc1
+    listen_url: grpc://127.0.0.1:9443
+    service_config:
+      backend_url: http://127.0.0.1:8545
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: http server with grpc backend",
+			suppliersYAML: `
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: grpc://127.0.0.1:9090
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(fmt.Sprintf(baseGRPCConfig, test.suppliersYAML))
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			serverConfig, ok := cfg.Servers["grpc://127.0.0.1:9443"]
+			require.True(t, ok)
+			require.Equal(t, config.RelayMinerServerTypeGRPC, serverConfig.ServerType)
+			require.Equal(t, "127.0.0.1:9443", serverConfig.ListenAddress)
+
+			supplierConfig := serverConfig.SupplierConfigsMap["svc1"]
+			require.Equal(t, config.RelayMinerServerTypeGRPC, supplierConfig.ServerType)
+			require.Equal(t, test.expectedBackendUrl, supplierConfig.ServiceConfig.BackendUrl.String())
+		})
+	}
+}
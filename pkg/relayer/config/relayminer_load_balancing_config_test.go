@@ -0,0 +1,186 @@
+package config_test
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// baseLoadBalancingConfig is a minimal valid RelayMiner config whose default
+// service config backends section is provided by each test case.
+const baseLoadBalancingConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+suppliers:
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+%s`
+
+func Test_ParseRelayMinerConfigs_LoadBalancing(t *testing.T) {
+	backendUrl := func(host string) *url.URL {
+		return &url.URL{Scheme: "http", Host: host}
+	}
+
+	tests := []struct {
+		desc         string
+		backendsYAML string
+
+		expectedErr           error
+		expectedBackends      []*config.RelayMinerSupplierServiceBackend
+		expectedLoadBalancing *config.RelayMinerLoadBalancingConfig
+	}{
+		{
+			desc: "valid: single backend_url",
+			backendsYAML: `
+      backend_url: http://anvil:8545
+`,
+			expectedBackends: []*config.RelayMinerSupplierServiceBackend{
+				{Url: backendUrl("anvil:8545"), Weight: 1},
+			},
+			expectedLoadBalancing: &config.RelayMinerLoadBalancingConfig{
+				Strategy:                       config.LoadBalancingStrategyRoundRobin,
+				HealthCheckInterval:            10 * time.Second,
+				CircuitBreakerFailureThreshold: 5,
+				CircuitBreakerCooldown:         30 * time.Second,
+				MaxRetries:                     0,
+			},
+		},
+		{
+			desc: "valid: multiple backends with default load balancing",
+			backendsYAML: `
+      backends:
+        - url: http://anvil-1:8545
+        - url: http://anvil-2:8545
+        - url: http://anvil-3:8545
+`,
+			expectedBackends: []*config.RelayMinerSupplierServiceBackend{
+				{Url: backendUrl("anvil-1:8545"), Weight: 1},
+				{Url: backendUrl("anvil-2:8545"), Weight: 1},
+				{Url: backendUrl("anvil-3:8545"), Weight: 1},
+			},
+			expectedLoadBalancing: &config.RelayMinerLoadBalancingConfig{
+				Strategy:                       config.LoadBalancingStrategyRoundRobin,
+				HealthCheckInterval:            10 * time.Second,
+				CircuitBreakerFailureThreshold: 5,
+				CircuitBreakerCooldown:         30 * time.Second,
+				MaxRetries:                     2,
+			},
+		},
+		{
+			desc: "valid: weighted backends with custom load balancing",
+			backendsYAML: `
+      backends:
+        - url: http://anvil-1:8545
+          weight: 3
+        - url: http://anvil-2:8545
+      load_balancing:
+        strategy: weighted
+        health_check_interval_seconds: 5
+        circuit_breaker_failure_threshold: 2
+        circuit_breaker_cooldown_seconds: 60
+        max_retries: 0
+`,
+			expectedBackends: []*config.RelayMinerSupplierServiceBackend{
+				{Url: backendUrl("anvil-1:8545"), Weight: 3},
+				{Url: backendUrl("anvil-2:8545"), Weight: 1},
+			},
+			expectedLoadBalancing: &config.RelayMinerLoadBalancingConfig{
+				Strategy:                       config.LoadBalancingStrategyWeighted,
+				HealthCheckInterval:            5 * time.Second,
+				CircuitBreakerFailureThreshold: 2,
+				CircuitBreakerCooldown:         60 * time.Second,
+				MaxRetries:                     0,
+			},
+		},
+		{
+			desc: "invalid: both backend_url and backends",
+			backendsYAML: `
+      backend_url: http://anvil:8545
+      backends:
+        - url: http://anvil-1:8545
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: backends with different schemes",
+			backendsYAML: `
+      backends:
+        - url: http://anvil-1:8545
+        - url: https://anvil-2:8545
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: duplicate backends",
+			backendsYAML: `
+      backends:
+        - url: http://anvil-1:8545
+        - url: http://anvil-1:8545
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: empty backend url",
+			backendsYAML: `
+      backends:
+        - url: http://anvil-1:8545
+        - weight: 2
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: unknown strategy",
+			backendsYAML: `
+      backends:
+        - url: http://anvil-1:8545
+        - url: http://anvil-2:8545
+      load_balancing:
+        strategy: random
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: max_retries not lower than the number of backends",
+			backendsYAML: `
+      backends:
+        - url: http://anvil-1:8545
+        - url: http://anvil-2:8545
+      load_balancing:
+        max_retries: 2
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(
+				fmt.Sprintf(baseLoadBalancingConfig, test.backendsYAML),
+			)
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			serviceConfig := cfg.Servers["http://127.0.0.1:8080"].SupplierConfigsMap["svc1"].ServiceConfig
+			require.Equal(t, test.expectedBackends, serviceConfig.Backends)
+			require.Equal(t, test.expectedBackends[0].Url, serviceConfig.BackendUrl)
+			require.Equal(t, test.expectedLoadBalancing, serviceConfig.LoadBalancing)
+		})
+	}
+}
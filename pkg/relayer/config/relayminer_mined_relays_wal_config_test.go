@@ -0,0 +1,45 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+func Test_ParseRelayMinerConfigs_MinedRelaysWALDefaults(t *testing.T) {
+	normalized := yaml.NormalizeYAMLIndentation(baseMiningKnobsConfig)
+
+	cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+	require.NoError(t, err)
+
+	// Unset thresholds fall back to the historical hardcoded values.
+	require.Equal(t, &config.RelayMinerMinedRelaysWALConfig{
+		MaxBufferedBytes: int(config.DefaultMinedRelaysWALMaxBufferedBytes),
+		FlushInterval:    time.Duration(config.DefaultMinedRelaysWALFlushIntervalSeconds) * time.Second,
+		WriteQueueSize:   int(config.DefaultMinedRelaysWALWriteQueueSize),
+	}, cfg.MinedRelaysWAL)
+}
+
+func Test_ParseRelayMinerConfigs_MinedRelaysWALOverrides(t *testing.T) {
+	withOverrides := baseMiningKnobsConfig + `
+mined_relays_wal:
+  max_buffered_bytes: 1000000
+  flush_interval_seconds: 2
+  write_queue_size: 500
+`
+	normalized := yaml.NormalizeYAMLIndentation(withOverrides)
+
+	cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+	require.NoError(t, err)
+
+	require.Equal(t, &config.RelayMinerMinedRelaysWALConfig{
+		MaxBufferedBytes: 1_000_000,
+		FlushInterval:    2 * time.Second,
+		WriteQueueSize:   500,
+	}, cfg.MinedRelaysWAL)
+}
@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -36,6 +37,8 @@ func Test_ParseRelayMinerConfigs_MiningKnobsDefaults(t *testing.T) {
 	require.Equal(t, int(config.DefaultServedRelaysBufferSize), cfg.ServedRelaysBufferSize)
 	require.Equal(t, int(config.DefaultMiningPipelineBufferSize), cfg.MiningPipelineBufferSize)
 	require.Equal(t, 0, cfg.MiningWorkers)
+	require.Equal(t, time.Duration(config.DefaultShutdownStopTimeoutSeconds)*time.Second, cfg.ShutdownStopTimeout)
+	require.Equal(t, time.Duration(config.DefaultShutdownDrainTimeoutSeconds)*time.Second, cfg.ShutdownDrainTimeout)
 }
 
 func Test_ParseRelayMinerConfigs_MiningKnobsOverrides(t *testing.T) {
@@ -43,6 +46,8 @@ func Test_ParseRelayMinerConfigs_MiningKnobsOverrides(t *testing.T) {
 served_relays_buffer_size: 5000
 mining_pipeline_buffer_size: 200
 mining_workers: 12
+shutdown_stop_timeout_seconds: 45
+shutdown_drain_timeout_seconds: 90
 `
 	normalized := yaml.NormalizeYAMLIndentation(withOverrides)
 
@@ -52,4 +57,6 @@ mining_workers: 12
 	require.Equal(t, 5000, cfg.ServedRelaysBufferSize)
 	require.Equal(t, 200, cfg.MiningPipelineBufferSize)
 	require.Equal(t, 12, cfg.MiningWorkers)
+	require.Equal(t, 45*time.Second, cfg.ShutdownStopTimeout)
+	require.Equal(t, 90*time.Second, cfg.ShutdownDrainTimeout)
 }
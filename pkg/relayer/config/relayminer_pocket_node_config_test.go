@@ -0,0 +1,153 @@
+package config_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// pocketNodeConfigSuppliers is the suppliers section appended to the pocket
+// node sections under test to make a valid RelayMiner config.
+const pocketNodeConfigSuppliers = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+suppliers:
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8545
+    service_config:
+      backend_url: http://127.0.0.1:8546
+`
+
+func Test_ParseRelayMinerConfigs_PocketNodeSingleUrls(t *testing.T) {
+	cfg := parsePocketNodeConfig(t, `
+pocket_node:
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+`)
+
+	// The query node rpc urls default to the tx node rpc urls.
+	txNodeRPCUrl := &url.URL{Scheme: "http", Host: "127.0.0.1:26657"}
+	require.Equal(t, txNodeRPCUrl, cfg.PocketNode.TxNodeRPCUrl)
+	require.Equal(t, txNodeRPCUrl, cfg.PocketNode.QueryNodeRPCUrl)
+	require.Equal(t, []*url.URL{txNodeRPCUrl}, cfg.PocketNode.TxNodeRPCUrls)
+	require.Equal(t, []*url.URL{txNodeRPCUrl}, cfg.PocketNode.QueryNodeRPCUrls)
+	require.Equal(t,
+		[]*url.URL{{Scheme: "http", Host: "127.0.0.1:9090"}},
+		cfg.PocketNode.QueryNodeGRPCUrls,
+	)
+
+	require.Equal(t,
+		time.Duration(config.DefaultPocketNodeHealthCheckIntervalSeconds)*time.Second,
+		cfg.PocketNode.HealthCheckInterval,
+	)
+	require.Equal(t, int64(config.DefaultPocketNodeMaxBlockLag), cfg.PocketNode.MaxBlockLag)
+}
+
+func Test_ParseRelayMinerConfigs_PocketNodeUrlLists(t *testing.T) {
+	cfg := parsePocketNodeConfig(t, `
+pocket_node:
+  query_node_rpc_url: http://node-1:26657
+  query_node_rpc_urls:
+    - http://node-2:26657
+    - http://node-3:26657
+  query_node_grpc_urls:
+    - http://node-1:9090
+    - http://node-2:9090
+  tx_node_rpc_urls:
+    - http://validator-1:26657
+    - http://validator-2:26657
+  health_check_interval_seconds: 5
+  max_block_lag: 10
+`)
+
+	// The single url comes first, followed by the list.
+	require.Equal(t, []*url.URL{
+		{Scheme: "http", Host: "node-1:26657"},
+		{Scheme: "http", Host: "node-2:26657"},
+		{Scheme: "http", Host: "node-3:26657"},
+	}, cfg.PocketNode.QueryNodeRPCUrls)
+	require.Equal(t, []*url.URL{
+		{Scheme: "http", Host: "node-1:9090"},
+		{Scheme: "http", Host: "node-2:9090"},
+	}, cfg.PocketNode.QueryNodeGRPCUrls)
+	require.Equal(t, []*url.URL{
+		{Scheme: "http", Host: "validator-1:26657"},
+		{Scheme: "http", Host: "validator-2:26657"},
+	}, cfg.PocketNode.TxNodeRPCUrls)
+
+	// The single url fields hold the preferred endpoint of each role.
+	require.Equal(t, "http://node-1:26657", cfg.PocketNode.QueryNodeRPCUrl.String())
+	require.Equal(t, "http://node-1:9090", cfg.PocketNode.QueryNodeGRPCUrl.String())
+	require.Equal(t, "http://validator-1:26657", cfg.PocketNode.TxNodeRPCUrl.String())
+
+	require.Equal(t, 5*time.Second, cfg.PocketNode.HealthCheckInterval)
+	require.Equal(t, int64(10), cfg.PocketNode.MaxBlockLag)
+}
+
+func Test_ParseRelayMinerConfigs_PocketNodeInvalidUrls(t *testing.T) {
+	tests := []struct {
+		desc       string
+		pocketNode string
+	}{
+		{
+			desc: "missing tx node rpc url",
+			pocketNode: `
+pocket_node:
+  query_node_grpc_url: http://127.0.0.1:9090
+`,
+		},
+		{
+			desc: "missing query node grpc url",
+			pocketNode: `
+pocket_node:
+  tx_node_rpc_urls:
+    - http://127.0.0.1:26657
+`,
+		},
+		{
+			desc: "duplicate tx node rpc url",
+			pocketNode: `
+pocket_node:
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+  tx_node_rpc_urls:
+    - http://127.0.0.1:26657
+`,
+		},
+		{
+			desc: "empty query node grpc url",
+			pocketNode: `
+pocket_node:
+  query_node_grpc_urls:
+    - ""
+  tx_node_rpc_url: http://127.0.0.1:26657
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(test.pocketNode + pocketNodeConfigSuppliers)
+
+			_, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			require.ErrorIs(t, err, config.ErrRelayMinerConfigInvalidNodeUrl)
+		})
+	}
+}
+
+// parsePocketNodeConfig parses a RelayMiner config made of the given pocket node section.
+func parsePocketNodeConfig(t *testing.T, pocketNode string) *config.RelayMinerConfig {
+	t.Helper()
+
+	normalized := yaml.NormalizeYAMLIndentation(pocketNode + pocketNodeConfigSuppliers)
+	cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+	require.NoError(t, err)
+
+	return cfg
+}
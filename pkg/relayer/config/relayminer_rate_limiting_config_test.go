@@ -0,0 +1,160 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// baseRateLimitingConfig is a minimal valid RelayMiner config whose default and
+// supplier rate limiting sections are provided by each test case.
+const baseRateLimitingConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+%s
+suppliers:
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: http://anvil:8545
+%s`
+
+func Test_ParseRelayMinerConfigs_RateLimiting(t *testing.T) {
+	tests := []struct {
+		desc                  string
+		defaultRateLimitYAML  string
+		supplierRateLimitYAML string
+
+		expectedErr          error
+		expectedRateLimiting *config.RelayMinerRateLimitingConfig
+	}{
+		{
+			desc: "valid: no rate limiting",
+			expectedRateLimiting: &config.RelayMinerRateLimitingConfig{
+				ApplicationOverrides: map[string]config.RelayMinerRateLimit{},
+			},
+		},
+		{
+			desc: "valid: default rate limiting with burst defaulting to requests_per_second",
+			defaultRateLimitYAML: `
+default_rate_limiting:
+  per_application:
+    requests_per_second: 100
+    max_concurrent_requests: 20
+  per_gateway:
+    requests_per_second: 1000
+    burst: 2000
+`,
+			expectedRateLimiting: &config.RelayMinerRateLimitingConfig{
+				PerApplication: config.RelayMinerRateLimit{
+					RequestsPerSecond:     100,
+					Burst:                 100,
+					MaxConcurrentRequests: 20,
+				},
+				PerGateway: config.RelayMinerRateLimit{
+					RequestsPerSecond: 1000,
+					Burst:             2000,
+				},
+				ApplicationOverrides: map[string]config.RelayMinerRateLimit{},
+			},
+		},
+		{
+			desc: "valid: supplier rate limiting overrides the default one",
+			defaultRateLimitYAML: `
+default_rate_limiting:
+  per_application:
+    requests_per_second: 100
+  per_gateway:
+    requests_per_second: 1000
+  application_overrides:
+    pokt1app1:
+      requests_per_second: 10
+    pokt1app2:
+      requests_per_second: 20
+`,
+			supplierRateLimitYAML: `
+    rate_limiting:
+      per_application:
+        max_concurrent_requests: 5
+      application_overrides:
+        pokt1app2:
+          requests_per_second: 200
+          burst: 400
+`,
+			expectedRateLimiting: &config.RelayMinerRateLimitingConfig{
+				PerApplication: config.RelayMinerRateLimit{
+					MaxConcurrentRequests: 5,
+				},
+				PerGateway: config.RelayMinerRateLimit{
+					RequestsPerSecond: 1000,
+					Burst:             1000,
+				},
+				ApplicationOverrides: map[string]config.RelayMinerRateLimit{
+					"pokt1app1": {RequestsPerSecond: 10, Burst: 10},
+					"pokt1app2": {RequestsPerSecond: 200, Burst: 400},
+				},
+			},
+		},
+		{
+			desc: "valid: supplier disables the default rate limiting",
+			defaultRateLimitYAML: `
+default_rate_limiting:
+  per_gateway:
+    requests_per_second: 1000
+`,
+			supplierRateLimitYAML: `
+    rate_limiting:
+      per_gateway: {}
+`,
+			expectedRateLimiting: &config.RelayMinerRateLimitingConfig{
+				ApplicationOverrides: map[string]config.RelayMinerRateLimit{},
+			},
+		},
+		{
+			desc: "invalid: burst without requests_per_second",
+			defaultRateLimitYAML: `
+default_rate_limiting:
+  per_application:
+    burst: 10
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidRateLimiting,
+		},
+		{
+			desc: "invalid: supplier application override with burst without requests_per_second",
+			supplierRateLimitYAML: `
+    rate_limiting:
+      application_overrides:
+        pokt1app1:
+          burst: 10
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidRateLimiting,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(
+				fmt.Sprintf(baseRateLimitingConfig, test.defaultRateLimitYAML, test.supplierRateLimitYAML),
+			)
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			supplierConfig := cfg.Servers["http://127.0.0.1:8080"].SupplierConfigsMap["svc1"]
+			require.Equal(t, test.expectedRateLimiting, supplierConfig.RateLimiting)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package config_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// baseRemoteSignersConfig is a minimal valid RelayMiner config whose
+// remote_signers section is provided by each test case.
+const baseRemoteSignersConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+%s
+suppliers:
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: http://anvil:8545
+`
+
+func Test_ParseRelayMinerConfigs_RemoteSigners(t *testing.T) {
+	tlsDir := t.TempDir()
+	caFile := filepath.Join(tlsDir, "ca.crt")
+	certFile := filepath.Join(tlsDir, "client.crt")
+	keyFile := filepath.Join(tlsDir, "client.key")
+	for _, filePath := range []string{caFile, certFile, keyFile} {
+		require.NoError(t, os.WriteFile(filePath, []byte("placeholder"), 0o600))
+	}
+
+	tests := []struct {
+		desc              string
+		remoteSignersYAML string
+
+		expectedErr           error
+		expectedRemoteSigners []*config.RelayMinerRemoteSignerConfig
+	}{
+		{
+			desc:                  "valid: no remote signers",
+			expectedRemoteSigners: []*config.RelayMinerRemoteSignerConfig{},
+		},
+		{
+			desc: "valid: unix socket remote signer with default timeout",
+			remoteSignersYAML: `
+remote_signers:
+  - address: unix:///run/pocket/signer.sock
+    key_names: [supplier1, supplier2]
+`,
+			expectedRemoteSigners: []*config.RelayMinerRemoteSignerConfig{
+				{
+					Address:  "unix:///run/pocket/signer.sock",
+					KeyNames: []string{"supplier1", "supplier2"},
+					Timeout:  time.Duration(config.DefaultRemoteSignerTimeoutSeconds) * time.Second,
+				},
+			},
+		},
+		{
+			desc: "valid: tcp remote signer with mutual TLS",
+			remoteSignersYAML: fmt.Sprintf(`
+remote_signers:
+  - address: tcp://signer.internal:8900
+    key_names: [supplier1]
+    timeout_seconds: 2
+    tls:
+      ca_file: %s
+      cert_file: %s
+      key_file: %s
+      server_name: signer
+`, caFile, certFile, keyFile),
+			expectedRemoteSigners: []*config.RelayMinerRemoteSignerConfig{
+				{
+					Address:  "tcp://signer.internal:8900",
+					KeyNames: []string{"supplier1"},
+					Timeout:  2 * time.Second,
+					TLS: &config.RelayMinerRemoteSignerTLSConfig{
+						CAFile:     caFile,
+						CertFile:   certFile,
+						KeyFile:    keyFile,
+						ServerName: "signer",
+					},
+				},
+			},
+		},
+		{
+			desc: "invalid: tcp remote signer without mutual TLS",
+			remoteSignersYAML: `
+remote_signers:
+  - address: tcp://signer.internal:8900
+    key_names: [supplier1]
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidRemoteSigner,
+		},
+		{
+			desc: "invalid: tcp remote signer with missing TLS file",
+			remoteSignersYAML: fmt.Sprintf(`
+remote_signers:
+  - address: tcp://signer.internal:8900
+    key_names: [supplier1]
+    tls:
+      ca_file: %s
+      cert_file: %s
+      key_file: /nonexistent/client.key
+`, caFile, certFile),
+			expectedErr: config.ErrRelayMinerConfigInvalidRemoteSigner,
+		},
+		{
+			desc: "invalid: unix socket remote signer with TLS",
+			remoteSignersYAML: fmt.Sprintf(`
+remote_signers:
+  - address: unix:///run/pocket/signer.sock
+    key_names: [supplier1]
+    tls:
+      ca_file: %s
+`, caFile),
+			expectedErr: config.ErrRelayMinerConfigInvalidRemoteSigner,
+		},
+		{
+			desc: "invalid: unsupported address scheme",
+			remoteSignersYAML: `
+remote_signers:
+  - address: http://signer.internal:8900
+    key_names: [supplier1]
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidRemoteSigner,
+		},
+		{
+			desc: "invalid: remote signer without key names",
+			remoteSignersYAML: `
+remote_signers:
+  - address: unix:///run/pocket/signer.sock
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidRemoteSigner,
+		},
+		{
+			desc: "invalid: key held by two remote signers",
+			remoteSignersYAML: `
+remote_signers:
+  - address: unix:///run/pocket/signer1.sock
+    key_names: [supplier1]
+  - address: unix:///run/pocket/signer2.sock
+    key_names: [supplier1]
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidRemoteSigner,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(fmt.Sprintf(baseRemoteSignersConfig, test.remoteSignersYAML))
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedRemoteSigners, cfg.RemoteSigners)
+		})
+	}
+}
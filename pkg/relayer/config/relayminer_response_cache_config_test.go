@@ -0,0 +1,168 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// baseResponseCacheConfig is a minimal valid RelayMiner config whose default
+// service config backend and response_cache sections are provided by each test case.
+const baseResponseCacheConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+suppliers:
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: %s
+%s`
+
+func Test_ParseRelayMinerConfigs_ResponseCache(t *testing.T) {
+	tests := []struct {
+		desc              string
+		backendUrl        string
+		responseCacheYAML string
+
+		expectedErr           error
+		expectedResponseCache *config.RelayMinerResponseCacheConfig
+	}{
+		{
+			desc:                  "valid: no response cache",
+			backendUrl:            "http://anvil:8545",
+			expectedResponseCache: nil,
+		},
+		{
+			desc:       "valid: response cache with default max_size",
+			backendUrl: "http://anvil:8545",
+			responseCacheYAML: `
+      response_cache:
+        rules:
+          - method: eth_chainId
+            ttl_seconds: 3600
+          - method: eth_getBlockByNumber
+            ttl_seconds: 60
+`,
+			expectedResponseCache: &config.RelayMinerResponseCacheConfig{
+				MaxSize:       10 * 1024 * 1024,
+				FinalityDepth: config.DefaultResponseCacheFinalityDepth,
+				MethodTTLs: map[string]time.Duration{
+					"eth_chainId":          time.Hour,
+					"eth_getBlockByNumber": time.Minute,
+				},
+			},
+		},
+		{
+			desc:       "valid: response cache with custom max_size and finality_depth",
+			backendUrl: "https://anvil:8545",
+			responseCacheYAML: `
+      response_cache:
+        max_size: 1MB
+        finality_depth: 12
+        rules:
+          - method: net_version
+            ttl_seconds: 10
+`,
+			expectedResponseCache: &config.RelayMinerResponseCacheConfig{
+				MaxSize:       1024 * 1024,
+				FinalityDepth: 12,
+				MethodTTLs: map[string]time.Duration{
+					"net_version": 10 * time.Second,
+				},
+			},
+		},
+		{
+			desc:       "invalid: response cache without rules",
+			backendUrl: "http://anvil:8545",
+			responseCacheYAML: `
+      response_cache:
+        max_size: 1MB
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: invalid max_size",
+			backendUrl: "http://anvil:8545",
+			responseCacheYAML: `
+      response_cache:
+        max_size: lots
+        rules:
+          - method: eth_chainId
+            ttl_seconds: 3600
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: empty method",
+			backendUrl: "http://anvil:8545",
+			responseCacheYAML: `
+      response_cache:
+        rules:
+          - ttl_seconds: 3600
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: duplicate method",
+			backendUrl: "http://anvil:8545",
+			responseCacheYAML: `
+      response_cache:
+        rules:
+          - method: eth_chainId
+            ttl_seconds: 3600
+          - method: eth_chainId
+            ttl_seconds: 60
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: zero ttl_seconds",
+			backendUrl: "http://anvil:8545",
+			responseCacheYAML: `
+      response_cache:
+        rules:
+          - method: eth_chainId
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: websocket backend",
+			backendUrl: "ws://anvil:8546",
+			responseCacheYAML: `
+      response_cache:
+        rules:
+          - method: eth_chainId
+            ttl_seconds: 3600
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(
+				fmt.Sprintf(baseResponseCacheConfig, test.backendUrl, test.responseCacheYAML),
+			)
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			serviceConfig := cfg.Servers["http://127.0.0.1:8080"].SupplierConfigsMap["svc1"].ServiceConfig
+			require.Equal(t, test.expectedResponseCache, serviceConfig.ResponseCache)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package config_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+)
+
+// baseTLSConfig is a minimal valid RelayMiner config whose suppliers section is
+// provided by each test case.
+const baseTLSConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+suppliers:
+%s`
+
+func Test_ParseRelayMinerConfigs_HTTPSServer(t *testing.T) {
+	tlsDir := t.TempDir()
+	certFile := filepath.Join(tlsDir, "tls.crt")
+	keyFile := filepath.Join(tlsDir, "tls.key")
+	caFile := filepath.Join(tlsDir, "ca.crt")
+	for _, filePath := range []string{certFile, keyFile, caFile} {
+		require.NoError(t, os.WriteFile(filePath, []byte("placeholder"), 0o600))
+	}
+
+	tests := []struct {
+		desc          string
+		suppliersYAML string
+
+		expectedErr       error
+		expectedTLSConfig *config.RelayMinerServerTLSConfig
+	}{
+		{
+			desc: "valid: https server with default reload interval",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %s
+      key_file: %s
+    service_config:
+      backend_url: http://127.0.0.1:8546
+`, certFile, keyFile),
+			expectedTLSConfig: &config.RelayMinerServerTLSConfig{
+				CertFile:       certFile,
+				KeyFile:        keyFile,
+				ReloadInterval: time.Duration(config.DefaultTLSReloadIntervalSeconds) * time.Second,
+			},
+		},
+		{
+			desc: "valid: https server with mutual TLS shared by two suppliers",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %[1]s
+      key_file: %[2]s
+      client_ca_file: %[3]s
+      require_client_cert: true
+      reload_interval_seconds: 5
+    service_config:
+      backend_url: http://127.0.0.1:8546
+  - service_id: svc2
+    listen_url: https://127.0.0.1:8443
+    service_config:
+      backend_url: http://127.0.0.1:8547
+`, certFile, keyFile, caFile),
+			expectedTLSConfig: &config.RelayMinerServerTLSConfig{
+				CertFile:          certFile,
+				KeyFile:           keyFile,
+				ClientCAFile:      caFile,
+				RequireClientCert: true,
+				ReloadInterval:    5 * time.Second,
+			},
+		},
+		{
+			desc: "valid: https server with the tls section on the second supplier",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: https://127.0.0.1:8443
+    service_config:
+      backend_url: http://127.0.0.1:8546
+  - service_id: svc2
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %s
+      key_file: %s
+    service_config:
+      backend_url: http://127.0.0.1:8547
+`, certFile, keyFile),
+			expectedTLSConfig: &config.RelayMinerServerTLSConfig{
+				CertFile:       certFile,
+				KeyFile:        keyFile,
+				ReloadInterval: time.Duration(config.DefaultTLSReloadIntervalSeconds) * time.Second,
+			},
+		},
+		{
+			desc: "invalid: https server without key file",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %s
+    service_config:
+      backend_url: http://127.0.0.1:8546
+`, certFile),
+			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+		},
+		{
+			desc: "invalid: https server with missing cert file",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %s
+      key_file: %s
+    service_config:
+      backend_url: http://127.0.0.1:8546
+`, filepath.Join(tlsDir, "missing.crt"), keyFile),
+			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+		},
+		{
+			desc: "invalid: client certificate required without client CA",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %s
+      key_file: %s
+      require_client_cert: true
+    service_config:
+      backend_url: http://127.0.0.1:8546
+`, certFile, keyFile),
+			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+		},
+		{
+			desc: "invalid: tls section on an http server",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    tls:
+      cert_file: %s
+      key_file: %s
+    service_config:
+      backend_url: http://127.0.0.1:8546
+`, certFile, keyFile),
+			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+		},
+		{
+			desc: "invalid: conflicting tls sections for the same listen url",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %[1]s
+      key_file: %[2]s
+    service_config:
+      backend_url: http://127.0.0.1:8546
+  - service_id: svc2
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %[2]s
+      key_file: %[1]s
+    service_config:
+      backend_url: http://127.0.0.1:8547
+`, certFile, keyFile),
+			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+		},
+		{
+			desc: "invalid: conflicting tls reload intervals for the same listen url",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %[1]s
+      key_file: %[2]s
+      reload_interval_seconds: 5
+    service_config:
+      backend_url: http://127.0.0.1:8546
+  - service_id: svc2
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %[1]s
+      key_file: %[2]s
+      reload_interval_seconds: 30
+    service_config:
+      backend_url: http://127.0.0.1:8547
+`, certFile, keyFile),
+			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+		},
+		{
+			desc: "valid: omitted tls reload interval matching the default",
+			suppliersYAML: fmt.Sprintf(`
+  - service_id: svc1
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %[1]s
+      key_file: %[2]s
+      reload_interval_seconds: %[3]d
+    service_config:
+      backend_url: http://127.0.0.1:8546
+  - service_id: svc2
+    listen_url: https://127.0.0.1:8443
+    tls:
+      cert_file: %[1]s
+      key_file: %[2]s
+    service_config:
+      backend_url: http://127.0.0.1:8547
+`, certFile, keyFile, config.DefaultTLSReloadIntervalSeconds),
+			expectedTLSConfig: &config.RelayMinerServerTLSConfig{
+				CertFile:       certFile,
+				KeyFile:        keyFile,
+				ReloadInterval: time.Duration(config.DefaultTLSReloadIntervalSeconds) * time.Second,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(fmt.Sprintf(baseTLSConfig, test.suppliersYAML))
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			serverConfig, ok := cfg.Servers["https://127.0.0.1:8443"]
+			require.True(t, ok)
+			require.Equal(t, config.RelayMinerServerTypeHTTPS, serverConfig.ServerType)
+			require.Equal(t, "127.0.0.1:8443", serverConfig.ListenAddress)
+			require.Equal(t, test.expectedTLSConfig, serverConfig.TLS)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/yaml"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// baseWebsocketMeteringConfig is a minimal valid RelayMiner config whose websocket
+// service config metering section is provided by each test case.
+const baseWebsocketMeteringConfig = `
+default_signing_key_names: [supplier1]
+smt_store_path: /tmp/pocket/smt
+pocket_node:
+  query_node_rpc_url: http://127.0.0.1:26657
+  query_node_grpc_url: http://127.0.0.1:9090
+  tx_node_rpc_url: http://127.0.0.1:26657
+suppliers:
+  - service_id: svc1
+    listen_url: http://127.0.0.1:8080
+    service_config:
+      backend_url: http://127.0.0.1:8545
+    rpc_type_service_configs:
+      websocket:
+        backend_url: %s
+%s`
+
+func Test_ParseRelayMinerConfigs_WebsocketMetering(t *testing.T) {
+	tests := []struct {
+		desc         string
+		backendUrl   string
+		meteringYAML string
+
+		expectedErr      error
+		expectedMetering *config.RelayMinerWebsocketMeteringConfig
+	}{
+		{
+			desc:             "valid: no metering section",
+			backendUrl:       "ws://127.0.0.1:8546",
+			expectedMetering: nil,
+		},
+		{
+			desc:       "valid: per_bytes metering",
+			backendUrl: "ws://127.0.0.1:8546",
+			meteringYAML: `
+        metering:
+          strategy: per_bytes
+          unit_size: 64KB
+`,
+			expectedMetering: &config.RelayMinerWebsocketMeteringConfig{
+				Strategy: config.WebsocketMeteringStrategyPerBytes,
+				UnitSize: 64 * 1024,
+			},
+		},
+		{
+			desc:       "valid: delimiter metering",
+			backendUrl: "wss://ws.example.com",
+			meteringYAML: `
+        metering:
+          strategy: delimiter
+          delimiter: "\n"
+`,
+			expectedMetering: &config.RelayMinerWebsocketMeteringConfig{
+				Strategy:  config.WebsocketMeteringStrategyDelimiter,
+				Delimiter: []byte("\n"),
+			},
+		},
+		{
+			desc:       "invalid: unknown strategy",
+			backendUrl: "ws://127.0.0.1:8546",
+			meteringYAML: `
+        metering:
+          strategy: per_packet
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: per_bytes without unit_size",
+			backendUrl: "ws://127.0.0.1:8546",
+			meteringYAML: `
+        metering:
+          strategy: per_bytes
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: delimiter without delimiter",
+			backendUrl: "ws://127.0.0.1:8546",
+			meteringYAML: `
+        metering:
+          strategy: delimiter
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc:       "invalid: metering of a non-websocket backend",
+			backendUrl: "http://127.0.0.1:8546",
+			meteringYAML: `
+        metering:
+          strategy: per_message
+`,
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			normalized := yaml.NormalizeYAMLIndentation(
+				fmt.Sprintf(baseWebsocketMeteringConfig, test.backendUrl, test.meteringYAML),
+			)
+
+			cfg, err := config.ParseRelayMinerConfigs(polyzero.NewLogger(), []byte(normalized))
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			supplierConfig := cfg.Servers["http://127.0.0.1:8080"].SupplierConfigsMap["svc1"]
+			websocketConfig, ok := supplierConfig.RPCTypeServiceConfigs[sharedtypes.RPCType_WEBSOCKET]
+			require.True(t, ok)
+			require.Equal(t, test.expectedMetering, websocketConfig.Metering)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"net/url"
+	"os"
+	"time"
+)
+
+// parseRemoteSignersConfig validates the remote_signers section and returns its
+// hydrated counterpart.
+// Each signing key can only be held by one remote signer.
+func parseRemoteSignersConfig(
+	yamlRemoteSignersConfig []YAMLRelayMinerRemoteSignerConfig,
+) ([]*RelayMinerRemoteSignerConfig, error) {
+	remoteSignersConfig := make([]*RelayMinerRemoteSignerConfig, 0, len(yamlRemoteSignersConfig))
+	keyNameToAddressMap := make(map[string]string)
+
+	for _, yamlRemoteSignerConfig := range yamlRemoteSignersConfig {
+		remoteSignerConfig, err := parseRemoteSignerConfig(yamlRemoteSignerConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, keyName := range remoteSignerConfig.KeyNames {
+			if address, ok := keyNameToAddressMap[keyName]; ok {
+				return nil, ErrRelayMinerConfigInvalidRemoteSigner.Wrapf(
+					"key name %q is held by both %q and %q",
+					keyName, address, remoteSignerConfig.Address,
+				)
+			}
+			keyNameToAddressMap[keyName] = remoteSignerConfig.Address
+		}
+
+		remoteSignersConfig = append(remoteSignersConfig, remoteSignerConfig)
+	}
+
+	return remoteSignersConfig, nil
+}
+
+// parseRemoteSignerConfig validates an entry of the remote_signers section and
+// returns its hydrated counterpart.
+func parseRemoteSignerConfig(
+	yamlRemoteSignerConfig YAMLRelayMinerRemoteSignerConfig,
+) (*RelayMinerRemoteSignerConfig, error) {
+	address := yamlRemoteSignerConfig.Address
+	addressURL, err := url.Parse(address)
+	if err != nil || address == "" {
+		return nil, ErrRelayMinerConfigInvalidRemoteSigner.Wrapf("invalid address %q", address)
+	}
+
+	if len(yamlRemoteSignerConfig.KeyNames) == 0 {
+		return nil, ErrRelayMinerConfigInvalidRemoteSigner.Wrapf("no key_names for %q", address)
+	}
+	for _, keyName := range yamlRemoteSignerConfig.KeyNames {
+		if keyName == "" {
+			return nil, ErrRelayMinerConfigInvalidRemoteSigner.Wrapf("empty key name for %q", address)
+		}
+	}
+
+	timeoutSeconds := yamlRemoteSignerConfig.TimeoutSeconds
+	if timeoutSeconds == 0 {
+		timeoutSeconds = DefaultRemoteSignerTimeoutSeconds
+	}
+
+	remoteSignerConfig := &RelayMinerRemoteSignerConfig{
+		Address:  address,
+		KeyNames: yamlRemoteSignerConfig.KeyNames,
+		Timeout:  time.Duration(timeoutSeconds) * time.Second,
+	}
+
+	yamlTLSConfig := yamlRemoteSignerConfig.TLS
+	switch addressURL.Scheme {
+	case "unix":
+		// The unix socket is secured by its file permissions.
+		if yamlTLSConfig != (YAMLRelayMinerRemoteSignerTLSConfig{}) {
+			return nil, ErrRelayMinerConfigInvalidRemoteSigner.Wrapf(
+				"tls section provided for unix socket address %q",
+				address,
+			)
+		}
+	case "tcp":
+		// The signing requests must never be sent in clear over the network.
+		if yamlTLSConfig.CAFile == "" || yamlTLSConfig.CertFile == "" || yamlTLSConfig.KeyFile == "" {
+			return nil, ErrRelayMinerConfigInvalidRemoteSigner.Wrapf(
+				"tls.ca_file, tls.cert_file and tls.key_file are required for tcp address %q",
+				address,
+			)
+		}
+
+		// Fail early if any of the files cannot be accessed. Their content is
+		// validated when the connection to the remote signer is set up.
+		for _, filePath := range []string{
+			yamlTLSConfig.CAFile,
+			yamlTLSConfig.CertFile,
+			yamlTLSConfig.KeyFile,
+		} {
+			if _, err := os.Stat(filePath); err != nil {
+				return nil, ErrRelayMinerConfigInvalidRemoteSigner.Wrapf(
+					"unable to access tls file %q: %s",
+					filePath, err.Error(),
+				)
+			}
+		}
+
+		remoteSignerConfig.TLS = &RelayMinerRemoteSignerTLSConfig{
+			CAFile:     yamlTLSConfig.CAFile,
+			CertFile:   yamlTLSConfig.CertFile,
+			KeyFile:    yamlTLSConfig.KeyFile,
+			ServerName: yamlTLSConfig.ServerName,
+		}
+	default:
+		return nil, ErrRelayMinerConfigInvalidRemoteSigner.Wrapf(
+			"unsupported scheme in address %q, expected \"unix\" or \"tcp\"",
+			address,
+		)
+	}
+
+	return remoteSignerConfig, nil
+}
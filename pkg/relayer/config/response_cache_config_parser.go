@@ -0,0 +1,86 @@
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/docker/go-units"
+)
+
+// parseResponseCacheConfig validates the response_cache sub-section of a service
+// config and returns its hydrated counterpart.
+// Responses are only cached for synchronous relays, it is rejected for any
+// backend url scheme other than "http" and "https".
+func parseResponseCacheConfig(
+	backendUrl *url.URL,
+	yamlResponseCacheConfig YAMLRelayMinerResponseCacheConfig,
+) (*RelayMinerResponseCacheConfig, error) {
+	if backendUrl.Scheme != "http" && backendUrl.Scheme != "https" {
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"response_cache is only supported by http backends, got backend url scheme %q",
+			backendUrl.Scheme,
+		)
+	}
+
+	if len(yamlResponseCacheConfig.Rules) == 0 {
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrap(
+			"response_cache requires at least one rule",
+		)
+	}
+
+	maxSizeStr := yamlResponseCacheConfig.MaxSize
+	if maxSizeStr == "" {
+		maxSizeStr = DefaultResponseCacheMaxSize
+	}
+
+	maxSize, err := units.RAMInBytes(maxSizeStr)
+	if err != nil {
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"invalid response_cache max_size %q: %s",
+			maxSizeStr,
+			err.Error(),
+		)
+	}
+	if maxSize <= 0 {
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"response_cache max_size must be positive, got %q",
+			maxSizeStr,
+		)
+	}
+
+	finalityDepth := yamlResponseCacheConfig.FinalityDepth
+	if finalityDepth == 0 {
+		finalityDepth = DefaultResponseCacheFinalityDepth
+	}
+
+	methodTTLs := make(map[string]time.Duration, len(yamlResponseCacheConfig.Rules))
+	for _, rule := range yamlResponseCacheConfig.Rules {
+		if rule.Method == "" {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrap(
+				"empty response_cache rule method",
+			)
+		}
+
+		if _, ok := methodTTLs[rule.Method]; ok {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"duplicate response_cache rule for method %q",
+				rule.Method,
+			)
+		}
+
+		if rule.TTLSeconds == 0 {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"response_cache rule for method %q requires a positive ttl_seconds",
+				rule.Method,
+			)
+		}
+
+		methodTTLs[rule.Method] = time.Duration(rule.TTLSeconds) * time.Second
+	}
+
+	return &RelayMinerResponseCacheConfig{
+		MaxSize:       maxSize,
+		FinalityDepth: finalityDepth,
+		MethodTTLs:    methodTTLs,
+	}, nil
+}
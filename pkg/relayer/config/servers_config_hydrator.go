@@ -17,6 +17,10 @@ func (relayMinerConfig *RelayMinerConfig) HydrateServers(
 
 	relayMinerConfig.Servers = make(map[string]*RelayMinerServerConfig)
 
+	// Suppliers sharing a listen url share the same server, whose TLS section may
+	// be carried by any of them, not necessarily the first one.
+	tlsSupplierConfigs := getTLSSupplierConfigs(yamlSupplierConfigs)
+
 	for _, yamlSupplierConfig := range yamlSupplierConfigs {
 		listenUrl, err := url.Parse(yamlSupplierConfig.ListenUrl)
 		if err != nil {
@@ -33,7 +37,12 @@ func (relayMinerConfig *RelayMinerConfig) HydrateServers(
 			)
 		}
 
-		if _, ok := relayMinerConfig.Servers[yamlSupplierConfig.ListenUrl]; ok {
+		if existingServerConfig, ok := relayMinerConfig.Servers[yamlSupplierConfig.ListenUrl]; ok {
+			// Suppliers sharing a listen url share the same server, so their TLS
+			// sections (if any) must not contradict the one already hydrated.
+			if err := existingServerConfig.ensureCompatibleTLSConfig(yamlSupplierConfig); err != nil {
+				return err
+			}
 			continue
 		}
 
@@ -59,10 +68,39 @@ func (relayMinerConfig *RelayMinerConfig) HydrateServers(
 		// Populate the server fields that are relevant to each supported server type
 		switch listenUrl.Scheme {
 		case "http", "ws":
+			if yamlSupplierConfig.TLS != (YAMLRelayMinerServerTLSConfig{}) {
+				return ErrRelayMinerConfigInvalidServer.Wrapf(
+					"tls section provided for non-https listen url %q",
+					yamlSupplierConfig.ListenUrl,
+				)
+			}
 			if err := serverConfig.parseHTTPServerConfig(yamlSupplierConfig); err != nil {
 				return err
 			}
 			serverConfig.ServerType = RelayMinerServerTypeHTTP
+		case "https", "wss":
+			if err := serverConfig.parseHTTPServerConfig(yamlSupplierConfig); err != nil {
+				return err
+			}
+			tlsSupplierConfig, ok := tlsSupplierConfigs[yamlSupplierConfig.ListenUrl]
+			if !ok {
+				tlsSupplierConfig = yamlSupplierConfig
+			}
+			if err := serverConfig.parseHTTPSServerConfig(tlsSupplierConfig); err != nil {
+				return err
+			}
+			serverConfig.ServerType = RelayMinerServerTypeHTTPS
+		case "grpc":
+			if yamlSupplierConfig.TLS != (YAMLRelayMinerServerTLSConfig{}) {
+				return ErrRelayMinerConfigInvalidServer.Wrapf(
+					"tls section provided for non-https listen url %q",
+					yamlSupplierConfig.ListenUrl,
+				)
+			}
+			if err := serverConfig.parseGRPCServerConfig(yamlSupplierConfig); err != nil {
+				return err
+			}
+			serverConfig.ServerType = RelayMinerServerTypeGRPC
 		default:
 			// Fail if the relay miner server type is not supported
 			return ErrRelayMinerConfigInvalidServer.Wrapf(
@@ -76,3 +114,22 @@ func (relayMinerConfig *RelayMinerConfig) HydrateServers(
 
 	return nil
 }
+
+// getTLSSupplierConfigs returns, for each listen url, the config of the first
+// supplier listening on it with a TLS section.
+func getTLSSupplierConfigs(
+	yamlSupplierConfigs []YAMLRelayMinerSupplierConfig,
+) map[string]YAMLRelayMinerSupplierConfig {
+	tlsSupplierConfigs := make(map[string]YAMLRelayMinerSupplierConfig)
+	for _, yamlSupplierConfig := range yamlSupplierConfigs {
+		if yamlSupplierConfig.TLS == (YAMLRelayMinerServerTLSConfig{}) {
+			continue
+		}
+		if _, ok := tlsSupplierConfigs[yamlSupplierConfig.ListenUrl]; ok {
+			continue
+		}
+		tlsSupplierConfigs[yamlSupplierConfig.ListenUrl] = yamlSupplierConfig
+	}
+
+	return tlsSupplierConfigs
+}
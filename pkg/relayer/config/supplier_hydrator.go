@@ -1,8 +1,6 @@
 package config
 
 import (
-	"net/url"
-
 	"github.com/pokt-network/poktroll/pkg/polylog"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
@@ -95,22 +93,13 @@ func (supplierConfig *RelayMinerSupplierConfig) hydrateServiceConfig(
 
 	logger.Debug().Msgf("🔧 Hydrating service config with backend URL: %s", supplierServiceConfigYAML.BackendUrl)
 
-	backendUrl, err := url.Parse(supplierServiceConfigYAML.BackendUrl)
+	backends, err := parseSupplierServiceBackends(supplierServiceConfigYAML)
 	if err != nil {
-		logger.Error().Msgf("❌ Error parsing backend URL '%s': %v", supplierServiceConfigYAML.BackendUrl, err)
-		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
-			"invalid supplier backend url %s",
-			err.Error(),
-		)
-	}
-
-	if backendUrl.Scheme == "" {
-		logger.Error().Msgf("❌ Error: missing scheme in supplier backend URL: %s", supplierServiceConfigYAML.BackendUrl)
-		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
-			"missing scheme in supplier backend url %s",
-			supplierServiceConfigYAML.BackendUrl,
-		)
+		logger.Error().Msgf("❌ Error parsing supplier backend URLs: %v", err)
+		return nil, err
 	}
+	// All the backends share the same scheme, which determines the server type.
+	backendUrl := backends[0].Url
 
 	logger.Debug().Msgf("✅ Successfully parsed backend URL with scheme: %s", backendUrl.Scheme)
 
@@ -125,7 +114,17 @@ func (supplierConfig *RelayMinerSupplierConfig) hydrateServiceConfig(
 		logger.Debug().Msgf("🌐 Configuring HTTP/WebSocket server type for scheme: %s", backendUrl.Scheme)
 
 		if err := supplierServiceConfig.
-			parseSupplierBackendUrl(supplierServiceConfigYAML); err != nil {
+			parseSupplierBackendUrl(supplierServiceConfigYAML, backends); err != nil {
+			logger.Error().Msgf("❌ Error parsing supplier backend URL: %v", err)
+			return nil, err
+		}
+		logger.Debug().Msg("✅ Successfully parsed supplier backend URL configuration")
+	case "grpc", "grpcs":
+		supplierConfig.ServerType = RelayMinerServerTypeGRPC
+		logger.Debug().Msgf("🌐 Configuring gRPC server type for scheme: %s", backendUrl.Scheme)
+
+		if err := supplierServiceConfig.
+			parseSupplierBackendUrl(supplierServiceConfigYAML, backends); err != nil {
 			logger.Error().Msgf("❌ Error parsing supplier backend URL: %v", err)
 			return nil, err
 		}
@@ -38,6 +38,16 @@ func (relayMinerConfig *RelayMinerConfig) HydrateSuppliers(
 			supplierConfig.RequestTimeoutSeconds = relayMinerConfig.DefaultRequestTimeoutSeconds
 		}
 
+		// Override the default rate limiting with the supplier's one, if any.
+		rateLimiting, err := parseRateLimitingConfig(
+			yamlSupplierConfig.RateLimiting,
+			relayMinerConfig.DefaultRateLimiting,
+		)
+		if err != nil {
+			return err
+		}
+		supplierConfig.RateLimiting = rateLimiting
+
 		// Supplier operator name should be unique
 		if _, ok := existingSuppliers[yamlSupplierConfig.ServiceId]; ok {
 			return ErrRelayMinerConfigInvalidSupplier.Wrapf(
@@ -50,9 +60,14 @@ func (relayMinerConfig *RelayMinerConfig) HydrateSuppliers(
 
 		logger.Info().Msgf("Hydrating supplier %s with config: %+v", yamlSupplierConfig.ServiceId, supplierConfig)
 
-		relayMinerConfig.
-			Servers[yamlSupplierConfig.ListenUrl].
-			SupplierConfigsMap[supplierConfig.ServiceId] = supplierConfig
+		serverConfig := relayMinerConfig.Servers[yamlSupplierConfig.ListenUrl]
+
+		// gRPC servers can only proxy to gRPC backends and vice versa.
+		if err := serverConfig.ensureCompatibleSupplierBackends(supplierConfig); err != nil {
+			return err
+		}
+
+		serverConfig.SupplierConfigsMap[supplierConfig.ServiceId] = supplierConfig
 	}
 
 	return nil
@@ -2,6 +2,8 @@ package config
 
 import (
 	"net/url"
+	"regexp"
+	"time"
 
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
@@ -10,8 +12,9 @@ type RelayMinerServerType int
 
 const (
 	RelayMinerServerTypeHTTP RelayMinerServerType = iota
+	RelayMinerServerTypeHTTPS
+	RelayMinerServerTypeGRPC
 	// TODO_FUTURE: Support other RelayMinerServerType:
-	// RelayMinerServerTypeHTTPS
 	// RelayMinerServerTypeTCP
 	// RelayMinerServerTypeUDP
 	// RelayMinerServerTypeQUIC
@@ -32,9 +35,18 @@ type YAMLRelayMinerConfig struct {
 	DisableSMTPersistence             bool                           `yaml:"disable_smt_persistence"`
 	Suppliers                         []YAMLRelayMinerSupplierConfig `yaml:"suppliers"`
 	Ping                              YAMLRelayMinerPingConfig       `yaml:"ping"`
+	Admin                             YAMLRelayMinerAdminConfig      `yaml:"admin"`
 	EnableOverServicing               bool                           `yaml:"enable_over_servicing"`
 	EnableEagerRelayRequestValidation bool                           `yaml:"enable_eager_relay_request_validation"`
 
+	// DefaultRateLimiting is the rate limiting applied to the relays of every
+	// supplier, unless overridden by the supplier's rate_limiting section.
+	DefaultRateLimiting YAMLRelayMinerRateLimitingConfig `yaml:"default_rate_limiting,omitempty"`
+
+	// MinedRelaysWAL holds the flush thresholds of the write-ahead logs persisting
+	// the mined relays of the sessions. Ignored when disable_smt_persistence is set.
+	MinedRelaysWAL YAMLRelayMinerMinedRelaysWALConfig `yaml:"mined_relays_wal,omitempty"`
+
 	// ServedRelaysBufferSize is the buffer size of the channel that forwards
 	// served, reward-eligible relays into the mining pipeline. When this buffer
 	// fills, relays are DROPPED (served but unpaid). Raise it for high-throughput
@@ -50,17 +62,22 @@ type YAMLRelayMinerConfig struct {
 	// per-relay independent, so parallelizing it is safe and raises sustained
 	// throughput before the served-relays buffer fills.
 	MiningWorkers uint64 `yaml:"mining_workers"`
-
-	// TODO_IMPROVE: Add a EnableErrorPropagation flag to control whether errors (i.e. non-2XX HTTP status codes)
-	// are propagated back to the client or masked as internal errors.
-	//
-	// The risk of (the current default behaviour) where RelayMiners propagate
-	// non-2XX HTTP status codes back to the client is that it PATH (or other clients)
-	// will sanction them. This is non-ideal but will indirectly lead to better Supplier
-	// behaviour in the long run until the following is implemented and communicated.
-	//
-	// See this discussion for more details: https://github.com/pokt-network/poktroll/pull/1608/files#r2175684381
-	// EnableErrorPropagation bool `yaml:"enable_error_propagation"`
+	// ShutdownStopTimeoutSeconds is how long the RelayMiner waits on shutdown for
+	// the relays being served to complete. Defaults to DefaultShutdownStopTimeoutSeconds.
+	ShutdownStopTimeoutSeconds uint64 `yaml:"shutdown_stop_timeout_seconds"`
+	// ShutdownDrainTimeoutSeconds is how long the RelayMiner waits on shutdown,
+	// once the relays being served completed, for the ones buffered in the mining
+	// pipeline to be persisted to their session trees before dropping them.
+	// Defaults to DefaultShutdownDrainTimeoutSeconds.
+	ShutdownDrainTimeoutSeconds uint64 `yaml:"shutdown_drain_timeout_seconds"`
+	// EventsCursorStorePath is the directory where the height up to which the
+	// block events were observed is persisted. When set, the blocks committed
+	// while the RelayMiner was not running are backfilled on startup.
+	EventsCursorStorePath string `yaml:"events_cursor_store_path,omitempty"`
+
+	// RemoteSigners are the signing daemons holding some of the signing keys,
+	// which then do not have to be in the RelayMiner's keyring.
+	RemoteSigners []YAMLRelayMinerRemoteSignerConfig `yaml:"remote_signers,omitempty"`
 }
 
 // YAMLRelayMinerPingConfig represents the configuration to expose a ping server.
@@ -70,12 +87,79 @@ type YAMLRelayMinerPingConfig struct {
 	Addr string `yaml:"addr"`
 }
 
+// YAMLRelayMinerAdminConfig represents the configuration to expose the operator
+// admin API, which inspects the session trees and retries failed claims and proofs.
+type YAMLRelayMinerAdminConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Addr is the address to bind to (format: 'hostname:port') where 'hostname' can be a DNS name or an IP
+	Addr string `yaml:"addr"`
+	// AuthToken is the bearer token the admin API requests must be authenticated with.
+	AuthToken string `yaml:"auth_token"`
+}
+
+// YAMLRelayMinerMinedRelaysWALConfig is the structure used to unmarshal the
+// mined_relays_wal section of the RelayMiner config file.
+// Unset (0) fields fall back to their defaults.
+type YAMLRelayMinerMinedRelaysWALConfig struct {
+	// MaxBufferedBytes is the size of the in-memory buffer of mined relays above
+	// which it is flushed to disk.
+	MaxBufferedBytes uint64 `yaml:"max_buffered_bytes"`
+	// FlushIntervalSeconds is the cadence at which the in-memory buffer of mined
+	// relays is flushed to disk.
+	FlushIntervalSeconds uint64 `yaml:"flush_interval_seconds"`
+	// WriteQueueSize is the number of flushed buffers waiting to be written to
+	// disk above which flushes are dropped and backpressure is reported.
+	WriteQueueSize uint64 `yaml:"write_queue_size"`
+}
+
+// YAMLRelayMinerRemoteSignerConfig is the structure used to unmarshal an entry of
+// the remote_signers section of the RelayMiner config file.
+type YAMLRelayMinerRemoteSignerConfig struct {
+	// Address is the address of the signing daemon, either
+	// unix:///<socket_path> or tcp://<host>:<port>.
+	Address string `yaml:"address"`
+	// KeyNames are the names of the signing keys held by the signing daemon.
+	KeyNames []string `yaml:"key_names"`
+	// TimeoutSeconds bounds the duration of each request to the signing daemon.
+	TimeoutSeconds uint64 `yaml:"timeout_seconds"`
+	// TLS holds the mutual TLS files, required for tcp:// addresses.
+	TLS YAMLRelayMinerRemoteSignerTLSConfig `yaml:"tls,omitempty"`
+}
+
+// YAMLRelayMinerRemoteSignerTLSConfig is the structure used to unmarshal the
+// mutual TLS section of a remote signer.
+type YAMLRelayMinerRemoteSignerTLSConfig struct {
+	// CAFile is the CA the signing daemon's certificate is verified against.
+	CAFile string `yaml:"ca_file"`
+	// CertFile and KeyFile are the client certificate and private key presented
+	// to the signing daemon.
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ServerName is the name the signing daemon's certificate is verified against.
+	// It defaults to the host of the address.
+	ServerName string `yaml:"server_name,omitempty"`
+}
+
 // YAMLRelayMinerPocketNodeConfig is the structure used to unmarshal the pocket
 // node URLs section of the RelayMiner config file.
 type YAMLRelayMinerPocketNodeConfig struct {
 	QueryNodeRPCUrl  string `yaml:"query_node_rpc_url"`
 	QueryNodeGRPCUrl string `yaml:"query_node_grpc_url"`
 	TxNodeRPCUrl     string `yaml:"tx_node_rpc_url"`
+
+	// QueryNodeRPCUrls, QueryNodeGRPCUrls and TxNodeRPCUrls are additional
+	// endpoints of each role, failed over to in order when the preceding ones
+	// are unhealthy. They follow the single URL of their role, if any.
+	QueryNodeRPCUrls  []string `yaml:"query_node_rpc_urls,omitempty"`
+	QueryNodeGRPCUrls []string `yaml:"query_node_grpc_urls,omitempty"`
+	TxNodeRPCUrls     []string `yaml:"tx_node_rpc_urls,omitempty"`
+
+	// HealthCheckIntervalSeconds is the interval at which the endpoints of the
+	// roles with more than one endpoint are health and lag checked.
+	HealthCheckIntervalSeconds uint64 `yaml:"health_check_interval_seconds,omitempty"`
+	// MaxBlockLag is the number of blocks an endpoint may lag behind the most
+	// advanced endpoint of its role before it is considered unhealthy.
+	MaxBlockLag uint64 `yaml:"max_block_lag,omitempty"`
 }
 
 // YAMLRelayMinerMetricsConfig is the structure used to unmarshal the metrics
@@ -96,6 +180,35 @@ type YAMLRelayMinerSupplierConfig struct {
 	RequestTimeoutSeconds uint64                                         `yaml:"request_timeout_seconds"`
 	MaxBodySize           string                                         `yaml:"max_body_size"`
 	XForwardedHostLookup  bool                                           `yaml:"x_forwarded_host_lookup"`
+	TLS                   YAMLRelayMinerServerTLSConfig                  `yaml:"tls,omitempty"`
+	RateLimiting          YAMLRelayMinerRateLimitingConfig               `yaml:"rate_limiting,omitempty"`
+}
+
+// YAMLRelayMinerRateLimitingConfig is the structure used to unmarshal the
+// default_rate_limiting section and the rate_limiting sub-section of a supplier.
+// Unset limits are inherited from default_rate_limiting.
+type YAMLRelayMinerRateLimitingConfig struct {
+	PerApplication       *YAMLRelayMinerRateLimit           `yaml:"per_application,omitempty"`
+	PerGateway           *YAMLRelayMinerRateLimit           `yaml:"per_gateway,omitempty"`
+	ApplicationOverrides map[string]YAMLRelayMinerRateLimit `yaml:"application_overrides,omitempty"`
+}
+
+// YAMLRelayMinerRateLimit is the structure used to unmarshal a rate limit.
+// A zero value disables the corresponding limit.
+type YAMLRelayMinerRateLimit struct {
+	RequestsPerSecond     uint64 `yaml:"requests_per_second,omitempty"`
+	Burst                 uint64 `yaml:"burst,omitempty"`
+	MaxConcurrentRequests uint64 `yaml:"max_concurrent_requests,omitempty"`
+}
+
+// YAMLRelayMinerServerTLSConfig is the structure used to unmarshal the TLS
+// sub-section of a supplier whose listen_url uses the "https" scheme.
+type YAMLRelayMinerServerTLSConfig struct {
+	CertFile              string `yaml:"cert_file,omitempty"`
+	KeyFile               string `yaml:"key_file,omitempty"`
+	ClientCAFile          string `yaml:"client_ca_file,omitempty"`
+	RequireClientCert     bool   `yaml:"require_client_cert,omitempty"`
+	ReloadIntervalSeconds uint64 `yaml:"reload_interval_seconds,omitempty"`
 }
 
 // YAMLRelayMinerSupplierServiceConfig is the structure used to unmarshal the supplier
@@ -103,8 +216,80 @@ type YAMLRelayMinerSupplierConfig struct {
 type YAMLRelayMinerSupplierServiceConfig struct {
 	Authentication       YAMLRelayMinerSupplierServiceAuthentication `yaml:"authentication,omitempty"`
 	BackendUrl           string                                      `yaml:"backend_url"`
+	Backends             []YAMLRelayMinerSupplierServiceBackend      `yaml:"backends,omitempty"`
+	LoadBalancing        YAMLRelayMinerLoadBalancingConfig           `yaml:"load_balancing,omitempty"`
 	Headers              map[string]string                           `yaml:"headers,omitempty"`
 	ForwardPocketHeaders bool                                        `yaml:"forward_pocket_headers"`
+	Metering             YAMLRelayMinerWebsocketMeteringConfig       `yaml:"metering,omitempty"`
+	ResponseCache        YAMLRelayMinerResponseCacheConfig           `yaml:"response_cache,omitempty"`
+	ErrorPolicy          YAMLRelayMinerErrorPolicyConfig             `yaml:"error_policy,omitempty"`
+}
+
+// YAMLRelayMinerSupplierServiceBackend is the structure used to unmarshal an
+// entry of the backends list of a service config.
+type YAMLRelayMinerSupplierServiceBackend struct {
+	Url    string `yaml:"url"`
+	Weight uint64 `yaml:"weight,omitempty"`
+}
+
+// YAMLRelayMinerLoadBalancingConfig is the structure used to unmarshal the
+// load_balancing sub-section of a service config. It defines how relays are
+// spread across the service backends.
+type YAMLRelayMinerLoadBalancingConfig struct {
+	Strategy                       string  `yaml:"strategy,omitempty"`
+	HealthCheckIntervalSeconds     uint64  `yaml:"health_check_interval_seconds,omitempty"`
+	CircuitBreakerFailureThreshold uint64  `yaml:"circuit_breaker_failure_threshold,omitempty"`
+	CircuitBreakerCooldownSeconds  uint64  `yaml:"circuit_breaker_cooldown_seconds,omitempty"`
+	MaxRetries                     *uint64 `yaml:"max_retries,omitempty"`
+}
+
+// YAMLRelayMinerWebsocketMeteringConfig is the structure used to unmarshal the
+// metering sub-section of a websocket service config. It defines which websocket
+// messages complete a payable unit of work (i.e. a relay emitted to the miner).
+type YAMLRelayMinerWebsocketMeteringConfig struct {
+	Strategy  string `yaml:"strategy,omitempty"`
+	UnitSize  string `yaml:"unit_size,omitempty"`
+	Delimiter string `yaml:"delimiter,omitempty"`
+}
+
+// YAMLRelayMinerResponseCacheConfig is the structure used to unmarshal the
+// response_cache sub-section of a service config. It defines which JSON-RPC
+// methods have their responses cached, and for how long.
+type YAMLRelayMinerResponseCacheConfig struct {
+	MaxSize       string                            `yaml:"max_size,omitempty"`
+	FinalityDepth uint64                            `yaml:"finality_depth,omitempty"`
+	Rules         []YAMLRelayMinerResponseCacheRule `yaml:"rules,omitempty"`
+}
+
+// YAMLRelayMinerResponseCacheRule is the structure used to unmarshal an entry
+// of the rules list of a response cache config.
+type YAMLRelayMinerResponseCacheRule struct {
+	Method     string `yaml:"method"`
+	TTLSeconds uint64 `yaml:"ttl_seconds"`
+}
+
+// YAMLRelayMinerErrorPolicyConfig is the structure used to unmarshal the
+// error_policy sub-section of a service config. It defines how the backend
+// errors are replied to the client and whether their relays are rewarded.
+type YAMLRelayMinerErrorPolicyConfig struct {
+	Rules []YAMLRelayMinerErrorPolicyRule `yaml:"rules,omitempty"`
+}
+
+// YAMLRelayMinerErrorPolicyRule is the structure used to unmarshal an entry of
+// the rules list of an error policy config.
+type YAMLRelayMinerErrorPolicyRule struct {
+	Name           string                         `yaml:"name,omitempty"`
+	Match          YAMLRelayMinerErrorPolicyMatch `yaml:"match"`
+	Action         string                         `yaml:"action,omitempty"`
+	RewardEligible *bool                          `yaml:"reward_eligible,omitempty"`
+}
+
+// YAMLRelayMinerErrorPolicyMatch is the structure used to unmarshal the match
+// criteria of an error policy rule.
+type YAMLRelayMinerErrorPolicyMatch struct {
+	StatusCodes       []int   `yaml:"status_codes,omitempty"`
+	JSONRPCErrorCodes []int64 `yaml:"jsonrpc_error_codes,omitempty"`
+	BodyPattern       string  `yaml:"body_pattern,omitempty"`
 }
 
 // YAMLRelayMinerSupplierServiceAuthentication is the structure used to unmarshal
@@ -134,6 +319,7 @@ type RelayMinerConfig struct {
 	SmtStorePath                      string
 	DisableSMTPersistence             bool
 	Ping                              *RelayMinerPingConfig
+	Admin                             *RelayMinerAdminConfig
 	EnableOverServicing               bool
 	EnableEagerRelayRequestValidation bool
 	// ServedRelaysBufferSize is the buffer size of the served-relays → mining
@@ -145,6 +331,22 @@ type RelayMinerConfig struct {
 	// MiningWorkers is the number of concurrent relay-mining workers (0 = auto).
 	// See YAML field of the same name.
 	MiningWorkers int
+	// ShutdownStopTimeout bounds how long the relays being served are waited for
+	// on shutdown. See YAML field of the same name.
+	ShutdownStopTimeout time.Duration
+	// ShutdownDrainTimeout bounds how long the mining pipeline is drained for on
+	// shutdown. See YAML field of the same name.
+	ShutdownDrainTimeout time.Duration
+	// DefaultRateLimiting is the rate limiting inherited by the suppliers that
+	// do not override it.
+	DefaultRateLimiting *RelayMinerRateLimitingConfig
+	// MinedRelaysWAL holds the flush thresholds of the mined relays write-ahead logs.
+	MinedRelaysWAL *RelayMinerMinedRelaysWALConfig
+	// RemoteSigners are the signing daemons holding some of the signing keys.
+	RemoteSigners []*RelayMinerRemoteSignerConfig
+	// EventsCursorStorePath is the directory of the block events height cursor,
+	// empty if it is not persisted. See YAML field of the same name.
+	EventsCursorStorePath string
 }
 
 // TODO_TECHDEBT(@red-0ne): Remove this structure altogether. See the discussion here for ref:
@@ -157,18 +359,74 @@ type RelayMinerPingConfig struct {
 	Addr string
 }
 
+// RelayMinerAdminConfig is the structure resulting from parsing the admin API
+// server configuration.
+type RelayMinerAdminConfig struct {
+	Enabled bool
+	// Addr is the address to bind to (format: hostname:port) where 'hostname' can be a DNS name or an IP
+	Addr string
+	// AuthToken is the bearer token the admin API requests must be authenticated with.
+	AuthToken string
+}
+
+// RelayMinerMinedRelaysWALConfig is the structure resulting from parsing the
+// mined_relays_wal section of the RelayMiner config file.
+type RelayMinerMinedRelaysWALConfig struct {
+	// MaxBufferedBytes is the in-memory buffer size above which it is flushed to disk.
+	MaxBufferedBytes int
+	// FlushInterval is the cadence at which the in-memory buffer is flushed to disk.
+	FlushInterval time.Duration
+	// WriteQueueSize is the number of flushed buffers waiting to be written to disk
+	// above which backpressure is reported.
+	WriteQueueSize int
+}
+
+// RelayMinerRemoteSignerConfig is the structure resulting from parsing an entry
+// of the remote_signers section of the RelayMiner config file.
+type RelayMinerRemoteSignerConfig struct {
+	// Address is the address of the signing daemon, either
+	// unix:///<socket_path> or tcp://<host>:<port>.
+	Address string
+	// KeyNames are the names of the signing keys held by the signing daemon.
+	KeyNames []string
+	// Timeout bounds the duration of each request to the signing daemon.
+	Timeout time.Duration
+	// TLS holds the mutual TLS files of tcp:// addresses, nil for unix:// ones.
+	TLS *RelayMinerRemoteSignerTLSConfig
+}
+
+// RelayMinerRemoteSignerTLSConfig is the structure resulting from parsing the
+// mutual TLS section of a remote signer.
+type RelayMinerRemoteSignerTLSConfig struct {
+	CAFile     string
+	CertFile   string
+	KeyFile    string
+	ServerName string
+}
+
 // RelayMinerPocketNodeConfig is the structure resulting from parsing the pocket
 // node URLs section of the RelayMiner config file
 type RelayMinerPocketNodeConfig struct {
+	// QueryNodeRPCUrl, QueryNodeGRPCUrl and TxNodeRPCUrl are the preferred
+	// endpoints of each role, i.e. the first of their endpoints list.
 	QueryNodeRPCUrl  *url.URL
 	QueryNodeGRPCUrl *url.URL
 	TxNodeRPCUrl     *url.URL
+
+	// QueryNodeRPCUrls, QueryNodeGRPCUrls and TxNodeRPCUrls are all the endpoints
+	// of each role, in failover order.
+	QueryNodeRPCUrls  []*url.URL
+	QueryNodeGRPCUrls []*url.URL
+	TxNodeRPCUrls     []*url.URL
+
+	HealthCheckInterval time.Duration
+	MaxBlockLag         int64
 }
 
 // RelayMinerServerConfig is the structure resulting from parsing the supplier's
 // server section of the RelayMiner config file.
 // Each server section embeds a map of supplier configs that are associated with it.
-// TODO_IMPROVE: Other server types may embed other fields in the future; eg. "https" may embed a TLS config.
+// TODO_IMPROVE: Other server types may embed other fields in the future.
 type RelayMinerServerConfig struct {
 	// ServerType is the transport protocol used by the server like (http, https, etc.)
 	ServerType RelayMinerServerType
@@ -187,6 +445,10 @@ type RelayMinerServerConfig struct {
 	// MaxBodySize sets the largest request or response body size (in bytes) that the RelayMiner will accept for this service.
 	MaxBodySize int64
 
+	// TLS is the TLS configuration of the server.
+	// It is only set when ServerType is RelayMinerServerTypeHTTPS.
+	TLS *RelayMinerServerTLSConfig
+
 	// EnableEagerRelayRequestValidation enables immediate validation of all incoming relay requests.
 	//
 	// When enabled (true, eager validation):
@@ -209,6 +471,32 @@ type RelayMinerServerConfig struct {
 	EnableEagerRelayRequestValidation bool
 }
 
+// RelayMinerServerTLSConfig is the structure resulting from parsing the TLS
+// sub-section of an "https" server.
+type RelayMinerServerTLSConfig struct {
+	// CertFile is the path to the PEM encoded certificate (chain) presented to gateways.
+	CertFile string
+
+	// KeyFile is the path to the PEM encoded private key matching CertFile.
+	KeyFile string
+
+	// ClientCAFile is the optional path to a PEM bundle of CAs used to verify
+	// gateway client certificates (i.e. mutual TLS).
+	// If empty, client certificates are neither requested nor verified.
+	ClientCAFile string
+
+	// RequireClientCert rejects TLS handshakes that do not present a client
+	// certificate signed by one of the ClientCAFile CAs.
+	// If false and ClientCAFile is set, client certificates are verified only
+	// when presented.
+	RequireClientCert bool
+
+	// ReloadInterval is the minimum duration between two checks of the certificate,
+	// key and client CA files for changes. Rotated files are picked up by new
+	// TLS handshakes without restarting the RelayMiner.
+	ReloadInterval time.Duration
+}
+
 // RelayMinerMetricsConfig is the structure resulting from parsing the metrics
 // section of the RelayMiner config file
 type RelayMinerMetricsConfig struct {
@@ -258,13 +546,67 @@ type RelayMinerSupplierConfig struct {
 	// RequestTimeoutSeconds is the timeout in seconds for the relay requests forwarded
 	// to the backend service.
 	RequestTimeoutSeconds uint64
+
+	// RateLimiting is the rate limiting applied to the relays of the service,
+	// i.e. the default rate limiting merged with the supplier's overrides.
+	RateLimiting *RelayMinerRateLimitingConfig
+}
+
+// RelayMinerRateLimitingConfig is the structure resulting from parsing the
+// rate limiting of a service.
+//
+// Limits are enforced per service, independently for each application and
+// for each gateway sending relays to it. Gateways are identified by the key
+// image of the relay requests' ring signature, which is the same for all the
+// requests signed by the same key without revealing which key it is.
+type RelayMinerRateLimitingConfig struct {
+	// PerApplication is the limit applied to each application without override.
+	PerApplication RelayMinerRateLimit
+	// PerGateway is the limit applied to each gateway (i.e. ring signer).
+	PerGateway RelayMinerRateLimit
+	// ApplicationOverrides maps application addresses to the limit that
+	// replaces PerApplication for them.
+	ApplicationOverrides map[string]RelayMinerRateLimit
+}
+
+// ApplicationRateLimit returns the rate limit of the given application.
+func (rateLimitingConfig *RelayMinerRateLimitingConfig) ApplicationRateLimit(appAddress string) RelayMinerRateLimit {
+	if rateLimit, ok := rateLimitingConfig.ApplicationOverrides[appAddress]; ok {
+		return rateLimit
+	}
+
+	return rateLimitingConfig.PerApplication
+}
+
+// RelayMinerRateLimit is a token bucket limit on the number of requests per
+// second, along with a limit on the number of concurrent requests.
+// A zero value disables the corresponding limit.
+type RelayMinerRateLimit struct {
+	RequestsPerSecond uint64
+	// Burst is the number of requests that can be sent at once.
+	// It defaults to RequestsPerSecond.
+	Burst                 uint64
+	MaxConcurrentRequests uint64
+}
+
+// IsUnlimited returns true if none of the limits is enabled.
+func (rateLimit RelayMinerRateLimit) IsUnlimited() bool {
+	return rateLimit.RequestsPerSecond == 0 && rateLimit.MaxConcurrentRequests == 0
 }
 
 // RelayMinerSupplierServiceConfig is the structure resulting from parsing the supplier
 // service sub-section of the RelayMiner config file.
 type RelayMinerSupplierServiceConfig struct {
 	// BackendUrl is the URL of the service that relays will be proxied to.
+	// When multiple Backends are configured, it is the URL of the first one.
 	BackendUrl *url.URL
+	// Backends is the list of backends that relays are spread across.
+	// It always contains at least one backend, the one of BackendUrl.
+	// All the backends of a service config share the same URL scheme.
+	Backends []*RelayMinerSupplierServiceBackend
+	// LoadBalancing defines how relays are spread across Backends, how their
+	// health is checked and when a failing backend is taken out of rotation.
+	LoadBalancing *RelayMinerLoadBalancingConfig
 	// Authentication is the basic auth structure used to authenticate to the
 	// request being proxied from the current relay miner server.
 	// If the service the relay requests are forwarded to requires basic auth
@@ -278,6 +620,147 @@ type RelayMinerSupplierServiceConfig struct {
 	// ForwardPocketHeaders toggles if headers prefixed with 'Pocket-' should be forwarded to
 	// the backend service servicing the relay requests.
 	ForwardPocketHeaders bool
+	// Metering defines which messages of a websocket service complete a payable
+	// unit of work. It is nil for non-websocket services, and for websocket
+	// services that did not configure it, in which case every message is paid.
+	Metering *RelayMinerWebsocketMeteringConfig
+	// ResponseCache defines which JSON-RPC responses are cached and served
+	// without reaching the backends. It is nil if response caching is disabled.
+	ResponseCache *RelayMinerResponseCacheConfig
+	// ErrorPolicy defines how the backend errors matching its rules are replied
+	// to the client and whether their relays are rewarded. The responses matching
+	// no rule, and all of them if it is nil, are handled by default: 5xx responses
+	// are retried on the other backends, then passed through unrewarded.
+	ErrorPolicy *RelayMinerErrorPolicyConfig
+}
+
+// RelayMinerResponseCacheConfig is the structure resulting from parsing the
+// response_cache sub-section of a service config.
+type RelayMinerResponseCacheConfig struct {
+	// MaxSize is the maximum cumulative size, in bytes, of the cached responses.
+	// The least recently used responses are evicted once it is reached.
+	MaxSize int64
+	// FinalityDepth is the number of blocks a block must be below the chain
+	// head for the responses of the requests referencing it to be cached,
+	// since the blocks near the head may still be reorged.
+	FinalityDepth uint64
+	// MethodTTLs maps the cacheable JSON-RPC methods to the duration their
+	// responses are cached for.
+	MethodTTLs map[string]time.Duration
+}
+
+// ErrorPolicyAction is the action taken on a backend response matching an
+// error policy rule.
+type ErrorPolicyAction string
+
+const (
+	// ErrorPolicyActionPassThrough replies the backend response as-is (the default).
+	ErrorPolicyActionPassThrough ErrorPolicyAction = "pass_through"
+	// ErrorPolicyActionMask replies a generic RelayMinerError instead of the
+	// backend response, which is never rewarded.
+	ErrorPolicyActionMask ErrorPolicyAction = "mask"
+	// ErrorPolicyActionRetry retries the relay on another backend of the service.
+	// The last backend response is passed through once the retries are exhausted.
+	ErrorPolicyActionRetry ErrorPolicyAction = "retry"
+)
+
+// RelayMinerErrorPolicyConfig is the structure resulting from parsing the
+// error_policy sub-section of a service config.
+type RelayMinerErrorPolicyConfig struct {
+	// Rules are evaluated in order, the first one matching a backend response
+	// decides how it is handled.
+	Rules []*RelayMinerErrorPolicyRule
+}
+
+// RelayMinerErrorPolicyRule is the structure resulting from parsing an entry of
+// the rules list of an error policy config.
+// A backend response matches the rule if it matches all of its set criteria.
+type RelayMinerErrorPolicyRule struct {
+	// Name identifies the rule in the logs and metrics.
+	// It defaults to "rule_<index>", index being the rule's position in the list.
+	Name string
+	// StatusCodes match the responses having one of the HTTP status codes.
+	StatusCodes []int
+	// JSONRPCErrorCodes match the JSON-RPC responses, or batches of responses,
+	// having an error with one of the codes.
+	JSONRPCErrorCodes []int64
+	// BodyPattern matches the responses whose body matches the regular expression.
+	BodyPattern *regexp.Regexp
+	Action      ErrorPolicyAction
+	// RewardEligible overrides whether the relays of the matched responses are
+	// rewarded. If nil, only the responses with a non-5xx status code are rewarded.
+	// Masked relays are never rewarded.
+	RewardEligible *bool
+}
+
+// WebsocketMeteringStrategy is the strategy used to decide which websocket
+// messages complete a payable unit of work.
+type WebsocketMeteringStrategy string
+
+const (
+	// WebsocketMeteringStrategyPerMessage pays every message (the default).
+	WebsocketMeteringStrategyPerMessage WebsocketMeteringStrategy = "per_message"
+	// WebsocketMeteringStrategyPerBytes pays every UnitSize bytes transmitted.
+	WebsocketMeteringStrategyPerBytes WebsocketMeteringStrategy = "per_bytes"
+	// WebsocketMeteringStrategyDelimiter pays every message containing Delimiter,
+	// the delimiter marking the end of a unit of work (e.g. a record or a chunk).
+	WebsocketMeteringStrategyDelimiter WebsocketMeteringStrategy = "delimiter"
+)
+
+// RelayMinerWebsocketMeteringConfig is the structure resulting from parsing the
+// metering sub-section of a websocket service config.
+type RelayMinerWebsocketMeteringConfig struct {
+	Strategy WebsocketMeteringStrategy
+	// UnitSize is the number of bytes of a unit of work.
+	// Only set for the per_bytes strategy.
+	UnitSize int64
+	// Delimiter is the byte sequence ending a unit of work.
+	// Only set for the delimiter strategy.
+	Delimiter []byte
+}
+
+// RelayMinerSupplierServiceBackend is the structure resulting from parsing an
+// entry of the backends list of a service config.
+type RelayMinerSupplierServiceBackend struct {
+	Url *url.URL
+	// Weight is the relative share of relays sent to the backend by the weighted
+	// strategy. It defaults to 1 and is ignored by the other strategies.
+	Weight uint64
+}
+
+// LoadBalancingStrategy is the strategy used to select the backend a relay is
+// forwarded to.
+type LoadBalancingStrategy string
+
+const (
+	// LoadBalancingStrategyRoundRobin cycles through the backends (the default).
+	LoadBalancingStrategyRoundRobin LoadBalancingStrategy = "round_robin"
+	// LoadBalancingStrategyWeighted cycles through the backends proportionally
+	// to their weight.
+	LoadBalancingStrategyWeighted LoadBalancingStrategy = "weighted"
+	// LoadBalancingStrategyLeastInflight selects the backend with the fewest
+	// requests in flight.
+	LoadBalancingStrategyLeastInflight LoadBalancingStrategy = "least_inflight"
+)
+
+// RelayMinerLoadBalancingConfig is the structure resulting from parsing the
+// load_balancing sub-section of a service config.
+type RelayMinerLoadBalancingConfig struct {
+	Strategy LoadBalancingStrategy
+	// HealthCheckInterval is the interval at which the backends are actively
+	// checked. Unhealthy backends are skipped until they pass a check again.
+	// Health checks only run for service configs with more than one backend.
+	HealthCheckInterval time.Duration
+	// CircuitBreakerFailureThreshold is the number of consecutive failed
+	// requests after which a backend is skipped for CircuitBreakerCooldown.
+	CircuitBreakerFailureThreshold uint64
+	// CircuitBreakerCooldown is the duration a backend is skipped for after
+	// reaching CircuitBreakerFailureThreshold. The next request sent to it
+	// afterward either closes the circuit (success) or reopens it (failure).
+	CircuitBreakerCooldown time.Duration
+	// MaxRetries is the maximum number of times a failed synchronous relay is
+	// retried, each time on a different backend, before replying with an error.
+	MaxRetries uint64
 }
 
 // RelayMinerSupplierServiceAuthentication is the structure resulting from parsing
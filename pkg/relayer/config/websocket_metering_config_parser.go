@@ -0,0 +1,72 @@
+package config
+
+import (
+	"net/url"
+
+	"github.com/docker/go-units"
+)
+
+// parseWebsocketMeteringConfig validates the metering sub-section of a service
+// config and returns its hydrated counterpart.
+// Metering is only meaningful for services bridged over websockets, it is
+// rejected for any other backend url scheme.
+func parseWebsocketMeteringConfig(
+	backendUrl *url.URL,
+	yamlMeteringConfig YAMLRelayMinerWebsocketMeteringConfig,
+) (*RelayMinerWebsocketMeteringConfig, error) {
+	if backendUrl.Scheme != "ws" && backendUrl.Scheme != "wss" {
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"metering is only supported by websocket backends, got backend url scheme %q",
+			backendUrl.Scheme,
+		)
+	}
+
+	strategy := WebsocketMeteringStrategy(yamlMeteringConfig.Strategy)
+	if strategy == "" {
+		strategy = WebsocketMeteringStrategyPerMessage
+	}
+
+	meteringConfig := &RelayMinerWebsocketMeteringConfig{Strategy: strategy}
+
+	switch strategy {
+	case WebsocketMeteringStrategyPerMessage:
+	case WebsocketMeteringStrategyPerBytes:
+		if yamlMeteringConfig.UnitSize == "" {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"metering strategy %q requires a unit_size",
+				strategy,
+			)
+		}
+
+		unitSize, err := units.RAMInBytes(yamlMeteringConfig.UnitSize)
+		if err != nil {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"invalid metering unit_size %q: %s",
+				yamlMeteringConfig.UnitSize,
+				err.Error(),
+			)
+		}
+		if unitSize <= 0 {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"metering unit_size must be positive, got %q",
+				yamlMeteringConfig.UnitSize,
+			)
+		}
+		meteringConfig.UnitSize = unitSize
+	case WebsocketMeteringStrategyDelimiter:
+		if yamlMeteringConfig.Delimiter == "" {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"metering strategy %q requires a non-empty delimiter",
+				strategy,
+			)
+		}
+		meteringConfig.Delimiter = []byte(yamlMeteringConfig.Delimiter)
+	default:
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"unsupported metering strategy %q",
+			strategy,
+		)
+	}
+
+	return meteringConfig, nil
+}
@@ -15,10 +15,12 @@ import (
 )
 
 // BuildServiceBackendRequest builds the service backend request from the
-// relay request and the service configuration.
+// relay request and the service configuration, targeting the given backend URL
+// (i.e. one of the service configuration backends).
 func BuildServiceBackendRequest(
 	relayRequest *types.RelayRequest,
 	serviceConfig *config.RelayMinerSupplierServiceConfig,
+	backendUrl *url.URL,
 ) (*http.Request, error) {
 	// Deserialize the relay request payload to get the upstream HTTP request.
 	poktHTTPRequest, err := sdktypes.DeserializeHTTPRequest(relayRequest.Payload)
@@ -31,8 +33,8 @@ func BuildServiceBackendRequest(
 		return nil, err
 	}
 
-	requestUrl.Host = serviceConfig.BackendUrl.Host
-	requestUrl.Scheme = serviceConfig.BackendUrl.Scheme
+	requestUrl.Host = backendUrl.Host
+	requestUrl.Scheme = backendUrl.Scheme
 
 	// Prepend the service's backend URL path to the upstream request path to ensure
 	// proper routing while  preserving the original request structure. For RESTful APIs,
@@ -42,7 +44,7 @@ func BuildServiceBackendRequest(
 	// - Backend URL: http://host:8080/api/v1
 	// - Upstream path: /users
 	// - Final path: http://host:8080/api/v1/users
-	requestUrl.Path = path.Join(serviceConfig.BackendUrl.Path, requestUrl.Path)
+	requestUrl.Path = path.Join(backendUrl.Path, requestUrl.Path)
 
 	// Merge query parameters from both the upstream request and service's backend URL
 	// to maintain filtering and pagination functionality.
@@ -52,7 +54,7 @@ func BuildServiceBackendRequest(
 	// - Upstream params: page=1
 	// - Final URL: http://host:8080/api/v1?key=abc&page=1
 	query := requestUrl.Query()
-	for key, values := range serviceConfig.BackendUrl.Query() {
+	for key, values := range backendUrl.Query() {
 		for _, value := range values {
 			query.Add(key, value)
 		}
@@ -96,7 +98,7 @@ func BuildServiceBackendRequest(
 	// request paths and query parameters.
 	// Use the same method, headers, and body as the original request to query the
 	// backend URL.
-	httpRequest.Host = serviceConfig.BackendUrl.Host
+	httpRequest.Host = backendUrl.Host
 
 	if serviceConfig.Authentication != nil {
 		httpRequest.SetBasicAuth(
@@ -11,6 +11,7 @@ import (
 	"github.com/pokt-network/smt"
 
 	"github.com/pokt-network/poktroll/pkg/observable"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 )
@@ -37,6 +38,10 @@ type Miner interface {
 		ctx context.Context,
 		servedRelayObs RelaysObservable,
 	) (minedRelaysObs MinedRelaysObservable)
+
+	// NumProcessedRelays returns the number of served relays processed (i.e. mined,
+	// skipped or failed) and the number of them mined since the Miner was created.
+	NumProcessedRelays() (numServedRelaysProcessed, numRelaysMined uint64)
 }
 
 type MinerOption func(Miner)
@@ -57,8 +62,26 @@ type RelayerProxy interface {
 	// and its RelayResponse has been signed and successfully sent to the client.
 	ServedRelays() RelaysObservable
 
+	// CloseServedRelays stops forwarding served relays to the miner and closes the
+	// ServedRelays observable once the relays already forwarded are delivered,
+	// letting the mining pipeline drain. The relays served afterwards are dropped.
+	CloseServedRelays()
+
+	// NumForwardedServedRelays returns the number of served relays forwarded to the
+	// mining pipeline since the RelayerProxy was created.
+	NumForwardedServedRelays() uint64
+
+	// NumDroppedServedRelays returns the number of served relays dropped from the
+	// mining pipeline, because it was full or closed, since the RelayerProxy was created.
+	NumDroppedServedRelays() uint64
+
 	// PingAll tests the connectivity between all the managed relay servers and their respective backend URLs.
 	PingAll(ctx context.Context) error
+
+	// UpdateServerConfigs applies the given relay servers configs (i.e. a map of
+	// listen addresses to server configs) by starting, stopping or restarting only
+	// the relay servers affected by the change.
+	UpdateServerConfigs(ctx context.Context, serverConfigs map[string]*config.RelayMinerServerConfig) error
 }
 
 type RelayerProxyOption func(RelayerProxy)
@@ -87,6 +110,10 @@ type RelayAuthenticator interface {
 	// GetSupplierOperatorAddresses returns the supplier operator addresses that
 	// the relay authenticator can use to sign relay responses.
 	GetSupplierOperatorAddresses() []string
+
+	// SetSigningKeyNames replaces the keyring signing key names used to sign
+	// relay responses (e.g. when the RelayMiner config is reloaded).
+	SetSigningKeyNames(signingKeyNames []string) error
 }
 
 type RelayAuthenticatorOption func(RelayAuthenticator)
@@ -122,22 +149,34 @@ type RelayerSessionsManager interface {
 	// network as necessary.
 	Start(ctx context.Context) error
 
-	// Stop unsubscribes all observables from the InsertRelays observable which
-	// will close downstream observables as they drain.
-	//
-	// TODO_TECHDEBT: Either add a mechanism to wait for draining to complete
-	// and/or ensure that the state at each pipeline stage is persisted to disk
-	// and exit as early as possible.
+	// Stop unsubscribes all observables from the InsertRelays observable, dropping
+	// the relays it still buffers, and persists the session trees to disk.
+	// Use Drain to insert the buffered relays before stopping.
 	Stop()
 
+	// Drain waits for the InsertRelays observable to complete and for all of its
+	// relays to be inserted in their session trees, or for ctx to be done, then
+	// calls Stop, flushing every session tree's WAL to disk.
+	// It returns the number of mined relays inserted since the manager started
+	// stopping and whether the InsertRelays observable was fully drained.
+	Drain(ctx context.Context) (numRelaysPersisted uint64, isDrained bool)
+
+	// NumProcessedMinedRelays returns the number of mined relays taken from the
+	// InsertRelays observable (i.e. inserted in their session trees or failed)
+	// since the manager was created.
+	NumProcessedMinedRelays() uint64
+
 	// SessionTreesSnapshots returns a point-in-time view of all session trees the
 	// manager is currently tracking.
 	//
 	// The snapshots are safe to iterate without holding the internal session tree
-	// mutex, making it suitable for tests and diagnostics.
-	//
-	// DEV_NOTE: This is used for testing purposes only.
+	// mutex, making it suitable for tests and diagnostics (e.g. the admin API).
 	SessionTreesSnapshots() []SessionTreeSnapshot
+
+	// RetrySession re-submits the failed claim or proof of the given session.
+	// It returns an error if the session is unknown, is not in a failed state,
+	// or if its claim or proof window has already closed.
+	RetrySession(ctx context.Context, supplierOperatorAddress, sessionId string) error
 }
 
 // SessionTreeSnapshot captures the identifying information for a session tree
@@ -146,8 +185,6 @@ type RelayerSessionsManager interface {
 // It is intended for diagnostic and testing scenarios where the caller needs a
 // consistent view of the manager's current session state without reaching into
 // its internal maps.
-//
-// DEV_NOTE: This is used for testing purposes only.
 type SessionTreeSnapshot struct {
 	SupplierOperatorAddress string
 	SessionEndHeight        int64
@@ -217,6 +254,14 @@ type SessionTree interface {
 	// It returns an error if it has already been marked as such.
 	StartClaiming() error
 
+	// GetLifecycleState returns the claim/proof lifecycle state of the session tree.
+	GetLifecycleState() SessionLifecycleState
+
+	// SetLifecycleState updates the claim/proof lifecycle state of the session tree.
+	// It is called by the RelayerSessionsManager as the session progresses
+	// through the claim/proof pipeline.
+	SetLifecycleState(state SessionLifecycleState)
+
 	// GetSupplierOperatorAddress returns a stringified bech32 address of the supplier
 	// operator this sessionTree belongs to.
 	GetSupplierOperatorAddress() string
@@ -27,6 +27,20 @@ const (
 	blockHeightCurrent                         = "block_height_current"
 	instructionTimeSeconds                     = "instruction_time_seconds"
 	relaysDroppedTotal                         = "relays_dropped_total"
+	backendRequestsTotal                       = "backend_requests_total"
+	backendRetriesTotal                        = "backend_retries_total"
+	backendInflightRequests                    = "backend_inflight_requests"
+	backendHealthy                             = "backend_healthy"
+	backendCircuitOpen                         = "backend_circuit_open"
+	relaysRateLimitedTotal                     = "relays_rate_limited_total"
+	backendErrorsPassedThroughTotal            = "backend_errors_passed_through_total"
+	backendErrorsMaskedTotal                   = "backend_errors_masked_total"
+	backendErrorsRetriedTotal                  = "backend_errors_retried_total"
+	pocketNodeHealthy                          = "pocket_node_healthy"
+	pocketNodeFailoversTotal                   = "pocket_node_failovers_total"
+	eventsReplayGapBlocks                      = "events_replay_gap_blocks"
+	eventsReplayBackfillDurationSeconds        = "events_replay_backfill_duration_seconds"
+	eventsReplayDeferredBlocksTotal            = "events_replay_deferred_blocks_total"
 )
 
 var (
@@ -38,6 +52,10 @@ var (
 		// Long tail: > 1s (slow queries, rollovers, cold state, failed, etc.)
 		2.0, 5.0, 10.0, 30.0,
 	}
+
+	// eventsReplayGapBuckets are the buckets of the number of blocks missed by an
+	// events subscription, from a dropped block to the default backfill limit.
+	eventsReplayGapBuckets = []float64{1, 2, 5, 10, 50, 100, 500, 1000}
 )
 
 var (
@@ -248,7 +266,8 @@ var (
 	// A dropped relay is permanently lost reward: the supplier did the work but will
 	// not be compensated on-chain because no evidence enters the SMST.
 	// It is labeled by 'service_id', 'supplier_operator_address', and 'reason'
-	// (e.g. "mining_channel_full") to quantify and localize reward leakage under load.
+	// (e.g. "mining_channel_full" or "relayminer_shutting_down") to quantify and
+	// localize reward leakage under load or on shutdown.
 	//
 	// Usage:
 	// - Alert when nonzero: it directly measures unpaid work.
@@ -258,6 +277,159 @@ var (
 		Name:      relaysDroppedTotal,
 		Help:      "Total number of served relays dropped from the mining pipeline (lost reward), labeled by service ID, supplier, and reason.",
 	}, []string{"service_id", "supplier_operator_address", "reason"})
+
+	// BackendRequestsTotal is a Counter metric for the requests sent to each
+	// backend of a service, labeled by 'service_id', 'backend' and 'outcome'
+	// (i.e. "success" or "failure").
+	// A request fails if the backend cannot be reached or replies with a 5xx.
+	//
+	// Usage:
+	// - Verify the load balancing of the relays across the backends.
+	// - Spot the backends failing more than their peers.
+	BackendRequestsTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendRequestsTotal,
+		Help:      "Total number of requests sent to a service backend, labeled by service ID, backend and outcome.",
+	}, []string{"service_id", "backend", "outcome"})
+
+	// BackendRetriesTotal is a Counter metric for the relays retried on another
+	// backend after a failed backend request, labeled by 'service_id'.
+	BackendRetriesTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendRetriesTotal,
+		Help:      "Total number of relays retried on another backend, labeled by service ID.",
+	}, []string{"service_id"})
+
+	// BackendInflightRequests is a Gauge metric for the requests currently sent
+	// to each backend of a service, labeled by 'service_id' and 'backend'.
+	BackendInflightRequests = prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendInflightRequests,
+		Help:      "Number of requests in flight to a service backend, labeled by service ID and backend.",
+	}, []string{"service_id", "backend"})
+
+	// BackendHealthy is a Gauge metric set to 1 when the last health check of a
+	// service backend succeeded and 0 otherwise, labeled by 'service_id' and 'backend'.
+	BackendHealthy = prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendHealthy,
+		Help:      "Whether a service backend passed its last health check, labeled by service ID and backend.",
+	}, []string{"service_id", "backend"})
+
+	// BackendCircuitOpen is a Gauge metric set to 1 while a service backend is
+	// taken out of rotation by the circuit breaker and 0 otherwise, labeled by
+	// 'service_id' and 'backend'.
+	BackendCircuitOpen = prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendCircuitOpen,
+		Help:      "Whether the circuit breaker of a service backend is open, labeled by service ID and backend.",
+	}, []string{"service_id", "backend"})
+
+	// RelaysRateLimitedTotal is a Counter metric for the relays rejected by the
+	// per-application and per-gateway rate limits, labeled by 'service_id',
+	// 'scope' (i.e. "application" or "gateway") and 'limit' (i.e.
+	// "requests_per_second" or "max_concurrent_requests").
+	// Rejected relays never reach the backend and are not mined.
+	//
+	// Usage:
+	// - Spot the applications and gateways flooding a service.
+	// - Tune the rate limits of a service.
+	RelaysRateLimitedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      relaysRateLimitedTotal,
+		Help:      "Total number of relays rejected by the rate limits, labeled by service ID, scope and limit.",
+	}, []string{"service_id", "scope", "limit"})
+
+	// BackendErrorsPassedThroughTotal is a Counter metric for the backend responses
+	// matching an error policy rule which are passed through to the client,
+	// labeled by 'service_id' and 'rule'.
+	// It includes the responses of the "retry" rules once the retries are exhausted.
+	BackendErrorsPassedThroughTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendErrorsPassedThroughTotal,
+		Help:      "Total number of backend responses passed through by an error policy rule, labeled by service ID and rule.",
+	}, []string{"service_id", "rule"})
+
+	// BackendErrorsMaskedTotal is a Counter metric for the backend responses
+	// matching an error policy rule which are replaced by a generic error,
+	// labeled by 'service_id' and 'rule'.
+	// Masked relays are not rewarded.
+	BackendErrorsMaskedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendErrorsMaskedTotal,
+		Help:      "Total number of backend responses masked by an error policy rule, labeled by service ID and rule.",
+	}, []string{"service_id", "rule"})
+
+	// BackendErrorsRetriedTotal is a Counter metric for the backend responses
+	// matching an error policy rule which are retried on another backend,
+	// labeled by 'service_id' and 'rule'.
+	//
+	// Usage:
+	// - Spot the backends failing in a way the other backends of the service don't.
+	BackendErrorsRetriedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendErrorsRetriedTotal,
+		Help:      "Total number of backend responses retried on another backend by an error policy rule, labeled by service ID and rule.",
+	}, []string{"service_id", "rule"})
+
+	// PocketNodeHealthy is a Gauge metric set to 1 when a pocket node endpoint
+	// passed its last health and lag check and 0 otherwise, labeled by 'role'
+	// (query_rpc, query_grpc or tx_rpc) and 'node'.
+	PocketNodeHealthy = prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Subsystem: relayMinerProcess,
+		Name:      pocketNodeHealthy,
+		Help:      "Whether a pocket node endpoint passed its last health and lag check, labeled by role and node.",
+	}, []string{"role", "node"})
+
+	// PocketNodeFailoversTotal is a Counter metric for the switches from a pocket
+	// node endpoint to another one, labeled by 'role', 'from' and 'to' nodes.
+	//
+	// Usage:
+	// - Spot the pocket nodes which regularly stall or lag behind the others.
+	PocketNodeFailoversTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      pocketNodeFailoversTotal,
+		Help:      "Total number of failovers from a pocket node endpoint to another, labeled by role, from and to nodes.",
+	}, []string{"role", "from", "to"})
+
+	// EventsReplayGapBlocks is a Histogram metric for the number of blocks whose
+	// events were missed by an events subscription, on reconnect or startup,
+	// labeled by subscription 'query'.
+	//
+	// Usage:
+	// - Spot the pocket nodes dropping subscriptions and the long RelayMiner downtimes.
+	EventsReplayGapBlocks = prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+		Subsystem: relayMinerProcess,
+		Name:      eventsReplayGapBlocks,
+		Help:      "Histogram of the number of blocks missed by an events subscription, labeled by query.",
+		Buckets:   eventsReplayGapBuckets,
+	}, []string{"query"})
+
+	// EventsReplayBackfillDurationSeconds is a Histogram metric for the time taken
+	// to backfill the events missed by an events subscription, labeled by
+	// subscription 'query'.
+	//
+	// Usage:
+	// - Monitor how long the live events are delayed by a backfill.
+	EventsReplayBackfillDurationSeconds = prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+		Subsystem: relayMinerProcess,
+		Name:      eventsReplayBackfillDurationSeconds,
+		Help:      "Histogram of the durations of the backfills of missed events in seconds, labeled by query.",
+		Buckets:   defaultBuckets,
+	}, []string{"query"})
+
+	// EventsReplayDeferredBlocksTotal is a Counter metric for the number of missed
+	// blocks whose backfill was deferred, because of a backfill failure or of a gap
+	// above the maximum number of blocks backfilled at once, labeled by
+	// subscription 'query'.
+	//
+	// Usage:
+	// - Spot the events subscriptions lagging behind the chain.
+	EventsReplayDeferredBlocksTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      eventsReplayDeferredBlocksTotal,
+		Help:      "Total number of missed blocks whose events backfill was deferred, labeled by query.",
+	}, []string{"query"})
 )
 
 // CaptureRelayDuration records the internal end-to-end duration of handling a relay which includes
@@ -366,3 +538,102 @@ func CaptureDroppedRelay(serviceId, supplierOperatorAddress, reason string) {
 		).
 		Add(1)
 }
+
+// CaptureBackendRequest records the outcome of a request sent to a service backend.
+func CaptureBackendRequest(serviceId, backend string, isSuccess bool) {
+	outcome := "success"
+	if !isSuccess {
+		outcome = "failure"
+	}
+
+	BackendRequestsTotal.
+		With("service_id", serviceId, "backend", backend, "outcome", outcome).
+		Add(1)
+}
+
+// CaptureBackendRetry records a relay retried on another backend.
+func CaptureBackendRetry(serviceId string) {
+	BackendRetriesTotal.With("service_id", serviceId).Add(1)
+}
+
+// CaptureBackendInflightRequests updates the number of requests in flight to a service backend.
+func CaptureBackendInflightRequests(serviceId, backend string, numInflight int64) {
+	BackendInflightRequests.
+		With("service_id", serviceId, "backend", backend).
+		Set(float64(numInflight))
+}
+
+// CaptureBackendHealth updates the health and circuit breaker state of a service backend.
+func CaptureBackendHealth(serviceId, backend string, isHealthy, isCircuitOpen bool) {
+	healthy, circuitOpen := 0.0, 0.0
+	if isHealthy {
+		healthy = 1
+	}
+	if isCircuitOpen {
+		circuitOpen = 1
+	}
+
+	BackendHealthy.With("service_id", serviceId, "backend", backend).Set(healthy)
+	BackendCircuitOpen.With("service_id", serviceId, "backend", backend).Set(circuitOpen)
+}
+
+// CaptureRelayRateLimited records a relay rejected by the given limit of the
+// given scope's rate limit.
+func CaptureRelayRateLimited(serviceId, scope, limit string) {
+	RelaysRateLimitedTotal.
+		With("service_id", serviceId, "scope", scope, "limit", limit).
+		Add(1)
+}
+
+// CaptureBackendErrorPassedThrough records a backend response passed through to
+// the client by the given error policy rule.
+func CaptureBackendErrorPassedThrough(serviceId, rule string) {
+	BackendErrorsPassedThroughTotal.With("service_id", serviceId, "rule", rule).Add(1)
+}
+
+// CaptureBackendErrorMasked records a backend response masked by the given error
+// policy rule.
+func CaptureBackendErrorMasked(serviceId, rule string) {
+	BackendErrorsMaskedTotal.With("service_id", serviceId, "rule", rule).Add(1)
+}
+
+// CaptureBackendErrorRetried records a backend response retried on another
+// backend by the given error policy rule.
+func CaptureBackendErrorRetried(serviceId, rule string) {
+	BackendErrorsRetriedTotal.With("service_id", serviceId, "rule", rule).Add(1)
+}
+
+// CapturePocketNodeHealth records the outcome of the last health and lag check
+// of the given pocket node endpoint.
+func CapturePocketNodeHealth(role, node string, isHealthy bool) {
+	healthy := 0.0
+	if isHealthy {
+		healthy = 1
+	}
+
+	PocketNodeHealthy.With("role", role, "node", node).Set(healthy)
+}
+
+// CapturePocketNodeFailover records a failover from a pocket node endpoint to
+// another one.
+func CapturePocketNodeFailover(role, fromNode, toNode string) {
+	PocketNodeFailoversTotal.With("role", role, "from", fromNode, "to", toNode).Add(1)
+}
+
+// CaptureEventsReplayGap records the number of blocks whose events were missed
+// by the events subscription of the given query.
+func CaptureEventsReplayGap(query string, numBlocks int64) {
+	EventsReplayGapBlocks.With("query", query).Observe(float64(numBlocks))
+}
+
+// CaptureEventsReplayBackfillDuration records the time taken to backfill the
+// events missed by the events subscription of the given query.
+func CaptureEventsReplayBackfillDuration(query string, startTime time.Time) {
+	EventsReplayBackfillDurationSeconds.With("query", query).Observe(time.Since(startTime).Seconds())
+}
+
+// CaptureEventsReplayDeferredBlocks records the number of missed blocks whose
+// events backfill was deferred for the events subscription of the given query.
+func CaptureEventsReplayDeferredBlocks(query string, numBlocks int64) {
+	EventsReplayDeferredBlocksTotal.With("query", query).Add(float64(numBlocks))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
 	"cosmossdk.io/depinject"
 
@@ -44,6 +45,12 @@ type miner struct {
 	// miningPipelineBufferSize is the per-observer channel buffer size for the
 	// mined-relays observable produced by MinedRelays. 0 keeps the default.
 	miningPipelineBufferSize int
+
+	// numServedRelaysProcessed is the number of served relays mined, skipped or
+	// failed, and numRelaysMined the number of them published as mined relays.
+	// They let the RelayMiner count the relays still in the mining pipeline.
+	numServedRelaysProcessed atomic.Uint64
+	numRelaysMined           atomic.Uint64
 }
 
 // NewMiner creates a new miner from the given dependencies and options. It
@@ -99,6 +106,9 @@ func WithMiningPipelineBufferSize(size int) relayer.MinerOption {
 // 2. Checks if it's above the mining difficulty
 // 3. Adds it to the session tree if so
 // It DOES NOT BLOCK as map operations run in their own goroutines.
+//
+// The pipeline is not canceled with ctx: it completes once servedRelaysObs does,
+// so that the relays it buffers are still mined when the RelayMiner shuts down.
 func (mnr *miner) MinedRelays(
 	ctx context.Context,
 	servedRelaysObs relayer.RelaysObservable,
@@ -106,6 +116,8 @@ func (mnr *miner) MinedRelays(
 	// Ensure the context is set with the miner component kind.
 	// This is used to capture the component kind in gRPC call duration metrics collection.
 	ctx = context.WithValue(ctx, query.ComponentCtxRelayMinerKey, query.ComponentCtxRelayMinerMiner)
+	// Detach the pipeline from ctx cancellation so it drains on shutdown.
+	ctx = context.WithoutCancel(ctx)
 	// NB: must cast back to generic observable type to use with Map.
 	// relayer.RelaysObservable cannot be an alias due to gomock's lack of
 	// support for generic types.
@@ -125,7 +137,7 @@ func (mnr *miner) MinedRelays(
 	eitherMinedRelaysObs := channel.MapParallel(
 		ctx,
 		relaysObs,
-		mnr.mapMineDehydratedRelay,
+		mnr.mapMineAndCountDehydratedRelay,
 		mnr.miningWorkers,
 		channel.WithSubscribeBufferSize[either.Either[*relayer.MinedRelay]](mnr.miningPipelineBufferSize),
 	)
@@ -134,6 +146,32 @@ func (mnr *miner) MinedRelays(
 	return filter.EitherSuccess(ctx, eitherMinedRelaysObs)
 }
 
+// NumProcessedRelays returns the number of served relays processed (i.e. mined,
+// skipped or failed) and the number of them mined since the miner was created.
+func (mnr *miner) NumProcessedRelays() (numServedRelaysProcessed, numRelaysMined uint64) {
+	// Relays are counted as processed before being counted as mined: loading the
+	// mined relays first ensures they are never counted as mined but not processed.
+	numRelaysMined = mnr.numRelaysMined.Load()
+	numServedRelaysProcessed = mnr.numServedRelaysProcessed.Load()
+	return numServedRelaysProcessed, numRelaysMined
+}
+
+// mapMineAndCountDehydratedRelay is intended to be used as a MapFn.
+// It mines the relay using mapMineDehydratedRelay and counts it as processed,
+// and as mined if it is published.
+func (mnr *miner) mapMineAndCountDehydratedRelay(
+	ctx context.Context,
+	relay *servicetypes.Relay,
+) (_ either.Either[*relayer.MinedRelay], skip bool) {
+	eitherMinedRelay, skip := mnr.mapMineDehydratedRelay(ctx, relay)
+	mnr.numServedRelaysProcessed.Add(1)
+	if !skip && eitherMinedRelay.IsSuccess() {
+		mnr.numRelaysMined.Add(1)
+	}
+
+	return eitherMinedRelay, skip
+}
+
 // mapMineDehydratedRelay is intended to be used as a MapFn.
 // 1. It hashes the relay and compares its difficulty to the minimum threshold.
 // 2. It sets the relay response payload to nil to minimize SMST / onchain proof size.
@@ -7,7 +7,9 @@ import (
 	"github.com/gorilla/websocket"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
 	proxyws "github.com/pokt-network/poktroll/pkg/relayer/proxy/websockets"
+	"github.com/pokt-network/poktroll/x/service/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
@@ -74,10 +76,14 @@ func (server *relayMinerHTTPServer) handleAsyncConnection(
 
 	sessionHeader := session.Header
 
+	// Select the backend to bridge the connection to among the service config backends.
+	backendPool := getBackendPool(server.logger, server.backendPools, serviceId, websocketServiceConfig)
+	backend := backendPool.nextBackend(nil)
+
 	logger = logger.With(
 		"server_addr", server.server.Addr,
 		"session_start_height", sessionHeader.SessionStartBlockHeight,
-		"destination_url", websocketServiceConfig.BackendUrl.String(),
+		"destination_url", backend.url.String(),
 	)
 
 	// Upgrade the HTTP connection to a websocket connection.
@@ -96,28 +102,6 @@ func (server *relayMinerHTTPServer) handleAsyncConnection(
 	// itself instead of relying on net/http to tear the connection down.
 	alreadyResponded = true
 
-	// TODO_MAINNET(@red0ne): Add unit and e2e tests for the websocket bridge and connection.
-	// Create a new websocket bridge between the gateway and the service endpoint.
-	bridge, err := proxyws.NewBridge(
-		logger,
-		server.relayAuthenticator,
-		server.relayMeter,
-		server.servedRewardableRelaysProducer,
-		server.blockClient,
-		websocketServiceConfig,
-		session,
-		clientConn,
-	)
-	if err != nil {
-		logger.Error().Err(err).Msg("❌ Error creating websocket bridge")
-		// The bridge never took ownership of clientConn, so close it here to
-		// avoid leaking the hijacked connection and its file descriptor.
-		if closeErr := clientConn.Close(); closeErr != nil {
-			logger.Warn().Err(closeErr).Msg("failed closing client connection after bridge creation error")
-		}
-		return alreadyResponded, ErrRelayerProxyInternalError.Wrap(err.Error())
-	}
-
 	// Set up the bridge to close before the claim window opens.
 	// TODO_CONSIDERATION: Async connection could be stricter and close the bridge
 	// right after the session ends, but it is technically possible to delay it
@@ -138,11 +122,70 @@ func (server *relayMinerHTTPServer) handleAsyncConnection(
 	}
 	claimWindowOpenHeight := sharedtypes.GetClaimWindowOpenHeight(sharedParams, sessionEndHeight)
 
-	// Run the websockets bridge.
-	// Set up the bridge to close after the session ends.
-	go bridge.Run(claimWindowOpenHeight)
+	// TODO_MAINNET(@red0ne): Add unit and e2e tests for the websocket bridge and connection.
+	// Create a new websocket bridge between the gateway and the service endpoint.
+	// Failing to connect to the backend is retried on the other backends of the
+	// service config.
+	triedBackends := make(map[*serviceBackend]struct{}, 1)
+	for {
+		triedBackends[backend] = struct{}{}
+
+		backendPool.startRequest(backend)
+		bridge, err := proxyws.NewBridge(
+			logger,
+			server.relayAuthenticator,
+			server.relayMeter,
+			server.admitBridgedRelayRequest,
+			server.servedRewardableRelaysProducer,
+			server.blockClient,
+			websocketServiceConfig,
+			backend.url,
+			session,
+			clientConn,
+		)
+		backendPool.endRequest(backend, err == nil)
+		if err == nil {
+			// Run the websockets bridge.
+			// Set up the bridge to close after the session ends.
+			go bridge.Run(claimWindowOpenHeight)
+
+			logger.Info().Msg("🔗 WebSocket connection established with client")
+
+			return alreadyResponded, nil
+		}
+
+		var nextBackend *serviceBackend
+		if uint64(len(triedBackends)) <= backendPool.maxRetries() {
+			nextBackend = backendPool.nextBackend(triedBackends)
+		}
 
-	logger.Info().Msg("🔗 WebSocket connection established with client")
+		if nextBackend == nil {
+			logger.Error().Err(err).Msg("❌ Error creating websocket bridge")
+			// The bridge never took ownership of clientConn, so close it here to
+			// avoid leaking the hijacked connection and its file descriptor.
+			if closeErr := clientConn.Close(); closeErr != nil {
+				logger.Warn().Err(closeErr).Msg("failed closing client connection after bridge creation error")
+			}
+			return alreadyResponded, ErrRelayerProxyInternalError.Wrap(err.Error())
+		}
+
+		logger.Warn().Err(err).Msgf(
+			"⚠️ Failed creating websocket bridge to backend %q, retrying on backend %q",
+			backend.url.String(),
+			nextBackend.url.String(),
+		)
+		relayer.CaptureBackendRetry(serviceId)
+		backend = nextBackend
+	}
+}
 
-	return alreadyResponded, nil
+// admitBridgedRelayRequest enforces the per-application and per-gateway rate
+// limits of the service on a websocket connection.
+// It is called by the bridge once the first relay request of the connection is
+// verified, since the headers of the connection upgrade request are not signed.
+// The concurrent requests slot is held for the whole connection duration.
+func (server *relayMinerHTTPServer) admitBridgedRelayRequest(
+	relayRequest *types.RelayRequest,
+) (release func(), err error) {
+	return admitRelayRequest(server.rateLimiters, relayRequest)
 }
@@ -0,0 +1,321 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// backendHealthCheckFn checks that a backend is able to serve relays.
+type backendHealthCheckFn func(ctx context.Context, backendUrl *url.URL) error
+
+// serviceBackend is a backend of a backendPool along with its load balancing state.
+type serviceBackend struct {
+	url    *url.URL
+	weight uint64
+
+	// label identifies the backend in metrics. It omits the backend URL path,
+	// query and credentials, which may embed secrets such as API keys.
+	label string
+
+	// numInflight is the number of requests currently sent to the backend.
+	numInflight atomic.Int64
+
+	// The fields below are protected by the pool's mutex.
+	//
+	// isHealthy is the result of the last health check. Backends are healthy
+	// until a health check fails.
+	isHealthy bool
+	// numConsecutiveFailures is the number of requests that failed in a row.
+	numConsecutiveFailures uint64
+	// circuitOpenUntil is the time until which the backend is skipped after
+	// reaching the circuit breaker failure threshold.
+	circuitOpenUntil time.Time
+	// currentWeight is the smooth weighted round robin state of the backend.
+	currentWeight int64
+}
+
+// backendPool spreads the relays of a service config across its backends
+// according to the configured load balancing strategy.
+//
+// Backends failing their health check, or whose circuit is open after too many
+// consecutive failed requests, are skipped. If no other backend is available,
+// they are used anyway rather than failing the relay without trying.
+type backendPool struct {
+	logger    polylog.Logger
+	serviceId string
+	config    *config.RelayMinerLoadBalancingConfig
+	backends  []*serviceBackend
+
+	// nextIndex is the round robin cursor, also used to break ties between
+	// backends with the same number of requests in flight.
+	nextIndex atomic.Uint64
+
+	mu sync.Mutex
+}
+
+// newBackendPool creates the backend pool of the given service config.
+// Service configs not built by the config hydrator (e.g. in tests) may only
+// have a BackendUrl, in which case it is used as the single backend.
+func newBackendPool(
+	logger polylog.Logger,
+	serviceId string,
+	serviceConfig *config.RelayMinerSupplierServiceConfig,
+) *backendPool {
+	configBackends := serviceConfig.Backends
+	if len(configBackends) == 0 {
+		configBackends = []*config.RelayMinerSupplierServiceBackend{
+			{Url: serviceConfig.BackendUrl, Weight: 1},
+		}
+	}
+
+	loadBalancingConfig := serviceConfig.LoadBalancing
+	if loadBalancingConfig == nil {
+		loadBalancingConfig = &config.RelayMinerLoadBalancingConfig{
+			Strategy:                       config.LoadBalancingStrategyRoundRobin,
+			HealthCheckInterval:            time.Duration(config.DefaultBackendHealthCheckIntervalSeconds) * time.Second,
+			CircuitBreakerFailureThreshold: config.DefaultCircuitBreakerFailureThreshold,
+			CircuitBreakerCooldown:         time.Duration(config.DefaultCircuitBreakerCooldownSeconds) * time.Second,
+			MaxRetries:                     uint64(len(configBackends) - 1),
+		}
+	}
+
+	pool := &backendPool{
+		logger:    logger.With("service_id", serviceId),
+		serviceId: serviceId,
+		config:    loadBalancingConfig,
+		backends:  make([]*serviceBackend, 0, len(configBackends)),
+	}
+
+	for _, configBackend := range configBackends {
+		backend := &serviceBackend{
+			url:       configBackend.Url,
+			weight:    max(configBackend.Weight, 1),
+			label:     configBackend.Url.Scheme + "://" + configBackend.Url.Host,
+			isHealthy: true,
+		}
+		pool.backends = append(pool.backends, backend)
+		relayer.CaptureBackendHealth(serviceId, backend.label, true, false)
+	}
+
+	return pool
+}
+
+// newServerBackendPools creates the backend pools of all the service configs
+// of the given server, keyed by service config.
+func newServerBackendPools(
+	logger polylog.Logger,
+	serverConfig *config.RelayMinerServerConfig,
+) map[*config.RelayMinerSupplierServiceConfig]*backendPool {
+	backendPools := make(map[*config.RelayMinerSupplierServiceConfig]*backendPool)
+	for serviceId, supplierConfig := range serverConfig.SupplierConfigsMap {
+		serviceConfigs := []*config.RelayMinerSupplierServiceConfig{supplierConfig.ServiceConfig}
+		for _, rpcTypeServiceConfig := range supplierConfig.RPCTypeServiceConfigs {
+			serviceConfigs = append(serviceConfigs, rpcTypeServiceConfig)
+		}
+
+		for _, serviceConfig := range serviceConfigs {
+			if serviceConfig == nil || serviceConfig.BackendUrl == nil {
+				continue
+			}
+			backendPools[serviceConfig] = newBackendPool(logger, serviceId, serviceConfig)
+		}
+	}
+
+	return backendPools
+}
+
+// getBackendPool returns the backend pool of the given service config, creating
+// a standalone one if the service config is not part of the server config.
+func getBackendPool(
+	logger polylog.Logger,
+	backendPools map[*config.RelayMinerSupplierServiceConfig]*backendPool,
+	serviceId string,
+	serviceConfig *config.RelayMinerSupplierServiceConfig,
+) *backendPool {
+	if pool, ok := backendPools[serviceConfig]; ok {
+		return pool
+	}
+
+	return newBackendPool(logger, serviceId, serviceConfig)
+}
+
+// maxRetries returns the maximum number of times a failed relay is retried on
+// another backend.
+func (pool *backendPool) maxRetries() uint64 {
+	return pool.config.MaxRetries
+}
+
+// nextBackend selects the backend to send the next request to, excluding the
+// given (already tried) backends. It returns nil if all backends are excluded.
+func (pool *backendPool) nextBackend(excluded map[*serviceBackend]struct{}) *serviceBackend {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	now := time.Now()
+	candidates := make([]*serviceBackend, 0, len(pool.backends))
+	for _, backend := range pool.backends {
+		if _, ok := excluded[backend]; ok {
+			continue
+		}
+		if backend.isHealthy && !now.Before(backend.circuitOpenUntil) {
+			candidates = append(candidates, backend)
+		}
+	}
+
+	// Fail open: try the unavailable backends rather than not trying at all.
+	if len(candidates) == 0 {
+		for _, backend := range pool.backends {
+			if _, ok := excluded[backend]; !ok {
+				candidates = append(candidates, backend)
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	switch pool.config.Strategy {
+	case config.LoadBalancingStrategyWeighted:
+		return selectSmoothWeightedBackend(candidates)
+	case config.LoadBalancingStrategyLeastInflight:
+		start := pool.nextIndex.Add(1) - 1
+		var selected *serviceBackend
+		for i := range candidates {
+			backend := candidates[(start+uint64(i))%uint64(len(candidates))]
+			if selected == nil || backend.numInflight.Load() < selected.numInflight.Load() {
+				selected = backend
+			}
+		}
+		return selected
+	default:
+		start := pool.nextIndex.Add(1) - 1
+		return candidates[start%uint64(len(candidates))]
+	}
+}
+
+// selectSmoothWeightedBackend selects a backend among the candidates using the
+// smooth weighted round robin algorithm, which interleaves the backends rather
+// than sending bursts of consecutive requests to the heaviest one.
+// It MUST be called with the pool's mutex held.
+func selectSmoothWeightedBackend(candidates []*serviceBackend) *serviceBackend {
+	var selected *serviceBackend
+	totalWeight := int64(0)
+	for _, backend := range candidates {
+		backend.currentWeight += int64(backend.weight)
+		totalWeight += int64(backend.weight)
+		if selected == nil || backend.currentWeight > selected.currentWeight {
+			selected = backend
+		}
+	}
+	selected.currentWeight -= totalWeight
+
+	return selected
+}
+
+// startRequest marks a request as sent to the given backend.
+// It MUST be followed by a call to endRequest once the request completes.
+func (pool *backendPool) startRequest(backend *serviceBackend) {
+	numInflight := backend.numInflight.Add(1)
+	relayer.CaptureBackendInflightRequests(pool.serviceId, backend.label, numInflight)
+}
+
+// endRequest marks a request sent to the given backend as completed and updates
+// the backend's circuit breaker according to the request outcome.
+func (pool *backendPool) endRequest(backend *serviceBackend, isSuccess bool) {
+	numInflight := backend.numInflight.Add(-1)
+	relayer.CaptureBackendInflightRequests(pool.serviceId, backend.label, numInflight)
+	relayer.CaptureBackendRequest(pool.serviceId, backend.label, isSuccess)
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if isSuccess {
+		if backend.numConsecutiveFailures >= pool.config.CircuitBreakerFailureThreshold {
+			pool.logger.Info().Msgf("✅ Closing the circuit of backend %q", backend.url.String())
+		}
+		backend.numConsecutiveFailures = 0
+		backend.circuitOpenUntil = time.Time{}
+		relayer.CaptureBackendHealth(pool.serviceId, backend.label, backend.isHealthy, false)
+		return
+	}
+
+	backend.numConsecutiveFailures++
+	if backend.numConsecutiveFailures >= pool.config.CircuitBreakerFailureThreshold {
+		// The circuit is (re)opened: on the first failure reaching the threshold,
+		// or on a failure of the first request sent after the cooldown.
+		backend.circuitOpenUntil = time.Now().Add(pool.config.CircuitBreakerCooldown)
+		pool.logger.Warn().Msgf(
+			"⚠️ Opening the circuit of backend %q for %s after %d consecutive failures",
+			backend.url.String(),
+			pool.config.CircuitBreakerCooldown,
+			backend.numConsecutiveFailures,
+		)
+		relayer.CaptureBackendHealth(pool.serviceId, backend.label, backend.isHealthy, true)
+	}
+}
+
+// startHealthChecks periodically checks the health of the pool's backends
+// until the context is done. Pools with a single backend are not checked since
+// there is no other backend to fail over to.
+func (pool *backendPool) startHealthChecks(ctx context.Context, healthCheck backendHealthCheckFn) {
+	if len(pool.backends) < 2 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(pool.config.HealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = pool.checkBackendsHealth(ctx, healthCheck)
+			}
+		}
+	}()
+}
+
+// checkBackendsHealth runs the health check of every backend of the pool and
+// updates their health accordingly.
+// It returns an error only if none of the backends is healthy.
+func (pool *backendPool) checkBackendsHealth(ctx context.Context, healthCheck backendHealthCheckFn) error {
+	var healthCheckErrs []error
+	for _, backend := range pool.backends {
+		err := healthCheck(ctx, backend.url)
+		isHealthy := err == nil
+		if !isHealthy {
+			healthCheckErrs = append(healthCheckErrs, err)
+		}
+
+		pool.mu.Lock()
+		if isHealthy != backend.isHealthy {
+			if isHealthy {
+				pool.logger.Info().Msgf("✅ Backend %q is healthy again", backend.url.String())
+			} else {
+				pool.logger.Warn().Err(err).Msgf("⚠️ Backend %q failed its health check", backend.url.String())
+			}
+		}
+		backend.isHealthy = isHealthy
+		isCircuitOpen := time.Now().Before(backend.circuitOpenUntil)
+		pool.mu.Unlock()
+
+		relayer.CaptureBackendHealth(pool.serviceId, backend.label, isHealthy, isCircuitOpen)
+	}
+
+	if len(healthCheckErrs) == len(pool.backends) {
+		return errors.Join(healthCheckErrs...)
+	}
+
+	return nil
+}
@@ -0,0 +1,290 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	sdktypes "github.com/pokt-network/shannon-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	poktrollhttp "github.com/pokt-network/poktroll/pkg/network/http"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+)
+
+func TestBackendPool_RoundRobin(t *testing.T) {
+	pool := newTestBackendPool(t, config.LoadBalancingStrategyRoundRobin, 1, 1, 1)
+
+	selectedBackends := make([]*serviceBackend, 0, 6)
+	for range 6 {
+		selectedBackends = append(selectedBackends, pool.nextBackend(nil))
+	}
+
+	require.Equal(t, []*serviceBackend{
+		pool.backends[0], pool.backends[1], pool.backends[2],
+		pool.backends[0], pool.backends[1], pool.backends[2],
+	}, selectedBackends)
+}
+
+func TestBackendPool_Weighted(t *testing.T) {
+	pool := newTestBackendPool(t, config.LoadBalancingStrategyWeighted, 3, 1)
+
+	selectedBackends := make([]*serviceBackend, 0, 8)
+	for range 8 {
+		selectedBackends = append(selectedBackends, pool.nextBackend(nil))
+	}
+
+	// The heaviest backend is interleaved with the lighter one rather than
+	// receiving bursts of consecutive requests.
+	heavy, light := pool.backends[0], pool.backends[1]
+	require.Equal(t, []*serviceBackend{
+		heavy, heavy, light, heavy,
+		heavy, heavy, light, heavy,
+	}, selectedBackends)
+}
+
+func TestBackendPool_LeastInflight(t *testing.T) {
+	pool := newTestBackendPool(t, config.LoadBalancingStrategyLeastInflight, 1, 1, 1)
+
+	pool.startRequest(pool.backends[0])
+	pool.startRequest(pool.backends[0])
+	pool.startRequest(pool.backends[1])
+
+	for range 3 {
+		require.Equal(t, pool.backends[2], pool.nextBackend(nil))
+	}
+
+	pool.endRequest(pool.backends[0], true)
+	pool.endRequest(pool.backends[0], true)
+	pool.startRequest(pool.backends[2])
+	pool.startRequest(pool.backends[2])
+
+	require.Equal(t, pool.backends[0], pool.nextBackend(nil))
+}
+
+func TestBackendPool_ExcludesTriedBackends(t *testing.T) {
+	pool := newTestBackendPool(t, config.LoadBalancingStrategyRoundRobin, 1, 1)
+
+	triedBackends := map[*serviceBackend]struct{}{pool.backends[0]: {}}
+	for range 3 {
+		require.Equal(t, pool.backends[1], pool.nextBackend(triedBackends))
+	}
+
+	triedBackends[pool.backends[1]] = struct{}{}
+	require.Nil(t, pool.nextBackend(triedBackends))
+}
+
+func TestBackendPool_CircuitBreaker(t *testing.T) {
+	pool := newTestBackendPool(t, config.LoadBalancingStrategyRoundRobin, 1, 1)
+	pool.config.CircuitBreakerFailureThreshold = 2
+	pool.config.CircuitBreakerCooldown = 50 * time.Millisecond
+	failingBackend, healthyBackend := pool.backends[0], pool.backends[1]
+
+	// A single failure does not open the circuit.
+	pool.endRequest(failingBackend, false)
+	requireBackendsSelected(t, pool, failingBackend, healthyBackend)
+
+	// Reaching the failure threshold opens the circuit.
+	pool.endRequest(failingBackend, false)
+	requireBackendsSelected(t, pool, healthyBackend)
+
+	// The backend is tried again once the cooldown elapsed, a new failure
+	// reopening the circuit right away.
+	time.Sleep(2 * pool.config.CircuitBreakerCooldown)
+	requireBackendsSelected(t, pool, failingBackend, healthyBackend)
+	pool.endRequest(failingBackend, false)
+	requireBackendsSelected(t, pool, healthyBackend)
+
+	// A success closes the circuit.
+	time.Sleep(2 * pool.config.CircuitBreakerCooldown)
+	pool.endRequest(failingBackend, true)
+	pool.endRequest(failingBackend, false)
+	requireBackendsSelected(t, pool, failingBackend, healthyBackend)
+}
+
+func TestBackendPool_HealthChecks(t *testing.T) {
+	pool := newTestBackendPool(t, config.LoadBalancingStrategyRoundRobin, 1, 1, 1)
+	unhealthyBackends := map[string]bool{pool.backends[0].url.Host: true}
+	healthCheck := func(_ context.Context, backendUrl *url.URL) error {
+		if unhealthyBackends[backendUrl.Host] {
+			return fmt.Errorf("backend %s is down", backendUrl.Host)
+		}
+		return nil
+	}
+
+	// Unhealthy backends are skipped.
+	require.NoError(t, pool.checkBackendsHealth(context.Background(), healthCheck))
+	requireBackendsSelected(t, pool, pool.backends[1], pool.backends[2])
+
+	// The pool fails to check only if all its backends are unhealthy, in which
+	// case they are all used anyway.
+	unhealthyBackends[pool.backends[1].url.Host] = true
+	unhealthyBackends[pool.backends[2].url.Host] = true
+	require.Error(t, pool.checkBackendsHealth(context.Background(), healthCheck))
+	requireBackendsSelected(t, pool, pool.backends...)
+
+	// Backends passing their health check again are used again.
+	delete(unhealthyBackends, pool.backends[0].url.Host)
+	require.NoError(t, pool.checkBackendsHealth(context.Background(), healthCheck))
+	requireBackendsSelected(t, pool, pool.backends[0])
+}
+
+func TestSendToServiceBackends_RetriesOnHealthyBackend(t *testing.T) {
+	failingBackend := newTestHTTPBackend(t, http.StatusBadGateway, "failing")
+	healthyBackend := newTestHTTPBackend(t, http.StatusOK, "healthy")
+
+	tests := []struct {
+		desc               string
+		maxRetries         uint64
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			desc:               "failed request is retried on another backend",
+			maxRetries:         1,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "healthy",
+		},
+		{
+			desc:               "failed request is passed through without retries",
+			maxRetries:         0,
+			expectedStatusCode: http.StatusBadGateway,
+			expectedBody:       "failing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			logger := polyzero.NewLogger()
+			serviceConfig := &config.RelayMinerSupplierServiceConfig{
+				BackendUrl: failingBackend,
+				Backends: []*config.RelayMinerSupplierServiceBackend{
+					{Url: failingBackend, Weight: 1},
+					{Url: healthyBackend, Weight: 1},
+				},
+				LoadBalancing: &config.RelayMinerLoadBalancingConfig{
+					Strategy:                       config.LoadBalancingStrategyRoundRobin,
+					CircuitBreakerFailureThreshold: config.DefaultCircuitBreakerFailureThreshold,
+					MaxRetries:                     test.maxRetries,
+				},
+			}
+			pool := newBackendPool(logger, "svc1", serviceConfig)
+			server := &relayMinerHTTPServer{
+				logger:     logger,
+				httpClient: poktrollhttp.NewDefaultHTTPClientWithDebugMetrics(),
+			}
+
+			relayRequest := newTestRelayRequest(t)
+			backend := pool.nextBackend(nil)
+			require.Equal(t, failingBackend, backend.url)
+
+			httpRequest, err := relayer.BuildServiceBackendRequest(relayRequest, serviceConfig, backend.url)
+			require.NoError(t, err)
+
+			httpResponse, _, _, err := server.sendToServiceBackends(
+				context.Background(),
+				logger,
+				relayRequest,
+				serviceConfig,
+				pool,
+				backend,
+				httpRequest,
+			)
+			require.NoError(t, err)
+			defer httpResponse.Body.Close()
+
+			body, err := io.ReadAll(httpResponse.Body)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedStatusCode, httpResponse.StatusCode)
+			require.Equal(t, test.expectedBody, string(body))
+		})
+	}
+}
+
+// newTestBackendPool returns a backend pool using the given strategy, having
+// one backend per given weight.
+func newTestBackendPool(
+	t *testing.T,
+	strategy config.LoadBalancingStrategy,
+	weights ...uint64,
+) *backendPool {
+	t.Helper()
+
+	serviceConfig := &config.RelayMinerSupplierServiceConfig{
+		LoadBalancing: &config.RelayMinerLoadBalancingConfig{
+			Strategy:                       strategy,
+			HealthCheckInterval:            time.Second,
+			CircuitBreakerFailureThreshold: config.DefaultCircuitBreakerFailureThreshold,
+			CircuitBreakerCooldown:         time.Second,
+			MaxRetries:                     uint64(len(weights) - 1),
+		},
+	}
+	for i, weight := range weights {
+		serviceConfig.Backends = append(serviceConfig.Backends, &config.RelayMinerSupplierServiceBackend{
+			Url:    &url.URL{Scheme: "http", Host: fmt.Sprintf("backend-%d:8545", i)},
+			Weight: weight,
+		})
+	}
+	serviceConfig.BackendUrl = serviceConfig.Backends[0].Url
+
+	return newBackendPool(polyzero.NewLogger(), "svc1", serviceConfig)
+}
+
+// requireBackendsSelected asserts that the backends selected by the pool over
+// a few rounds are exactly the expected ones.
+func requireBackendsSelected(t *testing.T, pool *backendPool, expectedBackends ...*serviceBackend) {
+	t.Helper()
+
+	selectedBackends := make(map[*serviceBackend]struct{})
+	for range 4 * len(pool.backends) {
+		selectedBackends[pool.nextBackend(nil)] = struct{}{}
+	}
+
+	require.Len(t, selectedBackends, len(expectedBackends))
+	for _, expectedBackend := range expectedBackends {
+		require.Contains(t, selectedBackends, expectedBackend)
+	}
+}
+
+// newTestHTTPBackend starts an HTTP backend replying to every request with the
+// given status code and body, and returns its URL.
+func newTestHTTPBackend(t *testing.T, statusCode int, body string) *url.URL {
+	t.Helper()
+
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(backend.Close)
+
+	backendUrl, err := url.Parse(backend.URL)
+	require.NoError(t, err)
+
+	return backendUrl
+}
+
+// newTestRelayRequest returns a relay request whose payload is a JSON-RPC request.
+func newTestRelayRequest(t *testing.T) *servicetypes.RelayRequest {
+	t.Helper()
+
+	request, err := http.NewRequest(
+		http.MethodPost,
+		"http://127.0.0.1/",
+		bytes.NewReader([]byte(`{"jsonrpc":"2.0","method":"eth_blockNumber","id":1}`)),
+	)
+	require.NoError(t, err)
+
+	_, payloadBz, err := sdktypes.SerializeHTTPRequest(request)
+	require.NoError(t, err)
+
+	return &servicetypes.RelayRequest{Payload: payloadBz}
+}
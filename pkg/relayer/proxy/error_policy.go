@@ -0,0 +1,138 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// jsonRPCErrorResponse is the subset of a JSON-RPC response needed to match its
+// error code against the error policy rules.
+type jsonRPCErrorResponse struct {
+	Error *struct {
+		Code int64 `json:"code"`
+	} `json:"error,omitempty"`
+}
+
+// errorPolicyResponse is a backend response being matched against the rules of
+// an error policy.
+type errorPolicyResponse struct {
+	statusCode int
+	// body is only read if a rule matches on the body.
+	body []byte
+
+	// jsonRPCErrorCodes are the error codes of the JSON-RPC response, or batch
+	// of responses, of the body. They are decoded on the first rule needing them.
+	jsonRPCErrorCodes       []int64
+	isJSONRPCErrorCodesRead bool
+}
+
+// matchErrorPolicyRule returns the first rule of the given error policy matching
+// the given backend response, or nil if none does.
+//
+// The response body is only read if a rule matches on it, in which case it is
+// replaced by an in-memory copy so that it can be read again by the caller.
+// Reading a body exceeding maxBodySize fails with ErrRelayerProxyResponseLimitExceeded.
+func matchErrorPolicyRule(
+	logger polylog.Logger,
+	errorPolicy *config.RelayMinerErrorPolicyConfig,
+	httpResponse *http.Response,
+	maxBodySize int64,
+) (*config.RelayMinerErrorPolicyRule, error) {
+	response := &errorPolicyResponse{statusCode: httpResponse.StatusCode}
+
+	if slices.ContainsFunc(errorPolicy.Rules, errorPolicyRuleMatchesBody) {
+		body, resetReadBodyPoolBytes, err := SafeResponseReadBody(logger, httpResponse, maxBodySize)
+		if resetReadBodyPoolBytes != nil {
+			// Ensure the read buffer is returned to the pool.
+			defer resetReadBodyPoolBytes()
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The body outlives the read buffer: keep a caller-owned copy of it.
+		body = bytes.Clone(body)
+		httpResponse.Body = io.NopCloser(bytes.NewReader(body))
+		response.body = body
+	}
+
+	for _, rule := range errorPolicy.Rules {
+		if response.matches(rule) {
+			return rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// errorPolicyRuleMatchesBody returns true if the given rule needs the response
+// body to be matched.
+func errorPolicyRuleMatchesBody(rule *config.RelayMinerErrorPolicyRule) bool {
+	return len(rule.JSONRPCErrorCodes) > 0 || rule.BodyPattern != nil
+}
+
+// matches returns true if the response matches all the criteria set by the rule.
+func (response *errorPolicyResponse) matches(rule *config.RelayMinerErrorPolicyRule) bool {
+	if len(rule.StatusCodes) > 0 && !slices.Contains(rule.StatusCodes, response.statusCode) {
+		return false
+	}
+
+	if len(rule.JSONRPCErrorCodes) > 0 {
+		hasMatchingErrorCode := slices.ContainsFunc(response.getJSONRPCErrorCodes(), func(code int64) bool {
+			return slices.Contains(rule.JSONRPCErrorCodes, code)
+		})
+		if !hasMatchingErrorCode {
+			return false
+		}
+	}
+
+	if rule.BodyPattern != nil && !rule.BodyPattern.Match(response.body) {
+		return false
+	}
+
+	return true
+}
+
+// getJSONRPCErrorCodes returns the error codes of the JSON-RPC response, or
+// batch of responses, of the body. Bodies which are not JSON-RPC responses have
+// no error codes.
+func (response *errorPolicyResponse) getJSONRPCErrorCodes() []int64 {
+	if response.isJSONRPCErrorCodesRead {
+		return response.jsonRPCErrorCodes
+	}
+	response.isJSONRPCErrorCodesRead = true
+
+	body := bytes.TrimSpace(response.body)
+	if len(body) == 0 {
+		return nil
+	}
+
+	var jsonRPCResponses []jsonRPCErrorResponse
+	switch body[0] {
+	case '{':
+		var jsonRPCResponse jsonRPCErrorResponse
+		if err := json.Unmarshal(body, &jsonRPCResponse); err != nil {
+			return nil
+		}
+		jsonRPCResponses = append(jsonRPCResponses, jsonRPCResponse)
+	case '[':
+		if err := json.Unmarshal(body, &jsonRPCResponses); err != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	for _, jsonRPCResponse := range jsonRPCResponses {
+		if jsonRPCResponse.Error != nil {
+			response.jsonRPCErrorCodes = append(response.jsonRPCErrorCodes, jsonRPCResponse.Error.Code)
+		}
+	}
+
+	return response.jsonRPCErrorCodes
+}
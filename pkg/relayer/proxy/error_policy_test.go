@@ -0,0 +1,446 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	poktrollhttp "github.com/pokt-network/poktroll/pkg/network/http"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+func TestMatchErrorPolicyRule(t *testing.T) {
+	rateLimitedRule := &config.RelayMinerErrorPolicyRule{
+		Name:        "rate_limited",
+		StatusCodes: []int{http.StatusTooManyRequests},
+		Action:      config.ErrorPolicyActionRetry,
+	}
+	executionRevertedRule := &config.RelayMinerErrorPolicyRule{
+		Name:              "execution_reverted",
+		StatusCodes:       []int{http.StatusOK},
+		JSONRPCErrorCodes: []int64{3},
+		Action:            config.ErrorPolicyActionPassThrough,
+	}
+	internalErrorRule := &config.RelayMinerErrorPolicyRule{
+		Name:        "internal_error",
+		BodyPattern: regexp.MustCompile(`(?i)internal error`),
+		Action:      config.ErrorPolicyActionMask,
+	}
+	errorPolicy := &config.RelayMinerErrorPolicyConfig{
+		Rules: []*config.RelayMinerErrorPolicyRule{
+			rateLimitedRule,
+			executionRevertedRule,
+			internalErrorRule,
+		},
+	}
+
+	tests := []struct {
+		desc         string
+		statusCode   int
+		body         string
+		expectedRule *config.RelayMinerErrorPolicyRule
+	}{
+		{
+			desc:         "status code match",
+			statusCode:   http.StatusTooManyRequests,
+			body:         "Internal error",
+			expectedRule: rateLimitedRule,
+		},
+		{
+			desc:         "JSON-RPC error code match",
+			statusCode:   http.StatusOK,
+			body:         `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted"}}`,
+			expectedRule: executionRevertedRule,
+		},
+		{
+			desc:         "JSON-RPC batch error code match",
+			statusCode:   http.StatusOK,
+			body:         `[{"jsonrpc":"2.0","id":1,"result":"0x1"},{"jsonrpc":"2.0","id":2,"error":{"code":3}}]`,
+			expectedRule: executionRevertedRule,
+		},
+		{
+			desc:         "JSON-RPC error code with another status code falls through to the next rule",
+			statusCode:   http.StatusInternalServerError,
+			body:         `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"internal error"}}`,
+			expectedRule: internalErrorRule,
+		},
+		{
+			desc:         "body pattern match",
+			statusCode:   http.StatusBadGateway,
+			body:         "INTERNAL ERROR",
+			expectedRule: internalErrorRule,
+		},
+		{
+			desc:         "no match",
+			statusCode:   http.StatusOK,
+			body:         `{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+			expectedRule: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			httpResponse := &http.Response{
+				StatusCode: test.statusCode,
+				Body:       io.NopCloser(bytes.NewReader([]byte(test.body))),
+			}
+
+			rule, err := matchErrorPolicyRule(polyzero.NewLogger(), errorPolicy, httpResponse, 1024)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedRule, rule)
+
+			// The body read to match the rules can be read again.
+			body, err := io.ReadAll(httpResponse.Body)
+			require.NoError(t, err)
+			require.Equal(t, test.body, string(body))
+		})
+	}
+}
+
+func TestMatchErrorPolicyRule_MaxBodySize(t *testing.T) {
+	errorPolicy := &config.RelayMinerErrorPolicyConfig{
+		Rules: []*config.RelayMinerErrorPolicyRule{{
+			Name:        "rule_0",
+			BodyPattern: regexp.MustCompile(`error`),
+		}},
+	}
+	httpResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(bytes.Repeat([]byte("a"), 16))),
+	}
+
+	_, err := matchErrorPolicyRule(polyzero.NewLogger(), errorPolicy, httpResponse, 8)
+	require.ErrorIs(t, err, ErrRelayerProxyResponseLimitExceeded)
+}
+
+func TestSendToServiceBackends_ErrorPolicy(t *testing.T) {
+	rateLimitedBody := `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"rate limited"}}`
+	rateLimitedBackend := newTestHTTPBackend(t, http.StatusOK, rateLimitedBody)
+	failingBackend := newTestHTTPBackend(t, http.StatusBadGateway, "failing")
+	healthyBackend := newTestHTTPBackend(t, http.StatusOK, "healthy")
+
+	retryRule := &config.RelayMinerErrorPolicyRule{
+		Name:              "rate_limited",
+		JSONRPCErrorCodes: []int64{-32005},
+		Action:            config.ErrorPolicyActionRetry,
+	}
+	passThroughRule := &config.RelayMinerErrorPolicyRule{
+		Name:        "bad_gateway",
+		StatusCodes: []int{http.StatusBadGateway},
+		Action:      config.ErrorPolicyActionPassThrough,
+	}
+
+	tests := []struct {
+		desc               string
+		maxRetries         uint64
+		expectedRule       *config.RelayMinerErrorPolicyRule
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			desc:               "retry rule retries a 2XX response on another backend",
+			maxRetries:         1,
+			expectedRule:       nil,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "healthy",
+		},
+		{
+			desc:               "retry rule passes the response through once the retries are exhausted",
+			maxRetries:         0,
+			expectedRule:       retryRule,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       rateLimitedBody,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			logger := polyzero.NewLogger()
+			serviceConfig := &config.RelayMinerSupplierServiceConfig{
+				BackendUrl: rateLimitedBackend,
+				Backends: []*config.RelayMinerSupplierServiceBackend{
+					{Url: rateLimitedBackend, Weight: 1},
+					{Url: healthyBackend, Weight: 1},
+				},
+				LoadBalancing: &config.RelayMinerLoadBalancingConfig{
+					Strategy:                       config.LoadBalancingStrategyRoundRobin,
+					CircuitBreakerFailureThreshold: config.DefaultCircuitBreakerFailureThreshold,
+					MaxRetries:                     test.maxRetries,
+				},
+				ErrorPolicy: &config.RelayMinerErrorPolicyConfig{
+					Rules: []*config.RelayMinerErrorPolicyRule{retryRule, passThroughRule},
+				},
+			}
+
+			pool := newBackendPool(logger, "svc1", serviceConfig)
+			httpResponse, rule := sendTestRelayToServiceBackends(t, logger, pool, serviceConfig)
+			require.Equal(t, test.expectedRule, rule)
+			requireHTTPResponse(t, httpResponse, test.expectedStatusCode, test.expectedBody)
+
+			// The retried 2XX response is recorded as a failure of its backend.
+			require.Equal(t, rateLimitedBackend, pool.backends[0].url)
+			require.Equal(t, uint64(1), pool.backends[0].numConsecutiveFailures)
+		})
+	}
+
+	t.Run("pass through rule prevents retrying a 5XX response", func(t *testing.T) {
+		logger := polyzero.NewLogger()
+		serviceConfig := &config.RelayMinerSupplierServiceConfig{
+			BackendUrl: failingBackend,
+			Backends: []*config.RelayMinerSupplierServiceBackend{
+				{Url: failingBackend, Weight: 1},
+				{Url: healthyBackend, Weight: 1},
+			},
+			LoadBalancing: &config.RelayMinerLoadBalancingConfig{
+				Strategy:                       config.LoadBalancingStrategyRoundRobin,
+				CircuitBreakerFailureThreshold: config.DefaultCircuitBreakerFailureThreshold,
+				MaxRetries:                     1,
+			},
+			ErrorPolicy: &config.RelayMinerErrorPolicyConfig{
+				Rules: []*config.RelayMinerErrorPolicyRule{retryRule, passThroughRule},
+			},
+		}
+
+		pool := newBackendPool(logger, "svc1", serviceConfig)
+		httpResponse, rule := sendTestRelayToServiceBackends(t, logger, pool, serviceConfig)
+		require.Equal(t, passThroughRule, rule)
+		requireHTTPResponse(t, httpResponse, http.StatusBadGateway, "failing")
+	})
+}
+
+func TestSendToServiceBackends_ErrorPolicyClosesOversizedResponses(t *testing.T) {
+	// Track the backend connections being closed, which happens once the
+	// oversized response body is closed without being fully read.
+	closedConnCh := make(chan struct{}, 1)
+	backend := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(bytes.Repeat([]byte("a"), 4096))
+	}))
+	backend.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateClosed {
+			select {
+			case closedConnCh <- struct{}{}:
+			default:
+			}
+		}
+	}
+	backend.Start()
+	t.Cleanup(backend.Close)
+
+	backendUrl, err := url.Parse(backend.URL)
+	require.NoError(t, err)
+
+	logger := polyzero.NewLogger()
+	serviceConfig := &config.RelayMinerSupplierServiceConfig{
+		BackendUrl: backendUrl,
+		ErrorPolicy: &config.RelayMinerErrorPolicyConfig{
+			Rules: []*config.RelayMinerErrorPolicyRule{{
+				Name:        "execution_reverted",
+				BodyPattern: regexp.MustCompile(`execution reverted`),
+				Action:      config.ErrorPolicyActionMask,
+			}},
+		},
+	}
+	pool := newBackendPool(logger, "svc1", serviceConfig)
+
+	server := &relayMinerHTTPServer{
+		logger:       logger,
+		httpClient:   poktrollhttp.NewDefaultHTTPClientWithDebugMetrics(),
+		serverConfig: &config.RelayMinerServerConfig{MaxBodySize: 1024},
+	}
+
+	relayRequest := newTestRelayRequest(t)
+	backendToTry := pool.nextBackend(nil)
+	httpRequest, err := relayer.BuildServiceBackendRequest(relayRequest, serviceConfig, backendToTry.url)
+	require.NoError(t, err)
+
+	httpResponse, _, rule, err := server.sendToServiceBackends(
+		context.Background(),
+		logger,
+		relayRequest,
+		serviceConfig,
+		pool,
+		backendToTry,
+		httpRequest,
+	)
+	require.ErrorIs(t, err, ErrRelayerProxyResponseLimitExceeded)
+	require.Nil(t, httpResponse)
+	require.Nil(t, rule)
+
+	// The oversized response body is closed, releasing the backend connection.
+	select {
+	case <-closedConnCh:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "the backend connection of the oversized response was not released")
+	}
+}
+
+func TestServeHTTP_ErrorPolicyMaskedRelayIsNotMined(t *testing.T) {
+	const serviceId = "svc1"
+
+	backendUrl := newTestHTTPBackend(t, http.StatusOK,
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`,
+	)
+	supplierAddr := sample.AccAddressBech32()
+	serverConfig := &config.RelayMinerServerConfig{
+		ServerType:  config.RelayMinerServerTypeHTTP,
+		MaxBodySize: 1 << 20,
+		SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+			serviceId: {
+				ServiceId:  serviceId,
+				ServerType: config.RelayMinerServerTypeHTTP,
+				ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+					BackendUrl: backendUrl,
+					ErrorPolicy: &config.RelayMinerErrorPolicyConfig{
+						Rules: []*config.RelayMinerErrorPolicyRule{{
+							Name:              "header_not_found",
+							JSONRPCErrorCodes: []int64{-32000},
+							Action:            config.ErrorPolicyActionMask,
+						}},
+					},
+				},
+				RequestTimeoutSeconds: 5,
+			},
+		},
+	}
+
+	servedRelaysPublisher := relayer.NewServedRelaysPublisher(make(chan *servicetypes.Relay, 1))
+	relayMeter := &fakeRelayMeter{}
+	relayServer := NewHTTPServer(
+		polyzero.NewLogger(),
+		serverConfig,
+		servedRelaysPublisher,
+		&fakeRelayAuthenticator{supplierOperatorAddress: supplierAddr},
+		relayMeter,
+		newFakeBlockClient(t),
+		nil,
+		nil,
+	).(*relayMinerHTTPServer)
+
+	relayMinerServer := httptest.NewServer(relayServer)
+	t.Cleanup(relayMinerServer.Close)
+
+	relayRequest := newTestRelayRequest(t)
+	relayRequest.Meta = servicetypes.RelayRequestMetadata{
+		SessionHeader: &sessiontypes.SessionHeader{
+			ApplicationAddress:      sample.AccAddressBech32(),
+			ServiceId:               serviceId,
+			SessionId:               "session_id",
+			SessionStartBlockHeight: 1,
+			SessionEndBlockHeight:   10,
+		},
+		Signature:               []byte("application_signature"),
+		SupplierOperatorAddress: supplierAddr,
+	}
+
+	httpResponse, err := http.Post(
+		relayMinerServer.URL,
+		"application/octet-stream",
+		bytes.NewReader(mustMarshal(t, relayRequest)),
+	)
+	require.NoError(t, err)
+	defer httpResponse.Body.Close()
+
+	relayResponseBz, err := io.ReadAll(httpResponse.Body)
+	require.NoError(t, err)
+
+	// The client gets the generic backend error instead of the backend response.
+	relayResponse := &servicetypes.RelayResponse{}
+	require.NoError(t, relayResponse.Unmarshal(relayResponseBz))
+	require.NotNil(t, relayResponse.RelayMinerError)
+	require.Equal(t, ErrRelayerProxyServiceBackendError.Codespace(), relayResponse.RelayMinerError.Codespace)
+	require.Equal(t, ErrRelayerProxyServiceBackendError.ABCICode(), relayResponse.RelayMinerError.Code)
+	require.Empty(t, relayResponse.Meta.SupplierOperatorSignature)
+
+	// The masked relay is neither mined nor rewarded.
+	require.Zero(t, servedRelaysPublisher.NumPublishedRelays())
+	require.Equal(t, int32(1), relayMeter.numNonApplicable.Load())
+}
+
+func TestIsRewardApplicable(t *testing.T) {
+	rewardEligible := true
+	rewardIneligible := false
+
+	require.True(t, isRewardApplicable(false, http.StatusOK, nil))
+	require.False(t, isRewardApplicable(false, http.StatusBadGateway, nil))
+	require.False(t, isRewardApplicable(true, http.StatusOK, nil))
+
+	// The error policy rule overrides the status code based eligibility.
+	require.True(t, isRewardApplicable(false, http.StatusBadGateway, &config.RelayMinerErrorPolicyRule{
+		RewardEligible: &rewardEligible,
+	}))
+	require.False(t, isRewardApplicable(false, http.StatusOK, &config.RelayMinerErrorPolicyRule{
+		RewardEligible: &rewardIneligible,
+	}))
+	require.False(t, isRewardApplicable(false, http.StatusBadGateway, &config.RelayMinerErrorPolicyRule{}))
+
+	// Over-serviced relays are never rewarded.
+	require.False(t, isRewardApplicable(true, http.StatusOK, &config.RelayMinerErrorPolicyRule{
+		RewardEligible: &rewardEligible,
+	}))
+}
+
+// sendTestRelayToServiceBackends sends a test relay request to the first backend
+// of the given pool and returns the final response and the error policy rule it
+// matched.
+func sendTestRelayToServiceBackends(
+	t *testing.T,
+	logger polylog.Logger,
+	pool *backendPool,
+	serviceConfig *config.RelayMinerSupplierServiceConfig,
+) (*http.Response, *config.RelayMinerErrorPolicyRule) {
+	t.Helper()
+
+	server := &relayMinerHTTPServer{
+		logger:       logger,
+		httpClient:   poktrollhttp.NewDefaultHTTPClientWithDebugMetrics(),
+		serverConfig: &config.RelayMinerServerConfig{MaxBodySize: 1024},
+	}
+
+	relayRequest := newTestRelayRequest(t)
+	backend := pool.nextBackend(nil)
+	require.Equal(t, serviceConfig.BackendUrl, backend.url)
+
+	httpRequest, err := relayer.BuildServiceBackendRequest(relayRequest, serviceConfig, backend.url)
+	require.NoError(t, err)
+
+	httpResponse, _, rule, err := server.sendToServiceBackends(
+		context.Background(),
+		logger,
+		relayRequest,
+		serviceConfig,
+		pool,
+		backend,
+		httpRequest,
+	)
+	require.NoError(t, err)
+
+	return httpResponse, rule
+}
+
+// requireHTTPResponse asserts the status code and body of the given response.
+func requireHTTPResponse(t *testing.T, httpResponse *http.Response, expectedStatusCode int, expectedBody string) {
+	t.Helper()
+
+	defer httpResponse.Body.Close()
+
+	body, err := io.ReadAll(httpResponse.Body)
+	require.NoError(t, err)
+	require.Equal(t, expectedStatusCode, httpResponse.StatusCode)
+	require.Equal(t, expectedBody, string(body))
+}
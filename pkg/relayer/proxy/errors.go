@@ -20,4 +20,7 @@ var (
 	ErrRelayerProxyResponseLimitExceeded     = sdkerrors.Register(codespace, 12, "response limit exceed")
 	ErrRelayerProxyRequestLimitExceeded      = sdkerrors.Register(codespace, 13, "request limit exceed")
 	ErrRelayerProxyUnmarshalingRelayRequest  = sdkerrors.Register(codespace, 14, "failed to unmarshal relay request")
+	ErrRelayerProxyInvalidTLSConfig          = sdkerrors.Register(codespace, 15, "invalid relayer proxy TLS configuration")
+	ErrRelayerProxyRelayRateLimitExceeded    = sdkerrors.Register(codespace, 16, "application or gateway relay rate limit exceeded")
+	ErrRelayerProxyServiceBackendError       = sdkerrors.Register(codespace, 17, "service backend error")
 )
@@ -0,0 +1,313 @@
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// grpcRelayStreamDesc describes the backend calls made by the gRPC relay server.
+// Unary and server-streaming calls are indistinguishable on the wire, so both
+// are proxied as server-streaming calls.
+var grpcRelayStreamDesc = &grpc.StreamDesc{ServerStreams: true}
+
+// serveRelayStream serves a relayed gRPC call (unary or server-streaming).
+// It implements grpc.StreamHandler and is registered as the gRPC server's
+// unknown service handler so that any backend method can be relayed.
+//
+// Unlike the http server, the relay request is always validated before reaching
+// the backend since a stream may produce an unbounded number of relays.
+func (server *relayMinerGRPCServer) serveRelayStream(_ any, gatewayStream grpc.ServerStream) error {
+	ctx := gatewayStream.Context()
+
+	fullMethod, ok := grpc.MethodFromServerStream(gatewayStream)
+	if !ok {
+		return status.Error(codes.Internal, "unable to determine the relayed gRPC method")
+	}
+
+	logger := server.logger.With(
+		"relay_request_type", "📡 grpc",
+		"grpc_method", fullMethod,
+	)
+
+	// The gateway sends exactly one message: the serialized relay request.
+	var relayRequestBz []byte
+	if err := gatewayStream.RecvMsg(&relayRequestBz); err != nil {
+		logger.Warn().Err(err).Msg("❌ Failed receiving relay request")
+		return err
+	}
+
+	relayRequest := &types.RelayRequest{}
+	if err := relayRequest.Unmarshal(relayRequestBz); err != nil {
+		return relayStatusError(codes.InvalidArgument, ErrRelayerProxyUnmarshalingRelayRequest.Wrap(err.Error()))
+	}
+
+	if err := relayRequest.ValidateBasic(); err != nil {
+		logger.Warn().Err(err).Msg("❌ Failed validating relay request")
+		return relayStatusError(codes.InvalidArgument, err)
+	}
+
+	meta := relayRequest.Meta
+	sessionHeader := meta.SessionHeader
+	serviceId := sessionHeader.ServiceId
+	supplierOperatorAddress := meta.SupplierOperatorAddress
+	logger = logger.With(
+		"session_id", sessionHeader.SessionId,
+		"service_id", serviceId,
+		"application_address", sessionHeader.ApplicationAddress,
+		"supplier_operator_address", supplierOperatorAddress,
+	)
+
+	if !slices.Contains(server.relayAuthenticator.GetSupplierOperatorAddresses(), supplierOperatorAddress) {
+		logger.Warn().Msg("❌ The request's selected supplier is not available for relaying")
+		return relayStatusError(codes.Unavailable, ErrRelayerProxySupplierNotReachable)
+	}
+
+	supplierConfig, ok := server.serverConfig.SupplierConfigsMap[serviceId]
+	if !ok {
+		return relayStatusError(
+			codes.Unimplemented,
+			ErrRelayerProxyServiceEndpointNotHandled.Wrapf("service %q not configured", serviceId),
+		)
+	}
+
+	// Use the gRPC specific service config, if any.
+	serviceConfig := supplierConfig.ServiceConfig
+	if grpcServiceConfig, ok := supplierConfig.RPCTypeServiceConfigs[sharedtypes.RPCType_GRPC]; ok {
+		serviceConfig = grpcServiceConfig
+	}
+
+	relayer.RelayRequestSizeBytes.With("service_id", serviceId).Observe(float64(relayRequest.Size()))
+
+	if err := server.relayAuthenticator.VerifyRelayRequest(ctx, relayRequest, serviceId); err != nil {
+		logger.Error().Err(err).Msg("❌ Failed verifying relay request")
+		return relayStatusError(codes.Unauthenticated, err)
+	}
+
+	// Only verified relay requests are charged against the rate limits.
+	// The concurrent requests slot is held for the whole stream duration.
+	releaseRateLimit, err := admitRelayRequest(server.rateLimiters, relayRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("❌ Relay request rejected by the rate limits")
+		return relayStatusError(codes.ResourceExhausted, err)
+	}
+	defer releaseRateLimit()
+
+	// Each backend frame is a relay whose reward is optimistically accumulated
+	// before it is served (see relayMinerHTTPServer.serveSyncRequest).
+	// isFrameRewardAccumulated tracks whether the current frame's reward must be
+	// reverted if the frame does not end up emitted to the miner.
+	isFrameRewardAccumulated := false
+	unclaimFrameReward := func() {
+		if isFrameRewardAccumulated {
+			server.relayMeter.SetNonApplicableRelayReward(ctx, meta)
+			isFrameRewardAccumulated = false
+		}
+	}
+	defer unclaimFrameReward()
+
+	meterFrame := func() (isOverServicing bool, err error) {
+		isOverServicing = server.relayMeter.IsOverServicing(ctx, meta)
+		isFrameRewardAccumulated = true
+		if isOverServicing && !server.relayMeter.AllowOverServicing() {
+			return isOverServicing, relayStatusError(codes.ResourceExhausted, ErrRelayerProxyRateLimited)
+		}
+		return isOverServicing, nil
+	}
+
+	isOverServicing, err := meterFrame()
+	if err != nil {
+		return err
+	}
+
+	// Select the backend to forward the call to among the service config backends.
+	// The call is not retried on another backend since its frames may already
+	// have been streamed to the gateway by the time it fails.
+	backendPool := getBackendPool(server.logger, server.backendPools, serviceId, serviceConfig)
+	backend := backendPool.nextBackend(nil)
+	logger = logger.With("destination_url", backend.url.String())
+
+	// The backend call fails if the backend cannot be reached, i.e. if the
+	// request cannot be sent to it.
+	isBackendCallSent := false
+	backendPool.startRequest(backend)
+	defer func() { backendPool.endRequest(backend, isBackendCallSent) }()
+
+	backendConn, err := server.getBackendConn(backend.url)
+	if err != nil {
+		logger.Error().Err(err).Msg("❌ Failed getting backend connection")
+		return relayStatusError(codes.Internal, err)
+	}
+
+	// The request timeout bounds the wait for each backend frame rather than the
+	// whole call, so that long-lived server-streaming calls are not interrupted
+	// as long as the backend keeps producing frames.
+	frameTimeout := time.Duration(supplierConfig.RequestTimeoutSeconds) * time.Second
+	if frameTimeout == 0 {
+		frameTimeout = config.DefaultRequestTimeoutDuration
+	}
+	backendCtx, cancelBackendCtx := context.WithCancel(ctx)
+	defer cancelBackendCtx()
+	frameTimer := time.AfterFunc(frameTimeout, cancelBackendCtx)
+	defer frameTimer.Stop()
+
+	backendCtx = metadata.NewOutgoingContext(backendCtx, buildGRPCBackendMetadata(relayRequest, serviceConfig))
+	backendStream, err := backendConn.NewStream(
+		backendCtx,
+		grpcRelayStreamDesc,
+		fullMethod,
+		grpc.ForceCodec(grpcRawFrameCodec{}),
+	)
+	if err != nil {
+		logger.Error().Err(err).Msg("❌ Failed opening backend stream")
+		return err
+	}
+
+	if err = backendStream.SendMsg(&relayRequest.Payload); err != nil && !errors.Is(err, io.EOF) {
+		logger.Error().Err(err).Msg("❌ Failed sending request to backend")
+		return err
+	}
+	if err = backendStream.CloseSend(); err != nil {
+		logger.Error().Err(err).Msg("❌ Failed closing backend stream send direction")
+		return err
+	}
+	isBackendCallSent = true
+
+	for numFrames := 0; ; numFrames++ {
+		var responseBz []byte
+		if err = backendStream.RecvMsg(&responseBz); err != nil {
+			if errors.Is(err, io.EOF) {
+				// The backend completed the call successfully.
+				return nil
+			}
+
+			// Surface the frame timeout as such instead of a generic cancellation.
+			if ctx.Err() == nil && backendCtx.Err() != nil {
+				return relayStatusError(
+					codes.DeadlineExceeded,
+					ErrRelayerProxyTimeout.Wrapf("no backend frame received within %s", frameTimeout),
+				)
+			}
+
+			// Backend status errors are propagated as-is to the gateway.
+			logger.Warn().Err(err).Int("num_frames", numFrames).Msg("⚠️ Backend stream ended with an error")
+			return err
+		}
+		frameTimer.Reset(frameTimeout)
+
+		// The first frame was metered before reaching the backend.
+		if numFrames > 0 {
+			if isOverServicing, err = meterFrame(); err != nil {
+				return err
+			}
+		}
+
+		relayer.RelaysTotal.With(
+			"service_id", serviceId,
+			"supplier_operator_address", supplierOperatorAddress,
+		).Add(1)
+
+		relayResponse, err := newSignedRelayResponse(
+			server.blockClient,
+			server.relayAuthenticator,
+			responseBz,
+			sessionHeader,
+			supplierOperatorAddress,
+		)
+		if err != nil {
+			logger.Error().Err(err).Msg("❌ Failed building the relay response")
+			return relayStatusError(codes.Internal, ErrRelayerProxyInternalError.Wrap(err.Error()))
+		}
+
+		relayResponseBz, err := relayResponse.Marshal()
+		if err != nil {
+			return relayStatusError(codes.Internal, ErrRelayerProxyInternalError.Wrap(err.Error()))
+		}
+
+		if err = gatewayStream.SendMsg(&relayResponseBz); err != nil {
+			logger.Warn().Err(err).Msg("❌ Failed sending relay response")
+			return err
+		}
+
+		relayer.RelaysSuccessTotal.With("service_id", serviceId).Add(1)
+		relayer.RelayResponseSizeBytes.With("service_id", serviceId).Observe(float64(relayResponse.Size()))
+
+		// Stop streaming once the session can no longer be claimed: the gateway
+		// is expected to reopen the stream with its new session.
+		if err = server.relayAuthenticator.CheckRelayRewardEligibility(ctx, relayRequest); err != nil {
+			logger.Warn().Err(err).Msg("⏱️ Relay no longer reward eligible, closing the stream")
+			return relayStatusError(codes.Unavailable, ErrRelayerProxyInvalidSession.Wrap(err.Error()))
+		}
+
+		// Over-serviced and dropped relays are not rewarded: revert their reward.
+		relay := &types.Relay{Req: relayRequest, Res: relayResponse}
+		if !isOverServicing && server.emitServedRelay(logger, relay) {
+			isFrameRewardAccumulated = false
+		}
+		unclaimFrameReward()
+	}
+}
+
+// emitServedRelay forwards the given reward-eligible relay to the miner.
+// It does not block if the mining channel is full, the relay being dropped instead.
+// It returns whether the relay was emitted.
+func (server *relayMinerGRPCServer) emitServedRelay(logger polylog.Logger, relay *types.Relay) bool {
+	if server.servedRewardableRelaysProducer.TryPublish(relay) {
+		return true
+	}
+
+	logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).
+		Msg("⚠️ Relay mining channel full or closed - dropping relay from mining pipeline")
+	return false
+}
+
+// buildGRPCBackendMetadata builds the outgoing gRPC metadata of the backend call
+// from the service config headers, authentication and Pocket headers.
+func buildGRPCBackendMetadata(
+	relayRequest *types.RelayRequest,
+	serviceConfig *config.RelayMinerSupplierServiceConfig,
+) metadata.MD {
+	header := http.Header{}
+	for headerKey, headerValue := range serviceConfig.Headers {
+		header.Set(headerKey, headerValue)
+	}
+
+	if serviceConfig.ForwardPocketHeaders {
+		relayer.ForwardPocketHeaders(&header, relayRequest.Meta)
+	}
+
+	if serviceConfig.Authentication != nil {
+		auth := serviceConfig.Authentication.Username + ":" + serviceConfig.Authentication.Password
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(auth)))
+	}
+
+	// gRPC metadata keys are lowercase.
+	md := metadata.MD{}
+	for headerKey, headerValues := range header {
+		md.Append(strings.ToLower(headerKey), headerValues...)
+	}
+
+	return md
+}
+
+// relayStatusError converts the given relay error into a gRPC status error with
+// the given code, so the gateway can tell client errors from supplier ones.
+func relayStatusError(code codes.Code, err error) error {
+	return status.Error(code, err.Error())
+}
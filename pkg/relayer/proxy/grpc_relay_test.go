@@ -0,0 +1,378 @@
+package proxy
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+const (
+	testGRPCServiceId = "grpcsvc"
+
+	healthCheckMethod = "/grpc.health.v1.Health/Check"
+	healthWatchMethod = "/grpc.health.v1.Health/Watch"
+)
+
+var _ relayer.RelayAuthenticator = (*fakeRelayAuthenticator)(nil)
+var _ relayer.RelayMeter = (*fakeRelayMeter)(nil)
+var _ client.BlockClient = (*fakeBlockClient)(nil)
+
+// TestGRPCRelay_Unary relays a unary call to an in-process gRPC backend and
+// ensures the response is signed and emitted for mining.
+func TestGRPCRelay_Unary(t *testing.T) {
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(testGRPCServiceId, healthpb.HealthCheckResponse_SERVING)
+
+	test := newGRPCRelayTest(t, healthServer, &fakeRelayMeter{})
+
+	relayRequestBz := test.relayRequestBz(t, &healthpb.HealthCheckRequest{Service: testGRPCServiceId})
+
+	var relayResponseBz []byte
+	err := test.gatewayConn.Invoke(
+		context.Background(),
+		healthCheckMethod,
+		&relayRequestBz,
+		&relayResponseBz,
+		grpc.ForceCodec(grpcRawFrameCodec{}),
+	)
+	require.NoError(t, err)
+
+	healthResponse := &healthpb.HealthCheckResponse{}
+	test.requireSignedRelayResponse(t, relayResponseBz, healthResponse)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, healthResponse.GetStatus())
+
+	relay := test.requireServedRelay(t)
+	require.Equal(t, relayRequestBz, mustMarshal(t, relay.Req))
+	require.Equal(t, relayResponseBz, mustMarshal(t, relay.Res))
+
+	require.EqualValues(t, 1, test.relayMeter.numIsOverServicing.Load())
+	require.EqualValues(t, 0, test.relayMeter.numNonApplicable.Load())
+}
+
+// TestGRPCRelay_ServerStreaming relays a server-streaming call to an in-process
+// gRPC backend and ensures every streamed frame is a signed and mined relay.
+func TestGRPCRelay_ServerStreaming(t *testing.T) {
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(testGRPCServiceId, healthpb.HealthCheckResponse_SERVING)
+
+	test := newGRPCRelayTest(t, healthServer, &fakeRelayMeter{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gatewayStream, err := test.gatewayConn.NewStream(
+		ctx,
+		&grpc.StreamDesc{ServerStreams: true},
+		healthWatchMethod,
+		grpc.ForceCodec(grpcRawFrameCodec{}),
+	)
+	require.NoError(t, err)
+
+	relayRequestBz := test.relayRequestBz(t, &healthpb.HealthCheckRequest{Service: testGRPCServiceId})
+	require.NoError(t, gatewayStream.SendMsg(&relayRequestBz))
+	require.NoError(t, gatewayStream.CloseSend())
+
+	expectedStatuses := []healthpb.HealthCheckResponse_ServingStatus{
+		healthpb.HealthCheckResponse_SERVING,
+		healthpb.HealthCheckResponse_NOT_SERVING,
+		healthpb.HealthCheckResponse_SERVING,
+	}
+	for i, expectedStatus := range expectedStatuses {
+		// The first status is sent by the backend as soon as the stream opens.
+		if i > 0 {
+			healthServer.SetServingStatus(testGRPCServiceId, expectedStatus)
+		}
+
+		var relayResponseBz []byte
+		require.NoError(t, gatewayStream.RecvMsg(&relayResponseBz))
+
+		healthResponse := &healthpb.HealthCheckResponse{}
+		test.requireSignedRelayResponse(t, relayResponseBz, healthResponse)
+		require.Equal(t, expectedStatus, healthResponse.GetStatus())
+
+		relay := test.requireServedRelay(t)
+		require.Equal(t, relayResponseBz, mustMarshal(t, relay.Res))
+	}
+
+	require.EqualValues(t, len(expectedStatuses), test.relayMeter.numIsOverServicing.Load())
+	require.EqualValues(t, 0, test.relayMeter.numNonApplicable.Load())
+}
+
+// TestGRPCRelay_RateLimited ensures over-serviced calls never reach the backend
+// and that their optimistically accumulated reward is reverted.
+func TestGRPCRelay_RateLimited(t *testing.T) {
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(testGRPCServiceId, healthpb.HealthCheckResponse_SERVING)
+
+	test := newGRPCRelayTest(t, healthServer, &fakeRelayMeter{isOverServicing: true})
+
+	relayRequestBz := test.relayRequestBz(t, &healthpb.HealthCheckRequest{Service: testGRPCServiceId})
+
+	var relayResponseBz []byte
+	err := test.gatewayConn.Invoke(
+		context.Background(),
+		healthCheckMethod,
+		&relayRequestBz,
+		&relayResponseBz,
+		grpc.ForceCodec(grpcRawFrameCodec{}),
+	)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	require.Empty(t, test.servedRelaysCh)
+	require.EqualValues(t, 1, test.relayMeter.numIsOverServicing.Load())
+	require.EqualValues(t, 1, test.relayMeter.numNonApplicable.Load())
+}
+
+// TestGRPCRelay_BackendError ensures backend status errors are propagated to the
+// gateway and that no relay is mined.
+func TestGRPCRelay_BackendError(t *testing.T) {
+	// The health server replies NotFound for unknown services.
+	test := newGRPCRelayTest(t, health.NewServer(), &fakeRelayMeter{})
+
+	relayRequestBz := test.relayRequestBz(t, &healthpb.HealthCheckRequest{Service: "unknown"})
+
+	var relayResponseBz []byte
+	err := test.gatewayConn.Invoke(
+		context.Background(),
+		healthCheckMethod,
+		&relayRequestBz,
+		&relayResponseBz,
+		grpc.ForceCodec(grpcRawFrameCodec{}),
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	require.Empty(t, test.servedRelaysCh)
+	require.EqualValues(t, 1, test.relayMeter.numNonApplicable.Load())
+}
+
+// grpcRelayTest holds an in-process gRPC backend, the RelayMiner gRPC server
+// relaying to it and a gateway connection to the RelayMiner.
+type grpcRelayTest struct {
+	relayMeter     *fakeRelayMeter
+	servedRelaysCh chan *types.Relay
+	gatewayConn    *grpc.ClientConn
+	sessionHeader  *sessiontypes.SessionHeader
+	supplierAddr   string
+}
+
+func newGRPCRelayTest(
+	t *testing.T,
+	healthServer healthpb.HealthServer,
+	relayMeter *fakeRelayMeter,
+) *grpcRelayTest {
+	t.Helper()
+
+	// Start the in-process gRPC backend.
+	backendListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	backendServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(backendServer, healthServer)
+	go func() { _ = backendServer.Serve(backendListener) }()
+	t.Cleanup(backendServer.Stop)
+
+	// Start the RelayMiner gRPC server.
+	relayMinerListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	supplierAddr := sample.AccAddressBech32()
+	serverConfig := &config.RelayMinerServerConfig{
+		ServerType:    config.RelayMinerServerTypeGRPC,
+		ListenAddress: relayMinerListener.Addr().String(),
+		SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+			testGRPCServiceId: {
+				ServiceId:  testGRPCServiceId,
+				ServerType: config.RelayMinerServerTypeGRPC,
+				ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+					BackendUrl: &url.URL{Scheme: "grpc", Host: backendListener.Addr().String()},
+				},
+				RequestTimeoutSeconds: 5,
+			},
+		},
+	}
+
+	servedRelaysCh := make(chan *types.Relay, 10)
+	relayServer := NewGRPCServer(
+		polyzero.NewLogger(),
+		serverConfig,
+		relayer.NewServedRelaysPublisher(servedRelaysCh),
+		&fakeRelayAuthenticator{supplierOperatorAddress: supplierAddr},
+		relayMeter,
+		newFakeBlockClient(t),
+	).(*relayMinerGRPCServer)
+
+	go func() { _ = relayServer.server.Serve(relayMinerListener) }()
+	t.Cleanup(func() { _ = relayServer.Stop(context.Background()) })
+
+	// Connect the gateway to the RelayMiner.
+	gatewayConn, err := grpc.NewClient(
+		relayMinerListener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = gatewayConn.Close() })
+
+	return &grpcRelayTest{
+		relayMeter:     relayMeter,
+		servedRelaysCh: servedRelaysCh,
+		gatewayConn:    gatewayConn,
+		supplierAddr:   supplierAddr,
+		sessionHeader: &sessiontypes.SessionHeader{
+			ApplicationAddress:      sample.AccAddressBech32(),
+			ServiceId:               testGRPCServiceId,
+			SessionId:               "session_id",
+			SessionStartBlockHeight: 1,
+			SessionEndBlockHeight:   10,
+		},
+	}
+}
+
+// relayRequestBz returns a serialized relay request wrapping the given backend request.
+func (test *grpcRelayTest) relayRequestBz(t *testing.T, backendRequest proto.Message) []byte {
+	t.Helper()
+
+	payload, err := proto.Marshal(backendRequest)
+	require.NoError(t, err)
+
+	return mustMarshal(t, &types.RelayRequest{
+		Meta: types.RelayRequestMetadata{
+			SessionHeader:           test.sessionHeader,
+			Signature:               []byte("application_signature"),
+			SupplierOperatorAddress: test.supplierAddr,
+		},
+		Payload: payload,
+	})
+}
+
+// requireSignedRelayResponse ensures the given relay response is signed and
+// unmarshals its payload into backendResponse.
+func (test *grpcRelayTest) requireSignedRelayResponse(
+	t *testing.T,
+	relayResponseBz []byte,
+	backendResponse proto.Message,
+) {
+	t.Helper()
+
+	relayResponse := &types.RelayResponse{}
+	require.NoError(t, relayResponse.Unmarshal(relayResponseBz))
+	require.NoError(t, relayResponse.ValidateBasic())
+	require.Equal(t, []byte(test.supplierAddr), relayResponse.Meta.SupplierOperatorSignature)
+	require.Equal(t, test.sessionHeader, relayResponse.Meta.SessionHeader)
+	require.NoError(t, proto.Unmarshal(relayResponse.Payload, backendResponse))
+}
+
+// requireServedRelay waits for a relay to be emitted for mining and returns it.
+func (test *grpcRelayTest) requireServedRelay(t *testing.T) *types.Relay {
+	t.Helper()
+
+	select {
+	case relay := <-test.servedRelaysCh:
+		return relay
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a served relay")
+		return nil
+	}
+}
+
+func mustMarshal(t *testing.T, msg interface{ Marshal() ([]byte, error) }) []byte {
+	t.Helper()
+
+	bz, err := msg.Marshal()
+	require.NoError(t, err)
+
+	return bz
+}
+
+// fakeRelayAuthenticator accepts every relay request and "signs" the relay
+// responses with the supplier operator address.
+type fakeRelayAuthenticator struct {
+	supplierOperatorAddress string
+}
+
+func (ra *fakeRelayAuthenticator) VerifyRelayRequest(context.Context, *types.RelayRequest, string) error {
+	return nil
+}
+
+func (ra *fakeRelayAuthenticator) CheckRelayRewardEligibility(context.Context, *types.RelayRequest) error {
+	return nil
+}
+
+func (ra *fakeRelayAuthenticator) SignRelayResponse(relayResponse *types.RelayResponse, supplierOperatorAddr string) error {
+	relayResponse.Meta.SupplierOperatorSignature = []byte(supplierOperatorAddr)
+	return nil
+}
+
+func (ra *fakeRelayAuthenticator) GetSupplierOperatorAddresses() []string {
+	return []string{ra.supplierOperatorAddress}
+}
+
+func (ra *fakeRelayAuthenticator) SetSigningKeyNames([]string) error {
+	return nil
+}
+
+// fakeRelayMeter counts the relay meter calls and never allows over-servicing.
+type fakeRelayMeter struct {
+	isOverServicing    bool
+	numIsOverServicing atomic.Int32
+	numNonApplicable   atomic.Int32
+}
+
+func (rm *fakeRelayMeter) Start(context.Context) error { return nil }
+
+func (rm *fakeRelayMeter) IsOverServicing(context.Context, types.RelayRequestMetadata) bool {
+	rm.numIsOverServicing.Add(1)
+	return rm.isOverServicing
+}
+
+func (rm *fakeRelayMeter) SetNonApplicableRelayReward(context.Context, types.RelayRequestMetadata) {
+	rm.numNonApplicable.Add(1)
+}
+
+func (rm *fakeRelayMeter) AllowOverServicing() bool { return false }
+
+// fakeBlockClient only provides the chain version used to build relay responses
+// and a last block at height 1.
+// The testblock helpers cannot be used here since they (indirectly) import this package.
+type fakeBlockClient struct {
+	client.BlockClient
+	chainVersion *version.Version
+}
+
+// fakeBlock is a block whose height is its value, with an empty hash.
+type fakeBlock int64
+
+func (b fakeBlock) Height() int64 { return int64(b) }
+func (b fakeBlock) Hash() []byte  { return nil }
+
+func newFakeBlockClient(t *testing.T) *fakeBlockClient {
+	t.Helper()
+
+	chainVersion, err := version.NewVersion("v0.1.27")
+	require.NoError(t, err)
+
+	return &fakeBlockClient{chainVersion: chainVersion}
+}
+
+func (bc *fakeBlockClient) GetChainVersion() *version.Version      { return bc.chainVersion }
+func (bc *fakeBlockClient) LastBlock(context.Context) client.Block { return fakeBlock(1) }
@@ -0,0 +1,290 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+var _ relayer.RelayServer = (*relayMinerGRPCServer)(nil)
+
+// relayMinerGRPCServer is the struct that holds the state of the RelayMiner's gRPC server.
+// It accepts gRPC calls coming from the Gateway and proxies them to the gRPC
+// service backend of the corresponding service.
+//
+// Every gRPC method is served, the full method name of the gateway call being
+// used as-is for the backend call. The call messages are framed as follows:
+//   - The gateway sends a single message: a serialized RelayRequest whose payload
+//     is the serialized backend request message.
+//   - Each message received from the backend (one for unary calls, many for
+//     server-streaming calls) is wrapped into a signed RelayResponse, sent to the
+//     gateway and emitted as a Relay{Req, Res} pair to be mined.
+//
+// Client-streaming and bidirectional-streaming calls are not supported since
+// every gateway message would need its own paid request/response pairing.
+type relayMinerGRPCServer struct {
+	logger polylog.Logger
+
+	// serverConfig is the RelayMiner's proxy server configuration.
+	serverConfig *config.RelayMinerServerConfig
+
+	// server is the gRPC server that listens for incoming relayed gRPC calls.
+	server *grpc.Server
+
+	// relayAuthenticator is the RelayMiner's relay authenticator that validates
+	// the relay requests and signs the relay responses.
+	relayAuthenticator relayer.RelayAuthenticator
+
+	// servedRewardableRelaysProducer is the publisher that emits the relays that
+	// have been successfully served and are reward-applicable.
+	// See relayMinerHTTPServer for more details.
+	servedRewardableRelaysProducer *relayer.ServedRelaysPublisher
+
+	// relayMeter is the relay meter that the RelayServer uses to meter the relays and claim the relay price.
+	relayMeter relayer.RelayMeter
+
+	// blockClient is used to get the chain version when building relay responses.
+	blockClient client.BlockClient
+
+	// backendConns is a map of backend URL -> gRPC client connection.
+	// Connections are created lazily and reused across relays.
+	backendConns   map[string]*grpc.ClientConn
+	backendConnsMu sync.Mutex
+
+	// backendPools is a map of service config -> pool of its backends, which
+	// spreads the gRPC calls across the backends.
+	backendPools map[*config.RelayMinerSupplierServiceConfig]*backendPool
+
+	// rateLimiters is a map of service ID -> rate limiter of the applications
+	// and gateways sending gRPC calls to the service.
+	// Services that are not rate limited are absent from the map.
+	rateLimiters map[string]*serviceRateLimiter
+}
+
+// NewGRPCServer creates a new RelayServer that listens for incoming gRPC calls
+// and proxies them to the corresponding gRPC service backend.
+func NewGRPCServer(
+	logger polylog.Logger,
+	serverConfig *config.RelayMinerServerConfig,
+	servedRelaysProducer *relayer.ServedRelaysPublisher,
+	relayAuthenticator relayer.RelayAuthenticator,
+	relayMeter relayer.RelayMeter,
+	blockClient client.BlockClient,
+) relayer.RelayServer {
+	grpcServer := &relayMinerGRPCServer{
+		logger:                         logger,
+		serverConfig:                   serverConfig,
+		relayAuthenticator:             relayAuthenticator,
+		servedRewardableRelaysProducer: servedRelaysProducer,
+		relayMeter:                     relayMeter,
+		blockClient:                    blockClient,
+		backendConns:                   make(map[string]*grpc.ClientConn),
+		backendPools:                   newServerBackendPools(logger, serverConfig),
+		rateLimiters:                   newServerRateLimiters(logger, serverConfig),
+	}
+
+	serverOptions := []grpc.ServerOption{
+		// Messages are relayed as opaque bytes: neither the RelayMiner nor the
+		// gateway need to know the protobuf definitions of the backend service.
+		grpc.ForceServerCodec(grpcRawFrameCodec{}),
+		// Every service and method is handled by the relay handler.
+		grpc.UnknownServiceHandler(grpcServer.serveRelayStream),
+	}
+	if serverConfig.MaxBodySize > 0 {
+		serverOptions = append(serverOptions,
+			grpc.MaxRecvMsgSize(int(serverConfig.MaxBodySize)),
+			grpc.MaxSendMsgSize(int(serverConfig.MaxBodySize)),
+		)
+	}
+
+	grpcServer.server = grpc.NewServer(serverOptions...)
+
+	return grpcServer
+}
+
+// Start starts the service server and returns an error if it fails.
+// It also waits for the passed in context to end before shutting down.
+// This method is blocking and should be called in a goroutine.
+func (server *relayMinerGRPCServer) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", server.serverConfig.ListenAddress)
+	if err != nil {
+		server.logger.Error().Err(err).Msg("failed to create listener")
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Stop(ctx)
+	}()
+
+	// Periodically health check the backends to fail over from the unhealthy ones.
+	for _, pool := range server.backendPools {
+		pool.startHealthChecks(ctx, server.backendHealthCheck(pool.serviceId))
+	}
+
+	return server.server.Serve(listener)
+}
+
+// Stop terminates the service server and closes the backend connections.
+func (server *relayMinerGRPCServer) Stop(_ context.Context) error {
+	server.server.Stop()
+
+	server.backendConnsMu.Lock()
+	defer server.backendConnsMu.Unlock()
+
+	for backendUrl, backendConn := range server.backendConns {
+		_ = backendConn.Close()
+		delete(server.backendConns, backendUrl)
+	}
+
+	return nil
+}
+
+// Ping tries to connect to the suppliers gRPC backends to test the connection.
+// A service config is reachable as long as one of its backends is.
+func (server *relayMinerGRPCServer) Ping(ctx context.Context) error {
+	for _, supplierCfg := range server.serverConfig.SupplierConfigsMap {
+		serviceConfigs := []*config.RelayMinerSupplierServiceConfig{supplierCfg.ServiceConfig}
+		for _, rpcTypeServiceConfig := range supplierCfg.RPCTypeServiceConfigs {
+			serviceConfigs = append(serviceConfigs, rpcTypeServiceConfig)
+		}
+
+		for _, serviceConfig := range serviceConfigs {
+			pool := getBackendPool(server.logger, server.backendPools, supplierCfg.ServiceId, serviceConfig)
+			if err := pool.checkBackendsHealth(ctx, server.backendHealthCheck(supplierCfg.ServiceId)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// backendHealthCheck returns the health check of the backends of the given
+// service, which waits for their gRPC connection to be ready.
+func (server *relayMinerGRPCServer) backendHealthCheck(serviceId string) backendHealthCheckFn {
+	// Default timeout for connecting to a backend.
+	const grpcPingTimeout = 2 * time.Second
+
+	return func(ctx context.Context, backendUrl *url.URL) error {
+		backendConn, err := server.getBackendConn(backendUrl)
+		if err != nil {
+			return fmt.Errorf(
+				"❌ Error pinging backend %q for serviceId %q: %w",
+				backendUrl.String(), serviceId, err,
+			)
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, grpcPingTimeout)
+		defer cancel()
+		if err = waitForBackendConnReady(pingCtx, backendConn); err != nil {
+			return fmt.Errorf(
+				"❌ Error pinging backend %q for serviceId %q: %w",
+				backendUrl.String(), serviceId, err,
+			)
+		}
+
+		return nil
+	}
+}
+
+// getBackendConn returns the gRPC client connection to the given backend URL,
+// creating it if it does not exist yet.
+// "grpcs" backends are dialed using TLS while "grpc" ones are dialed in plaintext.
+func (server *relayMinerGRPCServer) getBackendConn(backendUrl *url.URL) (*grpc.ClientConn, error) {
+	server.backendConnsMu.Lock()
+	defer server.backendConnsMu.Unlock()
+
+	if backendConn, ok := server.backendConns[backendUrl.String()]; ok {
+		return backendConn, nil
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if backendUrl.Scheme == "grpcs" {
+		transportCredentials = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}
+	if maxBodySize := int(server.serverConfig.MaxBodySize); maxBodySize > 0 {
+		dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxBodySize),
+			grpc.MaxCallSendMsgSize(maxBodySize),
+		))
+	}
+
+	backendConn, err := grpc.NewClient(backendUrl.Host, dialOptions...)
+	if err != nil {
+		return nil, ErrRelayerProxyInternalError.Wrapf(
+			"failed to create gRPC client for backend %q: %v",
+			backendUrl.String(), err,
+		)
+	}
+
+	server.backendConns[backendUrl.String()] = backendConn
+
+	return backendConn, nil
+}
+
+// waitForBackendConnReady triggers the connection of the given (lazy) gRPC client
+// and blocks until it is ready or the context is done.
+func waitForBackendConnReady(ctx context.Context, backendConn *grpc.ClientConn) error {
+	backendConn.Connect()
+
+	for {
+		state := backendConn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+
+		if !backendConn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("backend connection not ready (last state: %s): %w", state, ctx.Err())
+		}
+	}
+}
+
+// grpcRawFrameCodec is a gRPC codec passing the (already serialized) frames
+// through as-is. It overrides the default "proto" codec so that any gRPC
+// service can be relayed without its protobuf definitions.
+type grpcRawFrameCodec struct{}
+
+// Marshal implements encoding.Codec.
+func (grpcRawFrameCodec) Marshal(v any) ([]byte, error) {
+	frame, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected gRPC frame type %T", v)
+	}
+
+	return *frame, nil
+}
+
+// Unmarshal implements encoding.Codec.
+func (grpcRawFrameCodec) Unmarshal(data []byte, v any) error {
+	frame, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("unexpected gRPC frame type %T", v)
+	}
+
+	*frame = data
+
+	return nil
+}
+
+// Name implements encoding.Codec.
+// It is named "proto" to be selected for the default gRPC content-subtype.
+func (grpcRawFrameCodec) Name() string {
+	return "proto"
+}
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"net/http"
 	"strconv"
@@ -59,7 +60,7 @@ type relayMinerHTTPServer struct {
 	// the relay requests and signs the relay responses.
 	relayAuthenticator relayer.RelayAuthenticator
 
-	// servedRewardableRelaysProducer is a channel that emits the relays thatL
+	// servedRewardableRelaysProducer is the publisher that emits the relays that
 	// 	1. Have been successfully served
 	// 	2. Are reward-applicable (i.e. should be inserted into the SMT)
 	// Some examples of relays that shouldn't be emitted to this channel:
@@ -67,7 +68,7 @@ type relayMinerHTTPServer struct {
 	// 	- Relays that are not reward-applicable
 	// 	- Relays that are over-serviced
 	// The servedRewardableRelaysProducer observable to fan-out notifications to its subscribers.
-	servedRewardableRelaysProducer chan<- *types.Relay
+	servedRewardableRelaysProducer *relayer.ServedRelaysPublisher
 
 	// relayMeter is the relay meter that the RelayServer uses to meter the relays and claim the relay price.
 	// It is used to ensure that the relays are metered and priced correctly.
@@ -97,17 +98,37 @@ type relayMinerHTTPServer struct {
 	// HTTP client used for communication with backend server(s).
 	// Customized for high throughput.
 	httpClient *poktrollhttp.HTTPClientWithDebugMetrics
+
+	// certReloader provides the (hot reloadable) TLS material when the server
+	// is of type "https". It is nil for plain "http" servers.
+	certReloader *certificateReloader
+
+	// backendPools is a map of service config -> pool of its backends, which
+	// spreads the relays across the backends and fails over between them.
+	backendPools map[*config.RelayMinerSupplierServiceConfig]*backendPool
+
+	// rateLimiters is a map of service ID -> rate limiter of the applications
+	// and gateways sending relays to the service.
+	// Services that are not rate limited are absent from the map.
+	rateLimiters map[string]*serviceRateLimiter
+
+	// responseCaches is a map of service config -> cache of the responses of
+	// its cacheable JSON-RPC requests.
+	// Service configs without response caching are absent from the map.
+	responseCaches map[*config.RelayMinerSupplierServiceConfig]*relayResponseCache
 }
 
 // NewHTTPServer creates a new RelayServer that listens for incoming relay requests
 // and forwards them to the corresponding proxied service endpoint.
+// If the server config has a TLS section (i.e. "https" server type), the server
+// terminates TLS itself using the configured (hot reloadable) certificate.
 // TODO_RESEARCH(#590): Currently, the communication between the Gateway and the
 // RelayMiner uses HTTP. This could be changed to a more generic and performant
 // one, such as QUIC or pure TCP.
 func NewHTTPServer(
 	logger polylog.Logger,
 	serverConfig *config.RelayMinerServerConfig,
-	servedRelaysProducer chan<- *types.Relay,
+	servedRelaysProducer *relayer.ServedRelaysPublisher,
 	relayAuthenticator relayer.RelayAuthenticator,
 	relayMeter relayer.RelayMeter,
 	blockClient client.BlockClient,
@@ -136,6 +157,11 @@ func NewHTTPServer(
 	// Initialize separate HTTP clients for handling all backend server calls
 	httpClient := poktrollhttp.NewDefaultHTTPClientWithDebugMetrics()
 
+	var certReloader *certificateReloader
+	if serverConfig.TLS != nil {
+		certReloader = newCertificateReloader(logger, serverConfig.TLS)
+	}
+
 	return &relayMinerHTTPServer{
 		logger:                             logger,
 		server:                             httpServer,
@@ -150,6 +176,10 @@ func NewHTTPServer(
 		knownSessionsMutex:                 &sync.RWMutex{},
 		eagerRelayRequestValidationEnabled: serverConfig.EnableEagerRelayRequestValidation,
 		httpClient:                         httpClient,
+		certReloader:                       certReloader,
+		backendPools:                       newServerBackendPools(logger, serverConfig),
+		rateLimiters:                       newServerRateLimiters(logger, serverConfig),
+		responseCaches:                     newServerResponseCaches(logger, serverConfig),
 	}
 }
 
@@ -166,6 +196,11 @@ func (server *relayMinerHTTPServer) Start(ctx context.Context) error {
 	committedBlocksSequence := server.blockClient.CommittedBlocksSequence(ctx)
 	channel.ForEach(ctx, committedBlocksSequence, server.pruneOutdatedKnownSessions)
 
+	// Periodically health check the backends to fail over from the unhealthy ones.
+	for _, pool := range server.backendPools {
+		pool.startHealthChecks(ctx, server.backendHealthCheck(pool.serviceId))
+	}
+
 	// Set the HTTP handler.
 	server.server.Handler = server
 
@@ -175,6 +210,18 @@ func (server *relayMinerHTTPServer) Start(ctx context.Context) error {
 		return err
 	}
 
+	// Terminate TLS on the listener for "https" servers.
+	if server.certReloader != nil {
+		tlsConfig, tlsErr := server.certReloader.ServerTLSConfig()
+		if tlsErr != nil {
+			_ = listener.Close()
+			server.logger.Error().Err(tlsErr).Msg("failed to load TLS material")
+			return tlsErr
+		}
+
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
 	return server.server.Serve(listener)
 }
 
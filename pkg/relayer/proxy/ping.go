@@ -8,33 +8,53 @@ import (
 	"time"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
 )
 
 // Ping tries to dial the suppliers backend URLs to test the connection.
+// For "https" servers, it also ensures the TLS material is (still) loadable.
 func (server *relayMinerHTTPServer) Ping(ctx context.Context) error {
+	if server.certReloader != nil {
+		if err := server.certReloader.load(); err != nil {
+			return fmt.Errorf(
+				"❌ Error loading TLS material for server %q: %w",
+				server.serverConfig.ListenAddress, err,
+			)
+		}
+	}
+
 	for _, supplierCfg := range server.serverConfig.SupplierConfigsMap {
-		// Initialize the backend URLs to test with the default service config.
-		backendUrls := []*url.URL{
-			supplierCfg.ServiceConfig.BackendUrl,
+		// Initialize the service configs to test with the default service config.
+		serviceConfigs := []*config.RelayMinerSupplierServiceConfig{
+			supplierCfg.ServiceConfig,
 		}
 
-		// Add the RPC type specific service configs to the backend URLs to test, if any.
-		for _, rpcTypeBackendURL := range supplierCfg.RPCTypeServiceConfigs {
-			backendUrls = append(backendUrls, rpcTypeBackendURL.BackendUrl)
+		// Add the RPC type specific service configs to test, if any.
+		for _, rpcTypeServiceConfig := range supplierCfg.RPCTypeServiceConfigs {
+			serviceConfigs = append(serviceConfigs, rpcTypeServiceConfig)
 		}
 
-		// Test the connectivity of all the backend URLs for the supplier.
-		for _, backendUrl := range backendUrls {
-			if err := server.pingBackendURL(backendUrl, supplierCfg.ServiceId); err != nil {
+		// Test the connectivity of the backends of all the service configs for the supplier.
+		// A service config is reachable as long as one of its backends is.
+		for _, serviceConfig := range serviceConfigs {
+			pool := getBackendPool(server.logger, server.backendPools, supplierCfg.ServiceId, serviceConfig)
+			if err := pool.checkBackendsHealth(ctx, server.backendHealthCheck(supplierCfg.ServiceId)); err != nil {
 				return err
 			}
 		}
-
 	}
 
 	return nil
 }
 
+// backendHealthCheck returns the health check of the backends of the given
+// service, which pings them over HTTP.
+func (server *relayMinerHTTPServer) backendHealthCheck(serviceId string) backendHealthCheckFn {
+	return func(_ context.Context, backendUrl *url.URL) error {
+		return server.pingBackendURL(backendUrl, serviceId)
+	}
+}
+
 // pingBackendURL tests the connectivity of a backend URL for a given service ID.
 func (server *relayMinerHTTPServer) pingBackendURL(backendUrl *url.URL, serviceId string) error {
 	// Default client timeout for pinging the backend URL.
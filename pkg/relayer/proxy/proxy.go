@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"cosmossdk.io/depinject"
 	"golang.org/x/sync/errgroup"
@@ -58,6 +59,10 @@ type relayerProxy struct {
 	// It verifies the relay request signature and session validity, and signs relay responses.
 	relayAuthenticator relayer.RelayAuthenticator
 
+	// serversMu protects servers, serverConfigs and serverCancelFns which are
+	// updated when the RelayMiner config is reloaded.
+	serversMu sync.Mutex
+
 	// servers is a map of listenAddress -> RelayServer provided by the relayer proxy,
 	// where listenAddress is the address of the server defined in the config file and
 	// RelayServer is the server that listens for incoming relay requests.
@@ -68,19 +73,30 @@ type relayerProxy struct {
 	// is its configuration.
 	serverConfigs map[string]*config.RelayMinerServerConfig
 
+	// serverCancelFns is a map of listenAddress -> cancel function of the context
+	// the corresponding running RelayServer was started with.
+	serverCancelFns map[string]context.CancelFunc
+
+	// startGroup and startCtx are the errgroup running the relay servers and its
+	// context. They are set by Start so that the relay servers added by a config
+	// reload run (and fail) alongside the initial ones.
+	startGroup *errgroup.Group
+	startCtx   context.Context
+
 	// servedRelays is an observable that notifies the miner about the relays that have been served.
 	servedRelays relayer.RelaysObservable
 
-	// servedRelaysPublishCh is a channel that emits the relays that have been served so that the
+	// servedRelaysPublisher emits the relays that have been served so that the
 	// servedRelays observable can fan out the notifications to its subscribers.
-	servedRelaysPublishCh chan<- *types.Relay
+	// It is closed on shutdown to let the mining pipeline drain.
+	servedRelaysPublisher *relayer.ServedRelaysPublisher
 
 	// pingEnabled indicates whether the relay servers should be pinged before starting them.
 	// This is useful to ensure that the backend nodes are reachable before starting the servers.
 	pingEnabled bool
 
-	// servedRelaysBufferSize is the buffer size of servedRelaysPublishCh, the channel
-	// that forwards served relays into the mining pipeline. When full, relays are
+	// servedRelaysBufferSize is the buffer size of servedRelaysPublisher's channel,
+	// which forwards served relays into the mining pipeline. When full, relays are
 	// dropped (served but unpaid). A value <= 0 uses the observable's default buffer.
 	servedRelaysBufferSize int
 }
@@ -140,7 +156,7 @@ func NewRelayerProxy(
 	}
 
 	rp.servedRelays = servedRelays
-	rp.servedRelaysPublishCh = servedRelaysProducer
+	rp.servedRelaysPublisher = relayer.NewServedRelaysPublisher(servedRelaysProducer)
 
 	if err := rp.validateConfig(); err != nil {
 		return nil, err
@@ -153,11 +169,14 @@ func NewRelayerProxy(
 // if any of them errors.
 // NB: This method IS BLOCKING until all RelayServers are stopped.
 func (rp *relayerProxy) Start(ctx context.Context) error {
+	rp.serversMu.Lock()
+
 	// The provided services map is built from the supplier's onchain advertised information,
 	// which is a runtime parameter that can be changed by the supplier.
 	// NOTE: We build the provided services map at Start instead of NewRelayerProxy to avoid having to
 	// return an error from the constructor.
 	if err := rp.BuildProvidedServices(ctx); err != nil {
+		rp.serversMu.Unlock()
 		return err
 	}
 
@@ -165,14 +184,23 @@ func (rp *relayerProxy) Start(ctx context.Context) error {
 	// This function is non-blocking and the subscription cancellation is handled
 	// by the context passed to the Start method.
 	if err := rp.relayMeter.Start(ctx); err != nil {
+		rp.serversMu.Unlock()
 		return err
 	}
 
 	startGroup, ctx := errgroup.WithContext(ctx)
-
-	for _, relayServer := range rp.servers {
-		server := relayServer // create a new variable scoped to the anonymous function
-
+	rp.startGroup = startGroup
+	rp.startCtx = ctx
+	rp.serverCancelFns = make(map[string]context.CancelFunc, len(rp.servers))
+
+	// Keep the group running until its context is done, even if a config reload
+	// momentarily stops all the relay servers.
+	startGroup.Go(func() error {
+		<-ctx.Done()
+		return nil
+	})
+
+	for listenAddress, server := range rp.servers {
 		// Only test the connectivity of the backing data nodes if pingEnabled is true.
 		if rp.pingEnabled {
 			// Ensure that each backing data node responds to a ping request
@@ -180,19 +208,47 @@ func (rp *relayerProxy) Start(ctx context.Context) error {
 			if err := server.Ping(ctx); err != nil {
 				rp.logger.Error().Err(err).
 					Msg("failed to ping backend service before starting relay server")
+				rp.serversMu.Unlock()
 				return err
 			}
 		}
 
-		startGroup.Go(func() error { return server.Start(ctx) })
+		rp.goStartRelayServer(listenAddress, server)
 	}
+	rp.serversMu.Unlock()
 
 	return startGroup.Wait()
 }
 
+// goStartRelayServer starts the given relay server in the relayer proxy's start
+// group, with a context which is canceled when the server is stopped by a
+// config reload.
+// It MUST be called while holding serversMu.
+func (rp *relayerProxy) goStartRelayServer(listenAddress string, server relayer.RelayServer) {
+	serverCtx, cancelServerCtx := context.WithCancel(rp.startCtx)
+	rp.serverCancelFns[listenAddress] = cancelServerCtx
+
+	rp.startGroup.Go(func() error {
+		defer cancelServerCtx()
+
+		err := server.Start(serverCtx)
+
+		// A server stopped by a config reload is not a relayer proxy failure and
+		// must not stop the other servers.
+		if serverCtx.Err() != nil && rp.startCtx.Err() == nil {
+			return nil
+		}
+
+		return err
+	})
+}
+
 // Stop concurrently stops all advertised relay servers and returns an error if any of them fails.
 // This method is blocking until all RelayServers are stopped.
 func (rp *relayerProxy) Stop(ctx context.Context) error {
+	rp.serversMu.Lock()
+	defer rp.serversMu.Unlock()
+
 	stopGroup, ctx := errgroup.WithContext(ctx)
 
 	for _, relayServer := range rp.servers {
@@ -211,6 +267,25 @@ func (rp *relayerProxy) ServedRelays() relayer.RelaysObservable {
 	return rp.servedRelays
 }
 
+// CloseServedRelays stops forwarding served relays to the miner and closes the
+// ServedRelays observable once the relays already forwarded are delivered.
+// The relays served afterwards (e.g. by websocket bridges) are dropped.
+func (rp *relayerProxy) CloseServedRelays() {
+	rp.servedRelaysPublisher.Close()
+}
+
+// NumForwardedServedRelays returns the number of served relays forwarded to the
+// mining pipeline since the relayer proxy was created.
+func (rp *relayerProxy) NumForwardedServedRelays() uint64 {
+	return rp.servedRelaysPublisher.NumPublishedRelays()
+}
+
+// NumDroppedServedRelays returns the number of served relays dropped from the
+// mining pipeline, because it was full or closed, since the relayer proxy was created.
+func (rp *relayerProxy) NumDroppedServedRelays() uint64 {
+	return rp.servedRelaysPublisher.NumDroppedRelays()
+}
+
 // validateConfig validates the relayer proxy's configuration options and returns an error if it is invalid.
 // TODO_TEST: Add tests for validating these configurations.
 func (rp *relayerProxy) validateConfig() error {
@@ -223,6 +298,9 @@ func (rp *relayerProxy) validateConfig() error {
 
 // PingAll tests the connectivity between all the managed relay servers and their respective backend URLs.
 func (rp *relayerProxy) PingAll(ctx context.Context) error {
+	rp.serversMu.Lock()
+	defer rp.serversMu.Unlock()
+
 	var err error
 
 	for _, srv := range rp.servers {
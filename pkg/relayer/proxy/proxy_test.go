@@ -8,7 +8,9 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -16,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/docker/go-units"
 	"github.com/foxcpp/go-mockdns"
+	"github.com/gorilla/websocket"
 	sdktypes "github.com/pokt-network/shannon-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -23,6 +26,7 @@ import (
 	"github.com/pokt-network/poktroll/pkg/relayer/config"
 	"github.com/pokt-network/poktroll/pkg/relayer/proxy"
 	"github.com/pokt-network/poktroll/testutil/testproxy"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
@@ -557,6 +561,182 @@ func TestRelayerProxy_Relays(t *testing.T) {
 	}
 }
 
+// TestRelayerProxy_ForgedRelaysDoNotConsumeRateLimits asserts that relay requests
+// failing the signature verification are not charged against the rate limits of
+// the application they claim to be from.
+func TestRelayerProxy_ForgedRelaysDoNotConsumeRateLimits(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	// Rate limit the default service to a single relay per application.
+	rateLimitedServicesConfigMap := make(map[string]*config.RelayMinerServerConfig)
+	for listenAddress, serverConfig := range servicesConfigMap {
+		newServerConfig := *serverConfig
+		newServerConfig.SupplierConfigsMap = make(map[string]*config.RelayMinerSupplierConfig)
+		for serviceId, supplierConfig := range serverConfig.SupplierConfigsMap {
+			newSupplierConfig := *supplierConfig
+			if serviceId == defaultService {
+				newSupplierConfig.RateLimiting = &config.RelayMinerRateLimitingConfig{
+					PerApplication: config.RelayMinerRateLimit{RequestsPerSecond: 1, Burst: 1},
+				}
+			}
+			newServerConfig.SupplierConfigsMap[serviceId] = &newSupplierConfig
+		}
+		rateLimitedServicesConfigMap[listenAddress] = &newServerConfig
+	}
+
+	relayerProxyBehavior := []func(*testproxy.TestBehavior){
+		testproxy.WithRelayerProxyDependenciesForBlockHeight(supplierOperatorKeyName, blockHeight),
+		testproxy.WithServicesConfigMap(rateLimitedServicesConfigMap),
+		testproxy.WithDefaultSupplier(supplierOperatorKeyName, supplierEndpoints),
+		testproxy.WithDefaultApplication(appPrivateKey),
+		testproxy.WithDefaultSessionSupplier(supplierOperatorKeyName, defaultService, appPrivateKey),
+	}
+
+	signingKeyNames := []string{supplierOperatorKeyName}
+	testBehavior := testproxy.NewRelayerProxyTestBehavior(ctx, t, signingKeyNames, relayerProxyBehavior...)
+
+	rp, err := proxy.NewRelayerProxy(
+		testBehavior.Deps,
+		proxy.WithServicesConfigMap(rateLimitedServicesConfigMap),
+	)
+	require.NoError(t, err)
+
+	go rp.Start(ctx)
+	// Block so relayerProxy has sufficient time to start
+	time.Sleep(100 * time.Millisecond)
+
+	// Relay requests forged on behalf of the application are rejected by the
+	// signature verification, without consuming the application's rate limit.
+	for range 3 {
+		errCode, errMsg := sendRequestWithRingSignatureMismatch(t, testBehavior)
+		require.Equal(t, int32(testproxy.JSONRPCInternalErrorCode), errCode)
+		require.Contains(t, errMsg, "ring signature in the relay request does not match")
+	}
+
+	// The application's own relay request is still admitted.
+	errCode, errMsg := sendRequestWithSuccessfulReply(t, testBehavior)
+	require.Equal(t, int32(0), errCode, errMsg)
+
+	// The application's next relay request exceeds its rate limit.
+	errCode, errMsg = sendRequestWithSuccessfulReply(t, testBehavior)
+	require.Equal(t, int32(testproxy.JSONRPCInternalErrorCode), errCode)
+	require.Contains(t, errMsg, proxy.ErrRelayerProxyRelayRateLimitExceeded.Error())
+}
+
+// TestRelayerProxy_ForgedWebsocketRelaysDoNotConsumeRateLimits asserts that
+// websocket connections whose relay requests fail the signature verification
+// are not charged against the rate limits of the application they claim to be
+// from.
+func TestRelayerProxy_ForgedWebsocketRelaysDoNotConsumeRateLimits(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	// Echo the messages received by the websocket service backend.
+	upgrader := websocket.Upgrader{}
+	websocketBackend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(messageType, data); err != nil {
+				return
+			}
+		}
+	}))
+	defer websocketBackend.Close()
+	websocketBackendUrl, err := url.Parse(websocketBackend.URL)
+	require.NoError(t, err)
+
+	// Rate limit the default service, also served over websockets, to a single
+	// relay per application.
+	rateLimitedServicesConfigMap := make(map[string]*config.RelayMinerServerConfig)
+	for listenAddress, serverConfig := range servicesConfigMap {
+		newServerConfig := *serverConfig
+		newServerConfig.SupplierConfigsMap = make(map[string]*config.RelayMinerSupplierConfig)
+		for serviceId, supplierConfig := range serverConfig.SupplierConfigsMap {
+			newSupplierConfig := *supplierConfig
+			if serviceId == defaultService {
+				newSupplierConfig.RateLimiting = &config.RelayMinerRateLimitingConfig{
+					PerApplication: config.RelayMinerRateLimit{RequestsPerSecond: 1, Burst: 1},
+				}
+				newSupplierConfig.RPCTypeServiceConfigs = map[sharedtypes.RPCType]*config.RelayMinerSupplierServiceConfig{
+					sharedtypes.RPCType_WEBSOCKET: {
+						BackendUrl: &url.URL{Scheme: "ws", Host: websocketBackendUrl.Host},
+					},
+				}
+			}
+			newServerConfig.SupplierConfigsMap[serviceId] = &newSupplierConfig
+		}
+		rateLimitedServicesConfigMap[listenAddress] = &newServerConfig
+	}
+
+	relayerProxyBehavior := []func(*testproxy.TestBehavior){
+		testproxy.WithRelayerProxyDependenciesForBlockHeight(supplierOperatorKeyName, blockHeight),
+		testproxy.WithServicesConfigMap(rateLimitedServicesConfigMap),
+		testproxy.WithDefaultSupplier(supplierOperatorKeyName, supplierEndpoints),
+		testproxy.WithDefaultApplication(appPrivateKey),
+		testproxy.WithDefaultSessionSupplier(supplierOperatorKeyName, defaultService, appPrivateKey),
+	}
+
+	signingKeyNames := []string{supplierOperatorKeyName}
+	testBehavior := testproxy.NewRelayerProxyTestBehavior(ctx, t, signingKeyNames, relayerProxyBehavior...)
+
+	rp, err := proxy.NewRelayerProxy(
+		testBehavior.Deps,
+		proxy.WithServicesConfigMap(rateLimitedServicesConfigMap),
+	)
+	require.NoError(t, err)
+
+	go rp.Start(ctx)
+	// Block so relayerProxy has sufficient time to start
+	time.Sleep(100 * time.Millisecond)
+
+	// Websocket connections opened on behalf of the application are closed once
+	// their forged relay request is rejected by the signature verification,
+	// without consuming the application's rate limit.
+	for range 3 {
+		req := testproxy.GenerateRelayRequest(
+			testBehavior,
+			appPrivateKey,
+			defaultService,
+			blockHeight,
+			supplierOperatorKeyName,
+			testproxy.PrepareJSONRPCRequest(t),
+		)
+		req.Meta.Signature = testproxy.GetApplicationRingSignature(t, req, secp256k1.GenPrivKey())
+
+		_, err = sendWebsocketRelayRequest(t, req)
+		require.Error(t, err)
+	}
+
+	// The application's own websocket connection is still admitted.
+	req := testproxy.GenerateRelayRequest(
+		testBehavior,
+		appPrivateKey,
+		defaultService,
+		blockHeight,
+		supplierOperatorKeyName,
+		testproxy.PrepareJSONRPCRequest(t),
+	)
+	req.Meta.Signature = testproxy.GetApplicationRingSignature(t, req, appPrivateKey)
+
+	relayResponse, err := sendWebsocketRelayRequest(t, req)
+	require.NoError(t, err)
+	require.Equal(t, req.Payload, relayResponse.Payload)
+
+	// The application's next websocket connection exceeds its rate limit.
+	_, err = sendWebsocketRelayRequest(t, req)
+	require.Error(t, err)
+}
+
 // RelayProxyPingAllSuite implements the suite to test the relay proxy ping
 // application logic.
 type RelayProxyPingAllSuite struct {
@@ -1209,6 +1389,39 @@ func sendRequestWithSuccessfulReply(
 	return testproxy.MarshalAndSend(test, servicesConfigMap, defaultRelayMinerServer, defaultService, req)
 }
 
+// sendWebsocketRelayRequest opens a websocket connection to the RelayMiner on
+// behalf of the relay request's application, sends the relay request over it
+// and returns the relay response, or the error of the connection if it is
+// closed instead.
+func sendWebsocketRelayRequest(
+	t *testing.T,
+	relayRequest *servicetypes.RelayRequest,
+) (*servicetypes.RelayResponse, error) {
+	header := http.Header{}
+	header.Set(proxy.RPCTypeHeader, strconv.Itoa(int(sharedtypes.RPCType_WEBSOCKET)))
+	header.Set("Target-Service-Id", relayRequest.Meta.SessionHeader.ServiceId)
+	header.Set("App-Address", relayRequest.Meta.SessionHeader.ApplicationAddress)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+defaultRelayMinerServer, header)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	relayRequestBz, err := relayRequest.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteMessage(websocket.BinaryMessage, relayRequestBz))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	_, relayResponseBz, err := conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	relayResponse := &servicetypes.RelayResponse{}
+	require.NoError(t, relayResponse.Unmarshal(relayResponseBz))
+
+	return relayResponse, nil
+}
+
 // sendRequestWithCustomSessionHeight is a helper function that generates a `RelayRequest`
 // with a `Session` that contains the given `requestSessionBlockHeight` and sends it to the
 // `RelayerProxy`.
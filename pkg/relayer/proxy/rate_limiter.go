@@ -0,0 +1,267 @@
+package proxy
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/pokt-network/poktroll/pkg/crypto/rings"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+const (
+	// rateLimitScopeApplication and rateLimitScopeGateway are the scopes a rate
+	// limit applies to, used as the metrics and log labels of rejected relays.
+	rateLimitScopeApplication = "application"
+	rateLimitScopeGateway     = "gateway"
+
+	// rateLimitRequestsPerSecond and rateLimitMaxConcurrentRequests are the
+	// limits a relay can be rejected by.
+	rateLimitRequestsPerSecond     = "requests_per_second"
+	rateLimitMaxConcurrentRequests = "max_concurrent_requests"
+
+	// rateLimitEntryIdleTTL is the duration after which the state of an
+	// application or gateway that has not sent any relay is discarded.
+	// It is longer than the token buckets refill time of any sensible limit.
+	rateLimitEntryIdleTTL = 10 * time.Minute
+)
+
+// rateLimitEntry is the rate limiting state of an application or a gateway.
+type rateLimitEntry struct {
+	// limiter is the token bucket of the requests per second limit.
+	// It is nil if the number of requests per second is unlimited.
+	limiter *rate.Limiter
+	// maxConcurrentRequests is 0 if the number of concurrent requests is unlimited.
+	maxConcurrentRequests uint64
+	numInflight           uint64
+	lastSeenAt            time.Time
+}
+
+// serviceRateLimiter enforces the rate limiting of a service, independently
+// for each application and for each gateway sending relays to it.
+type serviceRateLimiter struct {
+	logger    polylog.Logger
+	serviceId string
+	config    *config.RelayMinerRateLimitingConfig
+
+	mu sync.Mutex
+	// entries is keyed by scope and application address or gateway key image.
+	entries     map[string]*rateLimitEntry
+	lastSweepAt time.Time
+}
+
+// newServiceRateLimiter creates the rate limiter of the given service.
+// It returns nil if the service is not rate limited.
+func newServiceRateLimiter(
+	logger polylog.Logger,
+	serviceId string,
+	rateLimitingConfig *config.RelayMinerRateLimitingConfig,
+) *serviceRateLimiter {
+	if rateLimitingConfig == nil {
+		return nil
+	}
+
+	isRateLimited := !rateLimitingConfig.PerApplication.IsUnlimited() ||
+		!rateLimitingConfig.PerGateway.IsUnlimited()
+	for _, rateLimit := range rateLimitingConfig.ApplicationOverrides {
+		isRateLimited = isRateLimited || !rateLimit.IsUnlimited()
+	}
+	if !isRateLimited {
+		return nil
+	}
+
+	return &serviceRateLimiter{
+		logger:      logger.With("service_id", serviceId),
+		serviceId:   serviceId,
+		config:      rateLimitingConfig,
+		entries:     make(map[string]*rateLimitEntry),
+		lastSweepAt: time.Now(),
+	}
+}
+
+// newServerRateLimiters creates the rate limiters of all the services of the
+// given server, keyed by service ID. Services that are not rate limited have
+// no rate limiter.
+func newServerRateLimiters(
+	logger polylog.Logger,
+	serverConfig *config.RelayMinerServerConfig,
+) map[string]*serviceRateLimiter {
+	rateLimiters := make(map[string]*serviceRateLimiter)
+	for serviceId, supplierConfig := range serverConfig.SupplierConfigsMap {
+		if rateLimiter := newServiceRateLimiter(logger, serviceId, supplierConfig.RateLimiting); rateLimiter != nil {
+			rateLimiters[serviceId] = rateLimiter
+		}
+	}
+
+	return rateLimiters
+}
+
+// isServiceRateLimited returns whether the given service has a rate limiter.
+func isServiceRateLimited(rateLimiters map[string]*serviceRateLimiter, serviceId string) bool {
+	_, ok := rateLimiters[serviceId]
+	return ok
+}
+
+// admitRelayRequest checks the given relay request against the rate limits of
+// its service.
+// The relay request MUST be verified beforehand: the application and gateway
+// it is charged to are the ones it claims to be from.
+//
+// If the relay request is admitted, the returned release function MUST be
+// called once it is served to free its concurrent requests slot. Otherwise, an
+// ErrRelayerProxyRelayRateLimitExceeded error is returned and the relay request
+// MUST be rejected before reaching the backend.
+func admitRelayRequest(
+	rateLimiters map[string]*serviceRateLimiter,
+	relayRequest *types.RelayRequest,
+) (release func(), err error) {
+	sessionHeader := relayRequest.Meta.SessionHeader
+	rateLimiter, ok := rateLimiters[sessionHeader.ServiceId]
+	if !ok {
+		return func() {}, nil
+	}
+
+	// Only identify the gateway if it is rate limited, to avoid the overhead
+	// of parsing the ring signature otherwise.
+	gatewayKey := ""
+	if !rateLimiter.config.PerGateway.IsUnlimited() {
+		keyImage, err := rings.GetRingSignatureKeyImage(relayRequest.Meta.Signature)
+		if err != nil {
+			return nil, err
+		}
+		gatewayKey = hex.EncodeToString(keyImage)
+	}
+
+	return rateLimiter.admit(sessionHeader.ApplicationAddress, gatewayKey)
+}
+
+// admit checks a relay of the given application, sent by the given gateway,
+// against the service's rate limits. The gateway limit is skipped if
+// gatewayKey is empty.
+// See admitRelayRequest for the semantics of the returned values.
+func (limiter *serviceRateLimiter) admit(appAddress, gatewayKey string) (release func(), err error) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := time.Now()
+	limiter.sweepIdleEntries(now)
+
+	entries := []*rateLimitEntry{
+		limiter.getEntry(rateLimitScopeApplication, appAddress, limiter.config.ApplicationRateLimit(appAddress), now),
+	}
+	scopes := []string{rateLimitScopeApplication}
+	if gatewayKey != "" {
+		entries = append(entries,
+			limiter.getEntry(rateLimitScopeGateway, gatewayKey, limiter.config.PerGateway, now),
+		)
+		scopes = append(scopes, rateLimitScopeGateway)
+	}
+
+	// Check all the concurrency limits before consuming any token, so that a
+	// rejected relay does not count against the limits it did not exceed.
+	for i, entry := range entries {
+		if entry.maxConcurrentRequests > 0 && entry.numInflight >= entry.maxConcurrentRequests {
+			return nil, limiter.reject(scopes[i], rateLimitMaxConcurrentRequests, appAddress, entry.maxConcurrentRequests)
+		}
+	}
+
+	reservations := make([]*rate.Reservation, 0, len(entries))
+	for i, entry := range entries {
+		if entry.limiter == nil {
+			continue
+		}
+
+		reservation := entry.limiter.ReserveN(now, 1)
+		if !reservation.OK() || reservation.DelayFrom(now) > 0 {
+			reservation.CancelAt(now)
+			for _, previousReservation := range reservations {
+				previousReservation.CancelAt(now)
+			}
+			return nil, limiter.reject(scopes[i], rateLimitRequestsPerSecond, appAddress, uint64(entry.limiter.Limit()))
+		}
+		reservations = append(reservations, reservation)
+	}
+
+	for _, entry := range entries {
+		entry.numInflight++
+	}
+
+	var releaseOnce sync.Once
+	return func() {
+		releaseOnce.Do(func() {
+			limiter.mu.Lock()
+			defer limiter.mu.Unlock()
+
+			releasedAt := time.Now()
+			for _, entry := range entries {
+				entry.numInflight--
+				entry.lastSeenAt = releasedAt
+			}
+		})
+	}, nil
+}
+
+// getEntry returns the rate limiting state of the given application or gateway,
+// creating it with the given rate limit if it does not exist.
+// It MUST be called with the limiter's mutex held.
+func (limiter *serviceRateLimiter) getEntry(
+	scope, key string,
+	rateLimit config.RelayMinerRateLimit,
+	now time.Time,
+) *rateLimitEntry {
+	entryKey := scope + "/" + key
+	entry, ok := limiter.entries[entryKey]
+	if !ok {
+		entry = &rateLimitEntry{maxConcurrentRequests: rateLimit.MaxConcurrentRequests}
+		if rateLimit.RequestsPerSecond > 0 {
+			entry.limiter = rate.NewLimiter(rate.Limit(rateLimit.RequestsPerSecond), int(rateLimit.Burst))
+		}
+		limiter.entries[entryKey] = entry
+	}
+	entry.lastSeenAt = now
+
+	return entry
+}
+
+// sweepIdleEntries discards the state of the applications and gateways that
+// have no relay in flight and have not sent any relay for rateLimitEntryIdleTTL.
+// The sweep runs at most once per rateLimitEntryIdleTTL to keep admissions cheap.
+// It MUST be called with the limiter's mutex held.
+func (limiter *serviceRateLimiter) sweepIdleEntries(now time.Time) {
+	if now.Sub(limiter.lastSweepAt) < rateLimitEntryIdleTTL {
+		return
+	}
+	limiter.lastSweepAt = now
+
+	for entryKey, entry := range limiter.entries {
+		if entry.numInflight == 0 && now.Sub(entry.lastSeenAt) >= rateLimitEntryIdleTTL {
+			delete(limiter.entries, entryKey)
+		}
+	}
+}
+
+// reject records a relay of the given application rejected by the given limit
+// of the given scope and returns the corresponding error.
+func (limiter *serviceRateLimiter) reject(scope, limit, appAddress string, limitValue uint64) error {
+	relayer.CaptureRelayRateLimited(limiter.serviceId, scope, limit)
+	limiter.logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).Msgf(
+		"🚦 Rejecting relay of application %q: %s %s limit (%d) exceeded",
+		appAddress,
+		scope,
+		limit,
+		limitValue,
+	)
+
+	return ErrRelayerProxyRelayRateLimitExceeded.Wrapf(
+		"%s %s limit (%d) exceeded for service %q",
+		scope,
+		limit,
+		limitValue,
+		limiter.serviceId,
+	)
+}
@@ -0,0 +1,174 @@
+package proxy
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	ring_secp256k1 "github.com/pokt-network/go-dleq/secp256k1"
+	ringtypes "github.com/pokt-network/go-dleq/types"
+	"github.com/pokt-network/ring-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+func TestServiceRateLimiter_RequestsPerSecond(t *testing.T) {
+	limiter := newServiceRateLimiter(polyzero.NewLogger(), "svc1", &config.RelayMinerRateLimitingConfig{
+		PerApplication: config.RelayMinerRateLimit{RequestsPerSecond: 1, Burst: 2},
+		ApplicationOverrides: map[string]config.RelayMinerRateLimit{
+			"app_unlimited": {},
+		},
+	})
+	require.NotNil(t, limiter)
+
+	// The burst is admitted, the next relays are rejected until tokens refill.
+	requireAdmitted(t, limiter, "app1", "")
+	requireAdmitted(t, limiter, "app1", "")
+	requireRateLimited(t, limiter, "app1", "")
+
+	// Applications are limited independently.
+	requireAdmitted(t, limiter, "app2", "")
+
+	// Overridden applications use their own limits.
+	for range 10 {
+		requireAdmitted(t, limiter, "app_unlimited", "")
+	}
+
+	time.Sleep(time.Second)
+	requireAdmitted(t, limiter, "app1", "")
+}
+
+func TestServiceRateLimiter_MaxConcurrentRequests(t *testing.T) {
+	limiter := newServiceRateLimiter(polyzero.NewLogger(), "svc1", &config.RelayMinerRateLimitingConfig{
+		PerGateway: config.RelayMinerRateLimit{MaxConcurrentRequests: 2},
+	})
+	require.NotNil(t, limiter)
+
+	release1 := requireAdmitted(t, limiter, "app1", "gateway1")
+	release2 := requireAdmitted(t, limiter, "app2", "gateway1")
+	requireRateLimited(t, limiter, "app3", "gateway1")
+
+	// Gateways are limited independently.
+	requireAdmitted(t, limiter, "app1", "gateway2")
+
+	// Releasing a relay frees its slot, releasing it twice does not.
+	release1()
+	release1()
+	requireAdmitted(t, limiter, "app3", "gateway1")
+	requireRateLimited(t, limiter, "app3", "gateway1")
+
+	release2()
+	requireAdmitted(t, limiter, "app3", "gateway1")
+}
+
+func TestServiceRateLimiter_RejectionDoesNotConsumeOtherLimits(t *testing.T) {
+	limiter := newServiceRateLimiter(polyzero.NewLogger(), "svc1", &config.RelayMinerRateLimitingConfig{
+		PerApplication: config.RelayMinerRateLimit{RequestsPerSecond: 1, Burst: 1},
+		PerGateway:     config.RelayMinerRateLimit{RequestsPerSecond: 1, Burst: 1},
+	})
+	require.NotNil(t, limiter)
+
+	// Exhaust the gateway's tokens.
+	requireAdmitted(t, limiter, "app1", "gateway1")
+
+	// The relay of app2 is rejected by the gateway limit, leaving the
+	// application's token for a relay sent through another gateway.
+	requireRateLimited(t, limiter, "app2", "gateway1")
+	requireAdmitted(t, limiter, "app2", "gateway2")
+}
+
+func TestNewServiceRateLimiter_Unlimited(t *testing.T) {
+	require.Nil(t, newServiceRateLimiter(polyzero.NewLogger(), "svc1", nil))
+	require.Nil(t, newServiceRateLimiter(polyzero.NewLogger(), "svc1", &config.RelayMinerRateLimitingConfig{
+		ApplicationOverrides: map[string]config.RelayMinerRateLimit{"app1": {}},
+	}))
+}
+
+func TestAdmitRelayRequest_IdentifiesGatewaysByKeyImage(t *testing.T) {
+	rateLimiters := map[string]*serviceRateLimiter{
+		"svc1": newServiceRateLimiter(polyzero.NewLogger(), "svc1", &config.RelayMinerRateLimitingConfig{
+			PerGateway: config.RelayMinerRateLimit{MaxConcurrentRequests: 1},
+		}),
+	}
+
+	curve := ring_secp256k1.NewCurve()
+	gateway1Key, gateway2Key := curve.NewRandomScalar(), curve.NewRandomScalar()
+
+	// Relays signed by the same gateway share its limit, whatever the ring
+	// (i.e. application) and the signed message.
+	release, err := admitRelayRequest(rateLimiters, newTestSignedRelayRequest(t, gateway1Key, 0, "app1"))
+	require.NoError(t, err)
+	_, err = admitRelayRequest(rateLimiters, newTestSignedRelayRequest(t, gateway1Key, 1, "app2"))
+	require.ErrorIs(t, err, ErrRelayerProxyRelayRateLimitExceeded)
+
+	// Relays signed by another gateway are not limited by the first one's.
+	_, err = admitRelayRequest(rateLimiters, newTestSignedRelayRequest(t, gateway2Key, 0, "app1"))
+	require.NoError(t, err)
+
+	release()
+	_, err = admitRelayRequest(rateLimiters, newTestSignedRelayRequest(t, gateway1Key, 1, "app2"))
+	require.NoError(t, err)
+
+	// Relays whose signature cannot be parsed are rejected.
+	relayRequest := newTestSignedRelayRequest(t, gateway1Key, 0, "app3")
+	relayRequest.Meta.Signature = relayRequest.Meta.Signature[:10]
+	_, err = admitRelayRequest(rateLimiters, relayRequest)
+	require.Error(t, err)
+}
+
+// requireAdmitted asserts that a relay of the given application, sent by the
+// given gateway, is admitted and returns its release function.
+func requireAdmitted(t *testing.T, limiter *serviceRateLimiter, appAddress, gatewayKey string) func() {
+	t.Helper()
+
+	release, err := limiter.admit(appAddress, gatewayKey)
+	require.NoError(t, err)
+
+	return release
+}
+
+// requireRateLimited asserts that a relay of the given application, sent by
+// the given gateway, is rejected.
+func requireRateLimited(t *testing.T, limiter *serviceRateLimiter, appAddress, gatewayKey string) {
+	t.Helper()
+
+	_, err := limiter.admit(appAddress, gatewayKey)
+	require.ErrorIs(t, err, ErrRelayerProxyRelayRateLimitExceeded)
+}
+
+// newTestSignedRelayRequest returns a relay request of the given application
+// for the "svc1" service, signed with the given key at the given index of a
+// random ring.
+func newTestSignedRelayRequest(
+	t *testing.T,
+	signingKey ringtypes.Scalar,
+	signerIdx int,
+	appAddress string,
+) *types.RelayRequest {
+	t.Helper()
+
+	signingRing, err := ring.NewKeyRing(ring_secp256k1.NewCurve(), 2, signingKey, signerIdx)
+	require.NoError(t, err)
+
+	relayRequest := &types.RelayRequest{
+		Meta: types.RelayRequestMetadata{
+			SessionHeader: &sessiontypes.SessionHeader{
+				ApplicationAddress: appAddress,
+				ServiceId:          "svc1",
+			},
+		},
+		Payload: []byte(appAddress),
+	}
+
+	signature, err := signingRing.Sign(sha256.Sum256(relayRequest.Payload), signingKey)
+	require.NoError(t, err)
+
+	relayRequest.Meta.Signature, err = signature.Serialize()
+	require.NoError(t, err)
+
+	return relayRequest
+}
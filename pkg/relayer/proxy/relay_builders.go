@@ -4,7 +4,9 @@ import (
 	"encoding/base64"
 	"net/http"
 
+	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/client/block"
+	"github.com/pokt-network/poktroll/pkg/relayer"
 	"github.com/pokt-network/poktroll/x/service/types"
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 )
@@ -50,13 +52,33 @@ func (sync *relayMinerHTTPServer) newRelayResponse(
 	responseBz []byte,
 	sessionHeader *sessiontypes.SessionHeader,
 	supplierOperatorAddr string,
+) (*types.RelayResponse, error) {
+	return newSignedRelayResponse(
+		sync.blockClient,
+		sync.relayAuthenticator,
+		responseBz,
+		sessionHeader,
+		supplierOperatorAddr,
+	)
+}
+
+// newSignedRelayResponse builds a RelayResponse wrapping the given payload,
+// computes its payload hash (when supported by the chain version) and signs it
+// on behalf of the given supplier operator.
+// It is shared by all the relay server types.
+func newSignedRelayResponse(
+	blockClient client.BlockClient,
+	relayAuthenticator relayer.RelayAuthenticator,
+	responseBz []byte,
+	sessionHeader *sessiontypes.SessionHeader,
+	supplierOperatorAddr string,
 ) (*types.RelayResponse, error) {
 	relayResponse := &types.RelayResponse{
 		Meta:    types.RelayResponseMetadata{SessionHeader: sessionHeader},
 		Payload: responseBz,
 	}
 
-	chainVersion := sync.blockClient.GetChainVersion()
+	chainVersion := blockClient.GetChainVersion()
 	if block.IsChainAfterAddPayloadHashInRelayResponse(chainVersion) {
 		// Compute hash of the response payload for proof verification.
 		// This hash will be stored in the RelayResponse and used during proof validation
@@ -67,7 +89,7 @@ func (sync *relayMinerHTTPServer) newRelayResponse(
 	}
 
 	// Sign the relay response and add the signature to the relay response metadata
-	if err := sync.relayAuthenticator.SignRelayResponse(relayResponse, supplierOperatorAddr); err != nil {
+	if err := relayAuthenticator.SignRelayResponse(relayResponse, supplierOperatorAddr); err != nil {
 		return nil, ErrRelayerProxyInternalError.Wrapf("failed to sign relay response for supplier %s: %v", supplierOperatorAddr, err)
 	}
 
@@ -0,0 +1,113 @@
+package proxy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// UpdateServerConfigs applies the given relay servers configs to the running
+// relayer proxy, only touching the relay servers affected by the change:
+//   - Starts the servers whose listen address is new
+//   - Stops the servers whose listen address is no longer configured
+//   - Restarts the servers whose configuration changed
+//
+// The servers whose configuration is unchanged keep serving relays uninterrupted.
+// Stopped servers are shut down gracefully: their in-flight relays complete
+// unless the given context is done first.
+//
+// Nothing is applied if any of the new relay servers fails to be initialized
+// or, if ping is enabled, to reach its backends. Otherwise, the given configs are
+// applied even if a stopped server fails to shut down gracefully.
+func (rp *relayerProxy) UpdateServerConfigs(
+	ctx context.Context,
+	serverConfigs map[string]*config.RelayMinerServerConfig,
+) error {
+	if len(serverConfigs) == 0 {
+		return ErrRelayerServicesConfigsUndefined
+	}
+
+	rp.serversMu.Lock()
+	defer rp.serversMu.Unlock()
+
+	// The relay servers are built from the server configs at Start.
+	if rp.startGroup == nil {
+		rp.serverConfigs = serverConfigs
+		return nil
+	}
+
+	diff := config.DiffServerConfigs(rp.serverConfigs, serverConfigs)
+	if diff.IsEmpty() {
+		rp.serverConfigs = serverConfigs
+		rp.logger.Info().Msg("[CONFIG] ⚙️ Relay servers configuration unchanged")
+		return nil
+	}
+
+	// Build (and ping) the added and changed servers before touching the running
+	// ones, so that an invalid config does not interrupt the running servers.
+	newServers := make(map[string]relayer.RelayServer, len(diff.Added)+len(diff.Changed))
+	for _, listenUrl := range append(diff.Added, diff.Changed...) {
+		serverConfig := serverConfigs[listenUrl]
+		server, err := rp.newRelayServer(serverConfig)
+		if err != nil {
+			return err
+		}
+
+		if rp.pingEnabled {
+			if err = server.Ping(ctx); err != nil {
+				rp.logger.Error().Err(err).
+					Str("server_host", serverConfig.ListenAddress).
+					Msg("failed to ping backend service before starting reloaded relay server")
+				return err
+			}
+		}
+
+		newServers[serverConfig.ListenAddress] = server
+	}
+
+	// Stop the removed and changed servers, releasing their listen addresses.
+	for _, listenUrl := range append(diff.Removed, diff.Changed...) {
+		rp.stopRelayServer(ctx, rp.serverConfigs[listenUrl].ListenAddress)
+	}
+
+	for listenAddress, server := range newServers {
+		rp.servers[listenAddress] = server
+		rp.goStartRelayServer(listenAddress, server)
+	}
+	rp.serverConfigs = serverConfigs
+
+	rp.logger.Info().
+		Str("added_servers", strings.Join(diff.Added, ",")).
+		Str("removed_servers", strings.Join(diff.Removed, ",")).
+		Str("restarted_servers", strings.Join(diff.Changed, ",")).
+		Msg("[CONFIG] ⚙️ Relay servers configuration reloaded")
+
+	return nil
+}
+
+// stopRelayServer stops the running relay server listening on the given address
+// and removes it from the relayer proxy's servers.
+// A failure to stop it gracefully is logged since the server is retired anyway.
+// It MUST be called while holding serversMu.
+func (rp *relayerProxy) stopRelayServer(ctx context.Context, listenAddress string) {
+	server, ok := rp.servers[listenAddress]
+	if !ok {
+		return
+	}
+	delete(rp.servers, listenAddress)
+
+	// Cancel the server context first so that its termination is not reported
+	// as a relayer proxy failure (see goStartRelayServer).
+	if cancelServerCtx, ok := rp.serverCancelFns[listenAddress]; ok {
+		cancelServerCtx()
+		delete(rp.serverCancelFns, listenAddress)
+	}
+
+	if err := server.Stop(ctx); err != nil {
+		rp.logger.Warn().Err(err).
+			Str("server_host", listenAddress).
+			Msg("failed to gracefully stop relay server")
+	}
+}
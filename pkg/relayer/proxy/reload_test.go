@@ -0,0 +1,93 @@
+package proxy_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/pkg/relayer/proxy"
+	"github.com/pokt-network/poktroll/testutil/testproxy"
+)
+
+const reloadedRelayMinerServer = "127.0.0.1:8082"
+
+// RelayerProxy should only start and stop the relay servers affected by a
+// config reload, keeping the other ones running.
+func TestRelayerProxy_UpdateServerConfigs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	signingKeyNames := []string{supplierOperatorKeyName}
+	test := testproxy.NewRelayerProxyTestBehavior(ctx, t, signingKeyNames, defaultRelayerProxyBehavior...)
+
+	rp, err := proxy.NewRelayerProxy(
+		test.Deps,
+		proxy.WithServicesConfigMap(servicesConfigMap),
+	)
+	require.NoError(t, err)
+
+	startErrCh := make(chan error, 1)
+	go func() { startErrCh <- rp.Start(ctx) }()
+	// Block so relayerProxy has sufficient time to start
+	time.Sleep(100 * time.Millisecond)
+
+	// An empty config is rejected and keeps the servers running.
+	err = rp.UpdateServerConfigs(ctx, make(map[string]*config.RelayMinerServerConfig))
+	require.ErrorIs(t, err, proxy.ErrRelayerServicesConfigsUndefined)
+	requireServerUp(t, defaultRelayMinerServer)
+	requireServerUp(t, secondaryRelayMinerServer)
+
+	// Keep the default server, remove the secondary one and add a new one.
+	reloadedServicesConfigMap := map[string]*config.RelayMinerServerConfig{
+		defaultRelayMinerServer: servicesConfigMap[defaultRelayMinerServer],
+		reloadedRelayMinerServer: {
+			ServerType:    config.RelayMinerServerTypeHTTP,
+			ListenAddress: reloadedRelayMinerServer,
+			SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+				thirdService: {
+					ServiceId:  thirdService,
+					ServerType: config.RelayMinerServerTypeHTTP,
+					ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+						BackendUrl: &url.URL{Scheme: "http", Host: "127.0.0.1:8547", Path: "/"},
+					},
+				},
+			},
+		},
+	}
+
+	err = rp.UpdateServerConfigs(ctx, reloadedServicesConfigMap)
+	require.NoError(t, err)
+	// Block so the reloaded server has sufficient time to start
+	time.Sleep(100 * time.Millisecond)
+
+	requireServerUp(t, defaultRelayMinerServer)
+	requireServerUp(t, reloadedRelayMinerServer)
+	_, err = http.DefaultClient.Get(fmt.Sprintf("http://%s/", secondaryRelayMinerServer))
+	require.Error(t, err)
+
+	// Stopping the removed server must not stop the relayer proxy.
+	select {
+	case err = <-startErrCh:
+		t.Fatalf("relayer proxy unexpectedly stopped: %v", err)
+	default:
+	}
+
+	err = rp.Stop(ctx)
+	require.NoError(t, err)
+}
+
+// requireServerUp asserts that a relay server is listening on the given address.
+func requireServerUp(t *testing.T, listenAddress string) {
+	t.Helper()
+
+	res, err := http.DefaultClient.Get(fmt.Sprintf("http://%s/", listenAddress))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	_ = res.Body.Close()
+}
@@ -0,0 +1,503 @@
+package proxy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	sdktypes "github.com/pokt-network/shannon-sdk/types"
+
+	"github.com/pokt-network/poktroll/pkg/cache"
+	"github.com/pokt-network/poktroll/pkg/cache/memory"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+// responseCacheBlockTags are the JSON-RPC block tags whose block changes as the
+// chain progresses. Requests referencing any of them are never cached, even if
+// their method is cacheable.
+var responseCacheBlockTags = []string{"latest", "pending", "safe", "finalized"}
+
+// responseCacheBlockNumberKeys are the keys of the JSON-RPC params objects whose
+// values are block numbers (e.g. EIP-1898 block params or eth_getLogs filters).
+var responseCacheBlockNumberKeys = []string{"blockNumber", "fromBlock", "toBlock"}
+
+const (
+	// responseCacheMethodBlockNumber, responseCacheMethodGetBlockByNumber and
+	// responseCacheMethodGetBlockByHash are the JSON-RPC methods whose results
+	// reveal the chain head of the backend: the head block number or a block
+	// (which is at most the head).
+	responseCacheMethodBlockNumber      = "eth_blockNumber"
+	responseCacheMethodGetBlockByNumber = "eth_getBlockByNumber"
+	responseCacheMethodGetBlockByHash   = "eth_getBlockByHash"
+)
+
+// jsonRPCRequest is the subset of a JSON-RPC request needed to decide whether
+// its response is cacheable.
+type jsonRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// jsonRPCResponse is the subset of a JSON-RPC response needed to cache it and
+// to rebuild it for another request.
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   json.RawMessage `json:"error,omitempty"`
+}
+
+// cachedRelayResponse is a backend response cached independently of the ID of
+// the request it replied to.
+type cachedRelayResponse struct {
+	statusCode int
+	// header is the backend response header, without Content-Length which
+	// depends on the ID of the request being replied to.
+	header         http.Header
+	jsonRPCVersion string
+	result         json.RawMessage
+	// expiresAt is the time after which the response is no longer served, as
+	// the cache TTL is the longest of all the cacheable methods TTLs.
+	expiresAt time.Time
+}
+
+// Size returns the approximate size, in bytes, of the cached response.
+// It is used to bound the cumulative size of the response cache.
+func (response *cachedRelayResponse) Size() int {
+	size := len(response.jsonRPCVersion) + len(response.result)
+	for key, values := range response.header {
+		size += len(key)
+		for _, value := range values {
+			size += len(value)
+		}
+	}
+
+	return size
+}
+
+// relayResponseCache caches the responses of the cacheable JSON-RPC requests of
+// a service config, so that identical requests are served without reaching the
+// backends.
+type relayResponseCache struct {
+	serviceId string
+	config    *config.RelayMinerResponseCacheConfig
+
+	responses cache.KeyValueCache[*cachedRelayResponse]
+
+	// headBlockNumber is the highest block number the backends are known to
+	// have produced, learnt from their responses. It is 0 until known.
+	headBlockNumber atomic.Uint64
+}
+
+// cacheableRelayRequest is a relay request whose response can be served from,
+// or stored in, the response cache.
+// Requests revealing the chain head are tracked even if they are not cacheable,
+// to learn the head from their responses.
+type cacheableRelayRequest struct {
+	responseCache *relayResponseCache
+	// key identifies the request independently of its ID.
+	key string
+	// id is the ID of the request, which the cached responses are rebuilt with.
+	id     json.RawMessage
+	method string
+	ttl    time.Duration
+	// isCacheable is false for the requests only tracked to learn the chain head.
+	isCacheable bool
+	// blockNumber is the highest block number referenced by the request params,
+	// if hasBlockNumber. The response is only cached once the block is final.
+	blockNumber    uint64
+	hasBlockNumber bool
+}
+
+// newRelayResponseCache creates the response cache of a service config.
+func newRelayResponseCache(
+	serviceId string,
+	responseCacheConfig *config.RelayMinerResponseCacheConfig,
+) (*relayResponseCache, error) {
+	var maxTTL time.Duration
+	for _, ttl := range responseCacheConfig.MethodTTLs {
+		maxTTL = max(maxTTL, ttl)
+	}
+
+	responses, err := memory.NewKeyValueCache[*cachedRelayResponse](
+		memory.WithMaxValueSize(responseCacheConfig.MaxSize),
+		memory.WithEvictionPolicy(memory.LeastRecentlyUsed),
+		memory.WithTTL(maxTTL),
+		memory.WithName("relay_responses:"+serviceId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &relayResponseCache{
+		serviceId: serviceId,
+		config:    responseCacheConfig,
+		responses: responses,
+	}, nil
+}
+
+// newServerResponseCaches creates the response caches of all the service configs
+// of the given server. Service configs without a response_cache section, or
+// whose response cache cannot be created, have no response cache.
+func newServerResponseCaches(
+	logger polylog.Logger,
+	serverConfig *config.RelayMinerServerConfig,
+) map[*config.RelayMinerSupplierServiceConfig]*relayResponseCache {
+	responseCaches := make(map[*config.RelayMinerSupplierServiceConfig]*relayResponseCache)
+	for serviceId, supplierConfig := range serverConfig.SupplierConfigsMap {
+		serviceConfigs := []*config.RelayMinerSupplierServiceConfig{supplierConfig.ServiceConfig}
+		for _, rpcTypeServiceConfig := range supplierConfig.RPCTypeServiceConfigs {
+			serviceConfigs = append(serviceConfigs, rpcTypeServiceConfig)
+		}
+
+		for _, serviceConfig := range serviceConfigs {
+			if serviceConfig == nil || serviceConfig.ResponseCache == nil {
+				continue
+			}
+
+			responseCache, err := newRelayResponseCache(serviceId, serviceConfig.ResponseCache)
+			if err != nil {
+				logger.Error().Err(err).Msgf(
+					"❌ Failed creating the response cache of service %q, responses will not be cached",
+					serviceId,
+				)
+				continue
+			}
+			responseCaches[serviceConfig] = responseCache
+		}
+	}
+
+	return responseCaches
+}
+
+// getCacheableRequest returns the cacheable counterpart of the given relay
+// request, or false if its response must not be cached.
+//
+// A relay request is cacheable if its payload is a single JSON-RPC request
+// (i.e. not a batch) whose method has a cache rule and whose params do not
+// reference a moving block tag (e.g. "latest").
+// The response of a cacheable request referencing block numbers is only cached
+// if the blocks are at least finality_depth blocks below the chain head.
+//
+// Non-cacheable requests revealing the chain head (e.g. eth_blockNumber) are
+// returned too, along with false, so that their responses update the head.
+func (responseCache *relayResponseCache) getCacheableRequest(
+	relayRequest *types.RelayRequest,
+	rpcType string,
+) (*cacheableRelayRequest, bool) {
+	poktHTTPRequest, err := sdktypes.DeserializeHTTPRequest(relayRequest.Payload)
+	if err != nil {
+		return nil, false
+	}
+
+	requestBody := bytes.TrimSpace(poktHTTPRequest.BodyBz)
+	if len(requestBody) == 0 || requestBody[0] != '{' {
+		return nil, false
+	}
+
+	var request jsonRPCRequest
+	if err = json.Unmarshal(requestBody, &request); err != nil {
+		return nil, false
+	}
+
+	// Notifications (i.e. requests without an ID) get no response to cache.
+	if len(request.ID) == 0 {
+		return nil, false
+	}
+
+	ttl, hasCacheRule := responseCache.config.MethodTTLs[request.Method]
+	isHeadRequest := isHeadRevealingMethod(request.Method)
+	if !hasCacheRule && !isHeadRequest {
+		return nil, false
+	}
+	isCacheable := hasCacheRule
+
+	// Normalize the params so that equivalent requests share their cache key.
+	var params bytes.Buffer
+	var blockNumber uint64
+	var hasBlockNumber bool
+	if len(request.Params) > 0 {
+		if err = json.Compact(&params, request.Params); err != nil {
+			return nil, false
+		}
+
+		var decodedParams any
+		if err = json.Unmarshal(params.Bytes(), &decodedParams); err != nil {
+			return nil, false
+		}
+		if hasMovingBlockTag(decodedParams) {
+			isCacheable = false
+		}
+		blockNumber, hasBlockNumber = getMaxBlockNumber(decodedParams)
+	}
+
+	if !isCacheable && !isHeadRequest {
+		return nil, false
+	}
+
+	requestPath := ""
+	if requestUrl, err := url.Parse(poktHTTPRequest.Url); err == nil {
+		requestPath = requestUrl.Path
+	}
+
+	// Hash the key components to bound the memory used by the keys, which is
+	// not accounted for by the cache max size.
+	keyHash := sha256.Sum256([]byte(strings.Join([]string{
+		responseCache.serviceId,
+		rpcType,
+		requestPath,
+		request.Method,
+		params.String(),
+	}, "\n")))
+
+	return &cacheableRelayRequest{
+		responseCache:  responseCache,
+		key:            hex.EncodeToString(keyHash[:]),
+		id:             request.ID,
+		method:         request.Method,
+		ttl:            ttl,
+		isCacheable:    isCacheable,
+		blockNumber:    blockNumber,
+		hasBlockNumber: hasBlockNumber,
+	}, isCacheable
+}
+
+// getResponse returns the cached response of the request, rebuilt with the
+// request's ID, or false if it is not cached.
+// Only final blocks are cached and the chain head only moves forward, so the
+// cached responses never need to be checked against the finality depth again.
+func (request *cacheableRelayRequest) getResponse() (*http.Response, bool) {
+	if !request.isCacheable {
+		return nil, false
+	}
+
+	cachedResponse, ok := request.responseCache.responses.Get(request.key)
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(cachedResponse.expiresAt) {
+		request.responseCache.responses.Delete(request.key)
+		return nil, false
+	}
+
+	responseBody, err := json.Marshal(jsonRPCResponse{
+		JSONRPC: cachedResponse.jsonRPCVersion,
+		ID:      request.id,
+		Result:  cachedResponse.result,
+	})
+	if err != nil {
+		return nil, false
+	}
+
+	return &http.Response{
+		StatusCode:    cachedResponse.statusCode,
+		Header:        cachedResponse.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+	}, true
+}
+
+// setResponse caches the given serialized backend response of the request.
+// Only successful responses having a non-null result are cached: errors and
+// null results (e.g. a block that is not produced yet) may change over time.
+// The responses revealing the chain head update it, even if they are not cached.
+func (request *cacheableRelayRequest) setResponse(responseBz []byte) {
+	poktHTTPResponse, err := sdktypes.DeserializeHTTPResponse(responseBz)
+	if err != nil {
+		return
+	}
+
+	if poktHTTPResponse.StatusCode < http.StatusOK ||
+		poktHTTPResponse.StatusCode >= http.StatusMultipleChoices {
+		return
+	}
+
+	var response jsonRPCResponse
+	if err = json.Unmarshal(poktHTTPResponse.BodyBz, &response); err != nil {
+		return
+	}
+
+	if len(response.Error) > 0 || len(response.Result) == 0 || string(response.Result) == "null" {
+		return
+	}
+
+	request.responseCache.observeHeadBlockNumber(request.method, response.Result)
+
+	if !request.isCacheable {
+		return
+	}
+
+	// Blocks near the chain head may be reorged, changing the responses
+	// referencing them.
+	if request.hasBlockNumber && !request.responseCache.isFinalBlock(request.blockNumber) {
+		return
+	}
+
+	header := make(http.Header, len(poktHTTPResponse.Header))
+	for _, responseHeader := range poktHTTPResponse.Header {
+		for _, value := range responseHeader.Values {
+			header.Add(responseHeader.Key, value)
+		}
+	}
+	header.Del("Content-Length")
+
+	request.responseCache.responses.Set(request.key, &cachedRelayResponse{
+		statusCode:     int(poktHTTPResponse.StatusCode),
+		header:         header,
+		jsonRPCVersion: response.JSONRPC,
+		result:         response.Result,
+		expiresAt:      time.Now().Add(request.ttl),
+	})
+}
+
+// hasMovingBlockTag returns true if the given decoded JSON-RPC params contain
+// one of the responseCacheBlockTags, at any depth.
+func hasMovingBlockTag(params any) bool {
+	switch typedParams := params.(type) {
+	case string:
+		return slices.Contains(responseCacheBlockTags, typedParams)
+	case []any:
+		return slices.ContainsFunc(typedParams, hasMovingBlockTag)
+	case map[string]any:
+		for _, value := range typedParams {
+			if hasMovingBlockTag(value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// observeHeadBlockNumber updates the chain head with the given result of a
+// request of the given method, if it reveals a higher head.
+func (responseCache *relayResponseCache) observeHeadBlockNumber(method string, result json.RawMessage) {
+	var blockNumberHex string
+	switch method {
+	case responseCacheMethodBlockNumber:
+		if err := json.Unmarshal(result, &blockNumberHex); err != nil {
+			return
+		}
+	case responseCacheMethodGetBlockByNumber, responseCacheMethodGetBlockByHash:
+		var block struct {
+			Number string `json:"number"`
+		}
+		if err := json.Unmarshal(result, &block); err != nil {
+			return
+		}
+		blockNumberHex = block.Number
+	default:
+		return
+	}
+
+	blockNumber, ok := parseBlockNumber(blockNumberHex)
+	if !ok {
+		return
+	}
+
+	for {
+		headBlockNumber := responseCache.headBlockNumber.Load()
+		if blockNumber <= headBlockNumber ||
+			responseCache.headBlockNumber.CompareAndSwap(headBlockNumber, blockNumber) {
+			return
+		}
+	}
+}
+
+// isFinalBlock returns true if the given block is at least finality_depth
+// blocks below the chain head. No block is final until the head is known.
+func (responseCache *relayResponseCache) isFinalBlock(blockNumber uint64) bool {
+	headBlockNumber := responseCache.headBlockNumber.Load()
+	if headBlockNumber == 0 || blockNumber > headBlockNumber {
+		return false
+	}
+
+	return headBlockNumber-blockNumber >= responseCache.config.FinalityDepth
+}
+
+// isHeadRevealingMethod returns true if the results of the given JSON-RPC
+// method reveal the chain head.
+func isHeadRevealingMethod(method string) bool {
+	switch method {
+	case responseCacheMethodBlockNumber,
+		responseCacheMethodGetBlockByNumber,
+		responseCacheMethodGetBlockByHash:
+		return true
+	default:
+		return false
+	}
+}
+
+// getMaxBlockNumber returns the highest block number referenced by the given
+// decoded JSON-RPC params, or false if none.
+// Block numbers are the hex quantities (e.g. "0x10") which are either
+// positional params or the values of the responseCacheBlockNumberKeys, at any
+// depth. Other nested hex values (e.g. eth_call data) are not block numbers.
+func getMaxBlockNumber(params any) (maxBlockNumber uint64, hasBlockNumber bool) {
+	observeBlockNumber := func(value any) {
+		blockNumberHex, ok := value.(string)
+		if !ok {
+			return
+		}
+		if blockNumber, ok := parseBlockNumber(blockNumberHex); ok {
+			maxBlockNumber = max(maxBlockNumber, blockNumber)
+			hasBlockNumber = true
+		}
+	}
+
+	if positionalParams, ok := params.([]any); ok {
+		for _, param := range positionalParams {
+			observeBlockNumber(param)
+		}
+	}
+
+	var observeKeyedBlockNumbers func(value any)
+	observeKeyedBlockNumbers = func(value any) {
+		switch typedValue := value.(type) {
+		case []any:
+			for _, element := range typedValue {
+				observeKeyedBlockNumbers(element)
+			}
+		case map[string]any:
+			for key, element := range typedValue {
+				if slices.Contains(responseCacheBlockNumberKeys, key) {
+					observeBlockNumber(element)
+				}
+				observeKeyedBlockNumbers(element)
+			}
+		}
+	}
+	observeKeyedBlockNumbers(params)
+
+	return maxBlockNumber, hasBlockNumber
+}
+
+// parseBlockNumber parses the given hex quantity (e.g. "0x10") as a block number.
+// Longer hex values (e.g. addresses or hashes) are not block numbers.
+func parseBlockNumber(blockNumberHex string) (uint64, bool) {
+	digits, ok := strings.CutPrefix(blockNumberHex, "0x")
+	if !ok || len(digits) == 0 || len(digits) > 16 {
+		return 0, false
+	}
+
+	blockNumber, err := strconv.ParseUint(digits, 16, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return blockNumber, true
+}
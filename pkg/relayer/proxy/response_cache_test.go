@@ -0,0 +1,359 @@
+package proxy
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	sdktypes "github.com/pokt-network/shannon-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestRelayResponseCache_CacheableRequests(t *testing.T) {
+	responseCache := newTestRelayResponseCache(t, map[string]time.Duration{
+		"eth_chainId":          time.Minute,
+		"eth_getBlockByNumber": time.Minute,
+	})
+
+	tests := []struct {
+		desc                string
+		requestBody         string
+		expectedIsCacheable bool
+	}{
+		{
+			desc:                "method with a cache rule",
+			requestBody:         `{"jsonrpc":"2.0","method":"eth_chainId","id":1}`,
+			expectedIsCacheable: true,
+		},
+		{
+			desc:                "method with a cache rule and a block number",
+			requestBody:         `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x10",false],"id":1}`,
+			expectedIsCacheable: true,
+		},
+		{
+			desc:                "method without a cache rule",
+			requestBody:         `{"jsonrpc":"2.0","method":"eth_blockNumber","id":1}`,
+			expectedIsCacheable: false,
+		},
+		{
+			desc:                "method with a cache rule and a moving block tag",
+			requestBody:         `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["latest",false],"id":1}`,
+			expectedIsCacheable: false,
+		},
+		{
+			desc:                "notification",
+			requestBody:         `{"jsonrpc":"2.0","method":"eth_chainId"}`,
+			expectedIsCacheable: false,
+		},
+		{
+			desc:                "batch request",
+			requestBody:         `[{"jsonrpc":"2.0","method":"eth_chainId","id":1}]`,
+			expectedIsCacheable: false,
+		},
+		{
+			desc:                "non JSON-RPC request",
+			requestBody:         `not json`,
+			expectedIsCacheable: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			relayRequest := newTestJSONRPCRelayRequest(t, test.requestBody)
+			_, isCacheable := responseCache.getCacheableRequest(relayRequest, "")
+			require.Equal(t, test.expectedIsCacheable, isCacheable)
+		})
+	}
+}
+
+func TestRelayResponseCache_ServesResponseWithRequestID(t *testing.T) {
+	responseCache := newTestRelayResponseCache(t, map[string]time.Duration{
+		"eth_getBlockByNumber": time.Minute,
+	})
+	setTestHeadBlockNumber(t, responseCache, "0x1000")
+
+	request1 := requireCacheableRequest(t, responseCache,
+		`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x10", false],"id":1}`,
+	)
+	_, isCached := request1.getResponse()
+	require.False(t, isCached)
+
+	request1.setResponse(newTestSerializedHTTPResponse(t, http.StatusOK,
+		`{"jsonrpc":"2.0","id":1,"result":{"number":"0x10"}}`,
+	))
+
+	// An equivalent request, differing in its ID and formatting, is served the
+	// cached response with its own ID.
+	request2 := requireCacheableRequest(t, responseCache,
+		`{"id":"abc","params":[ "0x10",false ],"method":"eth_getBlockByNumber","jsonrpc":"2.0"}`,
+	)
+	httpResponse, isCached := request2.getResponse()
+	require.True(t, isCached)
+	require.Equal(t, http.StatusOK, httpResponse.StatusCode)
+	require.Equal(t, "application/json", httpResponse.Header.Get("Content-Type"))
+	require.Empty(t, httpResponse.Header.Get("Content-Length"))
+
+	body, err := io.ReadAll(httpResponse.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":"abc","result":{"number":"0x10"}}`, string(body))
+
+	// Requests with other params do not share the cached response.
+	request3 := requireCacheableRequest(t, responseCache,
+		`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x11",false],"id":1}`,
+	)
+	_, isCached = request3.getResponse()
+	require.False(t, isCached)
+}
+
+func TestRelayResponseCache_DoesNotCacheFailures(t *testing.T) {
+	responseCache := newTestRelayResponseCache(t, map[string]time.Duration{
+		"eth_getBlockByNumber": time.Minute,
+	})
+
+	tests := []struct {
+		desc         string
+		statusCode   int
+		responseBody string
+	}{
+		{
+			desc:         "non-2XX status code",
+			statusCode:   http.StatusInternalServerError,
+			responseBody: `{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+		},
+		{
+			desc:         "JSON-RPC error",
+			statusCode:   http.StatusOK,
+			responseBody: `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`,
+		},
+		{
+			desc:         "null result",
+			statusCode:   http.StatusOK,
+			responseBody: `{"jsonrpc":"2.0","id":1,"result":null}`,
+		},
+		{
+			desc:         "non JSON response",
+			statusCode:   http.StatusOK,
+			responseBody: `not json`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			request := requireCacheableRequest(t, responseCache,
+				`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x10",false],"id":1}`,
+			)
+			request.setResponse(newTestSerializedHTTPResponse(t, test.statusCode, test.responseBody))
+
+			_, isCached := request.getResponse()
+			require.False(t, isCached)
+		})
+	}
+}
+
+func TestRelayResponseCache_FinalityDepth(t *testing.T) {
+	responseCache := newTestRelayResponseCache(t, map[string]time.Duration{
+		"eth_getBlockByNumber": time.Minute,
+		"eth_getLogs":          time.Minute,
+	})
+	finalityDepth := responseCache.config.FinalityDepth
+
+	// No block is final until the chain head is known.
+	setTestBlockResponse(t, responseCache, 0x10)
+	requireNotCached(t, responseCache, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x10",false],"id":1}`)
+
+	// The chain head is learnt from the latest block, which is not cached.
+	latestBlockRequest, isCacheable := responseCache.getCacheableRequest(newTestJSONRPCRelayRequest(t,
+		`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["latest",false],"id":1}`,
+	), "")
+	require.False(t, isCacheable)
+	latestBlockRequest.setResponse(newTestSerializedHTTPResponse(t, http.StatusOK,
+		fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":{"number":"0x%x"}}`, 0x10+finalityDepth),
+	))
+	_, isCached := latestBlockRequest.getResponse()
+	require.False(t, isCached)
+
+	// The blocks at least finality_depth blocks below the head are cached...
+	setTestBlockResponse(t, responseCache, 0x10)
+	requireCached(t, responseCache, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x10",false],"id":1}`)
+
+	// ...but not the ones above, which may still be reorged.
+	setTestBlockResponse(t, responseCache, 0x11)
+	requireNotCached(t, responseCache, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x11",false],"id":1}`)
+
+	// The highest block number referenced by the params must be final.
+	finalLogsRequestBody := `{"jsonrpc":"2.0","method":"eth_getLogs","params":[{"fromBlock":"0x1","toBlock":"0x10"}],"id":1}`
+	requireCacheableRequest(t, responseCache, finalLogsRequestBody).
+		setResponse(newTestSerializedHTTPResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":[]}`))
+	requireCached(t, responseCache, finalLogsRequestBody)
+
+	recentLogsRequestBody := `{"jsonrpc":"2.0","method":"eth_getLogs","params":[{"fromBlock":"0x1","toBlock":"0x11"}],"id":1}`
+	requireCacheableRequest(t, responseCache, recentLogsRequestBody).
+		setResponse(newTestSerializedHTTPResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":[]}`))
+	requireNotCached(t, responseCache, recentLogsRequestBody)
+
+	// The chain head is also learnt from eth_blockNumber, which has no cache rule.
+	setTestHeadBlockNumber(t, responseCache, fmt.Sprintf("0x%x", 0x11+finalityDepth))
+	setTestBlockResponse(t, responseCache, 0x11)
+	requireCached(t, responseCache, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x11",false],"id":1}`)
+}
+
+func TestRelayResponseCache_MethodTTL(t *testing.T) {
+	responseCache := newTestRelayResponseCache(t, map[string]time.Duration{
+		"eth_chainId": time.Hour,
+		"net_version": 50 * time.Millisecond,
+	})
+
+	chainIdRequest := requireCacheableRequest(t, responseCache, `{"jsonrpc":"2.0","method":"eth_chainId","id":1}`)
+	chainIdRequest.setResponse(newTestSerializedHTTPResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+
+	netVersionRequest := requireCacheableRequest(t, responseCache, `{"jsonrpc":"2.0","method":"net_version","id":1}`)
+	netVersionRequest.setResponse(newTestSerializedHTTPResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":"1"}`))
+
+	time.Sleep(100 * time.Millisecond)
+
+	// Each method's response expires after its own TTL.
+	_, isCached := chainIdRequest.getResponse()
+	require.True(t, isCached)
+	_, isCached = netVersionRequest.getResponse()
+	require.False(t, isCached)
+}
+
+func TestNewServerResponseCaches(t *testing.T) {
+	cachedServiceConfig := &config.RelayMinerSupplierServiceConfig{
+		ResponseCache: &config.RelayMinerResponseCacheConfig{
+			MaxSize:    1024,
+			MethodTTLs: map[string]time.Duration{"eth_chainId": time.Minute},
+		},
+	}
+	uncachedServiceConfig := &config.RelayMinerSupplierServiceConfig{}
+
+	responseCaches := newServerResponseCaches(polyzero.NewLogger(), &config.RelayMinerServerConfig{
+		SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+			"svc1": {
+				ServiceConfig: uncachedServiceConfig,
+				RPCTypeServiceConfigs: map[sharedtypes.RPCType]*config.RelayMinerSupplierServiceConfig{
+					sharedtypes.RPCType_JSON_RPC: cachedServiceConfig,
+				},
+			},
+		},
+	})
+
+	require.Len(t, responseCaches, 1)
+	require.Contains(t, responseCaches, cachedServiceConfig)
+}
+
+// newTestRelayResponseCache returns a response cache of the "svc1" service
+// caching the responses of the given methods for the given durations.
+func newTestRelayResponseCache(t *testing.T, methodTTLs map[string]time.Duration) *relayResponseCache {
+	t.Helper()
+
+	responseCache, err := newRelayResponseCache("svc1", &config.RelayMinerResponseCacheConfig{
+		MaxSize:       1024 * 1024,
+		FinalityDepth: config.DefaultResponseCacheFinalityDepth,
+		MethodTTLs:    methodTTLs,
+	})
+	require.NoError(t, err)
+
+	return responseCache
+}
+
+// requireCacheableRequest asserts that a relay request having the given
+// JSON-RPC body is cacheable and returns its cacheable counterpart.
+func requireCacheableRequest(
+	t *testing.T,
+	responseCache *relayResponseCache,
+	requestBody string,
+) *cacheableRelayRequest {
+	t.Helper()
+
+	request, isCacheable := responseCache.getCacheableRequest(newTestJSONRPCRelayRequest(t, requestBody), "")
+	require.True(t, isCacheable)
+
+	return request
+}
+
+// requireCached asserts that the response of a relay request having the given
+// JSON-RPC body is cached.
+func requireCached(t *testing.T, responseCache *relayResponseCache, requestBody string) {
+	t.Helper()
+
+	_, isCached := requireCacheableRequest(t, responseCache, requestBody).getResponse()
+	require.True(t, isCached)
+}
+
+// requireNotCached asserts that the response of a relay request having the
+// given JSON-RPC body is not cached.
+func requireNotCached(t *testing.T, responseCache *relayResponseCache, requestBody string) {
+	t.Helper()
+
+	_, isCached := requireCacheableRequest(t, responseCache, requestBody).getResponse()
+	require.False(t, isCached)
+}
+
+// setTestHeadBlockNumber sets the chain head of the given response cache
+// through an eth_blockNumber response having the given hex block number.
+func setTestHeadBlockNumber(t *testing.T, responseCache *relayResponseCache, headBlockNumberHex string) {
+	t.Helper()
+
+	request, isCacheable := responseCache.getCacheableRequest(newTestJSONRPCRelayRequest(t,
+		`{"jsonrpc":"2.0","method":"eth_blockNumber","id":1}`,
+	), "")
+	require.False(t, isCacheable)
+	request.setResponse(newTestSerializedHTTPResponse(t, http.StatusOK,
+		fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":%q}`, headBlockNumberHex),
+	))
+}
+
+// setTestBlockResponse caches, if final, the eth_getBlockByNumber response of
+// the block having the given number.
+func setTestBlockResponse(t *testing.T, responseCache *relayResponseCache, blockNumber uint64) {
+	t.Helper()
+
+	requireCacheableRequest(t, responseCache,
+		fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x%x",false],"id":1}`, blockNumber),
+	).setResponse(newTestSerializedHTTPResponse(t, http.StatusOK,
+		fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":{"number":"0x%x"}}`, blockNumber),
+	))
+}
+
+// newTestJSONRPCRelayRequest returns a relay request whose payload is an HTTP
+// request having the given body.
+func newTestJSONRPCRelayRequest(t *testing.T, requestBody string) *servicetypes.RelayRequest {
+	t.Helper()
+
+	request, err := http.NewRequest(http.MethodPost, "http://127.0.0.1/", bytes.NewReader([]byte(requestBody)))
+	require.NoError(t, err)
+
+	_, payloadBz, err := sdktypes.SerializeHTTPRequest(request)
+	require.NoError(t, err)
+
+	return &servicetypes.RelayRequest{Payload: payloadBz}
+}
+
+// newTestSerializedHTTPResponse returns a serialized JSON HTTP response having
+// the given status code and body.
+func newTestSerializedHTTPResponse(t *testing.T, statusCode int, responseBody string) []byte {
+	t.Helper()
+
+	httpResponse := &http.Response{
+		StatusCode: statusCode,
+		Header: http.Header{
+			"Content-Type":   []string{"application/json"},
+			"Content-Length": []string{"42"},
+		},
+		Body: io.NopCloser(bytes.NewReader([]byte(responseBody))),
+	}
+
+	_, responseBz, err := SerializeHTTPResponse(polyzero.NewLogger(), httpResponse, 1024*1024)
+	require.NoError(t, err)
+
+	return responseBz
+}
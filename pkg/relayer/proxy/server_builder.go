@@ -42,8 +42,6 @@ func (rp *relayerProxy) BuildProvidedServices(ctx context.Context) error {
 
 // initializeProxyServers initializes the proxy servers for each server config.
 func (rp *relayerProxy) initializeProxyServers() (proxyServerMap map[string]relayer.RelayServer, err error) {
-	// Build a map of serviceId -> service for the supplier's advertised services
-
 	// Build a map of listenAddress -> RelayServer for each server defined in the config file
 	servers := make(map[string]relayer.RelayServer)
 
@@ -52,32 +50,67 @@ func (rp *relayerProxy) initializeProxyServers() (proxyServerMap map[string]rela
 	for _, serverConfig := range rp.serverConfigs {
 		rp.logger.Info().Str("server host", serverConfig.ListenAddress).Msg("starting relay proxy server")
 
-		// Initialize the server according to the server type defined in the config file
-		switch serverConfig.ServerType {
-		case config.RelayMinerServerTypeHTTP:
-			logger := rp.logger.With(
-				"server_type", "http",
-				"server_host", serverConfig.ListenAddress,
-			)
-
-			servers[serverConfig.ListenAddress] = NewHTTPServer(
-				logger,
-				serverConfig,
-				rp.servedRelaysPublishCh,
-				rp.relayAuthenticator,
-				rp.relayMeter,
-				rp.blockClient,
-				rp.sharedQuerier,
-				rp.sessionQuerier,
-			)
-		default:
-			return nil, ErrRelayerProxyUnsupportedTransportType
+		server, err := rp.newRelayServer(serverConfig)
+		if err != nil {
+			return nil, err
 		}
+		servers[serverConfig.ListenAddress] = server
 	}
 
 	return servers, nil
 }
 
+// newRelayServer initializes a relay server according to the server type
+// defined in the given server config.
+func (rp *relayerProxy) newRelayServer(serverConfig *config.RelayMinerServerConfig) (relayer.RelayServer, error) {
+	switch serverConfig.ServerType {
+	// The "https" server is the "http" one terminating TLS using serverConfig.TLS.
+	case config.RelayMinerServerTypeHTTP, config.RelayMinerServerTypeHTTPS:
+		serverTypeName := "http"
+		if serverConfig.ServerType == config.RelayMinerServerTypeHTTPS {
+			if serverConfig.TLS == nil {
+				return nil, ErrRelayerProxyInvalidTLSConfig.Wrapf(
+					"missing TLS config for https server %q",
+					serverConfig.ListenAddress,
+				)
+			}
+			serverTypeName = "https"
+		}
+
+		logger := rp.logger.With(
+			"server_type", serverTypeName,
+			"server_host", serverConfig.ListenAddress,
+		)
+
+		return NewHTTPServer(
+			logger,
+			serverConfig,
+			rp.servedRelaysPublisher,
+			rp.relayAuthenticator,
+			rp.relayMeter,
+			rp.blockClient,
+			rp.sharedQuerier,
+			rp.sessionQuerier,
+		), nil
+	case config.RelayMinerServerTypeGRPC:
+		logger := rp.logger.With(
+			"server_type", "grpc",
+			"server_host", serverConfig.ListenAddress,
+		)
+
+		return NewGRPCServer(
+			logger,
+			serverConfig,
+			rp.servedRelaysPublisher,
+			rp.relayAuthenticator,
+			rp.relayMeter,
+			rp.blockClient,
+		), nil
+	default:
+		return nil, ErrRelayerProxyUnsupportedTransportType
+	}
+}
+
 // logRelayMinerConfiguredServices logs the services configured in the RelayMiner
 // server configs. This is useful for debugging and understanding which services
 // the RelayMiner is configured to handle.
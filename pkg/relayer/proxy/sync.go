@@ -263,10 +263,14 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 	}
 	instructionTimes.Record(relayer.InstructionGetServiceConfig)
 
+	// Select the backend to forward the relay request to among the service config backends.
+	backendPool := getBackendPool(server.logger, server.backendPools, serviceId, serviceConfig)
+	backend := backendPool.nextBackend(nil)
+
 	// Hydrate the logger with relevant values.
 	logger = logger.With(
 		"server_addr", server.server.Addr,
-		"destination_url", serviceConfig.BackendUrl.String(),
+		"destination_url", backend.url.String(),
 		"service_config_type", serviceConfigTypeLog,
 	)
 	instructionTimes.Record(relayer.InstructionLoggerWithServiceDetails)
@@ -275,8 +279,11 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 	// Verify the relay request signature and session when:
 	// 1. The session is already known (cached/available)
 	// 2. Eager validation is enabled (immediate validation for all requests)
+	// 3. The service is rate limited, since only verified relays are charged
+	//    against the rate limits (see below).
+	isRateLimited := isServiceRateLimited(server.rateLimiters, serviceId)
 	isRequestVerified := false
-	if isSessionKnown || server.eagerRelayRequestValidationEnabled {
+	if isSessionKnown || server.eagerRelayRequestValidationEnabled || isRateLimited {
 		instructionTimes.Record(relayer.InstructionPreRequestVerification)
 
 		if err = server.relayAuthenticator.VerifyRelayRequest(ctxWithDeadline, relayRequest, serviceId); err != nil {
@@ -289,8 +296,19 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 		isRequestVerified = true
 	}
 
+	// Enforce the per-application and per-gateway rate limits of the service
+	// once the relay request is verified, so that forged relay requests cannot
+	// exhaust the limits of the application or gateway they claim to be from.
+	// Rejected relays never reach the backend nor the miner.
+	releaseRateLimit, err := admitRelayRequest(server.rateLimiters, relayRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("❌ Relay request rejected by the rate limits")
+		return relayRequest, err
+	}
+	defer releaseRateLimit()
+
 	// Prepare backend data node request.
-	httpRequest, err := relayer.BuildServiceBackendRequest(relayRequest, serviceConfig)
+	httpRequest, err := relayer.BuildServiceBackendRequest(relayRequest, serviceConfig, backend.url)
 	if err != nil {
 		logger.Error().Err(err).Msg("❌ Failed building the service backend request")
 		return relayRequest, ErrRelayerProxyInternalError.Wrapf("failed to build the service backend request: %v", err)
@@ -332,11 +350,57 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 	defer cancelCtxWithRemainingTimeout()
 	instructionTimes.Record(relayer.InstructionSetRequestTimeoutWithRemainingTime)
 
+	// Serve cacheable JSON-RPC requests from the response cache when possible.
+	// Cached responses are signed and mined like the backend ones, so the relay
+	// is still rewarded while sparing the backends.
+	var cacheableRequest *cacheableRelayRequest
+	if responseCache, ok := server.responseCaches[serviceConfig]; ok {
+		cacheableRequest, _ = responseCache.getCacheableRequest(relayRequest, request.Header.Get(RPCTypeHeader))
+	}
+
+	var httpResponse *http.Response
+	var errorPolicyRule *config.RelayMinerErrorPolicyRule
+	isCachedResponse := false
+	if cacheableRequest != nil {
+		httpResponse, isCachedResponse = cacheableRequest.getResponse()
+	}
+
+	// Cached responses are subject to the error policy like the backend ones.
+	// A cached response matching a retry rule is discarded in favor of the backends.
+	if isCachedResponse && serviceConfig.ErrorPolicy != nil {
+		errorPolicyRule, err = matchErrorPolicyRule(
+			logger,
+			serviceConfig.ErrorPolicy,
+			httpResponse,
+			server.serverConfig.MaxBodySize,
+		)
+		switch {
+		case err != nil:
+			// The cached response is discarded along with the relay.
+			CloseBody(logger, httpResponse.Body)
+		case errorPolicyRule != nil && errorPolicyRule.Action == config.ErrorPolicyActionRetry:
+			CloseBody(logger, httpResponse.Body)
+			httpResponse, errorPolicyRule, isCachedResponse = nil, nil, false
+		}
+	}
+
 	// Send the relay request to the native service.
+	// Failed requests are retried on the other backends of the service config,
+	// within the remaining request budget.
 	serviceCallStartTime := time.Now()
-	httpResponse, err := server.httpClient.Do(ctxWithRemainingTimeout, logger, httpRequest)
-	// Early close backend request body to free up pool resources.
-	CloseBody(logger, httpRequest.Body)
+	if isCachedResponse {
+		logger = logger.With("response_cache_hit", true)
+	} else if err == nil {
+		httpResponse, httpRequest, errorPolicyRule, err = server.sendToServiceBackends(
+			ctxWithRemainingTimeout,
+			logger,
+			relayRequest,
+			serviceConfig,
+			backendPool,
+			backend,
+			httpRequest,
+		)
+	}
 	instructionTimes.Record(relayer.InstructionHTTPClientDo)
 	backendServiceProcessingEnd := time.Now()
 
@@ -362,14 +426,42 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 			)
 		}
 
+		// The backend response body exceeded the max body size while being
+		// matched against the error policy.
+		if ErrRelayerProxyResponseLimitExceeded.Is(err) {
+			return relayRequest, err
+		}
+
 		// Do not expose connection errors with the backend service to the client.
 		return relayRequest, ErrRelayerProxyInternalError.Wrap(err.Error())
 	}
 
 	// Capture the service call request duration metric.
-	relayer.CaptureServiceDuration(serviceId, serviceCallStartTime, httpResponse.StatusCode)
+	// Cached responses did not reach the backends and are not measured.
+	if !isCachedResponse {
+		relayer.CaptureServiceDuration(serviceId, serviceCallStartTime, httpResponse.StatusCode)
+	}
 	instructionTimes.Record(relayer.InstructionDeferCloseResponseBodyAndCaptureSvcDur)
 
+	// Apply the action of the error policy rule matching the backend response.
+	// Retried responses which are not retried anymore are passed through.
+	if errorPolicyRule != nil {
+		logger = logger.With("error_policy_rule", errorPolicyRule.Name)
+
+		if errorPolicyRule.Action == config.ErrorPolicyActionMask {
+			CloseBody(logger, httpResponse.Body)
+			relayer.CaptureBackendErrorMasked(serviceId, errorPolicyRule.Name)
+			logger.Warn().
+				Int("status_code", httpResponse.StatusCode).
+				Msg("backend service response masked by the error policy")
+
+			// The generic error does not expose the backend response to the client.
+			return relayRequest, ErrRelayerProxyServiceBackendError
+		}
+
+		relayer.CaptureBackendErrorPassedThrough(serviceId, errorPolicyRule.Name)
+	}
+
 	// Serialize the service response to be sent back to the client.
 	// This will include the status code, headers, and body.
 	wrappedHTTPResponse, responseBz, err := SerializeHTTPResponse(logger, httpResponse, server.serverConfig.MaxBodySize)
@@ -381,6 +473,12 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 	CloseBody(logger, httpResponse.Body)
 	instructionTimes.Record(relayer.InstructionSerializeHTTPResponse)
 
+	// Cache the backend response for the subsequent identical requests.
+	// Responses matching an error policy rule are never cached.
+	if cacheableRequest != nil && !isCachedResponse && errorPolicyRule == nil {
+		cacheableRequest.setResponse(responseBz)
+	}
+
 	// Pass through all backend responses including errors.
 	// Allows clients to see real HTTP status codes from backend service.
 	// Log non-2XX status codes for monitoring but don't block response.
@@ -515,27 +613,25 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 	}
 
 	// Only emit relays and mark as rewardable when no over-servicing or server error:
-	if isRewardApplicable(isOverServicing, httpResponse.StatusCode) {
+	if isRewardApplicable(isOverServicing, httpResponse.StatusCode, errorPolicyRule) {
 		// Forward reward-eligible relays for SMT updates (excludes over-serviced relays).
 		// We use a non-blocking select to prevent relay response delays.
 		//
 		// DEV_NOTE: This change was added under the presumption that a slow or full channel was resulting
 		// in "missing supplier operator signature" errors.
-		select {
-		case server.servedRewardableRelaysProducer <- relay:
+		if server.servedRewardableRelaysProducer.TryPublish(relay) {
 			// Successfully forwarded relay for mining
 			shouldRewardRelay = true
-		default:
-			// Channel is full - log warning but don't block the response
+		} else {
+			// Channel is full (or closed on shutdown) - log warning but don't block the response
 			// This prevents signature validation timeouts that cause "missing supplier operator signature" errors
 			//
 			// The relay was SERVED but is now LOST from the mining pipeline: the supplier
-			// did the work and will NOT be paid for it. Record a metric so this reward
-			// leakage is measurable (the log is probabilistic to avoid flooding under
-			// sustained drops, but the counter captures every drop).
-			relayer.CaptureDroppedRelay(serviceId, supplierOperatorAddress, "mining_channel_full")
+			// did the work and will NOT be paid for it. The publisher records a metric so
+			// this reward leakage is measurable (the log is probabilistic to avoid flooding
+			// under sustained drops, but the counter captures every drop).
 			logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).
-				Msg("⚠️ Relay mining channel full - dropping relay from mining pipeline (prevents signature timeout)")
+				Msg("⚠️ Relay mining channel full or closed - dropping relay from mining pipeline (prevents signature timeout)")
 			// Don't mark as rewardable since it wasn't forwarded to miner
 		}
 	}
@@ -546,7 +642,8 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 }
 
 // isRewardApplicable checks if the current relay is reward applicable given
-// its over-servicing status and the HTTP status code of the response.
+// its over-servicing status, the HTTP status code of the response and the error
+// policy rule it matched, if any.
 //
 // - Over-serviced relays exceed application's allocated stake
 // - Provided as free goodwill by supplier
@@ -559,12 +656,112 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 // Protocol details:
 // - Relay rewards optimistically accumulated before forwarding to relay miner
 // - Over-serviced relays MUST NOT enter reward pipeline
-// - 5xx errors MUST NOT enter reward pipeline
-func isRewardApplicable(isOverServicing bool, statusCode int) bool {
+// - 5xx errors MUST NOT enter reward pipeline, unless an error policy rule says otherwise
+func isRewardApplicable(
+	isOverServicing bool,
+	statusCode int,
+	errorPolicyRule *config.RelayMinerErrorPolicyRule,
+) bool {
+	if isOverServicing {
+		return false
+	}
+
+	// The matched error policy rule overrides the status code based eligibility.
+	if errorPolicyRule != nil && errorPolicyRule.RewardEligible != nil {
+		return *errorPolicyRule.RewardEligible
+	}
+
 	// Reward is applicable when:
 	// - Not over-servicing (application has enough stake)
 	// - Status code is 2xx (successful relay)
-	return !isOverServicing && statusCode < http.StatusInternalServerError
+	return statusCode < http.StatusInternalServerError
+}
+
+// sendToServiceBackends sends the given backend request to the given backend of
+// the pool. A failed request (i.e. the backend is unreachable or replies with a
+// 5xx status code) is retried on another backend of the pool, as long as the
+// pool's max retries and the request context allow it.
+//
+// If the service config has an error policy, the first rule matching a backend
+// response decides whether it is retried, and counted as a failure of its
+// backend, instead of its status code.
+//
+// It returns the response, request, matched error policy rule and error of the
+// last attempt.
+func (server *relayMinerHTTPServer) sendToServiceBackends(
+	ctx context.Context,
+	logger polylog.Logger,
+	relayRequest *types.RelayRequest,
+	serviceConfig *config.RelayMinerSupplierServiceConfig,
+	backendPool *backendPool,
+	backend *serviceBackend,
+	httpRequest *http.Request,
+) (*http.Response, *http.Request, *config.RelayMinerErrorPolicyRule, error) {
+	triedBackends := make(map[*serviceBackend]struct{}, 1)
+	for {
+		triedBackends[backend] = struct{}{}
+
+		backendPool.startRequest(backend)
+		httpResponse, err := server.httpClient.Do(ctx, logger, httpRequest)
+		// Early close backend request body to free up pool resources.
+		CloseBody(logger, httpRequest.Body)
+		isSuccess := err == nil && httpResponse.StatusCode < http.StatusInternalServerError
+
+		shouldRetry := !isSuccess
+		var errorPolicyRule *config.RelayMinerErrorPolicyRule
+		if err == nil && serviceConfig.ErrorPolicy != nil {
+			errorPolicyRule, err = matchErrorPolicyRule(
+				logger,
+				serviceConfig.ErrorPolicy,
+				httpResponse,
+				server.serverConfig.MaxBodySize,
+			)
+			if err != nil {
+				backendPool.endRequest(backend, isSuccess)
+				// The response is discarded, close its body to release the backend connection.
+				CloseBody(logger, httpResponse.Body)
+				return nil, httpRequest, nil, err
+			}
+			if errorPolicyRule != nil {
+				shouldRetry = errorPolicyRule.Action == config.ErrorPolicyActionRetry
+				// Responses to retry are backend failures, whatever their status code.
+				isSuccess = isSuccess && !shouldRetry
+			}
+		}
+		backendPool.endRequest(backend, isSuccess)
+
+		if !shouldRetry || uint64(len(triedBackends)) > backendPool.maxRetries() || ctx.Err() != nil {
+			return httpResponse, httpRequest, errorPolicyRule, err
+		}
+
+		nextBackend := backendPool.nextBackend(triedBackends)
+		if nextBackend == nil {
+			return httpResponse, httpRequest, errorPolicyRule, err
+		}
+
+		nextHTTPRequest, buildErr := relayer.BuildServiceBackendRequest(relayRequest, serviceConfig, nextBackend.url)
+		if buildErr != nil {
+			logger.Error().Err(buildErr).Msg("❌ Failed building the service backend request to retry")
+			return httpResponse, httpRequest, errorPolicyRule, err
+		}
+
+		failureLog := logger.Warn().Str("failed_backend_url", backend.url.String())
+		if err != nil {
+			failureLog = failureLog.Err(err)
+		} else {
+			failureLog = failureLog.Int("status_code", httpResponse.StatusCode)
+			// The failed response is discarded in favor of the retried one.
+			CloseBody(logger, httpResponse.Body)
+		}
+		if errorPolicyRule != nil {
+			failureLog = failureLog.Str("error_policy_rule", errorPolicyRule.Name)
+			relayer.CaptureBackendErrorRetried(backendPool.serviceId, errorPolicyRule.Name)
+		}
+		failureLog.Msgf("⚠️ Backend request failed, retrying on backend %q", nextBackend.url.String())
+		relayer.CaptureBackendRetry(backendPool.serviceId)
+
+		backend, httpRequest = nextBackend, nextHTTPRequest
+	}
 }
 
 // serviceConfigTypeDefault indicates that the service config being used is
@@ -0,0 +1,193 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// certificateReloader serves the TLS material of an "https" relay server.
+//
+// It keeps the last successfully loaded certificate and client CA pool in memory
+// and, at most once per reload interval, checks whether the files changed on disk.
+// This allows operators (or cert-manager, certbot, etc.) to rotate certificates
+// without restarting the RelayMiner: new TLS handshakes pick up the new material
+// while established connections keep using the old one.
+//
+// If a reload fails (e.g. the key was written before the certificate), the
+// previously loaded material keeps being served and the reload is retried at
+// the next interval.
+type certificateReloader struct {
+	logger    polylog.Logger
+	tlsConfig *config.RelayMinerServerTLSConfig
+
+	mu sync.RWMutex
+	// certificate is the last successfully loaded server certificate.
+	certificate *tls.Certificate
+	// clientCAs is the last successfully loaded client CA pool.
+	// It is nil when mutual TLS is not configured.
+	clientCAs *x509.CertPool
+	// filesModTime is the latest modification time across all the TLS files
+	// at the time of the last successful load. Any difference (not only a more
+	// recent one) triggers a reload, to support restoring older files.
+	filesModTime time.Time
+	// lastCheck is the last time the files were checked for changes.
+	lastCheck time.Time
+}
+
+// newCertificateReloader creates a certificateReloader for the given TLS config.
+// The TLS material is not loaded until load or GetConfigForClient is called.
+func newCertificateReloader(
+	logger polylog.Logger,
+	tlsConfig *config.RelayMinerServerTLSConfig,
+) *certificateReloader {
+	return &certificateReloader{
+		logger:    logger,
+		tlsConfig: tlsConfig,
+	}
+}
+
+// ServerTLSConfig returns the tls.Config to be used by the relay server listener.
+// It loads the TLS material eagerly so that misconfigurations are surfaced at
+// startup rather than on the first handshake.
+func (cr *certificateReloader) ServerTLSConfig() (*tls.Config, error) {
+	if err := cr.load(); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: cr.GetConfigForClient,
+	}, nil
+}
+
+// GetConfigForClient implements the tls.Config#GetConfigForClient callback.
+// It returns a config holding the most recent certificate and client CA pool,
+// reloading them from disk first if the reload interval has elapsed.
+func (cr *certificateReloader) GetConfigForClient(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+	cr.maybeReload()
+
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	serverTLSConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*cr.certificate},
+		// Only HTTP/1.1 is negotiated since websocket relays rely on the
+		// HTTP/1.1 connection upgrade mechanism.
+		NextProtos: []string{"http/1.1"},
+	}
+
+	if cr.clientCAs != nil {
+		serverTLSConfig.ClientCAs = cr.clientCAs
+		serverTLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		if cr.tlsConfig.RequireClientCert {
+			serverTLSConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+
+	return serverTLSConfig, nil
+}
+
+// maybeReload reloads the TLS material if the reload interval has elapsed and
+// any of the files changed since the last successful load.
+func (cr *certificateReloader) maybeReload() {
+	cr.mu.RLock()
+	isCheckDue := time.Since(cr.lastCheck) >= cr.tlsConfig.ReloadInterval
+	cr.mu.RUnlock()
+
+	if !isCheckDue {
+		return
+	}
+
+	if err := cr.load(); err != nil {
+		cr.logger.Error().Err(err).Msg("❌ failed to reload TLS material, keeping the previous one")
+	}
+}
+
+// load (re)loads the certificate, key and client CA files if they changed since
+// the last successful load. It is a no-op if none of the files changed.
+func (cr *certificateReloader) load() error {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	cr.lastCheck = time.Now()
+
+	modTime, err := cr.latestModTime()
+	if err != nil {
+		return err
+	}
+
+	if cr.certificate != nil && modTime.Equal(cr.filesModTime) {
+		return nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cr.tlsConfig.CertFile, cr.tlsConfig.KeyFile)
+	if err != nil {
+		return ErrRelayerProxyInvalidTLSConfig.Wrapf("failed to load key pair: %s", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if cr.tlsConfig.ClientCAFile != "" {
+		caPEM, readErr := os.ReadFile(cr.tlsConfig.ClientCAFile)
+		if readErr != nil {
+			return ErrRelayerProxyInvalidTLSConfig.Wrapf("failed to read client CA file: %s", readErr)
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return ErrRelayerProxyInvalidTLSConfig.Wrapf(
+				"no valid certificate found in client CA file %q",
+				cr.tlsConfig.ClientCAFile,
+			)
+		}
+	}
+
+	isReload := cr.certificate != nil
+
+	cr.certificate = &certificate
+	cr.clientCAs = clientCAs
+	cr.filesModTime = modTime
+
+	if isReload {
+		cr.logger.Info().
+			Str("cert_file", cr.tlsConfig.CertFile).
+			Msg("🔐 reloaded rotated TLS material")
+	}
+
+	return nil
+}
+
+// latestModTime returns the most recent modification time across all the
+// configured TLS files.
+func (cr *certificateReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, filePath := range []string{
+		cr.tlsConfig.CertFile,
+		cr.tlsConfig.KeyFile,
+		cr.tlsConfig.ClientCAFile,
+	} {
+		if filePath == "" {
+			continue
+		}
+
+		fileInfo, err := os.Stat(filePath)
+		if err != nil {
+			return time.Time{}, ErrRelayerProxyInvalidTLSConfig.Wrapf(
+				"unable to access tls file %q: %s",
+				filePath, err,
+			)
+		}
+
+		if fileInfo.ModTime().After(latest) {
+			latest = fileInfo.ModTime()
+		}
+	}
+
+	return latest, nil
+}
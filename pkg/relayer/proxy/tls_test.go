@@ -0,0 +1,202 @@
+package proxy
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// TestCertificateReloader_RotatesCertificate ensures that a rotated certificate
+// is served by new handshakes once the reload interval has elapsed.
+func TestCertificateReloader_RotatesCertificate(t *testing.T) {
+	tlsDir := t.TempDir()
+	tlsConfig := &config.RelayMinerServerTLSConfig{
+		CertFile:       filepath.Join(tlsDir, "tls.crt"),
+		KeyFile:        filepath.Join(tlsDir, "tls.key"),
+		ReloadInterval: 10 * time.Millisecond,
+	}
+
+	writeTestKeyPair(t, tlsConfig.CertFile, tlsConfig.KeyFile, "first")
+
+	reloader := newCertificateReloader(polyzero.NewLogger(), tlsConfig)
+	serverTLSConfig, err := reloader.ServerTLSConfig()
+	require.NoError(t, err)
+
+	listenAddr := serveTestTLS(t, serverTLSConfig)
+	require.Equal(t, "first", handshakeServerCommonName(t, listenAddr, nil))
+
+	// Rotate the certificate and make sure the files modification time changes.
+	time.Sleep(20 * time.Millisecond)
+	writeTestKeyPair(t, tlsConfig.CertFile, tlsConfig.KeyFile, "second")
+	later := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(tlsConfig.CertFile, later, later))
+
+	require.Equal(t, "second", handshakeServerCommonName(t, listenAddr, nil))
+}
+
+// TestCertificateReloader_KeepsPreviousOnFailedReload ensures that a broken
+// rotation does not take the server down.
+func TestCertificateReloader_KeepsPreviousOnFailedReload(t *testing.T) {
+	tlsDir := t.TempDir()
+	tlsConfig := &config.RelayMinerServerTLSConfig{
+		CertFile:       filepath.Join(tlsDir, "tls.crt"),
+		KeyFile:        filepath.Join(tlsDir, "tls.key"),
+		ReloadInterval: 10 * time.Millisecond,
+	}
+
+	writeTestKeyPair(t, tlsConfig.CertFile, tlsConfig.KeyFile, "first")
+
+	reloader := newCertificateReloader(polyzero.NewLogger(), tlsConfig)
+	serverTLSConfig, err := reloader.ServerTLSConfig()
+	require.NoError(t, err)
+
+	listenAddr := serveTestTLS(t, serverTLSConfig)
+
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, os.WriteFile(tlsConfig.CertFile, []byte("not a certificate"), 0o600))
+	later := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(tlsConfig.CertFile, later, later))
+
+	require.Equal(t, "first", handshakeServerCommonName(t, listenAddr, nil))
+	require.Error(t, reloader.load())
+}
+
+// TestCertificateReloader_MutualTLS ensures that client certificates are
+// required and verified when configured.
+func TestCertificateReloader_MutualTLS(t *testing.T) {
+	tlsDir := t.TempDir()
+	tlsConfig := &config.RelayMinerServerTLSConfig{
+		CertFile:          filepath.Join(tlsDir, "tls.crt"),
+		KeyFile:           filepath.Join(tlsDir, "tls.key"),
+		ClientCAFile:      filepath.Join(tlsDir, "ca.crt"),
+		RequireClientCert: true,
+		ReloadInterval:    time.Minute,
+	}
+
+	writeTestKeyPair(t, tlsConfig.CertFile, tlsConfig.KeyFile, "relayminer")
+
+	// The client CA is a self-signed gateway certificate.
+	gatewayCertFile := filepath.Join(tlsDir, "gateway.crt")
+	gatewayKeyFile := filepath.Join(tlsDir, "gateway.key")
+	writeTestKeyPair(t, gatewayCertFile, gatewayKeyFile, "gateway")
+	gatewayCertPEM, err := os.ReadFile(gatewayCertFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(tlsConfig.ClientCAFile, gatewayCertPEM, 0o600))
+
+	reloader := newCertificateReloader(polyzero.NewLogger(), tlsConfig)
+	serverTLSConfig, err := reloader.ServerTLSConfig()
+	require.NoError(t, err)
+
+	listenAddr := serveTestTLS(t, serverTLSConfig)
+
+	// A gateway presenting a trusted certificate is accepted.
+	gatewayCert, err := tls.LoadX509KeyPair(gatewayCertFile, gatewayKeyFile)
+	require.NoError(t, err)
+	require.Equal(t, "relayminer", handshakeServerCommonName(t, listenAddr, &gatewayCert))
+
+	// A gateway without a certificate is rejected.
+	conn, err := tls.Dial("tcp", listenAddr, &tls.Config{InsecureSkipVerify: true})
+	if err == nil {
+		// With TLS 1.3 the client handshake completes before the server
+		// verifies the client certificate: the rejection surfaces on read.
+		_, err = conn.Read(make([]byte, 1))
+		_ = conn.Close()
+	}
+	require.Error(t, err)
+}
+
+// serveTestTLS starts a TLS listener which completes handshakes and writes a
+// single byte on each accepted connection. It returns the listen address.
+func serveTestTLS(t *testing.T, serverTLSConfig *tls.Config) string {
+	t.Helper()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverTLSConfig)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, acceptErr := listener.Accept()
+			if acceptErr != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if tlsConn, ok := conn.(*tls.Conn); ok && tlsConn.Handshake() == nil {
+					_, _ = conn.Write([]byte{1})
+				}
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+// handshakeServerCommonName dials the given address and returns the common name
+// of the certificate presented by the server.
+func handshakeServerCommonName(t *testing.T, addr string, clientCert *tls.Certificate) string {
+	t.Helper()
+
+	clientTLSConfig := &tls.Config{InsecureSkipVerify: true}
+	if clientCert != nil {
+		clientTLSConfig.Certificates = []tls.Certificate{*clientCert}
+	}
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", addr, clientTLSConfig)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Read(make([]byte, 1))
+	require.NoError(t, err)
+
+	peerCerts := conn.ConnectionState().PeerCertificates
+	require.NotEmpty(t, peerCerts)
+
+	return peerCerts[0].Subject.CommonName
+}
+
+// writeTestKeyPair writes a PEM encoded self-signed key pair with the given common name.
+func writeTestKeyPair(t *testing.T, certFile, keyFile, commonName string) {
+	t.Helper()
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privKey.PublicKey, privKey)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(privKey)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+}
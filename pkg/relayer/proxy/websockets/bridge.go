@@ -3,6 +3,7 @@ package websockets
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"sync"
 
 	"github.com/gorilla/websocket"
@@ -35,20 +36,14 @@ import (
 //
 // This design has two important implications:
 //
-//  1. Each message (inbound or outbound) is treated as a reward-eligible relay.
-//     For example, with eth_subscribe, both the initial subscription request and
-//     each received event would be eligible for rewards.
+//  1. Messages (inbound or outbound) are metered by the service's MeteringStrategy,
+//     which decides which of them complete a reward-eligible relay.
+//     For example, with the default per-message metering of eth_subscribe, both the
+//     initial subscription request and each received event are eligible for rewards.
 //
 //  2. To maintain protocol compatibility, the bridge must always pair messages
 //     when submitting to the miner. It does this by combining the most recent
 //     request with the most recent response.
-//
-// TODO_FUTURE: Currently, the RelayMiner is paid for each incoming and outgoing
-// message transmitted.
-// While this is the most common and trivial use case, future services might have
-// different payable units of work (e.g. packet size, specific packet or data delimiter...).
-// To support these use cases, the bridge should be extensible to allow for custom
-// units of work to be metered and paid.
 type bridge struct {
 	ctx       context.Context
 	cancelCtx context.CancelFunc
@@ -80,9 +75,23 @@ type bridge struct {
 	// of the bridged relay requests.
 	relayMeter relayer.RelayMeter
 
+	// admitRelayRequest charges the connection against the rate limits of the
+	// service once its first relay request is verified.
+	admitRelayRequest RelayRequestAdmitter
+
+	// releaseRateLimit frees the rate limits slot held by the connection.
+	// It is nil until the first relay request is admitted and is only accessed
+	// from the message loop, then from Run once the message loop has returned.
+	releaseRateLimit func()
+
 	// blockClient is the client used to get the latest block height.
 	blockClient client.BlockClient
 
+	// meteringStrategy decides which bridged messages complete a payable unit
+	// of work, i.e. when a relay is emitted to the miner.
+	// It is only accessed from the message loop.
+	meteringStrategy MeteringStrategy
+
 	// latestRelayRequest is the latest relay request received from the gateway.
 	// It is used to emit relays to the miner such that there is always a request/response
 	// pair available when submitting proofs.
@@ -102,9 +111,9 @@ type bridge struct {
 	// latestRelayMu is the mutex that protects the latest relay request and response.
 	latestRelayMu sync.RWMutex
 
-	// relaysProducer is the channel that the bridge uses to emit the relays that
+	// relaysProducer is the publisher that the bridge uses to emit the relays that
 	// have been served to the miner.
-	relaysProducer chan<- *types.Relay
+	relaysProducer *relayer.ServedRelaysPublisher
 
 	// session is the session that the bridge is serving.
 	// It ensures that the bridge only serves relay requests matching the session
@@ -121,19 +130,34 @@ type bridge struct {
 	stopChanCloseOnce sync.Once
 }
 
-// NewBridge creates a new websocket bridge between the gateway and the service backend.
+// RelayRequestAdmitter checks a verified relay request against the rate limits
+// of its service.
+// If the relay request is admitted, the returned release function MUST be called
+// to free its concurrent requests slot. Otherwise, the relay request MUST be
+// rejected before reaching the service backend.
+type RelayRequestAdmitter func(relayRequest *types.RelayRequest) (release func(), err error)
+
+// NewBridge creates a new websocket bridge between the gateway and the given
+// backend of the service config.
 func NewBridge(
 	logger polylog.Logger,
 	relayAuthenticator relayer.RelayAuthenticator,
 	relayMeter relayer.RelayMeter,
-	serverRelaysProducer chan<- *types.Relay,
+	admitRelayRequest RelayRequestAdmitter,
+	serverRelaysProducer *relayer.ServedRelaysPublisher,
 	blockClient client.BlockClient,
 	serviceConfig *config.RelayMinerSupplierServiceConfig,
+	backendUrl *url.URL,
 	session *sessiontypes.Session,
 	gatewayWSConn *websocket.Conn,
 ) (*bridge, error) {
 	bridgeLogger := logger.With("component", "bridge")
 
+	meteringStrategy, err := NewMeteringStrategy(serviceConfig.Metering)
+	if err != nil {
+		return nil, ErrWebsocketsBridge.Wrapf("failed to create the metering strategy: %v", err)
+	}
+
 	header := make(http.Header)
 
 	// Add service-specific headers from config
@@ -163,7 +187,7 @@ func NewBridge(
 	}
 
 	// Connect to the service backend.
-	serviceBackendWSConn, err := connectServiceBackend(serviceConfig.BackendUrl, header)
+	serviceBackendWSConn, err := connectServiceBackend(backendUrl, header)
 	if err != nil {
 		bridgeLogger.Error().Err(err).Msg("failed to connect to the service backend")
 		return nil, ErrWebsocketsBridge.Wrapf("failed to connect to the service backend: %v", err)
@@ -212,8 +236,10 @@ func NewBridge(
 		msgChan:            msgChan,
 		relayAuthenticator: relayAuthenticator,
 		relayMeter:         relayMeter,
+		admitRelayRequest:  admitRelayRequest,
 		relaysProducer:     serverRelaysProducer,
 		blockClient:        blockClient,
+		meteringStrategy:   meteringStrategy,
 		session:            session,
 		stopChan:           stopChan,
 	}
@@ -270,6 +296,12 @@ func (b *bridge) Run(closeHeight int64) {
 	// channel closes); without it, goPublish leaks one goroutine, plus the
 	// observable and its buffer, for every websocket connection the bridge serves.
 	<-msgLoopDone
+
+	// Free the rate limits slot held by the connection, if it was admitted.
+	if b.releaseRateLimit != nil {
+		b.releaseRateLimit()
+	}
+
 	b.serviceBackendConn.waitSenders()
 	b.gatewayConn.waitSenders()
 	b.stopChanCloseOnce.Do(func() { close(b.stopChan) })
@@ -378,6 +410,21 @@ func (b *bridge) handleGatewayIncomingMessage(msg message) {
 
 	logger.Debug().Msg("relay request verified")
 
+	// Charge the connection against the rate limits of the service once its
+	// first relay request is verified, so that forged connections cannot exhaust
+	// the limits of the application or gateway they claim to be from.
+	// The rate limits slot is held until the bridge stops.
+	if b.releaseRateLimit == nil {
+		releaseRateLimit, err := b.admitRelayRequest(&relayRequest)
+		if err != nil {
+			b.serviceBackendConn.handleError(
+				ErrWebsocketsGatewayMessage.Wrapf("relay request rejected by the rate limits: %v", err),
+			)
+			return
+		}
+		b.releaseRateLimit = releaseRateLimit
+	}
+
 	// Forward the relay request payload to the service backend.
 	if err := b.serviceBackendConn.WriteMessage(msg.messageType, relayRequest.Payload); err != nil {
 		b.serviceBackendConn.handleError(
@@ -388,6 +435,13 @@ func (b *bridge) handleGatewayIncomingMessage(msg message) {
 
 	logger.Debug().Msg("relay request forwarded to service backend")
 
+	// Only emit a relay to the miner once the metering strategy reports that a
+	// payable unit of work has been completed.
+	if !b.meteringStrategy.ShouldEmitRelay(true, relayRequest.Payload) {
+		logger.Debug().Msg("relay request metered, no unit of work completed")
+		return
+	}
+
 	// Do not emit a relay to the miner if there is no response to form a request/response pair.
 	latestRelayResponse := b.getLatestRelayResponse()
 	if latestRelayResponse == nil {
@@ -411,10 +465,13 @@ func (b *bridge) handleGatewayIncomingMessage(msg message) {
 
 	// Emit the relay to the miner.
 	// Since async relays might be request or response only, each request or response
-	// is considered to be eligible for a reward.
-	b.relaysProducer <- relay
-
-	logger.Debug().Msg("relay emitted to miner")
+	// completing a unit of work is considered to be eligible for a reward.
+	// The relay is dropped if the mining pipeline is draining on shutdown.
+	if b.relaysProducer.Publish(relay) {
+		logger.Debug().Msg("relay emitted to miner")
+	} else {
+		logger.Debug().Msg("relay dropped: the relay miner is shutting down")
+	}
 
 	// Check if the relay should be rate-limited.
 	// Recall that num inbound messages is unlikely to equal num outbound messages in a websocket.
@@ -511,6 +568,13 @@ func (b *bridge) handleServiceBackendIncomingMessage(msg message) {
 
 	logger.Debug().Msg("relay response forwarded to gateway")
 
+	// Only emit a relay to the miner once the metering strategy reports that a
+	// payable unit of work has been completed.
+	if !b.meteringStrategy.ShouldEmitRelay(false, msg.data) {
+		logger.Debug().Msg("relay response metered, no unit of work completed")
+		return
+	}
+
 	// Shallow-copy the response before sending it to the miner. See the
 	// matching comment in handleGatewayIncomingMessage: the miner mutates
 	// relay.Res.Payload to nil, and that mutation must not leak back into
@@ -526,10 +590,13 @@ func (b *bridge) handleServiceBackendIncomingMessage(msg message) {
 
 	// Emit the relay to the miner.
 	// Since async relays might be request or response only, each request or response
-	// is considered to be eligible for a reward.
-	b.relaysProducer <- relay
-
-	logger.Debug().Msg("relay emitted to miner")
+	// completing a unit of work is considered to be eligible for a reward.
+	// The relay is dropped if the mining pipeline is draining on shutdown.
+	if b.relaysProducer.Publish(relay) {
+		logger.Debug().Msg("relay emitted to miner")
+	} else {
+		logger.Debug().Msg("relay dropped: the relay miner is shutting down")
+	}
 
 	// Check if the relay should be rate-limited.
 	// Recall that num inbound messages is unlikely to equal num outbound messages in a websocket.
@@ -0,0 +1,145 @@
+package websockets
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// MeteringStrategy decides which of the messages bridged between the gateway and
+// the service backend complete a payable unit of work.
+// The bridge emits a relay to the miner, pairing the latest request with the
+// latest response, every time the strategy reports that a unit of work completed.
+//
+// Implementations are not required to be safe for concurrent use: a bridge owns
+// its strategy and only calls it from its message loop.
+type MeteringStrategy interface {
+	// ShouldEmitRelay accounts for the given message payload and returns whether
+	// a relay should be emitted to the miner.
+	// isRequest is true for messages sent by the gateway and false for messages
+	// sent by the service backend.
+	ShouldEmitRelay(isRequest bool, payload []byte) bool
+}
+
+// NewMeteringStrategy returns the metering strategy described by the given
+// websocket metering config. A nil config results in per-message metering.
+func NewMeteringStrategy(meteringConfig *config.RelayMinerWebsocketMeteringConfig) (MeteringStrategy, error) {
+	if meteringConfig == nil {
+		return NewPerMessageMeteringStrategy(), nil
+	}
+
+	switch meteringConfig.Strategy {
+	case config.WebsocketMeteringStrategyPerMessage, "":
+		return NewPerMessageMeteringStrategy(), nil
+	case config.WebsocketMeteringStrategyPerBytes:
+		if meteringConfig.UnitSize <= 0 {
+			return nil, fmt.Errorf("invalid per_bytes metering unit size %d", meteringConfig.UnitSize)
+		}
+		return NewPerBytesMeteringStrategy(meteringConfig.UnitSize), nil
+	case config.WebsocketMeteringStrategyDelimiter:
+		if len(meteringConfig.Delimiter) == 0 {
+			return nil, fmt.Errorf("empty metering delimiter")
+		}
+		return NewDelimiterMeteringStrategy(meteringConfig.Delimiter), nil
+	default:
+		return nil, fmt.Errorf("unsupported metering strategy %q", meteringConfig.Strategy)
+	}
+}
+
+var _ MeteringStrategy = (*perMessageMeteringStrategy)(nil)
+
+// perMessageMeteringStrategy considers every message as a unit of work.
+type perMessageMeteringStrategy struct{}
+
+// NewPerMessageMeteringStrategy returns a strategy emitting a relay for every
+// incoming and outgoing message.
+func NewPerMessageMeteringStrategy() MeteringStrategy {
+	return perMessageMeteringStrategy{}
+}
+
+// ShouldEmitRelay always returns true.
+func (perMessageMeteringStrategy) ShouldEmitRelay(bool, []byte) bool {
+	return true
+}
+
+var _ MeteringStrategy = (*perBytesMeteringStrategy)(nil)
+
+// perBytesMeteringStrategy considers every unitSize bytes transmitted, in either
+// direction, as a unit of work.
+type perBytesMeteringStrategy struct {
+	unitSize int64
+
+	// numPendingBytes is the number of bytes transmitted and not yet paid for.
+	numPendingBytes int64
+}
+
+// NewPerBytesMeteringStrategy returns a strategy emitting a relay every time
+// unitSize bytes have been transmitted between the gateway and the service backend.
+func NewPerBytesMeteringStrategy(unitSize int64) MeteringStrategy {
+	return &perBytesMeteringStrategy{unitSize: unitSize}
+}
+
+// ShouldEmitRelay returns true once the accumulated payload bytes reach a unit.
+//
+// A relay is the latest request/response pair: emitting it several times for the
+// same message would produce identical relays, of which only one can be claimed.
+// A message spanning several units therefore emits a single relay, the remaining
+// units being paid by the next messages.
+func (s *perBytesMeteringStrategy) ShouldEmitRelay(_ bool, payload []byte) bool {
+	s.numPendingBytes += int64(len(payload))
+	if s.numPendingBytes < s.unitSize {
+		return false
+	}
+
+	s.numPendingBytes -= s.unitSize
+	return true
+}
+
+var _ MeteringStrategy = (*delimiterMeteringStrategy)(nil)
+
+// delimiterMeteringStrategy considers every delimiter transmitted as the end of
+// a unit of work (e.g. a newline separated record or a chunk terminator).
+type delimiterMeteringStrategy struct {
+	delimiter []byte
+
+	// requestTail and responseTail are the trailing bytes of the last request
+	// and response messages that could be the beginning of a delimiter split
+	// across messages.
+	requestTail  []byte
+	responseTail []byte
+}
+
+// NewDelimiterMeteringStrategy returns a strategy emitting a relay for every
+// message completing at least one occurrence of the given delimiter.
+// Each direction is scanned independently since request and response messages
+// are interleaved.
+func NewDelimiterMeteringStrategy(delimiter []byte) MeteringStrategy {
+	return &delimiterMeteringStrategy{delimiter: bytes.Clone(delimiter)}
+}
+
+// ShouldEmitRelay returns true if the payload completes a delimiter, including
+// one whose beginning was carried by the previous message of the same direction.
+func (s *delimiterMeteringStrategy) ShouldEmitRelay(isRequest bool, payload []byte) bool {
+	tail := &s.responseTail
+	if isRequest {
+		tail = &s.requestTail
+	}
+
+	data := append(*tail, payload...)
+
+	shouldEmit := false
+	if delimiterIdx := bytes.LastIndex(data, s.delimiter); delimiterIdx >= 0 {
+		shouldEmit = true
+		data = data[delimiterIdx+len(s.delimiter):]
+	}
+
+	// Only keep what could be an incomplete delimiter: a full one would have
+	// been found above.
+	if maxTailLen := len(s.delimiter) - 1; len(data) > maxTailLen {
+		data = data[len(data)-maxTailLen:]
+	}
+	*tail = bytes.Clone(data)
+
+	return shouldEmit
+}
@@ -0,0 +1,116 @@
+package websockets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// meteredMessage is a message bridged in either direction along with whether
+// the metering strategy is expected to emit a relay for it.
+type meteredMessage struct {
+	isRequest          bool
+	payload            string
+	expectedShouldEmit bool
+}
+
+func TestMeteringStrategy_ShouldEmitRelay(t *testing.T) {
+	tests := []struct {
+		desc           string
+		meteringConfig *config.RelayMinerWebsocketMeteringConfig
+		messages       []meteredMessage
+	}{
+		{
+			desc:           "nil config meters every message",
+			meteringConfig: nil,
+			messages: []meteredMessage{
+				{isRequest: true, payload: "", expectedShouldEmit: true},
+				{isRequest: false, payload: "response", expectedShouldEmit: true},
+				{isRequest: false, payload: "response", expectedShouldEmit: true},
+			},
+		},
+		{
+			desc: "per_bytes accumulates both directions",
+			meteringConfig: &config.RelayMinerWebsocketMeteringConfig{
+				Strategy: config.WebsocketMeteringStrategyPerBytes,
+				UnitSize: 10,
+			},
+			messages: []meteredMessage{
+				{isRequest: true, payload: "1234", expectedShouldEmit: false},
+				{isRequest: false, payload: "12345", expectedShouldEmit: false},
+				{isRequest: false, payload: "12", expectedShouldEmit: true},
+				// 1 byte carried over from the previous unit.
+				{isRequest: true, payload: "12345678", expectedShouldEmit: false},
+				{isRequest: true, payload: "1", expectedShouldEmit: true},
+			},
+		},
+		{
+			desc: "per_bytes pays large messages over the following ones",
+			meteringConfig: &config.RelayMinerWebsocketMeteringConfig{
+				Strategy: config.WebsocketMeteringStrategyPerBytes,
+				UnitSize: 4,
+			},
+			messages: []meteredMessage{
+				{isRequest: false, payload: "123456789", expectedShouldEmit: true},
+				{isRequest: false, payload: "", expectedShouldEmit: true},
+				{isRequest: false, payload: "", expectedShouldEmit: false},
+			},
+		},
+		{
+			desc: "delimiter within messages",
+			meteringConfig: &config.RelayMinerWebsocketMeteringConfig{
+				Strategy:  config.WebsocketMeteringStrategyDelimiter,
+				Delimiter: []byte("\n"),
+			},
+			messages: []meteredMessage{
+				{isRequest: false, payload: "partial record", expectedShouldEmit: false},
+				{isRequest: false, payload: " end\nnext", expectedShouldEmit: true},
+				{isRequest: false, payload: "a\nb\nc\n", expectedShouldEmit: true},
+				{isRequest: true, payload: "no delimiter", expectedShouldEmit: false},
+			},
+		},
+		{
+			desc: "delimiter split across messages",
+			meteringConfig: &config.RelayMinerWebsocketMeteringConfig{
+				Strategy:  config.WebsocketMeteringStrategyDelimiter,
+				Delimiter: []byte("\r\n\r\n"),
+			},
+			messages: []meteredMessage{
+				{isRequest: false, payload: "chunk\r", expectedShouldEmit: false},
+				// The other direction does not complete the response delimiter.
+				{isRequest: true, payload: "\n\r\n", expectedShouldEmit: false},
+				{isRequest: false, payload: "\n\r", expectedShouldEmit: false},
+				{isRequest: false, payload: "\n", expectedShouldEmit: true},
+				// The consumed delimiter is not counted twice.
+				{isRequest: false, payload: "\r\n", expectedShouldEmit: false},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			meteringStrategy, err := NewMeteringStrategy(test.meteringConfig)
+			require.NoError(t, err)
+
+			for i, msg := range test.messages {
+				shouldEmit := meteringStrategy.ShouldEmitRelay(msg.isRequest, []byte(msg.payload))
+				require.Equalf(t, msg.expectedShouldEmit, shouldEmit, "message %d: %q", i, msg.payload)
+			}
+		})
+	}
+}
+
+func TestNewMeteringStrategy_InvalidConfig(t *testing.T) {
+	invalidConfigs := []*config.RelayMinerWebsocketMeteringConfig{
+		{Strategy: config.WebsocketMeteringStrategyPerBytes},
+		{Strategy: config.WebsocketMeteringStrategyDelimiter},
+		{Strategy: "per_packet"},
+	}
+
+	for _, meteringConfig := range invalidConfigs {
+		_, err := NewMeteringStrategy(meteringConfig)
+		require.Error(t, err)
+	}
+}
@@ -1,6 +1,8 @@
 package relay_authenticator
 
 import (
+	"sync"
+
 	"cosmossdk.io/depinject"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 
@@ -23,6 +25,10 @@ type relayAuthenticator struct {
 	// They are used along with the keyring to get the supplier operator addresses.
 	signingKeyNames []string
 	keyring         keyring.Keyring
+
+	// signersMu protects signingKeyNames, signers and operatorAddressToSigningKeyNameMap
+	// which are replaced when the RelayMiner config is reloaded.
+	signersMu sync.RWMutex
 	// signers is a map of supplier operator addresses to their corresponding signers.
 	// It is used to cache signers and avoid initializing a signer from the keyring
 	// for every incoming relay request.
@@ -98,9 +104,37 @@ func NewRelayAuthenticator(
 	return ra, nil
 }
 
+// SetSigningKeyNames replaces the signing key names used to sign relay responses.
+// The relays of the suppliers whose signing key is no longer configured are
+// rejected from then on.
+// The current signing keys are kept if any of the given ones cannot be loaded
+// from the keyring.
+func (ra *relayAuthenticator) SetSigningKeyNames(signingKeyNames []string) error {
+	if len(signingKeyNames) == 0 || signingKeyNames[0] == "" {
+		return ErrRelayAuthenticatorUndefinedSigner
+	}
+
+	operatorAddressToSigningKeyNameMap, signers, err := ra.newSigners(signingKeyNames)
+	if err != nil {
+		return err
+	}
+
+	ra.signersMu.Lock()
+	defer ra.signersMu.Unlock()
+
+	ra.signingKeyNames = signingKeyNames
+	ra.operatorAddressToSigningKeyNameMap = operatorAddressToSigningKeyNameMap
+	ra.signers = signers
+
+	return nil
+}
+
 // GetSupplierOperatorAddresses returns the supplier operator addresses that
 // the relay authenticator can use to sign relay responses.
 func (ra *relayAuthenticator) GetSupplierOperatorAddresses() []string {
+	ra.signersMu.RLock()
+	defer ra.signersMu.RUnlock()
+
 	addresses := make([]string, 0, len(ra.operatorAddressToSigningKeyNameMap))
 	for address := range ra.operatorAddressToSigningKeyNameMap {
 		addresses = append(addresses, address)
@@ -121,28 +155,38 @@ func (ra *relayAuthenticator) validateConfig() error {
 
 // populateOperatorAddressToSigningKeyNameMap populates the operatorAddressToSigningKeyNameMap
 // with the supplier operator addresses as keys and the keyring signing key names as values.
-func (ra *relayAuthenticator) populateOperatorAddressToSigningKeyNameMap() error {
-	ra.operatorAddressToSigningKeyNameMap = make(map[string]string, len(ra.signingKeyNames))
-	ra.signers = make(map[string]signer.Signer, len(ra.signingKeyNames))
+func (ra *relayAuthenticator) populateOperatorAddressToSigningKeyNameMap() (err error) {
+	ra.operatorAddressToSigningKeyNameMap, ra.signers, err = ra.newSigners(ra.signingKeyNames)
+	return err
+}
 
-	for _, operatorSigningKeyName := range ra.signingKeyNames {
+// newSigners returns the map of supplier operator addresses to their signing key
+// names and the map of supplier operator addresses to their signers, built from
+// the given keyring signing key names.
+func (ra *relayAuthenticator) newSigners(
+	signingKeyNames []string,
+) (map[string]string, map[string]signer.Signer, error) {
+	operatorAddressToSigningKeyNameMap := make(map[string]string, len(signingKeyNames))
+	signers := make(map[string]signer.Signer, len(signingKeyNames))
+
+	for _, operatorSigningKeyName := range signingKeyNames {
 		supplierOperatorKey, err := ra.keyring.Key(operatorSigningKeyName)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 
 		supplierOperatorAddress, err := supplierOperatorKey.GetAddress()
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 
-		operatorSigner, err := signer.NewSimpleSigner(ra.keyring, operatorSigningKeyName)
+		operatorSigner, err := signer.NewKeyringSigner(ra.keyring, operatorSigningKeyName)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
-		ra.signers[supplierOperatorAddress.String()] = operatorSigner
-		ra.operatorAddressToSigningKeyNameMap[supplierOperatorAddress.String()] = operatorSigningKeyName
+		signers[supplierOperatorAddress.String()] = operatorSigner
+		operatorAddressToSigningKeyNameMap[supplierOperatorAddress.String()] = operatorSigningKeyName
 	}
 
-	return nil
+	return operatorAddressToSigningKeyNameMap, signers, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/relayer/relay_authenticator"
 	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/testutil/testclient"
 	"github.com/pokt-network/poktroll/testutil/testclient/testblock"
 	"github.com/pokt-network/poktroll/testutil/testclient/testkeyring"
 	"github.com/pokt-network/poktroll/testutil/testclient/testqueryclients"
@@ -235,6 +236,51 @@ func (s *RelayAuthenticatorTestSuite) TestSignRelayResponse_Success() {
 	require.NotEmpty(s.T(), relayRes.Meta.SupplierOperatorSignature)
 }
 
+func (s *RelayAuthenticatorTestSuite) TestSetSigningKeyNames() {
+	auth, err := relay_authenticator.NewRelayAuthenticator(
+		s.deps,
+		relay_authenticator.WithSigningKeyNames([]string{s.supplierKeyName}),
+	)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []string{s.supplierAddress}, auth.GetSupplierOperatorAddresses())
+
+	// Add a new supplier signing key to the keyring.
+	newSupplierKeyName := "supplier2"
+	newSupplierKeyringRecord, _ := testclient.NewKey(s.T(), newSupplierKeyName, s.keyring)
+	newSupplierAddress, err := newSupplierKeyringRecord.GetAddress()
+	require.NoError(s.T(), err)
+
+	// Unknown signing keys are rejected and the current ones are kept.
+	err = auth.SetSigningKeyNames([]string{newSupplierKeyName, "non_existent_key"})
+	require.Error(s.T(), err)
+	require.Equal(s.T(), []string{s.supplierAddress}, auth.GetSupplierOperatorAddresses())
+
+	// Rotate the signing key.
+	err = auth.SetSigningKeyNames([]string{newSupplierKeyName})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []string{newSupplierAddress.String()}, auth.GetSupplierOperatorAddresses())
+
+	relayRes := &servicetypes.RelayResponse{
+		Meta: servicetypes.RelayResponseMetadata{
+			SessionHeader: &sessiontypes.SessionHeader{
+				ApplicationAddress:      s.appAddress,
+				SessionId:               s.session.SessionId,
+				SessionStartBlockHeight: s.session.Header.SessionStartBlockHeight,
+				SessionEndBlockHeight:   s.session.Header.SessionEndBlockHeight,
+				ServiceId:               serviceId,
+			},
+		},
+	}
+
+	// The relays of the supplier whose signing key was removed are no longer signed.
+	err = auth.SignRelayResponse(relayRes, s.supplierAddress)
+	require.ErrorIs(s.T(), err, relay_authenticator.ErrRelayAuthenticatorUndefinedSigner)
+
+	err = auth.SignRelayResponse(relayRes, newSupplierAddress.String())
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), relayRes.Meta.SupplierOperatorSignature)
+}
+
 // setupApplicationAccount generates and sets up the application account details
 func (s *RelayAuthenticatorTestSuite) setupApplicationAccount() {
 	appAddress, appPubKey, appPrivKey := sample.AccAddressAndKeyPair()
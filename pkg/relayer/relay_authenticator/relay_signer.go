@@ -32,7 +32,9 @@ func (ra *relayAuthenticator) SignRelayResponse(relayResponse *types.RelayRespon
 	}
 
 	// create a simple signer for the request
+	ra.signersMu.RLock()
 	signer, ok := ra.signers[supplierOperatorAddr]
+	ra.signersMu.RUnlock()
 	if !ok {
 		return ErrRelayAuthenticatorUndefinedSigner.Wrapf("unable to resolve signer for supplier %s (available: %v)", supplierOperatorAddr, ra.getAvailableSupplierAddresses())
 	}
@@ -69,6 +71,9 @@ func (ra *relayAuthenticator) SignRelayResponse(relayResponse *types.RelayRespon
 // getAvailableSupplierAddresses returns a slice of available supplier addresses for enhanced error logging.
 // This helps debug "missing supplier operator signature" errors by showing which suppliers are configured.
 func (ra *relayAuthenticator) getAvailableSupplierAddresses() []string {
+	ra.signersMu.RLock()
+	defer ra.signersMu.RUnlock()
+
 	addresses := make([]string, 0, len(ra.operatorAddressToSigningKeyNameMap))
 	for addr := range ra.operatorAddressToSigningKeyNameMap {
 		addresses = append(addresses, addr)
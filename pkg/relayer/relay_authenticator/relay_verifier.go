@@ -107,7 +107,9 @@ func (ra *relayAuthenticator) VerifyRelayRequest(
 	}
 
 	// Check if the relayRequest is allowed to be served by the relayer proxy.
+	ra.signersMu.RLock()
 	_, isSupplierOperatorAddressPresent := ra.operatorAddressToSigningKeyNameMap[meta.GetSupplierOperatorAddress()]
+	ra.signersMu.RUnlock()
 	if !isSupplierOperatorAddressPresent {
 		return ErrRelayAuthenticatorMissingSupplierOperatorAddress.Wrapf(
 			"supplier operator address %s is not present in the signing key names map",
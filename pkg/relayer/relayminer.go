@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"net/url"
+	"time"
 
 	"cosmossdk.io/depinject"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -82,11 +83,76 @@ func (rel *relayMiner) Start(ctx context.Context) error {
 	return nil
 }
 
-// Stop stops the relayer proxy which in turn stops all advertised relay servers
-// and unsubscribes the miner from the served relays observable.
-func (rel *relayMiner) Stop(ctx context.Context) error {
-	rel.relayerSessionsManager.Stop()
-	return rel.relayerProxy.Stop(ctx)
+// Stop gracefully shuts the relay miner down by:
+//  1. Stopping the relayer proxy, which stops accepting relays and waits, for up
+//     to stopTimeout, for the ones being served to complete.
+//  2. Closing the served relays observable, letting the miner and the relayer
+//     sessions manager drain, for up to drainTimeout, the relays buffered in the
+//     mining pipeline.
+//  3. Stopping the relayer sessions manager once drained, flushing every
+//     session tree's WAL to disk.
+//
+// Both deadlines are derived from the given ctx, each one starting with its step:
+// a slow relayer proxy shutdown does not shorten the mining pipeline drain.
+// The relays still in the mining pipeline when the drain deadline is reached are
+// dropped. It logs how many relays were persisted and dropped during the shutdown.
+func (rel *relayMiner) Stop(ctx context.Context, stopTimeout, drainTimeout time.Duration) error {
+	numDroppedRelaysBefore := rel.relayerProxy.NumDroppedServedRelays()
+
+	rel.logger.Info().Msg("stopping relayer proxy")
+	stopCtx, cancelStopCtx := context.WithTimeout(ctx, stopTimeout)
+	defer cancelStopCtx()
+	err := rel.relayerProxy.Stop(stopCtx)
+	if err != nil {
+		rel.logger.Warn().Err(err).Msg("relayer proxy did not stop gracefully")
+	}
+
+	rel.logger.Info().Msg("draining the mining pipeline")
+	rel.relayerProxy.CloseServedRelays()
+	drainCtx, cancelDrainCtx := context.WithTimeout(ctx, drainTimeout)
+	defer cancelDrainCtx()
+	numRelaysPersisted, isDrained := rel.relayerSessionsManager.Drain(drainCtx)
+	numRelaysDropped := rel.relayerProxy.NumDroppedServedRelays() - numDroppedRelaysBefore
+	if !isDrained {
+		// The relays still in the miner or the relayer sessions manager pipelines
+		// are never inserted in their session trees once drained.
+		numRelaysDropped += rel.numRelaysInMiningPipeline()
+	}
+
+	logger := rel.logger.With(
+		"num_relays_persisted", numRelaysPersisted,
+		"num_relays_dropped", numRelaysDropped,
+	)
+	if !isDrained {
+		logger.Warn().Msg("⚠️ Shutdown deadline reached before the mining pipeline was drained: the relays it still buffered were dropped")
+		return err
+	}
+	logger.Info().Msg("💾 Mining pipeline drained and session trees persisted")
+
+	return err
+}
+
+// numRelaysInMiningPipeline returns the number of served relays forwarded to the
+// mining pipeline but neither processed by the miner nor, once mined, by the
+// relayer sessions manager.
+func (rel *relayMiner) numRelaysInMiningPipeline() uint64 {
+	// Load the counters downstream first: a relay moving along the pipeline in
+	// between is counted at most once rather than missed.
+	numMinedRelaysProcessed := rel.relayerSessionsManager.NumProcessedMinedRelays()
+	numServedRelaysProcessed, numRelaysMined := rel.miner.NumProcessedRelays()
+	numServedRelaysForwarded := rel.relayerProxy.NumForwardedServedRelays()
+
+	numRelaysInMiner := subtractOrZero(numServedRelaysForwarded, numServedRelaysProcessed)
+	numRelaysInSessionsManager := subtractOrZero(numRelaysMined, numMinedRelaysProcessed)
+	return numRelaysInMiner + numRelaysInSessionsManager
+}
+
+// subtractOrZero returns a - b, or 0 if b is greater than a.
+func subtractOrZero(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
 }
 
 // Starts a metrics server on the given address.
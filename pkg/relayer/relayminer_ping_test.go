@@ -99,7 +99,7 @@ func (t *RelayMinerPingSuite) TestOKPingAll() {
 	require.NoError(t.T(), err)
 	require.Equal(t.T(), http.StatusNoContent, resp.StatusCode)
 
-	require.NoError(t.T(), relayminer.Stop(ctx))
+	require.NoError(t.T(), relayminer.Stop(ctx, time.Second, time.Second))
 }
 
 func (t *RelayMinerPingSuite) TestNOKPingAllWithTemporaryError() {
@@ -162,7 +162,7 @@ func (t *RelayMinerPingSuite) TestNOKPingAllWithTemporaryError() {
 	require.NoError(t.T(), err)
 	require.Equal(t.T(), http.StatusGatewayTimeout, resp.StatusCode)
 
-	require.NoError(t.T(), relayminer.Stop(ctx))
+	require.NoError(t.T(), relayminer.Stop(ctx, time.Second, time.Second))
 }
 
 func (t *RelayMinerPingSuite) TestNOKPingWithoutTemporaryError() {
@@ -213,5 +213,5 @@ func (t *RelayMinerPingSuite) TestNOKPingWithoutTemporaryError() {
 	require.NoError(t.T(), err)
 	require.Equal(t.T(), http.StatusBadGateway, resp.StatusCode)
 
-	require.NoError(t.T(), relayminer.Stop(ctx))
+	require.NoError(t.T(), relayminer.Stop(ctx, time.Second, time.Second))
 }
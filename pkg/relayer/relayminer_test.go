@@ -53,5 +53,5 @@ func TestRelayMiner_StartAndStop(t *testing.T) {
 
 	time.Sleep(time.Millisecond)
 
-	require.NoError(t, relayminer.Stop(ctx))
+	require.NoError(t, relayminer.Stop(ctx, time.Second, time.Second))
 }
@@ -0,0 +1,122 @@
+package relayer
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+const (
+	// DroppedRelayReasonMiningChannelFull is the reason of the relays dropped
+	// because the mining pipeline could not keep up with the served relays.
+	DroppedRelayReasonMiningChannelFull = "mining_channel_full"
+
+	// DroppedRelayReasonShuttingDown is the reason of the relays dropped because
+	// they were served after the mining pipeline started draining on shutdown.
+	DroppedRelayReasonShuttingDown = "relayminer_shutting_down"
+)
+
+// ServedRelaysPublisher forwards the relays served by the relay servers to the
+// mining pipeline (i.e. the RelayerProxy's ServedRelays observable).
+//
+// It can be closed while relays are still being served (e.g. by websocket
+// bridges outliving the relay servers on shutdown): the relays published
+// afterwards are dropped instead of being sent on a closed channel.
+type ServedRelaysPublisher struct {
+	// publishMu is read-locked while a relay is sent to publishCh and write-locked
+	// by Close, ensuring publishCh is never closed while a relay is being sent.
+	publishMu sync.RWMutex
+	isClosed  bool
+	publishCh chan<- *types.Relay
+
+	// numPublishedRelays is the number of relays forwarded to the mining pipeline
+	// since the publisher was created.
+	numPublishedRelays atomic.Uint64
+
+	// numDroppedRelays is the number of relays dropped since the publisher was created.
+	numDroppedRelays atomic.Uint64
+}
+
+// NewServedRelaysPublisher returns a ServedRelaysPublisher forwarding the relays
+// to the given publish channel, which it owns closing.
+func NewServedRelaysPublisher(publishCh chan<- *types.Relay) *ServedRelaysPublisher {
+	return &ServedRelaysPublisher{publishCh: publishCh}
+}
+
+// TryPublish forwards the given relay to the mining pipeline without blocking.
+// It returns false if the relay was dropped because the mining pipeline is full
+// or closed.
+func (p *ServedRelaysPublisher) TryPublish(relay *types.Relay) bool {
+	p.publishMu.RLock()
+	defer p.publishMu.RUnlock()
+
+	if p.isClosed {
+		p.drop(relay, DroppedRelayReasonShuttingDown)
+		return false
+	}
+
+	select {
+	case p.publishCh <- relay:
+		p.numPublishedRelays.Add(1)
+		return true
+	default:
+		p.drop(relay, DroppedRelayReasonMiningChannelFull)
+		return false
+	}
+}
+
+// Publish forwards the given relay to the mining pipeline, blocking until it
+// has room for it.
+// It returns false if the relay was dropped because the mining pipeline is closed.
+func (p *ServedRelaysPublisher) Publish(relay *types.Relay) bool {
+	p.publishMu.RLock()
+	defer p.publishMu.RUnlock()
+
+	if p.isClosed {
+		p.drop(relay, DroppedRelayReasonShuttingDown)
+		return false
+	}
+
+	p.publishCh <- relay
+	p.numPublishedRelays.Add(1)
+	return true
+}
+
+// Close closes the publish channel, letting the mining pipeline drain the relays
+// already published. The relays published afterwards are dropped.
+// It is a no-op if the publisher is already closed.
+func (p *ServedRelaysPublisher) Close() {
+	p.publishMu.Lock()
+	defer p.publishMu.Unlock()
+
+	if p.isClosed {
+		return
+	}
+
+	p.isClosed = true
+	close(p.publishCh)
+}
+
+// NumPublishedRelays returns the number of relays forwarded to the mining pipeline
+// since the publisher was created.
+func (p *ServedRelaysPublisher) NumPublishedRelays() uint64 {
+	return p.numPublishedRelays.Load()
+}
+
+// NumDroppedRelays returns the number of relays dropped since the publisher was created.
+func (p *ServedRelaysPublisher) NumDroppedRelays() uint64 {
+	return p.numDroppedRelays.Load()
+}
+
+// drop records the given relay as dropped from the mining pipeline for the given reason.
+func (p *ServedRelaysPublisher) drop(relay *types.Relay, reason string) {
+	p.numDroppedRelays.Add(1)
+
+	meta := relay.GetReq().GetMeta()
+	CaptureDroppedRelay(
+		meta.GetSessionHeader().GetServiceId(),
+		meta.GetSupplierOperatorAddress(),
+		reason,
+	)
+}
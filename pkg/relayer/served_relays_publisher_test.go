@@ -0,0 +1,90 @@
+package relayer_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+)
+
+func TestServedRelaysPublisher_TryPublish(t *testing.T) {
+	publishCh := make(chan *servicetypes.Relay, 1)
+	publisher := relayer.NewServedRelaysPublisher(publishCh)
+
+	// The first relay fills the channel buffer, the second one is dropped.
+	require.True(t, publisher.TryPublish(&servicetypes.Relay{}))
+	require.False(t, publisher.TryPublish(&servicetypes.Relay{}))
+	require.Equal(t, uint64(1), publisher.NumPublishedRelays())
+	require.Equal(t, uint64(1), publisher.NumDroppedRelays())
+
+	// The relays published before closing are still delivered.
+	publisher.Close()
+	require.Len(t, publishCh, 1)
+	<-publishCh
+	_, isOpen := <-publishCh
+	require.False(t, isOpen)
+
+	// The relays published after closing are dropped.
+	require.False(t, publisher.TryPublish(&servicetypes.Relay{}))
+	require.False(t, publisher.Publish(&servicetypes.Relay{}))
+	require.Equal(t, uint64(1), publisher.NumPublishedRelays())
+	require.Equal(t, uint64(3), publisher.NumDroppedRelays())
+
+	// Closing twice is a no-op.
+	publisher.Close()
+}
+
+func TestServedRelaysPublisher_CloseWhilePublishing(t *testing.T) {
+	publishCh := make(chan *servicetypes.Relay, 10)
+	publisher := relayer.NewServedRelaysPublisher(publishCh)
+
+	// Consume the relays until the channel is closed, like the mining pipeline.
+	numReceivedCh := make(chan int)
+	go func() {
+		numReceived := 0
+		for range publishCh {
+			numReceived++
+		}
+		numReceivedCh <- numReceived
+	}()
+
+	// Keep publishing relays, as the relay servers would on shutdown, while the
+	// publisher is closed: it must neither panic nor lose track of any relay.
+	const numPublishers, numRelaysPerPublisher = 8, 500
+	var (
+		wg           sync.WaitGroup
+		numPublished sync.Map
+	)
+	wg.Add(numPublishers)
+	for publisherIdx := range numPublishers {
+		go func() {
+			defer wg.Done()
+			published := 0
+			for relayIdx := range numRelaysPerPublisher {
+				// Alternate between the blocking and the non-blocking publish.
+				isPublished := publisher.TryPublish(&servicetypes.Relay{})
+				if relayIdx%2 == 0 {
+					isPublished = publisher.Publish(&servicetypes.Relay{})
+				}
+				if isPublished {
+					published++
+				}
+			}
+			numPublished.Store(publisherIdx, published)
+		}()
+	}
+
+	publisher.Close()
+	wg.Wait()
+
+	totalPublished := 0
+	numPublished.Range(func(_, published any) bool {
+		totalPublished += published.(int)
+		return true
+	})
+
+	require.Equal(t, totalPublished, <-numReceivedCh)
+}
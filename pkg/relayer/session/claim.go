@@ -72,15 +72,18 @@ func (rs *relayerSessionsManager) createClaims(
 		rs.newMapClaimSessionsFn(supplierClient, failedCreateClaimSessionsPublishCh),
 	)
 
-	// TODO_TECHDEBT: pass failed create claim sessions to some retry mechanism.
-	// TODO_IMPROVE: It may be useful for the retry mechanism which consumes the
-	// observable which corresponds to failSubmitProofsSessionsCh to have a
-	// reference to the error which caused the proof submission to fail.
+	// TODO_IMPROVE: It may be useful for the operator retrying a failed claim
+	// to have a reference to the error which caused the claim creation to fail.
 	// In this case, the error may not be persistent.
 	logging.LogErrors(ctx, filter.EitherError(ctx, eitherClaimedSessionsObs))
 
-	// Delete failed session trees so they don't get claimed again.
-	channel.ForEach(ctx, failedCreateClaimSessionsObs, rs.deleteSessionTrees)
+	// Keep failed session trees so their claims can be retried (see RetrySession).
+	// They are not picked up for claiming again and are deleted once their proof
+	// window closes, like any other expired session tree.
+	channel.ForEach(
+		ctx, failedCreateClaimSessionsObs,
+		rs.newMarkSessionTreesFailedFn(relayer.SessionLifecycleStateClaimFailed),
+	)
 
 	// Map eitherClaimedSessions to a new observable of []relayer.SessionTree
 	// which is notified when the corresponding claims creation succeeded.
@@ -305,6 +308,8 @@ func (rs *relayerSessionsManager) newMapClaimSessionsFn(
 		}
 		claimWindowCloseHeight := sharedtypes.GetClaimWindowCloseHeight(sharedParams, sessionEndHeight)
 
+		setSessionTreesLifecycleState(claimableSessionTrees, relayer.SessionLifecycleStateClaimPending)
+
 		// Create claims for each supplier operator address in `sessionTrees`.
 		if err := supplierClient.CreateClaims(ctx, claimWindowCloseHeight, claimMsgs...); err != nil {
 			failedCreateClaimsSessionsPublishCh <- claimableSessionTrees
@@ -312,6 +317,8 @@ func (rs *relayerSessionsManager) newMapClaimSessionsFn(
 			return either.Error[[]relayer.SessionTree](err), false
 		}
 
+		setSessionTreesLifecycleState(claimableSessionTrees, relayer.SessionLifecycleStateClaimed)
+
 		return either.Success(claimableSessionTrees), false
 	}
 }
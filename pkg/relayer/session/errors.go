@@ -2,7 +2,7 @@ package session
 
 import sdkerrors "cosmossdk.io/errors"
 
-// Next available error code: 12
+// Next available error code: 18
 var (
 	codespace                                  = "relayer_session"
 	ErrSessionTreeClosed                       = sdkerrors.Register(codespace, 1, "session tree already closed")
@@ -18,4 +18,8 @@ var (
 	ErrSessionTreeInvalidStoresDirectoryPath   = sdkerrors.Register(codespace, 11, "session tree invalid stores directory path")
 	ErrSessionTreeWALWriteQueueFull            = sdkerrors.Register(codespace, 12, "session tree WAL write queue full")
 	ErrSessionTreeWALClosed                    = sdkerrors.Register(codespace, 13, "session tree WAL closed")
+	ErrSessionTreeNotFound                     = sdkerrors.Register(codespace, 14, "session tree not found")
+	ErrSessionTreeNotRetryable                 = sdkerrors.Register(codespace, 15, "session tree claim or proof is not retryable")
+	ErrSessionRetryWindowClosed                = sdkerrors.Register(codespace, 16, "session claim or proof window closed")
+	ErrSessionTreeWALCorrupted                 = sdkerrors.Register(codespace, 17, "session tree WAL corrupted")
 )
@@ -20,9 +20,14 @@ package session
 // - Each SessionTree has its own WAL file to avoid contention
 
 import (
+	"bufio"
 	"encoding/binary"
+	"fmt"
+	"hash/crc32"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -33,35 +38,80 @@ import (
 	"github.com/pokt-network/poktroll/pkg/polylog"
 )
 
-// TODO_TECHDEBT: Make these constants configurable by the RelayMiner
+// Default thresholds of the mined relays WAL, used when the RelayMiner config
+// does not set them (i.e. the MinedRelaysWALConfig fields are zero).
 const (
-	// maxBufferedMinedRelaysBytesBeforeFlush defines when to proactively flush the
+	// DefaultMinedRelaysWALMaxBufferedBytes defines when to proactively flush the
 	// in-memory buffer to disk. It is a simple safety and responsiveness threshold:
 	// - Keeps memory usage bounded
 	// - Ensures timely persistence even if traffic is bursty
 	//
 	// High-throughput suppliers may need lower thresholds to prevent memory pressure.
 	// Low-resource environments may need lower thresholds to prevent OOM.
-	maxBufferedMinedRelaysBytesBeforeFlush = 10_000_000 // 10MB
+	DefaultMinedRelaysWALMaxBufferedBytes = 10_000_000 // 10MB
 
-	// minedRelaysLogFlushInterval is the periodic cadence for background buffer flushes.
+	// DefaultMinedRelaysWALFlushInterval is the periodic cadence for background buffer flushes.
 	// Even if the threshold is not hit, this ensures regular persistence:
 	// - Reduces potential loss window in case of abrupt termination
 	// - Smooths out I/O instead of writing every mined relay
 	//
 	// Testing environments may want faster flushes (e.g., 1s) for rapid iteration.
 	// Production may want longer intervals (e.g., 30s) to reduce I/O overhead.
-	minedRelaysLogFlushInterval = 10 * time.Second
+	DefaultMinedRelaysWALFlushInterval = 10 * time.Second
 
-	// minedRelaysWriteQueueSize is the buffer size for the channel queue used by
+	// DefaultMinedRelaysWALWriteQueueSize is the buffer size for the channel queue used by
 	// the dedicated writer goroutine. A larger buffer provides more tolerance for
 	// burst traffic but uses more memory. If the queue fills up, AppendMinedRelay
 	// will detect backpressure.
-	minedRelaysWriteQueueSize = 100
+	DefaultMinedRelaysWALWriteQueueSize = 100
 )
 
+// MinedRelaysWALConfig holds the thresholds of the mined relays WAL.
+// Zero fields fall back to their DefaultMinedRelaysWAL* counterpart.
+type MinedRelaysWALConfig struct {
+	// MaxBufferedBytes is the in-memory buffer size above which it is flushed to disk.
+	MaxBufferedBytes int
+	// FlushInterval is the cadence at which the in-memory buffer is flushed to disk.
+	FlushInterval time.Duration
+	// WriteQueueSize is the number of flushed buffers waiting to be written to disk
+	// above which the WAL reports backpressure.
+	WriteQueueSize int
+}
+
+// withDefaults returns a copy of the config whose unset fields are set to their defaults.
+func (config MinedRelaysWALConfig) withDefaults() MinedRelaysWALConfig {
+	if config.MaxBufferedBytes <= 0 {
+		config.MaxBufferedBytes = DefaultMinedRelaysWALMaxBufferedBytes
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultMinedRelaysWALFlushInterval
+	}
+	if config.WriteQueueSize <= 0 {
+		config.WriteQueueSize = DefaultMinedRelaysWALWriteQueueSize
+	}
+	return config
+}
+
 // Internal constants for encoding the on-disk WAL format
 const (
+	// minedRelaysLogMagic identifies a mined relays WAL file which starts with a header.
+	// WAL files written before the header was introduced start directly with an entry.
+	minedRelaysLogMagic = "MRWL"
+
+	// MinedRelaysLogFormatVersion is the version of the WAL format written by this RelayMiner.
+	MinedRelaysLogFormatVersion uint32 = 1
+
+	// MinedRelaysLogLegacyFormatVersion is the version of the WAL files with no header
+	// and no checksums. They are still replayed, then rewritten in the current format.
+	MinedRelaysLogLegacyFormatVersion uint32 = 0
+
+	// minedRelaysLogFormatVersionSizeBytes is the size of the little-endian uint32
+	// format version following the magic in the WAL header.
+	minedRelaysLogFormatVersionSizeBytes = 4
+
+	// minedRelaysLogHeaderSizeBytes is the size of the WAL header (magic || format version).
+	minedRelaysLogHeaderSizeBytes = len(minedRelaysLogMagic) + minedRelaysLogFormatVersionSizeBytes
+
 	// minedRelaysLogRelayPayloadLengthPrefixSizeBytes indicates the size of the
 	// little-endian uint32 prefix that encodes the mined relay payload length.
 	// This allows us to read frames efficiently during replay.
@@ -75,24 +125,40 @@ const (
 	// field that captures the mined relay's weight (compute units). This participates in the
 	// SMST sum and is critical for accurate accounting.
 	minedRelaysLogComputeUnitsFieldSizeBytes = 8
+
+	// minedRelaysLogChecksumSizeBytes is the size of the little-endian uint32 CRC32-C
+	// checksum closing each entry. It detects torn writes and bit rot during replay.
+	minedRelaysLogChecksumSizeBytes = 4
 )
 
+// minedRelaysLogChecksumTable is the CRC32 (Castagnoli) table used to checksum WAL entries.
+var minedRelaysLogChecksumTable = crc32.MakeTable(crc32.Castagnoli)
+
 // **** WAL Encoding Format ****
+// On-disk header (once, at the beginning of the file):
+// - [4 bytes]  Magic:         "MRWL"
+// - [4 bytes]  FormatVersion: uint32, MinedRelaysLogFormatVersion
+//
 // On-disk record layout (per mined relay), in little-endian order:
 // - [4 bytes]  PayloadLength: uint32, number of bytes in RelayPayload
 // - [8 bytes]  ComputeUnits:  uint64, weight of this relay
 // - [N bytes]  RelayHash:     fixed-size hash (protocol.RelayHasherSize)
 // - [L bytes]  RelayPayload:  opaque bytes, length = PayloadLength
+// - [4 bytes]  Checksum:      uint32, CRC32-C of all the preceding fields of the record
 //
 // Example replay loop during recovery:
+// - Read and check the header
 // - Read 4 bytes -> L
 // - Read 8 bytes -> CU
 // - Read N bytes -> H
 // - Read L bytes -> P
+// - Read 4 bytes -> C, stop at the first record whose checksum does not match
 // - trie.Update(H, P, CU)
+//
+// Legacy (version 0) files have no header and no checksum.
 // ****************************
 
-// minedRelaysWriteAheadLog provides an append-only, length-prefixed, write-ahead log (WAL) for mined relays.
+// minedRelaysWriteAheadLog provides an append-only, length-prefixed, checksummed write-ahead log (WAL) for mined relays.
 //
 // Why it exists:
 // - The SMST lives in memory for performance and would be lost on crash or restart
@@ -101,8 +167,8 @@ const (
 // How it works:
 // - Buffer entries in memory to reduce I/O overhead
 // - Flush to disk either:
-//   - Periodically via timer (every minedRelaysLogFlushInterval)
-//   - Immediately when buffer exceeds memory threshold (maxBufferedMinedRelaysBytesBeforeFlush)
+//   - Periodically via timer (every MinedRelaysWALConfig.FlushInterval)
+//   - Immediately when buffer exceeds memory threshold (MinedRelaysWALConfig.MaxBufferedBytes)
 //
 // - On shutdown: flush remaining entries
 // - On restart: replay WAL to rebuild SMST state deterministically
@@ -117,6 +183,9 @@ const (
 type minedRelaysWriteAheadLog struct {
 	logger polylog.Logger
 
+	// config holds the flush thresholds and the write queue size of the WAL.
+	config MinedRelaysWALConfig
+
 	// bufferedLogBytes holds serialized log entries until they are flushed to disk.
 	bufferedLogBytes []byte
 
@@ -126,6 +195,11 @@ type minedRelaysWriteAheadLog struct {
 	// logFile is the append-only file where buffered entries are persisted.
 	logFile *os.File
 
+	// headerWritten indicates whether the WAL header is already on disk.
+	// It is only accessed by the writer goroutine, which writes the header
+	// along with the first flushed entries of an empty WAL file.
+	headerWritten bool
+
 	// flushTicker triggers periodic flushes of the in-memory buffer to disk.
 	flushTicker *time.Ticker
 
@@ -150,20 +224,36 @@ type minedRelaysWriteAheadLog struct {
 // - Keep the returned WAL around for the duration of the session
 // - On clean shutdown, flush; on crash, replay to rebuild the in-memory SMST
 //
+// An existing WAL file is appended to as is: it MUST be in the current format,
+// which ReconstructSMTFromMinedRelaysLog guarantees by compacting it beforehand.
+//
 // Errors indicate we could not open the on-disk log (permissions, full disk, etc.).
-func NewMinedRelaysWriteAheadLog(logFilePath string, logger polylog.Logger) (*minedRelaysWriteAheadLog, error) {
+func NewMinedRelaysWriteAheadLog(
+	logFilePath string,
+	config MinedRelaysWALConfig,
+	logger polylog.Logger,
+) (*minedRelaysWriteAheadLog, error) {
 	logFile, err := os.OpenFile(logFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
 	if err != nil {
 		logger.Error().Err(err).Msg("❌️ Failed to open mined relays WAL file for appending. ❗Check disk space and permissions. ❗Relay evidence may be lost on restart.")
 		return nil, err
 	}
 
+	logFileInfo, err := logFile.Stat()
+	if err != nil {
+		_ = logFile.Close()
+		return nil, err
+	}
+
+	config = config.withDefaults()
 	wal := &minedRelaysWriteAheadLog{
+		config:           config,
 		bufferedLogBytes: []byte{},
 		logFile:          logFile,
+		headerWritten:    logFileInfo.Size() > 0,
 		logger:           logger,
-		flushTicker:      time.NewTicker(minedRelaysLogFlushInterval),
-		writeQueue:       make(chan []byte, minedRelaysWriteQueueSize),
+		flushTicker:      time.NewTicker(config.FlushInterval),
+		writeQueue:       make(chan []byte, config.WriteQueueSize),
 		writerDone:       make(chan struct{}, 1),
 		shutdownCh:       make(chan struct{}, 1),
 	}
@@ -181,7 +271,7 @@ func NewMinedRelaysWriteAheadLog(logFilePath string, logger polylog.Logger) (*mi
 // If the buffer exceeds the configured threshold, it is flushed to disk immediately.
 //
 // What happens (and why it matters for backup):
-//   - Encode a single mined relay (length || compute units || hash || payload)
+//   - Encode a single mined relay (length || compute units || hash || payload || checksum)
 //   - Append to the in-memory buffer for batching (fast path)
 //   - If the buffer is getting large, reset the periodic timer and force a flush
 //     so recent relays are durably recorded on disk in case the process dies
@@ -191,11 +281,11 @@ func (wal *minedRelaysWriteAheadLog) AppendMinedRelay(relayHash []byte, relayPay
 	wal.bufferMu.Lock()
 	wal.bufferedLogBytes = append(wal.bufferedLogBytes, serializedEntry...)
 	bufferSize := len(wal.bufferedLogBytes)
-	shouldFlush := bufferSize > maxBufferedMinedRelaysBytesBeforeFlush
+	shouldFlush := bufferSize > wal.config.MaxBufferedBytes
 	wal.bufferMu.Unlock()
 
 	if shouldFlush {
-		wal.flushTicker.Reset(minedRelaysLogFlushInterval)
+		wal.flushTicker.Reset(wal.config.FlushInterval)
 		if err := wal.flushBufferToDisk(); err != nil {
 			wal.logger.Error().Err(err).Msg("❌️ Failed to flush mined relays WAL buffer to disk after exceeding size threshold")
 		}
@@ -327,10 +417,17 @@ func (wal *minedRelaysWriteAheadLog) startWriter() {
 // physical disk, not just the OS page cache. Without Sync(), a crash immediately after
 // Write() could lose recent entries, defeating the WAL's crash recovery guarantee.
 func (wal *minedRelaysWriteAheadLog) writeToDisk(buffer []byte) {
+	// Prepend the header to the first entries written to an empty WAL file,
+	// so that a WAL file is either empty or starts with a header.
+	if !wal.headerWritten {
+		buffer = append(encodeMinedRelaysLogHeader(), buffer...)
+	}
+
 	if _, err := wal.logFile.Write(buffer); err != nil {
 		wal.logger.Error().Err(err).Msg("❌️ Failed to write mined relays WAL entries to file. ❗Check disk space and permissions. ❗Mined relays may be lost on restart.")
 		return
 	}
+	wal.headerWritten = true
 
 	// Sync to disk to ensure durability (fsync). This is critical for crash recovery.
 	// Without it, writes may sit in OS page cache and never hit disk on abrupt shutdown.
@@ -351,89 +448,300 @@ func (wal *minedRelaysWriteAheadLog) writeToDisk(buffer []byte) {
 // Rebuilding an in-memory SMST backup from the WAL involves:
 // - Open the WAL file that contains all previously mined relays
 // - For each relay, read fields in order and feed them into a fresh, in-memory SMST via Update
-// - Stop on clean EOF; any other read error is logged/returned
+// - Stop on clean EOF or at the first corrupted entry (e.g. torn write after a crash)
+// - Compact the WAL if needed, so that new entries can be appended to it:
+//   - A corrupted WAL is truncated right before its first corrupted entry
+//   - A legacy WAL is rewritten in the current format
+//
 // - The resulting trie represents the exact pre-crash state (deterministic replay)
 //
-// Returns the reconstructed SMST or an error if replay fails
+// Returns the reconstructed SMST or an error if the WAL cannot be read or compacted.
 func ReconstructSMTFromMinedRelaysLog(
 	minedRelaysLogFilePath string,
 	treeStore kvstore.MapStore,
 	logger polylog.Logger,
 ) (*smt.SMST, error) {
+	// Create a new in-memory SMST backed by a SimpleMap and populate it by replaying the WAL
+	// TODO_TECHDEBT(#446): Centralize the configuration for the SMT spec by finding
+	// all smt.NewSparseMerkleSumTrie() calls and unifying the configuration.
+	trie := smt.NewSparseMerkleSumTrie(treeStore, protocol.NewTrieHasher(), protocol.SMTValueHasher())
+
+	// Update the SMST with the mined relays in the same order they were originally added
+	// This is critical for deterministic replay and correct proof generation.
+	report, err := ScanMinedRelaysLog(
+		minedRelaysLogFilePath,
+		func(relayHash, relayPayload []byte, computeUnits uint64) error {
+			return trie.Update(relayHash, relayPayload, computeUnits)
+		},
+	)
+	if err != nil {
+		logger.Error().Err(err).Msg("❌️ Failed to replay mined relays WAL file.")
+		return nil, err
+	}
+
+	if report.CorruptionErr != nil {
+		logger.Warn().
+			Err(report.CorruptionErr).
+			Uint64("num_valid_entries", report.NumEntries).
+			Int64("valid_size_bytes", report.ValidSizeBytes).
+			Int64("file_size_bytes", report.FileSizeBytes).
+			Msg("⚠️ Mined relays WAL is corrupted, replayed up to its first corrupted entry. ❗Relays after it are lost.")
+	}
+
+	if err := compactMinedRelaysLog(minedRelaysLogFilePath, report); err != nil {
+		logger.Error().Err(err).Msg("❌️ Failed to compact mined relays WAL file.")
+		return nil, err
+	}
+
+	return trie, nil
+}
+
+// MinedRelaysLogReport summarizes the content of a mined relays WAL file.
+type MinedRelaysLogReport struct {
+	// FormatVersion is the version of the WAL format the file is written in.
+	FormatVersion uint32
+	// NumEntries is the number of valid entries, i.e. preceding the first corrupted one.
+	NumEntries uint64
+	// TotalWeight is the sum of the compute units of the valid entries.
+	TotalWeight uint64
+	// ValidSizeBytes is the number of bytes of the file preceding the first corrupted entry.
+	ValidSizeBytes int64
+	// FileSizeBytes is the size of the file.
+	FileSizeBytes int64
+	// CorruptionErr describes the first corrupted entry, if any.
+	CorruptionErr error
+}
+
+// IsCorrupted returns true if the WAL file has a corrupted entry or trailing garbage.
+func (report *MinedRelaysLogReport) IsCorrupted() bool {
+	return report.CorruptionErr != nil
+}
+
+// ScanMinedRelaysLog reads the mined relays WAL file at the given path, calling
+// onEntry for each valid entry in order, until EOF or its first corrupted entry.
+//
+// The file is not modified. A corrupted entry is not an error: it is described by
+// the returned report. Errors are only returned if the file cannot be read or if
+// onEntry fails.
+func ScanMinedRelaysLog(
+	minedRelaysLogFilePath string,
+	onEntry func(relayHash, relayPayload []byte, computeUnits uint64) error,
+) (*MinedRelaysLogReport, error) {
 	file, err := os.Open(minedRelaysLogFilePath)
 	if err != nil {
-		logger.Error().Err(err).Msg("❌️ Failed to open mined relays WAL file for reading.")
 		return nil, err
 	}
 	defer file.Close()
 
-	// Create a new in-memory SMST backed by a SimpleMap and populate it by replaying the WAL
-	// TODO_TECHDEBT(#446): Centralize the configuration for the SMT spec by finding
-	// all smt.NewSparseMerkleSumTrie() calls and unifying the configuration.
-	trie := smt.NewSparseMerkleSumTrie(treeStore, protocol.NewTrieHasher(), protocol.SMTValueHasher())
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &MinedRelaysLogReport{
+		FormatVersion: MinedRelaysLogFormatVersion,
+		FileSizeBytes: fileInfo.Size(),
+	}
 
+	// An empty file is a WAL whose entries have not been flushed yet.
+	if report.FileSizeBytes == 0 {
+		return report, nil
+	}
+
+	reader := bufio.NewReader(file)
+
+	// Files starting with the magic have a header, legacy ones start with an entry.
+	magicBz, err := reader.Peek(len(minedRelaysLogMagic))
+	if err == nil && string(magicBz) == minedRelaysLogMagic {
+		headerBz := make([]byte, minedRelaysLogHeaderSizeBytes)
+		if _, err = io.ReadFull(reader, headerBz); err != nil {
+			report.CorruptionErr = ErrSessionTreeWALCorrupted.Wrapf("truncated header: %v", err)
+			return report, nil
+		}
+
+		report.FormatVersion = binary.LittleEndian.Uint32(headerBz[len(minedRelaysLogMagic):])
+		if report.FormatVersion != MinedRelaysLogFormatVersion {
+			report.CorruptionErr = ErrSessionTreeWALCorrupted.Wrapf("unsupported format version %d", report.FormatVersion)
+			return report, nil
+		}
+		report.ValidSizeBytes = int64(minedRelaysLogHeaderSizeBytes)
+	} else {
+		report.FormatVersion = MinedRelaysLogLegacyFormatVersion
+	}
+
+	hasChecksum := report.FormatVersion != MinedRelaysLogLegacyFormatVersion
 	for {
-		lengthPrefixBz, err := readExactlyNBytes(file, minedRelaysLogRelayPayloadLengthPrefixSizeBytes, logger)
+		entryBz, err := readMinedRelaysLogEntry(reader, report.FileSizeBytes-report.ValidSizeBytes, hasChecksum)
 		// Encountered clean EOF, done reading
 		if err == io.EOF {
-			break
+			return report, nil
 		}
 
-		// Any other read error is logged and returned, the replay has failed
+		// Any other read error means the entry is corrupted, the valid entries stop here.
 		if err != nil {
-			return nil, err
+			report.CorruptionErr = ErrSessionTreeWALCorrupted.Wrapf(
+				"entry %d at offset %d: %v", report.NumEntries, report.ValidSizeBytes, err,
+			)
+			return report, nil
 		}
-		// Parse the mined relay bytes length
-		relayBytesCount := binary.LittleEndian.Uint32(lengthPrefixBz)
 
-		// Read compute units
-		computeUnitsBz, err := readExactlyNBytes(file, minedRelaysLogComputeUnitsFieldSizeBytes, logger)
-		if err != nil {
+		relayHash, relayPayload, computeUnits := decodeMinedRelaysLogEntry(entryBz)
+		if err := onEntry(relayHash, relayPayload, computeUnits); err != nil {
 			return nil, err
 		}
-		computeUnits := binary.LittleEndian.Uint64(computeUnitsBz)
 
-		// Read the mined relay hash
-		relayHashBz, err := readExactlyNBytes(file, minedRelaysLogRelayHashSizeBytes, logger)
-		if err != nil {
-			return nil, err
+		report.NumEntries++
+		report.TotalWeight += computeUnits
+		report.ValidSizeBytes += int64(len(entryBz))
+		if hasChecksum {
+			report.ValidSizeBytes += minedRelaysLogChecksumSizeBytes
 		}
+	}
+}
+
+// FindMinedRelaysLogs returns the paths of the mined relays WAL files at the given path:
+//   - The path itself if it is a file
+//   - The WAL files found, recursively, under the path if it is a directory
+//     (e.g. the RelayMiner smt_store_path)
+func FindMinedRelaysLogs(path string) ([]string, error) {
+	pathInfo, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pathInfo.IsDir() {
+		return []string{path}, nil
+	}
 
-		// Read the mined relay bytes
-		relayPayload, err := readExactlyNBytes(file, int(relayBytesCount), logger)
+	var minedRelaysLogFilePaths []string
+	err = filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
 		if err != nil {
-			return nil, err
+			return err
 		}
+		if !entry.IsDir() && filepath.Ext(filePath) == minedRelaysWALFileExtension {
+			minedRelaysLogFilePaths = append(minedRelaysLogFilePaths, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		// Update the SMST with the mined relay in the same order they were originally added
-		// This is critical for deterministic replay and correct proof generation.
-		if err := trie.Update(relayHashBz, relayPayload, computeUnits); err != nil {
-			return nil, err
+	return minedRelaysLogFilePaths, nil
+}
+
+// compactMinedRelaysLog makes the WAL file described by the given report ready
+// to be appended to, in the current format:
+// - A corrupted WAL is truncated right before its first corrupted entry
+// - A legacy WAL is rewritten, atomically, in the current format
+func compactMinedRelaysLog(minedRelaysLogFilePath string, report *MinedRelaysLogReport) error {
+	if report.FormatVersion == MinedRelaysLogFormatVersion {
+		if !report.IsCorrupted() {
+			return nil
 		}
+		return os.Truncate(minedRelaysLogFilePath, report.ValidSizeBytes)
+	}
 
+	// A WAL with an unsupported format version cannot be rewritten, its entries are unknown.
+	if report.FormatVersion != MinedRelaysLogLegacyFormatVersion {
+		return report.CorruptionErr
 	}
 
-	return trie, nil
+	compactedLogFilePath := minedRelaysLogFilePath + ".compact"
+	compactedLogFile, err := os.OpenFile(compactedLogFilePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(compactedLogFilePath)
+
+	writer := bufio.NewWriter(compactedLogFile)
+	_, err = writer.Write(encodeMinedRelaysLogHeader())
+	if err == nil {
+		_, err = ScanMinedRelaysLog(
+			minedRelaysLogFilePath,
+			func(relayHash, relayPayload []byte, computeUnits uint64) error {
+				_, writeErr := writer.Write(encodeMinedRelaysLogEntry(relayHash, relayPayload, computeUnits))
+				return writeErr
+			},
+		)
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		err = compactedLogFile.Sync()
+	}
+	if closeErr := compactedLogFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(compactedLogFilePath, minedRelaysLogFilePath)
 }
 
-// readExactlyNBytes reads exactly expected bytes or returns an error.
-// - Returns io.EOF if no bytes are read and the file is at EOF
-// - Logs an error when fewer bytes than expected are read for any other error
-func readExactlyNBytes(file *os.File, expected int, logger polylog.Logger) ([]byte, error) {
-	buf := make([]byte, expected)
-	n, err := io.ReadFull(file, buf)
+// readMinedRelaysLogEntry reads the next encoded entry of a WAL, checking its checksum if any.
+// - Returns io.EOF if no bytes are read and the reader is at EOF
+// - Returns an error if the entry is truncated, larger than the remaining bytes or its checksum mismatches
+func readMinedRelaysLogEntry(reader io.Reader, remainingBytes int64, hasChecksum bool) ([]byte, error) {
+	lengthPrefixBz := make([]byte, minedRelaysLogRelayPayloadLengthPrefixSizeBytes)
+	n, err := io.ReadFull(reader, lengthPrefixBz)
 	// Clean EOF, no more data to read
 	if err == io.EOF && n == 0 {
 		return nil, err
 	}
-
-	// Any other error (including EOF with bytes read) is logged and returned
 	if err != nil {
-		logger.Error().Err(err).Int("expected_bytes", expected).Int("read_bytes", n).Msg("❌️ Failed to read from mined relays WAL file.")
-		return nil, err
+		return nil, fmt.Errorf("truncated payload length: %w", err)
 	}
 
-	return buf, nil
+	// Parse the mined relay bytes length and make sure the entry fits in the
+	// remaining bytes before allocating it: a corrupted length could be huge.
+	relayBytesCount := int64(binary.LittleEndian.Uint32(lengthPrefixBz))
+	entryLen := int64(minedRelaysLogRelayPayloadLengthPrefixSizeBytes+minedRelaysLogComputeUnitsFieldSizeBytes+minedRelaysLogRelayHashSizeBytes) + relayBytesCount
+	if hasChecksum {
+		entryLen += minedRelaysLogChecksumSizeBytes
+	}
+	if entryLen > remainingBytes {
+		return nil, fmt.Errorf("entry length %d exceeds the %d remaining bytes", entryLen, remainingBytes)
+	}
+
+	entryBz := make([]byte, entryLen)
+	copy(entryBz, lengthPrefixBz)
+	if _, err = io.ReadFull(reader, entryBz[len(lengthPrefixBz):]); err != nil {
+		return nil, fmt.Errorf("truncated entry: %w", err)
+	}
+
+	if !hasChecksum {
+		return entryBz, nil
+	}
+
+	checksumOffset := len(entryBz) - minedRelaysLogChecksumSizeBytes
+	expectedChecksum := binary.LittleEndian.Uint32(entryBz[checksumOffset:])
+	if checksum := crc32.Checksum(entryBz[:checksumOffset], minedRelaysLogChecksumTable); checksum != expectedChecksum {
+		return nil, fmt.Errorf("checksum mismatch: expected %08x, got %08x", expectedChecksum, checksum)
+	}
+
+	// Strip the checksum so checksummed and legacy entries are decoded the same way.
+	return entryBz[:checksumOffset], nil
+}
+
+// decodeMinedRelaysLogEntry splits a mined relay entry, stripped from its checksum,
+// into its relay hash, relay payload and compute units.
+func decodeMinedRelaysLogEntry(entryBz []byte) (relayHash, relayPayload []byte, computeUnits uint64) {
+	computeUnitsOffset := minedRelaysLogRelayPayloadLengthPrefixSizeBytes
+	relayHashOffset := computeUnitsOffset + minedRelaysLogComputeUnitsFieldSizeBytes
+	relayPayloadOffset := relayHashOffset + minedRelaysLogRelayHashSizeBytes
+
+	computeUnits = binary.LittleEndian.Uint64(entryBz[computeUnitsOffset:relayHashOffset])
+	return entryBz[relayHashOffset:relayPayloadOffset], entryBz[relayPayloadOffset:], computeUnits
+}
+
+// encodeMinedRelaysLogHeader serializes the header written at the beginning of a WAL file.
+func encodeMinedRelaysLogHeader() []byte {
+	header := make([]byte, 0, minedRelaysLogHeaderSizeBytes)
+	header = append(header, minedRelaysLogMagic...)
+	return binary.LittleEndian.AppendUint32(header, MinedRelaysLogFormatVersion)
 }
 
 // encodeMinedRelaysLogEntry serializes a single mined relay in the WAL format.
@@ -444,7 +752,7 @@ func encodeMinedRelaysLogEntry(relayHash []byte, relayPayload []byte, computeUni
 	computeUnitsBz := make([]byte, minedRelaysLogComputeUnitsFieldSizeBytes)
 	binary.LittleEndian.PutUint64(computeUnitsBz, computeUnitsPerRelay)
 
-	totalEntryLen := minedRelaysLogRelayPayloadLengthPrefixSizeBytes + minedRelaysLogComputeUnitsFieldSizeBytes + minedRelaysLogRelayHashSizeBytes + len(relayPayload)
+	totalEntryLen := minedRelaysLogRelayPayloadLengthPrefixSizeBytes + minedRelaysLogComputeUnitsFieldSizeBytes + minedRelaysLogRelayHashSizeBytes + len(relayPayload) + minedRelaysLogChecksumSizeBytes
 	entry := make([]byte, 0, totalEntryLen)
 
 	entry = append(entry, lengthPrefixBz...)
@@ -452,5 +760,6 @@ func encodeMinedRelaysLogEntry(relayHash []byte, relayPayload []byte, computeUni
 	entry = append(entry, relayHash...)
 	entry = append(entry, relayPayload...)
 
-	return entry
+	// The checksum covers all the preceding fields of the entry.
+	return binary.LittleEndian.AppendUint32(entry, crc32.Checksum(entry, minedRelaysLogChecksumTable))
 }
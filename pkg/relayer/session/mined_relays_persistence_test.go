@@ -1,8 +1,10 @@
 package session
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/binary"
+	"hash/crc32"
 	"io"
 	"os"
 	"path/filepath"
@@ -73,7 +75,7 @@ func TestEncodeMinedRelaysLogEntry_Format(t *testing.T) {
 	// Encode the entry
 	entry := encodeMinedRelaysLogEntry(relayHash, relayPayload, computeUnits)
 
-	// Parse back the entry to validate: [payload_len(4)][cu(8)][hash(32)][payload(n)]
+	// Parse back the entry to validate: [payload_len(4)][cu(8)][hash(32)][payload(n)][checksum(4)]
 
 	// Validate payload length
 	payloadLen := binary.LittleEndian.Uint32(entry[:4])
@@ -88,54 +90,74 @@ func TestEncodeMinedRelaysLogEntry_Format(t *testing.T) {
 	require.Equal(t, relayHash, decodedHash)
 
 	// Validate payload
-	decodedPayload := entry[12+len(relayHash):]
+	checksumOffset := len(entry) - 4
+	decodedPayload := entry[12+len(relayHash) : checksumOffset]
 	require.Equal(t, relayPayload, decodedPayload)
-}
-
-func TestReadExactlyNBytes_SuccessAndEOF(t *testing.T) {
-	logger := newTestLogger()
-	tmpFile, err := os.CreateTemp(t.TempDir(), "waltest")
-	require.NoError(t, err)
-	defer tmpFile.Close()
 
-	// Write some content to the temporary file.
-	content := []byte("abcdef")
-	_, err = tmpFile.Write(content)
-	require.NoError(t, err)
-	_, err = tmpFile.Seek(0, io.SeekStart)
-	require.NoError(t, err)
+	// Validate checksum
+	checksum := binary.LittleEndian.Uint32(entry[checksumOffset:])
+	require.Equal(t, crc32.Checksum(entry[:checksumOffset], crc32.MakeTable(crc32.Castagnoli)), checksum)
+}
 
-	// Read first 3 bytes and verify.
-	chunk, err := readExactlyNBytes(tmpFile, 3, logger)
-	require.NoError(t, err)
-	require.Equal(t, []byte("abc"), chunk)
+func TestReadMinedRelaysLogEntry_SuccessAndEOF(t *testing.T) {
+	relayHash := randomBytes(t, 32)
+	relayPayload := randomBytes(t, 10)
+	entry := encodeMinedRelaysLogEntry(relayHash, relayPayload, 3)
+	reader := bytes.NewReader(entry)
 
-	// Read next 3 bytes and verify.
-	chunk, err = readExactlyNBytes(tmpFile, 3, logger)
+	// Read the entry and verify it is returned without its checksum.
+	entryBz, err := readMinedRelaysLogEntry(reader, int64(len(entry)), true)
 	require.NoError(t, err)
-	require.Equal(t, []byte("def"), chunk)
+	decodedHash, decodedPayload, computeUnits := decodeMinedRelaysLogEntry(entryBz)
+	require.Equal(t, relayHash, decodedHash)
+	require.Equal(t, relayPayload, decodedPayload)
+	require.Equal(t, uint64(3), computeUnits)
 
 	// Now further reads should return a clean EOF.
-	_, err = readExactlyNBytes(tmpFile, 1, logger)
+	_, err = readMinedRelaysLogEntry(reader, 0, true)
 	require.ErrorIs(t, err, io.EOF)
 }
 
-func TestReadExactlyNBytes_ShortReadError(t *testing.T) {
-	logger := newTestLogger()
-	tmpFile, err := os.CreateTemp(t.TempDir(), "waltest")
-	require.NoError(t, err)
-	defer tmpFile.Close()
-
-	// Write some content to the temporary file.
-	_, err = tmpFile.Write([]byte{0x01, 0x02})
-	require.NoError(t, err)
-	_, err = tmpFile.Seek(0, io.SeekStart)
-	require.NoError(t, err)
+func TestReadMinedRelaysLogEntry_CorruptedEntries(t *testing.T) {
+	entry := encodeMinedRelaysLogEntry(randomBytes(t, 32), randomBytes(t, 10), 3)
+
+	tests := []struct {
+		desc  string
+		entry []byte
+	}{
+		{
+			desc:  "truncated payload length",
+			entry: entry[:2],
+		},
+		{
+			desc:  "truncated payload",
+			entry: entry[:len(entry)-6],
+		},
+		{
+			desc: "checksum mismatch",
+			entry: func() []byte {
+				corruptedEntry := bytes.Clone(entry)
+				corruptedEntry[20] ^= 0xFF
+				return corruptedEntry
+			}(),
+		},
+		{
+			desc: "payload length exceeding the remaining bytes",
+			entry: func() []byte {
+				corruptedEntry := bytes.Clone(entry)
+				binary.LittleEndian.PutUint32(corruptedEntry, 0xFFFFFFFF)
+				return corruptedEntry
+			}(),
+		},
+	}
 
-	// Request more bytes than present -> should error (not clean EOF because some bytes read).
-	_, err = readExactlyNBytes(tmpFile, 4, logger)
-	require.Error(t, err)
-	require.NotErrorIs(t, err, io.EOF)
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := readMinedRelaysLogEntry(bytes.NewReader(test.entry), int64(len(test.entry)), true)
+			require.Error(t, err)
+			require.NotErrorIs(t, err, io.EOF)
+		})
+	}
 }
 
 func TestReconstructSMTFromMinedRelaysLog_BasicReplay(t *testing.T) {
@@ -274,7 +296,7 @@ func TestMinedRelaysWAL_ThresholdFlush(t *testing.T) {
 	defer cleanup()
 
 	// Create payload larger than the threshold to trigger immediate flush in AppendMinedRelay.
-	bigPayload := make([]byte, maxBufferedMinedRelaysBytesBeforeFlush+1_024)
+	bigPayload := make([]byte, DefaultMinedRelaysWALMaxBufferedBytes+1_024)
 	_, err := rand.Read(bigPayload)
 	require.NoError(t, err)
 
@@ -291,6 +313,172 @@ func TestMinedRelaysWAL_ThresholdFlush(t *testing.T) {
 	require.Equal(t, 0, len(wal.bufferedLogBytes), "expected in-memory buffer to be cleared after flush")
 }
 
+// Test that the configured thresholds override the default ones.
+func TestMinedRelaysWAL_ConfiguredThresholds(t *testing.T) {
+	walPath := filepath.Join(t.TempDir(), tempMinedRelaysWAL)
+	wal, err := NewMinedRelaysWriteAheadLog(walPath, MinedRelaysWALConfig{
+		MaxBufferedBytes: 1_024,
+		FlushInterval:    time.Hour,
+		WriteQueueSize:   1,
+	}, newTestLogger())
+	require.NoError(t, err)
+	defer wal.Close()
+
+	// A small entry stays buffered since the periodic flush is an hour away.
+	wal.AppendMinedRelay(randomBytes(t, 32), randomBytes(t, 16), 1)
+	time.Sleep(50 * time.Millisecond)
+	walFileInfo, err := os.Stat(walPath)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), walFileInfo.Size())
+
+	// Exceeding the configured 1KB buffer size triggers an immediate flush.
+	wal.AppendMinedRelay(randomBytes(t, 32), randomBytes(t, 2_048), 1)
+	require.Eventually(t, func() bool {
+		walFileInfo, err = os.Stat(walPath)
+		return err == nil && walFileInfo.Size() > 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestScanMinedRelaysLog_Report(t *testing.T) {
+	wal, walPath, cleanup := createTempWal(t)
+	defer cleanup()
+
+	for i := range 3 {
+		wal.AppendMinedRelay(randomBytes(t, 32), randomBytes(t, 10), uint64(i+1))
+	}
+	require.NoError(t, wal.Close())
+
+	numScannedEntries := 0
+	report, err := ScanMinedRelaysLog(walPath, func(_, _ []byte, _ uint64) error {
+		numScannedEntries++
+		return nil
+	})
+	require.NoError(t, err)
+	require.False(t, report.IsCorrupted())
+	require.Equal(t, MinedRelaysLogFormatVersion, report.FormatVersion)
+	require.Equal(t, uint64(3), report.NumEntries)
+	require.Equal(t, 3, numScannedEntries)
+	require.Equal(t, uint64(1+2+3), report.TotalWeight)
+	require.Equal(t, report.FileSizeBytes, report.ValidSizeBytes)
+}
+
+func TestReconstructSMTFromMinedRelaysLog_TruncatesTornWrite(t *testing.T) {
+	wal, walPath, cleanup := createTempWal(t)
+	defer cleanup()
+
+	// Build a reference trie with the relays expected to survive the torn write.
+	expectedTrie := smt.NewSparseMerkleSumTrie(simplemap.NewSimpleMap(), protocol.NewTrieHasher(), protocol.SMTValueHasher())
+	for i := range 3 {
+		relayHash := randomBytes(t, 32)
+		relayPayload := randomBytes(t, 10)
+		wal.AppendMinedRelay(relayHash, relayPayload, uint64(i+1))
+		require.NoError(t, expectedTrie.Update(relayHash, relayPayload, uint64(i+1)))
+	}
+	require.NoError(t, wal.Close())
+
+	validFileInfo, err := os.Stat(walPath)
+	require.NoError(t, err)
+
+	// Simulate a torn write: a partial entry appended after the valid ones.
+	tornEntry := encodeMinedRelaysLogEntry(randomBytes(t, 32), randomBytes(t, 10), 4)
+	appendToFile(t, walPath, tornEntry[:len(tornEntry)/2])
+
+	reconstructedTrie, err := ReconstructSMTFromMinedRelaysLog(walPath, simplemap.NewSimpleMap(), newTestLogger())
+	require.NoError(t, err)
+	require.Equal(t, expectedTrie.Root(), reconstructedTrie.Root())
+
+	// The torn entry is truncated so new entries can be appended after the valid ones.
+	truncatedFileInfo, err := os.Stat(walPath)
+	require.NoError(t, err)
+	require.Equal(t, validFileInfo.Size(), truncatedFileInfo.Size())
+}
+
+func TestReconstructSMTFromMinedRelaysLog_StopsAtChecksumMismatch(t *testing.T) {
+	wal, walPath, cleanup := createTempWal(t)
+	defer cleanup()
+
+	for i := range 3 {
+		wal.AppendMinedRelay(randomBytes(t, 32), randomBytes(t, 10), uint64(i+1))
+	}
+	require.NoError(t, wal.Close())
+
+	// Flip a payload byte of the last entry.
+	walBz, err := os.ReadFile(walPath)
+	require.NoError(t, err)
+	walBz[len(walBz)-6] ^= 0xFF
+	require.NoError(t, os.WriteFile(walPath, walBz, 0o644))
+
+	report, err := ScanMinedRelaysLog(walPath, func(_, _ []byte, _ uint64) error { return nil })
+	require.NoError(t, err)
+	require.True(t, report.IsCorrupted())
+	require.ErrorIs(t, report.CorruptionErr, ErrSessionTreeWALCorrupted)
+	require.Equal(t, uint64(2), report.NumEntries)
+	require.Equal(t, uint64(1+2), report.TotalWeight)
+
+	reconstructedTrie, err := ReconstructSMTFromMinedRelaysLog(walPath, simplemap.NewSimpleMap(), newTestLogger())
+	require.NoError(t, err)
+	count, err := smt.MerkleSumRoot(reconstructedTrie.Root()).Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+}
+
+func TestReconstructSMTFromMinedRelaysLog_RewritesLegacyLog(t *testing.T) {
+	walPath := filepath.Join(t.TempDir(), tempMinedRelaysWAL)
+
+	// Write a legacy WAL: no header and no checksums.
+	expectedTrie := smt.NewSparseMerkleSumTrie(simplemap.NewSimpleMap(), protocol.NewTrieHasher(), protocol.SMTValueHasher())
+	for i := range 3 {
+		relayHash := randomBytes(t, 32)
+		relayPayload := randomBytes(t, 10)
+		legacyEntry := encodeMinedRelaysLogEntry(relayHash, relayPayload, uint64(i+1))
+		appendToFile(t, walPath, legacyEntry[:len(legacyEntry)-minedRelaysLogChecksumSizeBytes])
+		require.NoError(t, expectedTrie.Update(relayHash, relayPayload, uint64(i+1)))
+	}
+
+	report, err := ScanMinedRelaysLog(walPath, func(_, _ []byte, _ uint64) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, MinedRelaysLogLegacyFormatVersion, report.FormatVersion)
+	require.Equal(t, uint64(3), report.NumEntries)
+
+	reconstructedTrie, err := ReconstructSMTFromMinedRelaysLog(walPath, simplemap.NewSimpleMap(), newTestLogger())
+	require.NoError(t, err)
+	require.Equal(t, expectedTrie.Root(), reconstructedTrie.Root())
+
+	// The legacy WAL is rewritten in the current format, with all its entries.
+	report, err = ScanMinedRelaysLog(walPath, func(_, _ []byte, _ uint64) error { return nil })
+	require.NoError(t, err)
+	require.False(t, report.IsCorrupted())
+	require.Equal(t, MinedRelaysLogFormatVersion, report.FormatVersion)
+	require.Equal(t, uint64(3), report.NumEntries)
+	require.Equal(t, uint64(1+2+3), report.TotalWeight)
+}
+
+func TestFindMinedRelaysLogs(t *testing.T) {
+	storesDir := t.TempDir()
+	supplierDir := filepath.Join(storesDir, minedRelaysWALDirectoryPath, "supplier1")
+	require.NoError(t, os.MkdirAll(supplierDir, 0o755))
+
+	walPaths := []string{
+		filepath.Join(supplierDir, "session1"+minedRelaysWALFileExtension),
+		filepath.Join(supplierDir, "session2"+minedRelaysWALFileExtension),
+	}
+	for _, walPath := range walPaths {
+		appendToFile(t, walPath, nil)
+	}
+	// Files which are not WALs are ignored.
+	appendToFile(t, filepath.Join(storesDir, "sessions_metadata.db"), nil)
+
+	// A directory is searched recursively for WAL files.
+	foundWALPaths, err := FindMinedRelaysLogs(storesDir)
+	require.NoError(t, err)
+	require.ElementsMatch(t, walPaths, foundWALPaths)
+
+	// A file is returned as is.
+	foundWALPaths, err = FindMinedRelaysLogs(walPaths[0])
+	require.NoError(t, err)
+	require.Equal(t, walPaths[:1], foundWALPaths)
+}
+
 // randomBytes returns a slice of random bytes of the given length.
 func randomBytes(t *testing.T, n int) []byte {
 	b := make([]byte, n)
@@ -308,8 +496,17 @@ func newTestLogger() polylog.Logger {
 func createTempWal(t *testing.T) (*minedRelaysWriteAheadLog, string, func()) {
 	dir := t.TempDir()
 	filePath := filepath.Join(dir, tempMinedRelaysWAL)
-	wal, err := NewMinedRelaysWriteAheadLog(filePath, newTestLogger())
+	wal, err := NewMinedRelaysWriteAheadLog(filePath, MinedRelaysWALConfig{}, newTestLogger())
 	require.NoError(t, err)
 	cleanup := func() { _ = wal.Close(); _ = os.RemoveAll(dir) }
 	return wal, filePath, cleanup
 }
+
+// appendToFile appends the given bytes to the file at the given path, creating it if needed.
+func appendToFile(t *testing.T, filePath string, bz []byte) {
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = file.Write(bz)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+}
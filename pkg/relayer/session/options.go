@@ -18,3 +18,11 @@ func WithDisableSMTPersistence(smtPersistenceDisabled bool) relayer.RelayerSessi
 		relSessionMgr.(*relayerSessionsManager).smtPersistenceDisabled = smtPersistenceDisabled
 	}
 }
+
+// WithMinedRelaysWALConfig sets the flush thresholds and the write queue size of
+// the write-ahead logs persisting the mined relays of work sessions.
+func WithMinedRelaysWALConfig(minedRelaysWALConfig MinedRelaysWALConfig) relayer.RelayerSessionsManagerOption {
+	return func(relSessionMgr relayer.RelayerSessionsManager) {
+		relSessionMgr.(*relayerSessionsManager).minedRelaysWALConfig = minedRelaysWALConfig
+	}
+}
@@ -162,7 +162,7 @@ func (rs *relayerSessionsManager) loadSessionTreeMap(ctx context.Context, height
 		// Scenarios 2: The claim window is still open.
 		// The session has still a chance to reach settlement by creating the claim and submitting the proof.
 		minedRelaysWALDirectoryPath := filepath.Join(rs.storesDirectoryPath, minedRelaysWALDirectoryPath)
-		sessionTree, treeErr := importSessionTree(sessionLogger, sessionSMT, claim, minedRelaysWALDirectoryPath)
+		sessionTree, treeErr := importSessionTree(sessionLogger, sessionSMT, claim, minedRelaysWALDirectoryPath, rs.minedRelaysWALConfig)
 		if treeErr != nil {
 			sessionLogger.Error().Err(treeErr).Msg("failed to import session tree")
 			continue
@@ -56,8 +56,13 @@ func (rs *relayerSessionsManager) submitProofs(
 
 	logging.LogErrors(ctx, filter.EitherError(ctx, eitherProvenSessionsObs))
 
-	// Delete failed session trees so they don't get proven again.
-	channel.ForEach(ctx, failedSubmitProofsSessionsObs, rs.deleteSessionTrees)
+	// Keep failed session trees so their proofs can be retried (see RetrySession).
+	// They are deleted once their proof window closes, like any other expired
+	// session tree.
+	channel.ForEach(
+		ctx, failedSubmitProofsSessionsObs,
+		rs.newMarkSessionTreesFailedFn(relayer.SessionLifecycleStateProofFailed),
+	)
 }
 
 // mapStartAsyncProofProcessing returns a ForEachFn that starts async proof processing
@@ -230,6 +235,8 @@ func (rs *relayerSessionsManager) newMapProveSessionsFn(
 		}
 		proofWindowCloseHeight := sharedtypes.GetProofWindowCloseHeight(sharedParams, sessionEndHeight)
 
+		setSessionTreesLifecycleState(sessionTrees, relayer.SessionLifecycleStateProofPending)
+
 		// Submit proofs for each supplier operator address in `sessionTrees`.
 		if err := supplierClient.SubmitProofs(ctx, proofWindowCloseHeight, proofMsgs...); err != nil {
 			failedSubmitProofSessionsCh <- sessionTrees
@@ -0,0 +1,187 @@
+package session
+
+import (
+	"context"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// sessionsRetryPublishChs are the channels feeding the claim and proof pipelines
+// of a supplier with the sessions whose claim or proof is retried.
+type sessionsRetryPublishChs struct {
+	// claimsPublishCh receives the sessions whose claim creation failed.
+	// They go through the whole claim/proof pipeline again.
+	claimsPublishCh chan<- []relayer.SessionTree
+	// proofsPublishCh receives the claimed sessions whose proof failed.
+	// They only go through the proof pipeline again.
+	proofsPublishCh chan<- []relayer.SessionTree
+}
+
+// startSupplierRetryPipelines starts the claim and proof pipelines which the
+// retried sessions of the given supplier go through, and returns the channels
+// the retried sessions are to be published to.
+// It DOES NOT BLOCK as the pipelines run in their own goroutines.
+func (rs *relayerSessionsManager) startSupplierRetryPipelines(
+	ctx context.Context,
+	supplierClient client.SupplierClient,
+) *sessionsRetryPublishChs {
+	retriedClaimsObs, retriedClaimsPublishCh := channel.NewObservable[[]relayer.SessionTree]()
+	claimedSessionsObs := rs.createClaims(ctx, supplierClient, retriedClaimsObs)
+	rs.submitProofs(ctx, supplierClient, claimedSessionsObs)
+
+	retriedProofsObs, retriedProofsPublishCh := channel.NewObservable[[]relayer.SessionTree]()
+	rs.submitProofs(ctx, supplierClient, retriedProofsObs)
+
+	return &sessionsRetryPublishChs{
+		claimsPublishCh: retriedClaimsPublishCh,
+		proofsPublishCh: retriedProofsPublishCh,
+	}
+}
+
+// RetrySession re-submits the failed claim or proof of the given session.
+//
+// - A session whose claim failed goes through the claim/proof pipeline again.
+// - A session whose proof failed goes through the proof pipeline again.
+//
+// It returns an error if the session is unknown, is not in a failed state, or
+// if its claim (resp. proof) window closes before a retried tx can be committed.
+func (rs *relayerSessionsManager) RetrySession(
+	ctx context.Context,
+	supplierOperatorAddress string,
+	sessionId string,
+) error {
+	logger := rs.logger.With(
+		"method", "RSM.RetrySession",
+		"supplier_operator_address", supplierOperatorAddress,
+		"session_id", sessionId,
+	)
+
+	rs.sessionsTreesMu.Lock()
+	retryPublishChs, ok := rs.sessionsRetryPublishChs[supplierOperatorAddress]
+	if !ok {
+		rs.sessionsTreesMu.Unlock()
+		return ErrSessionSupplierClientNotFound.Wrapf("supplier operator address %q", supplierOperatorAddress)
+	}
+
+	sessionTree, ok := rs.findSessionTree(supplierOperatorAddress, sessionId)
+	if !ok {
+		rs.sessionsTreesMu.Unlock()
+		return ErrSessionTreeNotFound.Wrapf("session %q of supplier operator %q", sessionId, supplierOperatorAddress)
+	}
+
+	// Move the session out of its failed state while holding the lock, so that
+	// concurrent retries of the same session are rejected.
+	failedState := sessionTree.GetLifecycleState()
+	switch failedState {
+	case relayer.SessionLifecycleStateClaimFailed:
+		sessionTree.SetLifecycleState(relayer.SessionLifecycleStateClaiming)
+	case relayer.SessionLifecycleStateProofFailed:
+		sessionTree.SetLifecycleState(relayer.SessionLifecycleStateClaimed)
+	default:
+		rs.sessionsTreesMu.Unlock()
+		return ErrSessionTreeNotRetryable.Wrapf("session %q is in the %q state", sessionId, failedState)
+	}
+	rs.sessionsTreesMu.Unlock()
+
+	if err := rs.validateSessionRetryWindow(ctx, sessionTree, failedState); err != nil {
+		sessionTree.SetLifecycleState(failedState)
+		return err
+	}
+
+	retryPublishCh := retryPublishChs.proofsPublishCh
+	if failedState == relayer.SessionLifecycleStateClaimFailed {
+		retryPublishCh = retryPublishChs.claimsPublishCh
+	}
+
+	select {
+	case retryPublishCh <- []relayer.SessionTree{sessionTree}:
+	case <-ctx.Done():
+		sessionTree.SetLifecycleState(failedState)
+		return ctx.Err()
+	}
+
+	logger.Info().Msgf("🔁 Retrying the session previously in the %q state", failedState)
+
+	return nil
+}
+
+// validateSessionRetryWindow returns an error if the claim (resp. proof) window
+// of the given session, whose claim (resp. proof) failed, closes before a
+// retried tx can be committed.
+func (rs *relayerSessionsManager) validateSessionRetryWindow(
+	ctx context.Context,
+	sessionTree relayer.SessionTree,
+	failedState relayer.SessionLifecycleState,
+) error {
+	sessionHeader := sessionTree.GetSessionHeader()
+	sessionEndHeight := sessionHeader.GetSessionEndBlockHeight()
+
+	// Window TIMING resolves at the session END height, mirroring the chain.
+	sharedParams, err := rs.sharedQueryClient.GetParamsAtHeight(ctx, sessionEndHeight)
+	if err != nil {
+		return err
+	}
+
+	windowName := "proof"
+	windowCloseHeight := sharedtypes.GetProofWindowCloseHeight(sharedParams, sessionEndHeight)
+	if failedState == relayer.SessionLifecycleStateClaimFailed {
+		windowName = "claim"
+		windowCloseHeight = sharedtypes.GetClaimWindowCloseHeight(sharedParams, sessionEndHeight)
+	}
+
+	// The retried tx is at best committed in the block following the current one.
+	currentHeight := rs.blockClient.LastBlock(ctx).Height()
+	if currentHeight >= windowCloseHeight {
+		return ErrSessionRetryWindowClosed.Wrapf(
+			"%s window of session %q closes at height %d (current height: %d)",
+			windowName, sessionHeader.GetSessionId(), windowCloseHeight, currentHeight,
+		)
+	}
+
+	return nil
+}
+
+// findSessionTree returns the session tree of the given supplier and session ID.
+// It MUST be called while holding sessionsTreesMu.
+func (rs *relayerSessionsManager) findSessionTree(
+	supplierOperatorAddress string,
+	sessionId string,
+) (relayer.SessionTree, bool) {
+	for _, sessionTreesAtHeight := range rs.sessionsTrees[supplierOperatorAddress] {
+		if sessionTree, ok := sessionTreesAtHeight[sessionId]; ok {
+			return sessionTree, true
+		}
+	}
+
+	return nil, false
+}
+
+// newMarkSessionTreesFailedFn returns a ForEachFn that moves the given session
+// trees to the given failed lifecycle state, making them retryable.
+func (rs *relayerSessionsManager) newMarkSessionTreesFailedFn(
+	failedState relayer.SessionLifecycleState,
+) channel.ForEachFn[[]relayer.SessionTree] {
+	return func(ctx context.Context, sessionTrees []relayer.SessionTree) {
+		for _, sessionTree := range sessionTrees {
+			rs.logger.Warn().
+				Str("session_id", sessionTree.GetSessionHeader().GetSessionId()).
+				Str("supplier_operator_address", sessionTree.GetSupplierOperatorAddress()).
+				Msgf("⚠️ Session moved to the %q state. ❗It can be retried with 'pocketd relayminer sessions retry' until its window closes.", failedState)
+
+			sessionTree.SetLifecycleState(failedState)
+		}
+	}
+}
+
+// setSessionTreesLifecycleState sets the lifecycle state of all the given session trees.
+func setSessionTreesLifecycleState(
+	sessionTrees []relayer.SessionTree,
+	state relayer.SessionLifecycleState,
+) {
+	for _, sessionTree := range sessionTrees {
+		sessionTree.SetLifecycleState(state)
+	}
+}
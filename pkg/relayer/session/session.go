@@ -69,6 +69,9 @@ type relayerSessionsManager struct {
 	// smtPersistenceDisabled indicates whether or not to persist the SMT of work sessions to disk.
 	smtPersistenceDisabled bool
 
+	// minedRelaysWALConfig holds the thresholds of the session trees' mined relays WALs.
+	minedRelaysWALConfig MinedRelaysWALConfig
+
 	// sessionSMTStore is a key-value store used to persist the metadata of
 	// sessions created in order to recover the active ones in case of a restart.
 	sessionSMTStore pebble.PebbleKVStore
@@ -89,6 +92,11 @@ type relayerSessionsManager struct {
 	// bankQueryClient is used to query for the bank module parameters.
 	bankQueryClient client.BankQueryClient
 
+	// sessionsRetryPublishChs are the channels, per supplier operator address,
+	// which the sessions whose claim or proof is retried are published to.
+	// It is populated by Start and protected by sessionsTreesMu.
+	sessionsRetryPublishChs map[string]*sessionsRetryPublishChs
+
 	// stopping indicates whether the relayerSessionsManager is in the process of graceful shutdown.
 	//
 	// Why it exists:
@@ -100,6 +108,23 @@ type relayerSessionsManager struct {
 	// - These should NOT trigger deletion.
 	// - This ensures session trees are persisted for recovery after restart.
 	stopping atomic.Bool
+
+	// minedRelaysDrainedCh is closed once the mined relays observable completes
+	// and all of its relays have been inserted in their session trees.
+	// It is nil until Start begins inserting the mined relays and is protected
+	// by sessionsTreesMu.
+	minedRelaysDrainedCh chan struct{}
+
+	// numRelaysInserted is the number of mined relays inserted in their session trees.
+	numRelaysInserted atomic.Uint64
+
+	// numMinedRelaysProcessed is the number of mined relays inserted in their
+	// session trees or failed to be.
+	numMinedRelaysProcessed atomic.Uint64
+
+	// numRelaysInsertedAtStopping is the value of numRelaysInserted when the
+	// manager started stopping, used to report the relays persisted while draining.
+	numRelaysInsertedAtStopping atomic.Uint64
 }
 
 // NewRelayerSessions creates a new relayerSessions.
@@ -116,14 +141,16 @@ type relayerSessionsManager struct {
 //
 // Available options:
 //   - WithStoresDirectoryPath
-//   - WithSigningKeyNames
+//   - WithDisableSMTPersistence
+//   - WithMinedRelaysWALConfig
 func NewRelayerSessions(
 	deps depinject.Config,
 	opts ...relayer.RelayerSessionsManagerOption,
 ) (_ relayer.RelayerSessionsManager, err error) {
 	rs := &relayerSessionsManager{
-		sessionsTrees:   make(SessionsTreesMap),
-		sessionsTreesMu: &sync.Mutex{},
+		sessionsTrees:           make(SessionsTreesMap),
+		sessionsTreesMu:         &sync.Mutex{},
+		sessionsRetryPublishChs: make(map[string]*sessionsRetryPublishChs),
 	}
 
 	if err = depinject.Inject(
@@ -204,30 +231,80 @@ func (rs *relayerSessionsManager) Start(ctx context.Context) error {
 	// support for generic types.
 	relayObs := observable.Observable[*relayer.MinedRelay](rs.relayObs)
 
+	// The relays insertion is not canceled with ctx: it completes once relayObs
+	// does, so that the mined relays are still inserted while draining on shutdown.
+	insertionCtx := context.WithoutCancel(ctx)
+
 	// Map eitherMinedRelays to a new observable of an error type which is
 	// notified if an error occurs when attempting to add the relay to the session tree.
-	miningErrorsObs := channel.Map(ctx, relayObs, rs.mapAddMinedRelayToSessionTree)
-	logging.LogErrors(ctx, miningErrorsObs)
+	miningErrorsObs := channel.Map(insertionCtx, relayObs, rs.mapAddMinedRelayToSessionTree)
+	logging.LogErrors(insertionCtx, miningErrorsObs)
+
+	// miningErrorsObs completes once all the relays of relayObs are processed.
+	minedRelaysDrainedCh := make(chan struct{})
+	miningErrorsObserver := miningErrorsObs.Subscribe(insertionCtx)
+	go func() {
+		for range miningErrorsObserver.Ch() {
+		}
+		close(minedRelaysDrainedCh)
+	}()
 
 	// Start claim/proof pipeline for each supplier that is present in the RelayMiner.
+	rs.sessionsTreesMu.Lock()
+	rs.minedRelaysDrainedCh = minedRelaysDrainedCh
 	for supplierOperatorAddress, supplierClient := range rs.supplierClients.SupplierClients {
-		supplierSessionsToClaimObs := rs.supplierSessionsToClaim(ctx, supplierOperatorAddress)
-		claimedSessionsObs := rs.createClaims(ctx, supplierClient, supplierSessionsToClaimObs)
-		rs.submitProofs(ctx, supplierClient, claimedSessionsObs)
+		rs.startSupplierPipelines(ctx, supplierOperatorAddress, supplierClient)
 	}
+	rs.sessionsTreesMu.Unlock()
 
-	// Stop the relayer sessions manager when the context is done.
-	// This is necessary to ensure that during shutdown:
-	//   - All session trees are persisted
-	//   - Their root hashes are preserved
+	// Mark the relayer sessions manager as stopping when the context is done.
+	// The session trees are persisted by Drain (or Stop) once the mining pipeline
+	// is drained, ensuring that during shutdown:
+	//   - The relays still being mined are not lost
+	//   - The claim/proof pipelines cancellations do not delete session trees
 	go func() {
 		<-ctx.Done()
-		rs.Stop()
+		rs.markStopping()
 	}()
 
 	return nil
 }
 
+// AddSupplierClient starts the claim/proof pipeline of a supplier which was not
+// present in the RelayMiner when it started (e.g. its signing key was added by a
+// config reload).
+// It is a no-op if the supplier's claim/proof pipeline is already running.
+func (rs *relayerSessionsManager) AddSupplierClient(ctx context.Context, supplierClient client.SupplierClient) {
+	ctx = context.WithValue(ctx, query.ComponentCtxRelayMinerKey, query.ComponentCtxRelayMinerSessionsManager)
+
+	rs.sessionsTreesMu.Lock()
+	defer rs.sessionsTreesMu.Unlock()
+
+	supplierOperatorAddress := supplierClient.OperatorAddress()
+	rs.supplierClients.SupplierClients[supplierOperatorAddress] = supplierClient
+	rs.startSupplierPipelines(ctx, supplierOperatorAddress, supplierClient)
+}
+
+// startSupplierPipelines starts the claim/proof pipeline of the given supplier,
+// along with the pipelines which its sessions retried by the operator go through.
+// It is a no-op if they are already running.
+// It MUST be called while holding sessionsTreesMu.
+func (rs *relayerSessionsManager) startSupplierPipelines(
+	ctx context.Context,
+	supplierOperatorAddress string,
+	supplierClient client.SupplierClient,
+) {
+	if _, ok := rs.sessionsRetryPublishChs[supplierOperatorAddress]; ok {
+		return
+	}
+
+	supplierSessionsToClaimObs := rs.supplierSessionsToClaim(ctx, supplierOperatorAddress)
+	claimedSessionsObs := rs.createClaims(ctx, supplierClient, supplierSessionsToClaimObs)
+	rs.submitProofs(ctx, supplierClient, claimedSessionsObs)
+
+	rs.sessionsRetryPublishChs[supplierOperatorAddress] = rs.startSupplierRetryPipelines(ctx, supplierClient)
+}
+
 // Stop performs a complete shutdown of the relayerSessionsManager by:
 //   - Closing connections and canceling subscriptions
 //   - Persisting all session data to storage
@@ -240,7 +317,7 @@ func (rs *relayerSessionsManager) Stop() {
 	// This ensures:
 	// - Session trees are not deleted during shutdown.
 	// - Data is preserved for recovery on the next startup.
-	rs.stopping.Store(true)
+	rs.markStopping()
 
 	// Close the block client and unsubscribe from all observables to stop receiving events.
 	// Proper shutdown is important for:
@@ -303,6 +380,56 @@ func (rs *relayerSessionsManager) Stop() {
 	rs.logger.Info().Msgf("🧹 Successfully cleared %d session trees from memory during shutdown", numSessionTrees)
 }
 
+// Drain waits for the mined relays observable to complete and for all of its
+// relays to be inserted in their session trees, or for ctx to be done, whichever
+// happens first. It then stops the relayerSessionsManager, flushing every session
+// tree's WAL to disk.
+//
+// It returns the number of mined relays inserted since the manager started
+// stopping and whether the mined relays observable was fully drained.
+func (rs *relayerSessionsManager) Drain(ctx context.Context) (numRelaysPersisted uint64, isDrained bool) {
+	rs.markStopping()
+
+	rs.sessionsTreesMu.Lock()
+	minedRelaysDrainedCh := rs.minedRelaysDrainedCh
+	rs.sessionsTreesMu.Unlock()
+
+	// There is nothing to drain if the mined relays were never inserted
+	// (i.e. Start was not called or failed).
+	if minedRelaysDrainedCh == nil {
+		rs.Stop()
+		return 0, true
+	}
+
+	rs.logger.Info().Msg("⏳ Waiting for the mined relays to be inserted in their session trees before stopping.")
+
+	select {
+	case <-minedRelaysDrainedCh:
+		isDrained = true
+	case <-ctx.Done():
+		rs.logger.Warn().Err(ctx.Err()).Msg("⚠️ Stopping before all the mined relays were inserted in their session trees.")
+	}
+
+	rs.Stop()
+
+	numRelaysPersisted = rs.numRelaysInserted.Load() - rs.numRelaysInsertedAtStopping.Load()
+	return numRelaysPersisted, isDrained
+}
+
+// NumProcessedMinedRelays returns the number of mined relays inserted in their
+// session trees or failed to be since the relayerSessionsManager was created.
+func (rs *relayerSessionsManager) NumProcessedMinedRelays() uint64 {
+	return rs.numMinedRelaysProcessed.Load()
+}
+
+// markStopping marks the relayerSessionsManager as stopping, recording the
+// number of relays inserted so far the first time it is called.
+func (rs *relayerSessionsManager) markStopping() {
+	if rs.stopping.CompareAndSwap(false, true) {
+		rs.numRelaysInsertedAtStopping.Store(rs.numRelaysInserted.Load())
+	}
+}
+
 // SessionsToClaim returns an observable that notifies when sessions are ready to be claimed.
 func (rs *relayerSessionsManager) InsertRelays(relays relayer.MinedRelaysObservable) {
 	rs.relayObs = relays
@@ -343,7 +470,7 @@ func (rs *relayerSessionsManager) ensureSessionTree(
 	// sessionTreeWithSessionId map for the given supplier operator address.
 	if !ok {
 		var err error
-		sessionTree, err = NewSessionTree(rs.logger, sessionHeader, supplierOperatorAddress, rs.storesDirectoryPath, rs.smtPersistenceDisabled)
+		sessionTree, err = NewSessionTree(rs.logger, sessionHeader, supplierOperatorAddress, rs.storesDirectoryPath, rs.smtPersistenceDisabled, rs.minedRelaysWALConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -657,6 +784,8 @@ func (rs *relayerSessionsManager) mapAddMinedRelayToSessionTree(
 	ctx context.Context,
 	relay *relayer.MinedRelay,
 ) (_ error, skip bool) {
+	defer rs.numMinedRelaysProcessed.Add(1)
+
 	// ensure the session tree exists for this relay
 	// TODO_CONSIDERATION: if we get the session header from the response, there
 	// is no possibility that we forgot to hydrate it (i.e. blindly trust the client).
@@ -687,6 +816,7 @@ func (rs *relayerSessionsManager) mapAddMinedRelayToSessionTree(
 		logger.Error().Err(err).Msg("❌️ Failed to update session merkle tree with relay data. ❗Check disk space and permissions. ❗Relay evidence may be lost.")
 		return err, false
 	}
+	rs.numRelaysInserted.Add(1)
 
 	logger.Debug().Msg("⛏️ Successfully added relay to session tree for claim accumulation")
 
@@ -902,8 +1032,6 @@ func sessionSMTFromSessionTree(
 // The snapshot is created while holding the internal sessionsTreesMu lock to
 // ensure the underlying map is not accessed concurrently. The resulting slice
 // can be safely used by callers without additional synchronization.
-//
-// DEV_NOTE: This is used for testing purposes only.
 func (rs *relayerSessionsManager) SessionTreesSnapshots() []relayer.SessionTreeSnapshot {
 	rs.sessionsTreesMu.Lock()
 	defer rs.sessionsTreesMu.Unlock()
@@ -2,6 +2,7 @@ package session_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -54,6 +55,7 @@ type SessionPersistenceTestSuite struct {
 	service                 sharedtypes.Service
 	emptyBlockHash          []byte
 	claimToReturn           *prooftypes.Claim
+	createClaimErr          error
 	createClaimCallCount    int
 	submitProofCallCount    int
 	latestBlockMu           sync.Mutex
@@ -96,6 +98,7 @@ func (s *SessionPersistenceTestSuite) SetupTest() {
 	s.createClaimCallCount = 0
 	s.submitProofCallCount = 0
 	s.claimToReturn = nil
+	s.createClaimErr = nil
 	s.setLatestBlock(nil)
 
 	// Set up temporary directory for session storage
@@ -384,6 +387,86 @@ func (s *SessionPersistenceTestSuite) TestRestartAfterProofWindowClosed() {
 	require.Equal(s.T(), 0, s.submitProofCallCount)
 }
 
+// TestRetryFailedClaim tests that a session whose claim creation failed is kept
+// in the claim_failed state and that its claim can be created again once retried.
+func (s *SessionPersistenceTestSuite) TestRetryFailedClaim() {
+	sessionEndHeight := s.activeSessionHeader.GetSessionEndBlockHeight()
+	sessionId := s.activeSessionHeader.GetSessionId()
+
+	// Make the claim creation fail.
+	s.createClaimErr = errors.New("claim tx failed")
+
+	// Move to the block where the claim window opens (which should trigger claim creation)
+	claimWindowOpenHeight := sharedtypes.GetClaimWindowOpenHeight(&s.sharedParams, sessionEndHeight)
+	s.advanceToBlock(claimWindowOpenHeight)
+
+	// Verify the session tree is kept in the claim_failed state.
+	sessionTree := s.getActiveSessionTree()
+	claimFailed := waitForCondition(
+		s.T(),
+		func() bool { return sessionTree.GetLifecycleState() == relayer.SessionLifecycleStateClaimFailed },
+		20*time.Second,
+		200*time.Millisecond,
+	)
+	require.True(s.T(), claimFailed, "Session should be in the claim_failed state")
+	require.Equal(s.T(), 1, s.createClaimCallCount)
+
+	// Verify unknown sessions cannot be retried.
+	err := s.relayerSessionsManager.RetrySession(s.ctx, s.supplierOperatorAddress, "unknownSessionId")
+	require.ErrorIs(s.T(), err, session.ErrSessionTreeNotFound)
+
+	// Retry the claim, which now succeeds.
+	s.createClaimErr = nil
+	err = s.relayerSessionsManager.RetrySession(s.ctx, s.supplierOperatorAddress, sessionId)
+	require.NoError(s.T(), err)
+
+	claimed := waitForCondition(
+		s.T(),
+		func() bool { return sessionTree.GetLifecycleState() == relayer.SessionLifecycleStateClaimed },
+		20*time.Second,
+		200*time.Millisecond,
+	)
+	require.True(s.T(), claimed, "Session should be claimed after the retry")
+	require.Equal(s.T(), 2, s.createClaimCallCount)
+	require.NotNil(s.T(), s.claimToReturn)
+
+	// Verify sessions which are not in a failed state cannot be retried.
+	err = s.relayerSessionsManager.RetrySession(s.ctx, s.supplierOperatorAddress, sessionId)
+	require.ErrorIs(s.T(), err, session.ErrSessionTreeNotRetryable)
+}
+
+// TestRetryFailedClaimAfterClaimWindowClose tests that the claim of a session
+// cannot be retried once its claim window is closed.
+func (s *SessionPersistenceTestSuite) TestRetryFailedClaimAfterClaimWindowClose() {
+	sessionEndHeight := s.activeSessionHeader.GetSessionEndBlockHeight()
+	sessionId := s.activeSessionHeader.GetSessionId()
+
+	// Make the claim creation fail.
+	s.createClaimErr = errors.New("claim tx failed")
+
+	claimWindowOpenHeight := sharedtypes.GetClaimWindowOpenHeight(&s.sharedParams, sessionEndHeight)
+	s.advanceToBlock(claimWindowOpenHeight)
+
+	sessionTree := s.getActiveSessionTree()
+	claimFailed := waitForCondition(
+		s.T(),
+		func() bool { return sessionTree.GetLifecycleState() == relayer.SessionLifecycleStateClaimFailed },
+		20*time.Second,
+		200*time.Millisecond,
+	)
+	require.True(s.T(), claimFailed, "Session should be in the claim_failed state")
+
+	// Move to the block where the claim window closes.
+	claimWindowCloseHeight := sharedtypes.GetClaimWindowCloseHeight(&s.sharedParams, sessionEndHeight)
+	s.advanceToBlock(claimWindowCloseHeight)
+
+	// Verify the retry is rejected and the session is kept in the claim_failed state.
+	err := s.relayerSessionsManager.RetrySession(s.ctx, s.supplierOperatorAddress, sessionId)
+	require.ErrorIs(s.T(), err, session.ErrSessionRetryWindowClosed)
+	require.Equal(s.T(), relayer.SessionLifecycleStateClaimFailed, sessionTree.GetLifecycleState())
+	require.Equal(s.T(), 1, s.createClaimCallCount)
+}
+
 // TestWALRecoveryAfterMultipleRelays tests that the Write-Ahead Log (WAL) correctly
 // persists and recovers multiple relays after a relayer restart.
 // This validates that the WAL can handle batch persistence and complete recovery
@@ -449,6 +532,94 @@ func (s *SessionPersistenceTestSuite) TestWALRecoveryAfterMultipleRelays() {
 	require.Equal(s.T(), uint64(7), count, "Session tree should have 7 relays total after adding 2 more post-recovery")
 }
 
+// TestDrainPersistsBufferedRelaysOnShutdown simulates a RelayMiner killed (i.e.
+// its context canceled) while relays are being mined, and verifies that every
+// relay accepted before the mined relays observable is closed is persisted.
+func (s *SessionPersistenceTestSuite) TestDrainPersistsBufferedRelaysOnShutdown() {
+	// Use a dedicated mined relays observable since it is closed by this test.
+	mrObs, minedRelaysPublishCh := channel.NewObservable[*relayer.MinedRelay]()
+	s.minedRelaysObs = relayer.MinedRelaysObservable(mrObs)
+	s.minedRelaysPublishCh = minedRelaysPublishCh
+
+	s.relayerSessionsManager.Stop()
+	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
+
+	ctx, cancelCtx := context.WithCancel(s.ctx)
+	defer cancelCtx()
+	err := s.relayerSessionsManager.Start(ctx)
+	require.NoError(s.T(), err)
+	waitSimulateIO()
+
+	// Publish relays under load, without waiting for them to be inserted.
+	stopPublishingCh := make(chan struct{})
+	publishedCh := make(chan int)
+	go func() {
+		numPublished := 0
+		defer func() { publishedCh <- numPublished }()
+		for {
+			select {
+			case <-stopPublishingCh:
+				return
+			default:
+			}
+			minedRelaysPublishCh <- testrelayer.NewUnsignedMinedRelay(s.T(), s.activeSessionHeader, s.supplierOperatorAddress)
+			numPublished++
+		}
+	}()
+
+	// Kill the relay miner mid-load: the relays published afterwards, until it
+	// stops accepting them, must still be persisted.
+	time.Sleep(50 * time.Millisecond)
+	cancelCtx()
+	time.Sleep(50 * time.Millisecond)
+
+	// Stop accepting relays and let the pipeline drain.
+	close(stopPublishingCh)
+	numPublished := <-publishedCh
+	close(minedRelaysPublishCh)
+	require.Greater(s.T(), numPublished, 0)
+
+	drainCtx, cancelDrainCtx := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancelDrainCtx()
+	numRelaysPersisted, isDrained := s.relayerSessionsManager.Drain(drainCtx)
+	require.True(s.T(), isDrained)
+	require.LessOrEqual(s.T(), numRelaysPersisted, uint64(numPublished))
+
+	// Restart and verify that the relay from the setup and all the published ones were recovered.
+	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
+	err = s.relayerSessionsManager.Start(s.ctx)
+	require.NoError(s.T(), err)
+	waitSimulateIO()
+
+	smstRoot := s.getActiveSessionTree().GetSMSTRoot()
+	count, err := smstRoot.Count()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint64(numPublished+1), count)
+}
+
+// TestDrainStopsAtDeadline verifies that draining gives up when its deadline is
+// reached before the mined relays observable completes, still persisting the
+// relays inserted so far.
+func (s *SessionPersistenceTestSuite) TestDrainStopsAtDeadline() {
+	// Never close the mined relays observable so it cannot be fully drained.
+	drainCtx, cancelDrainCtx := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancelDrainCtx()
+	numRelaysPersisted, isDrained := s.relayerSessionsManager.Drain(drainCtx)
+	require.False(s.T(), isDrained)
+	require.Zero(s.T(), numRelaysPersisted)
+
+	// Restart and verify that the relay from the setup was persisted.
+	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
+	err := s.relayerSessionsManager.Start(s.ctx)
+	require.NoError(s.T(), err)
+	waitSimulateIO()
+
+	smstRoot := s.getActiveSessionTree().GetSMSTRoot()
+	count, err := smstRoot.Count()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint64(1), count)
+}
+
 // getActiveSessionTree retrieves the current active session tree for testing purposes.
 // It navigates through the session trees map structure to find the specific session tree
 // for the active session header and supplier address.
@@ -567,12 +738,15 @@ func (s *SessionPersistenceTestSuite) setupMockSupplierClient(ctrl *gomock.Contr
 		).
 		DoAndReturn(func(ctx context.Context, timeoutHeight int64, claimMsgs ...*prooftypes.MsgCreateClaim) error {
 			require.Len(s.T(), claimMsgs, 1)
+			s.createClaimCallCount++
+			if s.createClaimErr != nil {
+				return s.createClaimErr
+			}
 			s.claimToReturn = &prooftypes.Claim{
 				SupplierOperatorAddress: s.supplierOperatorAddress,
 				SessionHeader:           s.activeSessionHeader,
 				RootHash:                claimMsgs[0].GetRootHash(),
 			}
-			s.createClaimCallCount++
 			return nil
 		}).
 		AnyTimes()
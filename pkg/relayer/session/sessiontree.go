@@ -67,6 +67,9 @@ type sessionTree struct {
 	minedRelaysWAL *minedRelaysWriteAheadLog
 
 	isClaiming bool
+
+	// lifecycleState is the stage of the claim/proof lifecycle the session is at.
+	lifecycleState relayer.SessionLifecycleState
 }
 
 // NewSessionTree creates a new sessionTree from a Session and a storePrefix. It also takes a function
@@ -78,6 +81,7 @@ func NewSessionTree(
 	supplierOperatorAddress string,
 	storesDirectoryPath string,
 	smtPersistenceDisabled bool,
+	minedRelaysWALConfig MinedRelaysWALConfig,
 ) (relayer.SessionTree, error) {
 	logger = logger.With(
 		"session_id", sessionHeader.SessionId,
@@ -118,7 +122,7 @@ func NewSessionTree(
 
 		logger.Debug().Msgf("📁 Created mined relays WAL directory %q", storeDir)
 
-		if minedRelaysWAL, err = NewMinedRelaysWriteAheadLog(storePath, logger); err != nil {
+		if minedRelaysWAL, err = NewMinedRelaysWriteAheadLog(storePath, minedRelaysWALConfig, logger); err != nil {
 			return nil, err
 		}
 	}
@@ -135,6 +139,7 @@ func NewSessionTree(
 		sessionSMT:              trie,
 		sessionMu:               &sync.Mutex{},
 		supplierOperatorAddress: supplierOperatorAddress,
+		lifecycleState:          relayer.SessionLifecycleStateActive,
 	}
 
 	return sessionTree, nil
@@ -151,6 +156,7 @@ func importSessionTree(
 	sessionSMT *prooftypes.SessionSMT,
 	claim *prooftypes.Claim,
 	storesDirectoryPath string,
+	minedRelaysWALConfig MinedRelaysWALConfig,
 ) (relayer.SessionTree, error) {
 	sessionId := sessionSMT.SessionHeader.SessionId
 	supplierOperatorAddress := sessionSMT.SupplierOperatorAddress
@@ -178,7 +184,7 @@ func importSessionTree(
 	// - This allows the session to continue accepting updates if it hasn't been claimed yet.
 	// - If the session has been claimed, the WAL will not be used anymore, but it is still
 	//   needed to be able to close and delete the session tree properly.
-	minedRelaysWAL, err := NewMinedRelaysWriteAheadLog(storePath, logger)
+	minedRelaysWAL, err := NewMinedRelaysWriteAheadLog(storePath, minedRelaysWALConfig, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +198,7 @@ func importSessionTree(
 		supplierOperatorAddress: supplierOperatorAddress,
 		sessionSMT:              trie,
 		treeStore:               treeStore,
+		lifecycleState:          relayer.SessionLifecycleStateActive,
 	}
 
 	logger = logger.With(
@@ -206,6 +213,7 @@ func importSessionTree(
 	if claim != nil {
 		sessionTree.claimedRoot = claim.RootHash
 		sessionTree.isClaiming = true
+		sessionTree.lifecycleState = relayer.SessionLifecycleStateClaimed
 		logger.Info().Msg("imported a session tree WITH A PREVIOUSLY COMMITTED onchain claim")
 		return sessionTree, nil
 	}
@@ -480,9 +488,24 @@ func (st *sessionTree) StartClaiming() error {
 	}
 
 	st.isClaiming = true
+	st.lifecycleState = relayer.SessionLifecycleStateClaiming
 	return nil
 }
 
+// GetLifecycleState returns the claim/proof lifecycle state of the session tree.
+func (st *sessionTree) GetLifecycleState() relayer.SessionLifecycleState {
+	st.sessionMu.Lock()
+	defer st.sessionMu.Unlock()
+	return st.lifecycleState
+}
+
+// SetLifecycleState updates the claim/proof lifecycle state of the session tree.
+func (st *sessionTree) SetLifecycleState(state relayer.SessionLifecycleState) {
+	st.sessionMu.Lock()
+	defer st.sessionMu.Unlock()
+	st.lifecycleState = state
+}
+
 // GetSupplierOperatorAddress returns a stringified bech32 address of the supplier
 // operator this sessionTree belongs to.
 func (st *sessionTree) GetSupplierOperatorAddress() string {
@@ -298,7 +298,7 @@ func TestSessionTree_WAL_NewSessionTree_CreatesWALDirectory(t *testing.T) {
 	supplierAddr := sample.AccAddressBech32()
 
 	// Create session tree - should create WAL directory
-	sessionTree, err := session.NewSessionTree(logger, sessionHeader, supplierAddr, tmpDir, false)
+	sessionTree, err := session.NewSessionTree(logger, sessionHeader, supplierAddr, tmpDir, false, session.MinedRelaysWALConfig{})
 	require.NoError(t, err)
 	require.NotNil(t, sessionTree)
 
@@ -384,7 +384,7 @@ func TestSessionTree_WAL_LargePayloads(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	// Note: With 1MB payload, the total size exceeds maxBufferedMinedRelaysBytesBeforeFlush (10MB)
+	// Note: With 1MB payload, the total size exceeds DefaultMinedRelaysWALMaxBufferedBytes (10MB)
 	// However, since we need to add multiple large relays to exceed 10MB, let's verify
 	// that Close() properly flushes all the data
 	require.NoError(t, sessionTree.Close())
@@ -392,8 +392,8 @@ func TestSessionTree_WAL_LargePayloads(t *testing.T) {
 	// Verify WAL file exists and has substantial size
 	walFileInfo, err := os.Stat(walPath)
 	require.NoError(t, err)
-	// Expect at least the sum of payload sizes plus overhead (4 + 8 + 32 per relay)
-	minExpectedSize := int64(1024 + 10240 + 102400 + 1048576 + 4*44)
+	// Expect at least the sum of payload sizes plus overhead (4 + 8 + 32 + 4 per relay)
+	minExpectedSize := int64(1024 + 10240 + 102400 + 1048576 + 4*48)
 	require.GreaterOrEqual(t, walFileInfo.Size(), minExpectedSize, "Large payloads should be written to WAL")
 
 	// Verify large payloads are correctly stored by reconstructing the SMT
@@ -415,7 +415,7 @@ func TestSessionTree_WAL_AutoFlushOnSizeThreshold(t *testing.T) {
 	defer cleanup()
 
 	// Add relays with payloads totaling >10MB to trigger auto-flush
-	// maxBufferedMinedRelaysBytesBeforeFlush = 10 * 1024 * 1024 = 10485760 bytes
+	// DefaultMinedRelaysWALMaxBufferedBytes = 10_000_000 bytes
 	// Add 11 relays of 1MB each to exceed the threshold
 	numLargeRelays := 11
 	largePayloadSize := 1024 * 1024 // 1MB
@@ -490,7 +490,7 @@ func createTestSessionTree(t *testing.T) (relayer.SessionTree, string, func()) {
 	}
 	supplierAddr := sample.AccAddressBech32()
 
-	sessionTree, err := session.NewSessionTree(logger, sessionHeader, supplierAddr, tmpDir, false)
+	sessionTree, err := session.NewSessionTree(logger, sessionHeader, supplierAddr, tmpDir, false, session.MinedRelaysWALConfig{})
 	require.NoError(t, err)
 
 	walPath := filepath.Join(tmpDir, minedRelaysWALDirectoryPath, supplierAddr, sessionHeader.SessionId+".wal")
@@ -10,3 +10,32 @@ type MinedRelay struct {
 	Bytes []byte
 	Hash  []byte
 }
+
+// SessionLifecycleState is the stage of the claim/proof lifecycle a session
+// tree is at.
+type SessionLifecycleState string
+
+const (
+	// SessionLifecycleStateActive is the state of a session tree accumulating
+	// the relays served during its session.
+	SessionLifecycleStateActive SessionLifecycleState = "active"
+	// SessionLifecycleStateClaiming is the state of a session tree picked up for
+	// claiming, waiting for its claim window to open.
+	SessionLifecycleStateClaiming SessionLifecycleState = "claiming"
+	// SessionLifecycleStateClaimPending is the state of a session tree whose
+	// claim tx has been broadcast but not committed yet.
+	SessionLifecycleStateClaimPending SessionLifecycleState = "claim_pending"
+	// SessionLifecycleStateClaimed is the state of a session tree whose claim is
+	// committed onchain, waiting for its proof window to open.
+	SessionLifecycleStateClaimed SessionLifecycleState = "claimed"
+	// SessionLifecycleStateProofPending is the state of a session tree whose
+	// proof tx has been broadcast but not committed yet.
+	SessionLifecycleStateProofPending SessionLifecycleState = "proof_pending"
+	// SessionLifecycleStateClaimFailed is the state of a session tree whose claim
+	// could not be created. It can be retried until its claim window closes.
+	SessionLifecycleStateClaimFailed SessionLifecycleState = "claim_failed"
+	// SessionLifecycleStateProofFailed is the state of a session tree whose proof
+	// could not be generated or submitted. It can be retried until its proof
+	// window closes.
+	SessionLifecycleStateProofFailed SessionLifecycleState = "proof_failed"
+)
@@ -0,0 +1,39 @@
+package signer
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ Signer = (*KeyringSigner)(nil)
+
+// KeyringSigner is a signer implementation that delegates the signing of messages
+// to a keyring. Unlike SimpleSigner, it supports the keys whose private key is not
+// stored in the keyring (e.g. the keys held by a remote signing daemon).
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	keyName string
+}
+
+// NewKeyringSigner returns a signer for the key associated with the keyName in
+// the keyring provided.
+// The keys whose private key is stored in the keyring are signed with by a
+// SimpleSigner, the other ones by a KeyringSigner.
+func NewKeyringSigner(kr keyring.Keyring, keyName string) (Signer, error) {
+	info, err := kr.Key(keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.GetLocal() != nil {
+		return NewSimpleSigner(kr, keyName)
+	}
+
+	return &KeyringSigner{keyring: kr, keyName: keyName}, nil
+}
+
+// Sign signs the given message using the KeyringSigner's keyring key.
+func (s *KeyringSigner) Sign(msg [32]byte) (signature []byte, err error) {
+	signature, _, err = s.keyring.Sign(s.keyName, msg[:], signing.SignMode_SIGN_MODE_DIRECT)
+	return signature, err
+}
@@ -0,0 +1,155 @@
+package remote
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/pkg/signer"
+)
+
+// DefaultTimeout is the fallback duration a request to the signing daemon may
+// take before failing.
+const DefaultTimeout = 5 * time.Second
+
+// ClientConfig is the configuration of the connection to a signing daemon.
+type ClientConfig struct {
+	// Address is the address of the signing daemon, either
+	// unix:///<socket_path> or tcp://<host>:<port>.
+	Address string
+	// TLS holds the mutual TLS files required by tcp:// addresses.
+	// It must be empty for unix:// addresses, secured by the socket's permissions.
+	TLS TLSFiles
+	// Timeout bounds the duration of each request to the signing daemon.
+	// It defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Client is the connection to a signing daemon.
+// It is safe for concurrent use.
+type Client struct {
+	address      string
+	timeout      time.Duration
+	conn         *grpc.ClientConn
+	signerClient RemoteSignerClient
+}
+
+// NewClient returns a client of the signing daemon described by the given config.
+// The connection is established lazily, on the first request.
+func NewClient(config ClientConfig) (*Client, error) {
+	addr, err := parseAddress(config.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var transportCredentials credentials.TransportCredentials
+	switch addr.network {
+	case unixScheme:
+		if !config.TLS.IsEmpty() {
+			return nil, ErrRemoteSignerInvalidTLS.Wrapf(
+				"TLS is not supported on the unix socket %q", config.Address,
+			)
+		}
+		transportCredentials = insecure.NewCredentials()
+	default:
+		tlsConfig, tlsErr := newClientTLSConfig(config.TLS)
+		if tlsErr != nil {
+			return nil, tlsErr
+		}
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(
+		addr.grpcTarget(),
+		grpc.WithTransportCredentials(transportCredentials),
+	)
+	if err != nil {
+		return nil, ErrRemoteSignerInvalidAddress.Wrapf("%q: %s", config.Address, err)
+	}
+
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Client{
+		address:      config.Address,
+		timeout:      timeout,
+		conn:         conn,
+		signerClient: NewRemoteSignerClient(conn),
+	}, nil
+}
+
+// PubKey returns the public key of the named signing key.
+func (c *Client) PubKey(ctx context.Context, keyName string) (cryptotypes.PubKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	res, err := c.signerClient.GetPubKey(ctx, &GetPubKeyRequest{KeyName: keyName})
+	if err != nil {
+		return nil, c.wrapError(keyName, err)
+	}
+
+	if len(res.PubKey) != secp256k1.PubKeySize {
+		return nil, ErrRemoteSignerInvalidPubKey.Wrapf(
+			"key %q: expected a %d bytes compressed secp256k1 public key, got %d bytes",
+			keyName, secp256k1.PubKeySize, len(res.PubKey),
+		)
+	}
+
+	return &secp256k1.PubKey{Key: res.PubKey}, nil
+}
+
+// Sign returns the signature of the given bytes by the named signing key.
+// The bytes are hashed with sha256 before being signed, as done by the Cosmos SDK
+// secp256k1 private keys.
+func (c *Client) Sign(ctx context.Context, keyName string, signBytes []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	res, err := c.signerClient.Sign(ctx, &SignRequest{KeyName: keyName, SignBytes: signBytes})
+	if err != nil {
+		return nil, c.wrapError(keyName, err)
+	}
+
+	return res.Signature, nil
+}
+
+// Signer returns a signer.Signer signing with the named signing key.
+func (c *Client) Signer(keyName string) *KeySigner {
+	return &KeySigner{client: c, keyName: keyName}
+}
+
+// Close closes the connection to the signing daemon.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// wrapError returns the error of a failed request for the named key.
+func (c *Client) wrapError(keyName string, err error) error {
+	if status.Code(err) == codes.NotFound {
+		return ErrRemoteSignerUnknownKey.Wrapf("key %q on %q", keyName, c.address)
+	}
+	return ErrRemoteSignerSign.Wrapf("key %q on %q: %s", keyName, c.address, err)
+}
+
+var _ signer.Signer = (*KeySigner)(nil)
+
+// KeySigner is a signer implementation that delegates the signing of messages
+// to one of the keys of a signing daemon.
+type KeySigner struct {
+	client  *Client
+	keyName string
+}
+
+// Sign signs the given message with the KeySigner's remote key.
+func (s *KeySigner) Sign(msg [32]byte) (signature []byte, err error) {
+	return s.client.Sign(context.Background(), s.keyName, msg[:])
+}
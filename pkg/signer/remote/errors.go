@@ -0,0 +1,13 @@
+package remote
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	codespace                      = "remote_signer"
+	ErrRemoteSignerInvalidAddress  = sdkerrors.Register(codespace, 1, "invalid remote signer address")
+	ErrRemoteSignerInvalidTLS      = sdkerrors.Register(codespace, 2, "invalid remote signer TLS configuration")
+	ErrRemoteSignerUnknownKey      = sdkerrors.Register(codespace, 3, "unknown remote signing key")
+	ErrRemoteSignerSign            = sdkerrors.Register(codespace, 4, "remote signer failed to sign")
+	ErrRemoteSignerInvalidPubKey   = sdkerrors.Register(codespace, 5, "invalid public key returned by remote signer")
+	ErrRemoteSignerInvalidKeysFile = sdkerrors.Register(codespace, 6, "invalid remote signer keys file")
+)
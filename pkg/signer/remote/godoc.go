@@ -0,0 +1,14 @@
+// Package remote implements the signing of messages and transactions by an
+// out-of-process signing daemon, so that the signing keys do not have to be
+// stored on the host using them (e.g. the supplier operator keys of a RelayMiner).
+//
+// The daemon is reached over gRPC, either on a Unix socket or on a TCP address
+// secured with mutual TLS. It only holds secp256k1 keys, referenced by name.
+// Its RemoteSigner service is defined in proto/pocket/signer/service.proto.
+//
+// The package provides:
+//   - Client: the connection to a signing daemon
+//   - KeySigner: a signer.Signer signing with one of the daemon's keys
+//   - Keyring: a keyring.Keyring delegating the signing of some keys to daemons
+//   - Server: a reference signing daemon, holding its keys in a file
+package remote
@@ -0,0 +1,183 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ keyring.Keyring = (*Keyring)(nil)
+
+// Keyring is a keyring.Keyring whose remote keys are held by signing daemons,
+// the other keys being held by the keyring it wraps.
+//
+// The remote keys are exposed as offline records (i.e. public key only), and
+// signing with them is delegated to their signing daemon. It allows the
+// components using a keyring (e.g. the tx client signing claims and proofs) to
+// use the remote keys as-is.
+type Keyring struct {
+	keyring.Keyring
+
+	// remoteKeyClients is a map of remote key names to the client of the
+	// signing daemon holding them.
+	remoteKeyClients map[string]*Client
+
+	// remoteRecordsMu protects remoteRecords.
+	remoteRecordsMu sync.Mutex
+	// remoteRecords is a map of remote key names to their offline records.
+	// It caches the public keys fetched from the signing daemons.
+	remoteRecords map[string]*keyring.Record
+}
+
+// NewKeyring returns a Keyring delegating the signing of the keys named in the
+// given map to the signing daemon of their client, and the signing of the other
+// keys to the given keyring.
+func NewKeyring(kr keyring.Keyring, remoteKeyClients map[string]*Client) *Keyring {
+	return &Keyring{
+		Keyring:          kr,
+		remoteKeyClients: remoteKeyClients,
+		remoteRecords:    make(map[string]*keyring.Record, len(remoteKeyClients)),
+	}
+}
+
+// IsRemoteKey returns true if the named key is held by a signing daemon.
+func (kr *Keyring) IsRemoteKey(uid string) bool {
+	_, isRemote := kr.remoteKeyClients[uid]
+	return isRemote
+}
+
+// List returns the records of the wrapped keyring, followed by the ones of the
+// remote keys, which take precedence over the wrapped keys of the same name.
+func (kr *Keyring) List() ([]*keyring.Record, error) {
+	localRecords, err := kr.Keyring.List()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*keyring.Record, 0, len(localRecords)+len(kr.remoteKeyClients))
+	for _, record := range localRecords {
+		if !kr.IsRemoteKey(record.Name) {
+			records = append(records, record)
+		}
+	}
+
+	for keyName := range kr.remoteKeyClients {
+		record, err := kr.remoteRecord(keyName)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// Key returns the record of the named key.
+func (kr *Keyring) Key(uid string) (*keyring.Record, error) {
+	if kr.IsRemoteKey(uid) {
+		return kr.remoteRecord(uid)
+	}
+	return kr.Keyring.Key(uid)
+}
+
+// KeyByAddress returns the record of the key with the given address.
+func (kr *Keyring) KeyByAddress(address cosmostypes.Address) (*keyring.Record, error) {
+	if remoteKeyName, isRemote := kr.remoteKeyNameByAddress(address); isRemote {
+		return kr.remoteRecord(remoteKeyName)
+	}
+	return kr.Keyring.KeyByAddress(address)
+}
+
+// Sign signs the given bytes with the named key.
+func (kr *Keyring) Sign(
+	uid string,
+	msg []byte,
+	signMode signing.SignMode,
+) ([]byte, cryptotypes.PubKey, error) {
+	client, isRemote := kr.remoteKeyClients[uid]
+	if !isRemote {
+		return kr.Keyring.Sign(uid, msg, signMode)
+	}
+
+	record, err := kr.remoteRecord(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signature, err := client.Sign(context.Background(), uid, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signature, pubKey, nil
+}
+
+// SignByAddress signs the given bytes with the key with the given address.
+func (kr *Keyring) SignByAddress(
+	address cosmostypes.Address,
+	msg []byte,
+	signMode signing.SignMode,
+) ([]byte, cryptotypes.PubKey, error) {
+	if remoteKeyName, isRemote := kr.remoteKeyNameByAddress(address); isRemote {
+		return kr.Sign(remoteKeyName, msg, signMode)
+	}
+	return kr.Keyring.SignByAddress(address, msg, signMode)
+}
+
+// remoteKeyNameByAddress returns the name of the remote key with the given
+// address, if any.
+// The remote keys whose signing daemon can't be reached are skipped: they can't
+// be signed with anyway, and it must not prevent looking up the wrapped keys.
+func (kr *Keyring) remoteKeyNameByAddress(address cosmostypes.Address) (string, bool) {
+	for keyName := range kr.remoteKeyClients {
+		record, err := kr.remoteRecord(keyName)
+		if err != nil {
+			continue
+		}
+
+		pubKey, err := record.GetPubKey()
+		if err != nil {
+			continue
+		}
+
+		if bytes.Equal(pubKey.Address(), address.Bytes()) {
+			return keyName, true
+		}
+	}
+
+	return "", false
+}
+
+// remoteRecord returns the offline record of the named remote key, fetching its
+// public key from its signing daemon the first time.
+func (kr *Keyring) remoteRecord(keyName string) (*keyring.Record, error) {
+	kr.remoteRecordsMu.Lock()
+	defer kr.remoteRecordsMu.Unlock()
+
+	if record, ok := kr.remoteRecords[keyName]; ok {
+		return record, nil
+	}
+
+	pubKey, err := kr.remoteKeyClients[keyName].PubKey(context.Background(), keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := keyring.NewOfflineRecord(keyName, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	kr.remoteRecords[keyName] = record
+
+	return record, nil
+}
@@ -0,0 +1,198 @@
+package remote_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/signer"
+	"github.com/pokt-network/poktroll/pkg/signer/remote"
+	"github.com/pokt-network/poktroll/testutil/testclient/testkeyring"
+)
+
+const (
+	remoteKeyName = "remote_supplier"
+	localKeyName  = "local_supplier"
+)
+
+func TestClient_SignAndPubKey(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	client := setupRemoteSigner(t, map[string]cryptotypes.PrivKey{remoteKeyName: privKey})
+	ctx := context.Background()
+
+	pubKey, err := client.PubKey(ctx, remoteKeyName)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().Equals(pubKey))
+
+	msg := sha256.Sum256([]byte("relay response"))
+	signature, err := client.Signer(remoteKeyName).Sign(msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg[:], signature))
+
+	_, err = client.PubKey(ctx, "unknown")
+	require.ErrorIs(t, err, remote.ErrRemoteSignerUnknownKey)
+
+	_, err = client.Sign(ctx, "unknown", msg[:])
+	require.ErrorIs(t, err, remote.ErrRemoteSignerUnknownKey)
+}
+
+func TestKeyring_DelegatesRemoteKeys(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	client := setupRemoteSigner(t, map[string]cryptotypes.PrivKey{remoteKeyName: privKey})
+
+	localKeyring, localRecord := testkeyring.NewTestKeyringWithKey(t, localKeyName)
+	kr := remote.NewKeyring(localKeyring, map[string]*remote.Client{remoteKeyName: client})
+	require.True(t, kr.IsRemoteKey(remoteKeyName))
+	require.False(t, kr.IsRemoteKey(localKeyName))
+
+	// The remote key is exposed as an offline record.
+	remoteRecord, err := kr.Key(remoteKeyName)
+	require.NoError(t, err)
+	require.Nil(t, remoteRecord.GetLocal())
+	remotePubKey, err := remoteRecord.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().Equals(remotePubKey))
+
+	remoteAddress := cosmostypes.AccAddress(remotePubKey.Address())
+	recordByAddress, err := kr.KeyByAddress(remoteAddress)
+	require.NoError(t, err)
+	require.Equal(t, remoteKeyName, recordByAddress.Name)
+
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	// Signing with the remote key is delegated to the signing daemon.
+	signBytes := []byte("claim tx sign bytes")
+	signature, signPubKey, err := kr.SignByAddress(remoteAddress, signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, remotePubKey.Equals(signPubKey))
+	require.True(t, remotePubKey.VerifySignature(signBytes, signature))
+
+	// Signing with the local key is still done by the wrapped keyring.
+	localPubKey, err := localRecord.GetPubKey()
+	require.NoError(t, err)
+	signature, _, err = kr.Sign(localKeyName, signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, localPubKey.VerifySignature(signBytes, signature))
+
+	// The relay signers are selected according to where the key is held.
+	remoteSigner, err := signer.NewKeyringSigner(kr, remoteKeyName)
+	require.NoError(t, err)
+	require.IsType(t, &signer.KeyringSigner{}, remoteSigner)
+
+	localSigner, err := signer.NewKeyringSigner(kr, localKeyName)
+	require.NoError(t, err)
+	require.IsType(t, &signer.SimpleSigner{}, localSigner)
+
+	msg := sha256.Sum256([]byte("relay response"))
+	signature, err = remoteSigner.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, remotePubKey.VerifySignature(msg[:], signature))
+}
+
+func TestLoadKeysFile(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	keysFileContent := []byte(remoteKeyName + ": " + hex.EncodeToString(privKey.Bytes()) + "\n")
+	keysFilePath := filepath.Join(t.TempDir(), "keys.yaml")
+
+	// The keys file must only be accessible by its owner.
+	require.NoError(t, os.WriteFile(keysFilePath, keysFileContent, 0o644))
+	_, err := remote.LoadKeysFile(keysFilePath)
+	require.ErrorIs(t, err, remote.ErrRemoteSignerInvalidKeysFile)
+
+	require.NoError(t, os.Chmod(keysFilePath, 0o600))
+	privKeys, err := remote.LoadKeysFile(keysFilePath)
+	require.NoError(t, err)
+	require.Len(t, privKeys, 1)
+	require.True(t, privKey.Equals(privKeys[remoteKeyName]))
+
+	require.NoError(t, os.WriteFile(keysFilePath, []byte(remoteKeyName+": not_hex\n"), 0o600))
+	_, err = remote.LoadKeysFile(keysFilePath)
+	require.ErrorIs(t, err, remote.ErrRemoteSignerInvalidKeysFile)
+}
+
+func TestNewClient_InvalidConfig(t *testing.T) {
+	_, err := remote.NewClient(remote.ClientConfig{Address: "http://signer.internal:8900"})
+	require.ErrorIs(t, err, remote.ErrRemoteSignerInvalidAddress)
+
+	_, err = remote.NewClient(remote.ClientConfig{Address: "tcp://signer.internal:8900"})
+	require.ErrorIs(t, err, remote.ErrRemoteSignerInvalidTLS)
+
+	_, err = remote.NewClient(remote.ClientConfig{
+		Address: "unix:///run/pocket/signer.sock",
+		TLS:     remote.TLSFiles{CAFile: "ca.crt"},
+	})
+	require.ErrorIs(t, err, remote.ErrRemoteSignerInvalidTLS)
+}
+
+func TestServer_UnixSocketOnlyAccessibleByOwner(t *testing.T) {
+	// Unix socket paths are limited to ~100 bytes, which t.TempDir() may exceed.
+	socketDir, err := os.MkdirTemp("", "signer")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(socketDir) })
+	socketPath := filepath.Join(socketDir, "signer.sock")
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	serveErrCh := make(chan error, 1)
+	go func() {
+		serveErrCh <- remote.NewServer(polyzero.NewLogger(), nil).Serve(ctx, "unix://"+socketPath, remote.TLSFiles{})
+	}()
+	t.Cleanup(func() {
+		cancelCtx()
+		require.NoError(t, <-serveErrCh)
+	})
+
+	var fileInfo os.FileInfo
+	require.Eventually(t, func() bool {
+		fileInfo, err = os.Stat(socketPath)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, os.FileMode(0o600), fileInfo.Mode().Perm())
+}
+
+// setupRemoteSigner serves a signing daemon holding the given keys on a Unix
+// socket for the duration of the test and returns a client connected to it.
+func setupRemoteSigner(t *testing.T, privKeys map[string]cryptotypes.PrivKey) *remote.Client {
+	t.Helper()
+
+	// Unix socket paths are limited to ~100 bytes, which t.TempDir() may exceed.
+	socketDir, err := os.MkdirTemp("", "signer")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(socketDir) })
+	address := "unix://" + filepath.Join(socketDir, "signer.sock")
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	serveErrCh := make(chan error, 1)
+	go func() {
+		serveErrCh <- remote.NewServer(polyzero.NewLogger(), privKeys).Serve(ctx, address, remote.TLSFiles{})
+	}()
+
+	client, err := remote.NewClient(remote.ClientConfig{Address: address})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, client.Close())
+		cancelCtx()
+		require.NoError(t, <-serveErrCh)
+	})
+
+	// Wait for the signing daemon to listen on the socket.
+	require.Eventually(t, func() bool {
+		_, statErr := os.Stat(filepath.Join(socketDir, "signer.sock"))
+		return statErr == nil
+	}, time.Second, 10*time.Millisecond)
+
+	return client
+}
@@ -0,0 +1,206 @@
+package remote
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"net"
+	"os"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+// unixSocketFileMode restricts the access to the signing daemon's Unix socket
+// to the user running it.
+const unixSocketFileMode = 0o600
+
+var _ RemoteSignerServer = (*Server)(nil)
+
+// Server is a reference signing daemon implementation, signing with the
+// secp256k1 private keys loaded from a keys file (see: LoadKeysFile).
+type Server struct {
+	logger polylog.Logger
+
+	// privKeys is a map of key names to the private keys they reference.
+	privKeys map[string]cryptotypes.PrivKey
+}
+
+// NewServer returns a signing daemon signing with the given named private keys.
+func NewServer(logger polylog.Logger, privKeys map[string]cryptotypes.PrivKey) *Server {
+	return &Server{
+		logger:   logger.With("component", "remote_signer"),
+		privKeys: privKeys,
+	}
+}
+
+// GetPubKey returns the public key of the requested key.
+func (s *Server) GetPubKey(_ context.Context, req *GetPubKeyRequest) (*GetPubKeyResponse, error) {
+	privKey, err := s.privKey(req.KeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetPubKeyResponse{PubKey: privKey.PubKey().Bytes()}, nil
+}
+
+// Sign returns the signature of the requested bytes by the requested key.
+func (s *Server) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	privKey, err := s.privKey(req.KeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := privKey.Sign(req.SignBytes)
+	if err != nil {
+		s.logger.Error().Err(err).Str("key_name", req.KeyName).Msg("failed to sign")
+		return nil, status.Errorf(codes.Internal, "signing with key %q: %s", req.KeyName, err)
+	}
+
+	s.logger.Debug().
+		Str("key_name", req.KeyName).
+		Int("num_sign_bytes", len(req.SignBytes)).
+		Msg("signed")
+
+	return &SignResponse{Signature: signature}, nil
+}
+
+// Serve serves the signing API on the given address until the context is done.
+// TCP addresses require the mutual TLS files, which must be empty for Unix
+// socket addresses.
+func (s *Server) Serve(ctx context.Context, listenAddress string, tlsFiles TLSFiles) error {
+	addr, err := parseAddress(listenAddress)
+	if err != nil {
+		return err
+	}
+
+	var serverOpts []grpc.ServerOption
+
+	var listener net.Listener
+	switch addr.network {
+	case unixScheme:
+		if !tlsFiles.IsEmpty() {
+			return ErrRemoteSignerInvalidTLS.Wrapf("TLS is not supported on the unix socket %q", listenAddress)
+		}
+		if listener, err = listenUnixSocket(addr.path); err != nil {
+			return err
+		}
+	default:
+		tlsConfig, tlsErr := newServerTLSConfig(tlsFiles)
+		if tlsErr != nil {
+			return tlsErr
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+
+		if listener, err = net.Listen(addr.network, addr.path); err != nil {
+			return err
+		}
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
+	RegisterRemoteSignerServer(grpcServer, s)
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	s.logger.Info().
+		Str("listen_address", listenAddress).
+		Int("num_keys", len(s.privKeys)).
+		Msg("serving remote signer")
+
+	return grpcServer.Serve(listener)
+}
+
+// privKey returns the private key referenced by the given key name.
+func (s *Server) privKey(keyName string) (cryptotypes.PrivKey, error) {
+	privKey, ok := s.privKeys[keyName]
+	if !ok {
+		s.logger.Warn().Str("key_name", keyName).Msg("unknown key requested")
+		return nil, status.Errorf(codes.NotFound, "unknown key %q", keyName)
+	}
+
+	return privKey, nil
+}
+
+// listenUnixSocket listens on the Unix socket at the given path, only accessible
+// by the current user. A stale socket left by a previous daemon is replaced.
+func listenUnixSocket(socketPath string) (net.Listener, error) {
+	fileInfo, err := os.Lstat(socketPath)
+	switch {
+	case err == nil && fileInfo.Mode().Type() == fs.ModeSocket:
+		if err = os.Remove(socketPath); err != nil {
+			return nil, err
+		}
+	case err == nil:
+		return nil, ErrRemoteSignerInvalidAddress.Wrapf("%q exists and is not a unix socket", socketPath)
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, err
+	}
+
+	// Create the socket with its restricted permissions right away, rather than
+	// restricting them once created, so that no other user can connect to it in
+	// between. The umask is process wide, it is restored as soon as the socket exists.
+	previousUmask := syscall.Umask(^unixSocketFileMode & 0o777)
+	listener, err := net.Listen(unixScheme, socketPath)
+	syscall.Umask(previousUmask)
+	if err != nil {
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// LoadKeysFile loads the named secp256k1 private keys of the given YAML file,
+// mapping each key name to its hex encoded private key, e.g.:
+//
+//	supplier1: 2d00ef074d9b51e46886dc9a1df11e7b986611d0f336bdcf1f0adce3e037ec0a
+//
+// The file must not be accessible by the group nor the others.
+func LoadKeysFile(keysFilePath string) (map[string]cryptotypes.PrivKey, error) {
+	fileInfo, err := os.Stat(keysFilePath)
+	if err != nil {
+		return nil, ErrRemoteSignerInvalidKeysFile.Wrap(err.Error())
+	}
+
+	if fileInfo.Mode().Perm()&0o077 != 0 {
+		return nil, ErrRemoteSignerInvalidKeysFile.Wrapf(
+			"%q is accessible by the group or the others (mode %s), it must only be accessible by its owner",
+			keysFilePath, fileInfo.Mode().Perm(),
+		)
+	}
+
+	keysFileContent, err := os.ReadFile(keysFilePath)
+	if err != nil {
+		return nil, ErrRemoteSignerInvalidKeysFile.Wrap(err.Error())
+	}
+
+	var hexPrivKeys map[string]string
+	if err = yaml.Unmarshal(keysFileContent, &hexPrivKeys); err != nil {
+		return nil, ErrRemoteSignerInvalidKeysFile.Wrapf("unmarshaling %q: %s", keysFilePath, err)
+	}
+
+	privKeys := make(map[string]cryptotypes.PrivKey, len(hexPrivKeys))
+	for keyName, hexPrivKey := range hexPrivKeys {
+		privKeyBz, decodeErr := hex.DecodeString(hexPrivKey)
+		if decodeErr != nil || len(privKeyBz) != secp256k1.PrivKeySize {
+			return nil, ErrRemoteSignerInvalidKeysFile.Wrapf(
+				"key %q is not a hex encoded %d bytes secp256k1 private key",
+				keyName, secp256k1.PrivKeySize,
+			)
+		}
+		privKeys[keyName] = &secp256k1.PrivKey{Key: privKeyBz}
+	}
+
+	return privKeys, nil
+}
@@ -0,0 +1,140 @@
+package remote
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+	"os"
+)
+
+const (
+	// unixScheme is the scheme of the addresses of the signing daemons listening
+	// on a Unix socket (e.g. unix:///run/pocket/signer.sock).
+	unixScheme = "unix"
+	// tcpScheme is the scheme of the addresses of the signing daemons listening
+	// on a TCP address (e.g. tcp://signer.internal:8900), which requires mutual TLS.
+	tcpScheme = "tcp"
+)
+
+// TLSFiles are the PEM encoded files securing the TCP connections between the
+// signing daemon and its clients with mutual TLS.
+type TLSFiles struct {
+	// CAFile is the certificate authority the peer's certificate is verified against.
+	CAFile string
+	// CertFile and KeyFile are the certificate and private key presented to the peer.
+	CertFile string
+	KeyFile  string
+	// ServerName is the name the daemon's certificate is verified against by the
+	// clients. It defaults to the host of the daemon's address.
+	ServerName string
+}
+
+// IsEmpty returns true if none of the TLS files are set.
+func (tlsFiles TLSFiles) IsEmpty() bool {
+	return tlsFiles.CAFile == "" && tlsFiles.CertFile == "" && tlsFiles.KeyFile == ""
+}
+
+// address is a parsed signing daemon address.
+type address struct {
+	// network is the network of the address, as expected by net.Listen.
+	network string
+	// path is the Unix socket path or the TCP host:port of the address.
+	path string
+}
+
+// parseAddress parses the given signing daemon address, which is either a
+// unix:///<socket_path> or a tcp://<host>:<port> URL.
+// TCP addresses require mutual TLS, which is the caller's to check.
+func parseAddress(rawAddress string) (address, error) {
+	addressURL, err := url.Parse(rawAddress)
+	if err != nil {
+		return address{}, ErrRemoteSignerInvalidAddress.Wrapf("%q: %s", rawAddress, err)
+	}
+
+	switch addressURL.Scheme {
+	case unixScheme:
+		if addressURL.Path == "" {
+			return address{}, ErrRemoteSignerInvalidAddress.Wrapf("missing unix socket path in %q", rawAddress)
+		}
+		return address{network: unixScheme, path: addressURL.Path}, nil
+	case tcpScheme:
+		if addressURL.Host == "" || addressURL.Port() == "" {
+			return address{}, ErrRemoteSignerInvalidAddress.Wrapf("missing host or port in %q", rawAddress)
+		}
+		return address{network: tcpScheme, path: addressURL.Host}, nil
+	default:
+		return address{}, ErrRemoteSignerInvalidAddress.Wrapf(
+			"unsupported scheme in %q, expected %q or %q",
+			rawAddress, unixScheme, tcpScheme,
+		)
+	}
+}
+
+// grpcTarget returns the gRPC client target of the address.
+func (addr address) grpcTarget() string {
+	if addr.network == unixScheme {
+		return "unix://" + addr.path
+	}
+	return "dns:///" + addr.path
+}
+
+// newClientTLSConfig returns the TLS config of a client presenting its certificate
+// to the signing daemon and verifying the daemon's one against the CA.
+func newClientTLSConfig(tlsFiles TLSFiles) (*tls.Config, error) {
+	certificate, caCertPool, err := loadTLSFiles(tlsFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS13,
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      caCertPool,
+		ServerName:   tlsFiles.ServerName,
+	}, nil
+}
+
+// newServerTLSConfig returns the TLS config of a signing daemon presenting its
+// certificate to the clients and requiring their certificates to be signed by the CA.
+func newServerTLSConfig(tlsFiles TLSFiles) (*tls.Config, error) {
+	certificate, caCertPool, err := loadTLSFiles(tlsFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS13,
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    caCertPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}, nil
+}
+
+// loadTLSFiles loads the key pair and the CA certificates of the given TLS files,
+// all of which are required for mutual TLS.
+func loadTLSFiles(tlsFiles TLSFiles) (tls.Certificate, *x509.CertPool, error) {
+	if tlsFiles.CAFile == "" || tlsFiles.CertFile == "" || tlsFiles.KeyFile == "" {
+		return tls.Certificate{}, nil, ErrRemoteSignerInvalidTLS.Wrap(
+			"the ca, cert and key files are all required for mutual TLS",
+		)
+	}
+
+	certificate, err := tls.LoadX509KeyPair(tlsFiles.CertFile, tlsFiles.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, ErrRemoteSignerInvalidTLS.Wrapf("loading key pair: %s", err)
+	}
+
+	caCertPEM, err := os.ReadFile(tlsFiles.CAFile)
+	if err != nil {
+		return tls.Certificate{}, nil, ErrRemoteSignerInvalidTLS.Wrapf("reading CA file: %s", err)
+	}
+
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCertPEM) {
+		return tls.Certificate{}, nil, ErrRemoteSignerInvalidTLS.Wrapf(
+			"no PEM encoded certificate found in CA file %q", tlsFiles.CAFile,
+		)
+	}
+
+	return certificate, caCertPool, nil
+}
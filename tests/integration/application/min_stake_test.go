@@ -252,8 +252,7 @@ func (s *applicationMinStakeTestSuite) getExpectedApp(claim *prooftypes.Claim) *
 	require.NoError(s.T(), err)
 
 	globalInflationPerClaim := (*tokenomicskeeper.Keeper)(s.keepers.Keeper).GetParams(s.ctx).GlobalInflationPerClaim
-	globalInflationPerClaimRat, err := encoding.Float64ToRat(globalInflationPerClaim)
-	require.NoError(s.T(), err)
+	globalInflationPerClaimRat := encoding.LegacyDecToRat(globalInflationPerClaim)
 
 	globalInflationAmt := tlm.CalculateGlobalPerClaimMintInflationFromSettlementAmount(expectedBurnCoin, globalInflationPerClaimRat)
 	expectedEndStake := s.appStake.Sub(expectedBurnCoin).Sub(globalInflationAmt)
@@ -343,8 +342,7 @@ func (s *applicationMinStakeTestSuite) assertAppStakeIsReturnedToBalance() {
 	expectedAppBurnCoin := cosmostypes.NewInt64Coin(pocket.DenomuPOKT, int64(expectedAppBurn))
 
 	globalInflationPerClaim := (*tokenomicskeeper.Keeper)(s.keepers.Keeper).GetParams(s.ctx).GlobalInflationPerClaim
-	globalInflationPerClaimRat, err := encoding.Float64ToRat(globalInflationPerClaim)
-	require.NoError(s.T(), err)
+	globalInflationPerClaimRat := encoding.LegacyDecToRat(globalInflationPerClaim)
 
 	globalInflationCoin := tlm.CalculateGlobalPerClaimMintInflationFromSettlementAmount(expectedAppBurnCoin, globalInflationPerClaimRat)
 	expectedAppBalance := s.appStake.Sub(expectedAppBurnCoin).Sub(globalInflationCoin)
@@ -0,0 +1,68 @@
+package token_logic_modules
+
+import (
+	"github.com/stretchr/testify/require"
+
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+// TestSettlementHistory asserts that the results of settled claims are recorded in
+// the settlement history and queryable by supplier, application and service.
+func (s *tokenLogicModuleTestSuite) TestSettlementHistory() {
+	t := s.T()
+	s.setupKeepers(t)
+
+	numClaims := 10
+	s.createClaims(&s.keepers, numClaims)
+	settledResults, _ := s.settleClaims(t)
+
+	// The batched validator rewards result is not associated with a claim and is not recorded.
+	var expectedSettlementResults []tokenomicstypes.ClaimSettlementResult
+	for _, settledResult := range settledResults {
+		if settledResult.Claim.GetSessionHeader() != nil {
+			expectedSettlementResults = append(expectedSettlementResults, *settledResult)
+		}
+	}
+	require.Len(t, expectedSettlementResults, numClaims)
+
+	supplierRes, err := s.keepers.Keeper.AllSettlementResults(s.ctx, &tokenomicstypes.QueryAllSettlementResultsRequest{
+		Filter: &tokenomicstypes.QueryAllSettlementResultsRequest_SupplierOperatorAddress{
+			SupplierOperatorAddress: s.supplier.GetOperatorAddress(),
+		},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, expectedSettlementResults, supplierRes.GetSettlementResults())
+
+	serviceRes, err := s.keepers.Keeper.AllSettlementResults(s.ctx, &tokenomicstypes.QueryAllSettlementResultsRequest{
+		Filter: &tokenomicstypes.QueryAllSettlementResultsRequest_ServiceId{
+			ServiceId: s.service.GetId(),
+		},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, expectedSettlementResults, serviceRes.GetSettlementResults())
+
+	// Every claim is for a distinct application.
+	appRes, err := s.keepers.Keeper.AllSettlementResults(s.ctx, &tokenomicstypes.QueryAllSettlementResultsRequest{
+		Filter: &tokenomicstypes.QueryAllSettlementResultsRequest_ApplicationAddress{
+			ApplicationAddress: expectedSettlementResults[0].GetApplicationAddr(),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSettlementResults[:1], appRes.GetSettlementResults())
+}
+
+// TestSettlementHistory_Disabled asserts that no settlement result is recorded
+// when settlement_history_retention_blocks is 0.
+func (s *tokenLogicModuleTestSuite) TestSettlementHistory_Disabled() {
+	t := s.T()
+	s.setupKeepers(t)
+
+	tokenomicsParams := s.getTokenomicsParams()
+	tokenomicsParams.SettlementHistoryRetentionBlocks = 0
+	require.NoError(t, s.keepers.Keeper.SetParams(s.ctx, *tokenomicsParams))
+
+	s.createClaims(&s.keepers, 10)
+	s.settleClaims(t)
+
+	require.Empty(t, s.keepers.Keeper.GetAllSettlementResults(s.ctx))
+}
@@ -5,6 +5,7 @@ import (
 	"math"
 	"testing"
 
+	cosmosmath "cosmossdk.io/math"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -143,21 +144,21 @@ func (s *tokenLogicModuleTestSuite) getTokenomicsParamsWithCleanValidatorMath()
 	// Set validator allocation to 10% (instead of default 5%) for clean math
 	// This makes total validator rewards = 110 with our test setup, which divides
 	// evenly by stake ratio sum of 11, giving clean results [50, 40, 20]
-	tokenomicsParams.MintAllocationPercentages.Proposer = 0.10
-	tokenomicsParams.MintEqualsBurnClaimDistribution.Proposer = 0.10
+	tokenomicsParams.MintAllocationPercentages.Proposer = cosmosmath.LegacyMustNewDecFromStr("0.10")
+	tokenomicsParams.MintEqualsBurnClaimDistribution.Proposer = cosmosmath.LegacyMustNewDecFromStr("0.10")
 
 	// Adjust other percentages to maintain 100% total
 	// TLMGlobalMint: DAO=0.05, Proposer=0.10, Supplier=0.70, SourceOwner=0.15, Application=0.0
-	tokenomicsParams.MintAllocationPercentages.Dao = 0.05
-	tokenomicsParams.MintAllocationPercentages.Supplier = 0.70
-	tokenomicsParams.MintAllocationPercentages.SourceOwner = 0.15
-	tokenomicsParams.MintAllocationPercentages.Application = 0.0
+	tokenomicsParams.MintAllocationPercentages.Dao = cosmosmath.LegacyMustNewDecFromStr("0.05")
+	tokenomicsParams.MintAllocationPercentages.Supplier = cosmosmath.LegacyMustNewDecFromStr("0.70")
+	tokenomicsParams.MintAllocationPercentages.SourceOwner = cosmosmath.LegacyMustNewDecFromStr("0.15")
+	tokenomicsParams.MintAllocationPercentages.Application = cosmosmath.LegacyZeroDec()
 
 	// TLMRelayBurnEqualsMint: DAO=0.05, Proposer=0.10, Supplier=0.70, SourceOwner=0.15, Application=0.0
-	tokenomicsParams.MintEqualsBurnClaimDistribution.Dao = 0.05
-	tokenomicsParams.MintEqualsBurnClaimDistribution.Supplier = 0.70
-	tokenomicsParams.MintEqualsBurnClaimDistribution.SourceOwner = 0.15
-	tokenomicsParams.MintEqualsBurnClaimDistribution.Application = 0.0
+	tokenomicsParams.MintEqualsBurnClaimDistribution.Dao = cosmosmath.LegacyMustNewDecFromStr("0.05")
+	tokenomicsParams.MintEqualsBurnClaimDistribution.Supplier = cosmosmath.LegacyMustNewDecFromStr("0.70")
+	tokenomicsParams.MintEqualsBurnClaimDistribution.SourceOwner = cosmosmath.LegacyMustNewDecFromStr("0.15")
+	tokenomicsParams.MintEqualsBurnClaimDistribution.Application = cosmosmath.LegacyZeroDec()
 
 	return &tokenomicsParams
 }
@@ -1,6 +1,7 @@
 package suites
 
 import (
+	"cosmossdk.io/math"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/pokt-network/poktroll/app/pocket"
@@ -24,6 +25,7 @@ const (
 	ParamTypeInt64                           ParamType = "int64"
 	ParamTypeUint64                          ParamType = "uint64"
 	ParamTypeFloat64                         ParamType = "float64"
+	ParamTypeLegacyDec                       ParamType = "LegacyDec"
 	ParamTypeString                          ParamType = "string"
 	ParamTypeBytes                           ParamType = "uint8"
 	ParamTypeCoin                            ParamType = "Coin"
@@ -70,6 +72,7 @@ var (
 	ValidProofMissingPenaltyCoin       = cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 500)
 	ValidProofSubmissionFeeCoin        = cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 5000000)
 	ValidProofRequirementThresholdCoin = cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 100)
+	ValidProofMissingPenaltyMaxCoin    = cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 1_000_000_000)
 	ValidActorMinStake                 = cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 100)
 	ValidStakingFee                    = cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 1)
 
@@ -116,10 +119,13 @@ var (
 			QueryParamsResponse:     sessiontypes.QueryParamsResponse{},
 		},
 		ValidParams: sessiontypes.Params{
-			NumSuppliersPerSession: 420,
+			NumSuppliersPerSession:         420,
+			SupplierSelectionMode:          sessiontypes.SupplierSelectionModeStakeWeighted,
+			SupplierSelectionStakeCapUpokt: 1_000_000_000,
 		},
 		ParamTypes: map[ParamType]any{
 			ParamTypeUint64: sessiontypes.MsgUpdateParam_AsUint64{},
+			ParamTypeString: sessiontypes.MsgUpdateParam_AsString{},
 		},
 		DefaultParams:    sessiontypes.DefaultParams(),
 		NewParamClientFn: sessiontypes.NewQueryClient,
@@ -135,12 +141,19 @@ var (
 			QueryParamsResponse:     servicetypes.QueryParamsResponse{},
 		},
 		ValidParams: servicetypes.Params{
-			AddServiceFee:   &ValidAddServiceFeeCoin,
-			TargetNumRelays: servicetypes.DefaultTargetNumRelays,
+			AddServiceFee:                    &ValidAddServiceFeeCoin,
+			TargetNumRelays:                  servicetypes.DefaultTargetNumRelays,
+			RelayMiningDifficultyStrategy:    servicetypes.RelayMiningDifficultyStrategyBoundedEma,
+			RelayMiningDifficultyEmaAlphaBps: 2000,
+			RelayMiningDifficultyDeadbandBps: 1000,
+			RelayMiningDifficultyMaxStepBps:  5000,
+			MinServiceTargetNumRelays:        servicetypes.DefaultMinServiceTargetNumRelays,
+			MaxServiceTargetNumRelays:        servicetypes.DefaultMaxServiceTargetNumRelays,
 		},
 		ParamTypes: map[ParamType]any{
 			ParamTypeCoin:   servicetypes.MsgUpdateParam_AsCoin{},
 			ParamTypeUint64: servicetypes.MsgUpdateParam_AsUint64{},
+			ParamTypeString: servicetypes.MsgUpdateParam_AsString{},
 		},
 		DefaultParams:    servicetypes.DefaultParams(),
 		NewParamClientFn: servicetypes.NewQueryClient,
@@ -220,11 +233,18 @@ var (
 			ProofRequirementThreshold: &ValidProofRequirementThresholdCoin,
 			ProofMissingPenalty:       &ValidProofMissingPenaltyCoin,
 			ProofSubmissionFee:        &ValidProofSubmissionFeeCoin,
+			// The penalty cap MUST be greater than both the valid and the default penalties.
+			ProofMissingPenaltyMode:       prooftypes.ProofMissingPenaltyModeClaimAndStakeProportional,
+			ProofMissingPenaltyClaimRatio: math.LegacyMustNewDecFromStr("0.5"),
+			ProofMissingPenaltyStakeRatio: math.LegacyMustNewDecFromStr("0.01"),
+			ProofMissingPenaltyMax:        &ValidProofMissingPenaltyMaxCoin,
 		},
 		ParamTypes: map[ParamType]any{
-			ParamTypeBytes:   prooftypes.MsgUpdateParam_AsBytes{},
-			ParamTypeFloat64: prooftypes.MsgUpdateParam_AsFloat{},
-			ParamTypeCoin:    prooftypes.MsgUpdateParam_AsCoin{},
+			ParamTypeBytes:     prooftypes.MsgUpdateParam_AsBytes{},
+			ParamTypeFloat64:   prooftypes.MsgUpdateParam_AsFloat{},
+			ParamTypeString:    prooftypes.MsgUpdateParam_AsString{},
+			ParamTypeCoin:      prooftypes.MsgUpdateParam_AsCoin{},
+			ParamTypeLegacyDec: prooftypes.MsgUpdateParam_AsDec{},
 		},
 		DefaultParams:    prooftypes.DefaultParams(),
 		NewParamClientFn: prooftypes.NewQueryClient,
@@ -240,18 +260,19 @@ var (
 			QueryParamsResponse:     tokenomicstypes.QueryParamsResponse{},
 		},
 		ValidParams: tokenomicstypes.Params{
-			MintAllocationPercentages:       tokenomicstypes.DefaultMintAllocationPercentages,
-			DaoRewardAddress:                sample.AccAddressBech32(),
-			GlobalInflationPerClaim:         0.666,
-			MintEqualsBurnClaimDistribution: tokenomicstypes.DefaultMintEqualsBurnClaimDistribution,
-			MintRatio:                       tokenomicstypes.DefaultMintRatio, // PIP-41: deflationary mint mechanism
-			OverservicingBonusMultiplier:    3,                                // distinct from default (1) so the update test observes a change
+			MintAllocationPercentages:        tokenomicstypes.DefaultMintAllocationPercentages,
+			DaoRewardAddress:                 sample.AccAddressBech32(),
+			GlobalInflationPerClaim:          math.LegacyMustNewDecFromStr("0.666"),
+			MintEqualsBurnClaimDistribution:  tokenomicstypes.DefaultMintEqualsBurnClaimDistribution,
+			MintRatio:                        tokenomicstypes.DefaultMintRatio, // PIP-41: deflationary mint mechanism
+			OverservicingBonusMultiplier:     3,                                // distinct from default (1) so the update test observes a change
+			SettlementHistoryRetentionBlocks: 100,
 		},
 		ParamTypes: map[ParamType]any{
 			ParamTypeMintAllocationPercentages:       tokenomicstypes.MsgUpdateParam_AsMintAllocationPercentages{},
 			ParamTypeMintEqualsBurnClaimDistribution: tokenomicstypes.MsgUpdateParam_AsMintEqualsBurnClaimDistribution{},
 			ParamTypeString:                          tokenomicstypes.MsgUpdateParam_AsString{},
-			ParamTypeFloat64:                         tokenomicstypes.MsgUpdateParam_AsFloat{},
+			ParamTypeLegacyDec:                       tokenomicstypes.MsgUpdateParam_AsDec{},
 			ParamTypeUint64:                          tokenomicstypes.MsgUpdateParam_AsUint64{},
 		},
 		DefaultParams:    tokenomicstypes.DefaultParams(),
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"cosmossdk.io/math"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/stretchr/testify/require"
@@ -261,6 +262,11 @@ func (s *ParamsSuite) RunUpdateParamAsSigner(
 		// =~ msg.AsType.AsFloat = paramReflectValue.Interface().(float64)
 		msgAsTypeValue.Elem().FieldByName("AsFloat").Set(paramReflectValue)
 
+	// LegacyDec
+	case ParamTypeLegacyDec:
+		// =~ msg.AsType.AsDec = paramReflectValue.Interface().(math.LegacyDec).String()
+		msgAsTypeValue.Elem().FieldByName("AsDec").SetString(paramReflectValue.Interface().(math.LegacyDec).String())
+
 	// String
 	case ParamTypeString:
 		// =~ msg.AsType.AsString = paramReflectValue.Interface().(string)
@@ -35,6 +35,11 @@ import (
 // WARNING: Using this map may cause issues if running multiple tests in parallel
 var stakedGatewayToUnstakeSessionEndHeightMap = make(map[string]uint64)
 
+// stakedGatewayToPendingTransferMap mocks the transfer status of the gateways in
+// stakedGatewayToUnstakeSessionEndHeightMap.
+// WARNING: Using this map may cause issues if running multiple tests in parallel
+var stakedGatewayToPendingTransferMap = make(map[string]*gatewaytypes.PendingGatewayTransfer)
+
 // ApplicationModuleKeepers is a struct that contains the keepers needed for testing
 // the application module.
 type ApplicationModuleKeepers struct {
@@ -76,6 +81,7 @@ func NewApplicationModuleKeepers(t testing.TB) (ApplicationModuleKeepers, contex
 				Address:                 addr,
 				Stake:                   &stake,
 				UnstakeSessionEndHeight: stakedGatewayToUnstakeSessionEndHeightMap[addr],
+				PendingTransfer:         stakedGatewayToPendingTransferMap[addr],
 			}, true
 		},
 	).AnyTimes()
@@ -88,6 +94,7 @@ func NewApplicationModuleKeepers(t testing.TB) (ApplicationModuleKeepers, contex
 					Address:                 addr,
 					Stake:                   &stake,
 					UnstakeSessionEndHeight: unstakeSessionEndHeight,
+					PendingTransfer:         stakedGatewayToPendingTransferMap[addr],
 				})
 			}
 			return gateways
@@ -103,6 +110,7 @@ func NewApplicationModuleKeepers(t testing.TB) (ApplicationModuleKeepers, contex
 					Address:                 addr,
 					Stake:                   &stake,
 					UnstakeSessionEndHeight: unstakeSessionEndHeight,
+					PendingTransfer:         stakedGatewayToPendingTransferMap[addr],
 				})
 			}
 			return gateways
@@ -177,6 +185,27 @@ func AddGatewayToStakedGatewayMap(t *testing.T, gatewayAddr string, unstakeSessi
 	})
 }
 
+// AddTransferredGatewayToStakedGatewayMap registers a gateway in the test mock map
+// as having been transferred to dstGatewayAddr at the given session end height, in
+// which case it is also unbonding from that height.
+// It cleans up after test completion.
+func AddTransferredGatewayToStakedGatewayMap(
+	t *testing.T,
+	srcGatewayAddr string,
+	dstGatewayAddr string,
+	sessionEndHeight uint64,
+) {
+	t.Helper()
+	AddGatewayToStakedGatewayMap(t, srcGatewayAddr, sessionEndHeight)
+	stakedGatewayToPendingTransferMap[srcGatewayAddr] = &gatewaytypes.PendingGatewayTransfer{
+		DestinationAddress: dstGatewayAddr,
+		SessionEndHeight:   sessionEndHeight,
+	}
+	t.Cleanup(func() {
+		delete(stakedGatewayToPendingTransferMap, srcGatewayAddr)
+	})
+}
+
 // RemoveGatewayFromStakedGatewayMap removes the given gateway address from the
 // staked gateway map for use in the application's mocked gateway keeper
 func RemoveGatewayFromStakedGatewayMap(t *testing.T, gatewayAddr string) {
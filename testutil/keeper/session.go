@@ -126,7 +126,8 @@ var (
 // keeperConfig is a configuration struct to be used during keeper construction
 // to modify its behavior.
 type keeperConfig struct {
-	sharedParams *sharedtypes.Params
+	sharedParams   *sharedtypes.Params
+	supplierKeeper types.SupplierKeeper
 }
 
 // KeeperOptionFn is a function type that sets/updates fields on the keeperConfig.
@@ -140,6 +141,14 @@ func WithSharedModuleParams(sharedParams *sharedtypes.Params) KeeperOptionFn {
 	}
 }
 
+// WithSupplierKeeper returns a KeeperOptionFn that replaces the default supplier
+// keeper mock with the given supplier keeper.
+func WithSupplierKeeper(supplierKeeper types.SupplierKeeper) KeeperOptionFn {
+	return func(c *keeperConfig) {
+		c.supplierKeeper = supplierKeeper
+	}
+}
+
 func SessionKeeper(t testing.TB, opts ...KeeperOptionFn) (keeper.Keeper, context.Context) {
 	t.Helper()
 
@@ -167,6 +176,9 @@ func SessionKeeper(t testing.TB, opts ...KeeperOptionFn) (keeper.Keeper, context
 
 	mockAppKeeper := defaultAppKeeperMock(t)
 	mockSupplierKeeper := defaultSupplierKeeperMock(t)
+	if cfg.supplierKeeper != nil {
+		mockSupplierKeeper = cfg.supplierKeeper
+	}
 
 	sharedParams := sharedtypes.DefaultParams()
 	if cfg.sharedParams != nil {
@@ -283,6 +295,16 @@ func defaultSupplierKeeperMock(t testing.TB) types.SupplierKeeper {
 			return testSupplier, true
 		}).AnyTimes()
 
+	// Mocking the GetSupplierStakeAtHeight function to return the test supplier stake at any height.
+	mockSupplierKeeper.EXPECT().GetSupplierStakeAtHeight(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, operatorAddress string, queryHeight int64) (sdk.Coin, bool) {
+			if operatorAddress != TestSupplierOperatorAddress {
+				return sdk.Coin{}, false
+			}
+
+			return *TestSupplier.Stake, true
+		}).AnyTimes()
+
 	return mockSupplierKeeper
 }
 
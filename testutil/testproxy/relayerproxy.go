@@ -27,7 +27,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/crypto/rings"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
 	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/relayer"
 	"github.com/pokt-network/poktroll/pkg/relayer/config"
@@ -160,6 +162,15 @@ func WithRelayerProxyDependenciesForBlockHeight(
 		sharedQueryClient := testqueryclients.NewTestSharedQueryClient(test.t)
 
 		blockClient := testblock.NewAnyTimeLastBlockBlockClient(test.t, []byte{}, blockHeight)
+		// Websocket bridges subscribe to the committed blocks to stop once their
+		// session ends, which never happens in the tests.
+		blockClient.EXPECT().
+			CommittedBlocksSequence(gomock.Any()).
+			DoAndReturn(func(ctx context.Context) client.BlockReplayObservable {
+				blocksObs, _ := channel.NewReplayObservable[client.Block](ctx, 1)
+				return blocksObs
+			}).
+			AnyTimes()
 		keyring, _ := testkeyring.NewTestKeyringWithKey(test.t, keyName)
 
 		ringClientDeps := depinject.Supply(accountQueryClient, applicationQueryClient, sharedQueryClient)
@@ -12,7 +12,8 @@ import (
 
 // NewMockOneTimeMiner creates a new mock Miner. This mock Miner will expect a
 // call to MinedRelays with the given context and expectedRelayObs args. When
-// that call is made, returnedMinedRelaysObs is returned.
+// that call is made, returnedMinedRelaysObs is returned. It reports no processed
+// relays.
 func NewMockOneTimeMiner(
 	ctx context.Context,
 	t *testing.T,
@@ -30,5 +31,9 @@ func NewMockOneTimeMiner(
 		).
 		Return(returnedMinedRelaysObs).
 		Times(1)
+	minerMock.EXPECT().
+		NumProcessedRelays().
+		AnyTimes().
+		Return(uint64(0), uint64(0))
 	return minerMock
 }
@@ -13,7 +13,8 @@ import (
 // NewMockOneTimeRelayerProxy creates a new mock RelayerProxy that:
 // - Expects a call to ServedRelays with the given context
 // - Returns returnedRelaysObs when ServedRelays is called
-// - Expects one call each to Start, Ping, and Stop with the given context
+// - Expects one call each to Start and Ping with the given context
+// - Expects one call each to Stop and CloseServedRelays when the relay miner is stopped
 func NewMockOneTimeRelayerProxy(
 	ctx context.Context,
 	t *testing.T,
@@ -27,12 +28,23 @@ func NewMockOneTimeRelayerProxy(
 		Start(gomock.Eq(ctx)).
 		Times(1)
 	relayerProxyMock.EXPECT().
-		Stop(gomock.Eq(ctx)).
+		Stop(gomock.Any()).
 		Times(1)
 	relayerProxyMock.EXPECT().
 		ServedRelays().
 		Return(returnedRelaysObs).
 		Times(1)
+	relayerProxyMock.EXPECT().
+		CloseServedRelays().
+		Times(1)
+	relayerProxyMock.EXPECT().
+		NumForwardedServedRelays().
+		AnyTimes().
+		Return(uint64(0))
+	relayerProxyMock.EXPECT().
+		NumDroppedServedRelays().
+		AnyTimes().
+		Return(uint64(0))
 
 	return relayerProxyMock
 }
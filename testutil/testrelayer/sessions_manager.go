@@ -14,7 +14,7 @@ import (
 // This mock RelayerSessionsManager will expect a call to InsertRelays with the
 // given context and expectedMinedRelaysObs args. When that call is made,
 // returnedMinedRelaysObs is returned. It also expects a call to Start with the
-// given context, and stop.
+// given context, and one to Drain when stopped.
 func NewMockOneTimeRelayerSessionsManager(
 	ctx context.Context,
 	t *testing.T,
@@ -35,7 +35,12 @@ func NewMockOneTimeRelayerSessionsManager(
 		AnyTimes().
 		Return(nil)
 	relayerSessionsManagerMock.EXPECT().
-		Stop().
+		Drain(gomock.Any()).
+		Return(uint64(0), true).
 		Times(1)
+	relayerSessionsManagerMock.EXPECT().
+		NumProcessedMinedRelays().
+		AnyTimes().
+		Return(uint64(0))
 	return relayerSessionsManagerMock
 }
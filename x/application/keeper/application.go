@@ -40,6 +40,7 @@ func (k Keeper) SetApplication(ctx context.Context, application types.Applicatio
 	// Index the application in all relevant indexes
 	k.indexApplicationUnstaking(ctx, application)
 	k.indexApplicationTransfer(ctx, application)
+	k.indexApplicationStakeDecrease(ctx, application)
 	k.indexApplicationDelegations(ctx, application)
 	k.indexApplicationUndelegations(ctx, application)
 
@@ -91,12 +92,13 @@ func (k Keeper) GetApplication(
 }
 
 // RemoveApplication deletes an application from the store and all related indexes.
-// - Removes from unstaking, transfer, undelegation, and delegation indexes
+// - Removes from unstaking, transfer, stake decrease, undelegation, and delegation indexes
 // - Deletes from the main application store
 func (k Keeper) RemoveApplication(ctx context.Context, application types.Application) {
 	// Remove the application from all relevant indexes
 	k.removeApplicationUnstakingIndex(ctx, application.Address)
 	k.removeApplicationTransferIndex(ctx, application.Address)
+	k.removeApplicationStakeDecreaseIndex(ctx, application.Address)
 	k.removeApplicationUndelegationIndexes(ctx, application.Address)
 	k.removeApplicationDelegationsIndexes(ctx, application)
 
@@ -166,6 +168,22 @@ func (k Keeper) GetAllTransferringApplicationsIterator(
 	return sharedtypes.NewRecordIterator(transferringAppsIterator, applicationAccessor)
 }
 
+// GetAllStakeDecreasingApplicationsIterator returns an iterator over all applications
+// with pending stake decreases.
+// - Uses stake decreasing applications store as the source of truth
+// - Accesses full application objects via primary key accessor
+func (k Keeper) GetAllStakeDecreasingApplicationsIterator(
+	ctx context.Context,
+) sharedtypes.RecordIterator[types.Application] {
+	stakeDecreaseApplicationsStore := k.getApplicationStakeDecreaseStore(ctx)
+	applicationStore := k.getApplicationStore(ctx)
+
+	stakeDecreasingAppsIterator := storetypes.KVStorePrefixIterator(stakeDecreaseApplicationsStore, []byte{})
+
+	applicationAccessor := applicationFromPrimaryKeyAccessorFn(applicationStore, k.cdc)
+	return sharedtypes.NewRecordIterator(stakeDecreasingAppsIterator, applicationAccessor)
+}
+
 // GetDelegationsIterator returns an iterator for applications delegated to a specific gateway.
 // - Filters delegations by gateway address prefix
 // - Returns only delegations related to the given gateway
@@ -290,6 +308,12 @@ func (k Keeper) getApplicationTransferStore(ctx context.Context) storetypes.KVSt
 	return prefix.NewStore(storeAdapter, types.KeyPrefix(types.ApplicationTransferKeyPrefix))
 }
 
+// getApplicationStakeDecreaseStore returns a prefixed KVStore for applications with pending stake decreases.
+func (k Keeper) getApplicationStakeDecreaseStore(ctx context.Context) storetypes.KVStore {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(types.ApplicationStakeDecreaseKeyPrefix))
+}
+
 // GetAllApplicationsIterator returns a RecordIterator over all Application records.
 // - Uses the main application store and unmarshals each record
 // - Initializes nil fields in each application object
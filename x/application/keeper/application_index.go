@@ -7,6 +7,7 @@ package keeper
 // │───────────────────────────────────────────────────────────────────────────────────────────────│
 // │ applicationUnstakingStore                   AK                               → AK             │
 // │ applicationTransferStore                    AK                               → AK             │
+// │ applicationStakeDecreaseStore               AK                               → AK             │
 // │ delegationStore                             DK (GatewayAddr || AppAddr)      → AK             │
 // │ undelegationStore                           UK (AppAddr   || GatewayAddr)    → undelegationBz │
 // └───────────────────────────────────────────────────────────────────────────────────────────────┘
@@ -25,12 +26,14 @@ package keeper
 //   • Pending transfers       → iterate applicationTransferStore keys.           (②)
 //   • Delegated apps (by GW)  → delegationStore prefix-scan GatewayAddr.         (③)
 //   • Pending undelegations   → undelegationStore prefix-scan AppAddr/Gateway.   (④)
+//   • Stake decreases         → iterate applicationStakeDecreaseStore keys.      (⑤)
 //
 // Index counts
 //   ① Unstaking applications
 //   ② Applications with pending transfers
 //   ③ Application ↔ Gateway delegations
 //   ④ Pending undelegations
+//   ⑤ Applications with pending stake decreases
 
 import (
 	"context"
@@ -87,6 +90,25 @@ func (k Keeper) indexApplicationTransfer(ctx context.Context, app types.Applicat
 	}
 }
 
+// Maintains an index of applications with pending stake decreases.
+//
+// Behavior:
+// - Adds an application to the stake decrease index if it has pending stake decreases
+// - Removes an application from the index if there is no pending stake decrease
+//
+// Purpose:
+// - Enables the EndBlocker to find the stake decreases to unbond efficiently.
+func (k Keeper) indexApplicationStakeDecrease(ctx context.Context, app types.Application) {
+	appStakeDecreaseStore := k.getApplicationStakeDecreaseStore(ctx)
+
+	appKey := types.ApplicationKey(app.Address)
+	if len(app.PendingStakeDecreases) > 0 {
+		appStakeDecreaseStore.Set(appKey, appKey)
+	} else {
+		appStakeDecreaseStore.Delete(appKey)
+	}
+}
+
 // Maintains an index of which applications are delegated to which gateways.
 //
 // Behavior:
@@ -189,6 +211,19 @@ func (k Keeper) removeApplicationTransferIndex(
 	appTransferStore.Delete(appKey)
 }
 
+// Removes an application from the stake decrease index.
+//
+// Usage:
+// - Call when an application is removed or its stake decrease index entry is dangling.
+func (k Keeper) removeApplicationStakeDecreaseIndex(
+	ctx context.Context,
+	applicationAddress string,
+) {
+	appStakeDecreaseStore := k.getApplicationStakeDecreaseStore(ctx)
+	appKey := types.ApplicationKey(applicationAddress)
+	appStakeDecreaseStore.Delete(appKey)
+}
+
 // Removes a specific application-gateway delegation relationship from the delegation index.
 //
 // Usage:
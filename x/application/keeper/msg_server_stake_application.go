@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	cosmoslog "cosmossdk.io/log"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -41,10 +42,14 @@ func (k msgServer) StakeApplication(ctx context.Context, msg *types.MsgStakeAppl
 //   - if the application is found and is not unbonding, it is updated (in memory) according to the msg
 //   - if the application is found and is unbonding, it is updated (in memory; and no longer unbonding)
 //   - additional stake validation (e.g. min stake, etc.)
-//   - the positive difference between the msg stake and any current stake is transferred
+//   - EITHER the positive difference between the msg stake and any current stake is transferred
 //     from the staking application's account, to the application module's accounts.
+//   - OR the negative difference between the msg stake and the current stake is queued as a
+//     pending stake decrease, which is transferred back to the application's account once its
+//     unbonding period has elapsed.
 //   - the (new or updated) application is persisted.
 //   - an EventApplicationUnbondingCanceled event is emitted if the application was unbonding.
+//   - an EventApplicationStakeDecreaseUnbondingBegin event is emitted if the stake was decreased.
 //   - an EventApplicationStaked event is emitted.
 func (k Keeper) StakeApplication(
 	ctx context.Context,
@@ -71,9 +76,13 @@ func (k Keeper) StakeApplication(
 		}
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sessionEndHeight := k.sharedKeeper.GetSessionEndHeight(ctx, sdkCtx.BlockHeight())
+
 	// Check if the application already exists or not
 	var (
 		coinsToEscrow   sdk.Coin
+		stakeDecrease   *sharedtypes.PendingStakeDecrease
 		wasAppUnbonding bool
 	)
 	foundApp, isAppFound := k.GetApplication(ctx, msg.Address)
@@ -88,12 +97,31 @@ func (k Keeper) StakeApplication(
 			logger.Info(fmt.Sprintf("could not update application for address %q due to error %v", msg.Address, err))
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-		coinsToEscrow, err = (*msg.Stake).SafeSub(currAppStake)
-		if err != nil {
-			logger.Info(fmt.Sprintf("could not calculate coins to escrow due to error %v", err))
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+
+		if msg.Stake.IsLT(currAppStake) {
+			// The application is decreasing its stake, queue the difference for unbonding.
+			// It remains escrowed in the application module account until its unbonding
+			// period elapses, backing the claims of the sessions served before the decrease.
+			stakeDecreaseAmount := currAppStake.Sub(*msg.Stake)
+			foundApp.PendingStakeDecreases = sharedtypes.AddPendingStakeDecrease(
+				foundApp.PendingStakeDecreases,
+				stakeDecreaseAmount,
+				uint64(sessionEndHeight),
+			)
+			stakeDecrease = &sharedtypes.PendingStakeDecrease{
+				Amount:           &stakeDecreaseAmount,
+				SessionEndHeight: uint64(sessionEndHeight),
+			}
+			coinsToEscrow = sdk.NewCoin(currAppStake.Denom, math.ZeroInt())
+			logger.Info(fmt.Sprintf("Application is going to unbond %+v coins", stakeDecreaseAmount))
+		} else {
+			coinsToEscrow, err = (*msg.Stake).SafeSub(currAppStake)
+			if err != nil {
+				logger.Info(fmt.Sprintf("could not calculate coins to escrow due to error %v", err))
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			logger.Info(fmt.Sprintf("Application is going to escrow an additional %+v coins", coinsToEscrow))
 		}
-		logger.Info(fmt.Sprintf("Application is going to escrow an additional %+v coins", coinsToEscrow))
 
 		// If the application has initiated an unstake action, cancel it since it is staking again.
 		if foundApp.IsUnbonding() {
@@ -102,8 +130,8 @@ func (k Keeper) StakeApplication(
 		}
 	}
 
-	// MUST ALWAYS stake or upstake (> 0 delta)
-	if coinsToEscrow.IsZero() {
+	// MUST ALWAYS stake, upstake (> 0 delta) or downstake.
+	if coinsToEscrow.IsZero() && stakeDecrease == nil {
 		logger.Warn(fmt.Sprintf("Application %q must escrow more than 0 additional coins", msg.Address))
 		return nil, status.Error(
 			codes.InvalidArgument,
@@ -134,12 +162,14 @@ func (k Keeper) StakeApplication(
 	}
 
 	// Send the coins from the application to the staked application pool
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, appAddress, types.ModuleName, []sdk.Coin{coinsToEscrow})
-	if err != nil {
-		logger.Error(fmt.Sprintf("could not send %v coins from %q to %q module account due to %v", coinsToEscrow, appAddress, types.ModuleName, err))
-		return nil, status.Error(codes.Internal, err.Error())
+	if coinsToEscrow.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, appAddress, types.ModuleName, []sdk.Coin{coinsToEscrow})
+		if err != nil {
+			logger.Error(fmt.Sprintf("could not send %v coins from %q to %q module account due to %v", coinsToEscrow, appAddress, types.ModuleName, err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		logger.Info(fmt.Sprintf("Successfully escrowed %v coins from %q to %q module account", coinsToEscrow, appAddress, types.ModuleName))
 	}
-	logger.Info(fmt.Sprintf("Successfully escrowed %v coins from %q to %q module account", coinsToEscrow, appAddress, types.ModuleName))
 
 	// Update the Application in the store
 	k.SetApplication(ctx, foundApp)
@@ -150,21 +180,32 @@ func (k Keeper) StakeApplication(
 
 	// If application unbonding was canceled, emit the corresponding event.
 	if wasAppUnbonding {
-		sessionEndHeight := k.sharedKeeper.GetSessionEndHeight(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight())
 		events = append(events, &types.EventApplicationUnbondingCanceled{
 			Application:      &foundApp,
 			SessionEndHeight: sessionEndHeight,
 		})
 	}
 
+	// If the application stake was decreased, emit the corresponding event.
+	if stakeDecrease != nil {
+		// Use the shared params effective at the session end height, consistently
+		// with EndBlockerUnbondApplicationStakeDecreases.
+		decreaseParams := k.sharedKeeper.GetParamsAtHeight(ctx, sessionEndHeight)
+		events = append(events, &types.EventApplicationStakeDecreaseUnbondingBegin{
+			ApplicationAddress: foundApp.Address,
+			Amount:             stakeDecrease.Amount,
+			Stake:              foundApp.Stake,
+			SessionEndHeight:   sessionEndHeight,
+			UnbondingEndHeight: types.GetApplicationStakeDecreaseUnbondingHeight(&decreaseParams, stakeDecrease),
+		})
+	}
+
 	// ALWAYS emit an application staked event.
-	currentHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
 	events = append(events, &types.EventApplicationStaked{
 		Application:      &foundApp,
-		SessionEndHeight: k.sharedKeeper.GetSessionEndHeight(ctx, currentHeight),
+		SessionEndHeight: sessionEndHeight,
 	})
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if err = sdkCtx.EventManager().EmitTypedEvents(events...); err != nil {
 		err = types.ErrAppEmitEvent.Wrapf("(%+v): %s", events, err)
 		logger.Error(err.Error())
@@ -314,12 +355,13 @@ func (k Keeper) updateApplication(
 		return types.ErrAppUnauthorized.Wrapf("msg Address %q != application address %q", msg.Address, app.Address)
 	}
 
-	// Validate that the stake is not being lowered
+	// Validate that the stake is being changed. A lower stake is queued as a
+	// pending stake decrease by the caller.
 	if msg.Stake == nil {
 		return types.ErrAppInvalidStake.Wrapf("stake amount cannot be nil")
 	}
-	if msg.Stake.IsLTE(*app.Stake) {
-		return types.ErrAppInvalidStake.Wrapf("stake amount %v must be higher than previous stake amount %v", msg.Stake, app.Stake)
+	if msg.Stake.IsEqual(*app.Stake) {
+		return types.ErrAppInvalidStake.Wrapf("stake amount %v must differ from previous stake amount %v", msg.Stake, app.Stake)
 	}
 	app.Stake = msg.Stake
 
@@ -293,7 +293,7 @@ func TestMsgServer_StakeApplication_FailRestakingDueToInvalidServices(t *testing
 	require.Equal(t, "svc1", foundApp.ServiceConfigs[0].ServiceId)
 }
 
-func TestMsgServer_StakeApplication_FailLoweringStake(t *testing.T) {
+func TestMsgServer_StakeApplication_FailLoweringStakeBelowMinStake(t *testing.T) {
 	k, ctx := keepertest.ApplicationKeeper(t)
 	srv := keeper.NewMsgServerImpl(k)
 
@@ -334,6 +334,72 @@ func TestMsgServer_StakeApplication_FailLoweringStake(t *testing.T) {
 	require.Equal(t, initialStake, foundApp.Stake)
 }
 
+func TestMsgServer_StakeApplication_SuccessLoweringStakeAboveMinStake(t *testing.T) {
+	applicationModuleKeepers, ctx := keepertest.NewApplicationModuleKeepers(t)
+	srv := keeper.NewMsgServerImpl(*applicationModuleKeepers.Keeper)
+	sharedParams := applicationModuleKeepers.SharedKeeper.GetParams(ctx)
+
+	// Stake the application above the minimum stake.
+	appAddr := sample.AccAddressBech32()
+	initialStake := apptypes.DefaultMinStake.Amount.Int64() + 1000
+	_, err := srv.StakeApplication(ctx, createAppStakeMsg(appAddr, initialStake))
+	require.NoError(t, err)
+
+	// Lower the application stake twice within the same session.
+	_, err = srv.StakeApplication(ctx, createAppStakeMsg(appAddr, initialStake-400))
+	require.NoError(t, err)
+	_, err = srv.StakeApplication(ctx, createAppStakeMsg(appAddr, initialStake-1000))
+	require.NoError(t, err)
+
+	// Verify that the stake is lowered and that the decreases of the same session
+	// are merged into a single pending stake decrease.
+	foundApp, isAppFound := applicationModuleKeepers.GetApplication(ctx, appAddr)
+	require.True(t, isAppFound)
+	require.Equal(t, initialStake-1000, foundApp.Stake.Amount.Int64())
+	require.Len(t, foundApp.PendingStakeDecreases, 1)
+
+	stakeDecrease := foundApp.PendingStakeDecreases[0]
+	require.Equal(t, int64(1000), stakeDecrease.Amount.Amount.Int64())
+	unbondingEndHeight := apptypes.GetApplicationStakeDecreaseUnbondingHeight(&sharedParams, stakeDecrease)
+
+	// The stake decrease is still unbonding at the session end preceding the unbonding end height.
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight-int64(sharedParams.GetNumBlocksPerSession()))
+	err = applicationModuleKeepers.EndBlockerUnbondApplicationStakeDecreases(ctx)
+	require.NoError(t, err)
+
+	foundApp, isAppFound = applicationModuleKeepers.GetApplication(ctx, appAddr)
+	require.True(t, isAppFound)
+	require.Len(t, foundApp.PendingStakeDecreases, 1)
+
+	// Reset the events, as if a new block were created.
+	ctx, _ = testevents.ResetEventManager(ctx)
+
+	// The stake decrease completes unbonding at the unbonding end height.
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight)
+	err = applicationModuleKeepers.EndBlockerUnbondApplicationStakeDecreases(ctx)
+	require.NoError(t, err)
+
+	expectedEvent, err := cosmostypes.TypedEventToEvent(
+		&apptypes.EventApplicationStakeDecreaseUnbondingEnd{
+			ApplicationAddress: appAddr,
+			Amount:             stakeDecrease.Amount,
+			SessionEndHeight:   unbondingEndHeight,
+			UnbondingEndHeight: unbondingEndHeight,
+		},
+	)
+	require.NoError(t, err)
+
+	events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	require.Equalf(t, 1, len(events), "expected exactly 1 event")
+	require.EqualValues(t, expectedEvent, events[0])
+
+	// The application keeps its lowered stake and has no pending stake decreases left.
+	foundApp, isAppFound = applicationModuleKeepers.GetApplication(ctx, appAddr)
+	require.True(t, isAppFound)
+	require.Equal(t, initialStake-1000, foundApp.Stake.Amount.Int64())
+	require.Empty(t, foundApp.PendingStakeDecreases)
+}
+
 func TestMsgServer_StakeApplication_PerSessionSpendLimit(t *testing.T) {
 	k, ctx := keepertest.ApplicationKeeper(t)
 	srv := keeper.NewMsgServerImpl(k)
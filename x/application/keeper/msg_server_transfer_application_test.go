@@ -417,3 +417,78 @@ func TestMsgServer_TransferApplication_NewAddressRewritesHistoryAppAddress(t *te
 	_, isSrcFound := k.GetApplication(ctx, srcBech32)
 	require.False(t, isSrcFound, "src must be removed after a completed transfer")
 }
+
+// TestMsgServer_TransferApplication_MergePendingStakeDecreases verifies that the
+// pending stake decreases of a source application merged into an existing
+// destination keep unbonding on the destination, and that the ones of the same
+// session are merged into a single decrease.
+func TestMsgServer_TransferApplication_MergePendingStakeDecreases(t *testing.T) {
+	applicationModuleKeepers, ctx := keepertest.NewApplicationModuleKeepers(t)
+	k := *applicationModuleKeepers.Keeper
+	srv := appkeeper.NewMsgServerImpl(k)
+	sharedParams := applicationModuleKeepers.SharedKeeper.GetParams(ctx)
+
+	srcBech32 := sample.AccAddressBech32()
+	dstBech32 := sample.AccAddressBech32()
+	initialStake := apptypes.DefaultMinStake.Amount.Int64() + 1000
+
+	// Stake both applications then initiate the transfer of the source to the
+	// existing destination.
+	_, err := srv.StakeApplication(ctx, createAppStakeMsg(srcBech32, initialStake))
+	require.NoError(t, err)
+	_, err = srv.StakeApplication(ctx, createAppStakeMsg(dstBech32, initialStake))
+	require.NoError(t, err)
+
+	_, err = srv.TransferApplication(ctx, apptypes.NewMsgTransferApplication(srcBech32, dstBech32))
+	require.NoError(t, err)
+
+	srcApp, isSrcFound := k.GetApplication(ctx, srcBech32)
+	require.True(t, isSrcFound)
+	transferEndHeight := apptypes.GetApplicationTransferHeight(&sharedParams, &srcApp)
+	transferCompletionHeight := sharedtypes.GetSessionEndHeight(&sharedParams, transferEndHeight)
+
+	// Lower the stakes of both applications during the session ending at the
+	// transfer completion height, so that both decreases are still unbonding
+	// when the transfer completes.
+	ctx = keepertest.SetBlockHeight(ctx, transferCompletionHeight-int64(sharedParams.GetNumBlocksPerSession())+1)
+	_, err = srv.StakeApplication(ctx, createAppStakeMsg(srcBech32, initialStake-400))
+	require.NoError(t, err)
+	_, err = srv.StakeApplication(ctx, createAppStakeMsg(dstBech32, initialStake-600))
+	require.NoError(t, err)
+
+	// Complete the transfer, running the EndBlockers in the application module order.
+	ctx = keepertest.SetBlockHeight(ctx, transferCompletionHeight)
+	require.NoError(t, k.EndBlockerUnbondApplicationStakeDecreases(ctx))
+	require.NoError(t, k.EndBlockerTransferApplication(ctx))
+
+	_, isSrcFound = k.GetApplication(ctx, srcBech32)
+	require.False(t, isSrcFound)
+
+	dstApp, isDstFound := k.GetApplication(ctx, dstBech32)
+	require.True(t, isDstFound)
+	require.Equal(t, 2*initialStake-1000, dstApp.Stake.Amount.Int64())
+	require.Len(t, dstApp.PendingStakeDecreases, 1)
+
+	stakeDecrease := dstApp.PendingStakeDecreases[0]
+	require.Equal(t, int64(1000), stakeDecrease.Amount.Amount.Int64())
+	require.Equal(t, uint64(transferCompletionHeight), stakeDecrease.SessionEndHeight)
+
+	// Reset the events, as if a new block were created.
+	ctx, _ = testutilevents.ResetEventManager(ctx)
+
+	// The merged stake decrease completes unbonding on the destination, once.
+	unbondingEndHeight := apptypes.GetApplicationStakeDecreaseUnbondingHeight(&sharedParams, stakeDecrease)
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight)
+	require.NoError(t, k.EndBlockerUnbondApplicationStakeDecreases(ctx))
+
+	unbondingEndEvents := testutilevents.FilterEvents[*apptypes.EventApplicationStakeDecreaseUnbondingEnd](t,
+		cosmostypes.UnwrapSDKContext(ctx).EventManager().Events())
+	require.Len(t, unbondingEndEvents, 1)
+	require.Equal(t, dstBech32, unbondingEndEvents[0].GetApplicationAddress())
+	require.Equal(t, int64(1000), unbondingEndEvents[0].GetAmount().Amount.Int64())
+
+	dstApp, isDstFound = k.GetApplication(ctx, dstBech32)
+	require.True(t, isDstFound)
+	require.Equal(t, 2*initialStake-1000, dstApp.Stake.Amount.Int64())
+	require.Empty(t, dstApp.PendingStakeDecreases)
+}
@@ -128,6 +128,7 @@ func (k Keeper) transferApplication(
 	// If it does not: derive it from the source application.
 	// If it does: "merge" the src app into the dst app by:
 	// - summing stake amounts
+	// - merging the pending stake decreases
 	// - taking the union of delegations and service configs
 	dstApp, isDstFound := k.GetApplication(ctx, srcApp.GetPendingTransfer().GetDestinationAddress())
 
@@ -172,6 +173,11 @@ func (k Keeper) transferApplication(
 	} else {
 		srcStakeSumCoin := srcApp.GetStake().Add(*dstApp.GetStake())
 		dstApp.Stake = &srcStakeSumCoin
+		// The source pending stake decreases keep unbonding on the destination.
+		dstApp.PendingStakeDecreases = sharedtypes.MergePendingStakeDecreases(
+			dstApp.PendingStakeDecreases,
+			srcApp.PendingStakeDecreases,
+		)
 
 		mergeAppDelegatees(&srcApp, &dstApp)
 		mergeAppPendingUndelegations(&srcApp, &dstApp)
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	gatewaytypes "github.com/pokt-network/poktroll/x/gateway/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// EndBlockerTransferGatewayDelegations redelegates the applications delegating to
+// transferred gateways to the corresponding destination gateways.
+//
+// Gateway transfers complete in the gateway module's EndBlocker, which runs before
+// this one, on the last block of a session. Redelegating in the same block means the
+// destination gateway is in the applications' rings from the start of the next session,
+// while the source gateway is recorded as a pending undelegation so that the proofs
+// of the sessions it served can still be validated.
+func (k Keeper) EndBlockerTransferGatewayDelegations(ctx cosmostypes.Context) error {
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+
+	// Gateway transfers only ever complete at the end of a session.
+	if !sharedtypes.IsSessionEndHeight(&sharedParams, currentHeight) {
+		return nil
+	}
+
+	logger := k.Logger().
+		With("method", "EndBlockerTransferGatewayDelegations").
+		With("current_height", currentHeight)
+
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)
+
+	for _, transferredGateway := range k.getTransferredGateways(ctx) {
+		srcGatewayAddress := transferredGateway.GetAddress()
+		dstGatewayAddress := transferredGateway.PendingTransfer.GetDestinationAddress()
+
+		// Collect the delegating applications before updating them since updating
+		// an application updates the iterated delegation index.
+		delegatingApps := make([]apptypes.Application, 0)
+		delegationIterator := k.GetDelegationsIterator(ctx, srcGatewayAddress)
+		for ; delegationIterator.Valid(); delegationIterator.Next() {
+			application, err := delegationIterator.Value()
+			if err != nil {
+				delegationIterator.Close()
+				return err
+			}
+			delegatingApps = append(delegatingApps, application)
+		}
+		delegationIterator.Close()
+
+		for _, application := range delegatingApps {
+			gwIdx := slices.Index(application.DelegateeGatewayAddresses, srcGatewayAddress)
+			if gwIdx < 0 {
+				// If the delegation is referencing an application that is not delegating
+				// to the gateway, log the error, remove the index entry but continue
+				// to the next delegation.
+				logger.Error("Gateway address not found in application delegatee addresses")
+				k.removeApplicationDelegationIndex(ctx, application.Address, srcGatewayAddress)
+				continue
+			}
+
+			// Replace the source gateway with the destination gateway, in place, unless
+			// the application is (somehow) already delegating to the latter.
+			if slices.Contains(application.DelegateeGatewayAddresses, dstGatewayAddress) {
+				application.DelegateeGatewayAddresses = append(
+					application.DelegateeGatewayAddresses[:gwIdx],
+					application.DelegateeGatewayAddresses[gwIdx+1:]...,
+				)
+			} else {
+				application.DelegateeGatewayAddresses[gwIdx] = dstGatewayAddress
+			}
+
+			// The destination gateway may have been a gateway the application
+			// previously undelegated from.
+			k.updatePendingUndelegations(ctx, &application, dstGatewayAddress, logger)
+
+			// Record the pending undelegation for the application to allow any upcoming
+			// proofs to get the application's ring signatures.
+			k.recordPendingUndelegation(ctx, &application, srcGatewayAddress, currentHeight)
+
+			k.SetApplication(ctx, application)
+			logger.Info(fmt.Sprintf(
+				"Redelegated application %s from transferred gateway %s to gateway %s",
+				application.Address, srcGatewayAddress, dstGatewayAddress,
+			))
+
+			redelegationEvent := &apptypes.EventRedelegation{
+				Application:      &application,
+				SessionEndHeight: sessionEndHeight,
+			}
+			if err := sdkCtx.EventManager().EmitTypedEvent(redelegationEvent); err != nil {
+				err = fmt.Errorf("failed to emit application redelegation event: %w", err)
+				logger.Error(err.Error())
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getTransferredGateways returns the gateways which have completed a transfer.
+func (k Keeper) getTransferredGateways(ctx cosmostypes.Context) []*gatewaytypes.Gateway {
+	// TODO_IMPROVE: Add a GetAllTransferredGatewaysIterator method to the gateway keeper
+	// to avoid fetching all gateways.
+	//
+	// Decodes gateways WITHOUT their cards, see getInactiveUnbondingGateways.
+	gateways := k.gatewayKeeper.GetAllGatewayLifecycles(ctx)
+
+	transferredGateways := make([]*gatewaytypes.Gateway, 0)
+	for _, gatewayLifecycle := range gateways {
+		if gatewayLifecycle.IsTransferred() {
+			gateway := gatewayLifecycle.ToGateway()
+			transferredGateways = append(transferredGateways, &gateway)
+		}
+	}
+
+	return transferredGateways
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	testsession "github.com/pokt-network/poktroll/testutil/session"
+	"github.com/pokt-network/poktroll/x/application/keeper"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestEndBlockerTransferGatewayDelegations_Success(t *testing.T) {
+	k, ctx := keepertest.ApplicationKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	// Stake an application.
+	appAddr := sample.AccAddressBech32()
+	_, err := srv.StakeApplication(ctx, &apptypes.MsgStakeApplication{
+		Address: appAddr,
+		Stake:   &apptypes.DefaultMinStake,
+		Services: []*sharedtypes.ApplicationServiceConfig{
+			{ServiceId: "svc1"},
+		},
+	})
+	require.NoError(t, err)
+
+	// Delegate the application to the gateway to be transferred and to another gateway.
+	srcGatewayAddr := sample.AccAddressBech32()
+	dstGatewayAddr := sample.AccAddressBech32()
+	otherGatewayAddr := sample.AccAddressBech32()
+	for _, gatewayAddr := range []string{srcGatewayAddr, otherGatewayAddr} {
+		keepertest.AddGatewayToStakedGatewayMap(t, gatewayAddr, 0)
+		_, err = srv.DelegateToGateway(ctx, &apptypes.MsgDelegateToGateway{
+			AppAddress:     appAddr,
+			GatewayAddress: gatewayAddr,
+		})
+		require.NoError(t, err)
+	}
+
+	// Mock the gateway transfer completing at the end of the first session.
+	sessionEndHeight := testsession.GetSessionEndHeightWithDefaultParams(1)
+	keepertest.AddTransferredGatewayToStakedGatewayMap(t, srcGatewayAddr, dstGatewayAddr, uint64(sessionEndHeight))
+	keepertest.AddGatewayToStakedGatewayMap(t, dstGatewayAddr, 0)
+
+	// Delegations are not updated before the end of the session.
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(sessionEndHeight - 1)
+	err = k.EndBlockerTransferGatewayDelegations(sdkCtx)
+	require.NoError(t, err)
+
+	app, isAppFound := k.GetApplication(sdkCtx, appAddr)
+	require.True(t, isAppFound)
+	require.Equal(t, []string{srcGatewayAddr, otherGatewayAddr}, app.DelegateeGatewayAddresses)
+
+	// Delegations to the source gateway are replaced at the end of the session.
+	sdkCtx = sdkCtx.WithBlockHeight(sessionEndHeight)
+	err = k.EndBlockerTransferGatewayDelegations(sdkCtx)
+	require.NoError(t, err)
+
+	app, isAppFound = k.GetApplication(sdkCtx, appAddr)
+	require.True(t, isAppFound)
+	require.Equal(t, []string{dstGatewayAddr, otherGatewayAddr}, app.DelegateeGatewayAddresses)
+	require.Equal(t,
+		[]string{srcGatewayAddr},
+		app.PendingUndelegations[uint64(sessionEndHeight)].GatewayAddresses,
+	)
+
+	// The source gateway remains in the ring of the session it served, but not in
+	// the ring of the following one.
+	ringAddresses := getRingAddressesAtBlockWithDefaultParams(&app, sessionEndHeight)
+	require.ElementsMatch(t, []string{srcGatewayAddr, dstGatewayAddr, otherGatewayAddr}, ringAddresses)
+
+	nextRingAddresses := getRingAddressesAtBlockWithDefaultParams(&app, sessionEndHeight+1)
+	require.ElementsMatch(t, []string{dstGatewayAddr, otherGatewayAddr}, nextRingAddresses)
+
+	// The delegation index follows the destination gateway.
+	srcDelegationsIterator := k.GetDelegationsIterator(sdkCtx, srcGatewayAddr)
+	require.False(t, srcDelegationsIterator.Valid())
+	srcDelegationsIterator.Close()
+
+	dstDelegationsIterator := k.GetDelegationsIterator(sdkCtx, dstGatewayAddr)
+	require.True(t, dstDelegationsIterator.Valid())
+	delegatingApp, err := dstDelegationsIterator.Value()
+	require.NoError(t, err)
+	require.Equal(t, appAddr, delegatingApp.Address)
+	dstDelegationsIterator.Close()
+
+	// The redelegated application is not auto-undelegated once the source gateway is inactive.
+	sdkCtx = sdkCtx.WithBlockHeight(sessionEndHeight + 1)
+	err = k.EndBlockerAutoUndelegateFromUnbondingGateways(sdkCtx)
+	require.NoError(t, err)
+
+	app, isAppFound = k.GetApplication(sdkCtx, appAddr)
+	require.True(t, isAppFound)
+	require.Equal(t, []string{dstGatewayAddr, otherGatewayAddr}, app.DelegateeGatewayAddresses)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// EndBlockerUnbondApplicationStakeDecreases returns the application stake decreases
+// whose unbonding period has elapsed to the applications.
+func (k Keeper) EndBlockerUnbondApplicationStakeDecreases(ctx context.Context) error {
+	logger := k.Logger().With("method", "EndBlockerUnbondApplicationStakeDecreases")
+
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(sdkCtx)
+	currentHeight := sdkCtx.BlockHeight()
+
+	// Only process unbonding stake decreases at the end of the session.
+	if !sharedtypes.IsSessionEndHeight(&sharedParams, currentHeight) {
+		return nil
+	}
+
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)
+
+	// Collect the applications with pending stake decreases before updating any of
+	// them since updating an application may delete its entry from the iterated index.
+	stakeDecreasingApplications := make([]apptypes.Application, 0)
+	allStakeDecreasingApplicationsIterator := k.GetAllStakeDecreasingApplicationsIterator(ctx)
+	for ; allStakeDecreasingApplicationsIterator.Valid(); allStakeDecreasingApplicationsIterator.Next() {
+		application, err := allStakeDecreasingApplicationsIterator.Value()
+		if err != nil {
+			allStakeDecreasingApplicationsIterator.Close()
+			return err
+		}
+		stakeDecreasingApplications = append(stakeDecreasingApplications, application)
+	}
+	allStakeDecreasingApplicationsIterator.Close()
+
+	for _, application := range stakeDecreasingApplications {
+		// If the application has no pending stake decreases, this means that there
+		// is a dangling entry in the index.
+		// Log the error, remove the index entry but continue to the next application.
+		if len(application.PendingStakeDecreases) == 0 {
+			logger.Error(fmt.Sprintf(
+				"found application %s in stake decrease store but it has no pending stake decreases, removing index entry",
+				application.Address,
+			))
+			k.removeApplicationStakeDecreaseIndex(ctx, application.Address)
+			continue
+		}
+
+		// Retrieve the account address of the application.
+		appAddr, err := cosmostypes.AccAddressFromBech32(application.Address)
+		if err != nil {
+			logger.Error(fmt.Sprintf("could not parse address %s", application.Address))
+			return err
+		}
+
+		events := make([]cosmostypes.Msg, 0)
+		remainingStakeDecreases := make([]*sharedtypes.PendingStakeDecrease, 0, len(application.PendingStakeDecreases))
+		for _, stakeDecrease := range application.PendingStakeDecreases {
+			// Compute the unbonding end height using the shared params effective when the
+			// stake decrease began unbonding, NOT the live params.
+			decreaseParams := k.sharedKeeper.GetParamsAtHeight(ctx, int64(stakeDecrease.GetSessionEndHeight()))
+			unbondingEndHeight := apptypes.GetApplicationStakeDecreaseUnbondingHeight(&decreaseParams, stakeDecrease)
+
+			// If the unbonding height is ahead of the current height, the stake
+			// decrease stays in the unbonding state.
+			if unbondingEndHeight > currentHeight {
+				remainingStakeDecreases = append(remainingStakeDecreases, stakeDecrease)
+				continue
+			}
+
+			// If the stake decrease was fully used to settle claims, then do not
+			// move 0 coins to the application account.
+			if stakeDecrease.Amount.IsPositive() {
+				// Send the coins from the application pool back to the application.
+				// If the transfer fails, the coins remain in the application module pool and
+				// EventApplicationStakeStuckInModulePool surfaces them, mirroring UnbondApplication.
+				if sendErr := k.bankKeeper.SendCoinsFromModuleToAccount(
+					ctx, apptypes.ModuleName, appAddr, []cosmostypes.Coin{*stakeDecrease.Amount},
+				); sendErr != nil {
+					logger.Error(fmt.Sprintf(
+						"could not send %v coins from module %s to account %s due to %v; stake decrease will be dropped and coins will remain in module pool (see EventApplicationStakeStuckInModulePool)",
+						stakeDecrease.Amount, apptypes.ModuleName, appAddr, sendErr,
+					))
+
+					events = append(events, &apptypes.EventApplicationStakeStuckInModulePool{
+						ApplicationAddress: application.Address,
+						StuckCoin:          stakeDecrease.Amount,
+						Reason:             sendErr.Error(),
+						SessionEndHeight:   sessionEndHeight,
+					})
+					continue
+				}
+			}
+
+			events = append(events, &apptypes.EventApplicationStakeDecreaseUnbondingEnd{
+				ApplicationAddress: application.Address,
+				Amount:             stakeDecrease.Amount,
+				SessionEndHeight:   sessionEndHeight,
+				UnbondingEndHeight: unbondingEndHeight,
+			})
+		}
+
+		// Only update the application if any of its stake decreases completed unbonding.
+		if len(remainingStakeDecreases) == len(application.PendingStakeDecreases) {
+			continue
+		}
+
+		application.PendingStakeDecreases = remainingStakeDecreases
+		k.SetApplication(ctx, application)
+
+		if err = sdkCtx.EventManager().EmitTypedEvents(events...); err != nil {
+			err = apptypes.ErrAppEmitEvent.Wrapf("(%+v): %s", events, err)
+			logger.Error(err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
@@ -165,8 +165,9 @@ func (k Keeper) MarkBelowMinStakeApplicationsUnbonding(ctx context.Context) (int
 	return marked, nil
 }
 
-// UnbondApplication transfers the application stake to the bank module balance for the
-// corresponding account and removes the application from the application module state.
+// UnbondApplication transfers the application stake, along with its pending stake
+// decreases, to the bank module balance for the corresponding account and removes
+// the application from the application module state.
 func (k Keeper) UnbondApplication(ctx context.Context, app *apptypes.Application) error {
 	logger := k.Logger().With("method", "UnbondApplication")
 
@@ -177,6 +178,10 @@ func (k Keeper) UnbondApplication(ctx context.Context, app *apptypes.Application
 		return err
 	}
 
+	// The pending stake decreases have been backing the application claims along
+	// with its stake, they complete unbonding together with the application.
+	unbondedCoin := app.Stake.Add(sharedtypes.GetPendingStakeDecreasesAmount(app.PendingStakeDecreases))
+
 	// Send the coins from the application pool back to the application.
 	// If the transfer fails (e.g., a legacy module-account-owned application
 	// — new occurrences are blocked by the stake-time module-account-owner
@@ -191,11 +196,11 @@ func (k Keeper) UnbondApplication(ctx context.Context, app *apptypes.Application
 	// prevents an infinite-retry on the same dead entry every session-end.
 	// Mirror of the supplier-side fix in x/supplier/keeper/unbond_suppliers.go.
 	if sendErr := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx, apptypes.ModuleName, appAddr, []cosmostypes.Coin{*app.Stake},
+		ctx, apptypes.ModuleName, appAddr, []cosmostypes.Coin{unbondedCoin},
 	); sendErr != nil {
 		logger.Error(fmt.Sprintf(
 			"could not send %v coins from module %s to account %s due to %v; application will be removed and coins will remain in module pool (see EventApplicationStakeStuckInModulePool)",
-			app.Stake, apptypes.ModuleName, appAddr, sendErr,
+			unbondedCoin, apptypes.ModuleName, appAddr, sendErr,
 		))
 
 		sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
@@ -203,7 +208,7 @@ func (k Keeper) UnbondApplication(ctx context.Context, app *apptypes.Application
 		stuckSessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, sdkCtx.BlockHeight())
 		stuckEvent := &apptypes.EventApplicationStakeStuckInModulePool{
 			ApplicationAddress: app.Address,
-			StuckCoin:          app.Stake,
+			StuckCoin:          &unbondedCoin,
 			Reason:             sendErr.Error(),
 			SessionEndHeight:   stuckSessionEndHeight,
 		}
@@ -13,6 +13,10 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	// Telemetry: measure the end-block execution time following standard cosmos-sdk practices.
 	defer cosmostelemetry.ModuleMeasureSince(types.ModuleName, cosmostelemetry.Now(), cosmostelemetry.MetricKeyEndBlocker)
 
+	if err := k.EndBlockerTransferGatewayDelegations(ctx); err != nil {
+		return err
+	}
+
 	if err := k.EndBlockerAutoUndelegateFromUnbondingGateways(ctx); err != nil {
 		return err
 	}
@@ -21,6 +25,10 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 		return err
 	}
 
+	if err := k.EndBlockerUnbondApplicationStakeDecreases(ctx); err != nil {
+		return err
+	}
+
 	if err := k.EndBlockerUnbondApplications(ctx); err != nil {
 		return err
 	}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
@@ -99,6 +103,42 @@ func (s *Application) GetActiveServiceConfigs(
 	return activeServiceConfigs
 }
 
+// GetBackingStake returns the amount available to settle the claims of the
+// session ending at sessionEndHeight: the application stake plus the pending
+// stake decreases which were requested during or after that session.
+func (s *Application) GetBackingStake(sessionEndHeight int64) cosmostypes.Coin {
+	backingStakeDecreasesAmount := sharedtypes.GetBackingStakeDecreasesAmount(
+		s.PendingStakeDecreases,
+		uint64(sessionEndHeight),
+	)
+
+	return s.Stake.Add(backingStakeDecreasesAmount)
+}
+
+// DeductSettledAmount deducts the given amount, settled for a claim of the session
+// ending at sessionEndHeight, from the application. The pending stake decreases
+// backing the claim are deducted first, the remainder is deducted from the stake.
+// It returns an error, without updating the application, if the amount exceeds
+// the backing stake.
+func (s *Application) DeductSettledAmount(sessionEndHeight int64, amount cosmostypes.Coin) error {
+	if backingStake := s.GetBackingStake(sessionEndHeight); backingStake.IsLT(amount) {
+		return fmt.Errorf(
+			"application %q backing stake %s for session ending at height %d cannot cover %s",
+			s.Address, backingStake, sessionEndHeight, amount,
+		)
+	}
+
+	remainingAmount := sharedtypes.DeductFromBackingStakeDecreases(
+		s.PendingStakeDecreases,
+		uint64(sessionEndHeight),
+		amount,
+	)
+	newStake := s.Stake.Sub(remainingAmount)
+	s.Stake = &newStake
+
+	return nil
+}
+
 // BackfillServiceConfigHistory populates an empty service_config_history from the
 // application's flat ServiceConfigs snapshot, marking each config active since
 // genesis (activation height 1, no deactivation).
@@ -143,6 +183,17 @@ func GetApplicationUnbondingHeight(
 	return int64(application.UnstakeSessionEndHeight + applicationUnbondingPeriodBlocks)
 }
 
+// GetApplicationStakeDecreaseUnbondingHeight returns the session end height at
+// which the given application stake decrease finishes unbonding.
+func GetApplicationStakeDecreaseUnbondingHeight(
+	sharedParams *sharedtypes.Params,
+	pendingStakeDecrease *sharedtypes.PendingStakeDecrease,
+) int64 {
+	applicationUnbondingPeriodBlocks := sharedParams.ApplicationUnbondingPeriodSessions * sharedParams.NumBlocksPerSession
+
+	return int64(pendingStakeDecrease.SessionEndHeight + applicationUnbondingPeriodBlocks)
+}
+
 // GetApplicationTransferHeight returns the session end height at which the given
 // application transfer completes.
 func GetApplicationTransferHeight(
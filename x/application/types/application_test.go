@@ -3,8 +3,10 @@ package types
 import (
 	"testing"
 
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/app/pocket"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
@@ -118,3 +120,41 @@ func TestApplication_BackfillServiceConfigHistory(t *testing.T) {
 		require.Empty(t, app.ServiceConfigHistory)
 	})
 }
+
+func TestApplication_DeductSettledAmount(t *testing.T) {
+	tests := []struct {
+		name                            string
+		amount                          int64
+		expectedErr                     bool
+		expectedStake                   int64
+		expectedPendingStakeDecreaseAmt []int64
+	}{
+		{name: "covered by the backing decrease", amount: 150, expectedStake: 1000, expectedPendingStakeDecreaseAmt: []int64{100, 50}},
+		{name: "consumes the backing decrease then the stake", amount: 300, expectedStake: 900, expectedPendingStakeDecreaseAmt: []int64{100, 0}},
+		{name: "consumes the whole backing stake", amount: 1200, expectedStake: 0, expectedPendingStakeDecreaseAmt: []int64{100, 0}},
+		{name: "exceeds the backing stake", amount: 1201, expectedErr: true, expectedStake: 1000, expectedPendingStakeDecreaseAmt: []int64{100, 200}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The decrease of the session ending at height 10 does not back the claims
+			// of the session ending at height 20, the one ending at height 30 does.
+			stake := cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 1000)
+			app := &Application{Stake: &stake}
+			app.PendingStakeDecreases = sharedtypes.AddPendingStakeDecrease(app.PendingStakeDecreases, cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 100), 10)
+			app.PendingStakeDecreases = sharedtypes.AddPendingStakeDecrease(app.PendingStakeDecreases, cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 200), 30)
+
+			err := app.DeductSettledAmount(20, cosmostypes.NewInt64Coin(pocket.DenomuPOKT, tt.amount))
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.expectedStake, app.Stake.Amount.Int64())
+			for i, expectedAmt := range tt.expectedPendingStakeDecreaseAmt {
+				require.Equal(t, expectedAmt, app.PendingStakeDecreases[i].Amount.Amount.Int64())
+			}
+		})
+	}
+}
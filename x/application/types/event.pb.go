@@ -529,6 +529,166 @@ func (m *EventApplicationUnbondingEnd) GetUnbondingEndHeight() int64 {
 	return 0
 }
 
+// EventApplicationStakeDecreaseUnbondingBegin is emitted when an application
+// stake message lowering the application stake is committed onchain, indicating
+// that the removed amount will now begin unbonding while the application keeps
+// requesting services with its remaining stake.
+type EventApplicationStakeDecreaseUnbondingBegin struct {
+	ApplicationAddress string `protobuf:"bytes,1,opt,name=application_address,json=applicationAddress,proto3" json:"application_address,omitempty"`
+	// The amount removed from the application stake.
+	Amount *types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// The application stake remaining after the decrease.
+	Stake *types.Coin `protobuf:"bytes,3,opt,name=stake,proto3" json:"stake"`
+	// The end height of the session in which the stake decrease began unbonding.
+	SessionEndHeight int64 `protobuf:"varint,4,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The height at which the stake decrease unbonding will end.
+	UnbondingEndHeight int64 `protobuf:"varint,5,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height"`
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingBegin) Reset() {
+	*m = EventApplicationStakeDecreaseUnbondingBegin{}
+}
+func (m *EventApplicationStakeDecreaseUnbondingBegin) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventApplicationStakeDecreaseUnbondingBegin) ProtoMessage() {}
+func (*EventApplicationStakeDecreaseUnbondingBegin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f5dfa8a062ea63, []int{7}
+}
+func (m *EventApplicationStakeDecreaseUnbondingBegin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventApplicationStakeDecreaseUnbondingBegin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventApplicationStakeDecreaseUnbondingBegin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventApplicationStakeDecreaseUnbondingBegin.Merge(m, src)
+}
+func (m *EventApplicationStakeDecreaseUnbondingBegin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventApplicationStakeDecreaseUnbondingBegin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventApplicationStakeDecreaseUnbondingBegin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventApplicationStakeDecreaseUnbondingBegin proto.InternalMessageInfo
+
+func (m *EventApplicationStakeDecreaseUnbondingBegin) GetApplicationAddress() string {
+	if m != nil {
+		return m.ApplicationAddress
+	}
+	return ""
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingBegin) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingBegin) GetStake() *types.Coin {
+	if m != nil {
+		return m.Stake
+	}
+	return nil
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingBegin) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingBegin) GetUnbondingEndHeight() int64 {
+	if m != nil {
+		return m.UnbondingEndHeight
+	}
+	return 0
+}
+
+// EventApplicationStakeDecreaseUnbondingEnd is emitted when an application stake
+// decrease has completed unbonding and was returned to the application. The
+// unbonding period is determined by the shared param,
+// application_unbonding_period_sessions.
+type EventApplicationStakeDecreaseUnbondingEnd struct {
+	ApplicationAddress string `protobuf:"bytes,1,opt,name=application_address,json=applicationAddress,proto3" json:"application_address,omitempty"`
+	// The amount returned to the application. It is less than the amount removed
+	// from the stake if claims were settled against it while it was unbonding.
+	Amount *types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// The end height of the session in which the stake decrease unbonding ended.
+	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The height at which the stake decrease unbonding ended.
+	UnbondingEndHeight int64 `protobuf:"varint,4,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height"`
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingEnd) Reset() {
+	*m = EventApplicationStakeDecreaseUnbondingEnd{}
+}
+func (m *EventApplicationStakeDecreaseUnbondingEnd) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventApplicationStakeDecreaseUnbondingEnd) ProtoMessage() {}
+func (*EventApplicationStakeDecreaseUnbondingEnd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f5dfa8a062ea63, []int{8}
+}
+func (m *EventApplicationStakeDecreaseUnbondingEnd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventApplicationStakeDecreaseUnbondingEnd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventApplicationStakeDecreaseUnbondingEnd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventApplicationStakeDecreaseUnbondingEnd.Merge(m, src)
+}
+func (m *EventApplicationStakeDecreaseUnbondingEnd) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventApplicationStakeDecreaseUnbondingEnd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventApplicationStakeDecreaseUnbondingEnd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventApplicationStakeDecreaseUnbondingEnd proto.InternalMessageInfo
+
+func (m *EventApplicationStakeDecreaseUnbondingEnd) GetApplicationAddress() string {
+	if m != nil {
+		return m.ApplicationAddress
+	}
+	return ""
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingEnd) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingEnd) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingEnd) GetUnbondingEndHeight() int64 {
+	if m != nil {
+		return m.UnbondingEndHeight
+	}
+	return 0
+}
+
 // EventApplicationStakeStuckInModulePool is emitted when EndBlockerUnbondApplications
 // (via UnbondApplication) could NOT return the application's escrowed stake to its
 // owner account (e.g., a blocked module account, the bank module rejected the send).
@@ -536,6 +696,9 @@ func (m *EventApplicationUnbondingEnd) GetUnbondingEndHeight() int64 {
 // but the coins remain stranded in the application module pool. Indexers should
 // track these events so governance can propose a reclaim transfer; without this
 // event the loss would be invisible to off-chain observers.
+// It is also emitted by EndBlockerUnbondApplicationStakeDecreases for a stake
+// decrease which completed unbonding but could not be returned to the
+// application, in which case the stake decrease is dropped.
 //
 // Mirror of EventSupplierStakeStuckInModulePool (see pocket/supplier/event.proto).
 // Before v0.1.34 the application path returned the bank-send error from
@@ -567,7 +730,7 @@ func (m *EventApplicationStakeStuckInModulePool) Reset() {
 func (m *EventApplicationStakeStuckInModulePool) String() string { return proto.CompactTextString(m) }
 func (*EventApplicationStakeStuckInModulePool) ProtoMessage()    {}
 func (*EventApplicationStakeStuckInModulePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_44f5dfa8a062ea63, []int{7}
+	return fileDescriptor_44f5dfa8a062ea63, []int{9}
 }
 func (m *EventApplicationStakeStuckInModulePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +796,7 @@ func (m *EventApplicationUnbondingCanceled) Reset()         { *m = EventApplicat
 func (m *EventApplicationUnbondingCanceled) String() string { return proto.CompactTextString(m) }
 func (*EventApplicationUnbondingCanceled) ProtoMessage()    {}
 func (*EventApplicationUnbondingCanceled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_44f5dfa8a062ea63, []int{8}
+	return fileDescriptor_44f5dfa8a062ea63, []int{10}
 }
 func (m *EventApplicationUnbondingCanceled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -681,6 +844,8 @@ func init() {
 	proto.RegisterType((*EventTransferError)(nil), "pocket.application.EventTransferError")
 	proto.RegisterType((*EventApplicationUnbondingBegin)(nil), "pocket.application.EventApplicationUnbondingBegin")
 	proto.RegisterType((*EventApplicationUnbondingEnd)(nil), "pocket.application.EventApplicationUnbondingEnd")
+	proto.RegisterType((*EventApplicationStakeDecreaseUnbondingBegin)(nil), "pocket.application.EventApplicationStakeDecreaseUnbondingBegin")
+	proto.RegisterType((*EventApplicationStakeDecreaseUnbondingEnd)(nil), "pocket.application.EventApplicationStakeDecreaseUnbondingEnd")
 	proto.RegisterType((*EventApplicationStakeStuckInModulePool)(nil), "pocket.application.EventApplicationStakeStuckInModulePool")
 	proto.RegisterType((*EventApplicationUnbondingCanceled)(nil), "pocket.application.EventApplicationUnbondingCanceled")
 }
@@ -688,56 +853,62 @@ func init() {
 func init() { proto.RegisterFile("pocket/application/event.proto", fileDescriptor_44f5dfa8a062ea63) }
 
 var fileDescriptor_44f5dfa8a062ea63 = []byte{
-	// 776 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
-	0x14, 0x8d, 0x93, 0xd7, 0x4a, 0x9d, 0xea, 0xf5, 0xa5, 0xd3, 0xa8, 0x5f, 0xef, 0xc9, 0xe9, 0x8b,
-	0x44, 0x55, 0x2a, 0x6a, 0xd3, 0xc2, 0x1e, 0xc5, 0xa9, 0x69, 0x2d, 0xda, 0xa4, 0x72, 0x52, 0x40,
-	0x6c, 0x2c, 0xc7, 0x1e, 0x1c, 0x2b, 0xe9, 0x4c, 0xe4, 0x99, 0x14, 0xf8, 0x05, 0x6c, 0xf9, 0x13,
-	0x88, 0x15, 0x1b, 0xc4, 0x96, 0x3d, 0x0b, 0x16, 0x15, 0x42, 0xa8, 0xab, 0x08, 0xa5, 0xbb, 0xfc,
-	0x09, 0x90, 0xc7, 0x4e, 0xe2, 0xb6, 0x49, 0xd3, 0x20, 0x21, 0x65, 0xd1, 0x95, 0x3d, 0xf7, 0xde,
-	0x73, 0x7c, 0x7c, 0xee, 0xcc, 0xe8, 0x02, 0xb1, 0x4e, 0xac, 0x2a, 0x62, 0xb2, 0x59, 0xaf, 0xd7,
-	0x5c, 0xcb, 0x64, 0x2e, 0xc1, 0x32, 0x3a, 0x46, 0x98, 0x49, 0x75, 0x8f, 0x30, 0x02, 0x61, 0x90,
-	0x97, 0x22, 0xf9, 0xe5, 0x25, 0x8b, 0xd0, 0x23, 0x42, 0x0d, 0x5e, 0x21, 0x07, 0x8b, 0xa0, 0x7c,
-	0x39, 0xe5, 0x10, 0x87, 0x04, 0x71, 0xff, 0x2d, 0x8c, 0x8a, 0x41, 0x8d, 0x5c, 0x36, 0x29, 0x92,
-	0x8f, 0x37, 0xcb, 0x88, 0x99, 0x9b, 0xb2, 0x45, 0x5c, 0x1c, 0xe6, 0xff, 0x0d, 0x45, 0xd0, 0x8a,
-	0xe9, 0x21, 0x5b, 0xa6, 0xc8, 0x3b, 0x76, 0x2d, 0xd4, 0x01, 0xf7, 0x51, 0xc8, 0x5e, 0xd5, 0x51,
-	0xf8, 0xc9, 0xcc, 0x07, 0x01, 0xcc, 0xab, 0xbe, 0xe2, 0x6c, 0xaf, 0xa0, 0xc8, 0xcc, 0x2a, 0xb2,
-	0xa1, 0x0e, 0xa6, 0x23, 0xa8, 0x45, 0x61, 0x45, 0x58, 0x9b, 0xde, 0x4a, 0x4b, 0x97, 0x7f, 0x49,
-	0x8a, 0x60, 0x95, 0x7f, 0xda, 0xcd, 0x74, 0x14, 0xa7, 0x47, 0x17, 0x70, 0x1b, 0x40, 0x8a, 0x28,
-	0x75, 0x09, 0x36, 0x10, 0xb6, 0x8d, 0x0a, 0x72, 0x9d, 0x0a, 0x5b, 0x8c, 0xaf, 0x08, 0x6b, 0x09,
-	0x65, 0xbe, 0xdd, 0x4c, 0xf7, 0xc9, 0xea, 0xc9, 0x30, 0xa6, 0x62, 0x7b, 0x97, 0x47, 0x32, 0xef,
-	0x05, 0x30, 0xcb, 0x45, 0xeb, 0xc8, 0x46, 0x35, 0xe4, 0x04, 0xdc, 0xe3, 0xab, 0xf7, 0x67, 0x1c,
-	0x40, 0xae, 0xb7, 0xe4, 0x99, 0x98, 0x3e, 0x47, 0x9e, 0x82, 0x1c, 0x17, 0xc3, 0x07, 0x60, 0x86,
-	0x92, 0x86, 0x67, 0x21, 0xc3, 0xb4, 0x6d, 0x0f, 0x51, 0xca, 0x35, 0x4f, 0x29, 0x8b, 0x5f, 0x3f,
-	0x6e, 0xa4, 0xc2, 0x8d, 0x91, 0x0d, 0x32, 0x45, 0xe6, 0xb9, 0xd8, 0xd1, 0xff, 0x0e, 0xea, 0xc3,
-	0x20, 0xd4, 0xc0, 0x9c, 0x8d, 0x28, 0x73, 0x31, 0x17, 0xdb, 0x65, 0x89, 0x0f, 0x61, 0x81, 0x11,
-	0x50, 0x87, 0x2a, 0x0f, 0x60, 0x47, 0x4b, 0xc4, 0xc3, 0xc4, 0xb5, 0x3c, 0xd4, 0x67, 0x43, 0x59,
-	0x43, 0x8d, 0xfb, 0x6b, 0x34, 0xe3, 0xe0, 0x0e, 0x98, 0x63, 0xa1, 0x65, 0x51, 0x9a, 0x09, 0x4e,
-	0xb3, 0xd0, 0x6e, 0xa6, 0xfb, 0xa5, 0xf5, 0xd9, 0x4e, 0xb0, 0xd7, 0x81, 0xd7, 0x09, 0x90, 0x3c,
-	0xd7, 0x01, 0x15, 0xdb, 0x63, 0xe5, 0xff, 0x53, 0xb0, 0x70, 0x8e, 0x6a, 0xf4, 0x26, 0xcc, 0x47,
-	0x59, 0xc7, 0xb7, 0x13, 0x5f, 0x2e, 0x9e, 0x05, 0xd5, 0xf3, 0x88, 0x77, 0x73, 0x16, 0x86, 0x76,
-	0x20, 0x05, 0x26, 0x90, 0x6f, 0x15, 0xf7, 0x7c, 0x4a, 0x0f, 0x16, 0x99, 0xef, 0x71, 0x20, 0x5e,
-	0xbc, 0xbf, 0x0f, 0x71, 0x99, 0x60, 0xdb, 0xc5, 0x4e, 0x70, 0xcd, 0xfc, 0x89, 0x7b, 0x51, 0x07,
-	0x93, 0x1e, 0x32, 0x29, 0xc1, 0xdc, 0xe0, 0x99, 0x2d, 0x69, 0x08, 0x5d, 0x57, 0x92, 0xce, 0x51,
-	0x0a, 0x68, 0x37, 0xd3, 0x21, 0x83, 0x1e, 0x3e, 0x07, 0xd8, 0x94, 0x18, 0xd1, 0xa6, 0x87, 0x20,
-	0xd5, 0xe8, 0x7c, 0xec, 0xb2, 0xdd, 0xa9, 0x76, 0x33, 0x9d, 0xec, 0xe5, 0x43, 0x16, 0xd8, 0x8d,
-	0xf4, 0xf6, 0xe9, 0xb7, 0x38, 0xf8, 0x6f, 0xa0, 0xb1, 0xfe, 0xed, 0x71, 0x63, 0xeb, 0xef, 0xd8,
-	0xfa, 0x2e, 0x0e, 0x56, 0xfb, 0xce, 0x1b, 0x45, 0xd6, 0xb0, 0xaa, 0x1a, 0xde, 0x27, 0x76, 0xa3,
-	0x86, 0x0e, 0x08, 0xa9, 0xf9, 0x27, 0x3a, 0xf2, 0xdb, 0xd7, 0xbe, 0x17, 0x60, 0x04, 0xd4, 0x39,
-	0xd1, 0xbb, 0x00, 0x50, 0x9f, 0xdf, 0xf0, 0xc7, 0x26, 0xee, 0xed, 0xf4, 0xd6, 0x92, 0x14, 0xc2,
-	0xfd, 0xb9, 0x4a, 0x0a, 0xe7, 0x2a, 0x29, 0x47, 0x5c, 0xac, 0xcc, 0xb4, 0x9b, 0xe9, 0x08, 0x40,
-	0x9f, 0xe2, 0xef, 0x7e, 0x0a, 0x66, 0xba, 0x1d, 0x4a, 0x70, 0x1d, 0xd7, 0x77, 0x7c, 0xc4, 0xf3,
-	0x9e, 0xf9, 0x24, 0x80, 0xff, 0x07, 0x6e, 0xc0, 0x9c, 0x89, 0x2d, 0x54, 0x1b, 0xe7, 0x21, 0x6d,
-	0xfd, 0xad, 0x00, 0x96, 0x07, 0x6f, 0x55, 0x78, 0x1b, 0xdc, 0xca, 0x1e, 0x1c, 0xec, 0x69, 0xb9,
-	0x6c, 0x49, 0x2b, 0xe4, 0x8d, 0xc3, 0xbc, 0x52, 0xc8, 0x6f, 0x6b, 0xf9, 0x1d, 0x43, 0x57, 0xb3,
-	0xc5, 0x42, 0xde, 0x50, 0xf7, 0xd4, 0x5c, 0x49, 0x7b, 0xac, 0x26, 0x63, 0xf0, 0x2e, 0xb8, 0x73,
-	0x65, 0xa9, 0xa2, 0xee, 0x15, 0x9e, 0x18, 0xfb, 0x5a, 0xde, 0x28, 0x96, 0xb2, 0x8f, 0xd4, 0xa4,
-	0x00, 0xd7, 0xc1, 0xea, 0x95, 0x88, 0x7d, 0x6d, 0x47, 0xe7, 0xa9, 0x64, 0x5c, 0xd1, 0x3f, 0xb7,
-	0x44, 0xe1, 0xa4, 0x25, 0x0a, 0xa7, 0x2d, 0x51, 0xf8, 0xd1, 0x12, 0x85, 0x37, 0x67, 0x62, 0xec,
-	0xe4, 0x4c, 0x8c, 0x9d, 0x9e, 0x89, 0xb1, 0x67, 0xf7, 0x1d, 0x97, 0x55, 0x1a, 0x65, 0xc9, 0x22,
-	0x47, 0x72, 0x9d, 0x54, 0xd9, 0x06, 0x46, 0xec, 0x05, 0xf1, 0xaa, 0x7c, 0xe1, 0x91, 0x5a, 0x4d,
-	0x7e, 0x79, 0x79, 0xb6, 0x2e, 0x4f, 0xf2, 0xe1, 0xfa, 0xde, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xde, 0x24, 0x3c, 0xde, 0x20, 0x0c, 0x00, 0x00,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x1d, 0x5a, 0x29, 0x53, 0x51, 0xd2, 0x69, 0xd4, 0xcd, 0x16, 0x64, 0x2f, 0x91, 0x58,
+	0xed, 0x2e, 0xac, 0xcd, 0x16, 0x4e, 0x48, 0x08, 0xc5, 0xa9, 0xe9, 0x7a, 0x69, 0x93, 0x6a, 0x92,
+	0x05, 0xc4, 0xc5, 0x72, 0xec, 0xc1, 0xb1, 0x92, 0xce, 0x44, 0x1e, 0xa7, 0xc0, 0x07, 0x40, 0x5c,
+	0xf9, 0x12, 0x88, 0x13, 0x17, 0xc4, 0x95, 0x33, 0x1c, 0x38, 0xac, 0x10, 0x42, 0x3d, 0x59, 0x28,
+	0xbd, 0xf9, 0x4b, 0x80, 0x6c, 0x4f, 0x12, 0xb7, 0x4d, 0x9a, 0x04, 0x75, 0xa5, 0x1c, 0x7a, 0x72,
+	0xe6, 0xfd, 0xf9, 0xf9, 0xe7, 0xdf, 0x7b, 0xf3, 0x32, 0x03, 0xa4, 0x3e, 0xb5, 0xbb, 0x38, 0x50,
+	0xad, 0x7e, 0xbf, 0xe7, 0xd9, 0x56, 0xe0, 0x51, 0xa2, 0xe2, 0x53, 0x4c, 0x02, 0xa5, 0xef, 0xd3,
+	0x80, 0x42, 0x98, 0xfa, 0x95, 0x8c, 0x7f, 0xf7, 0xae, 0x4d, 0xd9, 0x09, 0x65, 0x66, 0x12, 0xa1,
+	0xa6, 0x8b, 0x34, 0x7c, 0xb7, 0xe4, 0x52, 0x97, 0xa6, 0xf6, 0xf8, 0x17, 0xb7, 0x4a, 0x69, 0x8c,
+	0xda, 0xb6, 0x18, 0x56, 0x4f, 0x9f, 0xb4, 0x71, 0x60, 0x3d, 0x51, 0x6d, 0xea, 0x11, 0xee, 0x7f,
+	0x9d, 0x93, 0x60, 0x1d, 0xcb, 0xc7, 0x8e, 0xca, 0xb0, 0x7f, 0xea, 0xd9, 0x78, 0x94, 0x3c, 0x85,
+	0x61, 0xf0, 0x4d, 0x1f, 0xf3, 0x57, 0x56, 0x7e, 0x16, 0xc0, 0x8e, 0x1e, 0x33, 0xae, 0x4e, 0x02,
+	0x9a, 0x81, 0xd5, 0xc5, 0x0e, 0x44, 0x60, 0x23, 0x93, 0x55, 0x16, 0xee, 0x09, 0x0f, 0x36, 0xf6,
+	0x64, 0xe5, 0xea, 0x27, 0x29, 0x99, 0x5c, 0xed, 0xb5, 0x28, 0x94, 0xb3, 0x79, 0x28, 0xbb, 0x80,
+	0xfb, 0x00, 0x32, 0xcc, 0x98, 0x47, 0x89, 0x89, 0x89, 0x63, 0x76, 0xb0, 0xe7, 0x76, 0x82, 0xb2,
+	0x78, 0x4f, 0x78, 0x90, 0xd7, 0x76, 0xa2, 0x50, 0x9e, 0xe2, 0x45, 0x45, 0x6e, 0xd3, 0x89, 0xf3,
+	0x34, 0xb1, 0x54, 0x7e, 0x12, 0xc0, 0x56, 0x42, 0x1a, 0x61, 0x07, 0xf7, 0xb0, 0x9b, 0x62, 0xaf,
+	0x2e, 0xdf, 0x7f, 0x45, 0x00, 0x13, 0xbe, 0x2d, 0xdf, 0x22, 0xec, 0x4b, 0xec, 0x6b, 0xd8, 0xf5,
+	0x08, 0xfc, 0x08, 0x6c, 0x32, 0x3a, 0xf0, 0x6d, 0x6c, 0x5a, 0x8e, 0xe3, 0x63, 0xc6, 0x12, 0xce,
+	0x05, 0xad, 0xfc, 0xe7, 0x2f, 0x8f, 0x4b, 0xbc, 0x31, 0xaa, 0xa9, 0xa7, 0x19, 0xf8, 0x1e, 0x71,
+	0xd1, 0xab, 0x69, 0x3c, 0x37, 0x42, 0x03, 0x6c, 0x3b, 0x98, 0x05, 0x1e, 0x49, 0xc8, 0x8e, 0x51,
+	0xc4, 0x39, 0x28, 0x30, 0x93, 0x34, 0x82, 0xaa, 0x03, 0x38, 0xe2, 0x92, 0xd1, 0x30, 0xbf, 0x90,
+	0x86, 0x68, 0x8b, 0xd3, 0x9a, 0x2b, 0xdc, 0x2b, 0xcb, 0x09, 0x07, 0x0f, 0xc0, 0x76, 0xc0, 0x25,
+	0xcb, 0xc2, 0xac, 0x25, 0x30, 0x77, 0xa2, 0x50, 0x9e, 0xe6, 0x46, 0x5b, 0x23, 0xe3, 0xa4, 0x02,
+	0xdf, 0xe5, 0x41, 0xf1, 0x42, 0x05, 0x74, 0xe2, 0xac, 0x94, 0xfe, 0x9f, 0x83, 0x3b, 0x17, 0xa0,
+	0x96, 0x2f, 0xc2, 0x4e, 0x16, 0x75, 0x75, 0x2b, 0xf1, 0xc7, 0xe5, 0xbd, 0xa0, 0xfb, 0x3e, 0xf5,
+	0x6f, 0xf7, 0xc2, 0xdc, 0x0a, 0x94, 0xc0, 0x1a, 0x8e, 0xa5, 0x4a, 0x34, 0x2f, 0xa0, 0x74, 0x51,
+	0xf9, 0x5b, 0x04, 0xd2, 0xe5, 0xf9, 0xfd, 0x9c, 0xb4, 0x29, 0x71, 0x3c, 0xe2, 0xa6, 0x63, 0xe6,
+	0x65, 0xcc, 0x45, 0x04, 0xd6, 0x7d, 0x6c, 0x31, 0x4a, 0x12, 0x81, 0x37, 0xf7, 0x94, 0x39, 0x70,
+	0x63, 0x4a, 0x28, 0xc9, 0xd2, 0x40, 0x14, 0xca, 0x1c, 0x01, 0xf1, 0xe7, 0x0c, 0x99, 0xf2, 0x4b,
+	0xca, 0xf4, 0x31, 0x28, 0x0d, 0x46, 0x2f, 0xbb, 0x2a, 0x77, 0x29, 0x0a, 0xe5, 0xe2, 0xc4, 0xcf,
+	0x51, 0xe0, 0xd8, 0x32, 0xe9, 0xd3, 0xbf, 0x44, 0xf0, 0xc6, 0x4c, 0x61, 0xe3, 0xe9, 0x71, 0x2b,
+	0xeb, 0xff, 0x91, 0xf5, 0xdb, 0x3c, 0x78, 0x7b, 0xea, 0x79, 0x63, 0x1f, 0xdb, 0x31, 0x61, 0x7c,
+	0xa9, 0x79, 0x0d, 0xb0, 0x9d, 0xf9, 0xf6, 0x85, 0x87, 0x03, 0xcc, 0x24, 0x8d, 0xb6, 0xf5, 0x87,
+	0x60, 0xdd, 0x3a, 0xa1, 0x03, 0x92, 0xfe, 0x7f, 0x6f, 0xec, 0xdd, 0x55, 0x78, 0x6a, 0x7c, 0xb0,
+	0x52, 0xf8, 0xc1, 0x4a, 0xa9, 0x51, 0x8f, 0xeb, 0x98, 0x06, 0x23, 0xfe, 0x84, 0x1f, 0x80, 0x35,
+	0x16, 0x13, 0x2d, 0xe7, 0xe7, 0x65, 0x17, 0xa2, 0x50, 0x4e, 0x63, 0x51, 0xfa, 0xb8, 0xa1, 0x09,
+	0xf0, 0x6c, 0x46, 0x0d, 0xd2, 0x21, 0x5c, 0x8e, 0x42, 0x79, 0xaa, 0x7f, 0x6a, 0x1d, 0x7e, 0x13,
+	0xc1, 0xc3, 0xc5, 0xea, 0x10, 0xf7, 0xfa, 0xea, 0x54, 0xe1, 0x66, 0xba, 0xf9, 0xd9, 0xb5, 0xdd,
+	0xbc, 0x9c, 0x92, 0x3f, 0x8a, 0xe0, 0xfe, 0x54, 0x25, 0x9b, 0xc1, 0xc0, 0xee, 0x1a, 0xe4, 0x88,
+	0x3a, 0x83, 0x1e, 0x3e, 0xa6, 0xb4, 0x77, 0x93, 0x32, 0x3e, 0x05, 0x80, 0xc5, 0xf8, 0xa6, 0x4d,
+	0x3d, 0x32, 0x5f, 0xca, 0xcd, 0x28, 0x94, 0x33, 0x09, 0xa8, 0x90, 0xfc, 0x8e, 0x5d, 0xb0, 0x32,
+	0x9e, 0x39, 0xf9, 0x84, 0xc7, 0xe2, 0x33, 0x64, 0xc9, 0xfe, 0xad, 0xfc, 0x2a, 0x80, 0x37, 0x67,
+	0x8e, 0xd4, 0x9a, 0x45, 0x6c, 0xdc, 0x5b, 0xe5, 0x6b, 0xc7, 0xa3, 0x1f, 0x04, 0xb0, 0x3b, 0x7b,
+	0xf8, 0xc2, 0x87, 0xe0, 0xad, 0xea, 0xf1, 0xf1, 0xa1, 0x51, 0xab, 0xb6, 0x8c, 0x46, 0xdd, 0x7c,
+	0x5e, 0xd7, 0x1a, 0xf5, 0x7d, 0xa3, 0x7e, 0x60, 0x22, 0xbd, 0xda, 0x6c, 0xd4, 0x4d, 0xfd, 0x50,
+	0xaf, 0xb5, 0x8c, 0x4f, 0xf5, 0x62, 0x0e, 0xbe, 0x0b, 0xde, 0xb9, 0x36, 0x54, 0xd3, 0x0f, 0x1b,
+	0x9f, 0x99, 0x47, 0x46, 0xdd, 0x6c, 0xb6, 0xaa, 0x9f, 0xe8, 0x45, 0x01, 0x3e, 0x02, 0xf7, 0xaf,
+	0xcd, 0x38, 0x32, 0x0e, 0x50, 0xe2, 0x2a, 0x8a, 0x1a, 0xfa, 0x7d, 0x28, 0x09, 0x2f, 0x86, 0x92,
+	0x70, 0x36, 0x94, 0x84, 0x7f, 0x86, 0x92, 0xf0, 0xfd, 0xb9, 0x94, 0x7b, 0x71, 0x2e, 0xe5, 0xce,
+	0xce, 0xa5, 0xdc, 0x17, 0xef, 0xbb, 0x5e, 0xd0, 0x19, 0xb4, 0x15, 0x9b, 0x9e, 0xa8, 0x7d, 0xda,
+	0x0d, 0x1e, 0x13, 0x1c, 0x7c, 0x45, 0xfd, 0x6e, 0xb2, 0xf0, 0x69, 0xaf, 0xa7, 0x7e, 0x7d, 0xf5,
+	0xb6, 0xd8, 0x5e, 0x4f, 0xae, 0x8b, 0xef, 0xfd, 0x37, 0x00, 0x8c, 0xab, 0x1b, 0xcd, 0xf2, 0x0e,
+	0x00, 0x00,
 }
 
 func (m *EventApplicationStaked) Marshal() (dAtA []byte, err error) {
@@ -1099,6 +1270,122 @@ func (m *EventApplicationUnbondingEnd) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *EventApplicationStakeDecreaseUnbondingBegin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingBegin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingBegin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Stake != nil {
+		{
+			size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ApplicationAddress) > 0 {
+		i -= len(m.ApplicationAddress)
+		copy(dAtA[i:], m.ApplicationAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ApplicationAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingEnd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingEnd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ApplicationAddress) > 0 {
+		i -= len(m.ApplicationAddress)
+		copy(dAtA[i:], m.ApplicationAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ApplicationAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventApplicationStakeStuckInModulePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1362,6 +1649,56 @@ func (m *EventApplicationUnbondingEnd) Size() (n int) {
 	return n
 }
 
+func (m *EventApplicationStakeDecreaseUnbondingBegin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ApplicationAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Stake != nil {
+		l = m.Stake.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventApplicationStakeDecreaseUnbondingEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ApplicationAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
 func (m *EventApplicationStakeStuckInModulePool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2481,6 +2818,354 @@ func (m *EventApplicationUnbondingEnd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventApplicationStakeDecreaseUnbondingBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventApplicationStakeDecreaseUnbondingBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventApplicationStakeDecreaseUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stake == nil {
+				m.Stake = &types.Coin{}
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventApplicationStakeDecreaseUnbondingEnd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventApplicationStakeDecreaseUnbondingEnd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventApplicationStakeDecreaseUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventApplicationStakeStuckInModulePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
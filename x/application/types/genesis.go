@@ -52,6 +52,11 @@ func (gs GenesisState) Validate() error {
 			return ErrAppInvalidStake.Wrapf("invalid stake amount denom for application %v", app.Stake)
 		}
 
+		// Validate the application pending stake decreases
+		if err := sharedtypes.ValidatePendingStakeDecreases(app.PendingStakeDecreases); err != nil {
+			return ErrAppInvalidStake.Wrapf("invalid pending stake decreases for application %q: %s", app.Address, err)
+		}
+
 		// Check that the application's delegated gateway addresses are valid
 		for _, gatewayAddr := range app.DelegateeGatewayAddresses {
 			if _, err := sdk.AccAddressFromBech32(gatewayAddr); err != nil {
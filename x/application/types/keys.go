@@ -14,6 +14,9 @@ package types
 // │ ApplicationTransferKeyPrefix    +         Application/transfer/                    │
 // │                                           └── <AppAddr>/                           │
 // │                                                                                    │
+// │ ApplicationStakeDecreaseKeyPrefix +       Application/stake_decrease/              │
+// │                                           └── <AppAddr>/                           │
+// │                                                                                    │
 // │ UndelegationKey()                         Application/undelegation/                │
 // │                                           └── <AppAddr>/                           │
 // │                                               <GatewayAddr>/                       │
@@ -65,6 +68,10 @@ const (
 	// - Prefix: Application/transfer/
 	ApplicationTransferKeyPrefix = "Application/transfer/"
 
+	// ApplicationStakeDecreaseKeyPrefix indexes applications with pending stake decreases
+	// - Prefix: Application/stake_decrease/
+	ApplicationStakeDecreaseKeyPrefix = "Application/stake_decrease/"
+
 	// UndelegationKeyPrefix indexes pending undelegations
 	// - Prefix: Application/undelegation/
 	UndelegationKeyPrefix = "Application/undelegation/"
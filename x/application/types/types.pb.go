@@ -70,6 +70,10 @@ type Application struct {
 	// forever avoids the pruning-induced historical-query non-determinism observed
 	// on the supplier side (see session_mutation_analysis).
 	ServiceConfigHistory []*ApplicationServiceConfigUpdate `protobuf:"bytes,9,rep,name=service_config_history,json=serviceConfigHistory,proto3" json:"service_config_history,omitempty"`
+	// Amounts removed from the stake which are unbonding, ordered by the session
+	// end height at which they were removed. They are returned to the application
+	// at the end of their unbonding period.
+	PendingStakeDecreases []*types1.PendingStakeDecrease `protobuf:"bytes,10,rep,name=pending_stake_decreases,json=pendingStakeDecreases,proto3" json:"pending_stake_decreases,omitempty"`
 }
 
 func (m *Application) Reset()         { *m = Application{} }
@@ -164,6 +168,13 @@ func (m *Application) GetServiceConfigHistory() []*ApplicationServiceConfigUpdat
 	return nil
 }
 
+func (m *Application) GetPendingStakeDecreases() []*types1.PendingStakeDecrease {
+	if m != nil {
+		return m.PendingStakeDecreases
+	}
+	return nil
+}
+
 // ApplicationServiceConfigUpdate tracks a change in an application's service
 // configuration at a specific block height, enabling deterministic
 // reconstruction of which service an application was staked for at any height.
@@ -395,53 +406,56 @@ func init() {
 func init() { proto.RegisterFile("pocket/application/types.proto", fileDescriptor_17caf8b8f14e547c) }
 
 var fileDescriptor_17caf8b8f14e547c = []byte{
-	// 731 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4e, 0xdb, 0x40,
-	0x14, 0xc6, 0xe3, 0x24, 0x40, 0x19, 0x24, 0x08, 0x43, 0x5a, 0x9c, 0x50, 0xb9, 0x51, 0x36, 0x8d,
-	0x44, 0xb1, 0x45, 0xda, 0x45, 0xff, 0xac, 0x12, 0x84, 0x0a, 0x12, 0x0b, 0xe4, 0x94, 0x45, 0xab,
-	0x4a, 0x96, 0x63, 0x0f, 0xce, 0x28, 0x61, 0xc6, 0xf2, 0x4c, 0x42, 0x73, 0x87, 0x2e, 0xba, 0xe9,
-	0x09, 0x7a, 0x85, 0x1e, 0x82, 0x25, 0xea, 0x8a, 0x55, 0x55, 0x85, 0x8b, 0x54, 0x9e, 0x19, 0x83,
-	0x43, 0x12, 0x48, 0xa5, 0x6e, 0x90, 0x87, 0xef, 0x7d, 0x2f, 0x3f, 0xbf, 0xf9, 0x9e, 0x0c, 0x8c,
-	0x90, 0x7a, 0x5d, 0xc4, 0x2d, 0x37, 0x0c, 0x7b, 0xd8, 0x73, 0x39, 0xa6, 0xc4, 0xe2, 0xc3, 0x10,
-	0x31, 0x33, 0x8c, 0x28, 0xa7, 0x10, 0x4a, 0xdd, 0x4c, 0xe9, 0xe5, 0x62, 0x40, 0x03, 0x2a, 0x64,
-	0x2b, 0x7e, 0x92, 0x95, 0x65, 0xc3, 0xa3, 0xec, 0x8c, 0x32, 0xab, 0xed, 0x32, 0x64, 0x0d, 0x76,
-	0xdb, 0x88, 0xbb, 0xbb, 0x96, 0x47, 0x31, 0x51, 0x7a, 0x49, 0xea, 0x8e, 0x34, 0xca, 0x83, 0x92,
-	0xb6, 0x14, 0x04, 0xeb, 0xb8, 0x11, 0xf2, 0x2d, 0x86, 0xa2, 0x01, 0xf6, 0x90, 0x14, 0xab, 0xa3,
-	0x45, 0xb0, 0xd2, 0xb8, 0xfd, 0x75, 0x58, 0x07, 0x4b, 0xae, 0xef, 0x47, 0x88, 0x31, 0x5d, 0xab,
-	0x68, 0xb5, 0xe5, 0xa6, 0xfe, 0xeb, 0xe7, 0x4e, 0x51, 0xf5, 0x6b, 0x48, 0xa5, 0xc5, 0x23, 0x4c,
-	0x02, 0x3b, 0x29, 0x84, 0x16, 0x58, 0x60, 0xdc, 0xed, 0x22, 0x3d, 0x5b, 0xd1, 0x6a, 0x2b, 0xf5,
-	0x92, 0xa9, 0xca, 0x63, 0x56, 0x53, 0xb1, 0x9a, 0x7b, 0x14, 0x13, 0x5b, 0xd6, 0xc1, 0x63, 0xb0,
-	0xa6, 0x28, 0x1c, 0x8f, 0x92, 0x53, 0x1c, 0x30, 0x3d, 0x57, 0xc9, 0xd5, 0x56, 0xea, 0xcf, 0x4d,
-	0x35, 0x10, 0xc9, 0x6a, 0xa6, 0xc8, 0x5a, 0xd2, 0xb0, 0x27, 0xea, 0xed, 0x55, 0x96, 0x3e, 0x32,
-	0xf8, 0x19, 0x6c, 0xf9, 0xa8, 0x87, 0x02, 0x97, 0x23, 0xe4, 0xc4, 0x7f, 0xcf, 0xdd, 0xa1, 0xa3,
-	0xf8, 0x10, 0xd3, 0xf3, 0x95, 0x5c, 0x6d, 0xb9, 0xf9, 0xf4, 0xe2, 0xf7, 0xb3, 0xcc, 0xcc, 0xd7,
-	0x29, 0xdd, 0x34, 0x78, 0x2f, 0xfd, 0x8d, 0xc4, 0x0e, 0x39, 0x78, 0x1c, 0x22, 0xe2, 0x63, 0x12,
-	0x38, 0x7d, 0xa2, 0xca, 0x30, 0x25, 0x4c, 0x5f, 0x10, 0xd4, 0x6f, 0xcc, 0xc9, 0x6b, 0x4c, 0xa3,
-	0x9b, 0xc7, 0xd2, 0x7c, 0x92, 0xf6, 0xee, 0x13, 0x1e, 0x0d, 0x9b, 0xf9, 0x18, 0xc9, 0x2e, 0x86,
-	0x53, 0x0a, 0xe0, 0x3b, 0x50, 0xee, 0x13, 0x31, 0x30, 0x87, 0x21, 0xc6, 0x30, 0x25, 0x0e, 0x22,
-	0xbe, 0xd3, 0x41, 0x38, 0xe8, 0x70, 0x7d, 0xb1, 0xa2, 0xd5, 0xf2, 0xf6, 0xa6, 0xaa, 0x68, 0xc9,
-	0x82, 0x7d, 0xe2, 0x1f, 0x08, 0x19, 0x7e, 0x04, 0x85, 0x04, 0x99, 0x47, 0x2e, 0x61, 0xa7, 0x28,
-	0xd2, 0x97, 0xc4, 0xf5, 0x98, 0xd3, 0x68, 0x15, 0x61, 0x0a, 0xfa, 0x83, 0x72, 0xd9, 0x6b, 0xaa,
-	0x4f, 0xf2, 0x0f, 0x78, 0x0c, 0x36, 0x43, 0x14, 0xdd, 0x30, 0xb1, 0x58, 0x77, 0x7a, 0xf8, 0x0c,
-	0x73, 0xfd, 0xd1, 0x43, 0x01, 0x28, 0x86, 0x28, 0x52, 0xac, 0xad, 0xd8, 0x77, 0x14, 0xdb, 0x60,
-	0x07, 0x3c, 0x19, 0xcf, 0x83, 0xd3, 0xc1, 0x8c, 0xd3, 0x68, 0xa8, 0x2f, 0x8b, 0x01, 0xd7, 0x1f,
-	0x18, 0xf0, 0x58, 0x36, 0x4e, 0x42, 0xdf, 0xe5, 0xc8, 0x2e, 0x8e, 0x25, 0xe4, 0x40, 0xf6, 0x2b,
-	0x73, 0x50, 0x9a, 0x79, 0x19, 0xb0, 0x00, 0x72, 0x5d, 0x34, 0x14, 0xb9, 0xcf, 0xdb, 0xf1, 0x23,
-	0x6c, 0x80, 0x85, 0x81, 0xdb, 0xeb, 0x27, 0xc9, 0xde, 0x9e, 0xc6, 0x71, 0xdb, 0x88, 0x04, 0x2a,
-	0x39, 0x47, 0x98, 0x71, 0x5b, 0x3a, 0xdf, 0x66, 0x5f, 0x6b, 0xd5, 0xaf, 0x59, 0x60, 0xdc, 0x8f,
-	0x0b, 0x0f, 0xc1, 0x46, 0xaa, 0xa9, 0x33, 0xef, 0x0e, 0xc2, 0x94, 0x49, 0x29, 0xb0, 0x01, 0x96,
-	0xd4, 0xbb, 0x2b, 0xec, 0xb9, 0xb7, 0x2a, 0xf1, 0xc1, 0x6d, 0xb0, 0xee, 0x7a, 0x1c, 0x0f, 0x24,
-	0x8c, 0x4a, 0x5c, 0xae, 0xa2, 0xd5, 0x72, 0x76, 0xe1, 0x56, 0x50, 0x51, 0xb3, 0xc0, 0x86, 0x8f,
-	0x26, 0xcb, 0xf3, 0xa2, 0x1c, 0xa6, 0x25, 0x69, 0xa8, 0xfa, 0x60, 0x73, 0xc6, 0xd0, 0xe0, 0x21,
-	0x58, 0x9f, 0xdc, 0xde, 0xec, 0x1c, 0xdb, 0x5b, 0x08, 0xee, 0x2c, 0x6d, 0xf5, 0xbb, 0x06, 0xca,
-	0xb3, 0x63, 0x1d, 0x0f, 0xdc, 0x47, 0x8c, 0x63, 0xf2, 0x8f, 0x03, 0x4f, 0x99, 0x92, 0x81, 0xbf,
-	0x00, 0x70, 0xca, 0x82, 0x66, 0x45, 0x8c, 0x0a, 0xec, 0xce, 0x66, 0x56, 0x7f, 0x68, 0x60, 0x63,
-	0x4a, 0x06, 0xff, 0x6f, 0x02, 0xd6, 0xee, 0x4c, 0x51, 0xd0, 0xdc, 0xd7, 0x66, 0x75, 0x7c, 0x7e,
-	0x4d, 0xfb, 0x62, 0x64, 0x68, 0x97, 0x23, 0x43, 0xbb, 0x1a, 0x19, 0xda, 0x9f, 0x91, 0xa1, 0x7d,
-	0xbb, 0x36, 0x32, 0x97, 0xd7, 0x46, 0xe6, 0xea, 0xda, 0xc8, 0x7c, 0x7a, 0x15, 0x60, 0xde, 0xe9,
-	0xb7, 0x4d, 0x8f, 0x9e, 0x59, 0x21, 0xed, 0xf2, 0x1d, 0x82, 0xf8, 0x39, 0x8d, 0xba, 0xe2, 0x10,
-	0xd1, 0x5e, 0xcf, 0xfa, 0x32, 0xf9, 0xcd, 0x6b, 0x2f, 0x8a, 0x4f, 0xce, 0xcb, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xdc, 0x34, 0x0f, 0x6f, 0x16, 0x07, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcb, 0x4e, 0xdb, 0x40,
+	0x14, 0x8d, 0x93, 0x40, 0xca, 0x20, 0x41, 0x18, 0x42, 0xe3, 0x84, 0xca, 0x8d, 0xd2, 0x45, 0x23,
+	0x51, 0x6c, 0x91, 0x76, 0xd1, 0xc7, 0x2a, 0xa1, 0xa8, 0x20, 0xb1, 0x40, 0x4e, 0x59, 0xf4, 0x21,
+	0x59, 0x8e, 0x3d, 0x38, 0xa3, 0x84, 0x19, 0xcb, 0x33, 0x09, 0xcd, 0x3f, 0x74, 0xd1, 0x4d, 0xbf,
+	0xa0, 0xbf, 0xd0, 0x7d, 0xb7, 0x2c, 0x51, 0x57, 0xac, 0xaa, 0x2a, 0xfc, 0x48, 0x65, 0xcf, 0x18,
+	0x9c, 0x57, 0xa1, 0x52, 0x37, 0x51, 0xc6, 0xe7, 0x9c, 0xeb, 0x33, 0x67, 0xee, 0x1d, 0x03, 0xcd,
+	0xa7, 0x4e, 0x17, 0x71, 0xc3, 0xf6, 0xfd, 0x1e, 0x76, 0x6c, 0x8e, 0x29, 0x31, 0xf8, 0xd0, 0x47,
+	0x4c, 0xf7, 0x03, 0xca, 0x29, 0x84, 0x02, 0xd7, 0x13, 0x78, 0xb9, 0xe0, 0x51, 0x8f, 0x46, 0xb0,
+	0x11, 0xfe, 0x13, 0xcc, 0xb2, 0xe6, 0x50, 0x76, 0x4a, 0x99, 0xd1, 0xb6, 0x19, 0x32, 0x06, 0x3b,
+	0x6d, 0xc4, 0xed, 0x1d, 0xc3, 0xa1, 0x98, 0x48, 0xbc, 0x24, 0x70, 0x4b, 0x08, 0xc5, 0x42, 0x42,
+	0x9b, 0xd2, 0x04, 0xeb, 0xd8, 0x01, 0x72, 0x0d, 0x86, 0x82, 0x01, 0x76, 0x90, 0x04, 0xab, 0x13,
+	0x20, 0xb7, 0xbb, 0xc8, 0x72, 0x91, 0x13, 0x20, 0x9b, 0x49, 0x4e, 0xf5, 0x47, 0x0e, 0x2c, 0x37,
+	0x6e, 0x1c, 0xc2, 0x3a, 0xc8, 0xd9, 0xae, 0x1b, 0x20, 0xc6, 0x54, 0xa5, 0xa2, 0xd4, 0x96, 0x9a,
+	0xea, 0xcf, 0xef, 0xdb, 0x05, 0xf9, 0xce, 0x86, 0x40, 0x5a, 0x3c, 0xc0, 0xc4, 0x33, 0x63, 0x22,
+	0x34, 0xc0, 0x42, 0x54, 0x5b, 0x4d, 0x57, 0x94, 0xda, 0x72, 0xbd, 0xa4, 0x4b, 0x7a, 0xb8, 0x1f,
+	0x5d, 0xee, 0x47, 0xdf, 0xa5, 0x98, 0x98, 0x82, 0x07, 0x8f, 0xc0, 0xaa, 0x74, 0x6a, 0x39, 0x94,
+	0x9c, 0x60, 0x8f, 0xa9, 0x99, 0x4a, 0xa6, 0xb6, 0x5c, 0x7f, 0xac, 0xcb, 0xd0, 0x84, 0x65, 0x3d,
+	0xe1, 0xac, 0x25, 0x04, 0xbb, 0x11, 0xdf, 0x5c, 0x61, 0xc9, 0x25, 0x83, 0x1f, 0xc1, 0xa6, 0x8b,
+	0x7a, 0xc8, 0xb3, 0x39, 0x42, 0x56, 0xf8, 0x7b, 0x66, 0x0f, 0x2d, 0xe9, 0x0f, 0x31, 0x35, 0x5b,
+	0xc9, 0xd4, 0x96, 0x9a, 0x0f, 0xce, 0x7f, 0x3d, 0x4c, 0xcd, 0xdd, 0x4e, 0xe9, 0xba, 0xc0, 0x1b,
+	0xa1, 0x6f, 0xc4, 0x72, 0xc8, 0xc1, 0x86, 0x8f, 0x88, 0x8b, 0x89, 0x67, 0xf5, 0x89, 0xa4, 0x61,
+	0x4a, 0x98, 0xba, 0x10, 0xb9, 0x7e, 0xa1, 0x4f, 0x1f, 0x75, 0xd2, 0xba, 0x7e, 0x24, 0xc4, 0xc7,
+	0x49, 0xed, 0x1e, 0xe1, 0xc1, 0xb0, 0x99, 0x0d, 0x2d, 0x99, 0x05, 0x7f, 0x06, 0x01, 0xbe, 0x02,
+	0xe5, 0x3e, 0x11, 0x87, 0xc6, 0x10, 0x63, 0x98, 0x12, 0x0b, 0x11, 0xd7, 0xea, 0x20, 0xec, 0x75,
+	0xb8, 0xba, 0x58, 0x51, 0x6a, 0x59, 0xb3, 0x28, 0x19, 0x2d, 0x41, 0xd8, 0x23, 0xee, 0x7e, 0x04,
+	0xc3, 0x77, 0x20, 0x1f, 0x5b, 0xe6, 0x81, 0x4d, 0xd8, 0x09, 0x0a, 0xd4, 0x5c, 0x74, 0x3c, 0xfa,
+	0x2c, 0xb7, 0xd2, 0x61, 0xc2, 0xf4, 0x5b, 0xa9, 0x32, 0x57, 0x65, 0x9d, 0xf8, 0x01, 0x3c, 0x02,
+	0x45, 0x1f, 0x05, 0xd7, 0x9e, 0x58, 0x88, 0x5b, 0x3d, 0x7c, 0x8a, 0xb9, 0x7a, 0xef, 0xb6, 0x06,
+	0x28, 0xf8, 0x28, 0x90, 0x5e, 0x5b, 0xa1, 0xee, 0x30, 0x94, 0xc1, 0x0e, 0xb8, 0x3f, 0xde, 0x0f,
+	0x56, 0x07, 0x33, 0x4e, 0x83, 0xa1, 0xba, 0x14, 0x05, 0x5c, 0xbf, 0x25, 0xe0, 0xb1, 0xde, 0x38,
+	0xf6, 0x5d, 0x9b, 0x23, 0xb3, 0x30, 0xd6, 0x21, 0xfb, 0xa2, 0x1e, 0xfc, 0x00, 0x8a, 0x72, 0x3b,
+	0xd6, 0xf8, 0x38, 0x30, 0x15, 0x44, 0xaf, 0x7a, 0x34, 0xd1, 0x81, 0x32, 0x98, 0x56, 0x48, 0x7e,
+	0x2d, 0xb9, 0xe6, 0x86, 0x3f, 0xe3, 0x29, 0x2b, 0x73, 0x50, 0x9a, 0x7b, 0xd2, 0x30, 0x0f, 0x32,
+	0x5d, 0x34, 0x8c, 0x86, 0x2a, 0x6b, 0x86, 0x7f, 0x61, 0x03, 0x2c, 0x0c, 0xec, 0x5e, 0x3f, 0x1e,
+	0x9b, 0xad, 0x59, 0x9b, 0xbc, 0x29, 0x44, 0x3c, 0xd9, 0x96, 0x87, 0x98, 0x71, 0x53, 0x28, 0x5f,
+	0xa6, 0x9f, 0x2b, 0xd5, 0xcf, 0x69, 0xa0, 0xfd, 0x3d, 0x0b, 0x78, 0x00, 0xd6, 0x13, 0x45, 0xad,
+	0xbb, 0x0e, 0x38, 0x4c, 0x88, 0x24, 0x02, 0x1b, 0x20, 0x27, 0x83, 0x95, 0xb6, 0xef, 0x3c, 0xb2,
+	0xb1, 0x0e, 0x6e, 0x81, 0x35, 0xdb, 0xe1, 0x78, 0x20, 0xcc, 0xc8, 0x76, 0xce, 0x54, 0x94, 0x5a,
+	0xc6, 0xcc, 0xdf, 0x00, 0xb2, 0x8f, 0x0d, 0xb0, 0xee, 0xa2, 0x69, 0x7a, 0x36, 0xa2, 0x43, 0x17,
+	0x4d, 0x0a, 0xaa, 0x2e, 0x28, 0xce, 0x09, 0x0d, 0x1e, 0x80, 0xb5, 0xe9, 0xab, 0x21, 0x7d, 0x87,
+	0xab, 0x21, 0xef, 0x4d, 0xdc, 0x08, 0xd5, 0xaf, 0x0a, 0x28, 0xcf, 0x9f, 0x99, 0x30, 0x70, 0x17,
+	0x31, 0x8e, 0xc9, 0x3f, 0x06, 0x9e, 0x10, 0xc5, 0x81, 0x3f, 0x01, 0x70, 0xc6, 0xf4, 0xa7, 0xa3,
+	0x36, 0xca, 0xb3, 0x89, 0xb1, 0xaf, 0x7e, 0x53, 0xc0, 0xfa, 0x8c, 0x1e, 0xfc, 0xbf, 0x1d, 0xb0,
+	0x3a, 0x91, 0xa2, 0x9a, 0xbe, 0xa5, 0xcc, 0xca, 0x78, 0x7e, 0x4d, 0xf3, 0x7c, 0xa4, 0x29, 0x17,
+	0x23, 0x4d, 0xb9, 0x1c, 0x69, 0xca, 0xef, 0x91, 0xa6, 0x7c, 0xb9, 0xd2, 0x52, 0x17, 0x57, 0x5a,
+	0xea, 0xf2, 0x4a, 0x4b, 0xbd, 0x7f, 0xe6, 0x61, 0xde, 0xe9, 0xb7, 0x75, 0x87, 0x9e, 0x1a, 0x3e,
+	0xed, 0xf2, 0x6d, 0x82, 0xf8, 0x19, 0x0d, 0xba, 0xd1, 0x22, 0xa0, 0xbd, 0x9e, 0xf1, 0x69, 0xfa,
+	0xa3, 0xdb, 0x5e, 0x8c, 0xbe, 0x67, 0x4f, 0xff, 0x0c, 0x00, 0x1a, 0x5a, 0x93, 0xbc, 0x97, 0x07,
+	0x00, 0x00,
 }
 
 func (m *Application) Marshal() (dAtA []byte, err error) {
@@ -464,6 +478,20 @@ func (m *Application) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingStakeDecreases) > 0 {
+		for iNdEx := len(m.PendingStakeDecreases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingStakeDecreases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.ServiceConfigHistory) > 0 {
 		for iNdEx := len(m.ServiceConfigHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -798,6 +826,12 @@ func (m *Application) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.PendingStakeDecreases) > 0 {
+		for _, e := range m.PendingStakeDecreases {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1281,6 +1315,40 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingStakeDecreases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingStakeDecreases = append(m.PendingStakeDecreases, &types1.PendingStakeDecrease{})
+			if err := m.PendingStakeDecreases[len(m.PendingStakeDecreases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
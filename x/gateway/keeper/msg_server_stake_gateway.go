@@ -52,6 +52,20 @@ func (k msgServer) StakeGateway(
 		coinsToEscrow = *msg.Stake
 	} else {
 		logger.Info(fmt.Sprintf("gateway found; about to try and update gateway for address %q", msg.Address))
+
+		// A transferring or transferred gateway cannot be (re-)staked, since its
+		// stake is (about to be) held by the destination gateway.
+		if gateway.PendingTransfer != nil {
+			logger.Info(fmt.Sprintf("gateway with address %q is transferring", msg.Address))
+			return nil, status.Error(
+				codes.FailedPrecondition,
+				types.ErrGatewayIsTransferring.Wrapf(
+					"gateway with address %q is transferring to %q",
+					msg.Address, gateway.PendingTransfer.GetDestinationAddress(),
+				).Error(),
+			)
+		}
+
 		currGatewayStake := *gateway.Stake
 		if err = k.updateGateway(ctx, &gateway, msg); err != nil {
 			logger.Error(fmt.Sprintf("could not update gateway for address %q due to error %v", msg.Address, err))
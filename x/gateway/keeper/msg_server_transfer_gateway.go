@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/telemetry"
+	"github.com/pokt-network/poktroll/x/gateway/types"
+)
+
+// TransferGateway begins the transfer of a gateway (its stake, card and application
+// delegations) from a source to a (new) destination gateway address.
+// The transfer completes at the end of the current session, see EndBlockerTransferGateways.
+func (k msgServer) TransferGateway(
+	goCtx context.Context,
+	msg *types.MsgTransferGateway,
+) (*types.MsgTransferGatewayResponse, error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"transfer_gateway_begin",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logger := k.Logger().With("method", "TransferGateway")
+	logger.Info(fmt.Sprintf("About to transfer gateway with msg: %v", msg))
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Ensure the destination gateway does not already exist.
+	if _, isDstFound := k.GetGateway(ctx, msg.GetDestinationAddress()); isDstFound {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			types.ErrGatewayDuplicateAddress.Wrapf(
+				"destination gateway (%s) exists", msg.GetDestinationAddress(),
+			).Error(),
+		)
+	}
+
+	// Ensure the source gateway exists.
+	srcGateway, isSrcFound := k.GetGateway(ctx, msg.GetSourceAddress())
+	if !isSrcFound {
+		return nil, status.Error(
+			codes.NotFound,
+			types.ErrGatewayNotFound.Wrapf(
+				"source gateway (%s) not found", msg.GetSourceAddress(),
+			).Error(),
+		)
+	}
+
+	// Ensure the source gateway is not unbonding, which also covers already transferred gateways.
+	if srcGateway.IsUnbonding() {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			types.ErrGatewayIsUnstaking.Wrapf(
+				"cannot transfer unbonding source gateway %q", msg.GetSourceAddress(),
+			).Error(),
+		)
+	}
+
+	// Ensure the source gateway is not already transferring.
+	if srcGateway.HasPendingTransfer() {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			types.ErrGatewayIsTransferring.Wrapf(
+				"source gateway %q is already transferring to %q",
+				msg.GetSourceAddress(), srcGateway.PendingTransfer.GetDestinationAddress(),
+			).Error(),
+		)
+	}
+
+	sessionEndHeight := k.sharedKeeper.GetSessionEndHeight(ctx, ctx.BlockHeight())
+
+	// The source gateway MAY continue processing requests until the current session ends.
+	srcGateway.PendingTransfer = &types.PendingGatewayTransfer{
+		DestinationAddress: msg.GetDestinationAddress(),
+		SessionEndHeight:   uint64(sessionEndHeight),
+	}
+	k.SetGateway(ctx, srcGateway)
+	logger.Info(fmt.Sprintf(
+		"Successfully began transfer of gateway from (%s) to (%s)",
+		srcGateway.GetAddress(), msg.GetDestinationAddress(),
+	))
+
+	transferBeginEvent := &types.EventGatewayTransferBegin{
+		SourceAddress:      srcGateway.GetAddress(),
+		DestinationAddress: msg.GetDestinationAddress(),
+		SourceGateway:      srcGateway.DehydratedForEvent(),
+		SessionEndHeight:   sessionEndHeight,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(transferBeginEvent); err != nil {
+		err = types.ErrGatewayEmitEvent.Wrapf("(%+v): %s", transferBeginEvent, err)
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	isSuccessful = true
+	return &types.MsgTransferGatewayResponse{}, nil
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	testsession "github.com/pokt-network/poktroll/testutil/session"
+	"github.com/pokt-network/poktroll/x/gateway/keeper"
+	"github.com/pokt-network/poktroll/x/gateway/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestMsgServer_TransferGateway_Success(t *testing.T) {
+	k, ctx := keepertest.GatewayKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithBlockHeight(1)
+
+	srcAddr := sample.AccAddressBech32()
+	dstAddr := sample.AccAddressBech32()
+
+	// Stake the source gateway and set its card.
+	initialStake := sdk.NewCoin("upokt", math.NewInt(100))
+	_, err := srv.StakeGateway(sdkCtx, &types.MsgStakeGateway{
+		Address: srcAddr,
+		Stake:   &initialStake,
+	})
+	require.NoError(t, err)
+
+	srcGateway, isGatewayFound := k.GetGateway(sdkCtx, srcAddr)
+	require.True(t, isGatewayFound)
+	srcGateway.Metadata = &sharedtypes.Metadata{Card: []byte(`{"name":"gateway"}`)}
+	k.SetGateway(sdkCtx, srcGateway)
+
+	// Begin the transfer.
+	_, err = srv.TransferGateway(sdkCtx, types.NewMsgTransferGateway(srcAddr, dstAddr))
+	require.NoError(t, err)
+
+	sessionEndHeight := testsession.GetSessionEndHeightWithDefaultParams(sdkCtx.BlockHeight())
+
+	srcGateway, isGatewayFound = k.GetGateway(sdkCtx, srcAddr)
+	require.True(t, isGatewayFound)
+	require.True(t, srcGateway.HasPendingTransfer())
+	require.Equal(t, dstAddr, srcGateway.PendingTransfer.GetDestinationAddress())
+	require.Equal(t, uint64(sessionEndHeight), srcGateway.PendingTransfer.GetSessionEndHeight())
+
+	// The source gateway can no longer be unstaked or transferred again.
+	_, err = srv.UnstakeGateway(sdkCtx, &types.MsgUnstakeGateway{Address: srcAddr})
+	require.ErrorContains(t, err, types.ErrGatewayIsTransferring.Error())
+
+	_, err = srv.TransferGateway(sdkCtx, types.NewMsgTransferGateway(srcAddr, sample.AccAddressBech32()))
+	require.ErrorContains(t, err, types.ErrGatewayIsTransferring.Error())
+
+	// The transfer does not complete before the end of the session.
+	sdkCtx = sdkCtx.WithBlockHeight(sessionEndHeight - 1)
+	numTransferredGateways, err := k.EndBlockerTransferGateways(sdkCtx)
+	require.NoError(t, err)
+	require.Zero(t, numTransferredGateways)
+
+	_, isGatewayFound = k.GetGateway(sdkCtx, dstAddr)
+	require.False(t, isGatewayFound)
+
+	// The transfer completes at the end of the session.
+	sdkCtx = sdkCtx.WithBlockHeight(sessionEndHeight)
+	numTransferredGateways, err = k.EndBlockerTransferGateways(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, numTransferredGateways)
+
+	dstGateway, isGatewayFound := k.GetGateway(sdkCtx, dstAddr)
+	require.True(t, isGatewayFound)
+	require.Equal(t, types.Gateway{
+		Address:                 dstAddr,
+		Stake:                   &initialStake,
+		UnstakeSessionEndHeight: types.GatewayNotUnstaking,
+		Metadata:                srcGateway.Metadata,
+	}, dstGateway)
+
+	// The source gateway is unbonding and inactive from the next session.
+	srcGateway, isGatewayFound = k.GetGateway(sdkCtx, srcAddr)
+	require.True(t, isGatewayFound)
+	require.True(t, srcGateway.IsTransferred())
+	require.Equal(t, uint64(sessionEndHeight), srcGateway.GetUnstakeSessionEndHeight())
+	require.True(t, srcGateway.IsActive(sessionEndHeight))
+	require.False(t, srcGateway.IsActive(sessionEndHeight+1))
+
+	// The source gateway can not be re-staked while it is unbonding.
+	upStake := sdk.NewCoin("upokt", math.NewInt(200))
+	_, err = srv.StakeGateway(sdkCtx, &types.MsgStakeGateway{
+		Address: srcAddr,
+		Stake:   &upStake,
+	})
+	require.ErrorContains(t, err, types.ErrGatewayIsTransferring.Error())
+
+	// The source gateway is removed once its unbonding period elapses.
+	sharedParams := sharedtypes.DefaultParams()
+	unbondingEndHeight := types.GetGatewayUnbondingHeight(&sharedParams, &srcGateway)
+	sdkCtx = sdkCtx.WithBlockHeight(unbondingEndHeight)
+	_, err = k.EndBlockerUnbondGateways(sdkCtx)
+	require.NoError(t, err)
+
+	_, isGatewayFound = k.GetGateway(sdkCtx, srcAddr)
+	require.False(t, isGatewayFound)
+
+	_, isGatewayFound = k.GetGateway(sdkCtx, dstAddr)
+	require.True(t, isGatewayFound)
+}
+
+func TestMsgServer_TransferGateway_Error(t *testing.T) {
+	k, ctx := keepertest.GatewayKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	stake := sdk.NewCoin("upokt", math.NewInt(100))
+	stakedAddr := sample.AccAddressBech32()
+	otherStakedAddr := sample.AccAddressBech32()
+	unstakingAddr := sample.AccAddressBech32()
+	for _, addr := range []string{stakedAddr, otherStakedAddr, unstakingAddr} {
+		_, err := srv.StakeGateway(ctx, &types.MsgStakeGateway{Address: addr, Stake: &stake})
+		require.NoError(t, err)
+	}
+	_, err := srv.UnstakeGateway(ctx, &types.MsgUnstakeGateway{Address: unstakingAddr})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc        string
+		msg         *types.MsgTransferGateway
+		expectedErr error
+	}{
+		{
+			desc:        "invalid destination address",
+			msg:         types.NewMsgTransferGateway(stakedAddr, "invalid_address"),
+			expectedErr: types.ErrGatewayInvalidAddress,
+		},
+		{
+			desc:        "destination gateway exists",
+			msg:         types.NewMsgTransferGateway(stakedAddr, otherStakedAddr),
+			expectedErr: types.ErrGatewayDuplicateAddress,
+		},
+		{
+			desc:        "source gateway not found",
+			msg:         types.NewMsgTransferGateway(sample.AccAddressBech32(), sample.AccAddressBech32()),
+			expectedErr: types.ErrGatewayNotFound,
+		},
+		{
+			desc:        "source gateway is unbonding",
+			msg:         types.NewMsgTransferGateway(unstakingAddr, sample.AccAddressBech32()),
+			expectedErr: types.ErrGatewayIsUnstaking,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := srv.TransferGateway(ctx, test.msg)
+			require.ErrorContains(t, err, test.expectedErr.Error())
+		})
+	}
+}
+
+func TestMsgServer_TransferGateway_DestinationStakedDuringTransfer(t *testing.T) {
+	k, ctx := keepertest.GatewayKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithBlockHeight(1)
+
+	srcAddr := sample.AccAddressBech32()
+	dstAddr := sample.AccAddressBech32()
+
+	stake := sdk.NewCoin("upokt", math.NewInt(100))
+	_, err := srv.StakeGateway(sdkCtx, &types.MsgStakeGateway{Address: srcAddr, Stake: &stake})
+	require.NoError(t, err)
+
+	_, err = srv.TransferGateway(sdkCtx, types.NewMsgTransferGateway(srcAddr, dstAddr))
+	require.NoError(t, err)
+
+	// The destination gateway is staked before the transfer completes.
+	_, err = srv.StakeGateway(sdkCtx, &types.MsgStakeGateway{Address: dstAddr, Stake: &stake})
+	require.NoError(t, err)
+
+	sessionEndHeight := testsession.GetSessionEndHeightWithDefaultParams(sdkCtx.BlockHeight())
+	sdkCtx = sdkCtx.WithBlockHeight(sessionEndHeight)
+	numTransferredGateways, err := k.EndBlockerTransferGateways(sdkCtx)
+	require.NoError(t, err)
+	require.Zero(t, numTransferredGateways)
+
+	// The transfer failed: the source gateway is left staked, without a pending transfer.
+	srcGateway, isGatewayFound := k.GetGateway(sdkCtx, srcAddr)
+	require.True(t, isGatewayFound)
+	require.Nil(t, srcGateway.PendingTransfer)
+	require.False(t, srcGateway.IsUnbonding())
+}
@@ -57,6 +57,19 @@ func (k msgServer) UnstakeGateway(
 		)
 	}
 
+	// Check if the gateway is transferring, in which case it begins unbonding
+	// once the transfer completes.
+	if gateway.HasPendingTransfer() {
+		logger.Info(fmt.Sprintf("Gateway with address [%s] is transferring", msg.GetAddress()))
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			types.ErrGatewayIsTransferring.Wrapf(
+				"gateway with address %q is transferring to %q",
+				msg.GetAddress(), gateway.PendingTransfer.GetDestinationAddress(),
+			).Error(),
+		)
+	}
+
 	currentHeight := ctx.BlockHeight()
 	sessionEndHeight := k.sharedKeeper.GetSessionEndHeight(ctx, currentHeight)
 
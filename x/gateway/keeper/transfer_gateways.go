@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/telemetry"
+	gatewaytypes "github.com/pokt-network/poktroll/x/gateway/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// EndBlockerTransferGateways completes pending gateway transfers.
+// This always happens on the last block of the session during which the transfer started.
+// It is accomplished by:
+//  1. Creating the destination gateway with the stake and card of the source gateway
+//  2. Unbonding the source gateway, retaining its pending transfer as a record of
+//     where its stake went
+//
+// The source gateway stake is NOT returned when it finishes unbonding since it is
+// held by the destination gateway.
+//
+// DEV_NOTE: Redelegating the applications which delegate to the source gateway is
+// taken care of by the application module's EndBlockerTransferGatewayDelegations,
+// which runs after this in the same block.
+func (k Keeper) EndBlockerTransferGateways(ctx context.Context) (numTransferredGateways int, err error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"transfer_gateway_end",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(sdkCtx)
+	currentHeight := sdkCtx.BlockHeight()
+
+	// Only process gateway transfers at the end of the session in
+	// order to avoid inconsistent/unpredictable mid-session behavior.
+	if !sharedtypes.IsSessionEndHeight(&sharedParams, currentHeight) {
+		isSuccessful = true
+		return numTransferredGateways, nil
+	}
+
+	logger := k.Logger().
+		With("method", "EndBlockerTransferGateways").
+		With("current_height", currentHeight)
+
+	// TODO_POST_MAINNET: Use an index to iterate over the gateways that have initiated
+	// a transfer instead of iterating over all of them.
+	// Decode WITHOUT cards: see GetAllGatewayLifecycles.
+	for _, gatewayLifecycle := range k.GetAllGatewayLifecycles(ctx) {
+		// Skip over gateways that have not initiated a transfer, or whose transfer
+		// already completed.
+		if !gatewayLifecycle.HasPendingTransfer() {
+			continue
+		}
+
+		// Skip over gateways whose transfer completes at a later session end height.
+		if currentHeight < int64(gatewayLifecycle.PendingTransfer.GetSessionEndHeight()) {
+			continue
+		}
+
+		// The full gateway is needed from here on, since its card is transferred too.
+		srcGateway, isSrcFound := k.GetGateway(ctx, gatewayLifecycle.GetAddress())
+		if !isSrcFound {
+			err = fmt.Errorf("should never happen: could not find transferring gateway %s", gatewayLifecycle.GetAddress())
+			logger.Error(err.Error())
+			return numTransferredGateways, err
+		}
+
+		// Ensure the destination gateway was not staked since the transfer began.
+		dstAddress := srcGateway.PendingTransfer.GetDestinationAddress()
+		if _, isDstFound := k.GetGateway(ctx, dstAddress); isDstFound {
+			transferErr := gatewaytypes.ErrGatewayDuplicateAddress.Wrapf(
+				"cannot transfer gateway %q to existing gateway %q",
+				srcGateway.GetAddress(), dstAddress,
+			)
+			logger.Warn(transferErr.Error())
+
+			// Gateway transfer failed, removing the pending transfer from the source gateway.
+			srcGateway.PendingTransfer = nil
+			k.SetGateway(ctx, srcGateway)
+
+			transferErrorEvent := &gatewaytypes.EventGatewayTransferError{
+				SourceAddress:      srcGateway.GetAddress(),
+				DestinationAddress: dstAddress,
+				SourceGateway:      srcGateway.DehydratedForEvent(),
+				SessionEndHeight:   currentHeight,
+				Error:              transferErr.Error(),
+			}
+			if err = sdkCtx.EventManager().EmitTypedEvent(transferErrorEvent); err != nil {
+				err = gatewaytypes.ErrGatewayEmitEvent.Wrapf("(%+v): %s", transferErrorEvent, err)
+				logger.Error(err.Error())
+				return numTransferredGateways, err
+			}
+			continue
+		}
+
+		if err = k.transferGateway(ctx, srcGateway); err != nil {
+			return numTransferredGateways, err
+		}
+
+		numTransferredGateways += 1
+	}
+
+	isSuccessful = true
+	return numTransferredGateways, nil
+}
+
+// transferGateway transfers srcGateway to srcGateway.PendingTransfer.DestinationAddress:
+//   - The destination gateway is created with the stake and card of the source gateway.
+//   - The source gateway begins unbonding, becoming inactive once the current session ends.
+//
+// The caller MUST ensure that the destination gateway does not exist.
+// It is intended to be called during the EndBlock ABCI method.
+func (k Keeper) transferGateway(
+	ctx context.Context,
+	srcGateway gatewaytypes.Gateway,
+) error {
+	logger := k.Logger().With("method", "transferGateway")
+
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, sdkCtx.BlockHeight())
+
+	dstStake := *srcGateway.Stake
+	dstGateway := gatewaytypes.Gateway{
+		Address:                 srcGateway.PendingTransfer.GetDestinationAddress(),
+		Stake:                   &dstStake,
+		UnstakeSessionEndHeight: gatewaytypes.GatewayNotUnstaking,
+		Metadata:                srcGateway.Metadata,
+	}
+
+	srcGateway.UnstakeSessionEndHeight = uint64(sessionEndHeight)
+
+	k.SetGateway(ctx, srcGateway)
+	k.SetGateway(ctx, dstGateway)
+
+	logger.Info(fmt.Sprintf(
+		"Successfully transferred gateway from (%s) to (%s)",
+		srcGateway.GetAddress(), dstGateway.GetAddress(),
+	))
+
+	transferEndEvent := &gatewaytypes.EventGatewayTransferEnd{
+		SourceAddress:      srcGateway.GetAddress(),
+		DestinationAddress: dstGateway.GetAddress(),
+		DestinationGateway: dstGateway.DehydratedForEvent(),
+		SessionEndHeight:   sessionEndHeight,
+		UnbondingEndHeight: gatewaytypes.GetGatewayUnbondingHeight(&sharedParams, &srcGateway),
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(transferEndEvent); err != nil {
+		err = gatewaytypes.ErrGatewayEmitEvent.Wrapf("(%+v): %s", transferEndEvent, err)
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
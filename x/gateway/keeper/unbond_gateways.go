@@ -73,9 +73,20 @@ func (k Keeper) EndBlockerUnbondGateways(ctx context.Context) (numUnbondedGatewa
 
 // UnbondGateway transfers the gateway stake to the bank module balance for the
 // corresponding account and removes the gateway from the gateway module state.
+// The stake of a transferred gateway is NOT returned since it is held by the
+// destination gateway.
 func (k Keeper) UnbondGateway(ctx context.Context, gateway *gatewaytypes.Gateway) error {
 	logger := k.Logger().With("method", "UnbondGateway")
 
+	if gateway.IsTransferred() {
+		k.RemoveGateway(ctx, gateway.GetAddress())
+		logger.Info(fmt.Sprintf(
+			"Successfully removed the transferred gateway: %s (transferred to: %s)",
+			gateway.GetAddress(), gateway.PendingTransfer.GetDestinationAddress(),
+		))
+		return nil
+	}
+
 	// Retrieve the account address of the gateway.
 	gatewayAddr, err := cosmostypes.AccAddressFromBech32(gateway.Address)
 	if err != nil {
@@ -17,6 +17,17 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 
 	logger := k.Logger().With("method", "EndBlocker")
 
+	numTransferredGateways, err := k.EndBlockerTransferGateways(ctx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("could not transfer gateways due to error %v", err))
+		return err
+	}
+
+	logger.Info(fmt.Sprintf(
+		"transferred %d gateways",
+		numTransferredGateways,
+	))
+
 	numUnbondedGateways, err := k.EndBlockerUnbondGateways(ctx)
 	if err != nil {
 		logger.Error(fmt.Sprintf("could not unbond gateways due to error %v", err))
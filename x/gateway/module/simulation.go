@@ -35,6 +35,10 @@ const (
 	// TODO_TECHDEBT: Determine the simulation weight value
 	defaultWeightMsgUpdateParam int = 100
 
+	opWeightMsgTransferGateway = "op_weight_msg_transfer_gateway"
+	// TODO_TECHDEBT: Determine the simulation weight value
+	defaultWeightMsgTransferGateway int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -96,6 +100,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		gatewaysimulation.SimulateMsgUpdateParam(am.accountKeeper, am.bankKeeper, am.gatewayKeeper),
 	))
 
+	var weightMsgTransferGateway int
+	simState.AppParams.GetOrGenerate(opWeightMsgTransferGateway, &weightMsgTransferGateway, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferGateway = defaultWeightMsgTransferGateway
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferGateway,
+		gatewaysimulation.SimulateMsgTransferGateway(am.accountKeeper, am.bankKeeper, am.gatewayKeeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -128,6 +143,14 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgTransferGateway,
+			defaultWeightMsgTransferGateway,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				gatewaysimulation.SimulateMsgTransferGateway(am.accountKeeper, am.bankKeeper, am.gatewayKeeper)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
@@ -23,6 +23,7 @@ func (am AppModule) GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdStakeGateway())
 	cmd.AddCommand(CmdUnstakeGateway())
 	cmd.AddCommand(CmdUpdateGatewayMetadata())
+	cmd.AddCommand(CmdTransferGateway())
 	cmd.AddCommand(CmdValidateCard())
 	// this line is used by starport scaffolding # 1
 
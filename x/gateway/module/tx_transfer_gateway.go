@@ -0,0 +1,48 @@
+package gateway
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/x/gateway/types"
+)
+
+func CmdTransferGateway() *cobra.Command {
+	// fromAddress & signature is retrieved via `flags.FlagFrom` in the `clientCtx`
+	cmd := &cobra.Command{
+		Use:   "transfer-gateway <destination_address>",
+		Short: "Transfer a gateway to a new address",
+		Long: `Transfer the gateway specified by the 'from' address to the destination address.
+
+The transfer completes at the end of the current session, at which point:
+- The destination gateway is created with the stake and card of the source gateway
+- Every application delegating to the source gateway is redelegated to the destination gateway
+- The source gateway becomes inactive and begins unbonding; its stake is NOT returned
+
+The destination address MUST NOT be a staked gateway.
+
+Example:
+$ pocketd tx gateway transfer-gateway $(NEW_GATEWAY) --keyring-backend test --from $(GATEWAY) --network=<network> --home $(POCKETD_HOME)`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferGateway(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/pokt-network/poktroll/x/gateway/keeper"
+	"github.com/pokt-network/poktroll/x/gateway/types"
+)
+
+func SimulateMsgTransferGateway(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		srcAccount, _ := simtypes.RandomAcc(r, accs)
+		dstAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgTransferGateway{
+			SourceAddress:      srcAccount.Address.String(),
+			DestinationAddress: dstAccount.Address.String(),
+		}
+
+		// TODO_TECHDEBT: Handling the TransferGateway simulation
+
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "TransferGateway simulation not implemented"), nil, nil
+	}
+}
@@ -316,46 +316,282 @@ func (m *EventGatewayMetadataUpdated) GetCardSizeBytes() uint64 {
 	return 0
 }
 
+// EventGatewayTransferBegin is emitted when a gateway begins a transfer to a new address.
+type EventGatewayTransferBegin struct {
+	SourceAddress      string `protobuf:"bytes,1,opt,name=source_address,json=sourceAddress,proto3" json:"source_address"`
+	DestinationAddress string `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address"`
+	// The source gateway immediately after the transfer began.
+	SourceGateway *Gateway `protobuf:"bytes,3,opt,name=source_gateway,json=sourceGateway,proto3" json:"source_gateway"`
+	// The end height of the session in which the transfer began, at which it completes.
+	SessionEndHeight int64 `protobuf:"varint,4,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+}
+
+func (m *EventGatewayTransferBegin) Reset()         { *m = EventGatewayTransferBegin{} }
+func (m *EventGatewayTransferBegin) String() string { return proto.CompactTextString(m) }
+func (*EventGatewayTransferBegin) ProtoMessage()    {}
+func (*EventGatewayTransferBegin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0556de18a777465, []int{5}
+}
+func (m *EventGatewayTransferBegin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGatewayTransferBegin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventGatewayTransferBegin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGatewayTransferBegin.Merge(m, src)
+}
+func (m *EventGatewayTransferBegin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGatewayTransferBegin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGatewayTransferBegin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGatewayTransferBegin proto.InternalMessageInfo
+
+func (m *EventGatewayTransferBegin) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
+	}
+	return ""
+}
+
+func (m *EventGatewayTransferBegin) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
+}
+
+func (m *EventGatewayTransferBegin) GetSourceGateway() *Gateway {
+	if m != nil {
+		return m.SourceGateway
+	}
+	return nil
+}
+
+func (m *EventGatewayTransferBegin) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+// EventGatewayTransferEnd is emitted when a gateway transfer is completed.
+// Either EventGatewayTransferEnd or EventGatewayTransferError will be emitted
+// corresponding to any given EventGatewayTransferBegin event.
+type EventGatewayTransferEnd struct {
+	SourceAddress      string `protobuf:"bytes,1,opt,name=source_address,json=sourceAddress,proto3" json:"source_address"`
+	DestinationAddress string `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address"`
+	// The destination gateway at the time the transfer completed.
+	DestinationGateway *Gateway `protobuf:"bytes,3,opt,name=destination_gateway,json=destinationGateway,proto3" json:"destination_gateway"`
+	// The end height of the session in which the transfer completed.
+	SessionEndHeight int64 `protobuf:"varint,4,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The height at which the source gateway unbonding will end.
+	UnbondingEndHeight int64 `protobuf:"varint,5,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height"`
+}
+
+func (m *EventGatewayTransferEnd) Reset()         { *m = EventGatewayTransferEnd{} }
+func (m *EventGatewayTransferEnd) String() string { return proto.CompactTextString(m) }
+func (*EventGatewayTransferEnd) ProtoMessage()    {}
+func (*EventGatewayTransferEnd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0556de18a777465, []int{6}
+}
+func (m *EventGatewayTransferEnd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGatewayTransferEnd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventGatewayTransferEnd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGatewayTransferEnd.Merge(m, src)
+}
+func (m *EventGatewayTransferEnd) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGatewayTransferEnd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGatewayTransferEnd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGatewayTransferEnd proto.InternalMessageInfo
+
+func (m *EventGatewayTransferEnd) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
+	}
+	return ""
+}
+
+func (m *EventGatewayTransferEnd) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
+}
+
+func (m *EventGatewayTransferEnd) GetDestinationGateway() *Gateway {
+	if m != nil {
+		return m.DestinationGateway
+	}
+	return nil
+}
+
+func (m *EventGatewayTransferEnd) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func (m *EventGatewayTransferEnd) GetUnbondingEndHeight() int64 {
+	if m != nil {
+		return m.UnbondingEndHeight
+	}
+	return 0
+}
+
+// EventGatewayTransferError is emitted when a gateway transfer fails.
+// Either EventGatewayTransferEnd or EventGatewayTransferError will be emitted
+// corresponding to any given EventGatewayTransferBegin event.
+type EventGatewayTransferError struct {
+	SourceAddress      string `protobuf:"bytes,1,opt,name=source_address,json=sourceAddress,proto3" json:"source_address"`
+	DestinationAddress string `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address"`
+	// The source gateway at the time the transfer failed.
+	SourceGateway *Gateway `protobuf:"bytes,3,opt,name=source_gateway,json=sourceGateway,proto3" json:"source_gateway"`
+	// The end height of the session in which the transfer failed.
+	SessionEndHeight int64  `protobuf:"varint,4,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	Error            string `protobuf:"bytes,5,opt,name=error,proto3" json:"error"`
+}
+
+func (m *EventGatewayTransferError) Reset()         { *m = EventGatewayTransferError{} }
+func (m *EventGatewayTransferError) String() string { return proto.CompactTextString(m) }
+func (*EventGatewayTransferError) ProtoMessage()    {}
+func (*EventGatewayTransferError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0556de18a777465, []int{7}
+}
+func (m *EventGatewayTransferError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGatewayTransferError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventGatewayTransferError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGatewayTransferError.Merge(m, src)
+}
+func (m *EventGatewayTransferError) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGatewayTransferError) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGatewayTransferError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGatewayTransferError proto.InternalMessageInfo
+
+func (m *EventGatewayTransferError) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
+	}
+	return ""
+}
+
+func (m *EventGatewayTransferError) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
+}
+
+func (m *EventGatewayTransferError) GetSourceGateway() *Gateway {
+	if m != nil {
+		return m.SourceGateway
+	}
+	return nil
+}
+
+func (m *EventGatewayTransferError) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func (m *EventGatewayTransferError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventGatewayStaked)(nil), "pocket.gateway.EventGatewayStaked")
 	proto.RegisterType((*EventGatewayUnbondingBegin)(nil), "pocket.gateway.EventGatewayUnbondingBegin")
 	proto.RegisterType((*EventGatewayUnbondingEnd)(nil), "pocket.gateway.EventGatewayUnbondingEnd")
 	proto.RegisterType((*EventGatewayUnbondingCanceled)(nil), "pocket.gateway.EventGatewayUnbondingCanceled")
 	proto.RegisterType((*EventGatewayMetadataUpdated)(nil), "pocket.gateway.EventGatewayMetadataUpdated")
+	proto.RegisterType((*EventGatewayTransferBegin)(nil), "pocket.gateway.EventGatewayTransferBegin")
+	proto.RegisterType((*EventGatewayTransferEnd)(nil), "pocket.gateway.EventGatewayTransferEnd")
+	proto.RegisterType((*EventGatewayTransferError)(nil), "pocket.gateway.EventGatewayTransferError")
 }
 
 func init() { proto.RegisterFile("pocket/gateway/event.proto", fileDescriptor_f0556de18a777465) }
 
 var fileDescriptor_f0556de18a777465 = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x77, 0x6c, 0x51, 0x9c, 0x62, 0x5b, 0xc6, 0x45, 0xd7, 0x88, 0x49, 0xe9, 0xa9, 0x20,
-	0x4d, 0x44, 0x8f, 0x82, 0x60, 0x74, 0xd5, 0x8b, 0x1e, 0xb2, 0xf6, 0xe2, 0x25, 0x4c, 0x32, 0x8f,
-	0xd9, 0x21, 0xdb, 0x99, 0x90, 0x99, 0x5a, 0xb7, 0x9f, 0xc2, 0xb3, 0x77, 0xbf, 0x81, 0x1f, 0xc2,
-	0x63, 0xf1, 0xd4, 0x53, 0x90, 0xec, 0x45, 0xf2, 0x01, 0x3c, 0x4b, 0x92, 0x59, 0xbb, 0x5d, 0xf6,
-	0x22, 0x28, 0x48, 0x6f, 0xf3, 0xfe, 0xff, 0x7f, 0xde, 0xfc, 0x1e, 0x49, 0x1e, 0x76, 0x72, 0x95,
-	0x66, 0x60, 0x02, 0x4e, 0x0d, 0x1c, 0xd3, 0x69, 0x00, 0xef, 0x41, 0x1a, 0x3f, 0x2f, 0x94, 0x51,
-	0x64, 0xb3, 0xf3, 0x7c, 0xeb, 0x39, 0x77, 0x52, 0xa5, 0x0f, 0x95, 0x8e, 0x5b, 0x37, 0xe8, 0x8a,
-	0x2e, 0xea, 0xf4, 0xb9, 0xe2, 0xaa, 0xd3, 0x9b, 0x93, 0x55, 0x97, 0x9b, 0x9b, 0x69, 0x0e, 0xf6,
-	0x89, 0xdd, 0x4f, 0x08, 0x93, 0x61, 0x73, 0xd9, 0xcb, 0xce, 0x1c, 0x19, 0x9a, 0x01, 0x23, 0x4f,
-	0xf0, 0x35, 0x9b, 0x1e, 0xa0, 0x1d, 0xb4, 0xb7, 0xf1, 0xf0, 0xb6, 0x7f, 0x91, 0xc2, 0xb7, 0xf9,
-	0x70, 0xa3, 0x2e, 0xbd, 0x79, 0x36, 0x9a, 0x1f, 0xc8, 0x73, 0x4c, 0x34, 0x68, 0x2d, 0x94, 0x8c,
-	0x41, 0xb2, 0x78, 0x0c, 0x82, 0x8f, 0xcd, 0xe0, 0xca, 0x0e, 0xda, 0x5b, 0x0b, 0x6f, 0xd5, 0xa5,
-	0xb7, 0xc2, 0x8d, 0xb6, 0xad, 0x36, 0x94, 0xec, 0x55, 0xab, 0xec, 0xd6, 0x08, 0x3b, 0x8b, 0x70,
-	0x07, 0x32, 0x51, 0x92, 0x09, 0xc9, 0x43, 0xe0, 0x42, 0xfe, 0x23, 0xc8, 0xb5, 0x3f, 0x83, 0x24,
-	0x2f, 0x70, 0xff, 0x68, 0xce, 0xb5, 0xd8, 0x67, 0xbd, 0xed, 0xd3, 0xaf, 0x4b, 0x6f, 0xfb, 0xdc,
-	0xb7, 0x5d, 0xc8, 0x6f, 0xe5, 0x7c, 0xd8, 0x1f, 0x08, 0x0f, 0x56, 0x0e, 0x3b, 0x94, 0xec, 0x92,
-	0x8d, 0xfa, 0x19, 0xe1, 0x7b, 0x2b, 0x47, 0x7d, 0x46, 0x65, 0x0a, 0x93, 0xff, 0xe6, 0xfb, 0xfb,
-	0x89, 0xf0, 0xdd, 0x45, 0xce, 0xd7, 0x60, 0x28, 0xa3, 0x86, 0x1e, 0xe4, 0x8c, 0x1a, 0x60, 0xe4,
-	0x2d, 0xde, 0xb2, 0x17, 0xc6, 0x94, 0xb1, 0x02, 0xb4, 0x6e, 0x69, 0xaf, 0x87, 0xf7, 0xeb, 0xd2,
-	0x5b, 0xb6, 0xbe, 0x7d, 0xd9, 0xef, 0xdb, 0x9f, 0xf5, 0x69, 0xa7, 0x8c, 0x4c, 0x21, 0x24, 0x8f,
-	0x36, 0x6d, 0xd0, 0xaa, 0x7f, 0x87, 0x9d, 0x3c, 0xc6, 0x5b, 0x29, 0x2d, 0x58, 0xac, 0xc5, 0x09,
-	0xc4, 0xc9, 0xd4, 0x80, 0x6e, 0x5f, 0xf7, 0x7a, 0x78, 0xb3, 0x61, 0x5b, 0xb2, 0xa2, 0x1b, 0x8d,
-	0x30, 0x12, 0x27, 0x10, 0x36, 0x65, 0xf8, 0xe6, 0x6b, 0xe5, 0xa2, 0xd3, 0xca, 0x45, 0x67, 0x95,
-	0x8b, 0xbe, 0x57, 0x2e, 0xfa, 0x38, 0x73, 0x7b, 0xa7, 0x33, 0xb7, 0x77, 0x36, 0x73, 0x7b, 0xef,
-	0x1e, 0x70, 0x61, 0xc6, 0x47, 0x89, 0x9f, 0xaa, 0xc3, 0x20, 0x57, 0x99, 0xd9, 0x97, 0x60, 0x8e,
-	0x55, 0x91, 0xb5, 0x45, 0xa1, 0x26, 0x93, 0xe0, 0xc3, 0xc5, 0x5d, 0x93, 0x5c, 0x6d, 0x97, 0xcd,
-	0xa3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x05, 0x08, 0xe9, 0xe7, 0x04, 0x00, 0x00,
+	// 611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xee, 0x36, 0x2d, 0xa8, 0x5b, 0xf5, 0x47, 0xdb, 0x88, 0xa6, 0x41, 0xd8, 0x55, 0x4f, 0x15,
+	0xa8, 0x31, 0x82, 0x23, 0x12, 0x12, 0x86, 0x50, 0x84, 0x04, 0x12, 0x6e, 0x7b, 0xe1, 0x62, 0x36,
+	0xde, 0xc5, 0xb1, 0x92, 0xee, 0x46, 0xbb, 0x1b, 0x4a, 0xfa, 0x0a, 0x5c, 0x38, 0x73, 0xe7, 0x0d,
+	0x78, 0x03, 0x2e, 0x1c, 0x2b, 0x4e, 0x3d, 0x59, 0x28, 0xb9, 0x20, 0x3f, 0x00, 0x67, 0x64, 0x7b,
+	0xdd, 0x3a, 0x96, 0x53, 0x51, 0x29, 0x48, 0x80, 0x38, 0xc5, 0xfb, 0x7d, 0x93, 0x99, 0xef, 0x9b,
+	0x9d, 0xb1, 0x0c, 0xeb, 0x3d, 0xee, 0x75, 0xa8, 0xb2, 0x7c, 0xac, 0xe8, 0x11, 0x1e, 0x58, 0xf4,
+	0x0d, 0x65, 0xaa, 0xd1, 0x13, 0x5c, 0x71, 0xb4, 0x9c, 0x72, 0x0d, 0xcd, 0xd5, 0x37, 0x3c, 0x2e,
+	0x0f, 0xb9, 0x74, 0x13, 0xd6, 0x4a, 0x0f, 0x69, 0x68, 0xbd, 0xea, 0x73, 0x9f, 0xa7, 0x78, 0xfc,
+	0xa4, 0xd1, 0x62, 0x72, 0x35, 0xe8, 0x51, 0xfd, 0x8f, 0xad, 0x0f, 0x00, 0xa2, 0x66, 0x5c, 0x6c,
+	0x37, 0x25, 0xf7, 0x14, 0xee, 0x50, 0x82, 0xee, 0xc3, 0xab, 0x3a, 0xba, 0x06, 0x36, 0xc1, 0xf6,
+	0xe2, 0x9d, 0xf5, 0xc6, 0xb8, 0x8a, 0x86, 0x8e, 0xb7, 0x17, 0xa3, 0xd0, 0xcc, 0x62, 0x9d, 0xec,
+	0x01, 0x3d, 0x82, 0x48, 0x52, 0x29, 0x03, 0xce, 0x5c, 0xca, 0x88, 0xdb, 0xa6, 0x81, 0xdf, 0x56,
+	0xb5, 0xd9, 0x4d, 0xb0, 0x5d, 0xb1, 0xaf, 0x45, 0xa1, 0x59, 0xc2, 0x3a, 0xab, 0x1a, 0x6b, 0x32,
+	0xf2, 0x24, 0x41, 0xb6, 0x22, 0x00, 0xeb, 0x79, 0x71, 0x07, 0xac, 0xc5, 0x19, 0x09, 0x98, 0x6f,
+	0x53, 0x3f, 0x60, 0xbf, 0x49, 0x64, 0xe5, 0x72, 0x22, 0xd1, 0x63, 0x58, 0xed, 0x67, 0xba, 0xf2,
+	0x79, 0xe6, 0x92, 0x3c, 0xd5, 0x28, 0x34, 0x57, 0xcf, 0x79, 0x9d, 0x05, 0x9d, 0x21, 0xe7, 0x66,
+	0xbf, 0x03, 0x58, 0x2b, 0x35, 0xdb, 0x64, 0xe4, 0x1f, 0xb3, 0xfa, 0x11, 0xc0, 0x1b, 0xa5, 0x56,
+	0x1f, 0x62, 0xe6, 0xd1, 0xee, 0x1f, 0x33, 0x7f, 0x3f, 0x00, 0xbc, 0x9e, 0xd7, 0xf9, 0x8c, 0x2a,
+	0x4c, 0xb0, 0xc2, 0x07, 0x3d, 0x82, 0x15, 0x25, 0x68, 0x1f, 0xae, 0xe8, 0x82, 0x2e, 0x26, 0x44,
+	0x50, 0x29, 0x13, 0xb5, 0x0b, 0xf6, 0xad, 0x28, 0x34, 0x8b, 0xd4, 0xd7, 0x4f, 0x3b, 0x55, 0xbd,
+	0xac, 0x0f, 0x52, 0x64, 0x4f, 0x89, 0x80, 0xf9, 0xce, 0xb2, 0x0e, 0xd4, 0xe8, 0x74, 0xb4, 0xa3,
+	0x7b, 0x70, 0xc5, 0xc3, 0x82, 0xb8, 0x32, 0x38, 0xa6, 0x6e, 0x6b, 0xa0, 0xa8, 0x4c, 0xae, 0x7b,
+	0xce, 0x5e, 0x8b, 0xb5, 0x15, 0x28, 0x67, 0x29, 0x06, 0xf6, 0x82, 0x63, 0x6a, 0xc7, 0xc7, 0xad,
+	0x68, 0x16, 0x6e, 0xe4, 0x8d, 0xef, 0x0b, 0xcc, 0xe4, 0x6b, 0x2a, 0xd2, 0xbd, 0x7b, 0x01, 0x97,
+	0x25, 0xef, 0x0b, 0x8f, 0x16, 0x5c, 0xdf, 0x8c, 0x42, 0xb3, 0xc0, 0x4c, 0x34, 0xbd, 0x94, 0xc6,
+	0x65, 0x9e, 0x5f, 0xc1, 0x35, 0x42, 0xa5, 0x0a, 0x18, 0x56, 0xb1, 0xb3, 0x2c, 0xef, 0x6c, 0x92,
+	0xd7, 0x8a, 0x42, 0xb3, 0x8c, 0x9e, 0x98, 0x1c, 0xe5, 0x82, 0xb3, 0x0a, 0xce, 0x99, 0xe8, 0x6c,
+	0xb0, 0x2a, 0x17, 0x0f, 0x16, 0xca, 0xb9, 0xc9, 0xe6, 0x4b, 0xab, 0xde, 0xbd, 0x70, 0xca, 0xe6,
+	0x2e, 0x39, 0x65, 0x9f, 0x2b, 0x70, 0xbd, 0xac, 0xd9, 0xf1, 0xde, 0xff, 0x95, 0xad, 0xc6, 0xe3,
+	0x15, 0x7e, 0xb1, 0xdf, 0xeb, 0xc5, 0xd2, 0x59, 0xd3, 0xf3, 0x25, 0xa6, 0xda, 0x79, 0xf4, 0x74,
+	0xc2, 0xfb, 0x6c, 0x3e, 0xc9, 0x53, 0x8b, 0x42, 0xb3, 0x94, 0x2f, 0x7d, 0xa7, 0xbd, 0xab, 0x94,
+	0xaf, 0x4c, 0x53, 0x08, 0x2e, 0xfe, 0xaf, 0xcc, 0x74, 0x2f, 0xce, 0x84, 0xf3, 0x34, 0xee, 0x6b,
+	0x72, 0x53, 0x0b, 0xf6, 0x42, 0x14, 0x9a, 0x29, 0xe0, 0xa4, 0x3f, 0xf6, 0xf3, 0x2f, 0x43, 0x03,
+	0x9c, 0x0c, 0x0d, 0x70, 0x3a, 0x34, 0xc0, 0xb7, 0xa1, 0x01, 0xde, 0x8f, 0x8c, 0x99, 0x93, 0x91,
+	0x31, 0x73, 0x3a, 0x32, 0x66, 0x5e, 0xde, 0xf6, 0x03, 0xd5, 0xee, 0xb7, 0x1a, 0x1e, 0x3f, 0xb4,
+	0x7a, 0xbc, 0xa3, 0x76, 0x18, 0x55, 0x47, 0x5c, 0x74, 0x92, 0x83, 0xe0, 0xdd, 0xae, 0xf5, 0x76,
+	0xfc, 0x63, 0xa9, 0x75, 0x25, 0xf9, 0x5a, 0xba, 0xfb, 0x73, 0x00, 0x27, 0x7a, 0xe8, 0x5b, 0xa8,
+	0x09, 0x00, 0x00,
 }
 
 func (m *EventGatewayStaked) Marshal() (dAtA []byte, err error) {
@@ -568,6 +804,180 @@ func (m *EventGatewayMetadataUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *EventGatewayTransferBegin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGatewayTransferBegin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGatewayTransferBegin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceGateway != nil {
+		{
+			size, err := m.SourceGateway.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGatewayTransferEnd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGatewayTransferEnd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGatewayTransferEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DestinationGateway != nil {
+		{
+			size, err := m.DestinationGateway.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGatewayTransferError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGatewayTransferError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGatewayTransferError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceGateway != nil {
+		{
+			size, err := m.SourceGateway.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -668,11 +1078,90 @@ func (m *EventGatewayMetadataUpdated) Size() (n int) {
 	return n
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventGatewayTransferBegin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SourceGateway != nil {
+		l = m.SourceGateway.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	return n
+}
+
+func (m *EventGatewayTransferEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationGateway != nil {
+		l = m.DestinationGateway.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventGatewayTransferError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SourceGateway != nil {
+		l = m.SourceGateway.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *EventGatewayStaked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -684,28 +1173,670 @@ func (m *EventGatewayStaked) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowEvent
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGatewayStaked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGatewayStaked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gateway == nil {
+				m.Gateway = &Gateway{}
+			}
+			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGatewayUnbondingBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGatewayUnbondingBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGatewayUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gateway == nil {
+				m.Gateway = &Gateway{}
+			}
+			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGatewayUnbondingEnd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGatewayUnbondingEnd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGatewayUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gateway == nil {
+				m.Gateway = &Gateway{}
+			}
+			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGatewayUnbondingCanceled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGatewayUnbondingCanceled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGatewayUnbondingCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gateway == nil {
+				m.Gateway = &Gateway{}
+			}
+			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGatewayMetadataUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGatewayMetadataUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGatewayMetadataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CardSizeBytes", wireType)
+			}
+			m.CardSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CardSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGatewayTransferBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGatewayTransferBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGatewayTransferBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventGatewayStaked: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGatewayStaked: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGateway", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -732,14 +1863,14 @@ func (m *EventGatewayStaked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gateway == nil {
-				m.Gateway = &Gateway{}
+			if m.SourceGateway == nil {
+				m.SourceGateway = &Gateway{}
 			}
-			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SourceGateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
@@ -779,7 +1910,7 @@ func (m *EventGatewayStaked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGatewayUnbondingBegin) Unmarshal(dAtA []byte) error {
+func (m *EventGatewayTransferEnd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -802,17 +1933,17 @@ func (m *EventGatewayUnbondingBegin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGatewayUnbondingBegin: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGatewayTransferEnd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGatewayUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGatewayTransferEnd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -822,52 +1953,29 @@ func (m *EventGatewayUnbondingBegin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gateway == nil {
-				m.Gateway = &Gateway{}
-			}
-			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
-			}
-			m.SessionEndHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SessionEndHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
 			}
-			m.UnbondingEndHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -877,64 +1985,27 @@ func (m *EventGatewayUnbondingBegin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventGatewayUnbondingEnd) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventGatewayUnbondingEnd: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGatewayUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationGateway", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -961,14 +2032,14 @@ func (m *EventGatewayUnbondingEnd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gateway == nil {
-				m.Gateway = &Gateway{}
+			if m.DestinationGateway == nil {
+				m.DestinationGateway = &Gateway{}
 			}
-			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DestinationGateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
@@ -987,7 +2058,7 @@ func (m *EventGatewayUnbondingEnd) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
 			}
@@ -1027,7 +2098,7 @@ func (m *EventGatewayUnbondingEnd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGatewayUnbondingCanceled) Unmarshal(dAtA []byte) error {
+func (m *EventGatewayTransferError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1050,17 +2121,17 @@ func (m *EventGatewayUnbondingCanceled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGatewayUnbondingCanceled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGatewayTransferError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGatewayUnbondingCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGatewayTransferError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1070,33 +2141,29 @@ func (m *EventGatewayUnbondingCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gateway == nil {
-				m.Gateway = &Gateway{}
-			}
-			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
 			}
-			m.SessionEndHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1106,66 +2173,29 @@ func (m *EventGatewayUnbondingCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SessionEndHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventGatewayMetadataUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventGatewayMetadataUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGatewayMetadataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGateway", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1175,25 +2205,29 @@ func (m *EventGatewayMetadataUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
+			if m.SourceGateway == nil {
+				m.SourceGateway = &Gateway{}
+			}
+			if err := m.SourceGateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
@@ -1212,11 +2246,11 @@ func (m *EventGatewayMetadataUpdated) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CardSizeBytes", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.CardSizeBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1226,11 +2260,24 @@ func (m *EventGatewayMetadataUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CardSizeBytes |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
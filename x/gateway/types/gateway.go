@@ -33,6 +33,18 @@ func (s *Gateway) IsActive(queryHeight int64) bool {
 	return !s.IsUnbonding() || uint64(queryHeight) <= s.GetUnstakeSessionEndHeight()
 }
 
+// HasPendingTransfer returns true if the gateway has begun a transfer which has
+// not yet completed.
+func (s *Gateway) HasPendingTransfer() bool {
+	return s.PendingTransfer != nil && !s.IsUnbonding()
+}
+
+// IsTransferred returns true if the gateway has completed a transfer, in which
+// case it is unbonding and its stake is held by the destination gateway.
+func (s *Gateway) IsTransferred() bool {
+	return s.PendingTransfer != nil && s.IsUnbonding()
+}
+
 // IsUnbonding mirrors Gateway.IsUnbonding for the decode-only projection.
 func (l *GatewayLifecycle) IsUnbonding() bool {
 	return l.UnstakeSessionEndHeight != GatewayNotUnstaking
@@ -43,6 +55,16 @@ func (l *GatewayLifecycle) IsActive(queryHeight int64) bool {
 	return !l.IsUnbonding() || uint64(queryHeight) <= l.GetUnstakeSessionEndHeight()
 }
 
+// HasPendingTransfer mirrors Gateway.HasPendingTransfer for the decode-only projection.
+func (l *GatewayLifecycle) HasPendingTransfer() bool {
+	return l.PendingTransfer != nil && !l.IsUnbonding()
+}
+
+// IsTransferred mirrors Gateway.IsTransferred for the decode-only projection.
+func (l *GatewayLifecycle) IsTransferred() bool {
+	return l.PendingTransfer != nil && l.IsUnbonding()
+}
+
 // ToGateway inflates a decode-only GatewayLifecycle into a Gateway carrying a nil card.
 //
 // Callers that only need lifecycle state (unbonding checks, unbonding height, address)
@@ -55,5 +77,6 @@ func (l *GatewayLifecycle) ToGateway() Gateway {
 		Address:                 l.Address,
 		Stake:                   l.Stake,
 		UnstakeSessionEndHeight: l.UnstakeSessionEndHeight,
+		PendingTransfer:         l.PendingTransfer,
 	}
 }
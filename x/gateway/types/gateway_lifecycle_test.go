@@ -92,3 +92,37 @@ func TestGatewayLifecycle_DecodesGatewayWithoutCard(t *testing.T) {
 	require.False(t, lifecycle.IsUnbonding())
 	require.True(t, lifecycle.IsActive(1))
 }
+
+// TestGatewayLifecycle_DecodesGatewayTransfer covers the field that follows the card:
+// the gateway EndBlockers rely on pending_transfer surviving the skipped card to tell
+// a transferred gateway apart from an unstaked one.
+func TestGatewayLifecycle_DecodesGatewayTransfer(t *testing.T) {
+	stake := cosmostypes.NewInt64Coin("upokt", 42)
+	gateway := types.Gateway{
+		Address:                 sample.AccAddressBech32(),
+		Stake:                   &stake,
+		UnstakeSessionEndHeight: 10,
+		Metadata: &sharedtypes.Metadata{
+			Card: make([]byte, 8192),
+		},
+		PendingTransfer: &types.PendingGatewayTransfer{
+			DestinationAddress: sample.AccAddressBech32(),
+			SessionEndHeight:   10,
+		},
+	}
+
+	gatewayBz, err := gateway.Marshal()
+	require.NoError(t, err)
+
+	var lifecycle types.GatewayLifecycle
+	require.NoError(t, lifecycle.Unmarshal(gatewayBz))
+
+	require.Equal(t, gateway.PendingTransfer, lifecycle.PendingTransfer)
+	require.Equal(t, gateway.IsTransferred(), lifecycle.IsTransferred())
+	require.Equal(t, gateway.HasPendingTransfer(), lifecycle.HasPendingTransfer())
+	require.True(t, lifecycle.IsTransferred())
+
+	inflated := lifecycle.ToGateway()
+	require.Equal(t, gateway.PendingTransfer, inflated.PendingTransfer)
+	require.Nil(t, inflated.Metadata)
+}
@@ -0,0 +1,28 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+var _ sdk.Msg = (*MsgTransferGateway)(nil)
+
+func NewMsgTransferGateway(srcAddr, dstAddr string) *MsgTransferGateway {
+	return &MsgTransferGateway{
+		SourceAddress:      srcAddr,
+		DestinationAddress: dstAddr,
+	}
+}
+
+func (msg *MsgTransferGateway) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GetSourceAddress()); err != nil {
+		return ErrGatewayInvalidAddress.Wrapf("invalid source gateway address %s; (%v)", msg.GetSourceAddress(), err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.GetDestinationAddress()); err != nil {
+		return ErrGatewayInvalidAddress.Wrapf("invalid destination gateway address %s; (%v)", msg.GetDestinationAddress(), err)
+	}
+
+	if msg.GetSourceAddress() == msg.GetDestinationAddress() {
+		return ErrGatewayDuplicateAddress.Wrapf("source and destination gateway addresses are the same: %s", msg.GetSourceAddress())
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferGateway_ValidateBasic(t *testing.T) {
+	gatewayAddr := sample.AccAddressBech32()
+
+	tests := []struct {
+		desc        string
+		msg         MsgTransferGateway
+		expectedErr error
+	}{
+		{
+			desc: "invalid source address",
+			msg: MsgTransferGateway{
+				SourceAddress:      "invalid_address",
+				DestinationAddress: sample.AccAddressBech32(),
+			},
+			expectedErr: ErrGatewayInvalidAddress,
+		},
+		{
+			desc: "missing source address",
+			msg: MsgTransferGateway{
+				DestinationAddress: sample.AccAddressBech32(),
+			},
+			expectedErr: ErrGatewayInvalidAddress,
+		},
+		{
+			desc: "invalid destination address",
+			msg: MsgTransferGateway{
+				SourceAddress:      sample.AccAddressBech32(),
+				DestinationAddress: "invalid_address",
+			},
+			expectedErr: ErrGatewayInvalidAddress,
+		},
+		{
+			desc: "missing destination address",
+			msg: MsgTransferGateway{
+				SourceAddress: sample.AccAddressBech32(),
+			},
+			expectedErr: ErrGatewayInvalidAddress,
+		},
+		{
+			desc: "duplicate source and destination addresses",
+			msg: MsgTransferGateway{
+				SourceAddress:      gatewayAddr,
+				DestinationAddress: gatewayAddr,
+			},
+			expectedErr: ErrGatewayDuplicateAddress,
+		},
+		{
+			desc: "valid source and destination addresses",
+			msg: MsgTransferGateway{
+				SourceAddress:      sample.AccAddressBech32(),
+				DestinationAddress: sample.AccAddressBech32(),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
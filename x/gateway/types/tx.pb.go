@@ -277,7 +277,6 @@ type MsgUpdateParam struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Types that are valid to be assigned to AsType:
-	//
 	//	*MsgUpdateParam_AsCoin
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"as_type"`
 }
@@ -481,6 +480,94 @@ func (m *MsgUpdateGatewayMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGatewayMetadataResponse proto.InternalMessageInfo
 
+// MsgTransferGateway begins the transfer of a staked gateway to a new address.
+//
+// The transfer completes at the end of the current session: the destination gateway
+// is created with the source gateway's stake and card, every application delegating
+// to the source gateway is redelegated to the destination gateway, and the source
+// gateway begins unbonding without its stake being returned.
+type MsgTransferGateway struct {
+	// The Bech32 address of the gateway to transfer. Must already be staked.
+	SourceAddress string `protobuf:"bytes,1,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// The Bech32 address to transfer the gateway to. Must not be a staked gateway.
+	DestinationAddress string `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+}
+
+func (m *MsgTransferGateway) Reset()         { *m = MsgTransferGateway{} }
+func (m *MsgTransferGateway) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferGateway) ProtoMessage()    {}
+func (*MsgTransferGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc5d84c597736301, []int{10}
+}
+func (m *MsgTransferGateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgTransferGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferGateway.Merge(m, src)
+}
+func (m *MsgTransferGateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferGateway proto.InternalMessageInfo
+
+func (m *MsgTransferGateway) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
+	}
+	return ""
+}
+
+func (m *MsgTransferGateway) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
+}
+
+type MsgTransferGatewayResponse struct {
+}
+
+func (m *MsgTransferGatewayResponse) Reset()         { *m = MsgTransferGatewayResponse{} }
+func (m *MsgTransferGatewayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferGatewayResponse) ProtoMessage()    {}
+func (*MsgTransferGatewayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc5d84c597736301, []int{11}
+}
+func (m *MsgTransferGatewayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferGatewayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgTransferGatewayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferGatewayResponse.Merge(m, src)
+}
+func (m *MsgTransferGatewayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferGatewayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferGatewayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferGatewayResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "pocket.gateway.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pocket.gateway.MsgUpdateParamsResponse")
@@ -492,54 +579,61 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamResponse)(nil), "pocket.gateway.MsgUpdateParamResponse")
 	proto.RegisterType((*MsgUpdateGatewayMetadata)(nil), "pocket.gateway.MsgUpdateGatewayMetadata")
 	proto.RegisterType((*MsgUpdateGatewayMetadataResponse)(nil), "pocket.gateway.MsgUpdateGatewayMetadataResponse")
+	proto.RegisterType((*MsgTransferGateway)(nil), "pocket.gateway.MsgTransferGateway")
+	proto.RegisterType((*MsgTransferGatewayResponse)(nil), "pocket.gateway.MsgTransferGatewayResponse")
 }
 
 func init() { proto.RegisterFile("pocket/gateway/tx.proto", fileDescriptor_bc5d84c597736301) }
 
 var fileDescriptor_bc5d84c597736301 = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0xad, 0xf7, 0xbf, 0xde, 0x54, 0x58, 0x34, 0xd6, 0x36, 0x48, 0x69, 0x97, 0x03, 0x8c, 0x49,
-	0x4b, 0xb6, 0x55, 0x42, 0xa2, 0xb7, 0x95, 0x03, 0x08, 0xa9, 0x08, 0x65, 0xaa, 0x84, 0x38, 0x30,
-	0xb9, 0xad, 0x95, 0x46, 0x5d, 0xe2, 0x28, 0xf6, 0xba, 0xf5, 0x86, 0x10, 0x27, 0x4e, 0xf0, 0x2d,
-	0x38, 0xf6, 0xc0, 0x05, 0x3e, 0xc1, 0x8e, 0x13, 0xa7, 0x9d, 0x26, 0xd4, 0x1e, 0x2a, 0x21, 0x3e,
-	0x04, 0x4a, 0xec, 0x74, 0x8d, 0xe9, 0x56, 0x34, 0x2e, 0x6d, 0xec, 0xdf, 0xfb, 0x3d, 0xbf, 0x67,
-	0x3f, 0x1b, 0x66, 0x7d, 0xd2, 0x68, 0x63, 0x66, 0xda, 0x88, 0xe1, 0x13, 0xd4, 0x35, 0xd9, 0xa9,
-	0xe1, 0x07, 0x84, 0x11, 0x25, 0xc3, 0x0b, 0x86, 0x28, 0xa8, 0xab, 0xc8, 0x75, 0x3c, 0x62, 0x46,
-	0xbf, 0x1c, 0xa2, 0x66, 0x1b, 0x84, 0xba, 0x84, 0x9a, 0x2e, 0xb5, 0xcd, 0xce, 0x6e, 0xf8, 0x27,
-	0x0a, 0x79, 0x5e, 0x38, 0x8c, 0x46, 0x26, 0x1f, 0x88, 0xd2, 0x9a, 0x4d, 0x6c, 0xc2, 0xe7, 0xc3,
-	0x2f, 0x31, 0xab, 0x09, 0xa6, 0x3a, 0xa2, 0xd8, 0xec, 0xec, 0xd6, 0x31, 0x43, 0xbb, 0x66, 0x83,
-	0x38, 0x9e, 0xa8, 0xdf, 0x97, 0x54, 0xfa, 0x28, 0x40, 0x6e, 0x4c, 0xa9, 0xca, 0x16, 0xba, 0x3e,
-	0xa6, 0x52, 0x23, 0x6d, 0xa1, 0x00, 0x37, 0x4d, 0x8a, 0x83, 0x8e, 0xd3, 0xc0, 0xbc, 0xa8, 0x7f,
-	0x03, 0xf0, 0x4e, 0x95, 0xda, 0x35, 0xbf, 0x89, 0x18, 0x7e, 0x15, 0x51, 0x2a, 0x8f, 0x61, 0x1a,
-	0x1d, 0xb3, 0x16, 0x09, 0x1c, 0xd6, 0xcd, 0x81, 0x22, 0xd8, 0x4c, 0x57, 0x72, 0x3f, 0xbe, 0x6e,
-	0xaf, 0x09, 0x13, 0xfb, 0xcd, 0x66, 0x80, 0x29, 0x3d, 0x60, 0x81, 0xe3, 0xd9, 0xd6, 0x15, 0x54,
-	0x79, 0x02, 0x17, 0xb8, 0xa8, 0xdc, 0x4c, 0x11, 0x6c, 0x2e, 0xef, 0xad, 0x1b, 0xc9, 0xfd, 0x33,
-	0x38, 0x7f, 0x25, 0x7d, 0x76, 0x59, 0x48, 0x7d, 0x19, 0xf6, 0xb6, 0x80, 0x25, 0x1a, 0xca, 0xa5,
-	0xf7, 0xc3, 0xde, 0xd6, 0x15, 0xd5, 0xc7, 0x61, 0x6f, 0xab, 0x28, 0x64, 0x9f, 0x8e, 0x4c, 0x49,
-	0x3a, 0xf5, 0x3c, 0xcc, 0x4a, 0x53, 0x16, 0xa6, 0x3e, 0xf1, 0x28, 0xd6, 0x3f, 0x70, 0x5b, 0x07,
-	0x0c, 0xb5, 0xf1, 0x33, 0xde, 0xae, 0xec, 0xc1, 0x45, 0xc4, 0xa5, 0x4f, 0x35, 0x15, 0x03, 0x15,
-	0x13, 0xce, 0xd3, 0x90, 0x43, 0x38, 0xca, 0x1b, 0x02, 0x1e, 0x1e, 0x92, 0x21, 0x0e, 0xc9, 0x78,
-	0x4a, 0x1c, 0xcf, 0xe2, 0xb8, 0xf2, 0x4a, 0x68, 0x24, 0x6e, 0xd7, 0x0b, 0x91, 0xc2, 0x71, 0x15,
-	0xb1, 0xc2, 0x17, 0x73, 0x4b, 0xe0, 0xee, 0x8c, 0x5e, 0x83, 0xab, 0xa1, 0x05, 0x8f, 0xfe, 0xa7,
-	0x50, 0x69, 0xdd, 0x0d, 0x98, 0xff, 0x8b, 0x56, 0x5a, 0xf9, 0x3b, 0x80, 0x99, 0xe4, 0xee, 0xdd,
-	0xfa, 0xdc, 0x15, 0x38, 0xe7, 0x21, 0x97, 0xef, 0x51, 0xda, 0x8a, 0xbe, 0x95, 0x7d, 0xb8, 0x88,
-	0xe8, 0x61, 0x18, 0xdf, 0xdc, 0xec, 0x94, 0xad, 0xab, 0x2c, 0xff, 0xba, 0x2c, 0xc4, 0xe8, 0xe7,
-	0x29, 0x6b, 0x01, 0xd1, 0x70, 0xba, 0x9c, 0x49, 0x66, 0xa2, 0x92, 0x8e, 0x28, 0xc3, 0x64, 0xeb,
-	0x1a, 0x5c, 0x4f, 0x6a, 0x97, 0xcc, 0x7d, 0x06, 0x30, 0x37, 0x02, 0x08, 0xff, 0x55, 0xcc, 0x50,
-	0x13, 0x31, 0x74, 0xab, 0x1c, 0x94, 0xe0, 0x92, 0x2b, 0xfa, 0x45, 0x14, 0xb2, 0x71, 0xb8, 0xf9,
-	0xb5, 0x32, 0x62, 0x7a, 0x6b, 0x04, 0x94, 0xce, 0x44, 0x87, 0xc5, 0xeb, 0x24, 0xc5, 0xea, 0xf7,
-	0x7e, 0xcf, 0xc2, 0xd9, 0x2a, 0xb5, 0x95, 0xd7, 0x70, 0x25, 0x71, 0x23, 0x0b, 0xf2, 0x4d, 0x92,
-	0x72, 0xaf, 0x3e, 0x9c, 0x02, 0x88, 0x57, 0x08, 0x99, 0x13, 0x97, 0x62, 0x12, 0xf3, 0x38, 0x60,
-	0x22, 0xf3, 0xa4, 0x40, 0x2b, 0x6f, 0x61, 0x46, 0xca, 0xf1, 0xc6, 0x24, 0x51, 0x09, 0x88, 0xfa,
-	0x68, 0x2a, 0x64, 0xc4, 0x5f, 0x83, 0xcb, 0xe3, 0x61, 0xd5, 0x6e, 0x76, 0xac, 0x3e, 0xb8, 0xb9,
-	0x3e, 0xa2, 0xa5, 0xf0, 0xde, 0xe4, 0x98, 0x6c, 0x5e, 0x4b, 0x20, 0x21, 0xd5, 0x9d, 0x7f, 0x45,
-	0xc6, 0x8b, 0xaa, 0xf3, 0xef, 0xc2, 0xd7, 0xaf, 0xf2, 0xf2, 0xac, 0xaf, 0x81, 0xf3, 0xbe, 0x06,
-	0x2e, 0xfa, 0x1a, 0xf8, 0xd9, 0xd7, 0xc0, 0xa7, 0x81, 0x96, 0x3a, 0x1f, 0x68, 0xa9, 0x8b, 0x81,
-	0x96, 0x7a, 0xb3, 0x63, 0x3b, 0xac, 0x75, 0x5c, 0x37, 0x1a, 0xc4, 0x35, 0x7d, 0xd2, 0x66, 0xdb,
-	0x1e, 0x66, 0x27, 0x24, 0x68, 0x47, 0x83, 0x80, 0x1c, 0x1d, 0x8d, 0x3d, 0x8d, 0xd1, 0x7b, 0x5f,
-	0x5f, 0x88, 0xde, 0xf4, 0xd2, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x33, 0x55, 0x00, 0x9a, 0xd1,
-	0x06, 0x00, 0x00,
+	// 738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x18, 0x8d, 0x7b, 0xcf, 0xb4, 0x7f, 0xfa, 0xd7, 0x2d, 0x4d, 0x62, 0x90, 0x93, 0x7a, 0x41, 0x4b,
+	0xa4, 0xda, 0xbd, 0x48, 0x48, 0x64, 0x83, 0x1a, 0x16, 0x5c, 0xa4, 0x20, 0xe4, 0x52, 0x09, 0xb1,
+	0xa0, 0x9a, 0x24, 0x83, 0x6b, 0xa5, 0xf6, 0x58, 0x9e, 0xe9, 0x6d, 0x87, 0x10, 0x2b, 0x56, 0xf0,
+	0x16, 0x2c, 0xbb, 0x80, 0x05, 0x3c, 0x41, 0x77, 0x54, 0xac, 0xba, 0xaa, 0x50, 0xba, 0x88, 0xc4,
+	0x53, 0x20, 0x7b, 0xc6, 0x6e, 0x3c, 0x4d, 0xeb, 0xaa, 0x6c, 0x12, 0x7b, 0xbe, 0xf3, 0x9d, 0x39,
+	0x67, 0xe6, 0x8c, 0x07, 0xe4, 0x3d, 0xdc, 0x6c, 0x23, 0x6a, 0x58, 0x90, 0xa2, 0x3d, 0x78, 0x60,
+	0xd0, 0x7d, 0xdd, 0xf3, 0x31, 0xc5, 0x72, 0x8e, 0x15, 0x74, 0x5e, 0x50, 0xa6, 0xa0, 0x63, 0xbb,
+	0xd8, 0x08, 0x7f, 0x19, 0x44, 0xc9, 0x37, 0x31, 0x71, 0x30, 0x31, 0x1c, 0x62, 0x19, 0xbb, 0xcb,
+	0xc1, 0x1f, 0x2f, 0x14, 0x59, 0x61, 0x33, 0x7c, 0x33, 0xd8, 0x0b, 0x2f, 0xcd, 0x58, 0xd8, 0xc2,
+	0x6c, 0x3c, 0x78, 0xe2, 0xa3, 0x2a, 0x67, 0x6a, 0x40, 0x82, 0x8c, 0xdd, 0xe5, 0x06, 0xa2, 0x70,
+	0xd9, 0x68, 0x62, 0xdb, 0xe5, 0xf5, 0xdb, 0x82, 0x4a, 0x0f, 0xfa, 0xd0, 0x89, 0x28, 0x15, 0xd1,
+	0xc2, 0x81, 0x87, 0x88, 0xd0, 0x48, 0xb6, 0xa0, 0x8f, 0x5a, 0x06, 0x41, 0xfe, 0xae, 0xdd, 0x44,
+	0xac, 0xa8, 0x7d, 0x97, 0xc0, 0x64, 0x9d, 0x58, 0x1b, 0x5e, 0x0b, 0x52, 0xf4, 0x22, 0xa4, 0x94,
+	0xef, 0x83, 0x2c, 0xdc, 0xa1, 0x5b, 0xd8, 0xb7, 0xe9, 0x41, 0x41, 0x2a, 0x4b, 0x0b, 0xd9, 0x5a,
+	0xe1, 0xd7, 0xd7, 0xc5, 0x19, 0x6e, 0x62, 0xad, 0xd5, 0xf2, 0x11, 0x21, 0xeb, 0xd4, 0xb7, 0x5d,
+	0xcb, 0x3c, 0x87, 0xca, 0x0f, 0xc0, 0x08, 0x13, 0x55, 0x18, 0x28, 0x4b, 0x0b, 0xe3, 0x2b, 0xb3,
+	0x7a, 0x72, 0xfd, 0x74, 0xc6, 0x5f, 0xcb, 0x1e, 0x9d, 0x96, 0x32, 0x5f, 0xba, 0x87, 0x15, 0xc9,
+	0xe4, 0x0d, 0xd5, 0xd5, 0xf7, 0xdd, 0xc3, 0xca, 0x39, 0xd5, 0xc7, 0xee, 0x61, 0xa5, 0xcc, 0x65,
+	0xef, 0xc7, 0xa6, 0x04, 0x9d, 0x5a, 0x11, 0xe4, 0x85, 0x21, 0x13, 0x11, 0x0f, 0xbb, 0x04, 0x69,
+	0x1f, 0x98, 0xad, 0x75, 0x0a, 0xdb, 0xe8, 0x31, 0x6b, 0x97, 0x57, 0xc0, 0x28, 0x64, 0xd2, 0x53,
+	0x4d, 0x45, 0x40, 0xd9, 0x00, 0xc3, 0x24, 0xe0, 0xe0, 0x8e, 0x8a, 0x3a, 0x87, 0x07, 0x9b, 0xa4,
+	0xf3, 0x4d, 0xd2, 0x1f, 0x61, 0xdb, 0x35, 0x19, 0xae, 0x3a, 0x11, 0x18, 0x89, 0xda, 0xb5, 0x12,
+	0xc8, 0x0b, 0x2a, 0x22, 0x85, 0xcf, 0x86, 0xc6, 0xa4, 0xff, 0x07, 0xb4, 0x0d, 0x30, 0x15, 0x58,
+	0x70, 0xc9, 0x3f, 0x0a, 0x15, 0xe6, 0x9d, 0x03, 0xc5, 0x0b, 0xb4, 0xc2, 0xcc, 0x3f, 0x24, 0x90,
+	0x4b, 0xae, 0xde, 0x8d, 0xf7, 0x5d, 0x06, 0x43, 0x2e, 0x74, 0xd8, 0x1a, 0x65, 0xcd, 0xf0, 0x59,
+	0x5e, 0x03, 0xa3, 0x90, 0x6c, 0x06, 0xf1, 0x2d, 0x0c, 0xa6, 0x2c, 0x5d, 0x6d, 0xfc, 0xcf, 0x69,
+	0x29, 0x42, 0x3f, 0xc9, 0x98, 0x23, 0x90, 0x04, 0xc3, 0xd5, 0x5c, 0x32, 0x13, 0xb5, 0x6c, 0x48,
+	0x19, 0x24, 0x5b, 0x53, 0xc1, 0x6c, 0x52, 0xbb, 0x60, 0xee, 0xb3, 0x04, 0x0a, 0x31, 0x80, 0xfb,
+	0xaf, 0x23, 0x0a, 0x5b, 0x90, 0xc2, 0x1b, 0xe5, 0x60, 0x15, 0x8c, 0x39, 0xbc, 0x9f, 0x47, 0x21,
+	0x1f, 0x85, 0x9b, 0x1d, 0x2b, 0x3d, 0xa2, 0x37, 0x63, 0xa0, 0xb0, 0x27, 0x1a, 0x28, 0x5f, 0x26,
+	0x29, 0x8e, 0xed, 0x37, 0x09, 0xc8, 0x75, 0x62, 0xbd, 0xf4, 0xa1, 0x4b, 0xde, 0x22, 0x3f, 0x0a,
+	0xc4, 0x43, 0x90, 0x23, 0x78, 0xc7, 0x6f, 0xa2, 0xcd, 0xeb, 0x0a, 0xff, 0x8f, 0xe1, 0xf9, 0xa0,
+	0xfc, 0x14, 0x4c, 0xb7, 0x10, 0xa1, 0xb6, 0x0b, 0xa9, 0x8d, 0xdd, 0x98, 0x65, 0x20, 0x85, 0x45,
+	0xee, 0x69, 0xe2, 0x95, 0xea, 0x74, 0x60, 0x4a, 0x90, 0xa3, 0xdd, 0x01, 0xca, 0x45, 0xd9, 0x91,
+	0xab, 0x95, 0x9f, 0x43, 0x60, 0xb0, 0x4e, 0x2c, 0xf9, 0x15, 0x98, 0x48, 0x7c, 0x67, 0x4a, 0xe2,
+	0xf7, 0x41, 0x38, 0xcd, 0xca, 0x7c, 0x0a, 0x20, 0x9a, 0x21, 0x60, 0x4e, 0x1c, 0xf5, 0x7e, 0xcc,
+	0xbd, 0x00, 0x65, 0x3e, 0x05, 0x10, 0x33, 0xbf, 0x01, 0x39, 0xe1, 0x74, 0xce, 0xf5, 0x13, 0x95,
+	0x80, 0x28, 0xf7, 0x52, 0x21, 0x31, 0xff, 0x06, 0x18, 0xef, 0x3d, 0x82, 0xea, 0xd5, 0x8e, 0x95,
+	0xbb, 0x57, 0xd7, 0x63, 0x5a, 0x02, 0x6e, 0xf5, 0x0f, 0xff, 0xc2, 0xa5, 0x04, 0x02, 0x52, 0x59,
+	0xba, 0x2e, 0x32, 0x9e, 0x14, 0x82, 0x49, 0x31, 0xb9, 0x5a, 0x1f, 0x12, 0x01, 0xa3, 0x54, 0xd2,
+	0x31, 0xd1, 0x14, 0xca, 0xf0, 0xbb, 0xe0, 0xda, 0xa8, 0x3d, 0x3f, 0xea, 0xa8, 0xd2, 0x71, 0x47,
+	0x95, 0x4e, 0x3a, 0xaa, 0xf4, 0xbb, 0xa3, 0x4a, 0x9f, 0xce, 0xd4, 0xcc, 0xf1, 0x99, 0x9a, 0x39,
+	0x39, 0x53, 0x33, 0xaf, 0x97, 0x2c, 0x9b, 0x6e, 0xed, 0x34, 0xf4, 0x26, 0x76, 0x0c, 0x0f, 0xb7,
+	0xe9, 0xa2, 0x8b, 0xe8, 0x1e, 0xf6, 0xdb, 0xe1, 0x8b, 0x8f, 0xb7, 0xb7, 0x7b, 0xee, 0x94, 0xf0,
+	0xa2, 0x6c, 0x8c, 0x84, 0x97, 0xe1, 0xea, 0xdf, 0x01, 0x00, 0xfc, 0x64, 0xe4, 0xc2, 0x0a, 0x08,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -562,6 +656,9 @@ type MsgClient interface {
 	UpdateParam(ctx context.Context, in *MsgUpdateParam, opts ...grpc.CallOption) (*MsgUpdateParamResponse, error)
 	// UpdateGatewayMetadata sets a gateway's card WITHOUT touching its stake.
 	UpdateGatewayMetadata(ctx context.Context, in *MsgUpdateGatewayMetadata, opts ...grpc.CallOption) (*MsgUpdateGatewayMetadataResponse, error)
+	// TransferGateway moves a gateway's stake, card and application delegations
+	// to a new address at the end of the current session.
+	TransferGateway(ctx context.Context, in *MsgTransferGateway, opts ...grpc.CallOption) (*MsgTransferGatewayResponse, error)
 }
 
 type msgClient struct {
@@ -617,6 +714,15 @@ func (c *msgClient) UpdateGatewayMetadata(ctx context.Context, in *MsgUpdateGate
 	return out, nil
 }
 
+func (c *msgClient) TransferGateway(ctx context.Context, in *MsgTransferGateway, opts ...grpc.CallOption) (*MsgTransferGatewayResponse, error) {
+	out := new(MsgTransferGatewayResponse)
+	err := c.cc.Invoke(ctx, "/pocket.gateway.Msg/TransferGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -627,6 +733,9 @@ type MsgServer interface {
 	UpdateParam(context.Context, *MsgUpdateParam) (*MsgUpdateParamResponse, error)
 	// UpdateGatewayMetadata sets a gateway's card WITHOUT touching its stake.
 	UpdateGatewayMetadata(context.Context, *MsgUpdateGatewayMetadata) (*MsgUpdateGatewayMetadataResponse, error)
+	// TransferGateway moves a gateway's stake, card and application delegations
+	// to a new address at the end of the current session.
+	TransferGateway(context.Context, *MsgTransferGateway) (*MsgTransferGatewayResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -648,6 +757,9 @@ func (*UnimplementedMsgServer) UpdateParam(ctx context.Context, req *MsgUpdatePa
 func (*UnimplementedMsgServer) UpdateGatewayMetadata(ctx context.Context, req *MsgUpdateGatewayMetadata) (*MsgUpdateGatewayMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGatewayMetadata not implemented")
 }
+func (*UnimplementedMsgServer) TransferGateway(ctx context.Context, req *MsgTransferGateway) (*MsgTransferGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferGateway not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -743,6 +855,24 @@ func _Msg_UpdateGatewayMetadata_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.gateway.Msg/TransferGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferGateway(ctx, req.(*MsgTransferGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pocket.gateway.Msg",
@@ -768,6 +898,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGatewayMetadata",
 			Handler:    _Msg_UpdateGatewayMetadata_Handler,
 		},
+		{
+			MethodName: "TransferGateway",
+			Handler:    _Msg_TransferGateway_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pocket/gateway/tx.proto",
@@ -1109,6 +1243,66 @@ func (m *MsgUpdateGatewayMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferGateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferGatewayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferGatewayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferGatewayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1259,6 +1453,32 @@ func (m *MsgUpdateGatewayMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferGateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferGatewayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2097,6 +2317,170 @@ func (m *MsgUpdateGatewayMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferGateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferGateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferGateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferGatewayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferGatewayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferGatewayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
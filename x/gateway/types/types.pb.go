@@ -43,6 +43,11 @@ type Gateway struct {
 	// As with Service, the chain enforces size only -- it does not parse, schema-check, or
 	// attest to anything the card claims.
 	Metadata *types1.Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Transfer of the gateway to a new address (nil if not transferring).
+	// - Pending: until the end of the session in which the transfer began
+	// - Completed: the gateway is then unbonding (unstake_session_end_height > 0) and
+	//   its stake, card and delegations are held by the destination gateway.
+	PendingTransfer *PendingGatewayTransfer `protobuf:"bytes,5,opt,name=pending_transfer,json=pendingTransfer,proto3" json:"pending_transfer,omitempty"`
 }
 
 func (m *Gateway) Reset()         { *m = Gateway{} }
@@ -102,6 +107,65 @@ func (m *Gateway) GetMetadata() *types1.Metadata {
 	return nil
 }
 
+func (m *Gateway) GetPendingTransfer() *PendingGatewayTransfer {
+	if m != nil {
+		return m.PendingTransfer
+	}
+	return nil
+}
+
+// PendingGatewayTransfer is used to store the details of a gateway transfer.
+// It is only intended to be used inside of a Gateway object.
+type PendingGatewayTransfer struct {
+	// The Bech32 address which the gateway is transferred to.
+	DestinationAddress string `protobuf:"bytes,1,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	// The end height of the session in which the transfer began, at which it completes.
+	SessionEndHeight uint64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+}
+
+func (m *PendingGatewayTransfer) Reset()         { *m = PendingGatewayTransfer{} }
+func (m *PendingGatewayTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingGatewayTransfer) ProtoMessage()    {}
+func (*PendingGatewayTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba65a578bc4f91b2, []int{1}
+}
+func (m *PendingGatewayTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingGatewayTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingGatewayTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingGatewayTransfer.Merge(m, src)
+}
+func (m *PendingGatewayTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingGatewayTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingGatewayTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingGatewayTransfer proto.InternalMessageInfo
+
+func (m *PendingGatewayTransfer) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
+}
+
+func (m *PendingGatewayTransfer) GetSessionEndHeight() uint64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
 // GatewayLifecycle is a decode-only projection of the leading fields of Gateway.
 //
 // It is NEVER written to state: it exists so that hot iteration paths can decode a
@@ -117,6 +181,9 @@ func (m *Gateway) GetMetadata() *types1.Metadata {
 // block, for records whose cards those paths never read. That work is not gas-metered,
 // so nothing throttles it.
 //
+// pending_transfer (field 5) is also mirrored, so that the same scans can tell a
+// transferred gateway apart from an unstaked one.
+//
 // Keep the field numbers and types in sync with Gateway.
 type GatewayLifecycle struct {
 	// The Bech32 address of the gateway
@@ -125,13 +192,15 @@ type GatewayLifecycle struct {
 	Stake *types.Coin `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake,omitempty"`
 	// Session end height at which the gateway initiated unstaking (0 if not unstaking)
 	UnstakeSessionEndHeight uint64 `protobuf:"varint,3,opt,name=unstake_session_end_height,json=unstakeSessionEndHeight,proto3" json:"unstake_session_end_height,omitempty"`
+	// Transfer of the gateway to a new address (nil if not transferring).
+	PendingTransfer *PendingGatewayTransfer `protobuf:"bytes,5,opt,name=pending_transfer,json=pendingTransfer,proto3" json:"pending_transfer,omitempty"`
 }
 
 func (m *GatewayLifecycle) Reset()         { *m = GatewayLifecycle{} }
 func (m *GatewayLifecycle) String() string { return proto.CompactTextString(m) }
 func (*GatewayLifecycle) ProtoMessage()    {}
 func (*GatewayLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba65a578bc4f91b2, []int{1}
+	return fileDescriptor_ba65a578bc4f91b2, []int{2}
 }
 func (m *GatewayLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,38 +246,51 @@ func (m *GatewayLifecycle) GetUnstakeSessionEndHeight() uint64 {
 	return 0
 }
 
+func (m *GatewayLifecycle) GetPendingTransfer() *PendingGatewayTransfer {
+	if m != nil {
+		return m.PendingTransfer
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Gateway)(nil), "pocket.gateway.Gateway")
+	proto.RegisterType((*PendingGatewayTransfer)(nil), "pocket.gateway.PendingGatewayTransfer")
 	proto.RegisterType((*GatewayLifecycle)(nil), "pocket.gateway.GatewayLifecycle")
 }
 
 func init() { proto.RegisterFile("pocket/gateway/types.proto", fileDescriptor_ba65a578bc4f91b2) }
 
 var fileDescriptor_ba65a578bc4f91b2 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0x41, 0x6e, 0xe2, 0x30,
-	0x14, 0x86, 0xf1, 0x0c, 0x33, 0xcc, 0x64, 0xa4, 0xd1, 0x28, 0x42, 0x22, 0x64, 0x24, 0x0b, 0xb1,
-	0x62, 0x83, 0x5d, 0x60, 0xd9, 0x55, 0xa9, 0xaa, 0x76, 0xd1, 0x76, 0x11, 0x76, 0xdd, 0x44, 0x4e,
-	0xf2, 0x9a, 0x58, 0x01, 0x3b, 0x8a, 0x0d, 0x94, 0x5b, 0xf4, 0x30, 0x1c, 0xa2, 0x4b, 0xd4, 0x15,
-	0x4b, 0x14, 0x2e, 0x52, 0x11, 0xbb, 0x95, 0x7a, 0x83, 0xee, 0xde, 0xcb, 0xf7, 0x45, 0xef, 0xfd,
-	0xb6, 0x1d, 0xbf, 0x90, 0x71, 0x0e, 0x9a, 0xa6, 0x4c, 0xc3, 0x9a, 0x6d, 0xa8, 0xde, 0x14, 0xa0,
-	0x48, 0x51, 0x4a, 0x2d, 0xdd, 0xbf, 0x86, 0x11, 0xcb, 0xfc, 0x6e, 0x2c, 0xd5, 0x42, 0xaa, 0xb0,
-	0xa6, 0xd4, 0x34, 0x46, 0xf5, 0xb1, 0xe9, 0x68, 0xc4, 0x14, 0xd0, 0xd5, 0x28, 0x02, 0xcd, 0x46,
-	0x34, 0x96, 0x5c, 0x58, 0xde, 0x4e, 0x65, 0x2a, 0xcd, 0x7f, 0xa7, 0xca, 0x7e, 0xfd, 0x6f, 0x87,
-	0xab, 0x8c, 0x95, 0x90, 0x50, 0x05, 0xe5, 0x8a, 0xc7, 0x60, 0x60, 0xff, 0x80, 0x9c, 0xd6, 0xb5,
-	0x99, 0xec, 0x8e, 0x9d, 0x16, 0x4b, 0x92, 0x12, 0x94, 0xf2, 0x50, 0x0f, 0x0d, 0x7e, 0x4f, 0xbd,
-	0xd7, 0xed, 0xb0, 0x6d, 0x37, 0xb8, 0x30, 0x64, 0xa6, 0x4b, 0x2e, 0xd2, 0xe0, 0x5d, 0x74, 0xa9,
-	0xf3, 0x43, 0x69, 0x96, 0x83, 0xf7, 0xad, 0x87, 0x06, 0x7f, 0xc6, 0x5d, 0x62, 0xf5, 0xd3, 0x8a,
-	0xc4, 0xae, 0x48, 0x2e, 0x25, 0x17, 0x81, 0xf1, 0xdc, 0x73, 0xc7, 0x5f, 0x8a, 0xba, 0x0c, 0x15,
-	0x28, 0xc5, 0xa5, 0x08, 0x41, 0x24, 0x61, 0x06, 0x3c, 0xcd, 0xb4, 0xf7, 0xbd, 0x87, 0x06, 0xcd,
-	0xa0, 0x63, 0x8d, 0x99, 0x11, 0xae, 0x44, 0x72, 0x53, 0x63, 0x77, 0xe2, 0xfc, 0x5a, 0x80, 0x66,
-	0x09, 0xd3, 0xcc, 0x6b, 0xd6, 0x03, 0x3b, 0xc4, 0x1e, 0x9f, 0x49, 0x47, 0xee, 0x2c, 0x0e, 0x3e,
-	0xc4, 0xfe, 0x16, 0x39, 0xff, 0x6c, 0xc4, 0x5b, 0xfe, 0x08, 0xf1, 0x26, 0x9e, 0xc3, 0xd7, 0xcf,
-	0x3a, 0xbd, 0x7f, 0xa9, 0x30, 0xda, 0x55, 0x18, 0xed, 0x2b, 0x8c, 0x0e, 0x15, 0x46, 0xcf, 0x47,
-	0xdc, 0xd8, 0x1d, 0x71, 0x63, 0x7f, 0xc4, 0x8d, 0x87, 0xb3, 0x94, 0xeb, 0x6c, 0x19, 0x91, 0x58,
-	0x2e, 0x68, 0x21, 0x73, 0x3d, 0x14, 0xa0, 0xd7, 0xb2, 0xcc, 0xeb, 0xa6, 0x94, 0xf3, 0x39, 0x7d,
-	0xfa, 0xfc, 0xda, 0xa2, 0x9f, 0xf5, 0x85, 0x4f, 0xde, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x27,
-	0x4c, 0xab, 0x8c, 0x02, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xe3, 0xd0, 0x52, 0x30, 0x12, 0x44, 0xa6, 0xa2, 0xd7, 0x20, 0x9d, 0xa2, 0x0c, 0x28,
+	0x03, 0xb5, 0x69, 0x3b, 0x32, 0x51, 0x84, 0x00, 0x09, 0x10, 0x5c, 0x99, 0x58, 0x4e, 0xce, 0xf9,
+	0xf5, 0x62, 0x25, 0xb1, 0x4f, 0xf6, 0x6b, 0x4b, 0x3e, 0x01, 0x2b, 0x7c, 0x16, 0xf8, 0x10, 0x8c,
+	0x15, 0x53, 0x47, 0x94, 0x7c, 0x11, 0x14, 0xdb, 0x45, 0x14, 0x65, 0x60, 0x60, 0xe9, 0x66, 0xfb,
+	0xf7, 0x7b, 0x7a, 0xcf, 0x7f, 0xcb, 0xb4, 0xdb, 0xd8, 0x6a, 0x0c, 0x28, 0x6a, 0x89, 0x70, 0x2a,
+	0x67, 0x02, 0x67, 0x0d, 0x78, 0xde, 0x38, 0x8b, 0x96, 0xdd, 0x8e, 0x8c, 0x27, 0xd6, 0xdd, 0xae,
+	0xac, 0x9f, 0x5a, 0x5f, 0x06, 0x2a, 0xe2, 0x26, 0xaa, 0xdd, 0x3c, 0xee, 0xc4, 0x50, 0x7a, 0x10,
+	0x27, 0xbb, 0x43, 0x40, 0xb9, 0x2b, 0x2a, 0xab, 0x4d, 0xe2, 0x9b, 0xb5, 0xad, 0x6d, 0xac, 0x5b,
+	0xae, 0xd2, 0xe9, 0xfd, 0xd4, 0xdc, 0x8f, 0xa4, 0x03, 0x25, 0x3c, 0xb8, 0x13, 0x5d, 0x41, 0x84,
+	0xfd, 0xaf, 0x6d, 0xba, 0xf1, 0x3c, 0x76, 0x66, 0x7b, 0x74, 0x43, 0x2a, 0xe5, 0xc0, 0xfb, 0x8c,
+	0xf4, 0xc8, 0xe0, 0xe6, 0x41, 0xf6, 0xe3, 0xdb, 0xce, 0x66, 0x9a, 0xe0, 0x49, 0x24, 0x87, 0xe8,
+	0xb4, 0xa9, 0x8b, 0x0b, 0x91, 0x09, 0xba, 0xee, 0x51, 0x8e, 0x21, 0x6b, 0xf7, 0xc8, 0xe0, 0xd6,
+	0xde, 0x36, 0x4f, 0xfa, 0x72, 0x44, 0x9e, 0x46, 0xe4, 0x4f, 0xad, 0x36, 0x45, 0xf4, 0xd8, 0x63,
+	0xda, 0x3d, 0x36, 0x61, 0x59, 0x7a, 0xf0, 0x5e, 0x5b, 0x53, 0x82, 0x51, 0xe5, 0x08, 0x74, 0x3d,
+	0xc2, 0xec, 0x5a, 0x8f, 0x0c, 0xd6, 0x8a, 0xad, 0x64, 0x1c, 0x46, 0xe1, 0x99, 0x51, 0x2f, 0x02,
+	0x66, 0xfb, 0xf4, 0xc6, 0x14, 0x50, 0x2a, 0x89, 0x32, 0x5b, 0x0b, 0x0d, 0xb7, 0x78, 0x8a, 0x2f,
+	0xde, 0x8e, 0xbf, 0x4e, 0xb8, 0xf8, 0x2d, 0xb2, 0x77, 0xb4, 0xd3, 0x80, 0x51, 0xda, 0xd4, 0x25,
+	0x3a, 0x69, 0xfc, 0x11, 0xb8, 0x6c, 0x3d, 0x14, 0x3f, 0xe0, 0x97, 0xb3, 0xe7, 0x6f, 0xa3, 0x97,
+	0x02, 0x79, 0x9f, 0xec, 0xe2, 0x4e, 0xaa, 0xbf, 0x38, 0xe8, 0x7f, 0x21, 0xf4, 0xde, 0x6a, 0x97,
+	0xbd, 0xa4, 0x77, 0x15, 0x78, 0xd4, 0x46, 0xe2, 0xf2, 0x6e, 0xff, 0x1a, 0x28, 0xfb, 0xa3, 0x28,
+	0x11, 0xf6, 0x90, 0xb2, 0x15, 0x11, 0xb5, 0x43, 0x44, 0x1d, 0xff, 0x57, 0x36, 0xfd, 0x4f, 0x6d,
+	0xda, 0x49, 0xc3, 0xbc, 0xd2, 0x47, 0x50, 0xcd, 0xaa, 0x09, 0x5c, 0x81, 0x27, 0xfd, 0xff, 0xaf,
+	0x73, 0xf0, 0xe6, 0xfb, 0x3c, 0x27, 0x67, 0xf3, 0x9c, 0x9c, 0xcf, 0x73, 0xf2, 0x73, 0x9e, 0x93,
+	0xcf, 0x8b, 0xbc, 0x75, 0xb6, 0xc8, 0x5b, 0xe7, 0x8b, 0xbc, 0xf5, 0xe1, 0x51, 0xad, 0x71, 0x74,
+	0x3c, 0xe4, 0x95, 0x9d, 0x8a, 0xc6, 0x8e, 0x71, 0xc7, 0x00, 0x9e, 0x5a, 0x37, 0x0e, 0x1b, 0x67,
+	0x27, 0x13, 0xf1, 0xf1, 0xf2, 0x3f, 0x1d, 0x5e, 0x0f, 0x5f, 0x65, 0xff, 0xd7, 0x00, 0x39, 0x4e,
+	0x0e, 0xc8, 0xc6, 0x03, 0x00, 0x00,
 }
 
 func (m *Gateway) Marshal() (dAtA []byte, err error) {
@@ -231,6 +313,18 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingTransfer != nil {
+		{
+			size, err := m.PendingTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Metadata != nil {
 		{
 			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
@@ -270,6 +364,41 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingGatewayTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingGatewayTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingGatewayTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GatewayLifecycle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -290,6 +419,18 @@ func (m *GatewayLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingTransfer != nil {
+		{
+			size, err := m.PendingTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.UnstakeSessionEndHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.UnstakeSessionEndHeight))
 		i--
@@ -349,6 +490,26 @@ func (m *Gateway) Size() (n int) {
 		l = m.Metadata.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.PendingTransfer != nil {
+		l = m.PendingTransfer.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingGatewayTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SessionEndHeight))
+	}
 	return n
 }
 
@@ -369,6 +530,10 @@ func (m *GatewayLifecycle) Size() (n int) {
 	if m.UnstakeSessionEndHeight != 0 {
 		n += 1 + sovTypes(uint64(m.UnstakeSessionEndHeight))
 	}
+	if m.PendingTransfer != nil {
+		l = m.PendingTransfer.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -530,6 +695,143 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingTransfer == nil {
+				m.PendingTransfer = &PendingGatewayTransfer{}
+			}
+			if err := m.PendingTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingGatewayTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingGatewayTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingGatewayTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -667,6 +969,42 @@ func (m *GatewayLifecycle) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingTransfer == nil {
+				m.PendingTransfer = &PendingGatewayTransfer{}
+			}
+			if err := m.PendingTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
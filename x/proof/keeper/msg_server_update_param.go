@@ -50,6 +50,26 @@ func (k msgServer) UpdateParam(
 	case types.ParamProofSubmissionFee:
 		logger = logger.With("param_value", msg.GetAsCoin())
 		params.ProofSubmissionFee = msg.GetAsCoin()
+	case types.ParamProofMissingPenaltyMode:
+		logger = logger.With("param_value", msg.GetAsString())
+		params.ProofMissingPenaltyMode = msg.GetAsString()
+	case types.ParamProofMissingPenaltyClaimRatio:
+		claimRatio, err := msg.GetAsLegacyDec()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger = logger.With("param_value", claimRatio.String())
+		params.ProofMissingPenaltyClaimRatio = claimRatio
+	case types.ParamProofMissingPenaltyStakeRatio:
+		stakeRatio, err := msg.GetAsLegacyDec()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger = logger.With("param_value", stakeRatio.String())
+		params.ProofMissingPenaltyStakeRatio = stakeRatio
+	case types.ParamProofMissingPenaltyMax:
+		logger = logger.With("param_value", msg.GetAsCoin())
+		params.ProofMissingPenaltyMax = msg.GetAsCoin()
 	default:
 		return nil, status.Error(
 			codes.InvalidArgument,
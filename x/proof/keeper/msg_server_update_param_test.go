@@ -129,3 +129,136 @@ func TestMsgUpdateParam_UpdateProofSubmissionFeeOnly(t *testing.T) {
 	// Ensure the other parameters are unchanged
 	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(prooftypes.KeyProofSubmissionFee))
 }
+
+func TestMsgUpdateParam_UpdateProofMissingPenaltyModeOnly(t *testing.T) {
+	expectedProofMissingPenaltyMode := prooftypes.ProofMissingPenaltyModeClaimProportional
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := prooftypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.NotEqual(t, expectedProofMissingPenaltyMode, defaultParams.ProofMissingPenaltyMode)
+
+	// Update the proof missing penalty mode
+	updateParamMsg := &prooftypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      prooftypes.ParamProofMissingPenaltyMode,
+		AsType:    &prooftypes.MsgUpdateParam_AsString{AsString: expectedProofMissingPenaltyMode},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	// Query the updated params from the keeper
+	updatedParams := k.GetParams(ctx)
+	require.Equal(t, expectedProofMissingPenaltyMode, updatedParams.ProofMissingPenaltyMode)
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(prooftypes.KeyProofMissingPenaltyMode))
+}
+
+func TestMsgUpdateParam_UpdateProofMissingPenaltyClaimRatioOnly(t *testing.T) {
+	expectedProofMissingPenaltyClaimRatio := math.LegacyMustNewDecFromStr("0.5")
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := prooftypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.False(t, expectedProofMissingPenaltyClaimRatio.Equal(defaultParams.ProofMissingPenaltyClaimRatio))
+
+	// Update the proof missing penalty claim ratio
+	updateParamMsg := &prooftypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      prooftypes.ParamProofMissingPenaltyClaimRatio,
+		AsType:    &prooftypes.MsgUpdateParam_AsDec{AsDec: expectedProofMissingPenaltyClaimRatio.String()},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	// Query the updated params from the keeper
+	updatedParams := k.GetParams(ctx)
+	require.True(t, expectedProofMissingPenaltyClaimRatio.Equal(updatedParams.ProofMissingPenaltyClaimRatio))
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(prooftypes.KeyProofMissingPenaltyClaimRatio))
+}
+
+func TestMsgUpdateParam_UpdateProofMissingPenaltyStakeRatioOnly(t *testing.T) {
+	expectedProofMissingPenaltyStakeRatio := math.LegacyMustNewDecFromStr("0.01")
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := prooftypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.False(t, expectedProofMissingPenaltyStakeRatio.Equal(defaultParams.ProofMissingPenaltyStakeRatio))
+
+	// Update the proof missing penalty stake ratio
+	updateParamMsg := &prooftypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      prooftypes.ParamProofMissingPenaltyStakeRatio,
+		AsType:    &prooftypes.MsgUpdateParam_AsDec{AsDec: expectedProofMissingPenaltyStakeRatio.String()},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	// Query the updated params from the keeper
+	updatedParams := k.GetParams(ctx)
+	require.True(t, expectedProofMissingPenaltyStakeRatio.Equal(updatedParams.ProofMissingPenaltyStakeRatio))
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(prooftypes.KeyProofMissingPenaltyStakeRatio))
+}
+
+func TestMsgUpdateParam_UpdateProofMissingPenaltyMaxOnly(t *testing.T) {
+	expectedProofMissingPenaltyMax := cosmostypes.NewCoin(pocket.DenomuPOKT, math.NewInt(1000e6))
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := prooftypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.NotEqual(t, &expectedProofMissingPenaltyMax, defaultParams.ProofMissingPenaltyMax)
+
+	// Update the proof missing penalty max
+	updateParamMsg := &prooftypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      prooftypes.ParamProofMissingPenaltyMax,
+		AsType:    &prooftypes.MsgUpdateParam_AsCoin{AsCoin: &expectedProofMissingPenaltyMax},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	// Query the updated params from the keeper
+	updatedParams := k.GetParams(ctx)
+	require.Equal(t, &expectedProofMissingPenaltyMax, updatedParams.ProofMissingPenaltyMax)
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(prooftypes.KeyProofMissingPenaltyMax))
+}
+
+func TestMsgUpdateParam_UpdateProofMissingPenaltyMaxBelowPenaltyFails(t *testing.T) {
+	// A penalty cap lower than the (default) penalty floor is invalid.
+	proofMissingPenaltyMax := cosmostypes.NewCoin(pocket.DenomuPOKT, prooftypes.DefaultProofMissingPenalty.Amount.SubRaw(1))
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := prooftypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	updateParamMsg := &prooftypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      prooftypes.ParamProofMissingPenaltyMax,
+		AsType:    &prooftypes.MsgUpdateParam_AsCoin{AsCoin: &proofMissingPenaltyMax},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.ErrorContains(t, err, prooftypes.ErrProofParamInvalid.Error())
+
+	// Ensure the params are unchanged
+	require.Equal(t, defaultParams, k.GetParams(ctx))
+}
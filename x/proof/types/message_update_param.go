@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,8 +17,14 @@ func NewMsgUpdateParam(authority string, name string, value any) (*MsgUpdatePara
 	switch v := value.(type) {
 	case []byte:
 		valueAsType = &MsgUpdateParam_AsBytes{AsBytes: v}
+	case float64:
+		valueAsType = &MsgUpdateParam_AsFloat{AsFloat: v}
+	case string:
+		valueAsType = &MsgUpdateParam_AsString{AsString: v}
 	case *sdk.Coin:
 		valueAsType = &MsgUpdateParam_AsCoin{AsCoin: v}
+	case math.LegacyDec:
+		valueAsType = &MsgUpdateParam_AsDec{AsDec: v.String()}
 	default:
 		return nil, fmt.Errorf("unexpected param value type: %T", value)
 	}
@@ -66,11 +73,53 @@ func (msg *MsgUpdateParam) ValidateBasic() error {
 			return err
 		}
 		return ValidateProofSubmissionFee(msg.GetAsCoin())
+	case ParamProofMissingPenaltyMode:
+		if err := msg.paramTypeIsString(); err != nil {
+			return err
+		}
+		return ValidateProofMissingPenaltyMode(msg.GetAsString())
+	case ParamProofMissingPenaltyClaimRatio:
+		if err := msg.paramTypeIsDec(); err != nil {
+			return err
+		}
+		claimRatio, err := msg.GetAsLegacyDec()
+		if err != nil {
+			return err
+		}
+		return ValidateProofMissingPenaltyClaimRatio(claimRatio)
+	case ParamProofMissingPenaltyStakeRatio:
+		if err := msg.paramTypeIsDec(); err != nil {
+			return err
+		}
+		stakeRatio, err := msg.GetAsLegacyDec()
+		if err != nil {
+			return err
+		}
+		return ValidateProofMissingPenaltyStakeRatio(stakeRatio)
+	case ParamProofMissingPenaltyMax:
+		if err := msg.paramTypeIsCoin(); err != nil {
+			return err
+		}
+		return ValidateProofMissingPenaltyMax(msg.GetAsCoin())
 	default:
 		return ErrProofParamNameInvalid.Wrapf("unsupported param %q", msg.Name)
 	}
 }
 
+// GetAsLegacyDec returns the decimal param value of the message, parsed from its
+// as_dec string representation.
+func (msg *MsgUpdateParam) GetAsLegacyDec() (math.LegacyDec, error) {
+	asDec, err := math.LegacyNewDecFromStr(msg.GetAsDec())
+	if err != nil {
+		return math.LegacyDec{}, ErrProofParamInvalid.Wrapf(
+			"invalid decimal value %q for param %q: %s",
+			msg.GetAsDec(), msg.Name, err,
+		)
+	}
+
+	return asDec, nil
+}
+
 // paramTypeIsFloat checks if the parameter type is Float, returning an error if not.
 func (msg *MsgUpdateParam) paramTypeIsFloat() error {
 	if _, ok := msg.AsType.(*MsgUpdateParam_AsFloat); !ok {
@@ -83,6 +132,18 @@ func (msg *MsgUpdateParam) paramTypeIsFloat() error {
 	return nil
 }
 
+// paramTypeIsDec checks if the parameter type is a decimal string, returning an error if not.
+func (msg *MsgUpdateParam) paramTypeIsDec() error {
+	if _, ok := msg.AsType.(*MsgUpdateParam_AsDec); !ok {
+		return ErrProofParamInvalid.Wrapf(
+			"invalid type for param %q expected %T, got %T",
+			msg.Name, &MsgUpdateParam_AsDec{},
+			msg.AsType,
+		)
+	}
+	return nil
+}
+
 // paramTypeIsCoin checks if the parameter type is *cosmostypes.Coin, returning an error if not.
 func (msg *MsgUpdateParam) paramTypeIsCoin() error {
 	if _, ok := msg.AsType.(*MsgUpdateParam_AsCoin); !ok {
@@ -94,3 +155,15 @@ func (msg *MsgUpdateParam) paramTypeIsCoin() error {
 	}
 	return nil
 }
+
+// paramTypeIsString checks if the parameter type is string, returning an error if not.
+func (msg *MsgUpdateParam) paramTypeIsString() error {
+	if _, ok := msg.AsType.(*MsgUpdateParam_AsString); !ok {
+		return ErrProofParamInvalid.Wrapf(
+			"invalid type for param %q expected %T, got %T",
+			msg.Name, &MsgUpdateParam_AsString{},
+			msg.AsType,
+		)
+	}
+	return nil
+}
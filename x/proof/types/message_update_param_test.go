@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,94 @@ func TestMsgUpdateParam_ValidateBasic(t *testing.T) {
 				AsType:    &MsgUpdateParam_AsFloat{AsFloat: 0},
 			},
 			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "invalid: unsupported proof missing penalty mode",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyMode,
+				AsType:    &MsgUpdateParam_AsString{AsString: "unsupported"},
+			},
+			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "invalid: negative proof missing penalty claim ratio",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyClaimRatio,
+				AsType:    &MsgUpdateParam_AsDec{AsDec: "-0.1"},
+			},
+			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "invalid: proof missing penalty claim ratio as float",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyClaimRatio,
+				AsType:    &MsgUpdateParam_AsFloat{AsFloat: 0.5},
+			},
+			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "invalid: NaN proof missing penalty claim ratio",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyClaimRatio,
+				AsType:    &MsgUpdateParam_AsDec{AsDec: "NaN"},
+			},
+			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "invalid: infinite proof missing penalty claim ratio",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyClaimRatio,
+				AsType:    &MsgUpdateParam_AsDec{AsDec: "+Inf"},
+			},
+			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "invalid: proof missing penalty stake ratio greater than 1",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyStakeRatio,
+				AsType:    &MsgUpdateParam_AsDec{AsDec: "1.1"},
+			},
+			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "invalid: NaN proof missing penalty stake ratio",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyStakeRatio,
+				AsType:    &MsgUpdateParam_AsDec{AsDec: "NaN"},
+			},
+			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "invalid: infinite proof missing penalty stake ratio",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyStakeRatio,
+				AsType:    &MsgUpdateParam_AsDec{AsDec: "-Inf"},
+			},
+			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "invalid: NaN proof request probability",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofRequestProbability,
+				AsType:    &MsgUpdateParam_AsFloat{AsFloat: math.NaN()},
+			},
+			expectedErr: ErrProofParamInvalid,
+		}, {
+			name: "valid: proof missing penalty stake ratio",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyStakeRatio,
+				AsType:    &MsgUpdateParam_AsDec{AsDec: "0.01"},
+			},
+			expectedErr: nil,
+		}, {
+			name: "valid: proof missing penalty mode",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamProofMissingPenaltyMode,
+				AsType:    &MsgUpdateParam_AsString{AsString: ProofMissingPenaltyModeClaimAndStakeProportional},
+			},
+			expectedErr: nil,
 		}, {
 			name: "valid: correct authority, param name, and type",
 			msg: MsgUpdateParam{
@@ -9,6 +9,18 @@ import (
 	"github.com/pokt-network/poktroll/pkg/client"
 )
 
+const (
+	// ProofMissingPenaltyModeFlat slashes proof_missing_penalty regardless of the claim.
+	ProofMissingPenaltyModeFlat = "flat"
+	// ProofMissingPenaltyModeClaimProportional slashes a ratio of the claimed uPOKT.
+	ProofMissingPenaltyModeClaimProportional = "claim_proportional"
+	// ProofMissingPenaltyModeStakeProportional slashes a ratio of the supplier's stake.
+	ProofMissingPenaltyModeStakeProportional = "stake_proportional"
+	// ProofMissingPenaltyModeClaimAndStakeProportional slashes the sum of the claim
+	// and stake proportional penalties.
+	ProofMissingPenaltyModeClaimAndStakeProportional = "claim_and_stake_proportional"
+)
+
 var (
 	_ client.ProofParams  = (*Params)(nil)
 	_ paramtypes.ParamSet = (*Params)(nil)
@@ -34,6 +46,23 @@ var (
 	// TODO_MAINNET: Determine a sensible default value for the proof submission fee.
 	// DefaultMinProofSubmissionFee is the default and minimum fee for submitting a proof.
 	DefaultMinProofSubmissionFee = cosmostypes.NewCoin(pocket.DenomuPOKT, math.NewInt(0))
+
+	KeyProofMissingPenaltyMode     = []byte("ProofMissingPenaltyMode")
+	ParamProofMissingPenaltyMode   = "proof_missing_penalty_mode"
+	DefaultProofMissingPenaltyMode = ProofMissingPenaltyModeFlat
+
+	KeyProofMissingPenaltyClaimRatio     = []byte("ProofMissingPenaltyClaimRatio")
+	ParamProofMissingPenaltyClaimRatio   = "proof_missing_penalty_claim_ratio"
+	DefaultProofMissingPenaltyClaimRatio = math.LegacyZeroDec()
+
+	KeyProofMissingPenaltyStakeRatio     = []byte("ProofMissingPenaltyStakeRatio")
+	ParamProofMissingPenaltyStakeRatio   = "proof_missing_penalty_stake_ratio"
+	DefaultProofMissingPenaltyStakeRatio = math.LegacyZeroDec()
+
+	KeyProofMissingPenaltyMax   = []byte("ProofMissingPenaltyMax")
+	ParamProofMissingPenaltyMax = "proof_missing_penalty_max"
+	// DefaultProofMissingPenaltyMax is zero, meaning that the penalty is not capped.
+	DefaultProofMissingPenaltyMax = cosmostypes.NewCoin(pocket.DenomuPOKT, math.NewInt(0))
 )
 
 // ParamKeyTable the param key table for launch module
@@ -58,12 +87,17 @@ func NewParams(
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultProofRequestProbability,
 		&DefaultProofRequirementThreshold,
 		&DefaultProofMissingPenalty,
 		&DefaultMinProofSubmissionFee,
 	)
+	params.ProofMissingPenaltyMode = DefaultProofMissingPenaltyMode
+	params.ProofMissingPenaltyClaimRatio = DefaultProofMissingPenaltyClaimRatio
+	params.ProofMissingPenaltyStakeRatio = DefaultProofMissingPenaltyStakeRatio
+	params.ProofMissingPenaltyMax = &DefaultProofMissingPenaltyMax
+	return params
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -89,6 +123,26 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.ProofSubmissionFee,
 			ValidateProofSubmissionFee,
 		),
+		paramtypes.NewParamSetPair(
+			KeyProofMissingPenaltyMode,
+			&p.ProofMissingPenaltyMode,
+			ValidateProofMissingPenaltyMode,
+		),
+		paramtypes.NewParamSetPair(
+			KeyProofMissingPenaltyClaimRatio,
+			&p.ProofMissingPenaltyClaimRatio,
+			ValidateProofMissingPenaltyClaimRatio,
+		),
+		paramtypes.NewParamSetPair(
+			KeyProofMissingPenaltyStakeRatio,
+			&p.ProofMissingPenaltyStakeRatio,
+			ValidateProofMissingPenaltyStakeRatio,
+		),
+		paramtypes.NewParamSetPair(
+			KeyProofMissingPenaltyMax,
+			&p.ProofMissingPenaltyMax,
+			ValidateProofMissingPenaltyMax,
+		),
 	}
 }
 
@@ -110,6 +164,32 @@ func (params *Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateProofMissingPenaltyMode(params.ProofMissingPenaltyMode); err != nil {
+		return err
+	}
+
+	if err := ValidateProofMissingPenaltyClaimRatio(params.ProofMissingPenaltyClaimRatio); err != nil {
+		return err
+	}
+
+	if err := ValidateProofMissingPenaltyStakeRatio(params.ProofMissingPenaltyStakeRatio); err != nil {
+		return err
+	}
+
+	if err := ValidateProofMissingPenaltyMax(params.ProofMissingPenaltyMax); err != nil {
+		return err
+	}
+
+	// The penalty cap, if any, MUST NOT be lower than the penalty floor.
+	penaltyMax := params.GetProofMissingPenaltyMax()
+	if penaltyMax != nil && penaltyMax.IsPositive() &&
+		penaltyMax.Amount.LT(params.GetProofMissingPenalty().Amount) {
+		return ErrProofParamInvalid.Wrapf(
+			"proof_missing_penalty_max (%s) MUST be greater than or equal to proof_missing_penalty (%s)",
+			penaltyMax, params.GetProofMissingPenalty(),
+		)
+	}
+
 	return nil
 }
 
@@ -121,7 +201,8 @@ func ValidateProofRequestProbability(proofRequestProbabilityAny any) error {
 		return ErrProofParamInvalid.Wrapf("invalid parameter type: %T", proofRequestProbabilityAny)
 	}
 
-	if proofRequestProbability < 0 || proofRequestProbability > 1 {
+	// The negated range check also rejects NaN, which fails every comparison.
+	if !(proofRequestProbability >= 0 && proofRequestProbability <= 1) {
 		return ErrProofParamInvalid.Wrapf("invalid ProofRequestProbability: (%v)", proofRequestProbability)
 	}
 
@@ -200,3 +281,94 @@ func ValidateProofSubmissionFee(proofSubmissionFeeAny any) error {
 
 	return nil
 }
+
+// ValidateProofMissingPenaltyMode validates the ProofMissingPenaltyMode param.
+// An empty mode is valid for backwards compatibility and equivalent to the flat mode.
+// NB: The argument is an interface type to satisfy the ParamSetPair function signature.
+func ValidateProofMissingPenaltyMode(proofMissingPenaltyModeAny any) error {
+	proofMissingPenaltyMode, ok := proofMissingPenaltyModeAny.(string)
+	if !ok {
+		return ErrProofParamInvalid.Wrapf("invalid parameter type: %T", proofMissingPenaltyModeAny)
+	}
+
+	switch proofMissingPenaltyMode {
+	case "",
+		ProofMissingPenaltyModeFlat,
+		ProofMissingPenaltyModeClaimProportional,
+		ProofMissingPenaltyModeStakeProportional,
+		ProofMissingPenaltyModeClaimAndStakeProportional:
+		return nil
+	default:
+		return ErrProofParamInvalid.Wrapf(
+			"proof_missing_penalty_mode %q MUST be one of %q, %q, %q or %q",
+			proofMissingPenaltyMode,
+			ProofMissingPenaltyModeFlat,
+			ProofMissingPenaltyModeClaimProportional,
+			ProofMissingPenaltyModeStakeProportional,
+			ProofMissingPenaltyModeClaimAndStakeProportional,
+		)
+	}
+}
+
+// ValidateProofMissingPenaltyClaimRatio validates the ProofMissingPenaltyClaimRatio param.
+// The ratio MAY exceed 1 in order to slash more than the claimed uPOKT.
+// NB: The argument is an interface type to satisfy the ParamSetPair function signature.
+func ValidateProofMissingPenaltyClaimRatio(proofMissingPenaltyClaimRatioAny any) error {
+	proofMissingPenaltyClaimRatio, ok := proofMissingPenaltyClaimRatioAny.(math.LegacyDec)
+	if !ok {
+		return ErrProofParamInvalid.Wrapf("invalid parameter type: %T", proofMissingPenaltyClaimRatioAny)
+	}
+
+	if proofMissingPenaltyClaimRatio.IsNil() {
+		return ErrProofParamInvalid.Wrap("proof_missing_penalty_claim_ratio must be set")
+	}
+
+	if proofMissingPenaltyClaimRatio.IsNegative() {
+		return ErrProofParamInvalid.Wrapf("invalid proof_missing_penalty_claim_ratio: (%s) < 0", proofMissingPenaltyClaimRatio)
+	}
+
+	return nil
+}
+
+// ValidateProofMissingPenaltyStakeRatio validates the ProofMissingPenaltyStakeRatio param.
+// NB: The argument is an interface type to satisfy the ParamSetPair function signature.
+func ValidateProofMissingPenaltyStakeRatio(proofMissingPenaltyStakeRatioAny any) error {
+	proofMissingPenaltyStakeRatio, ok := proofMissingPenaltyStakeRatioAny.(math.LegacyDec)
+	if !ok {
+		return ErrProofParamInvalid.Wrapf("invalid parameter type: %T", proofMissingPenaltyStakeRatioAny)
+	}
+
+	if proofMissingPenaltyStakeRatio.IsNil() {
+		return ErrProofParamInvalid.Wrap("proof_missing_penalty_stake_ratio must be set")
+	}
+
+	if proofMissingPenaltyStakeRatio.IsNegative() || proofMissingPenaltyStakeRatio.GT(math.LegacyOneDec()) {
+		return ErrProofParamInvalid.Wrapf("invalid proof_missing_penalty_stake_ratio: (%s)", proofMissingPenaltyStakeRatio)
+	}
+
+	return nil
+}
+
+// ValidateProofMissingPenaltyMax validates the ProofMissingPenaltyMax param.
+// A nil value is valid for backwards compatibility and equivalent to a zero (i.e. no) cap.
+// NB: The argument is an interface type to satisfy the ParamSetPair function signature.
+func ValidateProofMissingPenaltyMax(proofMissingPenaltyMaxAny any) error {
+	proofMissingPenaltyMaxCoin, ok := proofMissingPenaltyMaxAny.(*cosmostypes.Coin)
+	if !ok {
+		return ErrProofParamInvalid.Wrapf("invalid parameter type: %T", proofMissingPenaltyMaxAny)
+	}
+
+	if proofMissingPenaltyMaxCoin == nil {
+		return nil
+	}
+
+	if proofMissingPenaltyMaxCoin.Denom != pocket.DenomuPOKT {
+		return ErrProofParamInvalid.Wrapf("invalid proof_missing_penalty_max denom: %s", proofMissingPenaltyMaxCoin.Denom)
+	}
+
+	if proofMissingPenaltyMaxCoin.IsNegative() {
+		return ErrProofParamInvalid.Wrapf("invalid proof_missing_penalty_max amount: %s < 0", proofMissingPenaltyMaxCoin)
+	}
+
+	return nil
+}
@@ -4,8 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	encoding_binary "encoding/binary"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -49,6 +51,27 @@ type Params struct {
 	// spamming (i.e. sybil bloat attacks) the network with non-required proofs.
 	// TODO_MAINNET_MIGRATION: Consider renaming this to `proof_submission_fee_upokt`.
 	ProofSubmissionFee *types.Coin `protobuf:"bytes,5,opt,name=proof_submission_fee,json=proofSubmissionFee,proto3" json:"proof_submission_fee"`
+	// proof_missing_penalty_mode is the strategy used to compute the amount slashed from
+	// a supplier's stake when a required proof is missing or invalid:
+	// - "flat": proof_missing_penalty is slashed regardless of the claim (default).
+	// - "claim_proportional": proof_missing_penalty_claim_ratio of the claimed uPOKT is slashed.
+	// - "stake_proportional": proof_missing_penalty_stake_ratio of the supplier's stake is slashed.
+	// - "claim_and_stake_proportional": the sum of the two above is slashed.
+	// In the proportional modes, proof_missing_penalty is the minimum amount slashed and
+	// proof_missing_penalty_max (if non-zero) the maximum.
+	// An empty value, as found in params recorded before its introduction, is equivalent to "flat".
+	ProofMissingPenaltyMode string `protobuf:"bytes,6,opt,name=proof_missing_penalty_mode,json=proofMissingPenaltyMode,proto3" json:"proof_missing_penalty_mode"`
+	// proof_missing_penalty_claim_ratio is the ratio of the claimed uPOKT (i.e. the claimed
+	// compute units converted to uPOKT) slashed in the claim proportional modes.
+	// It is a decimal (e.g. "0.5") so that the slashed amount is computed exactly.
+	ProofMissingPenaltyClaimRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=proof_missing_penalty_claim_ratio,json=proofMissingPenaltyClaimRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proof_missing_penalty_claim_ratio" yaml:"proof_missing_penalty_claim_ratio"`
+	// proof_missing_penalty_stake_ratio is the ratio of the supplier's stake slashed
+	// in the stake proportional modes.
+	// It is a decimal (e.g. "0.01") so that the slashed amount is computed exactly.
+	ProofMissingPenaltyStakeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=proof_missing_penalty_stake_ratio,json=proofMissingPenaltyStakeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proof_missing_penalty_stake_ratio" yaml:"proof_missing_penalty_stake_ratio"`
+	// proof_missing_penalty_max is the maximum number of tokens (uPOKT) slashed in the
+	// proportional modes. A nil or zero value means that the penalty is not capped.
+	ProofMissingPenaltyMax *types.Coin `protobuf:"bytes,9,opt,name=proof_missing_penalty_max,json=proofMissingPenaltyMax,proto3" json:"proof_missing_penalty_max"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -108,6 +131,20 @@ func (m *Params) GetProofSubmissionFee() *types.Coin {
 	return nil
 }
 
+func (m *Params) GetProofMissingPenaltyMode() string {
+	if m != nil {
+		return m.ProofMissingPenaltyMode
+	}
+	return ""
+}
+
+func (m *Params) GetProofMissingPenaltyMax() *types.Coin {
+	if m != nil {
+		return m.ProofMissingPenaltyMax
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "pocket.proof.Params")
 }
@@ -115,32 +152,43 @@ func init() {
 func init() { proto.RegisterFile("pocket/proof/params.proto", fileDescriptor_42b012b13af1e20c) }
 
 var fileDescriptor_42b012b13af1e20c = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0x86, 0x6f, 0x93, 0x10, 0x21, 0x43, 0x01, 0x26, 0x11, 0x76, 0x10, 0xeb, 0x88, 0x2a, 0x42,
-	0x62, 0x57, 0x81, 0x8e, 0xf2, 0x90, 0x28, 0x10, 0x48, 0x27, 0x43, 0x03, 0x8d, 0x65, 0x9b, 0x89,
-	0xbd, 0x3a, 0x7b, 0x67, 0xd9, 0xdd, 0x03, 0x8e, 0x47, 0xa0, 0xe2, 0x11, 0x78, 0x04, 0x1e, 0x83,
-	0x32, 0x15, 0x4a, 0x65, 0x21, 0x5f, 0x01, 0xf2, 0x53, 0xa0, 0xec, 0x9a, 0x4b, 0x13, 0xb8, 0xc6,
-	0x1a, 0xff, 0xdf, 0xfc, 0xf3, 0x8f, 0xb4, 0x13, 0xc4, 0x0a, 0xcb, 0x39, 0x58, 0xae, 0x34, 0xe2,
-	0x09, 0x57, 0xb9, 0xce, 0x5b, 0xc3, 0x94, 0x46, 0x8b, 0xe1, 0x75, 0x8f, 0x98, 0x43, 0x07, 0x37,
-	0xf3, 0x56, 0x48, 0xe4, 0xee, 0xeb, 0x1b, 0x0e, 0xf6, 0x2a, 0xac, 0xd0, 0x95, 0xfc, 0xbc, 0x1a,
-	0x55, 0x5a, 0xa2, 0x69, 0xd1, 0xf0, 0x22, 0x37, 0xc0, 0xdf, 0x1f, 0x17, 0x60, 0xf3, 0x63, 0x5e,
-	0xa2, 0x90, 0x9e, 0xdf, 0xfb, 0xb1, 0x1d, 0xec, 0xce, 0x5c, 0x4e, 0xf8, 0x3a, 0x88, 0xdd, 0xf0,
-	0x4c, 0xc3, 0xbb, 0x05, 0x18, 0x9b, 0x29, 0x8d, 0x45, 0x5e, 0x88, 0x46, 0xd8, 0x65, 0xb4, 0x75,
-	0x48, 0x8e, 0xc8, 0xf4, 0xee, 0xd0, 0x25, 0xff, 0x6e, 0x4a, 0x6f, 0x3b, 0x94, 0x7a, 0x32, 0xbb,
-	0x00, 0xe1, 0xa7, 0xe0, 0xce, 0x85, 0x4b, 0x68, 0x68, 0x41, 0xda, 0xcc, 0xd6, 0x1a, 0x4c, 0x8d,
-	0xcd, 0xdb, 0x68, 0xfb, 0x90, 0x1c, 0x5d, 0x7b, 0x18, 0x33, 0xbf, 0x2b, 0x3b, 0xdf, 0x95, 0x8d,
-	0xbb, 0xb2, 0x27, 0x28, 0xe4, 0x34, 0x19, 0xba, 0xe4, 0x7f, 0x13, 0xd2, 0x78, 0x9d, 0x3c, 0xb2,
-	0x57, 0x7f, 0x51, 0x58, 0x07, 0xfb, 0xde, 0xd9, 0x0a, 0x63, 0x84, 0xac, 0x32, 0x05, 0x32, 0x6f,
-	0xec, 0x32, 0xda, 0xd9, 0x94, 0x1a, 0x0f, 0x5d, 0x72, 0xb9, 0x37, 0xbd, 0xe5, 0xe4, 0x17, 0x5e,
-	0x9d, 0x79, 0x31, 0x84, 0x60, 0xcf, 0x77, 0x9b, 0x45, 0xe1, 0x0c, 0x28, 0xb3, 0x13, 0x80, 0xe8,
-	0xca, 0xa6, 0xa0, 0x68, 0xe8, 0x92, 0x4b, 0xad, 0x69, 0xe8, 0xd4, 0x97, 0x6b, 0xf1, 0x29, 0xc0,
-	0x63, 0xfa, 0xfb, 0x6b, 0x42, 0x3e, 0xff, 0xfa, 0x76, 0x7f, 0x7f, 0xbc, 0x96, 0x8f, 0xe3, 0xbd,
-	0xf8, 0x77, 0x7c, 0xb6, 0x73, 0x95, 0xdc, 0xd8, 0x9a, 0x3e, 0xff, 0xde, 0x53, 0x72, 0xda, 0x53,
-	0x72, 0xd6, 0x53, 0xf2, 0xb3, 0xa7, 0xe4, 0xcb, 0x8a, 0x4e, 0x4e, 0x57, 0x74, 0x72, 0xb6, 0xa2,
-	0x93, 0x37, 0xac, 0x12, 0xb6, 0x5e, 0x14, 0xac, 0xc4, 0x96, 0x2b, 0x9c, 0xdb, 0x07, 0x12, 0xec,
-	0x07, 0xd4, 0x73, 0xf7, 0xa3, 0xb1, 0x69, 0xd6, 0x43, 0xed, 0x52, 0x81, 0x29, 0x76, 0xdd, 0xb5,
-	0x3c, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0x00, 0x84, 0xd3, 0x2b, 0xa1, 0x02, 0x00, 0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x4c,
+	0x18, 0xce, 0xf5, 0xeb, 0x17, 0x52, 0xc3, 0x00, 0xa6, 0xa5, 0x76, 0xaa, 0xda, 0x21, 0x53, 0x84,
+	0x54, 0x5b, 0x85, 0xad, 0x63, 0x5a, 0x31, 0xa0, 0x56, 0x8a, 0x5c, 0x16, 0x60, 0xb0, 0xce, 0xce,
+	0x35, 0x39, 0xc5, 0xf6, 0x6b, 0x7c, 0x17, 0x48, 0x18, 0x19, 0x99, 0xf8, 0x09, 0xfc, 0x04, 0x06,
+	0x7e, 0x44, 0x16, 0xa4, 0x8a, 0xa9, 0x62, 0xb0, 0x50, 0x32, 0x80, 0x3c, 0xf2, 0x0b, 0x90, 0xef,
+	0x4c, 0x9a, 0xc1, 0xc4, 0x0c, 0x2c, 0xd1, 0xdd, 0xfb, 0x3c, 0xcf, 0xfb, 0x3e, 0x4f, 0x74, 0x7e,
+	0x15, 0x3d, 0x06, 0x7f, 0x44, 0xb8, 0x1d, 0x27, 0x00, 0x17, 0x76, 0x8c, 0x13, 0x1c, 0x32, 0x2b,
+	0x4e, 0x80, 0x83, 0x7a, 0x4b, 0x42, 0x96, 0x80, 0x9a, 0x77, 0x70, 0x48, 0x23, 0xb0, 0xc5, 0xaf,
+	0x24, 0x34, 0xb7, 0x07, 0x30, 0x00, 0x71, 0xb4, 0xf3, 0x53, 0x51, 0xd5, 0x7d, 0x60, 0x21, 0x30,
+	0x57, 0x02, 0xf2, 0x52, 0x40, 0x86, 0xbc, 0xd9, 0x1e, 0x66, 0xc4, 0x7e, 0x75, 0xe8, 0x11, 0x8e,
+	0x0f, 0x6d, 0x1f, 0x68, 0x24, 0xf1, 0xf6, 0xac, 0xa1, 0xd4, 0x7b, 0xc2, 0x82, 0xfa, 0x4c, 0xd1,
+	0xc5, 0x5c, 0x37, 0x21, 0x2f, 0xc7, 0x84, 0xf1, 0xbc, 0x9d, 0x87, 0x3d, 0x1a, 0x50, 0x3e, 0xd5,
+	0x36, 0x5a, 0xa8, 0x83, 0xba, 0xfb, 0x59, 0x6a, 0xfe, 0x99, 0xe4, 0xec, 0x0a, 0xc8, 0x91, 0x48,
+	0xef, 0x1a, 0x50, 0xdf, 0x28, 0x7b, 0xd7, 0x2a, 0x9a, 0x90, 0x90, 0x44, 0xdc, 0xe5, 0xc3, 0x84,
+	0xb0, 0x21, 0x04, 0x7d, 0xed, 0xbf, 0x16, 0xea, 0xdc, 0x7c, 0xa8, 0x5b, 0x85, 0xf3, 0xdc, 0xab,
+	0x55, 0x78, 0xb5, 0x8e, 0x81, 0x46, 0x5d, 0x33, 0x4b, 0xcd, 0x75, 0x1d, 0x1c, 0x7d, 0x39, 0xb9,
+	0xc0, 0x9e, 0xfe, 0x86, 0xd4, 0xa1, 0xb2, 0x23, 0x95, 0x21, 0x65, 0x8c, 0x46, 0x03, 0x37, 0x26,
+	0x11, 0x0e, 0xf8, 0x54, 0xdb, 0xac, 0x9a, 0xaa, 0x67, 0xa9, 0x59, 0xae, 0x75, 0xee, 0x8a, 0xf2,
+	0x99, 0xac, 0xf6, 0x64, 0x51, 0x25, 0xca, 0xb6, 0x64, 0xb3, 0xb1, 0x27, 0x04, 0x10, 0xb9, 0x17,
+	0x84, 0x68, 0xff, 0x57, 0x0d, 0xd2, 0xb2, 0xd4, 0x2c, 0x95, 0x3a, 0xaa, 0xa8, 0x9e, 0x2f, 0x8b,
+	0x8f, 0x09, 0x51, 0x5f, 0x28, 0xcd, 0x52, 0x53, 0x6e, 0x08, 0x7d, 0xa2, 0xd5, 0x5b, 0xa8, 0xb3,
+	0xd5, 0x35, 0xb2, 0xd4, 0x5c, 0xc3, 0x72, 0x76, 0x4b, 0xfc, 0x9f, 0x41, 0x9f, 0xa8, 0x9f, 0x91,
+	0x72, 0xbf, 0x5c, 0xe7, 0x07, 0x98, 0x86, 0x6e, 0x82, 0x39, 0x05, 0xed, 0x86, 0x18, 0xf2, 0x16,
+	0xcd, 0x52, 0xb3, 0xf6, 0x35, 0x35, 0xf7, 0x64, 0x32, 0xd6, 0x1f, 0x59, 0x14, 0xec, 0x10, 0xf3,
+	0xa1, 0x75, 0x4a, 0x06, 0xd8, 0x9f, 0x9e, 0x10, 0x3f, 0x4b, 0xcd, 0xea, 0x86, 0x3f, 0x53, 0xb3,
+	0x33, 0xc5, 0x61, 0x70, 0xd4, 0xae, 0xa4, 0xb6, 0xbf, 0x7c, 0x3a, 0x50, 0x8a, 0x7f, 0xf2, 0x84,
+	0xf8, 0xce, 0x7e, 0x49, 0x92, 0xe3, 0x9c, 0xee, 0xe4, 0xec, 0x35, 0x79, 0x18, 0xc7, 0x23, 0x52,
+	0xe4, 0x69, 0xfc, 0x8b, 0x3c, 0x2b, 0x0d, 0xab, 0xf2, 0xac, 0x50, 0xff, 0x26, 0xcf, 0x79, 0x4e,
+	0x97, 0x79, 0x98, 0xa2, 0x97, 0xb7, 0x0c, 0xf1, 0x44, 0xdb, 0xaa, 0x7a, 0x68, 0x2b, 0xdf, 0x6f,
+	0x89, 0xde, 0xb9, 0x57, 0xf6, 0x2a, 0xf0, 0xe4, 0xc8, 0xf8, 0xf1, 0xc1, 0x44, 0xef, 0xbe, 0x7f,
+	0x7c, 0xb0, 0x53, 0xac, 0xae, 0x49, 0xb1, 0xbc, 0xe4, 0xe6, 0x78, 0xb2, 0xd9, 0x40, 0xb7, 0x37,
+	0xba, 0xa7, 0xb3, 0xb9, 0x81, 0x2e, 0xe7, 0x06, 0xba, 0x9a, 0x1b, 0xe8, 0xdb, 0xdc, 0x40, 0xef,
+	0x17, 0x46, 0xed, 0x72, 0x61, 0xd4, 0xae, 0x16, 0x46, 0xed, 0xb9, 0x35, 0xa0, 0x7c, 0x38, 0xf6,
+	0x2c, 0x1f, 0x42, 0x3b, 0x86, 0x11, 0x3f, 0x88, 0x08, 0x7f, 0x0d, 0xc9, 0x48, 0x5c, 0x12, 0x08,
+	0x82, 0x65, 0x53, 0x3e, 0x8d, 0x09, 0xf3, 0xea, 0x62, 0x3f, 0x3d, 0xfa, 0x35, 0x00, 0xc0, 0x3f,
+	0xe0, 0xbc, 0x2e, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -174,6 +222,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.ProofSubmissionFee.Equal(that1.ProofSubmissionFee) {
 		return false
 	}
+	if this.ProofMissingPenaltyMode != that1.ProofMissingPenaltyMode {
+		return false
+	}
+	if !this.ProofMissingPenaltyClaimRatio.Equal(that1.ProofMissingPenaltyClaimRatio) {
+		return false
+	}
+	if !this.ProofMissingPenaltyStakeRatio.Equal(that1.ProofMissingPenaltyStakeRatio) {
+		return false
+	}
+	if !this.ProofMissingPenaltyMax.Equal(that1.ProofMissingPenaltyMax) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -196,6 +256,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProofMissingPenaltyMax != nil {
+		{
+			size, err := m.ProofMissingPenaltyMax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.ProofMissingPenaltyStakeRatio.Size()
+		i -= size
+		if _, err := m.ProofMissingPenaltyStakeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ProofMissingPenaltyClaimRatio.Size()
+		i -= size
+		if _, err := m.ProofMissingPenaltyClaimRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ProofMissingPenaltyMode) > 0 {
+		i -= len(m.ProofMissingPenaltyMode)
+		copy(dAtA[i:], m.ProofMissingPenaltyMode)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ProofMissingPenaltyMode)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.ProofSubmissionFee != nil {
 		{
 			size, err := m.ProofSubmissionFee.MarshalToSizedBuffer(dAtA[:i])
@@ -273,6 +372,18 @@ func (m *Params) Size() (n int) {
 		l = m.ProofSubmissionFee.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = len(m.ProofMissingPenaltyMode)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.ProofMissingPenaltyClaimRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ProofMissingPenaltyStakeRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ProofMissingPenaltyMax != nil {
+		l = m.ProofMissingPenaltyMax.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -430,6 +541,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofMissingPenaltyMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofMissingPenaltyMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofMissingPenaltyClaimRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofMissingPenaltyClaimRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofMissingPenaltyStakeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofMissingPenaltyStakeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofMissingPenaltyMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofMissingPenaltyMax == nil {
+				m.ProofMissingPenaltyMax = &types.Coin{}
+			}
+			if err := m.ProofMissingPenaltyMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/app/pocket"
+)
+
+// ComputeProofMissingPenalty returns the amount to slash from a supplier's stake
+// for a claim whose required proof is missing or invalid, according to the
+// proof_missing_penalty_mode param:
+//   - flat: proof_missing_penalty
+//   - claim_proportional: claimeduPOKT * proof_missing_penalty_claim_ratio
+//   - stake_proportional: supplierStake * proof_missing_penalty_stake_ratio
+//   - claim_and_stake_proportional: the sum of the two above
+//
+// In the proportional modes, the penalty is floored at proof_missing_penalty and
+// capped at proof_missing_penalty_max, if non-zero.
+// The returned penalty MAY exceed the supplier's stake; it is the caller's
+// responsibility to bound the amount actually slashed.
+func (params *Params) ComputeProofMissingPenalty(
+	claimeduPOKT cosmostypes.Coin,
+	supplierStake cosmostypes.Coin,
+) (cosmostypes.Coin, error) {
+	penaltyFloor := params.GetProofMissingPenalty()
+	if penaltyFloor == nil {
+		return cosmostypes.Coin{}, ErrProofParamInvalid.Wrap("missing proof_missing_penalty")
+	}
+
+	includeClaim, includeStake := false, false
+	switch params.GetProofMissingPenaltyMode() {
+	case "", ProofMissingPenaltyModeFlat:
+		return *penaltyFloor, nil
+	case ProofMissingPenaltyModeClaimProportional:
+		includeClaim = true
+	case ProofMissingPenaltyModeStakeProportional:
+		includeStake = true
+	case ProofMissingPenaltyModeClaimAndStakeProportional:
+		includeClaim, includeStake = true, true
+	default:
+		return cosmostypes.Coin{}, ErrProofParamInvalid.Wrapf(
+			"unsupported proof_missing_penalty_mode %q",
+			params.GetProofMissingPenaltyMode(),
+		)
+	}
+
+	penaltyDec := math.LegacyZeroDec()
+	if includeClaim {
+		claimPenaltyDec, err := applyProofMissingPenaltyRatio(claimeduPOKT, params.ProofMissingPenaltyClaimRatio)
+		if err != nil {
+			return cosmostypes.Coin{}, err
+		}
+		penaltyDec = penaltyDec.Add(claimPenaltyDec)
+	}
+	if includeStake {
+		stakePenaltyDec, err := applyProofMissingPenaltyRatio(supplierStake, params.ProofMissingPenaltyStakeRatio)
+		if err != nil {
+			return cosmostypes.Coin{}, err
+		}
+		penaltyDec = penaltyDec.Add(stakePenaltyDec)
+	}
+
+	// Truncate the fractional uPOKT, in favor of the supplier.
+	penaltyAmount := math.MaxInt(penaltyDec.TruncateInt(), penaltyFloor.Amount)
+
+	if penaltyMax := params.GetProofMissingPenaltyMax(); penaltyMax != nil && penaltyMax.IsPositive() {
+		penaltyAmount = math.MinInt(penaltyAmount, penaltyMax.Amount)
+	}
+
+	return cosmostypes.NewCoin(pocket.DenomuPOKT, penaltyAmount), nil
+}
+
+// applyProofMissingPenaltyRatio returns amount * ratio as a decimal, which is exact
+// since the amount is an integer, to avoid precision loss until the final penalty
+// is computed.
+func applyProofMissingPenaltyRatio(amount cosmostypes.Coin, ratio math.LegacyDec) (math.LegacyDec, error) {
+	if amount.Denom != pocket.DenomuPOKT {
+		return math.LegacyDec{}, ErrProofInvalidClaimedAmount.Wrapf("expected %s denom, got %s", pocket.DenomuPOKT, amount)
+	}
+
+	if ratio.IsNil() || ratio.IsNegative() {
+		return math.LegacyDec{}, ErrProofParamInvalid.Wrapf("invalid proof missing penalty ratio: %s", ratio)
+	}
+
+	return ratio.MulInt(amount.Amount), nil
+}
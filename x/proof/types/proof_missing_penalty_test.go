@@ -0,0 +1,172 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/app/pocket"
+)
+
+func TestParams_ComputeProofMissingPenalty(t *testing.T) {
+	upokt := func(amount int64) cosmostypes.Coin {
+		return cosmostypes.NewInt64Coin(pocket.DenomuPOKT, amount)
+	}
+
+	penaltyFloor := upokt(100)
+	penaltyMax := upokt(10_000)
+	noPenaltyMax := upokt(0)
+	supplierStake := upokt(50_000)
+
+	tests := []struct {
+		desc          string
+		mode          string
+		claimRatio    string
+		stakeRatio    string
+		penaltyMax    *cosmostypes.Coin
+		claimeduPOKT  cosmostypes.Coin
+		expectedSlash cosmostypes.Coin
+	}{
+		{
+			desc:          "empty mode is flat",
+			mode:          "",
+			claimRatio:    "0.5",
+			claimeduPOKT:  upokt(1_000),
+			expectedSlash: penaltyFloor,
+		},
+		{
+			desc:          "flat: small claim",
+			mode:          ProofMissingPenaltyModeFlat,
+			claimRatio:    "0.5",
+			claimeduPOKT:  upokt(10),
+			expectedSlash: penaltyFloor,
+		},
+		{
+			desc:          "flat: large claim",
+			mode:          ProofMissingPenaltyModeFlat,
+			claimRatio:    "0.5",
+			claimeduPOKT:  upokt(1_000_000),
+			expectedSlash: penaltyFloor,
+		},
+		{
+			desc:          "claim proportional: small claim is floored",
+			mode:          ProofMissingPenaltyModeClaimProportional,
+			claimRatio:    "0.5",
+			penaltyMax:    &penaltyMax,
+			claimeduPOKT:  upokt(10),
+			expectedSlash: penaltyFloor,
+		},
+		{
+			desc:          "claim proportional: medium claim is scaled",
+			mode:          ProofMissingPenaltyModeClaimProportional,
+			claimRatio:    "0.5",
+			penaltyMax:    &penaltyMax,
+			claimeduPOKT:  upokt(1_001),
+			expectedSlash: upokt(500), // 500.5 is truncated
+		},
+		{
+			desc:          "claim proportional: large claim is capped",
+			mode:          ProofMissingPenaltyModeClaimProportional,
+			claimRatio:    "0.5",
+			penaltyMax:    &penaltyMax,
+			claimeduPOKT:  upokt(1_000_000),
+			expectedSlash: penaltyMax,
+		},
+		{
+			desc:          "claim proportional: large claim with zero max is not capped",
+			mode:          ProofMissingPenaltyModeClaimProportional,
+			claimRatio:    "0.5",
+			penaltyMax:    &noPenaltyMax,
+			claimeduPOKT:  upokt(1_000_000),
+			expectedSlash: upokt(500_000),
+		},
+		{
+			desc:          "claim proportional: large claim with nil max is not capped",
+			mode:          ProofMissingPenaltyModeClaimProportional,
+			claimRatio:    "2",
+			claimeduPOKT:  upokt(1_000_000),
+			expectedSlash: upokt(2_000_000),
+		},
+		{
+			desc:          "stake proportional: independent of the claim",
+			mode:          ProofMissingPenaltyModeStakeProportional,
+			claimRatio:    "0.5",
+			stakeRatio:    "0.1",
+			penaltyMax:    &penaltyMax,
+			claimeduPOKT:  upokt(1_000_000),
+			expectedSlash: upokt(5_000),
+		},
+		{
+			desc:          "claim and stake proportional: small claim",
+			mode:          ProofMissingPenaltyModeClaimAndStakeProportional,
+			claimRatio:    "0.5",
+			stakeRatio:    "0.01",
+			penaltyMax:    &penaltyMax,
+			claimeduPOKT:  upokt(10),
+			expectedSlash: upokt(505),
+		},
+		{
+			desc:          "claim and stake proportional: large claim is capped",
+			mode:          ProofMissingPenaltyModeClaimAndStakeProportional,
+			claimRatio:    "0.5",
+			stakeRatio:    "0.01",
+			penaltyMax:    &penaltyMax,
+			claimeduPOKT:  upokt(1_000_000),
+			expectedSlash: penaltyMax,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			params := DefaultParams()
+			params.ProofMissingPenalty = &penaltyFloor
+			params.ProofMissingPenaltyMode = test.mode
+			params.ProofMissingPenaltyClaimRatio = newTestRatio(test.claimRatio)
+			params.ProofMissingPenaltyStakeRatio = newTestRatio(test.stakeRatio)
+			params.ProofMissingPenaltyMax = test.penaltyMax
+			require.NoError(t, params.ValidateBasic())
+
+			slash, err := params.ComputeProofMissingPenalty(test.claimeduPOKT, supplierStake)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedSlash.Denom, slash.Denom)
+			require.Truef(t,
+				test.expectedSlash.Amount.Equal(slash.Amount),
+				"expected slash %s, got %s", test.expectedSlash, slash,
+			)
+		})
+	}
+}
+
+func TestParams_ValidateBasic_ProofMissingPenaltyMaxBelowFloor(t *testing.T) {
+	params := DefaultParams()
+	params.ProofMissingPenaltyMax = &cosmostypes.Coin{
+		Denom:  pocket.DenomuPOKT,
+		Amount: params.ProofMissingPenalty.Amount.Sub(math.OneInt()),
+	}
+	require.ErrorIs(t, params.ValidateBasic(), ErrProofParamInvalid)
+}
+
+func TestParams_ComputeProofMissingPenalty_ExactDecimalRatio(t *testing.T) {
+	params := DefaultParams()
+	params.ProofMissingPenalty = &cosmostypes.Coin{Denom: pocket.DenomuPOKT, Amount: math.ZeroInt()}
+	params.ProofMissingPenaltyMode = ProofMissingPenaltyModeClaimProportional
+	// 0.1 has no exact float64 representation (0.1000000000000000055...),
+	// which would slash 1 more uPOKT than 0.1 of the claim below.
+	params.ProofMissingPenaltyClaimRatio = math.LegacyMustNewDecFromStr("0.1")
+	require.NoError(t, params.ValidateBasic())
+
+	claimeduPOKT := cosmostypes.NewCoin(pocket.DenomuPOKT, math.NewIntWithDecimal(1, 20).SubRaw(1))
+	slash, err := params.ComputeProofMissingPenalty(claimeduPOKT, cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 0))
+	require.NoError(t, err)
+	require.Equal(t, math.NewIntWithDecimal(1, 19).SubRaw(1).String(), slash.Amount.String())
+}
+
+// newTestRatio returns the decimal ratio of the given string, or zero if empty.
+func newTestRatio(ratio string) math.LegacyDec {
+	if ratio == "" {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyMustNewDecFromStr(ratio)
+}
@@ -132,6 +132,8 @@ type MsgUpdateParam struct {
 	//	*MsgUpdateParam_AsBytes
 	//	*MsgUpdateParam_AsFloat
 	//	*MsgUpdateParam_AsCoin
+	//	*MsgUpdateParam_AsString
+	//	*MsgUpdateParam_AsDec
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"as_type"`
 }
 
@@ -179,10 +181,18 @@ type MsgUpdateParam_AsFloat struct {
 type MsgUpdateParam_AsCoin struct {
 	AsCoin *types.Coin `protobuf:"bytes,9,opt,name=as_coin,json=asCoin,proto3,oneof" json:"as_coin"`
 }
+type MsgUpdateParam_AsString struct {
+	AsString string `protobuf:"bytes,10,opt,name=as_string,json=asString,proto3,oneof" json:"as_string"`
+}
+type MsgUpdateParam_AsDec struct {
+	AsDec string `protobuf:"bytes,11,opt,name=as_dec,json=asDec,proto3,oneof" json:"as_dec"`
+}
 
-func (*MsgUpdateParam_AsBytes) isMsgUpdateParam_AsType() {}
-func (*MsgUpdateParam_AsFloat) isMsgUpdateParam_AsType() {}
-func (*MsgUpdateParam_AsCoin) isMsgUpdateParam_AsType()  {}
+func (*MsgUpdateParam_AsBytes) isMsgUpdateParam_AsType()  {}
+func (*MsgUpdateParam_AsFloat) isMsgUpdateParam_AsType()  {}
+func (*MsgUpdateParam_AsCoin) isMsgUpdateParam_AsType()   {}
+func (*MsgUpdateParam_AsString) isMsgUpdateParam_AsType() {}
+func (*MsgUpdateParam_AsDec) isMsgUpdateParam_AsType()    {}
 
 func (m *MsgUpdateParam) GetAsType() isMsgUpdateParam_AsType {
 	if m != nil {
@@ -226,12 +236,28 @@ func (m *MsgUpdateParam) GetAsCoin() *types.Coin {
 	return nil
 }
 
+func (m *MsgUpdateParam) GetAsString() string {
+	if x, ok := m.GetAsType().(*MsgUpdateParam_AsString); ok {
+		return x.AsString
+	}
+	return ""
+}
+
+func (m *MsgUpdateParam) GetAsDec() string {
+	if x, ok := m.GetAsType().(*MsgUpdateParam_AsDec); ok {
+		return x.AsDec
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgUpdateParam) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MsgUpdateParam_AsBytes)(nil),
 		(*MsgUpdateParam_AsFloat)(nil),
 		(*MsgUpdateParam_AsCoin)(nil),
+		(*MsgUpdateParam_AsString)(nil),
+		(*MsgUpdateParam_AsDec)(nil),
 	}
 }
 
@@ -461,53 +487,56 @@ func init() {
 func init() { proto.RegisterFile("pocket/proof/tx.proto", fileDescriptor_e2ac03fee77e551a) }
 
 var fileDescriptor_e2ac03fee77e551a = []byte{
-	// 721 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0xce, 0x86, 0x7f, 0xc9, 0x92, 0x1f, 0xbf, 0xd6, 0x4a, 0x8b, 0x13, 0xc0, 0x44, 0x51, 0x2b,
-	0xa5, 0x48, 0xd8, 0x0a, 0x95, 0x5a, 0x89, 0x1b, 0xa1, 0xaa, 0xa2, 0xaa, 0xa8, 0xd4, 0xd0, 0x4b,
-	0x2f, 0xd1, 0x26, 0x59, 0x6c, 0x8b, 0xd8, 0x6b, 0xed, 0x6e, 0x28, 0xdc, 0xaa, 0x1e, 0x7b, 0xe2,
-	0x31, 0x7a, 0xe4, 0xd0, 0xbe, 0x03, 0x47, 0xd4, 0x13, 0x52, 0x25, 0x54, 0x85, 0x03, 0x2a, 0x4f,
-	0x51, 0xed, 0x7a, 0x0d, 0x76, 0x48, 0x69, 0xd5, 0x53, 0x2f, 0xf6, 0xce, 0x7c, 0x33, 0xe3, 0x99,
-	0xcf, 0xdf, 0x0e, 0xbc, 0x17, 0x92, 0xce, 0x2e, 0xe6, 0x56, 0x48, 0x09, 0xd9, 0xb1, 0xf8, 0xbe,
-	0x19, 0x52, 0xc2, 0x89, 0x56, 0x88, 0xdc, 0xa6, 0x74, 0x97, 0xef, 0x22, 0xdf, 0x0b, 0x88, 0x25,
-	0x9f, 0x51, 0x40, 0xd9, 0xe8, 0x10, 0xe6, 0x13, 0x66, 0xb5, 0x11, 0xc3, 0xd6, 0x5e, 0xbd, 0x8d,
-	0x39, 0xaa, 0x5b, 0x1d, 0xe2, 0x05, 0x0a, 0x9f, 0x55, 0xb8, 0xcf, 0x1c, 0x6b, 0xaf, 0x2e, 0x5e,
-	0x0a, 0x28, 0x45, 0x40, 0x4b, 0x5a, 0x56, 0x64, 0x28, 0xa8, 0xe8, 0x10, 0x87, 0x44, 0x7e, 0x71,
-	0x8a, 0x13, 0x52, 0x1d, 0x86, 0x88, 0x22, 0x3f, 0x4e, 0xd0, 0xd3, 0xcd, 0x1f, 0x84, 0x38, 0x46,
-	0xca, 0x0a, 0x61, 0x98, 0x31, 0x8f, 0x04, 0x29, 0x6c, 0x2e, 0xc6, 0x5c, 0x44, 0x71, 0xd7, 0x62,
-	0x98, 0xee, 0x79, 0x1d, 0x1c, 0x81, 0xd5, 0x2f, 0x00, 0xfe, 0xbf, 0xc1, 0x9c, 0x37, 0x61, 0x17,
-	0x71, 0xbc, 0x29, 0x3f, 0xa6, 0x3d, 0x81, 0x79, 0xd4, 0xe7, 0x2e, 0xa1, 0x1e, 0x3f, 0xd0, 0x41,
-	0x05, 0xd4, 0xf2, 0x0d, 0xfd, 0xeb, 0xe7, 0xe5, 0xa2, 0x6a, 0x7e, 0xad, 0xdb, 0xa5, 0x98, 0xb1,
-	0x2d, 0x4e, 0xbd, 0xc0, 0xb1, 0xaf, 0x43, 0xb5, 0xa7, 0x70, 0x32, 0x6a, 0x57, 0xcf, 0x56, 0x40,
-	0x6d, 0x7a, 0xa5, 0x68, 0x26, 0x59, 0x35, 0xa3, 0xea, 0x8d, 0xfc, 0xf1, 0xd9, 0x62, 0xe6, 0xd3,
-	0xc5, 0xd1, 0x12, 0xb0, 0x55, 0xf8, 0x6a, 0xfd, 0xc3, 0xc5, 0xd1, 0xd2, 0x75, 0xa1, 0x8f, 0x17,
-	0x47, 0x4b, 0x86, 0x6a, 0x7a, 0x5f, 0x0d, 0x3b, 0xd4, 0x63, 0xb5, 0x04, 0x67, 0x87, 0x5c, 0x36,
-	0x66, 0x21, 0x09, 0x18, 0xae, 0x1e, 0x66, 0xe1, 0x4c, 0x1a, 0xfb, 0xeb, 0x89, 0x34, 0x38, 0x1e,
-	0x20, 0x1f, 0xcb, 0x79, 0xf2, 0xb6, 0x3c, 0x6b, 0x8f, 0x60, 0x0e, 0xb1, 0x56, 0xfb, 0x80, 0x63,
-	0xa6, 0x4f, 0x55, 0x40, 0xad, 0xd0, 0x28, 0x5c, 0x9e, 0x2d, 0x5e, 0xf9, 0x9a, 0x19, 0x7b, 0x0a,
-	0xb1, 0x86, 0x38, 0xaa, 0xd0, 0x9d, 0x1e, 0x41, 0x5c, 0xcf, 0x55, 0x40, 0x0d, 0x5c, 0x85, 0x4a,
-	0x5f, 0x14, 0xfa, 0x5c, 0x1c, 0xb5, 0x35, 0x38, 0x85, 0x58, 0x4b, 0x08, 0x4a, 0xcf, 0x4b, 0xf2,
-	0x4a, 0xa6, 0x6a, 0x4e, 0x28, 0xce, 0x54, 0x8a, 0x33, 0xd7, 0x89, 0x17, 0x34, 0xa6, 0x2f, 0xcf,
-	0x16, 0xe3, 0xe8, 0x66, 0xc6, 0x9e, 0x44, 0x4c, 0xb8, 0x57, 0x67, 0xd2, 0x2c, 0x36, 0xf2, 0xb2,
-	0xa4, 0x50, 0x42, 0xd5, 0x80, 0xf7, 0xd3, 0x8c, 0xc4, 0x64, 0xbd, 0x18, 0xcf, 0x81, 0x3b, 0xd9,
-	0xea, 0x0f, 0x20, 0x29, 0x5b, 0xa7, 0x18, 0x71, 0xbc, 0xde, 0x43, 0x9e, 0xaf, 0x6d, 0xc3, 0x12,
-	0xeb, 0x87, 0x61, 0xcf, 0xc3, 0xb4, 0x45, 0x42, 0x4c, 0x11, 0x27, 0xb4, 0x85, 0x22, 0xa2, 0x7e,
-	0x4b, 0xe1, 0x6c, 0x9c, 0xfa, 0x4a, 0x65, 0x2a, 0x58, 0x7b, 0x06, 0x67, 0x94, 0x44, 0x5b, 0x2e,
-	0x46, 0x5d, 0x4c, 0x95, 0x54, 0x16, 0x62, 0xa9, 0x28, 0xd4, 0xdc, 0x8a, 0xde, 0x4d, 0x19, 0x64,
-	0xff, 0xc7, 0x92, 0xa6, 0x36, 0x07, 0xf3, 0x94, 0x10, 0xde, 0x72, 0x11, 0x73, 0xf5, 0x31, 0xf1,
-	0x0f, 0xec, 0x9c, 0x70, 0x34, 0x11, 0x73, 0x57, 0x0d, 0x41, 0xc3, 0xaf, 0x7b, 0x57, 0x5c, 0x24,
-	0x46, 0x1d, 0xe2, 0x62, 0x10, 0x71, 0xb1, 0xd5, 0x6f, 0xfb, 0x1e, 0xdf, 0x14, 0xe2, 0xfb, 0xa7,
-	0xb9, 0x28, 0xc2, 0x09, 0x79, 0x43, 0x14, 0x0f, 0x91, 0xf1, 0x87, 0x24, 0x24, 0x66, 0x4c, 0x93,
-	0xb0, 0xf2, 0x2d, 0x0b, 0xc7, 0x36, 0x98, 0xa3, 0x6d, 0xc3, 0x42, 0x6a, 0x35, 0x2c, 0xa4, 0xaf,
-	0xf4, 0xd0, 0x15, 0x2c, 0x3f, 0xbc, 0x15, 0x8e, 0xbf, 0xa1, 0xbd, 0x86, 0xd3, 0x49, 0xa9, 0xcd,
-	0xdf, 0xc8, 0x4a, 0xa0, 0xe5, 0x07, 0xb7, 0xa1, 0xc9, 0x92, 0xc9, 0x3f, 0x76, 0xb3, 0x64, 0x02,
-	0x1d, 0x51, 0x72, 0x04, 0x13, 0xa2, 0x64, 0x72, 0x87, 0xcc, 0xdf, 0x36, 0xdb, 0x88, 0x92, 0x23,
-	0x6e, 0x5b, 0x79, 0xe2, 0xbd, 0xd8, 0x7b, 0x8d, 0x97, 0xc7, 0x03, 0x03, 0x9c, 0x0c, 0x0c, 0x70,
-	0x3a, 0x30, 0xc0, 0xf7, 0x81, 0x01, 0x0e, 0xcf, 0x8d, 0xcc, 0xc9, 0xb9, 0x91, 0x39, 0x3d, 0x37,
-	0x32, 0x6f, 0x4d, 0xc7, 0xe3, 0x6e, 0xbf, 0x6d, 0x76, 0x88, 0x6f, 0x85, 0x64, 0x97, 0x2f, 0x07,
-	0x98, 0xbf, 0x23, 0x74, 0x57, 0x1a, 0x94, 0xf4, 0x7a, 0x57, 0x4b, 0x51, 0x6e, 0xf9, 0xf6, 0xa4,
-	0xdc, 0xe4, 0x8f, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x14, 0xc8, 0x74, 0x1d, 0xdb, 0x06, 0x00,
-	0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x4f, 0xe3, 0x46,
+	0x14, 0xb6, 0x03, 0x84, 0x78, 0x12, 0x68, 0x6b, 0xa5, 0xc5, 0x31, 0xe0, 0x44, 0x11, 0x95, 0x52,
+	0x54, 0x6c, 0x85, 0x4a, 0xad, 0xc4, 0x8d, 0x80, 0xaa, 0xa8, 0x2a, 0x2a, 0x35, 0xf4, 0xd2, 0x8b,
+	0x35, 0x71, 0x86, 0xc4, 0x22, 0xf6, 0x58, 0x33, 0x13, 0x0a, 0xb7, 0xaa, 0xc7, 0x9e, 0xf6, 0x67,
+	0xec, 0x91, 0x03, 0xfb, 0x1f, 0x38, 0x22, 0x4e, 0x48, 0x2b, 0x45, 0xab, 0x70, 0x40, 0x9b, 0xdb,
+	0xfe, 0x83, 0x95, 0xc7, 0x63, 0xb0, 0x83, 0x36, 0xbb, 0xda, 0xd3, 0x5e, 0x92, 0x99, 0xef, 0x7b,
+	0xf3, 0xe6, 0xbd, 0xef, 0xbd, 0x79, 0x06, 0xdf, 0x86, 0xd8, 0x3d, 0x45, 0xcc, 0x0a, 0x09, 0xc6,
+	0x27, 0x16, 0x3b, 0x37, 0x43, 0x82, 0x19, 0x56, 0x4b, 0x31, 0x6c, 0x72, 0x58, 0xff, 0x06, 0xfa,
+	0x5e, 0x80, 0x2d, 0xfe, 0x1b, 0x1b, 0xe8, 0x86, 0x8b, 0xa9, 0x8f, 0xa9, 0xd5, 0x81, 0x14, 0x59,
+	0x67, 0xcd, 0x0e, 0x62, 0xb0, 0x69, 0xb9, 0xd8, 0x0b, 0x04, 0xbf, 0x22, 0x78, 0x9f, 0xf6, 0xac,
+	0xb3, 0x66, 0xf4, 0x27, 0x88, 0x4a, 0x4c, 0x38, 0x7c, 0x67, 0xc5, 0x1b, 0x41, 0x95, 0x7b, 0xb8,
+	0x87, 0x63, 0x3c, 0x5a, 0x25, 0x07, 0x32, 0x11, 0x86, 0x90, 0x40, 0x3f, 0x39, 0xa0, 0x65, 0x83,
+	0xbf, 0x08, 0x51, 0xc2, 0xe8, 0x82, 0xa1, 0x88, 0x52, 0x0f, 0x07, 0x19, 0x6e, 0x35, 0xe1, 0xfa,
+	0x90, 0xa0, 0xae, 0x45, 0x11, 0x39, 0xf3, 0x5c, 0x14, 0x93, 0xf5, 0x57, 0x32, 0xf8, 0xea, 0x80,
+	0xf6, 0xfe, 0x0a, 0xbb, 0x90, 0xa1, 0x43, 0x7e, 0x99, 0xfa, 0x33, 0x50, 0xe0, 0x90, 0xf5, 0x31,
+	0xf1, 0xd8, 0x85, 0x26, 0xd7, 0xe4, 0x86, 0xd2, 0xd2, 0x6e, 0xaf, 0xb6, 0xca, 0x22, 0xf8, 0xdd,
+	0x6e, 0x97, 0x20, 0x4a, 0x8f, 0x18, 0xf1, 0x82, 0x9e, 0xfd, 0x64, 0xaa, 0xfe, 0x02, 0xf2, 0x71,
+	0xb8, 0x5a, 0xae, 0x26, 0x37, 0x8a, 0xdb, 0x65, 0x33, 0xad, 0xaa, 0x19, 0x7b, 0x6f, 0x29, 0xd7,
+	0xa3, 0xaa, 0xf4, 0xf2, 0xe1, 0x72, 0x53, 0xb6, 0x85, 0xf9, 0x4e, 0xf3, 0xbf, 0x87, 0xcb, 0xcd,
+	0x27, 0x47, 0xff, 0x3f, 0x5c, 0x6e, 0x1a, 0x22, 0xe8, 0x73, 0x91, 0xec, 0x54, 0x8c, 0xf5, 0x0a,
+	0x58, 0x99, 0x82, 0x6c, 0x44, 0x43, 0x1c, 0x50, 0x54, 0x7f, 0x97, 0x03, 0xcb, 0x59, 0xee, 0xb3,
+	0x33, 0x52, 0xc1, 0x7c, 0x00, 0x7d, 0xc4, 0xf3, 0x51, 0x6c, 0xbe, 0x56, 0x7f, 0x00, 0x05, 0x48,
+	0x9d, 0xce, 0x05, 0x43, 0x54, 0x5b, 0xac, 0xc9, 0x8d, 0x52, 0xab, 0x34, 0x19, 0x55, 0x1f, 0xb1,
+	0xb6, 0x64, 0x2f, 0x42, 0xda, 0x8a, 0x96, 0xc2, 0xf4, 0x64, 0x80, 0x21, 0xd3, 0x0a, 0x35, 0xb9,
+	0x21, 0x3f, 0x9a, 0x72, 0x2c, 0x36, 0xfd, 0x35, 0x5a, 0xaa, 0xbb, 0x60, 0x11, 0x52, 0x27, 0x6a,
+	0x28, 0x4d, 0xe1, 0xe2, 0x55, 0x4c, 0x11, 0x5c, 0xd4, 0x71, 0xa6, 0xe8, 0x38, 0x73, 0x0f, 0x7b,
+	0x41, 0xab, 0x38, 0x19, 0x55, 0x13, 0xeb, 0xb6, 0x64, 0xe7, 0x21, 0x8d, 0x60, 0xf5, 0x47, 0xa0,
+	0x40, 0xea, 0x50, 0x9e, 0x84, 0x06, 0x78, 0x92, 0x4b, 0x93, 0x51, 0xf5, 0x09, 0x6c, 0x4b, 0x76,
+	0x01, 0x8a, 0x2c, 0xd5, 0x26, 0xc8, 0x43, 0xea, 0x74, 0x91, 0xab, 0x15, 0x63, 0x3d, 0x26, 0xa3,
+	0xaa, 0x40, 0x6e, 0xaf, 0xb6, 0x80, 0xb8, 0x7c, 0x1f, 0xb9, 0x6d, 0xc9, 0x5e, 0x80, 0x74, 0x1f,
+	0xb9, 0x3b, 0xcb, 0xd9, 0x32, 0xb5, 0x14, 0x1e, 0x73, 0xd4, 0x6a, 0x75, 0x03, 0x7c, 0x97, 0x95,
+	0x3c, 0xa9, 0xc6, 0x6f, 0xf3, 0x05, 0xf9, 0xeb, 0x5c, 0xfd, 0xad, 0xcc, 0x6b, 0xb2, 0x47, 0x10,
+	0x64, 0x68, 0x6f, 0x00, 0x3d, 0x5f, 0x3d, 0x06, 0x15, 0x3a, 0x0c, 0xc3, 0x81, 0x87, 0x88, 0x83,
+	0x43, 0x44, 0x20, 0xc3, 0xc4, 0x81, 0x71, 0x25, 0x3e, 0x5a, 0xa3, 0x95, 0xe4, 0xe8, 0x1f, 0xe2,
+	0xa4, 0xa0, 0xd5, 0x7d, 0xb0, 0x2c, 0xde, 0x80, 0xd3, 0x47, 0xb0, 0x8b, 0x88, 0xe8, 0xc5, 0xf5,
+	0xa4, 0x17, 0x05, 0x6b, 0x1e, 0xc5, 0xff, 0x6d, 0x6e, 0x64, 0x2f, 0xd1, 0xf4, 0x56, 0x5d, 0x05,
+	0x0a, 0xc1, 0x98, 0x39, 0x7d, 0x48, 0xfb, 0xda, 0x5c, 0x54, 0x64, 0xbb, 0x10, 0x01, 0x6d, 0x48,
+	0xfb, 0x3b, 0x46, 0x24, 0xc3, 0x87, 0x63, 0x17, 0x5a, 0xa4, 0x52, 0x9d, 0xd2, 0x62, 0x1c, 0x6b,
+	0x71, 0x34, 0xec, 0xf8, 0x1e, 0x3b, 0x8c, 0xba, 0xfb, 0x8b, 0xd6, 0xa2, 0x0c, 0x16, 0xf8, 0x13,
+	0x14, 0x3a, 0xc4, 0x9b, 0x4f, 0x14, 0x21, 0x95, 0x63, 0x56, 0x84, 0xed, 0xd7, 0x39, 0x30, 0x77,
+	0x40, 0x7b, 0xea, 0x31, 0x28, 0x65, 0x66, 0xcf, 0x7a, 0x76, 0x66, 0x4c, 0xbd, 0x71, 0xfd, 0xfb,
+	0x99, 0x74, 0x72, 0x87, 0xfa, 0x27, 0x28, 0xa6, 0x5b, 0x6d, 0xed, 0xd9, 0xa9, 0x14, 0xab, 0x6f,
+	0xcc, 0x62, 0xd3, 0x2e, 0xd3, 0x15, 0x7b, 0xee, 0x32, 0xc5, 0xea, 0x1b, 0xb3, 0xd8, 0xb4, 0xcb,
+	0xf4, 0x90, 0x5a, 0x9b, 0x95, 0x9b, 0xbe, 0x31, 0x8b, 0x4d, 0x5c, 0xea, 0x0b, 0xff, 0x46, 0x83,
+	0xb5, 0xf5, 0xfb, 0xf5, 0xd8, 0x90, 0x6f, 0xc6, 0x86, 0x7c, 0x37, 0x36, 0xe4, 0x37, 0x63, 0x43,
+	0x7e, 0x71, 0x6f, 0x48, 0x37, 0xf7, 0x86, 0x74, 0x77, 0x6f, 0x48, 0x7f, 0x9b, 0x3d, 0x8f, 0xf5,
+	0x87, 0x1d, 0xd3, 0xc5, 0xbe, 0x15, 0xe2, 0x53, 0xb6, 0x15, 0x20, 0xf6, 0x0f, 0x26, 0xa7, 0x7c,
+	0x43, 0xf0, 0x60, 0xf0, 0x38, 0x75, 0xf9, 0x67, 0xa4, 0x93, 0xe7, 0x9f, 0x8a, 0x9f, 0xde, 0x0f,
+	0x00, 0x60, 0x4a, 0xc2, 0x53, 0x3c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -862,6 +891,34 @@ func (m *MsgUpdateParam_AsCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *MsgUpdateParam_AsString) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParam_AsString) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.AsString)
+	copy(dAtA[i:], m.AsString)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.AsString)))
+	i--
+	dAtA[i] = 0x52
+	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParam_AsDec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParam_AsDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.AsDec)
+	copy(dAtA[i:], m.AsDec)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.AsDec)))
+	i--
+	dAtA[i] = 0x5a
+	return len(dAtA) - i, nil
+}
 func (m *MsgUpdateParamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1117,6 +1174,26 @@ func (m *MsgUpdateParam_AsCoin) Size() (n int) {
 	}
 	return n
 }
+func (m *MsgUpdateParam_AsString) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AsString)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgUpdateParam_AsDec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AsDec)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
 func (m *MsgUpdateParamResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1529,6 +1606,70 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 			}
 			m.AsType = &MsgUpdateParam_AsCoin{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsType = &MsgUpdateParam_AsString{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsDec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsType = &MsgUpdateParam_AsDec{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -44,6 +44,13 @@ func (k msgServer) AddService(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// The target number of relays set by the service owner, if any, must be within
+	// the governance bounds.
+	if err := k.GetParams(ctx).ValidateServiceTargetNumRelays(msg.Service.TargetNumRelays); err != nil {
+		logger.Error(fmt.Sprintf("Adding service failed target number of relays validation: %v", err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Check if the service already exists or not.
 	foundService, found := k.GetService(ctx, msg.Service.Id)
 	if found {
@@ -62,8 +69,8 @@ func (k msgServer) AddService(
 		// https://github.com/pokt-network/poktroll/pull/1388
 
 		// Update the service fields that are allowed to change
-		logger.Info(fmt.Sprintf("Updating service: ComputeUnitsPerRelay=%v, HasMetadata=%v",
-			msg.Service.ComputeUnitsPerRelay, msg.Service.Metadata != nil))
+		logger.Info(fmt.Sprintf("Updating service: ComputeUnitsPerRelay=%v, TargetNumRelays=%v, ResetTargetNumRelays=%v, HasMetadata=%v",
+			msg.Service.ComputeUnitsPerRelay, msg.Service.TargetNumRelays, msg.ResetTargetNumRelays, msg.Service.Metadata != nil))
 
 		// Capture the previous cupr before overwriting so a change can be snapshotted
 		// for session-start-pinned claim validation.
@@ -72,6 +79,16 @@ func (k msgServer) AddService(
 		foundService.Name = msg.Service.Name
 		foundService.ComputeUnitsPerRelay = msg.Service.ComputeUnitsPerRelay
 
+		// Like metadata, only overwrite the target number of relays when the message
+		// carries one, so that clients updating other fields (e.g. the `edit-service` CLI)
+		// do not reset it. Resetting it to the target_num_relays param is explicit.
+		switch {
+		case msg.ResetTargetNumRelays:
+			foundService.TargetNumRelays = 0
+		case msg.Service.TargetNumRelays != 0:
+			foundService.TargetNumRelays = msg.Service.TargetNumRelays
+		}
+
 		// Only overwrite metadata when the message actually carries it.
 		//
 		// MsgAddService is the ONLY update path for an existing service and always
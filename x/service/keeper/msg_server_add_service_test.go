@@ -315,3 +315,66 @@ func TestMsgServer_AddService_UpdatePreservesMetadata(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, replacementMetadata, serviceFound.Metadata, "explicit metadata must replace the stored value")
 }
+
+func TestMsgServer_AddService_TargetNumRelays(t *testing.T) {
+	k, ctx := keepertest.ServiceKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	serviceOwnerAddr := sample.AccAddressBech32()
+	keepertest.AddAccToAccMapCoins(t, serviceOwnerAddr, pocket.DenomuPOKT, oneUPOKTGreaterThanFee)
+
+	newMsgAddService := func(targetNumRelays uint64) *types.MsgAddService {
+		return &types.MsgAddService{
+			OwnerAddress: serviceOwnerAddr,
+			Service: sharedtypes.Service{
+				Id:                   "svc-target",
+				Name:                 "service with a target number of relays",
+				ComputeUnitsPerRelay: 1,
+				OwnerAddress:         serviceOwnerAddr,
+				TargetNumRelays:      targetNumRelays,
+			},
+		}
+	}
+
+	// A target number of relays out of the governance bounds is rejected.
+	params := k.GetParams(ctx)
+	_, err := srv.AddService(ctx, newMsgAddService(params.MaxServiceTargetNumRelays+1))
+	require.ErrorContains(t, err, types.ErrServiceInvalidTargetNumRelays.Error())
+	_, err = srv.AddService(ctx, newMsgAddService(params.MinServiceTargetNumRelays-1))
+	require.ErrorContains(t, err, types.ErrServiceInvalidTargetNumRelays.Error())
+
+	_, found := k.GetService(ctx, "svc-target")
+	require.False(t, found)
+
+	// A target number of relays within the governance bounds is stored.
+	_, err = srv.AddService(ctx, newMsgAddService(params.MaxServiceTargetNumRelays))
+	require.NoError(t, err)
+
+	serviceFound, found := k.GetService(ctx, "svc-target")
+	require.True(t, found)
+	require.Equal(t, params.MaxServiceTargetNumRelays, serviceFound.TargetNumRelays)
+
+	// An update without a target number of relays keeps the existing one.
+	_, err = srv.AddService(ctx, newMsgAddService(0))
+	require.NoError(t, err)
+
+	serviceFound, found = k.GetService(ctx, "svc-target")
+	require.True(t, found)
+	require.Equal(t, params.MaxServiceTargetNumRelays, serviceFound.TargetNumRelays)
+
+	// Resetting the target number of relays along with a new one is rejected.
+	resetMsg := newMsgAddService(params.MinServiceTargetNumRelays)
+	resetMsg.ResetTargetNumRelays = true
+	_, err = srv.AddService(ctx, resetMsg)
+	require.ErrorContains(t, err, types.ErrServiceInvalidTargetNumRelays.Error())
+
+	// An explicit reset resets the service to the target_num_relays param.
+	resetMsg = newMsgAddService(0)
+	resetMsg.ResetTargetNumRelays = true
+	_, err = srv.AddService(ctx, resetMsg)
+	require.NoError(t, err)
+
+	serviceFound, found = k.GetService(ctx, "svc-target")
+	require.True(t, found)
+	require.Zero(t, serviceFound.TargetNumRelays)
+}
@@ -35,6 +35,7 @@ func (k msgServer) UpdateParam(
 	}
 
 	params := k.GetParams(ctx)
+	prevStrategy := params.RelayMiningDifficultyStrategy
 
 	switch msg.Name {
 	case servicetypes.ParamAddServiceFee:
@@ -43,6 +44,24 @@ func (k msgServer) UpdateParam(
 	case servicetypes.ParamTargetNumRelays:
 		logger = logger.With("param_value", msg.GetAsUint64())
 		params.TargetNumRelays = msg.GetAsUint64()
+	case servicetypes.ParamRelayMiningDifficultyStrategy:
+		logger = logger.With("param_value", msg.GetAsString())
+		params.RelayMiningDifficultyStrategy = msg.GetAsString()
+	case servicetypes.ParamRelayMiningDifficultyEmaAlphaBps:
+		logger = logger.With("param_value", msg.GetAsUint64())
+		params.RelayMiningDifficultyEmaAlphaBps = msg.GetAsUint64()
+	case servicetypes.ParamRelayMiningDifficultyDeadbandBps:
+		logger = logger.With("param_value", msg.GetAsUint64())
+		params.RelayMiningDifficultyDeadbandBps = msg.GetAsUint64()
+	case servicetypes.ParamRelayMiningDifficultyMaxStepBps:
+		logger = logger.With("param_value", msg.GetAsUint64())
+		params.RelayMiningDifficultyMaxStepBps = msg.GetAsUint64()
+	case servicetypes.ParamMinServiceTargetNumRelays:
+		logger = logger.With("param_value", msg.GetAsUint64())
+		params.MinServiceTargetNumRelays = msg.GetAsUint64()
+	case servicetypes.ParamMaxServiceTargetNumRelays:
+		logger = logger.With("param_value", msg.GetAsUint64())
+		params.MaxServiceTargetNumRelays = msg.GetAsUint64()
 	default:
 		return nil, status.Error(
 			codes.InvalidArgument,
@@ -61,5 +80,8 @@ func (k msgServer) UpdateParam(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// The strategies compute the EMA of different quantities of relays.
+	k.convertAllRelayMiningDifficultyNumRelaysEma(ctx, prevStrategy, params.RelayMiningDifficultyStrategy)
+
 	return &servicetypes.MsgUpdateParamResponse{}, nil
 }
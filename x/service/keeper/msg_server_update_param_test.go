@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -69,3 +70,90 @@ func TestMsgUpdateParam_UpdateTargetNumRelaysOnly(t *testing.T) {
 	// Ensure the other parameters are unchanged
 	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &params, "TargetNumRelays")
 }
+
+func TestMsgUpdateParam_UpdateRelayMiningDifficultyStrategyOnly(t *testing.T) {
+	expectedStrategy := servicetypes.RelayMiningDifficultyStrategyBoundedEma
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := servicetypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.NotEqual(t, expectedStrategy, defaultParams.RelayMiningDifficultyStrategy)
+
+	// Update the relay mining difficulty strategy parameter
+	updateParamMsg := &servicetypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      servicetypes.ParamRelayMiningDifficultyStrategy,
+		AsType:    &servicetypes.MsgUpdateParam_AsString{AsString: expectedStrategy},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	require.NotEqual(t, defaultParams.RelayMiningDifficultyStrategy, params.RelayMiningDifficultyStrategy)
+	require.Equal(t, expectedStrategy, params.RelayMiningDifficultyStrategy)
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &params, "RelayMiningDifficultyStrategy")
+}
+
+func TestMsgUpdateParam_UpdateRelayMiningDifficultyStrategy_ConvertsNumRelaysEma(t *testing.T) {
+	k, msgSrv, ctx := setupMsgServer(t)
+	require.NoError(t, k.SetParams(ctx, servicetypes.DefaultParams()))
+
+	// Half of the relays served are claimed at this target hash.
+	targetHash, err := hex.DecodeString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	require.NoError(t, err)
+	k.SetRelayMiningDifficulty(ctx, servicetypes.RelayMiningDifficulty{
+		ServiceId:    "svc1",
+		BlockHeight:  1,
+		NumRelaysEma: 100_000,
+		TargetHash:   targetHash,
+	})
+
+	updateStrategy := func(strategy string) {
+		updateParamMsg := &servicetypes.MsgUpdateParam{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Name:      servicetypes.ParamRelayMiningDifficultyStrategy,
+			AsType:    &servicetypes.MsgUpdateParam_AsString{AsString: strategy},
+		}
+		_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+		require.NoError(t, err)
+	}
+
+	// The EMA of the claimed relays is converted to the EMA of the relays served.
+	updateStrategy(servicetypes.RelayMiningDifficultyStrategyBoundedEma)
+	difficulty, found := k.GetRelayMiningDifficulty(ctx, "svc1")
+	require.True(t, found)
+	require.Equal(t, uint64(200_000), difficulty.NumRelaysEma)
+	require.Equal(t, targetHash, difficulty.TargetHash)
+
+	// Re-submitting the same strategy leaves the EMA unchanged.
+	updateStrategy(servicetypes.RelayMiningDifficultyStrategyBoundedEma)
+	difficulty, _ = k.GetRelayMiningDifficulty(ctx, "svc1")
+	require.Equal(t, uint64(200_000), difficulty.NumRelaysEma)
+
+	// The EMA of the relays served is converted back to the EMA of the claimed relays.
+	updateStrategy(servicetypes.RelayMiningDifficultyStrategyEma)
+	difficulty, _ = k.GetRelayMiningDifficulty(ctx, "svc1")
+	require.Equal(t, uint64(99_999), difficulty.NumRelaysEma)
+}
+
+func TestMsgUpdateParam_UpdateRelayMiningDifficultyStrategyUnsupported(t *testing.T) {
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := servicetypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	updateParamMsg := &servicetypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      servicetypes.ParamRelayMiningDifficultyStrategy,
+		AsType:    &servicetypes.MsgUpdateParam_AsString{AsString: "pid"},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.ErrorContains(t, err, servicetypes.ErrServiceParamInvalid.Error())
+
+	// Ensure the parameters are unchanged
+	require.Equal(t, defaultParams, k.GetParams(ctx))
+}
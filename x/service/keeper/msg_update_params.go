@@ -28,7 +28,8 @@ func (k msgServer) UpdateParams(
 		)
 	}
 
-	logger.Info(fmt.Sprintf("About to update params from [%v] to [%v]", k.GetParams(ctx), msg.Params))
+	prevParams := k.GetParams(ctx)
+	logger.Info(fmt.Sprintf("About to update params from [%v] to [%v]", prevParams, msg.Params))
 
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		err = fmt.Errorf("unable to set params: %w", err)
@@ -36,6 +37,13 @@ func (k msgServer) UpdateParams(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// The strategies compute the EMA of different quantities of relays.
+	k.convertAllRelayMiningDifficultyNumRelaysEma(
+		ctx,
+		prevParams.RelayMiningDifficultyStrategy,
+		msg.Params.RelayMiningDifficultyStrategy,
+	)
+
 	logger.Info("Done updating params")
 
 	return &types.MsgUpdateParamsResponse{}, nil
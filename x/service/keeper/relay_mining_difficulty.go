@@ -92,6 +92,24 @@ func (k Keeper) GetAllRelayMiningDifficulty(ctx context.Context) (list []types.R
 	return
 }
 
+// convertAllRelayMiningDifficultyNumRelaysEma converts the EMA of the number of
+// relays of every relayMiningDifficulty from the relays counted by fromStrategy
+// to the relays counted by toStrategy, so that the new strategy does not compute
+// the next difficulties from an EMA of a different quantity.
+func (k Keeper) convertAllRelayMiningDifficultyNumRelaysEma(
+	ctx context.Context,
+	fromStrategy, toStrategy string,
+) {
+	if fromStrategy == toStrategy {
+		return
+	}
+
+	for _, difficulty := range k.GetAllRelayMiningDifficulty(ctx) {
+		difficulty.NumRelaysEma = types.ConvertNumRelaysEma(difficulty, fromStrategy, toStrategy)
+		k.SetRelayMiningDifficulty(ctx, difficulty)
+	}
+}
+
 // SetRelayMiningDifficultyAtHeight stores a snapshot of relay mining difficulty
 // with its effective height for historical lookups.
 func (k Keeper) SetRelayMiningDifficultyAtHeight(
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
-	"math/big"
 	"sort"
 
 	"cosmossdk.io/log"
@@ -16,19 +15,10 @@ import (
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
-var (
-	// Exponential moving average (ema) smoothing factor, commonly known as alpha.
-	// Usually, alpha = 2 / (N+1), where N is the number of periods.
-	// Large alpha -> more weight on recent data; less smoothing and fast response.
-	// Small alpha -> more weight on past data; more smoothing and slow response.
-	//
-	// TODO_MAINNET_MIGRATION(@olshansk, @rawthil): Play around with the value N for EMA to
-	// capture what the memory should be.
-	emaSmoothingFactor = new(big.Float).SetFloat64(0.1)
-)
-
 // UpdateRelayMiningDifficulty updates the onchain relay mining difficulty
 // based on the amount of onchain relays for each service, given a map of serviceId->numRelays.
+// The difficulty is computed by the strategy selected by the relay_mining_difficulty_strategy
+// param, targeting the target number of relays of each service.
 func (k Keeper) UpdateRelayMiningDifficulty(
 	ctx context.Context,
 	relaysPerServiceMap map[string]uint64,
@@ -46,15 +36,28 @@ func (k Keeper) UpdateRelayMiningDifficulty(
 	currentSessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)
 	nextSessionStartHeight := currentSessionEndHeight + 1
 
+	params := k.GetParams(ctx)
+	difficultyStrategy, err := types.NewRelayMiningDifficultyStrategy(params)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate over the relaysPerServiceMap deterministically by sorting the keys.
 	// This ensures that the order of the keys is consistent across different nodes.
 	// See comment: https://github.com/pokt-network/poktroll/pull/840#discussion_r1796663285
-	targetNumRelays := k.GetParams(ctx).TargetNumRelays
 	sortedRelayPerServiceMapKeys := getSortedMapKeys(relaysPerServiceMap)
 	for _, serviceId := range sortedRelayPerServiceMapKeys {
 		numRelays := relaysPerServiceMap[serviceId]
+		targetNumRelays := k.getServiceTargetNumRelays(ctx, params, serviceId)
+
+		// The claimed relays were mined against the difficulty of their session, which
+		// the current difficulty approximates. The relays of services without a
+		// difficulty were mined against the base difficulty (see GetRelayMiningDifficultyAtHeight).
+		minedTargetHash := protocol.BaseRelayDifficultyHashBz
 		prevDifficulty, found := k.GetRelayMiningDifficulty(ctx, serviceId)
-		if !found {
+		if found {
+			minedTargetHash = prevDifficulty.TargetHash
+		} else {
 			prevDifficulty = NewDefaultRelayMiningDifficulty(
 				ctx,
 				logger,
@@ -64,29 +67,13 @@ func (k Keeper) UpdateRelayMiningDifficulty(
 			)
 		}
 
-		// TODO_MAINNET(@Olshansk): We could potentially compute the smoothing factor
-		// using a common formula, such as alpha = 2 / (N+1), where N is the number
-		// of periods.
-		// N := ctx.BlockHeight() - prevDifficulty.BlockHeight
-		// alpha := 2 / (1 + N)
-		alpha := emaSmoothingFactor
-
-		// Compute the updated EMA of the number of relays.
-		prevRelaysEma := prevDifficulty.NumRelaysEma
-		newRelaysEma := computeEma(alpha, prevRelaysEma, numRelays)
-
-		// CRITICAL_DEV_NOTE: We changed this code to pass in  "BaseRelayDifficultyHashBz" instead of "prevDifficulty.TargetHash"
-		// to "ComputeNewDifficultyTargetHash" because we used to have 2 moving variables:
-		// 		1. Input difficulty
-		// 		2. Relays EMA
-		// However, since the "TargetNumRelays" remained constant, the following case would keep scaling down the difficulty:
-		// 		- newRelaysEma = 100 -> scaled by 10 / 100 -> scaled down by 0.1
-		// 		- newRelaysEma = 50 -> scaled by 10 / 50 -> scaled down by 0.2
-		// 		- newRelaysEma = 20 -> scaled by 10 / 20 -> scaled down by 0.5
-		// We kept scaling down even though numRelaysEma was decreasing.
-		// To avoid continuing to increase the difficulty (i.e. scaling down), the
-		// relative starting difficulty has to be kept constant.
-		difficultyHash := protocol.ComputeNewDifficultyTargetHash(protocol.BaseRelayDifficultyHashBz, targetNumRelays, newRelaysEma)
+		// Compute the updated EMA of the number of relays and the resulting difficulty.
+		newRelaysEma, difficultyHash := difficultyStrategy.NextRelayMiningDifficulty(
+			prevDifficulty,
+			minedTargetHash,
+			numRelays,
+			targetNumRelays,
+		)
 
 		// Initialize history if empty (first update for this service since upgrade)
 		existingHistory := k.GetRelayMiningDifficultyHistoryForService(ctx, serviceId)
@@ -149,21 +136,16 @@ func (k Keeper) UpdateRelayMiningDifficulty(
 	return difficultyPerServiceMap, nil
 }
 
-// computeEma computes the EMA at time t, given the EMA at time t-1, the raw
-// data revealed at time t, and the smoothing factor α.
-// Src: https://en.wikipedia.org/wiki/Exponential_smoothing
-//
-// TODO_MAINNET: Use a language agnostic float implementation or arithmetic library
-// to ensure deterministic results across different language implementations of the
-// protocol.
-func computeEma(alpha *big.Float, prevEma, currValue uint64) uint64 {
-	oneMinusAlpha := new(big.Float).Sub(new(big.Float).SetInt64(1), alpha)
-	prevEmaFloat := new(big.Float).SetUint64(prevEma)
-
-	weightedCurrentContribution := new(big.Float).Mul(alpha, new(big.Float).SetUint64(currValue))
-	weightedPreviousContribution := new(big.Float).Mul(oneMinusAlpha, prevEmaFloat)
-	newEma, _ := new(big.Float).Add(weightedCurrentContribution, weightedPreviousContribution).Uint64()
-	return newEma
+// getServiceTargetNumRelays returns the target number of relays the relay mining
+// difficulty of the given service is adjusted to: the target set by the service
+// owner, clamped within the governance bounds, or the target_num_relays param.
+func (k Keeper) getServiceTargetNumRelays(ctx context.Context, params types.Params, serviceId string) uint64 {
+	service, found := k.GetService(ctx, serviceId)
+	if !found {
+		return params.TargetNumRelays
+	}
+
+	return params.GetServiceTargetNumRelays(service.TargetNumRelays)
 }
 
 // NewDefaultRelayMiningDifficulty is a helper that creates a new RelayMiningDifficulty
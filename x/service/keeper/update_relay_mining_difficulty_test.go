@@ -11,6 +11,7 @@ import (
 	testutilevents "github.com/pokt-network/poktroll/testutil/events"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
 func TestComputeNewDifficultyHash_MonotonicallyIncreasingRelays(t *testing.T) {
@@ -238,3 +239,49 @@ func makeBytesFullOfOnes(length int) []byte {
 func defaultDifficulty() []byte {
 	return makeBytesFullOfOnes(32)
 }
+
+func TestUpdateRelayMiningDifficulty_ServiceTargetNumRelays(t *testing.T) {
+	keeper, ctx := keepertest.ServiceKeeper(t)
+	params := keeper.GetParams(ctx)
+
+	// svc1 targets 10 times more relays than the target_num_relays param, svc2 uses the param.
+	serviceTargetNumRelays := params.TargetNumRelays * 10
+	keeper.SetService(ctx, sharedtypes.Service{Id: "svc1", TargetNumRelays: serviceTargetNumRelays})
+	keeper.SetService(ctx, sharedtypes.Service{Id: "svc2"})
+
+	relaysPerServiceMap := map[string]uint64{
+		"svc1": serviceTargetNumRelays,
+		"svc2": serviceTargetNumRelays,
+	}
+	_, err := keeper.UpdateRelayMiningDifficulty(ctx, relaysPerServiceMap)
+	require.NoError(t, err)
+
+	// The relays claimed for svc1 are on its target: its difficulty is the base difficulty.
+	svc1Difficulty, found := keeper.GetRelayMiningDifficulty(ctx, "svc1")
+	require.True(t, found)
+	require.Equal(t, protocol.BaseRelayDifficultyHashBz, svc1Difficulty.TargetHash)
+
+	// The relays claimed for svc2 are 10 times its target: its difficulty increased.
+	svc2Difficulty, found := keeper.GetRelayMiningDifficulty(ctx, "svc2")
+	require.True(t, found)
+	require.Equal(t,
+		protocol.ComputeNewDifficultyTargetHash(protocol.BaseRelayDifficultyHashBz, params.TargetNumRelays, serviceTargetNumRelays),
+		svc2Difficulty.TargetHash,
+	)
+	require.Negative(t, bytes.Compare(svc2Difficulty.TargetHash, svc1Difficulty.TargetHash))
+}
+
+func TestUpdateRelayMiningDifficulty_UnsupportedStrategy(t *testing.T) {
+	keeper, ctx := keepertest.ServiceKeeper(t)
+
+	// Simulate a strategy unsupported by this version of the protocol.
+	params := keeper.GetParams(ctx)
+	params.RelayMiningDifficultyStrategy = "pid"
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	_, err := keeper.UpdateRelayMiningDifficulty(ctx, map[string]uint64{"svc1": 1})
+	require.ErrorIs(t, err, servicetypes.ErrServiceParamInvalid)
+
+	_, found := keeper.GetRelayMiningDifficulty(ctx, "svc1")
+	require.False(t, found)
+}
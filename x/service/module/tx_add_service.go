@@ -40,7 +40,13 @@ Services are uniquely identified by their ID and can optionally carry a service
 self-describing JSON document (limited to 256 KiB). See docs/pocket_service_card.md.
 
 The service ID MUST be unique but the service name doesn't have to be.
-Only the service owner can update an existing service.`,
+Only the service owner can update an existing service.
+
+The service owner can override the target number of relays per session the relay mining
+difficulty of the service is adjusted to, within the bounds set by governance
+(min_service_target_num_relays and max_service_target_num_relays service params).
+Updating a service without --target-num-relays keeps its target; use --reset-target-num-relays
+to reset it to the target_num_relays param.`,
 		Example: `  # Add a basic service without a card
   pocketd tx service add-service "svc1" "My Service" 10 --from owner
 
@@ -54,7 +60,15 @@ Only the service owner can update an existing service.`,
 
   # Update an existing service's compute units and card
   pocketd tx service add-service "svc1" "My Service" 20 \
-    --card-file ./card-v2.json --from owner`,
+    --card-file ./card-v2.json --from owner
+
+  # Add a service whose relay mining difficulty targets 50K relays per session
+  pocketd tx service add-service "svc1" "My Service" 10 \
+    --target-num-relays 50000 --from owner
+
+  # Reset the target of an existing service to the target_num_relays param
+  pocketd tx service add-service "svc1" "My Service" 10 \
+    --reset-target-num-relays --from owner`,
 		Args: cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// Args are already validated by cobra, so anything failing below (a malformed
@@ -102,6 +116,17 @@ Only the service owner can update an existing service.`,
 			// Attach metadata to the service if provided
 			msg.Service.Metadata = metadata
 
+			// Attach the target number of relays override, 0 if not provided
+			msg.Service.TargetNumRelays, err = cmd.Flags().GetUint64(FlagTargetNumRelays)
+			if err != nil {
+				return err
+			}
+
+			msg.ResetTargetNumRelays, err = cmd.Flags().GetBool(FlagResetTargetNumRelays)
+			if err != nil {
+				return err
+			}
+
 			// Validate the message before broadcasting
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -126,6 +151,19 @@ Only the service owner can update an existing service.`,
 			"Limited to 256 KiB. Mutually exclusive with --card-base64.",
 	)
 
+	cmd.Flags().Uint64(
+		FlagTargetNumRelays,
+		0,
+		"Target number of relays per session the relay mining difficulty of the service is adjusted to. "+
+			"Must be within the governance bounds; 0 keeps the target of an existing service.",
+	)
+	cmd.Flags().Bool(
+		FlagResetTargetNumRelays,
+		false,
+		"Reset the target number of relays of an existing service to the target_num_relays service param. "+
+			"Mutually exclusive with --target-num-relays.",
+	)
+
 	cmd.Flags().Bool(
 		FlagSkipCardValidation,
 		false,
@@ -152,6 +190,12 @@ const (
 	// FlagCardFile is the flag name for providing a file path containing a service card.
 	FlagCardFile = "card-file"
 
+	// FlagTargetNumRelays is the flag name for overriding the target number of relays of a service.
+	FlagTargetNumRelays = "target-num-relays"
+
+	// FlagResetTargetNumRelays is the flag name for resetting the target number of relays of a service.
+	FlagResetTargetNumRelays = "reset-target-num-relays"
+
 	// FlagExperimentalMetadataBase64 is the deprecated alias for FlagCardBase64.
 	FlagExperimentalMetadataBase64 = "experimental-metadata-base64"
 
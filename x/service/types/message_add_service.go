@@ -37,5 +37,13 @@ func (msg *MsgAddService) ValidateBasic() error {
 	if err := msg.Service.ValidateBasic(); err != nil {
 		return err
 	}
+
+	// A zero target keeps the existing one, so resetting it along with a new target is ambiguous.
+	if msg.ResetTargetNumRelays && msg.Service.TargetNumRelays != 0 {
+		return ErrServiceInvalidTargetNumRelays.Wrapf(
+			"target number of relays (%d) MUST NOT be set when resetting it",
+			msg.Service.TargetNumRelays,
+		)
+	}
 	return nil
 }
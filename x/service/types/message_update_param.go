@@ -16,6 +16,8 @@ func NewMsgUpdateParam(authority string, name string, asType any) (*MsgUpdatePar
 		asTypeIface = &MsgUpdateParam_AsCoin{AsCoin: t}
 	case uint64:
 		asTypeIface = &MsgUpdateParam_AsUint64{AsUint64: t}
+	case string:
+		asTypeIface = &MsgUpdateParam_AsString{AsString: t}
 	default:
 		return nil, ErrServiceParamInvalid.Wrapf("unexpected param value type: %T", asType)
 	}
@@ -50,6 +52,36 @@ func (msg *MsgUpdateParam) ValidateBasic() error {
 		return ValidateAddServiceFee(msg.GetAsCoin())
 	case ParamTargetNumRelays:
 		return ValidateTargetNumRelays(msg.GetAsUint64())
+	case ParamRelayMiningDifficultyStrategy:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsString](msg); err != nil {
+			return err
+		}
+		return ValidateRelayMiningDifficultyStrategy(msg.GetAsString())
+	case ParamRelayMiningDifficultyEmaAlphaBps:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateRelayMiningDifficultyEmaAlphaBps(msg.GetAsUint64())
+	case ParamRelayMiningDifficultyDeadbandBps:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateRelayMiningDifficultyDeadbandBps(msg.GetAsUint64())
+	case ParamRelayMiningDifficultyMaxStepBps:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateRelayMiningDifficultyMaxStepBps(msg.GetAsUint64())
+	case ParamMinServiceTargetNumRelays:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateMinServiceTargetNumRelays(msg.GetAsUint64())
+	case ParamMaxServiceTargetNumRelays:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateMaxServiceTargetNumRelays(msg.GetAsUint64())
 	default:
 		return ErrServiceParamInvalid.Wrapf("unsupported param %q", msg.Name)
 	}
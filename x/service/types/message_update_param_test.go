@@ -35,6 +35,32 @@ func TestMsgUpdateParam_ValidateBasic(t *testing.T) {
 			},
 			expectedErr: ErrServiceParamInvalid,
 		},
+		{
+			desc: "invalid: relay mining difficulty strategy type incorrect",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamRelayMiningDifficultyStrategy,
+				AsType:    &MsgUpdateParam_AsUint64{AsUint64: 1},
+			},
+			expectedErr: ErrServiceParamInvalid,
+		},
+		{
+			desc: "invalid: relay mining difficulty strategy unsupported",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamRelayMiningDifficultyStrategy,
+				AsType:    &MsgUpdateParam_AsString{AsString: "pid"},
+			},
+			expectedErr: ErrServiceParamInvalid,
+		},
+		{
+			desc: "valid: relay mining difficulty strategy",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamRelayMiningDifficultyStrategy,
+				AsType:    &MsgUpdateParam_AsString{AsString: RelayMiningDifficultyStrategyBoundedEma},
+			},
+		},
 		{
 			name: "valid address",
 			desc: "valid: correct address, param name, and type",
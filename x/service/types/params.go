@@ -23,6 +23,31 @@ var (
 	KeyTargetNumRelays     = []byte("TargetNumRelays")
 	ParamTargetNumRelays   = "target_num_relays"
 	DefaultTargetNumRelays = uint64(10e4)
+
+	KeyRelayMiningDifficultyStrategy     = []byte("RelayMiningDifficultyStrategy")
+	ParamRelayMiningDifficultyStrategy   = "relay_mining_difficulty_strategy"
+	DefaultRelayMiningDifficultyStrategy = RelayMiningDifficultyStrategyEma
+
+	// DefaultRelayMiningDifficultyEmaAlphaBps is the historical, hard-coded alpha of 0.1.
+	KeyRelayMiningDifficultyEmaAlphaBps     = []byte("RelayMiningDifficultyEmaAlphaBps")
+	ParamRelayMiningDifficultyEmaAlphaBps   = "relay_mining_difficulty_ema_alpha_bps"
+	DefaultRelayMiningDifficultyEmaAlphaBps = uint64(1000)
+
+	KeyRelayMiningDifficultyDeadbandBps     = []byte("RelayMiningDifficultyDeadbandBps")
+	ParamRelayMiningDifficultyDeadbandBps   = "relay_mining_difficulty_deadband_bps"
+	DefaultRelayMiningDifficultyDeadbandBps = uint64(500)
+
+	KeyRelayMiningDifficultyMaxStepBps     = []byte("RelayMiningDifficultyMaxStepBps")
+	ParamRelayMiningDifficultyMaxStepBps   = "relay_mining_difficulty_max_step_bps"
+	DefaultRelayMiningDifficultyMaxStepBps = uint64(10000)
+
+	KeyMinServiceTargetNumRelays     = []byte("MinServiceTargetNumRelays")
+	ParamMinServiceTargetNumRelays   = "min_service_target_num_relays"
+	DefaultMinServiceTargetNumRelays = uint64(10e2)
+
+	KeyMaxServiceTargetNumRelays     = []byte("MaxServiceTargetNumRelays")
+	ParamMaxServiceTargetNumRelays   = "max_service_target_num_relays"
+	DefaultMaxServiceTargetNumRelays = uint64(10e6)
 )
 
 // ParamKeyTable the param key table for launch module
@@ -34,10 +59,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	addServiceFee *cosmostypes.Coin,
 	targetNumRelays uint64,
+	relayMiningDifficultyStrategy string,
+	relayMiningDifficultyEmaAlphaBps uint64,
+	relayMiningDifficultyDeadbandBps uint64,
+	relayMiningDifficultyMaxStepBps uint64,
+	minServiceTargetNumRelays uint64,
+	maxServiceTargetNumRelays uint64,
 ) Params {
 	return Params{
-		AddServiceFee:   addServiceFee,
-		TargetNumRelays: targetNumRelays,
+		AddServiceFee:                    addServiceFee,
+		TargetNumRelays:                  targetNumRelays,
+		RelayMiningDifficultyStrategy:    relayMiningDifficultyStrategy,
+		RelayMiningDifficultyEmaAlphaBps: relayMiningDifficultyEmaAlphaBps,
+		RelayMiningDifficultyDeadbandBps: relayMiningDifficultyDeadbandBps,
+		RelayMiningDifficultyMaxStepBps:  relayMiningDifficultyMaxStepBps,
+		MinServiceTargetNumRelays:        minServiceTargetNumRelays,
+		MaxServiceTargetNumRelays:        maxServiceTargetNumRelays,
 	}
 }
 
@@ -46,6 +83,12 @@ func DefaultParams() Params {
 	return NewParams(
 		&MinAddServiceFee,
 		DefaultTargetNumRelays,
+		DefaultRelayMiningDifficultyStrategy,
+		DefaultRelayMiningDifficultyEmaAlphaBps,
+		DefaultRelayMiningDifficultyDeadbandBps,
+		DefaultRelayMiningDifficultyMaxStepBps,
+		DefaultMinServiceTargetNumRelays,
+		DefaultMaxServiceTargetNumRelays,
 	)
 }
 
@@ -54,6 +97,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAddServiceFee, &p.AddServiceFee, ValidateAddServiceFee),
 		paramtypes.NewParamSetPair(KeyTargetNumRelays, &p.AddServiceFee, ValidateTargetNumRelays),
+		paramtypes.NewParamSetPair(KeyRelayMiningDifficultyStrategy, &p.RelayMiningDifficultyStrategy, ValidateRelayMiningDifficultyStrategy),
+		paramtypes.NewParamSetPair(KeyRelayMiningDifficultyEmaAlphaBps, &p.RelayMiningDifficultyEmaAlphaBps, ValidateRelayMiningDifficultyEmaAlphaBps),
+		paramtypes.NewParamSetPair(KeyRelayMiningDifficultyDeadbandBps, &p.RelayMiningDifficultyDeadbandBps, ValidateRelayMiningDifficultyDeadbandBps),
+		paramtypes.NewParamSetPair(KeyRelayMiningDifficultyMaxStepBps, &p.RelayMiningDifficultyMaxStepBps, ValidateRelayMiningDifficultyMaxStepBps),
+		paramtypes.NewParamSetPair(KeyMinServiceTargetNumRelays, &p.MinServiceTargetNumRelays, ValidateMinServiceTargetNumRelays),
+		paramtypes.NewParamSetPair(KeyMaxServiceTargetNumRelays, &p.MaxServiceTargetNumRelays, ValidateMaxServiceTargetNumRelays),
 	}
 }
 
@@ -67,9 +116,76 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateRelayMiningDifficultyStrategy(p.RelayMiningDifficultyStrategy); err != nil {
+		return err
+	}
+
+	if err := ValidateRelayMiningDifficultyEmaAlphaBps(p.RelayMiningDifficultyEmaAlphaBps); err != nil {
+		return err
+	}
+
+	if err := ValidateRelayMiningDifficultyDeadbandBps(p.RelayMiningDifficultyDeadbandBps); err != nil {
+		return err
+	}
+
+	if err := ValidateRelayMiningDifficultyMaxStepBps(p.RelayMiningDifficultyMaxStepBps); err != nil {
+		return err
+	}
+
+	if err := ValidateMinServiceTargetNumRelays(p.MinServiceTargetNumRelays); err != nil {
+		return err
+	}
+
+	if err := ValidateMaxServiceTargetNumRelays(p.MaxServiceTargetNumRelays); err != nil {
+		return err
+	}
+
+	if p.MinServiceTargetNumRelays > p.MaxServiceTargetNumRelays {
+		return ErrServiceParamInvalid.Wrapf(
+			"min_service_target_num_relays (%d) must not be greater than max_service_target_num_relays (%d)",
+			p.MinServiceTargetNumRelays, p.MaxServiceTargetNumRelays,
+		)
+	}
+
+	return nil
+}
+
+// ValidateServiceTargetNumRelays validates the target number of relays a service
+// owner sets for their service against the governance bounds.
+// A zero target is valid: the service then uses the target_num_relays param.
+func (p Params) ValidateServiceTargetNumRelays(serviceTargetNumRelays uint64) error {
+	if serviceTargetNumRelays == 0 {
+		return nil
+	}
+
+	if serviceTargetNumRelays < p.MinServiceTargetNumRelays || serviceTargetNumRelays > p.MaxServiceTargetNumRelays {
+		return ErrServiceInvalidTargetNumRelays.Wrapf(
+			"service target_num_relays must be within [%d, %d]: got %d",
+			p.MinServiceTargetNumRelays, p.MaxServiceTargetNumRelays, serviceTargetNumRelays,
+		)
+	}
+
 	return nil
 }
 
+// GetServiceTargetNumRelays returns the target number of relays the relay mining
+// difficulty of a service is adjusted to, given the target set by its owner:
+//   - 0 falls back to the target_num_relays param
+//   - Any other target is clamped within the governance bounds, which may have
+//     changed since the owner set it.
+func (p Params) GetServiceTargetNumRelays(serviceTargetNumRelays uint64) uint64 {
+	switch {
+	case serviceTargetNumRelays == 0:
+		return p.TargetNumRelays
+	case serviceTargetNumRelays < p.MinServiceTargetNumRelays:
+		return p.MinServiceTargetNumRelays
+	case serviceTargetNumRelays > p.MaxServiceTargetNumRelays:
+		return p.MaxServiceTargetNumRelays
+	default:
+		return serviceTargetNumRelays
+	}
+}
+
 // ValidateAddServiceFee validates the AddServiceFee param
 func ValidateAddServiceFee(addServiceFeeAny any) error {
 	addServiceFee, ok := addServiceFeeAny.(*cosmostypes.Coin)
@@ -110,3 +226,92 @@ func ValidateTargetNumRelays(targetNumRelaysAny any) error {
 
 	return nil
 }
+
+// ValidateRelayMiningDifficultyStrategy validates the RelayMiningDifficultyStrategy param
+func ValidateRelayMiningDifficultyStrategy(strategyAny any) error {
+	strategy, ok := strategyAny.(string)
+	if !ok {
+		return ErrServiceParamInvalid.Wrapf("invalid parameter type: %T", strategyAny)
+	}
+
+	if _, ok := relayMiningDifficultyStrategies[strategy]; !ok {
+		return ErrServiceParamInvalid.Wrapf(
+			"unsupported relay_mining_difficulty_strategy %q; expected one of %v",
+			strategy, GetRelayMiningDifficultyStrategyNames(),
+		)
+	}
+
+	return nil
+}
+
+// ValidateRelayMiningDifficultyEmaAlphaBps validates the RelayMiningDifficultyEmaAlphaBps param
+func ValidateRelayMiningDifficultyEmaAlphaBps(alphaBpsAny any) error {
+	alphaBps, ok := alphaBpsAny.(uint64)
+	if !ok {
+		return ErrServiceParamInvalid.Wrapf("invalid parameter type: %T", alphaBpsAny)
+	}
+
+	if alphaBps < 1 || alphaBps > BasisPointsTotal {
+		return ErrServiceParamInvalid.Wrapf(
+			"relay_mining_difficulty_ema_alpha_bps must be within [1, %d]: got %d",
+			BasisPointsTotal, alphaBps,
+		)
+	}
+
+	return nil
+}
+
+// ValidateRelayMiningDifficultyDeadbandBps validates the RelayMiningDifficultyDeadbandBps param
+func ValidateRelayMiningDifficultyDeadbandBps(deadbandBpsAny any) error {
+	deadbandBps, ok := deadbandBpsAny.(uint64)
+	if !ok {
+		return ErrServiceParamInvalid.Wrapf("invalid parameter type: %T", deadbandBpsAny)
+	}
+
+	if deadbandBps >= BasisPointsTotal {
+		return ErrServiceParamInvalid.Wrapf(
+			"relay_mining_difficulty_deadband_bps must be less than %d: got %d",
+			BasisPointsTotal, deadbandBps,
+		)
+	}
+
+	return nil
+}
+
+// ValidateRelayMiningDifficultyMaxStepBps validates the RelayMiningDifficultyMaxStepBps param
+func ValidateRelayMiningDifficultyMaxStepBps(maxStepBpsAny any) error {
+	if _, ok := maxStepBpsAny.(uint64); !ok {
+		return ErrServiceParamInvalid.Wrapf("invalid parameter type: %T", maxStepBpsAny)
+	}
+
+	// Any step is valid: 0 means unbounded.
+	return nil
+}
+
+// ValidateMinServiceTargetNumRelays validates the MinServiceTargetNumRelays param
+func ValidateMinServiceTargetNumRelays(minServiceTargetNumRelaysAny any) error {
+	minServiceTargetNumRelays, ok := minServiceTargetNumRelaysAny.(uint64)
+	if !ok {
+		return ErrServiceParamInvalid.Wrapf("invalid parameter type: %T", minServiceTargetNumRelaysAny)
+	}
+
+	if minServiceTargetNumRelays < 1 {
+		return ErrServiceParamInvalid.Wrapf("min_service_target_num_relays must be greater than 0: got %d", minServiceTargetNumRelays)
+	}
+
+	return nil
+}
+
+// ValidateMaxServiceTargetNumRelays validates the MaxServiceTargetNumRelays param
+func ValidateMaxServiceTargetNumRelays(maxServiceTargetNumRelaysAny any) error {
+	maxServiceTargetNumRelays, ok := maxServiceTargetNumRelaysAny.(uint64)
+	if !ok {
+		return ErrServiceParamInvalid.Wrapf("invalid parameter type: %T", maxServiceTargetNumRelaysAny)
+	}
+
+	if maxServiceTargetNumRelays < 1 {
+		return ErrServiceParamInvalid.Wrapf("max_service_target_num_relays must be greater than 0: got %d", maxServiceTargetNumRelays)
+	}
+
+	return nil
+}
@@ -34,6 +34,31 @@ type Params struct {
 	// target_num_relays is the target for the EMA of the number of relays per session.
 	// Per service, onchain relay mining difficulty will be adjusted to maintain this target.
 	TargetNumRelays uint64 `protobuf:"varint,2,opt,name=target_num_relays,json=targetNumRelays,proto3" json:"target_num_relays" yaml:"target_num_relays"`
+	// relay_mining_difficulty_strategy is the name of the strategy computing the
+	// relay mining difficulty of each service at the end of every session:
+	//   - "ema": scales the base difficulty by the ratio of target_num_relays to the EMA of the claimed relays.
+	//   - "bounded_ema": tracks the EMA of the estimated relays served, ignores deviations within
+	//     the deadband and bounds the change of the difficulty per session.
+	RelayMiningDifficultyStrategy string `protobuf:"bytes,3,opt,name=relay_mining_difficulty_strategy,json=relayMiningDifficultyStrategy,proto3" json:"relay_mining_difficulty_strategy" yaml:"relay_mining_difficulty_strategy"`
+	// relay_mining_difficulty_ema_alpha_bps is the smoothing factor (i.e. alpha) of the
+	// EMA of the number of relays, in basis points (1/10000).
+	// Large alpha -> more weight on recent data; less smoothing and fast response.
+	// Small alpha -> more weight on past data; more smoothing and slow response.
+	RelayMiningDifficultyEmaAlphaBps uint64 `protobuf:"varint,4,opt,name=relay_mining_difficulty_ema_alpha_bps,json=relayMiningDifficultyEmaAlphaBps,proto3" json:"relay_mining_difficulty_ema_alpha_bps" yaml:"relay_mining_difficulty_ema_alpha_bps"`
+	// relay_mining_difficulty_deadband_bps is the deviation from the target number of relays,
+	// in basis points of the target, within which the difficulty is kept unchanged.
+	// Only used by the "bounded_ema" strategy.
+	RelayMiningDifficultyDeadbandBps uint64 `protobuf:"varint,5,opt,name=relay_mining_difficulty_deadband_bps,json=relayMiningDifficultyDeadbandBps,proto3" json:"relay_mining_difficulty_deadband_bps" yaml:"relay_mining_difficulty_deadband_bps"`
+	// relay_mining_difficulty_max_step_bps is the maximum change of the difficulty target hash
+	// per session, in basis points of the previous target hash. 0 means unbounded.
+	// Only used by the "bounded_ema" strategy.
+	RelayMiningDifficultyMaxStepBps uint64 `protobuf:"varint,6,opt,name=relay_mining_difficulty_max_step_bps,json=relayMiningDifficultyMaxStepBps,proto3" json:"relay_mining_difficulty_max_step_bps" yaml:"relay_mining_difficulty_max_step_bps"`
+	// min_service_target_num_relays is the lowest target number of relays a service
+	// owner can set for their service, overriding target_num_relays.
+	MinServiceTargetNumRelays uint64 `protobuf:"varint,7,opt,name=min_service_target_num_relays,json=minServiceTargetNumRelays,proto3" json:"min_service_target_num_relays" yaml:"min_service_target_num_relays"`
+	// max_service_target_num_relays is the highest target number of relays a service
+	// owner can set for their service, overriding target_num_relays.
+	MaxServiceTargetNumRelays uint64 `protobuf:"varint,8,opt,name=max_service_target_num_relays,json=maxServiceTargetNumRelays,proto3" json:"max_service_target_num_relays" yaml:"max_service_target_num_relays"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +104,48 @@ func (m *Params) GetTargetNumRelays() uint64 {
 	return 0
 }
 
+func (m *Params) GetRelayMiningDifficultyStrategy() string {
+	if m != nil {
+		return m.RelayMiningDifficultyStrategy
+	}
+	return ""
+}
+
+func (m *Params) GetRelayMiningDifficultyEmaAlphaBps() uint64 {
+	if m != nil {
+		return m.RelayMiningDifficultyEmaAlphaBps
+	}
+	return 0
+}
+
+func (m *Params) GetRelayMiningDifficultyDeadbandBps() uint64 {
+	if m != nil {
+		return m.RelayMiningDifficultyDeadbandBps
+	}
+	return 0
+}
+
+func (m *Params) GetRelayMiningDifficultyMaxStepBps() uint64 {
+	if m != nil {
+		return m.RelayMiningDifficultyMaxStepBps
+	}
+	return 0
+}
+
+func (m *Params) GetMinServiceTargetNumRelays() uint64 {
+	if m != nil {
+		return m.MinServiceTargetNumRelays
+	}
+	return 0
+}
+
+func (m *Params) GetMaxServiceTargetNumRelays() uint64 {
+	if m != nil {
+		return m.MaxServiceTargetNumRelays
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "pocket.service.Params")
 }
@@ -86,28 +153,44 @@ func init() {
 func init() { proto.RegisterFile("pocket/service/params.proto", fileDescriptor_bb052db7e9dc89f5) }
 
 var fileDescriptor_bb052db7e9dc89f5 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0xc8, 0x4f, 0xce,
-	0x4e, 0x2d, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x48, 0xea, 0x41, 0x25, 0xa5, 0x04,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e,
-	0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xe5, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12,
-	0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33, 0xf3, 0x20,
-	0xf2, 0x4a, 0xbf, 0x19, 0xb9, 0xd8, 0x02, 0xc0, 0x36, 0x09, 0x15, 0x70, 0xf1, 0x27, 0xa6, 0xa4,
-	0xc4, 0x43, 0xad, 0x88, 0x4f, 0x4b, 0x4d, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4,
-	0x83, 0x18, 0xa2, 0x07, 0x32, 0x44, 0x0f, 0x6a, 0x88, 0x9e, 0x73, 0x7e, 0x66, 0x9e, 0x93, 0xee,
-	0xab, 0x7b, 0xf2, 0xe8, 0xba, 0x3e, 0xdd, 0x93, 0x17, 0xab, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x42,
-	0x93, 0x50, 0x0a, 0xe2, 0x4d, 0x4c, 0x49, 0x09, 0x86, 0x08, 0xb8, 0xa5, 0xa6, 0x0a, 0xc5, 0x72,
-	0x09, 0x96, 0x24, 0x16, 0xa5, 0xa7, 0x96, 0xc4, 0xe7, 0x95, 0xe6, 0xc6, 0x17, 0xa5, 0xe6, 0x24,
-	0x56, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0x19, 0xbe, 0xba, 0x27, 0x8f, 0x29, 0xf9,
-	0xe9, 0x9e, 0xbc, 0x04, 0xc4, 0x68, 0x0c, 0x29, 0xa5, 0x20, 0x7e, 0x88, 0x98, 0x5f, 0x69, 0x6e,
-	0x10, 0x58, 0xc4, 0x4a, 0xe1, 0xc5, 0x02, 0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0xc4, 0xa1, 0x41,
-	0x5b, 0x01, 0x0f, 0x5c, 0x88, 0x97, 0x9d, 0xfc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc6, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x83, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0xfd, 0x82, 0xfc, 0xec, 0x12, 0xdd, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x30,
-	0xa7, 0x28, 0x3f, 0x27, 0x07, 0xc9, 0xc0, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xa0,
-	0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xc9, 0x3c, 0xd8, 0xcc, 0x01, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0xd4, 0x4e,
+	0x18, 0xc7, 0x3b, 0xbf, 0x5f, 0xad, 0x36, 0xa2, 0xa5, 0x41, 0x74, 0x5b, 0x69, 0x26, 0x84, 0x8a,
+	0x45, 0x6d, 0xe2, 0xea, 0xad, 0x37, 0xd7, 0x2a, 0x78, 0x68, 0x95, 0xac, 0x20, 0x0a, 0x12, 0x26,
+	0xc9, 0x6c, 0x1a, 0x36, 0x93, 0x09, 0xc9, 0x6c, 0xcd, 0xbe, 0x05, 0xbd, 0x78, 0xf4, 0xe8, 0x49,
+	0xc1, 0x93, 0xf8, 0x2a, 0x3c, 0xf6, 0xd8, 0xd3, 0x20, 0xbb, 0x07, 0x25, 0xc7, 0xbc, 0x02, 0xc9,
+	0x24, 0x2c, 0xeb, 0xfe, 0xc9, 0xee, 0x65, 0x99, 0x7d, 0xbe, 0x9f, 0x79, 0xe6, 0xb3, 0x0b, 0xcf,
+	0x23, 0xdd, 0x8c, 0xa8, 0xd3, 0xc5, 0xcc, 0x48, 0x70, 0x7c, 0xea, 0x3b, 0xd8, 0x88, 0x50, 0x8c,
+	0x48, 0xa2, 0x47, 0x31, 0x65, 0x54, 0xbe, 0x5a, 0x86, 0x7a, 0x15, 0x6e, 0x6f, 0x22, 0xe2, 0x87,
+	0xd4, 0x10, 0x9f, 0x25, 0xb2, 0x7d, 0xcd, 0xa3, 0x1e, 0x15, 0x47, 0xa3, 0x38, 0x55, 0x55, 0xc5,
+	0xa1, 0x09, 0xa1, 0x89, 0x61, 0xa3, 0x04, 0x1b, 0xa7, 0x4d, 0x1b, 0x33, 0xd4, 0x34, 0x1c, 0xea,
+	0x87, 0x65, 0xae, 0xfd, 0x58, 0x97, 0xd6, 0x5e, 0x88, 0x97, 0xe4, 0x48, 0xda, 0x40, 0xae, 0x6b,
+	0x55, 0x4f, 0x58, 0x1d, 0x8c, 0x1b, 0x40, 0x05, 0x7b, 0x97, 0x1f, 0x6c, 0xe9, 0x65, 0x13, 0xbd,
+	0x68, 0xa2, 0x57, 0x4d, 0xf4, 0xc7, 0xd4, 0x0f, 0x5b, 0xfb, 0x19, 0x87, 0x93, 0xb7, 0x72, 0x0e,
+	0xaf, 0xf7, 0x11, 0x09, 0x0e, 0xb4, 0x89, 0x40, 0x33, 0xaf, 0x20, 0xd7, 0x6d, 0x97, 0x85, 0xa7,
+	0x18, 0xcb, 0x6f, 0xa5, 0x4d, 0x86, 0x62, 0x0f, 0x33, 0x2b, 0xec, 0x11, 0x2b, 0xc6, 0x01, 0xea,
+	0x27, 0x8d, 0xff, 0x54, 0xb0, 0xb7, 0xda, 0x6a, 0x66, 0x1c, 0x4e, 0x87, 0x39, 0x87, 0x8d, 0xb2,
+	0xf5, 0x54, 0xa4, 0x99, 0x1b, 0x65, 0xed, 0xb8, 0x47, 0x4c, 0x51, 0x91, 0x3f, 0x01, 0x49, 0x15,
+	0xa1, 0x45, 0xfc, 0xd0, 0x0f, 0x3d, 0xcb, 0xf5, 0x3b, 0x1d, 0xdf, 0xe9, 0x05, 0xac, 0x6f, 0x25,
+	0x2c, 0x46, 0x0c, 0x7b, 0xfd, 0xc6, 0xff, 0x2a, 0xd8, 0x5b, 0x6f, 0x3d, 0xcf, 0x38, 0x5c, 0xc8,
+	0xe6, 0x1c, 0xde, 0x2e, 0x5f, 0x5f, 0x44, 0x6a, 0xe6, 0x8e, 0x40, 0x8e, 0x04, 0x71, 0x38, 0x02,
+	0xda, 0x55, 0x2e, 0x7f, 0x03, 0xd2, 0xad, 0x79, 0x4d, 0x30, 0x41, 0x16, 0x0a, 0xa2, 0x13, 0x64,
+	0xd9, 0x51, 0xd2, 0x58, 0x15, 0x7f, 0xc7, 0xeb, 0x8c, 0xc3, 0xe5, 0x2e, 0xe4, 0x1c, 0xde, 0xab,
+	0x97, 0xfc, 0x07, 0xd7, 0x4c, 0x75, 0xa6, 0xe9, 0x13, 0x82, 0x1e, 0x15, 0x4c, 0x2b, 0x4a, 0xe4,
+	0xaf, 0x40, 0xda, 0x9d, 0xd7, 0xcc, 0xc5, 0xc8, 0xb5, 0x51, 0xe8, 0x0a, 0xd7, 0x0b, 0xc2, 0xf5,
+	0x55, 0xc6, 0xe1, 0x52, 0x7c, 0xce, 0xe1, 0xdd, 0x7a, 0xd5, 0x71, 0x7a, 0x9e, 0xe9, 0x61, 0xc5,
+	0x14, 0xa6, 0x5f, 0x6a, 0x4c, 0x09, 0x4a, 0xad, 0x84, 0xe1, 0x48, 0x98, 0xae, 0x2d, 0x36, 0x1d,
+	0xe7, 0x17, 0x9b, 0x8e, 0xd3, 0x9a, 0x09, 0x67, 0x9a, 0x1e, 0xa1, 0xb4, 0xcd, 0x70, 0x54, 0x88,
+	0x7e, 0x00, 0xd2, 0x0e, 0xf1, 0xc3, 0xd1, 0x74, 0x4c, 0x8f, 0xc1, 0x45, 0x61, 0xf8, 0x2c, 0xe3,
+	0xb0, 0x1e, 0xcc, 0x39, 0xdc, 0x2d, 0xd5, 0x6a, 0x31, 0xcd, 0xdc, 0x22, 0x7e, 0x58, 0xcd, 0xde,
+	0xcb, 0x89, 0x41, 0x11, 0x36, 0xc5, 0x0f, 0x98, 0x6b, 0x73, 0x69, 0xcc, 0x06, 0xa5, 0x4b, 0xd9,
+	0xa0, 0xb4, 0xde, 0x06, 0xa5, 0xb3, 0x6d, 0x0e, 0xd4, 0x3f, 0x9f, 0x21, 0x78, 0xff, 0xfb, 0xfb,
+	0x9d, 0x1b, 0xd5, 0x46, 0x4c, 0x47, 0x3b, 0xb1, 0xdc, 0x54, 0xad, 0xe3, 0x9f, 0x03, 0x05, 0x9c,
+	0x0d, 0x14, 0x70, 0x3e, 0x50, 0xc0, 0xaf, 0x81, 0x02, 0x3e, 0x0e, 0x95, 0x95, 0xb3, 0xa1, 0xb2,
+	0x72, 0x3e, 0x54, 0x56, 0xde, 0xdc, 0xf7, 0x7c, 0x76, 0xd2, 0xb3, 0x75, 0x87, 0x12, 0x23, 0xa2,
+	0x5d, 0xb6, 0x1f, 0x62, 0xf6, 0x8e, 0xc6, 0x5d, 0xf1, 0x25, 0xa6, 0x41, 0x30, 0xd6, 0x90, 0xf5,
+	0x23, 0x9c, 0xd8, 0x6b, 0x62, 0x17, 0x3e, 0xfc, 0x3b, 0x00, 0xf2, 0x18, 0xc1, 0xba, 0x83, 0x05,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -135,6 +218,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.TargetNumRelays != that1.TargetNumRelays {
 		return false
 	}
+	if this.RelayMiningDifficultyStrategy != that1.RelayMiningDifficultyStrategy {
+		return false
+	}
+	if this.RelayMiningDifficultyEmaAlphaBps != that1.RelayMiningDifficultyEmaAlphaBps {
+		return false
+	}
+	if this.RelayMiningDifficultyDeadbandBps != that1.RelayMiningDifficultyDeadbandBps {
+		return false
+	}
+	if this.RelayMiningDifficultyMaxStepBps != that1.RelayMiningDifficultyMaxStepBps {
+		return false
+	}
+	if this.MinServiceTargetNumRelays != that1.MinServiceTargetNumRelays {
+		return false
+	}
+	if this.MaxServiceTargetNumRelays != that1.MaxServiceTargetNumRelays {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -157,6 +258,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxServiceTargetNumRelays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxServiceTargetNumRelays))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinServiceTargetNumRelays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinServiceTargetNumRelays))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RelayMiningDifficultyMaxStepBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RelayMiningDifficultyMaxStepBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RelayMiningDifficultyDeadbandBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RelayMiningDifficultyDeadbandBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RelayMiningDifficultyEmaAlphaBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RelayMiningDifficultyEmaAlphaBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RelayMiningDifficultyStrategy) > 0 {
+		i -= len(m.RelayMiningDifficultyStrategy)
+		copy(dAtA[i:], m.RelayMiningDifficultyStrategy)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RelayMiningDifficultyStrategy)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TargetNumRelays != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.TargetNumRelays))
 		i--
@@ -201,6 +334,25 @@ func (m *Params) Size() (n int) {
 	if m.TargetNumRelays != 0 {
 		n += 1 + sovParams(uint64(m.TargetNumRelays))
 	}
+	l = len(m.RelayMiningDifficultyStrategy)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.RelayMiningDifficultyEmaAlphaBps != 0 {
+		n += 1 + sovParams(uint64(m.RelayMiningDifficultyEmaAlphaBps))
+	}
+	if m.RelayMiningDifficultyDeadbandBps != 0 {
+		n += 1 + sovParams(uint64(m.RelayMiningDifficultyDeadbandBps))
+	}
+	if m.RelayMiningDifficultyMaxStepBps != 0 {
+		n += 1 + sovParams(uint64(m.RelayMiningDifficultyMaxStepBps))
+	}
+	if m.MinServiceTargetNumRelays != 0 {
+		n += 1 + sovParams(uint64(m.MinServiceTargetNumRelays))
+	}
+	if m.MaxServiceTargetNumRelays != 0 {
+		n += 1 + sovParams(uint64(m.MaxServiceTargetNumRelays))
+	}
 	return n
 }
 
@@ -294,6 +446,133 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayMiningDifficultyStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayMiningDifficultyStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayMiningDifficultyEmaAlphaBps", wireType)
+			}
+			m.RelayMiningDifficultyEmaAlphaBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayMiningDifficultyEmaAlphaBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayMiningDifficultyDeadbandBps", wireType)
+			}
+			m.RelayMiningDifficultyDeadbandBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayMiningDifficultyDeadbandBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayMiningDifficultyMaxStepBps", wireType)
+			}
+			m.RelayMiningDifficultyMaxStepBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayMiningDifficultyMaxStepBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinServiceTargetNumRelays", wireType)
+			}
+			m.MinServiceTargetNumRelays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinServiceTargetNumRelays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxServiceTargetNumRelays", wireType)
+			}
+			m.MaxServiceTargetNumRelays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxServiceTargetNumRelays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,226 @@
+package types_test
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/crypto/protocol"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+const (
+	simNumSessions = 240
+
+	// simBaseServedRelays is the number of relays served per session outside of bursts,
+	// 20 times the default target number of relays.
+	simBaseServedRelays = 20 * types.DefaultTargetNumRelays
+
+	// Every simBurstPeriodSessions sessions, starting at simBurstOffsetSessions, the
+	// number of relays served is multiplied by simBurstFactor for simBurstNumSessions.
+	simBurstPeriodSessions = 60
+	simBurstOffsetSessions = 20
+	simBurstNumSessions    = 4
+	simBurstFactor         = 8
+
+	// simSettledWindowSessions is the number of sessions before every burst during
+	// which the number of claimed relays is expected to have settled.
+	simSettledWindowSessions = 5
+)
+
+// simulatedSession is the outcome of a simulated session.
+type simulatedSession struct {
+	servedRelays  uint64
+	claimedRelays uint64
+	targetHash    []byte
+}
+
+func TestRelayMiningDifficultySimulation_BoundedEmaConvergesUnderBurstyTraffic(t *testing.T) {
+	params := types.DefaultParams()
+	params.RelayMiningDifficultyStrategy = types.RelayMiningDifficultyStrategyBoundedEma
+	targetNumRelays := params.TargetNumRelays
+
+	// Claims are settled after the claim and proof windows: the relays of a session
+	// can update the difficulty several sessions later.
+	for _, settlementLagSessions := range []int{0, 1, 2} {
+		sessions := simulateRelayMining(t, params, newBurstyServedRelays(1), targetNumRelays, settlementLagSessions)
+
+		for sessionNumber, session := range sessions {
+			// The difficulty never gets easier than the base difficulty.
+			require.LessOrEqual(t, len(session.targetHash), len(protocol.BaseRelayDifficultyHashBz))
+
+			// The initial difficulty of a service is not bounded by the max step.
+			if sessionNumber <= settlementLagSessions+1 {
+				continue
+			}
+
+			// The difficulty moves by at most the max step per session.
+			prevTargetHash := sessions[sessionNumber-1].targetHash
+			requireTargetHashStepWithin(t, prevTargetHash, session.targetHash, params.RelayMiningDifficultyMaxStepBps)
+		}
+
+		// Right before every burst, the number of claimed relays settled around the target.
+		for _, sessionNumber := range getSettledSessionNumbers() {
+			claimedRelays := sessions[sessionNumber].claimedRelays
+			require.InEpsilonf(t, targetNumRelays, claimedRelays, 0.15,
+				"settlement lag %d: session %d claimed %d relays; expected ~%d",
+				settlementLagSessions, sessionNumber, claimedRelays, targetNumRelays,
+			)
+		}
+	}
+}
+
+func TestRelayMiningDifficultySimulation_EmaSettlesAboveTarget(t *testing.T) {
+	// The ema strategy computes the difficulty from the claimed relays, which the
+	// difficulty itself scales down: the claimed relays settle around
+	// sqrt(servedRelays * targetNumRelays) rather than around the target.
+	params := types.DefaultParams()
+	targetNumRelays := params.TargetNumRelays
+
+	sessions := simulateRelayMining(t, params, newBurstyServedRelays(1), targetNumRelays, 0)
+	for _, sessionNumber := range getSettledSessionNumbers() {
+		require.Greater(t, sessions[sessionNumber].claimedRelays, 3*targetNumRelays)
+	}
+}
+
+func TestRelayMiningDifficultySimulation_Deterministic(t *testing.T) {
+	for _, strategyName := range types.GetRelayMiningDifficultyStrategyNames() {
+		t.Run(strategyName, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.RelayMiningDifficultyStrategy = strategyName
+
+			// Independent runs over the same traffic compute the same difficulties.
+			sessions := simulateRelayMining(t, params, newBurstyServedRelays(2), params.TargetNumRelays, 2)
+			replayedSessions := simulateRelayMining(t, params, newBurstyServedRelays(2), params.TargetNumRelays, 2)
+			require.Equal(t, sessions, replayedSessions)
+		})
+	}
+}
+
+// simulateRelayMining simulates the relay mining difficulty feedback loop of a
+// single service: the relays served during a session are mined against the
+// difficulty of the session, and the claimed relays update the difficulty of the
+// next session once settled, settlementLagSessions sessions later.
+// Like the service keeper, the strategy estimates the served relays from the
+// current difficulty rather than the one the relays were mined against.
+func simulateRelayMining(
+	t *testing.T,
+	params types.Params,
+	servedRelaysPerSession []uint64,
+	targetNumRelays uint64,
+	settlementLagSessions int,
+) []simulatedSession {
+	t.Helper()
+
+	strategy, err := types.NewRelayMiningDifficultyStrategy(params)
+	require.NoError(t, err)
+
+	var (
+		difficulty      types.RelayMiningDifficulty
+		isDifficultySet bool
+		sessions        = make([]simulatedSession, 0, len(servedRelaysPerSession))
+	)
+	for sessionNumber, servedRelays := range servedRelaysPerSession {
+		targetHash := protocol.BaseRelayDifficultyHashBz
+		if isDifficultySet {
+			targetHash = difficulty.TargetHash
+		}
+
+		sessions = append(sessions, simulatedSession{
+			servedRelays:  servedRelays,
+			claimedRelays: getExpectedClaimedRelays(servedRelays, targetHash),
+			targetHash:    targetHash,
+		})
+
+		settledSessionNumber := sessionNumber - settlementLagSessions
+		if settledSessionNumber < 0 {
+			continue
+		}
+		numRelays := sessions[settledSessionNumber].claimedRelays
+
+		// Mirror the service keeper initialization of the difficulty of a new service.
+		minedTargetHash := difficulty.TargetHash
+		if !isDifficultySet {
+			minedTargetHash = protocol.BaseRelayDifficultyHashBz
+			difficulty = types.RelayMiningDifficulty{
+				NumRelaysEma: numRelays,
+				TargetHash:   protocol.ComputeNewDifficultyTargetHash(protocol.BaseRelayDifficultyHashBz, targetNumRelays, numRelays),
+			}
+			isDifficultySet = true
+		}
+
+		newRelaysEma, newTargetHash := strategy.NextRelayMiningDifficulty(difficulty, minedTargetHash, numRelays, targetNumRelays)
+		difficulty = types.RelayMiningDifficulty{
+			NumRelaysEma: newRelaysEma,
+			TargetHash:   newTargetHash,
+		}
+	}
+
+	return sessions
+}
+
+// newBurstyServedRelays returns the number of relays served per session: a ±10%
+// noise around simBaseServedRelays, with periodic bursts.
+func newBurstyServedRelays(seed uint64) []uint64 {
+	rng := rand.New(rand.NewPCG(seed, seed))
+
+	servedRelaysPerSession := make([]uint64, simNumSessions)
+	for sessionNumber := range servedRelaysPerSession {
+		servedRelays := simBaseServedRelays
+		if (sessionNumber-simBurstOffsetSessions)%simBurstPeriodSessions < simBurstNumSessions &&
+			sessionNumber >= simBurstOffsetSessions {
+			servedRelays *= simBurstFactor
+		}
+		servedRelaysPerSession[sessionNumber] = servedRelays * uint64(90+rng.IntN(21)) / 100
+	}
+
+	return servedRelaysPerSession
+}
+
+// getSettledSessionNumbers returns the numbers of the sessions right before
+// every burst but the first one.
+func getSettledSessionNumbers() []int {
+	var sessionNumbers []int
+	for burstSessionNumber := simBurstOffsetSessions + simBurstPeriodSessions; burstSessionNumber <= simNumSessions; burstSessionNumber += simBurstPeriodSessions {
+		for sessionNumber := burstSessionNumber - simSettledWindowSessions; sessionNumber < burstSessionNumber; sessionNumber++ {
+			sessionNumbers = append(sessionNumbers, sessionNumber)
+		}
+	}
+
+	return sessionNumbers
+}
+
+// getExpectedClaimedRelays returns the expected number of relays, out of the given
+// served relays, whose hash is below the given target hash.
+func getExpectedClaimedRelays(servedRelays uint64, targetHash []byte) uint64 {
+	claimedRelays := new(big.Int).SetUint64(servedRelays)
+	claimedRelays.Mul(claimedRelays, new(big.Int).SetBytes(targetHash))
+	claimedRelays.Quo(claimedRelays, new(big.Int).SetBytes(protocol.BaseRelayDifficultyHashBz))
+	return claimedRelays.Uint64()
+}
+
+// requireTargetHashStepWithin requires the new target hash to be within the max
+// step of the previous one, i.e. in [prev / (1 + maxStep), prev * (1 + maxStep)].
+func requireTargetHashStepWithin(t *testing.T, prevTargetHash, newTargetHash []byte, maxStepBps uint64) {
+	t.Helper()
+
+	prevHash := new(big.Int).SetBytes(prevTargetHash)
+	newHash := new(big.Int).SetBytes(newTargetHash)
+	stepFactorBps := new(big.Int).SetUint64(types.BasisPointsTotal + maxStepBps)
+	basisPointsTotal := big.NewInt(types.BasisPointsTotal)
+
+	// newHash * 10000 <= prevHash * (10000 + maxStepBps)
+	require.LessOrEqual(t,
+		new(big.Int).Mul(newHash, basisPointsTotal).Cmp(new(big.Int).Mul(prevHash, stepFactorBps)), 0,
+		"target hash increased from %x to %x", prevTargetHash, newTargetHash,
+	)
+	// newHash * (10000 + maxStepBps) >= prevHash * 10000 - (10000 + maxStepBps), accounting for the rounding down.
+	lowerBound := new(big.Int).Mul(prevHash, basisPointsTotal)
+	lowerBound.Sub(lowerBound, stepFactorBps)
+	require.GreaterOrEqual(t,
+		new(big.Int).Mul(newHash, stepFactorBps).Cmp(lowerBound), 0,
+		"target hash decreased from %x to %x", prevTargetHash, newTargetHash,
+	)
+}
@@ -0,0 +1,285 @@
+package types
+
+import (
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+
+	"github.com/pokt-network/poktroll/pkg/crypto/protocol"
+)
+
+const (
+	// RelayMiningDifficultyStrategyEma scales the base difficulty by the ratio of
+	// the target number of relays to the EMA of the number of claimed relays.
+	// It is the historical strategy, and ignores the deadband and max step params.
+	RelayMiningDifficultyStrategyEma = "ema"
+
+	// RelayMiningDifficultyStrategyBoundedEma scales the base difficulty by the ratio
+	// of the target number of relays to the EMA of the estimated number of relays
+	// served (i.e. the claimed relays scaled by the difficulty they were mined at).
+	// The difficulty is kept unchanged while the relays expected to be claimed are
+	// within the deadband of the target, and changes by at most the max step per session.
+	RelayMiningDifficultyStrategyBoundedEma = "bounded_ema"
+
+	// BasisPointsTotal is the number of basis points in 100%.
+	BasisPointsTotal = 10000
+)
+
+// relayMiningDifficultyStrategies maps the name of every supported strategy to
+// its constructor.
+var relayMiningDifficultyStrategies = map[string]func(Params) RelayMiningDifficultyStrategy{
+	RelayMiningDifficultyStrategyEma:        newEmaStrategy,
+	RelayMiningDifficultyStrategyBoundedEma: newBoundedEmaStrategy,
+}
+
+// RelayMiningDifficultyStrategy computes the relay mining difficulty of a service
+// for the next session, from the relays claimed for it.
+//
+// Implementations MUST be deterministic: every node computes the same difficulty
+// from the same inputs.
+type RelayMiningDifficultyStrategy interface {
+	// NextRelayMiningDifficulty returns the new EMA of the number of relays and the
+	// new target hash of a service, given:
+	//   - prevDifficulty: the current relay mining difficulty of the service
+	//   - minedTargetHash: the target hash the claimed relays were mined against
+	//   - numRelays: the number of relays claimed for the service
+	//   - targetNumRelays: the number of relays per session the difficulty targets
+	NextRelayMiningDifficulty(
+		prevDifficulty RelayMiningDifficulty,
+		minedTargetHash []byte,
+		numRelays uint64,
+		targetNumRelays uint64,
+	) (newRelaysEma uint64, newTargetHash []byte)
+}
+
+// NewRelayMiningDifficultyStrategy returns the relay mining difficulty strategy
+// selected by the given params.
+func NewRelayMiningDifficultyStrategy(params Params) (RelayMiningDifficultyStrategy, error) {
+	newStrategy, ok := relayMiningDifficultyStrategies[params.RelayMiningDifficultyStrategy]
+	if !ok {
+		return nil, ErrServiceParamInvalid.Wrapf(
+			"unsupported relay_mining_difficulty_strategy %q; expected one of %v",
+			params.RelayMiningDifficultyStrategy, GetRelayMiningDifficultyStrategyNames(),
+		)
+	}
+
+	return newStrategy(params), nil
+}
+
+// GetRelayMiningDifficultyStrategyNames returns the lexicographically sorted
+// names of the supported relay mining difficulty strategies.
+func GetRelayMiningDifficultyStrategyNames() []string {
+	return slices.Sorted(maps.Keys(relayMiningDifficultyStrategies))
+}
+
+// emaStrategy implements the RelayMiningDifficultyStrategyEma strategy.
+type emaStrategy struct {
+	// alphaBps is the EMA smoothing factor, in basis points.
+	alphaBps uint64
+}
+
+// newEmaStrategy returns the RelayMiningDifficultyStrategyEma strategy.
+func newEmaStrategy(params Params) RelayMiningDifficultyStrategy {
+	return emaStrategy{alphaBps: params.RelayMiningDifficultyEmaAlphaBps}
+}
+
+// NextRelayMiningDifficulty implements RelayMiningDifficultyStrategy.
+func (s emaStrategy) NextRelayMiningDifficulty(
+	prevDifficulty RelayMiningDifficulty,
+	_ []byte,
+	numRelays uint64,
+	targetNumRelays uint64,
+) (uint64, []byte) {
+	newRelaysEma := computeEma(s.alphaBps, prevDifficulty.NumRelaysEma, numRelays)
+
+	// CRITICAL_DEV_NOTE: We changed this code to pass in  "BaseRelayDifficultyHashBz" instead of "prevDifficulty.TargetHash"
+	// to "ComputeNewDifficultyTargetHash" because we used to have 2 moving variables:
+	// 		1. Input difficulty
+	// 		2. Relays EMA
+	// However, since the "TargetNumRelays" remained constant, the following case would keep scaling down the difficulty:
+	// 		- newRelaysEma = 100 -> scaled by 10 / 100 -> scaled down by 0.1
+	// 		- newRelaysEma = 50 -> scaled by 10 / 50 -> scaled down by 0.2
+	// 		- newRelaysEma = 20 -> scaled by 10 / 20 -> scaled down by 0.5
+	// We kept scaling down even though numRelaysEma was decreasing.
+	// To avoid continuing to increase the difficulty (i.e. scaling down), the
+	// relative starting difficulty has to be kept constant.
+	newTargetHash := protocol.ComputeNewDifficultyTargetHash(protocol.BaseRelayDifficultyHashBz, targetNumRelays, newRelaysEma)
+
+	return newRelaysEma, newTargetHash
+}
+
+// computeEma computes the EMA at time t, given the EMA at time t-1, the raw
+// data revealed at time t, and the smoothing factor α in basis points.
+// Src: https://en.wikipedia.org/wiki/Exponential_smoothing
+//
+// It uses integer arithmetic and truncates the result, as the float64 alpha
+// the strategy used to be computed with did.
+func computeEma(alphaBps, prevEma, currValue uint64) uint64 {
+	newEma := weightedSumBps(alphaBps, prevEma, currValue)
+	newEma.Quo(newEma, big.NewInt(BasisPointsTotal))
+	return saturateUint64(newEma)
+}
+
+// boundedEmaStrategy implements the RelayMiningDifficultyStrategyBoundedEma strategy.
+// It only uses integer arithmetic so that its results are exactly reproducible by
+// any implementation of the protocol.
+type boundedEmaStrategy struct {
+	alphaBps    uint64
+	deadbandBps uint64
+	maxStepBps  uint64
+}
+
+// newBoundedEmaStrategy returns the RelayMiningDifficultyStrategyBoundedEma strategy.
+func newBoundedEmaStrategy(params Params) RelayMiningDifficultyStrategy {
+	return boundedEmaStrategy{
+		alphaBps:    params.RelayMiningDifficultyEmaAlphaBps,
+		deadbandBps: params.RelayMiningDifficultyDeadbandBps,
+		maxStepBps:  params.RelayMiningDifficultyMaxStepBps,
+	}
+}
+
+// NextRelayMiningDifficulty implements RelayMiningDifficultyStrategy.
+func (s boundedEmaStrategy) NextRelayMiningDifficulty(
+	prevDifficulty RelayMiningDifficulty,
+	minedTargetHash []byte,
+	numRelays uint64,
+	targetNumRelays uint64,
+) (uint64, []byte) {
+	baseHash := new(big.Int).SetBytes(protocol.BaseRelayDifficultyHashBz)
+	prevHash := new(big.Int).SetBytes(prevDifficulty.TargetHash)
+
+	// Estimate the number of relays served from the number of relays claimed:
+	// a relay is claimed with a probability of minedTargetHash / baseHash.
+	estimatedNumRelays := new(big.Int).SetUint64(numRelays)
+	if minedHash := new(big.Int).SetBytes(minedTargetHash); minedHash.Sign() > 0 {
+		estimatedNumRelays.Mul(estimatedNumRelays, baseHash)
+		estimatedNumRelays.Quo(estimatedNumRelays, minedHash)
+	}
+	newRelaysEma := computeEmaBps(s.alphaBps, prevDifficulty.NumRelaysEma, saturateUint64(estimatedNumRelays))
+
+	target := new(big.Int).SetUint64(targetNumRelays)
+	relaysEma := new(big.Int).SetUint64(newRelaysEma)
+
+	// Keep the difficulty unchanged if the number of relays expected to be claimed
+	// at the current difficulty (i.e. relaysEma * prevHash / baseHash) is within the
+	// deadband of the target:
+	// |relaysEma * prevHash - target * baseHash| * 10000 <= deadbandBps * target * baseHash
+	targetTimesBase := new(big.Int).Mul(target, baseHash)
+	deviation := new(big.Int).Mul(relaysEma, prevHash)
+	deviation.Sub(deviation, targetTimesBase).Abs(deviation)
+	deviation.Mul(deviation, big.NewInt(BasisPointsTotal))
+	deadband := new(big.Int).Mul(new(big.Int).SetUint64(s.deadbandBps), targetTimesBase)
+	if prevHash.Sign() > 0 && deviation.Cmp(deadband) <= 0 {
+		return newRelaysEma, fillDifficultyHashBytes(prevHash)
+	}
+
+	// The hash which would make the number of relays expected to be claimed equal
+	// to the target: baseHash * target / relaysEma, capped at baseHash.
+	newHash := new(big.Int).Set(baseHash)
+	if relaysEma.Sign() > 0 {
+		newHash.Quo(targetTimesBase, relaysEma)
+	}
+
+	// Bound the change of the target hash relative to the previous one to
+	// [prevHash / (1 + maxStep), prevHash * (1 + maxStep)].
+	if s.maxStepBps > 0 && prevHash.Sign() > 0 {
+		stepFactorBps := new(big.Int).SetUint64(BasisPointsTotal + s.maxStepBps)
+
+		maxHash := new(big.Int).Mul(prevHash, stepFactorBps)
+		maxHash.Quo(maxHash, big.NewInt(BasisPointsTotal))
+		if newHash.Cmp(maxHash) > 0 {
+			newHash = maxHash
+		}
+
+		minHash := new(big.Int).Mul(prevHash, big.NewInt(BasisPointsTotal))
+		minHash.Quo(minHash, stepFactorBps)
+		if newHash.Cmp(minHash) < 0 {
+			newHash = minHash
+		}
+	}
+
+	// A hash greater than the base would make relays easier to mine than "every relay is claimable".
+	if newHash.Cmp(baseHash) > 0 {
+		newHash = baseHash
+	}
+
+	return newRelaysEma, fillDifficultyHashBytes(newHash)
+}
+
+// computeEmaBps computes the EMA at time t, given the EMA at time t-1, the raw
+// data revealed at time t, and the smoothing factor α in basis points.
+// It uses integer arithmetic, rounding half up.
+func computeEmaBps(alphaBps, prevEma, currValue uint64) uint64 {
+	newEma := weightedSumBps(alphaBps, prevEma, currValue)
+	newEma.Add(newEma, big.NewInt(BasisPointsTotal/2))
+	newEma.Quo(newEma, big.NewInt(BasisPointsTotal))
+	return saturateUint64(newEma)
+}
+
+// weightedSumBps returns alphaBps * currValue + (BasisPointsTotal - alphaBps) * prevEma,
+// i.e. the EMA at time t scaled by BasisPointsTotal.
+func weightedSumBps(alphaBps, prevEma, currValue uint64) *big.Int {
+	weightedCurrentContribution := new(big.Int).Mul(
+		new(big.Int).SetUint64(alphaBps),
+		new(big.Int).SetUint64(currValue),
+	)
+	weightedPreviousContribution := new(big.Int).Mul(
+		new(big.Int).SetUint64(BasisPointsTotal-alphaBps),
+		new(big.Int).SetUint64(prevEma),
+	)
+
+	return weightedCurrentContribution.Add(weightedCurrentContribution, weightedPreviousContribution)
+}
+
+// ConvertNumRelaysEma returns the EMA of the number of relays of the given
+// difficulty, converted from the relays counted by fromStrategy to the relays
+// counted by toStrategy:
+//   - RelayMiningDifficultyStrategyEma counts the relays claimed
+//   - RelayMiningDifficultyStrategyBoundedEma counts the relays served, i.e. the
+//     relays claimed scaled by the difficulty they were mined at
+//
+// The relays are assumed to have been mined at the current target hash of the difficulty.
+func ConvertNumRelaysEma(difficulty RelayMiningDifficulty, fromStrategy, toStrategy string) uint64 {
+	countsServedRelays := func(strategy string) bool {
+		return strategy == RelayMiningDifficultyStrategyBoundedEma
+	}
+	if countsServedRelays(fromStrategy) == countsServedRelays(toStrategy) {
+		return difficulty.NumRelaysEma
+	}
+
+	baseHash := new(big.Int).SetBytes(protocol.BaseRelayDifficultyHashBz)
+	targetHash := new(big.Int).SetBytes(difficulty.TargetHash)
+	if targetHash.Sign() <= 0 {
+		return difficulty.NumRelaysEma
+	}
+
+	numRelaysEma := new(big.Int).SetUint64(difficulty.NumRelaysEma)
+	if countsServedRelays(toStrategy) {
+		// Claimed -> served: a relay is claimed with a probability of targetHash / baseHash.
+		numRelaysEma.Mul(numRelaysEma, baseHash)
+		numRelaysEma.Quo(numRelaysEma, targetHash)
+	} else {
+		// Served -> claimed.
+		numRelaysEma.Mul(numRelaysEma, targetHash)
+		numRelaysEma.Quo(numRelaysEma, baseHash)
+	}
+
+	return saturateUint64(numRelaysEma)
+}
+
+// saturateUint64 returns the given non-negative integer as a uint64, or
+// math.MaxUint64 if it does not fit.
+func saturateUint64(value *big.Int) uint64 {
+	if !value.IsUint64() {
+		return math.MaxUint64
+	}
+
+	return value.Uint64()
+}
+
+// fillDifficultyHashBytes returns the big endian representation of the given
+// hash, zero padded to the length of the base difficulty hash.
+func fillDifficultyHashBytes(hash *big.Int) []byte {
+	return hash.FillBytes(make([]byte, len(protocol.BaseRelayDifficultyHashBz)))
+}
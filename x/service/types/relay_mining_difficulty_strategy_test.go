@@ -0,0 +1,231 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/crypto/protocol"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+// halfBaseRelayDifficultyHashHex is the target hash under which half of the relays are claimed.
+const halfBaseRelayDifficultyHashHex = "7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+
+func TestNewRelayMiningDifficultyStrategy_Unsupported(t *testing.T) {
+	params := types.DefaultParams()
+	params.RelayMiningDifficultyStrategy = "pid"
+
+	_, err := types.NewRelayMiningDifficultyStrategy(params)
+	require.ErrorIs(t, err, types.ErrServiceParamInvalid)
+}
+
+func TestEmaStrategy_NextRelayMiningDifficulty(t *testing.T) {
+	tests := []struct {
+		desc              string
+		alphaBps          uint64
+		prevRelaysEma     uint64
+		numRelays         uint64
+		expectedRelaysEma uint64
+	}{
+		{
+			desc:              "exact EMA",
+			alphaBps:          types.DefaultRelayMiningDifficultyEmaAlphaBps,
+			prevRelaysEma:     100_000,
+			numRelays:         10,
+			expectedRelaysEma: 90_001,
+		},
+		{
+			desc:              "truncated EMA",
+			alphaBps:          types.DefaultRelayMiningDifficultyEmaAlphaBps,
+			prevRelaysEma:     999,
+			numRelays:         1_000,
+			expectedRelaysEma: 999,
+		},
+		{
+			desc:              "no relays",
+			alphaBps:          types.DefaultRelayMiningDifficultyEmaAlphaBps,
+			prevRelaysEma:     10,
+			numRelays:         0,
+			expectedRelaysEma: 9,
+		},
+		{
+			desc:              "alpha of 100%",
+			alphaBps:          types.BasisPointsTotal,
+			prevRelaysEma:     100_000,
+			numRelays:         10,
+			expectedRelaysEma: 10,
+		},
+		{
+			desc:              "no overflow",
+			alphaBps:          types.DefaultRelayMiningDifficultyEmaAlphaBps,
+			prevRelaysEma:     math.MaxUint64,
+			numRelays:         math.MaxUint64,
+			expectedRelaysEma: math.MaxUint64,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.RelayMiningDifficultyEmaAlphaBps = test.alphaBps
+			strategy, err := types.NewRelayMiningDifficultyStrategy(params)
+			require.NoError(t, err)
+
+			prevDifficulty := types.RelayMiningDifficulty{
+				NumRelaysEma: test.prevRelaysEma,
+				TargetHash:   protocol.BaseRelayDifficultyHashBz,
+			}
+			newRelaysEma, newTargetHash := strategy.NextRelayMiningDifficulty(
+				prevDifficulty,
+				nil,
+				test.numRelays,
+				types.DefaultTargetNumRelays,
+			)
+
+			require.Equal(t, test.expectedRelaysEma, newRelaysEma)
+			require.Equal(t,
+				protocol.ComputeNewDifficultyTargetHash(protocol.BaseRelayDifficultyHashBz, types.DefaultTargetNumRelays, test.expectedRelaysEma),
+				newTargetHash,
+			)
+		})
+	}
+}
+
+func TestBoundedEmaStrategy_NextRelayMiningDifficulty(t *testing.T) {
+	tests := []struct {
+		desc                  string
+		prevRelaysEma         uint64
+		prevTargetHashHex     string
+		numRelays             uint64
+		expectedRelaysEma     uint64
+		expectedTargetHashHex string
+	}{
+		{
+			desc: "expected claimed relays within the deadband: unchanged difficulty",
+			// 102K relays claimed at 1/2 -> ~204K relays served.
+			prevRelaysEma:         200_000,
+			prevTargetHashHex:     halfBaseRelayDifficultyHashHex,
+			numRelays:             102_000,
+			expectedRelaysEma:     200_400,
+			expectedTargetHashHex: halfBaseRelayDifficultyHashHex,
+		},
+		{
+			desc: "expected claimed relays outside the deadband: difficulty scaled to the target",
+			// 160K relays claimed at 1/2 -> ~320K relays served.
+			prevRelaysEma:         200_000,
+			prevTargetHashHex:     halfBaseRelayDifficultyHashHex,
+			numRelays:             160_000,
+			expectedRelaysEma:     212_000,
+			expectedTargetHashHex: "78c13521cfb2b78c13521cfb2b78c13521cfb2b78c13521cfb2b78c13521cfb2",
+		},
+		{
+			desc: "burst: difficulty increase bounded by the max step",
+			// 10M relays claimed at 1/2 -> ~20M relays served.
+			prevRelaysEma:         200_000,
+			prevTargetHashHex:     halfBaseRelayDifficultyHashHex,
+			numRelays:             10_000_000,
+			expectedRelaysEma:     2_180_000,
+			expectedTargetHashHex: "3fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		},
+		{
+			desc: "drop: difficulty decrease bounded by the max step",
+			// 1K relays claimed at 1/2 -> ~2K relays served.
+			prevRelaysEma:         40_000,
+			prevTargetHashHex:     halfBaseRelayDifficultyHashHex,
+			numRelays:             1_000,
+			expectedRelaysEma:     36_200,
+			expectedTargetHashHex: "fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe",
+		},
+		{
+			desc:                  "no relays: base difficulty",
+			prevRelaysEma:         0,
+			prevTargetHashHex:     protocol.BaseRelayDifficultyHashHex,
+			numRelays:             0,
+			expectedRelaysEma:     0,
+			expectedTargetHashHex: protocol.BaseRelayDifficultyHashHex,
+		},
+	}
+
+	params := types.DefaultParams()
+	params.RelayMiningDifficultyStrategy = types.RelayMiningDifficultyStrategyBoundedEma
+	strategy, err := types.NewRelayMiningDifficultyStrategy(params)
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			prevTargetHash, err := hex.DecodeString(test.prevTargetHashHex)
+			require.NoError(t, err)
+
+			prevDifficulty := types.RelayMiningDifficulty{
+				NumRelaysEma: test.prevRelaysEma,
+				TargetHash:   prevTargetHash,
+			}
+			newRelaysEma, newTargetHash := strategy.NextRelayMiningDifficulty(
+				prevDifficulty,
+				prevTargetHash,
+				test.numRelays,
+				types.DefaultTargetNumRelays,
+			)
+
+			require.Equal(t, test.expectedRelaysEma, newRelaysEma)
+			require.Equal(t, test.expectedTargetHashHex, hex.EncodeToString(newTargetHash))
+		})
+	}
+}
+
+func TestConvertNumRelaysEma(t *testing.T) {
+	halfBaseRelayDifficultyHash, err := hex.DecodeString(halfBaseRelayDifficultyHashHex)
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc                 string
+		targetHash           []byte
+		fromStrategy         string
+		toStrategy           string
+		expectedNumRelaysEma uint64
+	}{
+		{
+			desc:                 "claimed to served relays",
+			targetHash:           halfBaseRelayDifficultyHash,
+			fromStrategy:         types.RelayMiningDifficultyStrategyEma,
+			toStrategy:           types.RelayMiningDifficultyStrategyBoundedEma,
+			expectedNumRelaysEma: 200_000,
+		},
+		{
+			desc:                 "served to claimed relays",
+			targetHash:           halfBaseRelayDifficultyHash,
+			fromStrategy:         types.RelayMiningDifficultyStrategyBoundedEma,
+			toStrategy:           types.RelayMiningDifficultyStrategyEma,
+			expectedNumRelaysEma: 49_999,
+		},
+		{
+			desc:                 "unchanged strategy",
+			targetHash:           halfBaseRelayDifficultyHash,
+			fromStrategy:         types.RelayMiningDifficultyStrategyBoundedEma,
+			toStrategy:           types.RelayMiningDifficultyStrategyBoundedEma,
+			expectedNumRelaysEma: 100_000,
+		},
+		{
+			desc:                 "base difficulty",
+			targetHash:           protocol.BaseRelayDifficultyHashBz,
+			fromStrategy:         types.RelayMiningDifficultyStrategyEma,
+			toStrategy:           types.RelayMiningDifficultyStrategyBoundedEma,
+			expectedNumRelaysEma: 100_000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			difficulty := types.RelayMiningDifficulty{
+				NumRelaysEma: 100_000,
+				TargetHash:   test.targetHash,
+			}
+
+			numRelaysEma := types.ConvertNumRelaysEma(difficulty, test.fromStrategy, test.toStrategy)
+			require.Equal(t, test.expectedNumRelaysEma, numRelaysEma)
+		})
+	}
+}
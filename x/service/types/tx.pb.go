@@ -129,6 +129,7 @@ type MsgUpdateParam struct {
 	// Types that are valid to be assigned to AsType:
 	//	*MsgUpdateParam_AsCoin
 	//	*MsgUpdateParam_AsUint64
+	//	*MsgUpdateParam_AsString
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"as_type"`
 }
 
@@ -173,9 +174,13 @@ type MsgUpdateParam_AsCoin struct {
 type MsgUpdateParam_AsUint64 struct {
 	AsUint64 uint64 `protobuf:"varint,4,opt,name=as_uint64,json=asUint64,proto3,oneof" json:"as_uint64"`
 }
+type MsgUpdateParam_AsString struct {
+	AsString string `protobuf:"bytes,5,opt,name=as_string,json=asString,proto3,oneof" json:"as_string"`
+}
 
 func (*MsgUpdateParam_AsCoin) isMsgUpdateParam_AsType()   {}
 func (*MsgUpdateParam_AsUint64) isMsgUpdateParam_AsType() {}
+func (*MsgUpdateParam_AsString) isMsgUpdateParam_AsType() {}
 
 func (m *MsgUpdateParam) GetAsType() isMsgUpdateParam_AsType {
 	if m != nil {
@@ -212,11 +217,19 @@ func (m *MsgUpdateParam) GetAsUint64() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateParam) GetAsString() string {
+	if x, ok := m.GetAsType().(*MsgUpdateParam_AsString); ok {
+		return x.AsString
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgUpdateParam) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MsgUpdateParam_AsCoin)(nil),
 		(*MsgUpdateParam_AsUint64)(nil),
+		(*MsgUpdateParam_AsString)(nil),
 	}
 }
 
@@ -260,6 +273,10 @@ var xxx_messageInfo_MsgUpdateParamResponse proto.InternalMessageInfo
 type MsgAddService struct {
 	OwnerAddress string         `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
 	Service      types1.Service `protobuf:"bytes,2,opt,name=service,proto3" json:"service"`
+	// reset_target_num_relays resets the target number of relays of an existing
+	// service to the target_num_relays param. Otherwise, a zero service.target_num_relays
+	// keeps the existing target. It MUST NOT be set along with service.target_num_relays.
+	ResetTargetNumRelays bool `protobuf:"varint,3,opt,name=reset_target_num_relays,json=resetTargetNumRelays,proto3" json:"reset_target_num_relays,omitempty"`
 }
 
 func (m *MsgAddService) Reset()         { *m = MsgAddService{} }
@@ -305,6 +322,13 @@ func (m *MsgAddService) GetService() types1.Service {
 	return types1.Service{}
 }
 
+func (m *MsgAddService) GetResetTargetNumRelays() bool {
+	if m != nil {
+		return m.ResetTargetNumRelays
+	}
+	return false
+}
+
 type MsgAddServiceResponse struct {
 }
 
@@ -442,50 +466,53 @@ func init() {
 func init() { proto.RegisterFile("pocket/service/tx.proto", fileDescriptor_c139846c83c36dca) }
 
 var fileDescriptor_c139846c83c36dca = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0xd4, 0x40,
-	0x14, 0xdf, 0x81, 0x15, 0xd8, 0xc7, 0x3f, 0x99, 0x20, 0x2c, 0x55, 0xba, 0x9b, 0x26, 0x2a, 0xd9,
-	0x48, 0x2b, 0x60, 0x48, 0x24, 0xf1, 0x40, 0xf5, 0x80, 0x26, 0xab, 0xa6, 0x48, 0x62, 0xbc, 0x6c,
-	0x66, 0xb7, 0x63, 0x69, 0x60, 0x3b, 0x4d, 0x67, 0x60, 0xe1, 0x66, 0x3c, 0x7a, 0xf2, 0xec, 0x27,
-	0xf0, 0xc8, 0xc1, 0x8b, 0xdf, 0x80, 0x83, 0x07, 0xe2, 0x89, 0x13, 0x31, 0xcb, 0x01, 0xc3, 0xd9,
-	0x0f, 0x60, 0xda, 0x4e, 0xd9, 0x6d, 0x21, 0x6c, 0x62, 0xbc, 0xb4, 0x33, 0xef, 0xf7, 0x7b, 0x7f,
-	0x7e, 0xef, 0xcd, 0x0c, 0x4c, 0xfb, 0xac, 0xb1, 0x45, 0x85, 0xc1, 0x69, 0xb0, 0xeb, 0x36, 0xa8,
-	0x21, 0xf6, 0x74, 0x3f, 0x60, 0x82, 0xe1, 0xb1, 0x18, 0xd0, 0x25, 0xa0, 0x4c, 0x90, 0xa6, 0xeb,
-	0x31, 0x23, 0xfa, 0xc6, 0x14, 0x45, 0x6d, 0x30, 0xde, 0x64, 0xdc, 0xa8, 0x13, 0x4e, 0x8d, 0xdd,
-	0x85, 0x3a, 0x15, 0x64, 0xc1, 0x68, 0x30, 0xd7, 0x93, 0xf8, 0xb4, 0xc4, 0x9b, 0xdc, 0x31, 0x76,
-	0x17, 0xc2, 0x9f, 0x04, 0x66, 0x62, 0xa0, 0x16, 0xed, 0x8c, 0x78, 0x23, 0xa1, 0x49, 0x87, 0x39,
-	0x2c, 0xb6, 0x87, 0x2b, 0x69, 0xbd, 0x9d, 0xa9, 0xd2, 0x27, 0x01, 0x69, 0xf2, 0x2c, 0xb8, 0x49,
-	0x02, 0x6a, 0x27, 0x9c, 0x18, 0xd4, 0xbe, 0x23, 0x18, 0xaf, 0x72, 0x67, 0xc3, 0xb7, 0x89, 0xa0,
-	0xaf, 0x23, 0x37, 0xbc, 0x0c, 0x05, 0xb2, 0x23, 0x36, 0x59, 0xe0, 0x8a, 0xfd, 0x22, 0x2a, 0xa3,
-	0xb9, 0x82, 0x59, 0xfc, 0xf9, 0x6d, 0x7e, 0x52, 0x16, 0xb2, 0x6a, 0xdb, 0x01, 0xe5, 0x7c, 0x5d,
-	0x04, 0xae, 0xe7, 0x58, 0x1d, 0x2a, 0x7e, 0x0c, 0x03, 0x71, 0xe2, 0x62, 0x5f, 0x19, 0xcd, 0x0d,
-	0x2f, 0x4e, 0xe9, 0xe9, 0x1e, 0xe9, 0x71, 0x7c, 0xb3, 0x70, 0x78, 0x52, 0xca, 0x7d, 0x3d, 0x3b,
-	0xa8, 0x20, 0x4b, 0x3a, 0xac, 0x2c, 0x7d, 0x3c, 0x3b, 0xa8, 0x74, 0x42, 0x7d, 0x3a, 0x3b, 0xa8,
-	0x94, 0x65, 0xd9, 0x7b, 0x17, 0xaa, 0x32, 0x75, 0x6a, 0x33, 0x30, 0x9d, 0x31, 0x59, 0x94, 0xfb,
-	0xcc, 0xe3, 0x54, 0xfb, 0x8d, 0x60, 0x2c, 0x8d, 0xfd, 0xb3, 0x2a, 0x0c, 0x79, 0x8f, 0x34, 0x69,
-	0xa4, 0xa9, 0x60, 0x45, 0x6b, 0xbc, 0x0a, 0x83, 0x84, 0xd7, 0xc2, 0x51, 0x16, 0xfb, 0x23, 0xa9,
-	0x33, 0xba, 0x0c, 0x13, 0xce, 0x5a, 0x97, 0xb3, 0xd6, 0x9f, 0x32, 0xd7, 0x33, 0x87, 0xcf, 0x4f,
-	0x4a, 0x09, 0x7b, 0x2d, 0x67, 0x0d, 0x10, 0x1e, 0x9a, 0xf1, 0x03, 0x28, 0x10, 0x5e, 0xdb, 0x71,
-	0x3d, 0xb1, 0xfc, 0xa8, 0x98, 0x2f, 0xa3, 0xb9, 0xbc, 0x39, 0x7a, 0x7e, 0x52, 0xea, 0x18, 0xd7,
-	0x72, 0xd6, 0x10, 0xe1, 0x1b, 0xd1, 0x7a, 0x65, 0x2c, 0xdd, 0x1f, 0xb3, 0x10, 0x15, 0x20, 0xf6,
-	0x7d, 0xaa, 0xa9, 0x30, 0x95, 0x56, 0x9a, 0x34, 0xe1, 0x45, 0x7e, 0x08, 0xdd, 0xec, 0xd3, 0xbe,
-	0x20, 0x18, 0xad, 0x72, 0x67, 0xd5, 0xb6, 0xd7, 0xe3, 0x3e, 0xe2, 0x27, 0x30, 0xca, 0x5a, 0x1e,
-	0x0d, 0x6a, 0x24, 0xd6, 0xdc, 0xb3, 0x1b, 0x23, 0x11, 0x5d, 0xda, 0xf0, 0x32, 0x0c, 0xca, 0x89,
-	0x5c, 0x9a, 0x73, 0x74, 0xc2, 0x74, 0x99, 0xc7, 0xcc, 0x87, 0x73, 0xb6, 0x12, 0xf2, 0x0a, 0x0e,
-	0x35, 0xa4, 0x33, 0x6b, 0xb3, 0x70, 0x2b, 0x55, 0x5b, 0xa6, 0xf6, 0x1f, 0x08, 0x70, 0x95, 0x3b,
-	0x6f, 0x02, 0xe2, 0xf1, 0xf7, 0x34, 0xf8, 0x4f, 0x02, 0x66, 0x01, 0x64, 0x4d, 0x35, 0xd7, 0x96,
-	0x73, 0x2d, 0x48, 0xcb, 0x73, 0x1b, 0x3f, 0x83, 0x09, 0x8f, 0xb6, 0x6a, 0xe9, 0x0c, 0xfd, 0x3d,
-	0x32, 0x8c, 0x7b, 0xb4, 0xf5, 0xaa, 0x2b, 0xc9, 0x95, 0x6a, 0xef, 0x80, 0x72, 0x59, 0x4d, 0x22,
-	0x79, 0xf1, 0x4f, 0x1f, 0xf4, 0x57, 0xb9, 0x83, 0xdf, 0xc2, 0x48, 0xea, 0x3a, 0x96, 0xb2, 0xd7,
-	0x28, 0x73, 0xe8, 0x95, 0xfb, 0x3d, 0x08, 0x49, 0x06, 0xbc, 0x01, 0xc3, 0xdd, 0x37, 0x42, 0xbd,
-	0xde, 0x4f, 0xb9, 0x77, 0x3d, 0x7e, 0x11, 0xd6, 0x02, 0xe8, 0x3a, 0x5d, 0xb3, 0x57, 0x78, 0x75,
-	0x60, 0xe5, 0xee, 0xb5, 0xf0, 0x45, 0x4c, 0x02, 0xe3, 0xd9, 0xa9, 0x6b, 0x57, 0x78, 0x66, 0x38,
-	0x4a, 0xa5, 0x37, 0x27, 0x49, 0xa1, 0xdc, 0xf8, 0x10, 0x3e, 0x41, 0xe6, 0xcb, 0xc3, 0xb6, 0x8a,
-	0x8e, 0xda, 0x2a, 0x3a, 0x6e, 0xab, 0xe8, 0x57, 0x5b, 0x45, 0x9f, 0x4f, 0xd5, 0xdc, 0xd1, 0xa9,
-	0x9a, 0x3b, 0x3e, 0x55, 0x73, 0xef, 0x1e, 0x3a, 0xae, 0xd8, 0xdc, 0xa9, 0xeb, 0x0d, 0xd6, 0x34,
-	0x7c, 0xb6, 0x25, 0xe6, 0x3d, 0x2a, 0x5a, 0x2c, 0xd8, 0x8a, 0x36, 0x01, 0xdb, 0xde, 0xee, 0x7a,
-	0x9f, 0xc2, 0xeb, 0xc8, 0xeb, 0x03, 0xd1, 0xc3, 0xba, 0xf4, 0x37, 0x00, 0x00, 0xff, 0xff, 0x15,
-	0xf3, 0xfc, 0x65, 0x3a, 0x06, 0x00, 0x00,
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x43, 0xf8, 0xc9, 0xf0, 0x77, 0x19, 0x71, 0x49, 0xf0, 0xbd, 0x38, 0x51, 0xa4, 0xb6,
+	0x28, 0x2a, 0x76, 0x81, 0x16, 0xa9, 0x48, 0x5d, 0x90, 0x76, 0x41, 0x2b, 0x85, 0x56, 0x06, 0xa4,
+	0xaa, 0x1b, 0x6b, 0x12, 0x4f, 0x8d, 0x05, 0xf6, 0x58, 0x33, 0x13, 0x02, 0xbb, 0xaa, 0xcb, 0xae,
+	0xba, 0xea, 0x33, 0x74, 0xc9, 0xa2, 0x9b, 0xbe, 0x01, 0x8b, 0x2e, 0x68, 0x57, 0xac, 0x50, 0x15,
+	0x16, 0x48, 0xac, 0xfb, 0x00, 0x95, 0xc7, 0x63, 0x12, 0x1b, 0x44, 0xa4, 0xaa, 0x9b, 0xc4, 0x73,
+	0xbe, 0x6f, 0xce, 0x39, 0xdf, 0xf9, 0x19, 0x50, 0x08, 0x48, 0x73, 0x17, 0x73, 0x83, 0x61, 0xba,
+	0xef, 0x36, 0xb1, 0xc1, 0x0f, 0xf4, 0x80, 0x12, 0x4e, 0xe0, 0x44, 0x04, 0xe8, 0x12, 0x50, 0xa7,
+	0x90, 0xe7, 0xfa, 0xc4, 0x10, 0xbf, 0x11, 0x45, 0xd5, 0x9a, 0x84, 0x79, 0x84, 0x19, 0x0d, 0xc4,
+	0xb0, 0xb1, 0xbf, 0xd8, 0xc0, 0x1c, 0x2d, 0x1a, 0x4d, 0xe2, 0xfa, 0x12, 0x2f, 0x48, 0xdc, 0x63,
+	0x8e, 0xb1, 0xbf, 0x18, 0xfe, 0x49, 0x60, 0x36, 0x02, 0x2c, 0x71, 0x32, 0xa2, 0x83, 0x84, 0xa6,
+	0x1d, 0xe2, 0x90, 0xc8, 0x1e, 0x7e, 0x49, 0xeb, 0x7f, 0xa9, 0x2c, 0x03, 0x44, 0x91, 0xc7, 0xd2,
+	0xe0, 0x0e, 0xa2, 0xd8, 0x8e, 0x39, 0x11, 0x58, 0xf9, 0xaa, 0x80, 0xc9, 0x3a, 0x73, 0xb6, 0x03,
+	0x1b, 0x71, 0xfc, 0x4a, 0x5c, 0x83, 0x2b, 0x20, 0x8f, 0x5a, 0x7c, 0x87, 0x50, 0x97, 0x1f, 0x16,
+	0x95, 0xb2, 0x32, 0x9f, 0xaf, 0x15, 0x7f, 0x7c, 0x59, 0x98, 0x96, 0x89, 0xac, 0xd9, 0x36, 0xc5,
+	0x8c, 0x6d, 0x72, 0xea, 0xfa, 0x8e, 0xd9, 0xa5, 0xc2, 0xc7, 0x60, 0x28, 0x0a, 0x5c, 0xcc, 0x96,
+	0x95, 0xf9, 0xd1, 0xa5, 0x19, 0x3d, 0x59, 0x23, 0x3d, 0xf2, 0x5f, 0xcb, 0x1f, 0x9f, 0x95, 0x32,
+	0x9f, 0x2f, 0x8e, 0xaa, 0x8a, 0x29, 0x2f, 0xac, 0x2e, 0xbf, 0xbf, 0x38, 0xaa, 0x76, 0x5d, 0x7d,
+	0xb8, 0x38, 0xaa, 0x96, 0x65, 0xda, 0x07, 0x57, 0xaa, 0x52, 0x79, 0x56, 0x66, 0x41, 0x21, 0x65,
+	0x32, 0x31, 0x0b, 0x88, 0xcf, 0x70, 0xe5, 0x53, 0x16, 0x4c, 0x24, 0xb1, 0x3f, 0x56, 0x05, 0x41,
+	0xce, 0x47, 0x1e, 0x16, 0x9a, 0xf2, 0xa6, 0xf8, 0x86, 0x6b, 0x60, 0x18, 0x31, 0x2b, 0x6c, 0x65,
+	0x71, 0x40, 0x48, 0x9d, 0xd5, 0xa5, 0x9b, 0xb0, 0xd7, 0xba, 0xec, 0xb5, 0xfe, 0x94, 0xb8, 0x7e,
+	0x6d, 0xf4, 0xf2, 0xac, 0x14, 0xb3, 0xd7, 0x33, 0xe6, 0x10, 0x62, 0xa1, 0x19, 0xde, 0x07, 0x79,
+	0xc4, 0xac, 0x96, 0xeb, 0xf3, 0x95, 0x87, 0xc5, 0x5c, 0x59, 0x99, 0xcf, 0xd5, 0xc6, 0x2f, 0xcf,
+	0x4a, 0x5d, 0xe3, 0x7a, 0xc6, 0x1c, 0x41, 0x6c, 0x5b, 0x7c, 0x4b, 0x36, 0x13, 0xc9, 0x15, 0x07,
+	0x45, 0xf2, 0x31, 0x3b, 0x32, 0x46, 0xec, 0x28, 0xfb, 0xd5, 0x89, 0x64, 0x35, 0x6b, 0x79, 0x91,
+	0x2e, 0x3f, 0x0c, 0x70, 0x45, 0x03, 0x33, 0xc9, 0xba, 0xc4, 0x25, 0x7b, 0x91, 0x1b, 0x51, 0xfe,
+	0xc9, 0x56, 0xbe, 0x2b, 0x60, 0xbc, 0xce, 0x9c, 0x35, 0xdb, 0xde, 0x8c, 0xaa, 0x0e, 0x9f, 0x80,
+	0x71, 0xd2, 0xf6, 0x31, 0xb5, 0x50, 0x54, 0xa1, 0xbe, 0xb5, 0x1b, 0x13, 0x74, 0x69, 0x83, 0x2b,
+	0x60, 0x58, 0xf6, 0xef, 0xda, 0x54, 0x88, 0x79, 0xd4, 0x65, 0x9c, 0x5a, 0x2e, 0x9c, 0x0a, 0x33,
+	0x26, 0xc3, 0x47, 0xa0, 0x40, 0x31, 0xc3, 0xdc, 0xe2, 0x88, 0x3a, 0x98, 0x5b, 0x7e, 0xcb, 0xb3,
+	0x28, 0xde, 0x43, 0x87, 0x4c, 0x94, 0x7c, 0xc4, 0x9c, 0x16, 0xf0, 0x96, 0x40, 0x37, 0x5a, 0x9e,
+	0x29, 0xb0, 0x55, 0x18, 0x4a, 0x4f, 0x26, 0x5c, 0x99, 0x03, 0xff, 0x26, 0x24, 0xa5, 0x24, 0x7f,
+	0x53, 0x00, 0xac, 0x33, 0x67, 0x8b, 0x22, 0x9f, 0xbd, 0xc5, 0xf4, 0x2f, 0xe9, 0x9e, 0x03, 0x40,
+	0x4a, 0xb1, 0x5c, 0x5b, 0x0e, 0x4f, 0x5e, 0x5a, 0x9e, 0xdb, 0xf0, 0x19, 0x98, 0xf2, 0x71, 0xdb,
+	0x4a, 0x46, 0x18, 0xe8, 0x13, 0x61, 0xd2, 0xc7, 0xed, 0x97, 0x3d, 0x41, 0x6e, 0x54, 0xfb, 0x3f,
+	0x50, 0xaf, 0xab, 0x89, 0x25, 0x2f, 0xfd, 0xca, 0x82, 0x81, 0x3a, 0x73, 0xe0, 0x6b, 0x30, 0x96,
+	0xd8, 0xf9, 0x52, 0x7a, 0x57, 0x53, 0x9b, 0xa5, 0xde, 0xeb, 0x43, 0x88, 0x23, 0xc0, 0x6d, 0x30,
+	0xda, 0xbb, 0x76, 0xda, 0xed, 0xf7, 0xd4, 0xbb, 0xb7, 0xe3, 0x57, 0x6e, 0x4d, 0x00, 0x7a, 0x86,
+	0x72, 0xee, 0x86, 0x5b, 0x5d, 0x58, 0xbd, 0x73, 0x2b, 0x7c, 0xe5, 0x13, 0x81, 0xc9, 0x74, 0xd7,
+	0x2b, 0x37, 0xdc, 0x4c, 0x71, 0xd4, 0x6a, 0x7f, 0x4e, 0x1c, 0x42, 0x1d, 0x7c, 0x17, 0xbe, 0x73,
+	0xb5, 0x8d, 0xe3, 0x8e, 0xa6, 0x9c, 0x74, 0x34, 0xe5, 0xb4, 0xa3, 0x29, 0x3f, 0x3b, 0x9a, 0xf2,
+	0xf1, 0x5c, 0xcb, 0x9c, 0x9c, 0x6b, 0x99, 0xd3, 0x73, 0x2d, 0xf3, 0xe6, 0x81, 0xe3, 0xf2, 0x9d,
+	0x56, 0x43, 0x6f, 0x12, 0xcf, 0x08, 0xc8, 0x2e, 0x5f, 0xf0, 0x31, 0x6f, 0x13, 0xba, 0x2b, 0x0e,
+	0x94, 0xec, 0xed, 0xf5, 0x3c, 0x82, 0xe1, 0x16, 0xb3, 0xc6, 0x90, 0x78, 0xbd, 0x97, 0x7f, 0x0f,
+	0x00, 0x6c, 0x59, 0xe1, 0x39, 0x9f, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -823,6 +850,20 @@ func (m *MsgUpdateParam_AsUint64) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x20
 	return len(dAtA) - i, nil
 }
+func (m *MsgUpdateParam_AsString) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParam_AsString) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.AsString)
+	copy(dAtA[i:], m.AsString)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.AsString)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
 func (m *MsgUpdateParamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -866,6 +907,16 @@ func (m *MsgAddService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResetTargetNumRelays {
+		i--
+		if m.ResetTargetNumRelays {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1052,6 +1103,16 @@ func (m *MsgUpdateParam_AsUint64) Size() (n int) {
 	n += 1 + sovTx(uint64(m.AsUint64))
 	return n
 }
+func (m *MsgUpdateParam_AsString) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AsString)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
 func (m *MsgUpdateParamResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1073,6 +1134,9 @@ func (m *MsgAddService) Size() (n int) {
 	}
 	l = m.Service.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.ResetTargetNumRelays {
+		n += 2
+	}
 	return n
 }
 
@@ -1434,6 +1498,38 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AsType = &MsgUpdateParam_AsUint64{v}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsType = &MsgUpdateParam_AsString{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1599,6 +1695,26 @@ func (m *MsgAddService) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetTargetNumRelays", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetTargetNumRelays = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
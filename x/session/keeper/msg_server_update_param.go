@@ -40,6 +40,12 @@ func (k msgServer) UpdateParam(ctx context.Context, msg *sessiontypes.MsgUpdateP
 	case sessiontypes.ParamNumSuppliersPerSession:
 		logger = logger.With("param_value", msg.GetAsUint64())
 		params.NumSuppliersPerSession = msg.GetAsUint64()
+	case sessiontypes.ParamSupplierSelectionMode:
+		logger = logger.With("param_value", msg.GetAsString())
+		params.SupplierSelectionMode = msg.GetAsString()
+	case sessiontypes.ParamSupplierSelectionStakeCapUpokt:
+		logger = logger.With("param_value", msg.GetAsUint64())
+		params.SupplierSelectionStakeCapUpokt = msg.GetAsUint64()
 	default:
 		return nil, status.Error(
 			codes.InvalidArgument,
@@ -38,3 +38,59 @@ func TestMsgUpdateParam_UpdateNumSuppliersPerSessionOnly(t *testing.T) {
 	// Ensure the other parameters are unchanged
 	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(sessiontypes.KeyNumSuppliersPerSession))
 }
+
+func TestMsgUpdateParam_UpdateSupplierSelectionModeOnly(t *testing.T) {
+	expectedSupplierSelectionMode := sessiontypes.SupplierSelectionModeStakeWeightedSqrt
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := sessiontypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.NotEqual(t, expectedSupplierSelectionMode, defaultParams.SupplierSelectionMode)
+
+	// Update the new parameter
+	updateParamMsg := &sessiontypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      sessiontypes.ParamSupplierSelectionMode,
+		AsType:    &sessiontypes.MsgUpdateParam_AsString{AsString: expectedSupplierSelectionMode},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	// Query the updated params from the keeper
+	updatedParams := k.GetParams(ctx)
+	require.Equal(t, expectedSupplierSelectionMode, updatedParams.SupplierSelectionMode)
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(sessiontypes.KeySupplierSelectionMode))
+}
+
+func TestMsgUpdateParam_UpdateSupplierSelectionStakeCapUpoktOnly(t *testing.T) {
+	var expectedSupplierSelectionStakeCapUpokt uint64 = 1_000_000_000_000
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := sessiontypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.NotEqual(t, expectedSupplierSelectionStakeCapUpokt, defaultParams.SupplierSelectionStakeCapUpokt)
+
+	// Update the new parameter
+	updateParamMsg := &sessiontypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      sessiontypes.ParamSupplierSelectionStakeCapUpokt,
+		AsType:    &sessiontypes.MsgUpdateParam_AsUint64{AsUint64: expectedSupplierSelectionStakeCapUpokt},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	// Query the updated params from the keeper
+	updatedParams := k.GetParams(ctx)
+	require.Equal(t, expectedSupplierSelectionStakeCapUpokt, updatedParams.SupplierSelectionStakeCapUpokt)
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(sessiontypes.KeySupplierSelectionStakeCapUpokt))
+}
@@ -1,15 +1,22 @@
 package keeper_test
 
 import (
+	"context"
+	"slices"
 	"strings"
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
+	"github.com/pokt-network/poktroll/app/pocket"
 	"github.com/pokt-network/poktroll/cmd/pocketd/cmd"
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/testutil/session/mocks"
+	sharedtest "github.com/pokt-network/poktroll/testutil/shared"
 	"github.com/pokt-network/poktroll/x/session/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
@@ -156,3 +163,141 @@ func TestSession_GetSession_Failure(t *testing.T) {
 		})
 	}
 }
+
+func TestSession_GetSession_StakeWeightedSuppliersStableAcrossStakeChanges(t *testing.T) {
+	const (
+		numCandidateSuppliers = 30
+		sessionBlockHeight    = int64(10) // session [9, 12]
+		stakeChangeHeight     = int64(11) // mid-session
+		claimBlockHeight      = int64(14) // claim window of the session
+	)
+
+	// Stake the candidate suppliers for svc1 with distinct stakes.
+	operatorAddresses := make([]string, numCandidateSuppliers)
+	serviceConfigUpdates := make([]*sharedtypes.ServiceConfigUpdate, numCandidateSuppliers)
+	currentStakes := make(map[string]sdk.Coin, numCandidateSuppliers)
+	stakeHistories := make(map[string][]stakeHistoryEntry, numCandidateSuppliers)
+	for i := range operatorAddresses {
+		operatorAddress := sample.AccAddressBech32()
+		stake := sdk.NewCoin(pocket.DenomuPOKT, math.NewInt(int64(i+1)*1_000_000))
+
+		operatorAddresses[i] = operatorAddress
+		serviceConfigUpdates[i] = &sharedtypes.ServiceConfigUpdate{
+			OperatorAddress:  operatorAddress,
+			Service:          &sharedtypes.SupplierServiceConfig{ServiceId: keepertest.TestServiceId1},
+			ActivationHeight: 1,
+		}
+		currentStakes[operatorAddress] = stake
+		stakeHistories[operatorAddress] = []stakeHistoryEntry{{height: 1, stake: stake}}
+	}
+	removedSuppliers := make(map[string]bool)
+
+	supplierKeeper := newStakeHistorySupplierKeeperMock(t, serviceConfigUpdates, currentStakes, stakeHistories, removedSuppliers)
+	sessionKeeper, ctx := keepertest.SessionKeeper(t,
+		sharedParamsOpt,
+		keepertest.WithSupplierKeeper(supplierKeeper),
+	)
+
+	sessionParams := types.DefaultParams()
+	sessionParams.SupplierSelectionMode = types.SupplierSelectionModeStakeWeighted
+	require.NoError(t, sessionKeeper.SetParams(ctx, sessionParams))
+
+	req := &types.QueryGetSessionRequest{
+		ApplicationAddress: keepertest.TestApp1Address,
+		ServiceId:          keepertest.TestServiceId1,
+		BlockHeight:        sessionBlockHeight,
+	}
+
+	// Get the session suppliers during the session.
+	sessionCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(sessionBlockHeight)
+	res, err := sessionKeeper.GetSession(sessionCtx, req)
+	require.NoError(t, err)
+	initialSupplierAddresses := getSessionSupplierAddresses(res.Session)
+	require.Len(t, initialSupplierAddresses, int(sessionParams.NumSuppliersPerSession))
+
+	// Change the stakes mid-session in favor of the suppliers that were not selected,
+	// and remove one of the selected suppliers from the store.
+	for _, operatorAddress := range operatorAddresses {
+		newStake := sdk.NewCoin(pocket.DenomuPOKT, math.NewInt(1_000_000_000))
+		if slices.Contains(initialSupplierAddresses, operatorAddress) {
+			newStake = sdk.NewCoin(pocket.DenomuPOKT, math.NewInt(1))
+		}
+		currentStakes[operatorAddress] = newStake
+		stakeHistories[operatorAddress] = append(
+			stakeHistories[operatorAddress],
+			stakeHistoryEntry{height: stakeChangeHeight, stake: newStake},
+		)
+	}
+	removedSupplierAddress := initialSupplierAddresses[0]
+	removedSuppliers[removedSupplierAddress] = true
+
+	// Get the session suppliers at claim height.
+	claimCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(claimBlockHeight)
+	res, err = sessionKeeper.GetSession(claimCtx, req)
+	require.NoError(t, err)
+
+	// The same suppliers are selected, except the one missing from the store.
+	expectedSupplierAddresses := slices.DeleteFunc(
+		slices.Clone(initialSupplierAddresses),
+		func(operatorAddress string) bool { return operatorAddress == removedSupplierAddress },
+	)
+	require.Equal(t, expectedSupplierAddresses, getSessionSupplierAddresses(res.Session))
+}
+
+// stakeHistoryEntry is a supplier stake effective from the given height.
+type stakeHistoryEntry struct {
+	height int64
+	stake  sdk.Coin
+}
+
+// newStakeHistorySupplierKeeperMock returns a supplier keeper mock whose suppliers,
+// stakes and stake histories are read from the given (mutable) maps.
+func newStakeHistorySupplierKeeperMock(
+	t *testing.T,
+	serviceConfigUpdates []*sharedtypes.ServiceConfigUpdate,
+	currentStakes map[string]sdk.Coin,
+	stakeHistories map[string][]stakeHistoryEntry,
+	removedSuppliers map[string]bool,
+) types.SupplierKeeper {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+
+	supplierKeeper := mocks.NewMockSupplierKeeper(ctrl)
+	supplierKeeper.EXPECT().GetServiceConfigUpdatesIterator(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ int64) sharedtypes.RecordIterator[*sharedtypes.ServiceConfigUpdate] {
+			return sharedtest.NewMockRecordIterator(serviceConfigUpdates)
+		}).AnyTimes()
+	supplierKeeper.EXPECT().GetDehydratedSupplier(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, operatorAddress string) (sharedtypes.Supplier, bool) {
+			stake, found := currentStakes[operatorAddress]
+			if !found || removedSuppliers[operatorAddress] {
+				return sharedtypes.Supplier{}, false
+			}
+			return sharedtypes.Supplier{OperatorAddress: operatorAddress, Stake: &stake}, true
+		}).AnyTimes()
+	supplierKeeper.EXPECT().GetSupplierStakeAtHeight(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, operatorAddress string, queryHeight int64) (sdk.Coin, bool) {
+			stakeHistory := stakeHistories[operatorAddress]
+			if len(stakeHistory) == 0 {
+				return sdk.Coin{}, false
+			}
+			stake := stakeHistory[0].stake
+			for _, entry := range stakeHistory {
+				if entry.height <= queryHeight {
+					stake = entry.stake
+				}
+			}
+			return stake, true
+		}).AnyTimes()
+
+	return supplierKeeper
+}
+
+// getSessionSupplierAddresses returns the operator addresses of the session suppliers.
+func getSessionSupplierAddresses(session *types.Session) []string {
+	supplierAddresses := make([]string, 0, len(session.Suppliers))
+	for _, supplier := range session.Suppliers {
+		supplierAddresses = append(supplierAddresses, supplier.OperatorAddress)
+	}
+	return supplierAddresses
+}
@@ -184,6 +184,8 @@ func (k Keeper) hydrateSessionApplication(ctx context.Context, sh *sessionHydrat
 
 // hydrateSessionSuppliers finds the suppliers that are staked at the session
 // height and populates the session with them.
+// When there are more candidates than NumSuppliersPerSession, they are selected
+// according to the SupplierSelectionMode of the params at the session height.
 func (k Keeper) hydrateSessionSuppliers(ctx context.Context, sh *sessionHydrator) error {
 	logger := k.Logger().With("method", "hydrateSessionSuppliers")
 
@@ -250,6 +252,11 @@ func (k Keeper) hydrateSessionSuppliers(ctx context.Context, sh *sessionHydrator
 		return nil
 	}
 
+	if isStakeWeightedSupplierSelectionMode(params.SupplierSelectionMode) {
+		sh.session.Suppliers = k.selectStakeWeightedSessionSuppliers(ctx, sh, candidateSupplierConfigs, &params)
+		return nil
+	}
+
 	for _, serviceConfigUpdate := range candidateSupplierConfigs {
 		supplierOperatorAddress := serviceConfigUpdate.OperatorAddress
 		candidatesToRandomWeight[supplierOperatorAddress] = generateSupplierRandomWeight(supplierOperatorAddress, sh.sessionIDBz)
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// supplierSelectionSeedComponent domain-separates the stake weighted selection
+// seeds from the uniform selection ones (see generateSupplierRandomWeight).
+var supplierSelectionSeedComponent = []byte("stake_weighted_supplier_selection")
+
+// isStakeWeightedSupplierSelectionMode returns true if the given supplier selection
+// mode weights the candidate suppliers by their stake.
+func isStakeWeightedSupplierSelectionMode(supplierSelectionMode string) bool {
+	switch supplierSelectionMode {
+	case types.SupplierSelectionModeStakeWeighted, types.SupplierSelectionModeStakeWeightedSqrt:
+		return true
+	default:
+		return false
+	}
+}
+
+// selectStakeWeightedSessionSuppliers selects params.NumSuppliersPerSession
+// suppliers out of the given candidates with a probability proportional to
+// their (capped and optionally square-rooted) stake.
+//
+// The stake of each candidate is the one it had at the session start height, so
+// that hydrating the session again (e.g. when validating its claims and proofs)
+// selects the same suppliers, regardless of stake changes during the session.
+// The draw only depends on the candidate service configs: a selected supplier
+// missing from the store is omitted without changing the other selected suppliers.
+func (k Keeper) selectStakeWeightedSessionSuppliers(
+	ctx context.Context,
+	sh *sessionHydrator,
+	candidateSupplierConfigs []*sharedtypes.ServiceConfigUpdate,
+	params *types.Params,
+) []*sharedtypes.Supplier {
+	// Sort the candidates by operator address so the selection does not depend
+	// on the order in which they were retrieved from the store.
+	sortedCandidateConfigs := slices.Clone(candidateSupplierConfigs)
+	slices.SortStableFunc(sortedCandidateConfigs, func(configA, configB *sharedtypes.ServiceConfigUpdate) int {
+		return strings.Compare(configA.OperatorAddress, configB.OperatorAddress)
+	})
+
+	candidateWeights := make([]math.Int, len(sortedCandidateConfigs))
+	for i, candidateConfig := range sortedCandidateConfigs {
+		candidateWeights[i] = getSupplierSelectionWeight(
+			k.getSupplierStakeAtSessionStart(ctx, sh, candidateConfig.OperatorAddress),
+			params.SupplierSelectionMode,
+			params.SupplierSelectionStakeCapUpokt,
+		)
+	}
+
+	selectedIndices := selectWeightedCandidateIndices(
+		candidateWeights,
+		sh.sessionIDBz,
+		int(params.NumSuppliersPerSession),
+	)
+
+	selectedConfigs := make([]*sharedtypes.ServiceConfigUpdate, 0, len(selectedIndices))
+	for _, candidateIdx := range selectedIndices {
+		selectedConfigs = append(selectedConfigs, sortedCandidateConfigs[candidateIdx])
+	}
+
+	return k.getServiceConfigsSuppliers(ctx, selectedConfigs)
+}
+
+// getSupplierStakeAtSessionStart returns the stake the given supplier had at the
+// start of the session being hydrated.
+// Suppliers without a stake history fall back to their current stake, and
+// suppliers missing from the store to no stake at all.
+func (k Keeper) getSupplierStakeAtSessionStart(
+	ctx context.Context,
+	sh *sessionHydrator,
+	supplierOperatorAddress string,
+) *sdk.Coin {
+	stake, found := k.supplierKeeper.GetSupplierStakeAtHeight(
+		ctx,
+		supplierOperatorAddress,
+		sh.sessionHeader.SessionStartBlockHeight,
+	)
+	if found {
+		return &stake
+	}
+
+	supplier, found := k.supplierKeeper.GetDehydratedSupplier(ctx, supplierOperatorAddress)
+	if !found {
+		return nil
+	}
+
+	return supplier.Stake
+}
+
+// getSupplierSelectionWeight returns the selection weight of a supplier with the
+// given stake according to the given stake weighted selection mode:
+// 1. The stake is capped to stakeCapUpokt, unless it is 0
+// 2. The square root of the capped stake is used by the stake_weighted_sqrt mode
+// 3. The weight is at least 1 so every candidate can be selected
+func getSupplierSelectionWeight(
+	stake *sdk.Coin,
+	supplierSelectionMode string,
+	stakeCapUpokt uint64,
+) math.Int {
+	weight := math.ZeroInt()
+	if stake != nil && !stake.Amount.IsNil() {
+		weight = stake.Amount
+	}
+
+	if stakeCapUpokt > 0 {
+		weight = math.MinInt(weight, math.NewIntFromUint64(stakeCapUpokt))
+	}
+
+	if supplierSelectionMode == types.SupplierSelectionModeStakeWeightedSqrt && weight.IsPositive() {
+		weight = math.NewIntFromBigInt(new(big.Int).Sqrt(weight.BigInt()))
+	}
+
+	if !weight.IsPositive() {
+		return math.OneInt()
+	}
+
+	return weight
+}
+
+// selectWeightedCandidateIndices deterministically selects up to numSelected
+// distinct candidates, each draw picking one of the remaining candidates with a
+// probability proportional to its weight (i.e. weighted sampling without replacement).
+// All weights MUST be positive.
+//
+// Only integer arithmetic is used so that every node selects the same candidates.
+// It returns the indices of the selected candidates, in selection order.
+func selectWeightedCandidateIndices(
+	candidateWeights []math.Int,
+	sessionIDBz []byte,
+	numSelected int,
+) []int {
+	remainingIndices := make([]int, len(candidateWeights))
+	remainingTotalWeight := math.ZeroInt()
+	for i, weight := range candidateWeights {
+		remainingIndices[i] = i
+		remainingTotalWeight = remainingTotalWeight.Add(weight)
+	}
+
+	selectedIndices := make([]int, 0, min(numSelected, len(candidateWeights)))
+	for drawIdx := 0; drawIdx < numSelected && len(remainingIndices) > 0; drawIdx++ {
+		// Pick the candidate whose cumulative weight range contains the draw target.
+		target := generateSupplierSelectionTarget(sessionIDBz, drawIdx, remainingTotalWeight)
+		cumulativeWeight := math.ZeroInt()
+		for i, candidateIdx := range remainingIndices {
+			cumulativeWeight = cumulativeWeight.Add(candidateWeights[candidateIdx])
+			if target.LT(cumulativeWeight) {
+				selectedIndices = append(selectedIndices, candidateIdx)
+				remainingTotalWeight = remainingTotalWeight.Sub(candidateWeights[candidateIdx])
+				remainingIndices = slices.Delete(remainingIndices, i, i+1)
+				break
+			}
+		}
+	}
+
+	return selectedIndices
+}
+
+// generateSupplierSelectionTarget returns a deterministic pseudo-random integer
+// in [0, totalWeight) for the given session and draw:
+// 1. Combine the session ID and the draw index to create a unique seed
+// 2. Hash the seed using SHA3-256
+// 3. Reduce the 256 bits hash modulo totalWeight, whose bias is negligible for
+// weights fitting in 64 bits.
+// totalWeight MUST be positive.
+func generateSupplierSelectionTarget(sessionIDBz []byte, drawIdx int, totalWeight math.Int) math.Int {
+	drawIdxBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(drawIdxBz, uint64(drawIdx))
+
+	seed := concatWithDelimiter(
+		sessionIDComponentDelimiter,
+		sessionIDBz,
+		supplierSelectionSeedComponent,
+		drawIdxBz,
+	)
+
+	target := new(big.Int).SetBytes(sha3Hash(seed))
+	target.Mod(target, totalWeight.BigInt())
+
+	return math.NewIntFromBigInt(target)
+}
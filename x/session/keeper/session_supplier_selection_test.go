@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/app/pocket"
+	"github.com/pokt-network/poktroll/x/session/types"
+)
+
+// numDistributionSessions is the number of sessions simulated by the supplier
+// selection distribution tests.
+const numDistributionSessions = 20_000
+
+func TestGetSupplierSelectionWeight(t *testing.T) {
+	tests := []struct {
+		desc           string
+		stake          *sdk.Coin
+		mode           string
+		stakeCapUpokt  uint64
+		expectedWeight int64
+	}{
+		{
+			desc:           "stake weighted",
+			stake:          newUpoktCoin(1_000_000),
+			mode:           types.SupplierSelectionModeStakeWeighted,
+			expectedWeight: 1_000_000,
+		},
+		{
+			desc:           "stake weighted sqrt",
+			stake:          newUpoktCoin(1_000_000),
+			mode:           types.SupplierSelectionModeStakeWeightedSqrt,
+			expectedWeight: 1_000,
+		},
+		{
+			desc:           "stake weighted sqrt rounds down",
+			stake:          newUpoktCoin(99),
+			mode:           types.SupplierSelectionModeStakeWeightedSqrt,
+			expectedWeight: 9,
+		},
+		{
+			desc:           "capped stake",
+			stake:          newUpoktCoin(1_000_000),
+			mode:           types.SupplierSelectionModeStakeWeighted,
+			stakeCapUpokt:  10_000,
+			expectedWeight: 10_000,
+		},
+		{
+			desc:           "capped stake sqrt",
+			stake:          newUpoktCoin(1_000_000),
+			mode:           types.SupplierSelectionModeStakeWeightedSqrt,
+			stakeCapUpokt:  10_000,
+			expectedWeight: 100,
+		},
+		{
+			desc:           "stake below cap",
+			stake:          newUpoktCoin(500),
+			mode:           types.SupplierSelectionModeStakeWeighted,
+			stakeCapUpokt:  10_000,
+			expectedWeight: 500,
+		},
+		{
+			desc:           "nil stake has the minimum weight",
+			stake:          nil,
+			mode:           types.SupplierSelectionModeStakeWeighted,
+			expectedWeight: 1,
+		},
+		{
+			desc:           "zero stake has the minimum weight",
+			stake:          newUpoktCoin(0),
+			mode:           types.SupplierSelectionModeStakeWeightedSqrt,
+			expectedWeight: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			weight := getSupplierSelectionWeight(test.stake, test.mode, test.stakeCapUpokt)
+			require.Equal(t, math.NewInt(test.expectedWeight), weight)
+		})
+	}
+}
+
+func TestSelectWeightedCandidateIndices_Deterministic(t *testing.T) {
+	weights := newWeights(5, 1, 100, 20, 7, 3)
+	sessionIDBz := newTestSessionIDBz(42)
+
+	selectedIndices := selectWeightedCandidateIndices(weights, sessionIDBz, 4)
+	require.Len(t, selectedIndices, 4)
+
+	// The selected candidates are distinct.
+	seenIndices := make(map[int]struct{})
+	for _, candidateIdx := range selectedIndices {
+		require.NotContains(t, seenIndices, candidateIdx)
+		seenIndices[candidateIdx] = struct{}{}
+	}
+
+	// The same inputs always select the same candidates, in the same order.
+	for i := 0; i < 10; i++ {
+		require.Equal(t, selectedIndices, selectWeightedCandidateIndices(weights, sessionIDBz, 4))
+	}
+
+	// A different session selects the candidates in a different order.
+	require.NotEqual(t, selectedIndices, selectWeightedCandidateIndices(weights, newTestSessionIDBz(43), 4))
+}
+
+func TestSelectWeightedCandidateIndices_AllCandidates(t *testing.T) {
+	weights := newWeights(1, 1_000_000, 1)
+
+	selectedIndices := selectWeightedCandidateIndices(weights, newTestSessionIDBz(1), 10)
+	require.ElementsMatch(t, []int{0, 1, 2}, selectedIndices)
+}
+
+func TestSelectWeightedCandidateIndices_Distribution(t *testing.T) {
+	tests := []struct {
+		desc          string
+		stakes        []int64
+		mode          string
+		stakeCapUpokt uint64
+		numSelected   int
+		// expectedSelectionRatios is the expected ratio of sessions including
+		// each candidate.
+		expectedSelectionRatios []float64
+	}{
+		{
+			desc:                    "stake weighted",
+			stakes:                  []int64{3_000, 1_000},
+			mode:                    types.SupplierSelectionModeStakeWeighted,
+			numSelected:             1,
+			expectedSelectionRatios: []float64{0.75, 0.25},
+		},
+		{
+			desc:                    "stake weighted sqrt",
+			stakes:                  []int64{9_000_000, 1_000_000},
+			mode:                    types.SupplierSelectionModeStakeWeightedSqrt,
+			numSelected:             1,
+			expectedSelectionRatios: []float64{0.75, 0.25},
+		},
+		{
+			desc:                    "stake weighted with cap",
+			stakes:                  []int64{1_000_000, 1_000, 1_000},
+			mode:                    types.SupplierSelectionModeStakeWeighted,
+			stakeCapUpokt:           2_000,
+			numSelected:             1,
+			expectedSelectionRatios: []float64{0.5, 0.25, 0.25},
+		},
+		{
+			// P(A) = 1/2 + 1/4*2/3 + 1/4*2/3 = 5/6
+			// P(B) = P(C) = 1/4 + 1/2*1/2 + 1/4*1/3 = 7/12
+			desc:                    "stake weighted without replacement",
+			stakes:                  []int64{2_000, 1_000, 1_000},
+			mode:                    types.SupplierSelectionModeStakeWeighted,
+			numSelected:             2,
+			expectedSelectionRatios: []float64{5.0 / 6, 7.0 / 12, 7.0 / 12},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			weights := make([]math.Int, len(test.stakes))
+			for i, stake := range test.stakes {
+				weights[i] = getSupplierSelectionWeight(newUpoktCoin(stake), test.mode, test.stakeCapUpokt)
+			}
+
+			numSelections := make([]int, len(weights))
+			for sessionIdx := 0; sessionIdx < numDistributionSessions; sessionIdx++ {
+				selectedIndices := selectWeightedCandidateIndices(
+					weights,
+					newTestSessionIDBz(sessionIdx),
+					test.numSelected,
+				)
+				require.Len(t, selectedIndices, test.numSelected)
+
+				for _, candidateIdx := range selectedIndices {
+					numSelections[candidateIdx]++
+				}
+			}
+
+			for candidateIdx, expectedRatio := range test.expectedSelectionRatios {
+				selectionRatio := float64(numSelections[candidateIdx]) / numDistributionSessions
+				require.InDeltaf(t, expectedRatio, selectionRatio, 0.02, "candidate %d", candidateIdx)
+			}
+		})
+	}
+}
+
+// newUpoktCoin returns a uPOKT coin of the given amount.
+func newUpoktCoin(amount int64) *sdk.Coin {
+	coin := sdk.NewInt64Coin(pocket.DenomuPOKT, amount)
+	return &coin
+}
+
+// newWeights returns the given weights as math.Int.
+func newWeights(weights ...int64) []math.Int {
+	weightsInt := make([]math.Int, len(weights))
+	for i, weight := range weights {
+		weightsInt[i] = math.NewInt(weight)
+	}
+	return weightsInt
+}
+
+// newTestSessionIDBz returns a distinct session ID for each given session index.
+func newTestSessionIDBz(sessionIdx int) []byte {
+	sessionIdxBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(sessionIdxBz, uint64(sessionIdx))
+	return sha3Hash(sessionIdxBz)
+}
@@ -43,6 +43,13 @@ type SupplierKeeper interface {
 		ctx context.Context,
 		operatorAddr string,
 	) (supplier sharedtypes.Supplier, found bool)
+
+	// GetSupplierStakeAtHeight returns the stake the supplier had at the given height.
+	GetSupplierStakeAtHeight(
+		ctx context.Context,
+		operatorAddr string,
+		queryHeight int64,
+	) (stake sdk.Coin, found bool)
 }
 
 // SharedKeeper defines the expected interface needed to retrieve shared parameters
@@ -14,6 +14,8 @@ func NewMsgUpdateParam(authority string, name string, asType any) (*MsgUpdatePar
 	switch t := asType.(type) {
 	case uint64:
 		asTypeIface = &MsgUpdateParam_AsUint64{AsUint64: t}
+	case string:
+		asTypeIface = &MsgUpdateParam_AsString{AsString: t}
 	default:
 		return nil, ErrSessionParamInvalid.Wrapf("unexpected param value type: %T", asType)
 	}
@@ -40,6 +42,16 @@ func (msg *MsgUpdateParam) ValidateBasic() error {
 			return err
 		}
 		return ValidateNumSuppliersPerSession(msg.GetAsUint64())
+	case ParamSupplierSelectionMode:
+		if err := msg.paramTypeIsString(); err != nil {
+			return err
+		}
+		return ValidateSupplierSelectionMode(msg.GetAsString())
+	case ParamSupplierSelectionStakeCapUpokt:
+		if err := msg.paramTypeIsUint64(); err != nil {
+			return err
+		}
+		return ValidateSupplierSelectionStakeCapUpokt(msg.GetAsUint64())
 	default:
 		return ErrSessionParamInvalid.Wrapf("unsupported param %q", msg.Name)
 	}
@@ -54,3 +66,13 @@ func (msg *MsgUpdateParam) paramTypeIsUint64() error {
 	}
 	return nil
 }
+
+func (msg *MsgUpdateParam) paramTypeIsString() error {
+	if _, ok := msg.AsType.(*MsgUpdateParam_AsString); !ok {
+		return ErrSessionParamInvalid.Wrapf(
+			"invalid type for param %q expected %T, got %T",
+			msg.Name, &MsgUpdateParam_AsString{}, msg.AsType,
+		)
+	}
+	return nil
+}
@@ -38,6 +38,36 @@ func TestMsgUpdateParam_ValidateBasic(t *testing.T) {
 				Name:      ParamNumSuppliersPerSession,
 				AsType:    &MsgUpdateParam_AsUint64{AsUint64: DefaultNumSuppliersPerSession},
 			},
+		}, {
+			desc: "invalid: supplier selection mode type incorrect",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamSupplierSelectionMode,
+				AsType:    &MsgUpdateParam_AsUint64{AsUint64: 1},
+			},
+			expectedErr: ErrSessionParamInvalid,
+		}, {
+			desc: "invalid: unknown supplier selection mode",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamSupplierSelectionMode,
+				AsType:    &MsgUpdateParam_AsString{AsString: "stake_weighted_log"},
+			},
+			expectedErr: ErrSessionParamInvalid,
+		}, {
+			desc: "valid: supplier selection mode",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamSupplierSelectionMode,
+				AsType:    &MsgUpdateParam_AsString{AsString: SupplierSelectionModeStakeWeighted},
+			},
+		}, {
+			desc: "valid: supplier selection stake cap",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddressBech32(),
+				Name:      ParamSupplierSelectionStakeCapUpokt,
+				AsType:    &MsgUpdateParam_AsUint64{AsUint64: 1_000_000},
+			},
 		},
 	}
 
@@ -2,11 +2,29 @@ package types
 
 import paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+const (
+	// SupplierSelectionModeUniform selects every candidate supplier with the same probability.
+	SupplierSelectionModeUniform = "uniform"
+	// SupplierSelectionModeStakeWeighted selects candidate suppliers proportionally to their stake.
+	SupplierSelectionModeStakeWeighted = "stake_weighted"
+	// SupplierSelectionModeStakeWeightedSqrt selects candidate suppliers proportionally
+	// to the square root of their stake, dampening the advantage of large stakes.
+	SupplierSelectionModeStakeWeightedSqrt = "stake_weighted_sqrt"
+)
+
 var (
 	KeyNumSuppliersPerSession            = []byte("NumSuppliersPerSession")
 	ParamNumSuppliersPerSession          = "num_suppliers_per_session"
 	DefaultNumSuppliersPerSession uint64 = 15
 
+	KeySupplierSelectionMode     = []byte("SupplierSelectionMode")
+	ParamSupplierSelectionMode   = "supplier_selection_mode"
+	DefaultSupplierSelectionMode = SupplierSelectionModeUniform
+
+	KeySupplierSelectionStakeCapUpokt            = []byte("SupplierSelectionStakeCapUpokt")
+	ParamSupplierSelectionStakeCapUpokt          = "supplier_selection_stake_cap_upokt"
+	DefaultSupplierSelectionStakeCapUpokt uint64 = 0 // No cap
+
 	_ paramtypes.ParamSet = (*Params)(nil)
 )
 
@@ -16,15 +34,25 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(numSuppliersPerSession uint64) Params {
+func NewParams(
+	numSuppliersPerSession uint64,
+	supplierSelectionMode string,
+	supplierSelectionStakeCapUpokt uint64,
+) Params {
 	return Params{
-		NumSuppliersPerSession: numSuppliersPerSession,
+		NumSuppliersPerSession:         numSuppliersPerSession,
+		SupplierSelectionMode:          supplierSelectionMode,
+		SupplierSelectionStakeCapUpokt: supplierSelectionStakeCapUpokt,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultNumSuppliersPerSession)
+	return NewParams(
+		DefaultNumSuppliersPerSession,
+		DefaultSupplierSelectionMode,
+		DefaultSupplierSelectionStakeCapUpokt,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -35,6 +63,16 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.NumSuppliersPerSession,
 			ValidateNumSuppliersPerSession,
 		),
+		paramtypes.NewParamSetPair(
+			KeySupplierSelectionMode,
+			&p.SupplierSelectionMode,
+			ValidateSupplierSelectionMode,
+		),
+		paramtypes.NewParamSetPair(
+			KeySupplierSelectionStakeCapUpokt,
+			&p.SupplierSelectionStakeCapUpokt,
+			ValidateSupplierSelectionStakeCapUpokt,
+		),
 	}
 }
 
@@ -44,6 +82,14 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateSupplierSelectionMode(p.SupplierSelectionMode); err != nil {
+		return err
+	}
+
+	if err := ValidateSupplierSelectionStakeCapUpokt(p.SupplierSelectionStakeCapUpokt); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -60,3 +106,38 @@ func ValidateNumSuppliersPerSession(numSuppliersPerSessionAny any) error {
 
 	return nil
 }
+
+// ValidateSupplierSelectionMode validates the SupplierSelectionMode param.
+// An empty mode is valid for backwards compatibility and equivalent to the uniform mode.
+func ValidateSupplierSelectionMode(supplierSelectionModeAny any) error {
+	supplierSelectionMode, ok := supplierSelectionModeAny.(string)
+	if !ok {
+		return ErrSessionParamInvalid.Wrapf("invalid parameter type: %T", supplierSelectionModeAny)
+	}
+
+	switch supplierSelectionMode {
+	case "",
+		SupplierSelectionModeUniform,
+		SupplierSelectionModeStakeWeighted,
+		SupplierSelectionModeStakeWeightedSqrt:
+		return nil
+	default:
+		return ErrSessionParamInvalid.Wrapf(
+			"supplier selection mode %q MUST be one of %q, %q or %q",
+			supplierSelectionMode,
+			SupplierSelectionModeUniform,
+			SupplierSelectionModeStakeWeighted,
+			SupplierSelectionModeStakeWeightedSqrt,
+		)
+	}
+}
+
+// ValidateSupplierSelectionStakeCapUpokt validates the SupplierSelectionStakeCapUpokt param.
+// Any value is valid, 0 meaning that the stake is not capped.
+func ValidateSupplierSelectionStakeCapUpokt(supplierSelectionStakeCapUpoktAny any) error {
+	if _, ok := supplierSelectionStakeCapUpoktAny.(uint64); !ok {
+		return ErrSessionParamInvalid.Wrapf("invalid parameter type: %T", supplierSelectionStakeCapUpoktAny)
+	}
+
+	return nil
+}
@@ -29,6 +29,17 @@ type Params struct {
 	// num_suppliers_per_session is the maximum number of suppliers per session
 	// (application:supplier pair for a given session number).
 	NumSuppliersPerSession uint64 `protobuf:"varint,3,opt,name=num_suppliers_per_session,json=numSuppliersPerSession,proto3" json:"num_suppliers_per_session" yaml:"num_suppliers_per_session"`
+	// supplier_selection_mode is the strategy used to select the session suppliers
+	// when there are more candidates than num_suppliers_per_session:
+	// - "uniform": every candidate is equally likely to be selected (default).
+	// - "stake_weighted": candidates are selected proportionally to their stake.
+	// - "stake_weighted_sqrt": candidates are selected proportionally to the square root of their stake.
+	// An empty value, as found in params recorded before its introduction, is equivalent to "uniform".
+	SupplierSelectionMode string `protobuf:"bytes,4,opt,name=supplier_selection_mode,json=supplierSelectionMode,proto3" json:"supplier_selection_mode" yaml:"supplier_selection_mode"`
+	// supplier_selection_stake_cap_upokt is the maximum stake, in uPOKT, accounted
+	// for by the stake weighted supplier selection modes. Any stake above it does
+	// not increase the selection probability. 0 means no cap.
+	SupplierSelectionStakeCapUpokt uint64 `protobuf:"varint,5,opt,name=supplier_selection_stake_cap_upokt,json=supplierSelectionStakeCapUpokt,proto3" json:"supplier_selection_stake_cap_upokt" yaml:"supplier_selection_stake_cap_upokt"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,6 +78,20 @@ func (m *Params) GetNumSuppliersPerSession() uint64 {
 	return 0
 }
 
+func (m *Params) GetSupplierSelectionMode() string {
+	if m != nil {
+		return m.SupplierSelectionMode
+	}
+	return ""
+}
+
+func (m *Params) GetSupplierSelectionStakeCapUpokt() uint64 {
+	if m != nil {
+		return m.SupplierSelectionStakeCapUpokt
+	}
+	return 0
+}
+
 // ParamsUpdate stores a snapshot of session parameters
 // along with the height at which they became effective.
 // This enables historical parameter lookups for session calculations.
@@ -129,28 +154,35 @@ func init() {
 func init() { proto.RegisterFile("pocket/session/params.proto", fileDescriptor_867b17065b766436) }
 
 var fileDescriptor_867b17065b766436 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0xc8, 0x4f, 0xce,
-	0x4e, 0x2d, 0xd1, 0x2f, 0x4e, 0x2d, 0x2e, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x48, 0xea, 0x41, 0x25, 0xa5, 0x04,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e,
-	0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x56, 0x30, 0x72, 0xb1, 0x05, 0x80, 0x4d, 0x12, 0xaa,
-	0xe1, 0x92, 0xcc, 0x2b, 0xcd, 0x8d, 0x2f, 0x2e, 0x2d, 0x28, 0xc8, 0xc9, 0x4c, 0x2d, 0x2a, 0x8e,
-	0x2f, 0x48, 0x2d, 0x8a, 0x87, 0x1a, 0x28, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0xe2, 0xe4, 0xf8, 0xea,
-	0x9e, 0x3c, 0x6e, 0x45, 0x9f, 0xee, 0xc9, 0x2b, 0x54, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xe1, 0x54,
-	0xa2, 0x14, 0x24, 0x96, 0x57, 0x9a, 0x1b, 0x0c, 0x93, 0x0a, 0x48, 0x2d, 0x0a, 0x86, 0x48, 0x58,
-	0x29, 0xbc, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x38, 0xd4, 0x9f, 0x15, 0x70, 0x9f,
-	0x42, 0xdc, 0xa7, 0xd4, 0xcd, 0xc8, 0xc5, 0x03, 0x61, 0x86, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0x0a,
-	0xd9, 0x73, 0x09, 0xa4, 0xa6, 0xa5, 0xa5, 0x26, 0x97, 0x64, 0x96, 0xa5, 0xc6, 0x67, 0xa4, 0x66,
-	0xa6, 0x67, 0x94, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x3b, 0x89, 0xbc, 0xba, 0x27, 0x8f, 0x21,
-	0x17, 0xc4, 0x0f, 0x17, 0xf1, 0x00, 0x0b, 0x08, 0x59, 0x71, 0xb1, 0x41, 0x42, 0x51, 0x82, 0x49,
-	0x81, 0x51, 0x83, 0xdb, 0x48, 0x4c, 0x0f, 0x35, 0x18, 0xf5, 0x20, 0xd6, 0x39, 0x71, 0xbd, 0xba,
-	0x27, 0x0f, 0x55, 0x19, 0x04, 0xa5, 0x9d, 0xfc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc6, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x83, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0xfd, 0x82, 0xfc, 0xec, 0x12, 0xdd, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x30,
-	0xa7, 0x28, 0x3f, 0x27, 0x07, 0xc9, 0x7b, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xf8,
-	0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x0e, 0xa5, 0xf5, 0xe7, 0x01, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x6b, 0xd4, 0x40,
+	0x18, 0xc7, 0x77, 0xdc, 0xba, 0xe0, 0x28, 0xbe, 0x84, 0xda, 0xc6, 0x0a, 0x33, 0x21, 0xa7, 0x55,
+	0x30, 0x11, 0xbd, 0x2d, 0x88, 0x18, 0x2f, 0x5e, 0x94, 0x92, 0xd0, 0x8b, 0x97, 0x30, 0xdd, 0x7d,
+	0xba, 0x1b, 0x36, 0xc9, 0x0c, 0x99, 0x89, 0x5a, 0xf0, 0x13, 0xe8, 0xc5, 0x6f, 0xa0, 0x1f, 0xc1,
+	0xaf, 0xe0, 0xcd, 0x63, 0x8f, 0x3d, 0x0d, 0xb2, 0x7b, 0x50, 0x72, 0xdc, 0x4f, 0x20, 0xc9, 0x24,
+	0x45, 0xad, 0x81, 0x5e, 0x92, 0x27, 0xcf, 0xff, 0xf7, 0xbc, 0x91, 0x3f, 0xbe, 0x2b, 0xf8, 0x74,
+	0x09, 0xca, 0x97, 0x20, 0x65, 0xc2, 0x73, 0x5f, 0xb0, 0x82, 0x65, 0xd2, 0x13, 0x05, 0x57, 0xdc,
+	0xba, 0x6e, 0x44, 0xaf, 0x15, 0xf7, 0x6e, 0xb1, 0x2c, 0xc9, 0xb9, 0xdf, 0x3c, 0x0d, 0xb2, 0xb7,
+	0x3d, 0xe7, 0x73, 0xde, 0x84, 0x7e, 0x1d, 0x99, 0xac, 0xfb, 0x6d, 0x88, 0x47, 0xfb, 0x4d, 0x27,
+	0xeb, 0x3d, 0xbe, 0x93, 0x97, 0x59, 0x2c, 0x4b, 0x21, 0xd2, 0x04, 0x0a, 0x19, 0x0b, 0x28, 0xe2,
+	0xb6, 0xa1, 0x3d, 0x74, 0xd0, 0x78, 0x2b, 0x78, 0x56, 0x69, 0xda, 0x0f, 0x6d, 0x34, 0x75, 0x8e,
+	0x59, 0x96, 0x4e, 0xdc, 0x5e, 0xc4, 0x0d, 0x77, 0xf2, 0x32, 0x8b, 0x3a, 0x69, 0x1f, 0x8a, 0xc8,
+	0x08, 0x56, 0x89, 0x77, 0xbb, 0x8a, 0x58, 0x42, 0x0a, 0x53, 0x95, 0xf0, 0x3c, 0xce, 0xf8, 0x0c,
+	0xec, 0x2d, 0x07, 0x8d, 0xaf, 0x04, 0x4f, 0x2a, 0x4d, 0xfb, 0x90, 0x8d, 0xa6, 0xc4, 0x4c, 0xee,
+	0x01, 0xdc, 0xf0, 0x76, 0xa7, 0x44, 0x9d, 0xf0, 0x92, 0xcf, 0xc0, 0xfa, 0x8c, 0xf0, 0xff, 0x6a,
+	0xa4, 0x62, 0x4b, 0x88, 0xa7, 0x4c, 0xc4, 0xa5, 0xe0, 0x4b, 0x65, 0x5f, 0x6e, 0xce, 0x8f, 0x2a,
+	0x4d, 0x2f, 0x40, 0x6f, 0x34, 0xbd, 0xd7, 0xbb, 0xcd, 0x3f, 0xac, 0x1b, 0x92, 0x73, 0x8b, 0x45,
+	0x35, 0xf2, 0x9c, 0x89, 0x83, 0x1a, 0x98, 0x38, 0xbf, 0xbe, 0x50, 0xf4, 0xe1, 0xe7, 0xd7, 0xfb,
+	0xbb, 0xad, 0x01, 0xde, 0x9d, 0x59, 0xc0, 0xfc, 0x38, 0xf7, 0x23, 0xc2, 0xd7, 0x4c, 0x78, 0x20,
+	0x66, 0x4c, 0x81, 0xf5, 0x14, 0xdf, 0x84, 0xa3, 0xa3, 0xba, 0xd9, 0x1b, 0x88, 0x17, 0x90, 0xcc,
+	0x17, 0xca, 0x46, 0x0e, 0x1a, 0x0f, 0x83, 0xed, 0x4a, 0xd3, 0x73, 0x5a, 0x78, 0xe3, 0x2c, 0xf3,
+	0xa2, 0x49, 0x58, 0x13, 0x3c, 0x32, 0xf6, 0xb2, 0x2f, 0x39, 0x68, 0x7c, 0xf5, 0xd1, 0x8e, 0xf7,
+	0xb7, 0xbf, 0x3c, 0x33, 0x2e, 0xc0, 0x95, 0xa6, 0x2d, 0x19, 0xb6, 0xef, 0xe0, 0xd5, 0xf7, 0x15,
+	0x41, 0x27, 0x2b, 0x82, 0x4e, 0x57, 0x04, 0xfd, 0x58, 0x11, 0xf4, 0x69, 0x4d, 0x06, 0x27, 0x6b,
+	0x32, 0x38, 0x5d, 0x93, 0xc1, 0xeb, 0x87, 0xf3, 0x44, 0x2d, 0xca, 0x43, 0x6f, 0xca, 0x33, 0xbf,
+	0x3e, 0xef, 0x41, 0x0e, 0xea, 0x2d, 0x2f, 0x96, 0xcd, 0x47, 0xc1, 0xd3, 0xf4, 0x8f, 0xf3, 0xd4,
+	0xb1, 0x00, 0x79, 0x38, 0x6a, 0x8c, 0xfa, 0xf8, 0xf7, 0x00, 0xca, 0x13, 0xb9, 0x89, 0x00, 0x03,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -175,6 +207,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.NumSuppliersPerSession != that1.NumSuppliersPerSession {
 		return false
 	}
+	if this.SupplierSelectionMode != that1.SupplierSelectionMode {
+		return false
+	}
+	if this.SupplierSelectionStakeCapUpokt != that1.SupplierSelectionStakeCapUpokt {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -197,6 +235,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SupplierSelectionStakeCapUpokt != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SupplierSelectionStakeCapUpokt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SupplierSelectionMode) > 0 {
+		i -= len(m.SupplierSelectionMode)
+		copy(dAtA[i:], m.SupplierSelectionMode)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SupplierSelectionMode)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.NumSuppliersPerSession != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.NumSuppliersPerSession))
 		i--
@@ -265,6 +315,13 @@ func (m *Params) Size() (n int) {
 	if m.NumSuppliersPerSession != 0 {
 		n += 1 + sovParams(uint64(m.NumSuppliersPerSession))
 	}
+	l = len(m.SupplierSelectionMode)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.SupplierSelectionStakeCapUpokt != 0 {
+		n += 1 + sovParams(uint64(m.SupplierSelectionStakeCapUpokt))
+	}
 	return n
 }
 
@@ -338,6 +395,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierSelectionMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierSelectionMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierSelectionStakeCapUpokt", wireType)
+			}
+			m.SupplierSelectionStakeCapUpokt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplierSelectionStakeCapUpokt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -123,6 +123,7 @@ type MsgUpdateParam struct {
 	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Types that are valid to be assigned to AsType:
 	//	*MsgUpdateParam_AsUint64
+	//	*MsgUpdateParam_AsString
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"as_type"`
 }
 
@@ -164,8 +165,12 @@ type isMsgUpdateParam_AsType interface {
 type MsgUpdateParam_AsUint64 struct {
 	AsUint64 uint64 `protobuf:"varint,3,opt,name=as_uint64,json=asUint64,proto3,oneof" json:"as_uint64"`
 }
+type MsgUpdateParam_AsString struct {
+	AsString string `protobuf:"bytes,4,opt,name=as_string,json=asString,proto3,oneof" json:"as_string"`
+}
 
 func (*MsgUpdateParam_AsUint64) isMsgUpdateParam_AsType() {}
+func (*MsgUpdateParam_AsString) isMsgUpdateParam_AsType() {}
 
 func (m *MsgUpdateParam) GetAsType() isMsgUpdateParam_AsType {
 	if m != nil {
@@ -195,10 +200,18 @@ func (m *MsgUpdateParam) GetAsUint64() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateParam) GetAsString() string {
+	if x, ok := m.GetAsType().(*MsgUpdateParam_AsString); ok {
+		return x.AsString
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgUpdateParam) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MsgUpdateParam_AsUint64)(nil),
+		(*MsgUpdateParam_AsString)(nil),
 	}
 }
 
@@ -244,36 +257,37 @@ func init() {
 func init() { proto.RegisterFile("pocket/session/tx.proto", fileDescriptor_9b2e75af3bbfb183) }
 
 var fileDescriptor_9b2e75af3bbfb183 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2f, 0xc8, 0x4f, 0xce,
-	0x4e, 0x2d, 0xd1, 0x2f, 0x4e, 0x2d, 0x2e, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0xa9, 0xd0, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x48, 0xe8, 0x41, 0x25, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3,
-	0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x78, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x7e, 0x6e,
-	0x71, 0xba, 0x7e, 0x99, 0x21, 0x88, 0x82, 0x4a, 0x48, 0x42, 0x24, 0xe2, 0xc1, 0x3c, 0x7d, 0x08,
-	0x07, 0x2a, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x11, 0x07, 0xb1, 0xa0, 0xa2, 0xd2, 0x68, 0xae,
-	0x28, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x6a, 0x51, 0x3a, 0xcc, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e,
-	0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x00, 0x96, 0x11, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9,
-	0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45,
-	0x57, 0x04, 0x6a, 0x97, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e,
-	0x7a, 0x10, 0x42, 0xa9, 0x90, 0x33, 0x17, 0x1b, 0xc4, 0x6c, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e,
-	0x23, 0x31, 0x3d, 0x54, 0x6f, 0xea, 0x41, 0xcc, 0x77, 0x12, 0x3e, 0x71, 0x4f, 0x9e, 0xe1, 0xd5,
-	0x3d, 0x79, 0xa8, 0xea, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0x39, 0x56, 0xc6, 0x4d, 0xcf,
-	0x37, 0x68, 0x21, 0x0c, 0xed, 0x7a, 0xbe, 0x41, 0x4b, 0x01, 0xea, 0x81, 0x0a, 0xb8, 0x17, 0xd0,
-	0x5c, 0xac, 0x24, 0xc9, 0x25, 0x8e, 0x26, 0x14, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0xaa,
-	0xb4, 0x94, 0x91, 0x8b, 0x0f, 0x55, 0x8e, 0x6c, 0xff, 0x09, 0x71, 0xb1, 0xe4, 0x25, 0xe6, 0xa6,
-	0x82, 0x7d, 0xc7, 0x19, 0x04, 0x66, 0x0b, 0xe9, 0x70, 0x71, 0x26, 0x16, 0xc7, 0x97, 0x66, 0xe6,
-	0x95, 0x98, 0x99, 0x48, 0x30, 0x2b, 0x30, 0x6a, 0xb0, 0x38, 0xf1, 0xbe, 0xba, 0x27, 0x8f, 0x10,
-	0xf4, 0x60, 0x08, 0xe2, 0x48, 0x2c, 0x0e, 0x05, 0xb3, 0xad, 0xf8, 0x50, 0x3d, 0xe7, 0xc4, 0xc9,
-	0xc5, 0x9e, 0x58, 0x1c, 0x5f, 0x52, 0x59, 0x90, 0xaa, 0x24, 0xc7, 0x25, 0x86, 0xea, 0x4c, 0x98,
-	0x0f, 0xbc, 0x58, 0x38, 0x18, 0x05, 0x98, 0x8c, 0xf6, 0x32, 0x72, 0x31, 0xfb, 0x16, 0xa7, 0x0b,
-	0x45, 0x70, 0xf1, 0xa0, 0x44, 0x96, 0x3c, 0x7a, 0x20, 0xa3, 0x05, 0x84, 0x94, 0x3a, 0x01, 0x05,
-	0x30, 0x7b, 0x84, 0x42, 0xb9, 0xb8, 0x91, 0x43, 0x49, 0x0e, 0xbf, 0x3e, 0x29, 0x35, 0xfc, 0xf2,
-	0x30, 0x63, 0xa5, 0x58, 0x1b, 0x40, 0xf1, 0xeb, 0xe4, 0x77, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x37, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
-	0x25, 0xe7, 0xe7, 0xea, 0x17, 0xe4, 0x67, 0x97, 0xe8, 0xe6, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65,
-	0x83, 0x39, 0x45, 0xf9, 0x39, 0x39, 0x48, 0x91, 0x0f, 0x0a, 0xae, 0xe2, 0x24, 0x36, 0x70, 0xfa,
-	0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x47, 0x25, 0x2e, 0x65, 0x64, 0x03, 0x00, 0x00,
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0xb5, 0xa1, 0xd4, 0x57, 0x08, 0x60, 0xaa, 0xc6, 0x35, 0xd2, 0x25, 0xca, 0x00, 0x51,
+	0x44, 0x6d, 0x68, 0x51, 0x87, 0x6e, 0x98, 0x05, 0x21, 0x15, 0x21, 0xa3, 0x48, 0x88, 0x25, 0xba,
+	0x26, 0x27, 0xd7, 0x4a, 0xed, 0xb3, 0xfc, 0x2e, 0xd0, 0x6e, 0x88, 0x91, 0x89, 0x9f, 0xc1, 0x98,
+	0x81, 0x95, 0x89, 0xa5, 0x63, 0x05, 0x4b, 0xa7, 0x08, 0x25, 0x43, 0xa4, 0xfe, 0x0a, 0x94, 0xbb,
+	0x33, 0xa9, 0x3d, 0x14, 0xa9, 0x8b, 0xfd, 0xde, 0xfb, 0xbe, 0xfb, 0xde, 0x7d, 0xef, 0x1e, 0xae,
+	0xa5, 0xbc, 0x37, 0x60, 0xc2, 0x03, 0x06, 0x10, 0xf1, 0xc4, 0x13, 0xc7, 0x6e, 0x9a, 0x71, 0xc1,
+	0xad, 0xaa, 0x02, 0x5c, 0x0d, 0x38, 0xf7, 0x68, 0x1c, 0x25, 0xdc, 0x93, 0x5f, 0x45, 0x71, 0x6a,
+	0x3d, 0x0e, 0x31, 0x07, 0x2f, 0x86, 0xd0, 0xfb, 0xf0, 0x74, 0xfe, 0xd3, 0xc0, 0xa6, 0x02, 0xba,
+	0x32, 0xf3, 0x54, 0xa2, 0xa1, 0xf5, 0x90, 0x87, 0x5c, 0xd5, 0xe7, 0x91, 0xae, 0x3e, 0x28, 0xdd,
+	0x22, 0xa5, 0x19, 0x8d, 0xf5, 0x91, 0xe6, 0x4f, 0x84, 0xef, 0xec, 0x43, 0xd8, 0x49, 0xfb, 0x54,
+	0xb0, 0x37, 0x12, 0xb1, 0x76, 0xb1, 0x49, 0x87, 0xe2, 0x90, 0x67, 0x91, 0x38, 0xb1, 0x51, 0x03,
+	0xb5, 0x4c, 0xdf, 0xfe, 0xf5, 0x7d, 0x6b, 0x5d, 0xf7, 0x7a, 0xde, 0xef, 0x67, 0x0c, 0xe0, 0xad,
+	0xc8, 0xa2, 0x24, 0x0c, 0x16, 0x54, 0xeb, 0x05, 0x5e, 0x51, 0xda, 0xf6, 0x52, 0x03, 0xb5, 0xd6,
+	0xb6, 0x37, 0xdc, 0xa2, 0x4d, 0x57, 0xe9, 0xfb, 0xf7, 0x4f, 0xc7, 0x75, 0xe3, 0x62, 0x5c, 0xd7,
+	0xec, 0x6f, 0xb3, 0x51, 0x1b, 0x05, 0x3a, 0xd9, 0xdb, 0xf9, 0x3c, 0x1b, 0xb5, 0x17, 0xa2, 0x5f,
+	0x66, 0xa3, 0x76, 0x43, 0x1b, 0x38, 0xfe, 0x67, 0xa1, 0x74, 0xe3, 0xe6, 0x26, 0xae, 0x95, 0x4a,
+	0x01, 0x83, 0x94, 0x27, 0xc0, 0x9a, 0xbf, 0x11, 0xae, 0x16, 0xb1, 0x6b, 0xfb, 0xb3, 0x70, 0x25,
+	0xa1, 0x31, 0x93, 0xee, 0xcc, 0x40, 0xc6, 0xd6, 0x63, 0x6c, 0x52, 0xe8, 0x0e, 0xa3, 0x44, 0xec,
+	0x3e, 0xb3, 0x97, 0x1b, 0xa8, 0x55, 0xf1, 0x6f, 0x5f, 0x8c, 0xeb, 0x8b, 0xe2, 0x4b, 0x23, 0x58,
+	0xa5, 0xd0, 0x91, 0xb1, 0x66, 0x83, 0x54, 0xb6, 0x2b, 0xb2, 0x73, 0xce, 0x56, 0x45, 0xc5, 0x56,
+	0xad, 0xf7, 0xaa, 0xc5, 0x51, 0xf8, 0x26, 0xbe, 0x49, 0xa1, 0x2b, 0x4e, 0x52, 0xd6, 0x24, 0x78,
+	0xa3, 0x68, 0x2a, 0xf7, 0xfb, 0xaa, 0xb2, 0x8a, 0xee, 0x2e, 0x6d, 0xff, 0x40, 0x78, 0x79, 0x1f,
+	0x42, 0xeb, 0x1d, 0xbe, 0x55, 0x78, 0xda, 0x7a, 0xf9, 0x49, 0x4a, 0x63, 0x73, 0x1e, 0xfd, 0x87,
+	0x90, 0xf7, 0xb1, 0x3a, 0x78, 0xed, 0xf2, 0x4c, 0xc9, 0xd5, 0xe7, 0x9c, 0x87, 0x57, 0xe3, 0xb9,
+	0xac, 0x73, 0xe3, 0xd3, 0x7c, 0x1b, 0xfc, 0xd7, 0xa7, 0x13, 0x82, 0xce, 0x26, 0x04, 0x9d, 0x4f,
+	0x08, 0xfa, 0x33, 0x21, 0xe8, 0xeb, 0x94, 0x18, 0x67, 0x53, 0x62, 0x9c, 0x4f, 0x89, 0xf1, 0xfe,
+	0x49, 0x18, 0x89, 0xc3, 0xe1, 0x81, 0xdb, 0xe3, 0xb1, 0x97, 0xf2, 0x81, 0xd8, 0x4a, 0x98, 0xf8,
+	0xc8, 0xb3, 0x81, 0x4c, 0x32, 0x7e, 0x74, 0x74, 0x69, 0x55, 0xe6, 0xe3, 0x82, 0x83, 0x15, 0xb9,
+	0xed, 0x3b, 0x7f, 0x07, 0x00, 0x38, 0xc2, 0x31, 0xb9, 0x92, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -518,6 +532,20 @@ func (m *MsgUpdateParam_AsUint64) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x18
 	return len(dAtA) - i, nil
 }
+func (m *MsgUpdateParam_AsString) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParam_AsString) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.AsString)
+	copy(dAtA[i:], m.AsString)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.AsString)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
 func (m *MsgUpdateParamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -605,6 +633,16 @@ func (m *MsgUpdateParam_AsUint64) Size() (n int) {
 	n += 1 + sovTx(uint64(m.AsUint64))
 	return n
 }
+func (m *MsgUpdateParam_AsString) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AsString)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
 func (m *MsgUpdateParamResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -898,6 +936,38 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AsType = &MsgUpdateParam_AsUint64{v}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsType = &MsgUpdateParam_AsString{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -94,7 +94,7 @@ func (ConfigOptions) EnumDescriptor() ([]byte, []int) {
 
 // Service message to encapsulate unique and semantic identifiers for a service on the network
 //
-// Next free index: 6
+// Next free index: 7
 type Service struct {
 	// For example, what if we want to request a session for a certain service but with some additional configs that identify it?
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -112,6 +112,12 @@ type Service struct {
 	// Optional metadata carrying the service's card (see docs/pocket_service_card.md).
 	// When exposed via JSON, the card is base64 encoded and MUST be <= 256 KiB when decoded.
 	Metadata *Metadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// (Optional) The target number of relays per session the relay mining difficulty of
+	// this service is adjusted to, overriding the service module target_num_relays param.
+	// It is set by the service owner, within the governance bounds of the service module
+	// params (i.e. min_service_target_num_relays and max_service_target_num_relays).
+	// 0 means the service uses the target_num_relays param.
+	TargetNumRelays uint64 `protobuf:"varint,6,opt,name=target_num_relays,json=targetNumRelays,proto3" json:"target_num_relays,omitempty"`
 }
 
 func (m *Service) Reset()         { *m = Service{} }
@@ -178,6 +184,13 @@ func (m *Service) GetMetadata() *Metadata {
 	return nil
 }
 
+func (m *Service) GetTargetNumRelays() uint64 {
+	if m != nil {
+		return m.TargetNumRelays
+	}
+	return 0
+}
+
 // ApplicationServiceConfig holds the service configuration the application stakes for
 type ApplicationServiceConfig struct {
 	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
@@ -503,51 +516,52 @@ func init() {
 func init() { proto.RegisterFile("pocket/shared/service.proto", fileDescriptor_4dfdeb4ae793ca69) }
 
 var fileDescriptor_4dfdeb4ae793ca69 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
-	0x14, 0x66, 0x80, 0x5c, 0xe0, 0xf0, 0x73, 0xad, 0xb9, 0xdc, 0xc4, 0x37, 0xb9, 0x97, 0x8b, 0xbc,
-	0x42, 0x91, 0x02, 0x29, 0x51, 0x2a, 0x75, 0x11, 0x55, 0x01, 0x91, 0x28, 0x89, 0xf8, 0xd1, 0xe0,
-	0x28, 0x52, 0x36, 0x96, 0x63, 0x4f, 0x89, 0x05, 0x78, 0x46, 0x63, 0x43, 0xc2, 0xb2, 0x2f, 0x50,
-	0xf5, 0x61, 0xaa, 0x3e, 0x43, 0x97, 0x51, 0x57, 0x59, 0x56, 0xe4, 0x45, 0xaa, 0xf1, 0x0f, 0x6d,
-	0x50, 0xd5, 0xaa, 0xbb, 0x33, 0xe7, 0xfb, 0xce, 0x9c, 0xef, 0x1c, 0x7f, 0x1e, 0xd8, 0xe1, 0xcc,
-	0x1a, 0x53, 0xbf, 0xe1, 0xdd, 0x9a, 0x82, 0xda, 0x0d, 0x8f, 0x8a, 0xb9, 0x63, 0xd1, 0x3a, 0x17,
-	0xcc, 0x67, 0xb8, 0x18, 0x82, 0xf5, 0x10, 0xdc, 0xfe, 0xc7, 0x62, 0xde, 0x94, 0x79, 0x46, 0x00,
-	0x36, 0xc2, 0x43, 0xc8, 0xdc, 0x2e, 0x8f, 0xd8, 0x88, 0x85, 0x79, 0x19, 0x85, 0x59, 0xed, 0x11,
-	0x41, 0x66, 0x18, 0xde, 0x88, 0x4b, 0x90, 0x74, 0x6c, 0x15, 0x55, 0x51, 0x2d, 0x47, 0x92, 0x8e,
-	0x8d, 0x31, 0xa4, 0x5d, 0x73, 0x4a, 0xd5, 0x64, 0x90, 0x09, 0x62, 0x7c, 0x08, 0x5b, 0x16, 0x9b,
-	0xf2, 0x99, 0x4f, 0x8d, 0x99, 0xeb, 0xf8, 0x9e, 0xc1, 0xa9, 0x30, 0x04, 0x9d, 0x98, 0x0b, 0x35,
-	0x55, 0x45, 0xb5, 0x34, 0x29, 0x47, 0xf0, 0xa5, 0x44, 0x07, 0x54, 0x10, 0x89, 0xe1, 0x23, 0x28,
-	0xb2, 0x3b, 0x97, 0x0a, 0xc3, 0xb4, 0x6d, 0x41, 0x3d, 0x4f, 0x4d, 0xcb, 0x3b, 0x5b, 0xea, 0xe7,
-	0x0f, 0x7b, 0xe5, 0x48, 0xe5, 0x71, 0x88, 0x0c, 0x7d, 0xe1, 0xb8, 0x23, 0x52, 0x08, 0xe8, 0x51,
-	0x0e, 0x1f, 0x40, 0x76, 0x4a, 0x7d, 0xd3, 0x36, 0x7d, 0x53, 0xdd, 0xa8, 0xa2, 0x5a, 0xbe, 0xb9,
-	0x55, 0x7f, 0x36, 0x78, 0xbd, 0x1b, 0xc1, 0x64, 0x45, 0xd4, 0x5e, 0x81, 0x7a, 0xcc, 0xf9, 0xc4,
-	0xb1, 0x4c, 0xdf, 0x61, 0x6e, 0x34, 0x64, 0x9b, 0xb9, 0x6f, 0x9c, 0x11, 0xfe, 0x0f, 0x20, 0xda,
-	0xa3, 0xb1, 0x1a, 0x39, 0x17, 0x65, 0xce, 0x6c, 0xed, 0x23, 0x82, 0xbf, 0x87, 0x33, 0x59, 0x4c,
-	0xc5, 0xef, 0x14, 0xe2, 0x23, 0xc8, 0x51, 0xd7, 0xe6, 0xcc, 0x71, 0x7d, 0x4f, 0x4d, 0x56, 0x53,
-	0xb5, 0x7c, 0xf3, 0xff, 0x35, 0xa5, 0xf1, 0xbd, 0x9d, 0x88, 0x47, 0xbe, 0x55, 0xe0, 0xd7, 0x90,
-	0x13, 0x74, 0x6e, 0x04, 0x4c, 0x35, 0x15, 0x94, 0x6b, 0xeb, 0xe5, 0x61, 0x2f, 0x42, 0xe7, 0xd4,
-	0x9d, 0xd1, 0xa1, 0x4c, 0x92, 0xac, 0xa0, 0xf3, 0x20, 0xd2, 0xde, 0x21, 0x50, 0xd6, 0x1b, 0x60,
-	0x05, 0x52, 0x33, 0x31, 0x89, 0xc4, 0xca, 0x10, 0xbf, 0x80, 0xac, 0xe0, 0x96, 0xe1, 0x2f, 0x78,
-	0xf8, 0x75, 0x4b, 0xcd, 0xcd, 0xb5, 0x36, 0x64, 0xd0, 0xd6, 0x17, 0x9c, 0x92, 0x8c, 0xe0, 0x96,
-	0x0c, 0xf0, 0x21, 0x64, 0xac, 0x60, 0x05, 0x5e, 0x24, 0x6c, 0x67, 0xad, 0x22, 0x5c, 0x50, 0x9f,
-	0xcb, 0x65, 0x93, 0x98, 0xab, 0xbd, 0x45, 0xf0, 0xd7, 0x0f, 0x24, 0xe3, 0x26, 0x64, 0x62, 0x2b,
-	0xa0, 0x5f, 0x58, 0x21, 0x26, 0xe2, 0x7d, 0x28, 0xaf, 0xb6, 0x23, 0x7d, 0x67, 0x51, 0xd7, 0x37,
-	0x47, 0x34, 0x32, 0x1e, 0x8e, 0x97, 0x30, 0x58, 0x21, 0xe7, 0xe9, 0x6c, 0x52, 0x49, 0x69, 0x3a,
-	0x14, 0xbe, 0x17, 0x87, 0xeb, 0x90, 0x1a, 0xd3, 0x45, 0xd0, 0xb7, 0xd4, 0xfc, 0xf7, 0x27, 0x63,
-	0x78, 0x44, 0x12, 0x71, 0x19, 0x36, 0xe6, 0xe6, 0x64, 0x16, 0xff, 0x08, 0xe1, 0x41, 0x7b, 0x09,
-	0xd9, 0xd8, 0x74, 0xf2, 0x4f, 0xb1, 0x4c, 0x11, 0xfa, 0xa1, 0x40, 0x82, 0x98, 0x6c, 0xd2, 0x7b,
-	0x4e, 0x85, 0x33, 0x95, 0x52, 0x26, 0x86, 0xc9, 0x1d, 0xc3, 0xe3, 0xd4, 0xf2, 0x76, 0xaf, 0x21,
-	0x13, 0x2d, 0x17, 0xff, 0x09, 0xf9, 0xcb, 0xde, 0x45, 0xaf, 0x7f, 0xd5, 0x33, 0xc8, 0xa0, 0xad,
-	0x24, 0x70, 0x16, 0xd2, 0xa7, 0x32, 0x42, 0xb8, 0x08, 0xb9, 0xab, 0x4e, 0x6b, 0xd8, 0x6f, 0x5f,
-	0x74, 0x74, 0x25, 0x89, 0x0b, 0x90, 0x3d, 0x1f, 0xf6, 0x43, 0x5a, 0x4a, 0xd2, 0x48, 0x67, 0xa8,
-	0x2b, 0x69, 0x49, 0x6b, 0xf7, 0xbb, 0x1d, 0xdd, 0x68, 0x9d, 0xe8, 0xca, 0xc6, 0xee, 0x3e, 0x14,
-	0x9f, 0xe9, 0xc7, 0x18, 0x4a, 0x71, 0x87, 0x76, 0xbf, 0x77, 0x72, 0x76, 0xaa, 0x24, 0x70, 0x1e,
-	0x32, 0xfa, 0x59, 0xb7, 0xd3, 0xbf, 0xd4, 0x15, 0xd4, 0xea, 0x7e, 0x5a, 0x56, 0xd0, 0xc3, 0xb2,
-	0x82, 0x1e, 0x97, 0x15, 0xf4, 0x65, 0x59, 0x41, 0xef, 0x9f, 0x2a, 0x89, 0x87, 0xa7, 0x4a, 0xe2,
-	0xf1, 0xa9, 0x92, 0xb8, 0x6e, 0x8c, 0x1c, 0xff, 0x76, 0x76, 0x53, 0xb7, 0xd8, 0xb4, 0xc1, 0xd9,
-	0xd8, 0xdf, 0x73, 0xa9, 0x7f, 0xc7, 0xc4, 0x38, 0x38, 0x08, 0x36, 0x99, 0x34, 0xee, 0xe3, 0x67,
-	0x49, 0x7a, 0xc9, 0xbb, 0xf9, 0x23, 0x78, 0x55, 0x0e, 0xbe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xec,
-	0xb5, 0xd0, 0x89, 0xb4, 0x04, 0x00, 0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5d, 0x6b, 0xe3, 0x46,
+	0x14, 0xb5, 0x6c, 0x67, 0x6d, 0x5f, 0x7f, 0xac, 0x3a, 0x75, 0x77, 0xd5, 0xdd, 0xd6, 0x35, 0x7a,
+	0x32, 0x81, 0xb5, 0xb7, 0x5e, 0xb6, 0xd0, 0x87, 0x50, 0x62, 0xe3, 0x84, 0x24, 0xf8, 0x83, 0xb1,
+	0x42, 0x20, 0x2f, 0x42, 0x91, 0xa6, 0x8e, 0xb0, 0xa5, 0x19, 0x46, 0x23, 0x27, 0x7e, 0x2c, 0xf4,
+	0xb9, 0xf4, 0xc7, 0x94, 0xfe, 0x86, 0x3e, 0x86, 0x3e, 0xe5, 0xb1, 0x38, 0x7f, 0xa4, 0x8c, 0x46,
+	0x72, 0x1b, 0x53, 0x5a, 0xfa, 0x76, 0xe7, 0x9e, 0x73, 0xe7, 0x9e, 0x39, 0x1c, 0x09, 0xde, 0x32,
+	0xea, 0x2e, 0x89, 0xe8, 0x45, 0xb7, 0x0e, 0x27, 0x5e, 0x2f, 0x22, 0x7c, 0xed, 0xbb, 0xa4, 0xcb,
+	0x38, 0x15, 0x14, 0xd5, 0x15, 0xd8, 0x55, 0xe0, 0x9b, 0xcf, 0x5d, 0x1a, 0x05, 0x34, 0xb2, 0x13,
+	0xb0, 0xa7, 0x0e, 0x8a, 0xf9, 0xa6, 0xb9, 0xa0, 0x0b, 0xaa, 0xfa, 0xb2, 0x52, 0x5d, 0xf3, 0xc7,
+	0x3c, 0x94, 0xe6, 0xea, 0x46, 0xd4, 0x80, 0xbc, 0xef, 0x19, 0x5a, 0x5b, 0xeb, 0x54, 0x70, 0xde,
+	0xf7, 0x10, 0x82, 0x62, 0xe8, 0x04, 0xc4, 0xc8, 0x27, 0x9d, 0xa4, 0x46, 0x1f, 0xe1, 0xb5, 0x4b,
+	0x03, 0x16, 0x0b, 0x62, 0xc7, 0xa1, 0x2f, 0x22, 0x9b, 0x11, 0x6e, 0x73, 0xb2, 0x72, 0x36, 0x46,
+	0xa1, 0xad, 0x75, 0x8a, 0xb8, 0x99, 0xc2, 0x97, 0x12, 0x9d, 0x11, 0x8e, 0x25, 0x86, 0x8e, 0xa0,
+	0x4e, 0xef, 0x42, 0xc2, 0x6d, 0xc7, 0xf3, 0x38, 0x89, 0x22, 0xa3, 0x28, 0xef, 0x1c, 0x18, 0xbf,
+	0xff, 0xf2, 0xae, 0x99, 0xaa, 0x3c, 0x56, 0xc8, 0x5c, 0x70, 0x3f, 0x5c, 0xe0, 0x5a, 0x42, 0x4f,
+	0x7b, 0xe8, 0x03, 0x94, 0x03, 0x22, 0x1c, 0xcf, 0x11, 0x8e, 0x71, 0xd0, 0xd6, 0x3a, 0xd5, 0xfe,
+	0xeb, 0xee, 0xb3, 0x87, 0x77, 0xc7, 0x29, 0x8c, 0x77, 0x44, 0x74, 0x08, 0x9f, 0x08, 0x87, 0x2f,
+	0x88, 0xb0, 0xc3, 0x38, 0x50, 0x1a, 0x23, 0xe3, 0x45, 0x22, 0xf2, 0xa5, 0x02, 0x26, 0x71, 0x90,
+	0xc8, 0x8b, 0xcc, 0x6f, 0xc1, 0x38, 0x66, 0x6c, 0xe5, 0xbb, 0x8e, 0xf0, 0x69, 0x98, 0x1a, 0x32,
+	0xa4, 0xe1, 0xf7, 0xfe, 0x02, 0x7d, 0x09, 0x90, 0x7a, 0x6e, 0xef, 0xec, 0xa9, 0xa4, 0x9d, 0x33,
+	0xcf, 0xfc, 0x55, 0x83, 0xcf, 0xe6, 0xb1, 0x1c, 0x26, 0xfc, 0xff, 0x0c, 0xa2, 0x23, 0xa8, 0x90,
+	0xd0, 0x63, 0xd4, 0x0f, 0x45, 0x64, 0xe4, 0xdb, 0x85, 0x4e, 0xb5, 0xff, 0xd5, 0xde, 0xab, 0xb2,
+	0x7b, 0x47, 0x29, 0x0f, 0xff, 0x35, 0x81, 0xbe, 0x83, 0x0a, 0x27, 0x6b, 0x3b, 0x61, 0x1a, 0x85,
+	0x64, 0xdc, 0xdc, 0x1f, 0x57, 0xbb, 0x30, 0x59, 0x93, 0x30, 0x26, 0x73, 0xd9, 0xc4, 0x65, 0x4e,
+	0xd6, 0x49, 0x65, 0xfe, 0xa4, 0x81, 0xbe, 0xbf, 0x00, 0xe9, 0x50, 0x88, 0xf9, 0x2a, 0x15, 0x2b,
+	0x4b, 0xf4, 0x35, 0x94, 0x39, 0x73, 0x6d, 0xb1, 0x61, 0x2a, 0x09, 0x8d, 0xfe, 0xab, 0xbd, 0x35,
+	0x78, 0x36, 0xb4, 0x36, 0x8c, 0xe0, 0x12, 0x67, 0xae, 0x2c, 0xd0, 0x47, 0x28, 0xb9, 0x89, 0x05,
+	0x51, 0x2a, 0xec, 0xed, 0xde, 0x84, 0x32, 0x68, 0xca, 0xa4, 0xd9, 0x38, 0xe3, 0x9a, 0x3f, 0x68,
+	0xf0, 0xe9, 0x3f, 0x48, 0x46, 0x7d, 0x28, 0x65, 0xb1, 0xd1, 0xfe, 0x23, 0x36, 0x19, 0x11, 0xbd,
+	0x87, 0xe6, 0xce, 0x1d, 0x99, 0x51, 0x97, 0x84, 0xc2, 0x59, 0x90, 0x34, 0xa4, 0x28, 0x33, 0x61,
+	0xb6, 0x43, 0xce, 0x8b, 0xe5, 0xbc, 0x5e, 0x30, 0x2d, 0xa8, 0xfd, 0x5d, 0x1c, 0xea, 0x42, 0x61,
+	0x49, 0x36, 0xc9, 0xde, 0x46, 0xff, 0x8b, 0x7f, 0x79, 0x46, 0x84, 0x25, 0x11, 0x35, 0xe1, 0x60,
+	0xed, 0xac, 0xe2, 0xec, 0xa3, 0x51, 0x07, 0xf3, 0x1b, 0x28, 0x67, 0x01, 0x95, 0x5f, 0x95, 0xeb,
+	0x70, 0x95, 0x87, 0x1a, 0x4e, 0x6a, 0xfc, 0x8a, 0xdc, 0x33, 0xc2, 0xfd, 0x40, 0x4a, 0x59, 0xd9,
+	0x0e, 0xf3, 0xed, 0x88, 0x11, 0x37, 0x3a, 0xbc, 0x86, 0x52, 0x6a, 0x2e, 0x7a, 0x09, 0xd5, 0xcb,
+	0xc9, 0xc5, 0x64, 0x7a, 0x35, 0xb1, 0xf1, 0x6c, 0xa8, 0xe7, 0x50, 0x19, 0x8a, 0xa7, 0xb2, 0xd2,
+	0x50, 0x1d, 0x2a, 0x57, 0xa3, 0xc1, 0x7c, 0x3a, 0xbc, 0x18, 0x59, 0x7a, 0x1e, 0xd5, 0xa0, 0x7c,
+	0x3e, 0x9f, 0x2a, 0x5a, 0x41, 0xd2, 0xf0, 0x68, 0x6e, 0xe9, 0x45, 0x49, 0x1b, 0x4e, 0xc7, 0x23,
+	0xcb, 0x1e, 0x9c, 0x58, 0xfa, 0xc1, 0xe1, 0x7b, 0xa8, 0x3f, 0xd3, 0x8f, 0x10, 0x34, 0xb2, 0x0d,
+	0xc3, 0xe9, 0xe4, 0xe4, 0xec, 0x54, 0xcf, 0xa1, 0x2a, 0x94, 0xac, 0xb3, 0xf1, 0x68, 0x7a, 0x69,
+	0xe9, 0xda, 0x60, 0xfc, 0xdb, 0xb6, 0xa5, 0x3d, 0x6c, 0x5b, 0xda, 0xe3, 0xb6, 0xa5, 0xfd, 0xb1,
+	0x6d, 0x69, 0x3f, 0x3f, 0xb5, 0x72, 0x0f, 0x4f, 0xad, 0xdc, 0xe3, 0x53, 0x2b, 0x77, 0xdd, 0x5b,
+	0xf8, 0xe2, 0x36, 0xbe, 0xe9, 0xba, 0x34, 0xe8, 0x31, 0xba, 0x14, 0xef, 0x42, 0x22, 0xee, 0x28,
+	0x5f, 0x26, 0x07, 0x4e, 0x57, 0xab, 0xde, 0x7d, 0xf6, 0x0b, 0x93, 0x59, 0x8a, 0x6e, 0x5e, 0x24,
+	0x7f, 0xa0, 0x0f, 0x7f, 0x0e, 0x00, 0xa7, 0x18, 0xbf, 0xfd, 0xe0, 0x04, 0x00, 0x00,
 }
 
 func (m *Service) Marshal() (dAtA []byte, err error) {
@@ -570,6 +584,11 @@ func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TargetNumRelays != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TargetNumRelays))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Metadata != nil {
 		{
 			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
@@ -884,6 +903,9 @@ func (m *Service) Size() (n int) {
 		l = m.Metadata.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.TargetNumRelays != 0 {
+		n += 1 + sovService(uint64(m.TargetNumRelays))
+	}
 	return n
 }
 
@@ -1178,6 +1200,25 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNumRelays", wireType)
+			}
+			m.TargetNumRelays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetNumRelays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
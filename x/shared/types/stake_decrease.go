@@ -0,0 +1,142 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/app/pocket"
+)
+
+// AddPendingStakeDecrease returns the given pending stake decreases with the given
+// amount added to the decrease of the session ending at sessionEndHeight.
+// Decreases requested within the same session are merged into a single entry so
+// that the list stays ordered by session end height.
+func AddPendingStakeDecrease(
+	pendingStakeDecreases []*PendingStakeDecrease,
+	amount cosmostypes.Coin,
+	sessionEndHeight uint64,
+) []*PendingStakeDecrease {
+	for _, pendingStakeDecrease := range pendingStakeDecreases {
+		if pendingStakeDecrease.SessionEndHeight == sessionEndHeight {
+			mergedAmount := pendingStakeDecrease.Amount.Add(amount)
+			pendingStakeDecrease.Amount = &mergedAmount
+			return pendingStakeDecreases
+		}
+	}
+
+	return append(pendingStakeDecreases, &PendingStakeDecrease{
+		Amount:           &amount,
+		SessionEndHeight: sessionEndHeight,
+	})
+}
+
+// MergePendingStakeDecreases returns the pending stake decreases of dst with the
+// ones of src added, ordered by session end height.
+func MergePendingStakeDecreases(dst, src []*PendingStakeDecrease) []*PendingStakeDecrease {
+	for _, pendingStakeDecrease := range src {
+		dst = AddPendingStakeDecrease(dst, *pendingStakeDecrease.Amount, pendingStakeDecrease.SessionEndHeight)
+	}
+
+	sort.SliceStable(dst, func(i, j int) bool {
+		return dst[i].SessionEndHeight < dst[j].SessionEndHeight
+	})
+
+	return dst
+}
+
+// GetPendingStakeDecreasesAmount returns the total amount of the given pending
+// stake decreases.
+func GetPendingStakeDecreasesAmount(pendingStakeDecreases []*PendingStakeDecrease) cosmostypes.Coin {
+	totalAmount := cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 0)
+	for _, pendingStakeDecrease := range pendingStakeDecreases {
+		totalAmount = totalAmount.Add(*pendingStakeDecrease.Amount)
+	}
+
+	return totalAmount
+}
+
+// GetBackingStakeDecreasesAmount returns the total amount of the pending stake
+// decreases which back the claims of the session ending at sessionEndHeight.
+//
+// A decrease requested during a session still backs the claims of that session
+// and of all the sessions before it: the relays of these sessions were served
+// against the stake prior to the decrease.
+func GetBackingStakeDecreasesAmount(
+	pendingStakeDecreases []*PendingStakeDecrease,
+	sessionEndHeight uint64,
+) cosmostypes.Coin {
+	backingAmount := cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 0)
+	for _, pendingStakeDecrease := range pendingStakeDecreases {
+		if pendingStakeDecrease.SessionEndHeight >= sessionEndHeight {
+			backingAmount = backingAmount.Add(*pendingStakeDecrease.Amount)
+		}
+	}
+
+	return backingAmount
+}
+
+// DeductFromBackingStakeDecreases deducts the given amount from the pending stake
+// decreases backing the claims of the session ending at sessionEndHeight, the
+// earliest to unbond first. It returns the part of the amount which could not be
+// covered by the backing decreases and must be deducted from the stake instead.
+//
+// Fully consumed decreases are kept with a zero amount, they are dropped once
+// they complete unbonding.
+func DeductFromBackingStakeDecreases(
+	pendingStakeDecreases []*PendingStakeDecrease,
+	sessionEndHeight uint64,
+	amount cosmostypes.Coin,
+) cosmostypes.Coin {
+	for _, pendingStakeDecrease := range pendingStakeDecreases {
+		if amount.IsZero() {
+			break
+		}
+
+		if pendingStakeDecrease.SessionEndHeight < sessionEndHeight {
+			continue
+		}
+
+		deductedAmount := amount
+		if pendingStakeDecrease.Amount.IsLT(deductedAmount) {
+			deductedAmount = *pendingStakeDecrease.Amount
+		}
+
+		remainingDecrease := pendingStakeDecrease.Amount.Sub(deductedAmount)
+		pendingStakeDecrease.Amount = &remainingDecrease
+		amount = amount.Sub(deductedAmount)
+	}
+
+	return amount
+}
+
+// ValidatePendingStakeDecreases validates that the given pending stake decreases
+// have a valid non-negative upokt amount, a session end height and are ordered by
+// strictly increasing session end height.
+func ValidatePendingStakeDecreases(pendingStakeDecreases []*PendingStakeDecrease) error {
+	var prevSessionEndHeight uint64
+	for _, pendingStakeDecrease := range pendingStakeDecreases {
+		if pendingStakeDecrease == nil || pendingStakeDecrease.Amount == nil {
+			return fmt.Errorf("nil pending stake decrease amount")
+		}
+
+		amount := pendingStakeDecrease.Amount
+		if !amount.IsValid() {
+			return fmt.Errorf("invalid pending stake decrease amount %s; (%v)", amount, amount.Validate())
+		}
+		if amount.Denom != pocket.DenomuPOKT {
+			return fmt.Errorf("invalid pending stake decrease denom: expected %s, got %s", pocket.DenomuPOKT, amount.Denom)
+		}
+
+		if pendingStakeDecrease.SessionEndHeight <= prevSessionEndHeight {
+			return fmt.Errorf(
+				"pending stake decreases must be ordered by strictly increasing session end height, got %d after %d",
+				pendingStakeDecrease.SessionEndHeight, prevSessionEndHeight,
+			)
+		}
+		prevSessionEndHeight = pendingStakeDecrease.SessionEndHeight
+	}
+
+	return nil
+}
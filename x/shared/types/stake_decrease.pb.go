@@ -0,0 +1,374 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pocket/shared/stake_decrease.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingStakeDecrease is an amount removed from the stake of an actor (i.e.
+// supplier or application) which is unbonding.
+// It is only intended to be used inside of a Supplier or Application object.
+//
+// The decreased amount remains in escrow until the end of its unbonding period,
+// during which it still backs the claims of the sessions which ended before it
+// was removed from the stake: they can be settled (or slashed) against it.
+type PendingStakeDecrease struct {
+	// Amount of uPOKT removed from the stake, less what was settled against it.
+	Amount *types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// End height of the session in which the stake was decreased.
+	SessionEndHeight uint64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+}
+
+func (m *PendingStakeDecrease) Reset()         { *m = PendingStakeDecrease{} }
+func (m *PendingStakeDecrease) String() string { return proto.CompactTextString(m) }
+func (*PendingStakeDecrease) ProtoMessage()    {}
+func (*PendingStakeDecrease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d140deb4eab6c50, []int{0}
+}
+func (m *PendingStakeDecrease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingStakeDecrease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingStakeDecrease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingStakeDecrease.Merge(m, src)
+}
+func (m *PendingStakeDecrease) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingStakeDecrease) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingStakeDecrease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingStakeDecrease proto.InternalMessageInfo
+
+func (m *PendingStakeDecrease) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PendingStakeDecrease) GetSessionEndHeight() uint64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingStakeDecrease)(nil), "pocket.shared.PendingStakeDecrease")
+}
+
+func init() {
+	proto.RegisterFile("pocket/shared/stake_decrease.proto", fileDescriptor_6d140deb4eab6c50)
+}
+
+var fileDescriptor_6d140deb4eab6c50 = []byte{
+	// 263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0x3f, 0x6a, 0xf3, 0x40,
+	0x10, 0xc5, 0xb5, 0x1f, 0x1f, 0x2e, 0x14, 0x02, 0x41, 0xb8, 0x70, 0x5c, 0x2c, 0xc6, 0x95, 0x8b,
+	0x64, 0x17, 0x25, 0x37, 0xc8, 0x1f, 0x48, 0x13, 0x08, 0x4e, 0x97, 0x46, 0xac, 0xa4, 0x41, 0x5a,
+	0x64, 0xed, 0x08, 0xcd, 0x38, 0x4e, 0x6e, 0x91, 0x63, 0xa5, 0x74, 0xe9, 0x32, 0x48, 0x17, 0x09,
+	0xd2, 0x2a, 0xdd, 0x9b, 0x37, 0x3f, 0xde, 0x63, 0x26, 0x5c, 0x37, 0x98, 0x55, 0xc0, 0x9a, 0x4a,
+	0xd3, 0x42, 0xae, 0x89, 0x4d, 0x05, 0x49, 0x0e, 0x59, 0x0b, 0x86, 0x40, 0x35, 0x2d, 0x32, 0x46,
+	0xe7, 0x9e, 0x51, 0x9e, 0x59, 0xca, 0x0c, 0xa9, 0x46, 0xd2, 0xa9, 0x21, 0xd0, 0xef, 0x71, 0x0a,
+	0x6c, 0x62, 0x9d, 0xa1, 0x75, 0x1e, 0x5f, 0xce, 0x0b, 0x2c, 0x70, 0x94, 0x7a, 0x50, 0xde, 0x5d,
+	0x1f, 0xc2, 0xf9, 0x0b, 0xb8, 0xdc, 0xba, 0xe2, 0x75, 0xe8, 0x78, 0x98, 0x2a, 0xa2, 0x38, 0x9c,
+	0x99, 0x1a, 0xf7, 0x8e, 0x17, 0x62, 0x25, 0x36, 0x67, 0x37, 0x97, 0xca, 0xc7, 0xab, 0x21, 0x5e,
+	0x4d, 0xf1, 0xea, 0x1e, 0xad, 0xdb, 0x4e, 0x60, 0x74, 0x15, 0x46, 0x04, 0x44, 0x16, 0x5d, 0x02,
+	0x2e, 0x4f, 0x4a, 0xb0, 0x45, 0xc9, 0x8b, 0x7f, 0x2b, 0xb1, 0xf9, 0xbf, 0xbd, 0x98, 0x36, 0x8f,
+	0x2e, 0x7f, 0x1a, 0xfd, 0xbb, 0xe7, 0xef, 0x4e, 0x8a, 0x63, 0x27, 0xc5, 0xa9, 0x93, 0xe2, 0xa7,
+	0x93, 0xe2, 0xab, 0x97, 0xc1, 0xb1, 0x97, 0xc1, 0xa9, 0x97, 0xc1, 0x9b, 0x2e, 0x2c, 0x97, 0xfb,
+	0x54, 0x65, 0x58, 0xeb, 0x06, 0x2b, 0xbe, 0x76, 0xc0, 0x07, 0x6c, 0xab, 0x71, 0x68, 0x71, 0xb7,
+	0xd3, 0x1f, 0x7f, 0xbf, 0xe1, 0xcf, 0x06, 0x28, 0x9d, 0x8d, 0xe7, 0xdc, 0xfe, 0x0e, 0x00, 0x96,
+	0xe0, 0xbf, 0x1d, 0x39, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingStakeDecrease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingStakeDecrease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingStakeDecrease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintStakeDecrease(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStakeDecrease(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStakeDecrease(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStakeDecrease(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingStakeDecrease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovStakeDecrease(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovStakeDecrease(uint64(m.SessionEndHeight))
+	}
+	return n
+}
+
+func sovStakeDecrease(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStakeDecrease(x uint64) (n int) {
+	return sovStakeDecrease(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingStakeDecrease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakeDecrease
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingStakeDecrease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingStakeDecrease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeDecrease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakeDecrease
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeDecrease
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeDecrease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakeDecrease(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakeDecrease
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStakeDecrease(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStakeDecrease
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStakeDecrease
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStakeDecrease
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStakeDecrease
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStakeDecrease
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStakeDecrease
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStakeDecrease        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStakeDecrease          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStakeDecrease = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/app/pocket"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestPendingStakeDecreases_AddAndMerge(t *testing.T) {
+	decreases := sharedtypes.AddPendingStakeDecrease(nil, upokt(10), 20)
+	decreases = sharedtypes.AddPendingStakeDecrease(decreases, upokt(5), 20)
+	decreases = sharedtypes.AddPendingStakeDecrease(decreases, upokt(7), 40)
+	require.Len(t, decreases, 2)
+	require.Equal(t, int64(15), decreases[0].Amount.Amount.Int64())
+	require.Equal(t, int64(7), decreases[1].Amount.Amount.Int64())
+
+	srcDecreases := sharedtypes.AddPendingStakeDecrease(nil, upokt(3), 30)
+	srcDecreases = sharedtypes.AddPendingStakeDecrease(srcDecreases, upokt(1), 40)
+	merged := sharedtypes.MergePendingStakeDecreases(decreases, srcDecreases)
+	require.NoError(t, sharedtypes.ValidatePendingStakeDecreases(merged))
+	require.Len(t, merged, 3)
+	require.Equal(t, uint64(20), merged[0].SessionEndHeight)
+	require.Equal(t, uint64(30), merged[1].SessionEndHeight)
+	require.Equal(t, uint64(40), merged[2].SessionEndHeight)
+	require.Equal(t, int64(8), merged[2].Amount.Amount.Int64())
+	require.Equal(t, upokt(26), sharedtypes.GetPendingStakeDecreasesAmount(merged))
+}
+
+func TestPendingStakeDecreases_BackingAndDeduct(t *testing.T) {
+	decreases := sharedtypes.AddPendingStakeDecrease(nil, upokt(10), 20)
+	decreases = sharedtypes.AddPendingStakeDecrease(decreases, upokt(5), 40)
+
+	// Decreases requested during or after a session back its claims.
+	require.Equal(t, upokt(15), sharedtypes.GetBackingStakeDecreasesAmount(decreases, 20))
+	require.Equal(t, upokt(5), sharedtypes.GetBackingStakeDecreasesAmount(decreases, 30))
+	require.Equal(t, upokt(0), sharedtypes.GetBackingStakeDecreasesAmount(decreases, 50))
+
+	// The earliest backing decrease is deducted first.
+	remaining := sharedtypes.DeductFromBackingStakeDecreases(decreases, 20, upokt(12))
+	require.Equal(t, upokt(0), remaining)
+	require.Equal(t, int64(0), decreases[0].Amount.Amount.Int64())
+	require.Equal(t, int64(3), decreases[1].Amount.Amount.Int64())
+
+	// The part not covered by the backing decreases is returned.
+	remaining = sharedtypes.DeductFromBackingStakeDecreases(decreases, 30, upokt(4))
+	require.Equal(t, upokt(1), remaining)
+	require.Equal(t, int64(0), decreases[1].Amount.Amount.Int64())
+}
+
+func TestValidatePendingStakeDecreases(t *testing.T) {
+	invalidDenom := cosmostypes.NewInt64Coin("invalid", 1)
+
+	tests := []struct {
+		desc        string
+		decreases   []*sharedtypes.PendingStakeDecrease
+		expectedErr bool
+	}{
+		{
+			desc: "valid",
+			decreases: []*sharedtypes.PendingStakeDecrease{
+				{Amount: ptr(upokt(1)), SessionEndHeight: 10},
+				{Amount: ptr(upokt(0)), SessionEndHeight: 20},
+			},
+		},
+		{
+			desc:        "invalid: nil amount",
+			decreases:   []*sharedtypes.PendingStakeDecrease{{SessionEndHeight: 10}},
+			expectedErr: true,
+		},
+		{
+			desc:        "invalid: denom",
+			decreases:   []*sharedtypes.PendingStakeDecrease{{Amount: &invalidDenom, SessionEndHeight: 10}},
+			expectedErr: true,
+		},
+		{
+			desc:        "invalid: zero session end height",
+			decreases:   []*sharedtypes.PendingStakeDecrease{{Amount: ptr(upokt(1))}},
+			expectedErr: true,
+		},
+		{
+			desc: "invalid: unordered session end heights",
+			decreases: []*sharedtypes.PendingStakeDecrease{
+				{Amount: ptr(upokt(1)), SessionEndHeight: 20},
+				{Amount: ptr(upokt(1)), SessionEndHeight: 10},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := sharedtypes.ValidatePendingStakeDecreases(test.decreases)
+			if test.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func upokt(amount int64) cosmostypes.Coin {
+	return cosmostypes.NewInt64Coin(pocket.DenomuPOKT, amount)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
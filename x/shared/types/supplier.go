@@ -66,6 +66,19 @@ func (s *Supplier) HasOperator(address string) bool {
 	return s.OperatorAddress == address
 }
 
+// HasPendingTransfer returns true if the supplier has begun but not completed
+// a transfer to a new operator address.
+func (s *Supplier) HasPendingTransfer() bool {
+	return s.Transfer != nil && !s.IsUnbonding()
+}
+
+// IsTransferred returns true if the supplier has completed a transfer to a new
+// operator address. A transferred supplier is unbonding and its stake is held by
+// the destination supplier.
+func (s *Supplier) IsTransferred() bool {
+	return s.Transfer != nil && s.IsUnbonding()
+}
+
 // GetSupplierUnbondingEndHeight returns the session end height at which the given
 // supplier finishes unbonding.
 //
@@ -83,6 +96,21 @@ func GetSupplierUnbondingEndHeight(
 	return int64(supplier.GetUnstakeSessionEndHeight() + supplierUnbondingPeriodBlocks)
 }
 
+// GetSupplierStakeDecreaseUnbondingEndHeight returns the session end height at
+// which the given supplier stake decrease finishes unbonding.
+//
+// Stake decreases go through the same unbonding period as a full unstake so that
+// they keep backing the claims of the sessions served before the decrease until
+// these are settled.
+func GetSupplierStakeDecreaseUnbondingEndHeight(
+	sharedParams *Params,
+	pendingStakeDecrease *PendingStakeDecrease,
+) int64 {
+	supplierUnbondingPeriodBlocks := sharedParams.GetSupplierUnbondingPeriodSessions() * sharedParams.GetNumBlocksPerSession()
+
+	return int64(pendingStakeDecrease.GetSessionEndHeight() + supplierUnbondingPeriodBlocks)
+}
+
 // GetActiveServiceConfigsFromHistory filters the service configuration history
 // to find all configurations that are active at the specified block height.
 func GetActiveServiceConfigsFromHistory(
@@ -43,6 +43,16 @@ type Supplier struct {
 	// List of historical service configuration updates, tracking the suppliers
 	// services update and corresponding activation heights.
 	ServiceConfigHistory []*ServiceConfigUpdate `protobuf:"bytes,6,rep,name=service_config_history,json=serviceConfigHistory,proto3" json:"service_config_history,omitempty"`
+	// Transfer of the supplier to a new operator address (nil if not transferring).
+	// - Pending: until the end of the session in which the transfer began
+	// - Completed: the supplier is then unbonding (unstake_session_end_height > 0)
+	//   and is only retained until its pending claims are settled, against the
+	//   destination supplier which holds its stake.
+	Transfer *SupplierTransfer `protobuf:"bytes,7,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// Amounts removed from the stake which are unbonding, ordered by the session
+	// end height at which they were removed. They are returned to the owner at the
+	// end of their unbonding period.
+	PendingStakeDecreases []*PendingStakeDecrease `protobuf:"bytes,8,rep,name=pending_stake_decreases,json=pendingStakeDecreases,proto3" json:"pending_stake_decreases,omitempty"`
 }
 
 func (m *Supplier) Reset()         { *m = Supplier{} }
@@ -116,6 +126,72 @@ func (m *Supplier) GetServiceConfigHistory() []*ServiceConfigUpdate {
 	return nil
 }
 
+func (m *Supplier) GetTransfer() *SupplierTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return nil
+}
+
+func (m *Supplier) GetPendingStakeDecreases() []*PendingStakeDecrease {
+	if m != nil {
+		return m.PendingStakeDecreases
+	}
+	return nil
+}
+
+// SupplierTransfer is used to store the details of a supplier operator transfer.
+// It is only intended to be used inside of a Supplier object.
+type SupplierTransfer struct {
+	// The operator address which the supplier is transferred to.
+	DestinationOperatorAddress string `protobuf:"bytes,1,opt,name=destination_operator_address,json=destinationOperatorAddress,proto3" json:"destination_operator_address,omitempty"`
+	// The end height of the session in which the transfer began, at which it completes.
+	SessionEndHeight uint64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+}
+
+func (m *SupplierTransfer) Reset()         { *m = SupplierTransfer{} }
+func (m *SupplierTransfer) String() string { return proto.CompactTextString(m) }
+func (*SupplierTransfer) ProtoMessage()    {}
+func (*SupplierTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd9cf6b0d91d1e18, []int{1}
+}
+func (m *SupplierTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplierTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SupplierTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplierTransfer.Merge(m, src)
+}
+func (m *SupplierTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplierTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplierTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplierTransfer proto.InternalMessageInfo
+
+func (m *SupplierTransfer) GetDestinationOperatorAddress() string {
+	if m != nil {
+		return m.DestinationOperatorAddress
+	}
+	return ""
+}
+
+func (m *SupplierTransfer) GetSessionEndHeight() uint64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
 // ServiceConfigUpdate tracks a change in a supplier's service configurations
 // at a specific block height, enabling tracking of configuration changes over time.
 // This record helps maintain a complete history of service configs and their availability periods.
@@ -139,7 +215,7 @@ func (m *ServiceConfigUpdate) Reset()         { *m = ServiceConfigUpdate{} }
 func (m *ServiceConfigUpdate) String() string { return proto.CompactTextString(m) }
 func (*ServiceConfigUpdate) ProtoMessage()    {}
 func (*ServiceConfigUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd9cf6b0d91d1e18, []int{1}
+	return fileDescriptor_fd9cf6b0d91d1e18, []int{2}
 }
 func (m *ServiceConfigUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,43 +270,51 @@ func (m *ServiceConfigUpdate) GetDeactivationHeight() int64 {
 
 func init() {
 	proto.RegisterType((*Supplier)(nil), "pocket.shared.Supplier")
+	proto.RegisterType((*SupplierTransfer)(nil), "pocket.shared.SupplierTransfer")
 	proto.RegisterType((*ServiceConfigUpdate)(nil), "pocket.shared.ServiceConfigUpdate")
 }
 
 func init() { proto.RegisterFile("pocket/shared/supplier.proto", fileDescriptor_fd9cf6b0d91d1e18) }
 
 var fileDescriptor_fd9cf6b0d91d1e18 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x49, 0x5a, 0xca, 0x96, 0x8a, 0xb2, 0x8d, 0xc0, 0x0d, 0xc8, 0x8a, 0x22, 0x0e, 0x41,
-	0xa8, 0x5e, 0xb5, 0x1c, 0x11, 0x08, 0x1a, 0x21, 0xf5, 0xc2, 0xc5, 0x11, 0x12, 0xe2, 0x62, 0x6d,
-	0xec, 0xc1, 0x5e, 0x25, 0xdd, 0xb1, 0x76, 0x37, 0x29, 0x7d, 0x0b, 0x8e, 0x3c, 0x08, 0x0f, 0xc1,
-	0xb1, 0xe2, 0xd4, 0x13, 0x42, 0xc9, 0x8b, 0xa0, 0xec, 0xae, 0x69, 0x68, 0x23, 0xc1, 0xcd, 0x33,
-	0xdf, 0x8f, 0x67, 0xbe, 0x59, 0xf2, 0xb8, 0xc2, 0x6c, 0x0c, 0x86, 0xe9, 0x92, 0x2b, 0xc8, 0x99,
-	0x9e, 0x56, 0xd5, 0x44, 0x80, 0x8a, 0x2b, 0x85, 0x06, 0xe9, 0x8e, 0x43, 0x63, 0x87, 0x76, 0xf6,
-	0x33, 0xd4, 0xa7, 0xa8, 0x53, 0x0b, 0x32, 0x57, 0x38, 0x66, 0x27, 0x72, 0x15, 0x1b, 0x71, 0x0d,
-	0x6c, 0x76, 0x38, 0x02, 0xc3, 0x0f, 0x59, 0x86, 0x42, 0x7a, 0xfc, 0xd1, 0xb5, 0xff, 0x80, 0x9a,
-	0x89, 0x0c, 0x3c, 0xd8, 0x2e, 0xb0, 0x40, 0x67, 0xba, 0xfc, 0x72, 0xdd, 0xde, 0xd7, 0x26, 0xd9,
-	0x1a, 0xfa, 0x79, 0xe8, 0x4b, 0xb2, 0x83, 0x67, 0x12, 0x54, 0xca, 0xf3, 0x5c, 0x81, 0xd6, 0x61,
-	0xd0, 0x0d, 0xfa, 0x77, 0x8e, 0xc3, 0x1f, 0xdf, 0x0e, 0xda, 0x7e, 0x90, 0x37, 0x0e, 0x19, 0x1a,
-	0x25, 0x64, 0x91, 0xdc, 0xb5, 0x74, 0xdf, 0xa3, 0x03, 0xb2, 0x8b, 0x15, 0x28, 0x6e, 0xf0, 0xca,
-	0xe1, 0xd6, 0x3f, 0x1c, 0xee, 0xd5, 0x8a, 0xda, 0x84, 0x91, 0x0d, 0x6d, 0xf8, 0x18, 0xc2, 0x66,
-	0x37, 0xe8, 0x6f, 0x1f, 0xed, 0xc7, 0x5e, 0xb6, 0xdc, 0x39, 0xf6, 0x3b, 0xc7, 0x03, 0x14, 0x32,
-	0x71, 0x3c, 0xfa, 0x9a, 0x6c, 0xf9, 0x45, 0x75, 0xd8, 0xea, 0x36, 0xfb, 0xdb, 0x47, 0x4f, 0xe2,
-	0xbf, 0x12, 0x8d, 0xeb, 0xfd, 0x86, 0x8e, 0x36, 0x40, 0xf9, 0x49, 0x14, 0xc9, 0x1f, 0x15, 0x7d,
-	0x41, 0x3a, 0x53, 0x69, 0xcd, 0x52, 0x0d, 0x5a, 0x0b, 0x94, 0x29, 0xc8, 0x3c, 0x2d, 0x41, 0x14,
-	0xa5, 0x09, 0x37, 0xba, 0x41, 0xbf, 0x95, 0x3c, 0xf4, 0x8c, 0xa1, 0x23, 0xbc, 0x95, 0xf9, 0x89,
-	0x85, 0xe9, 0x07, 0xf2, 0xc0, 0x1b, 0xa5, 0x99, 0x35, 0x4e, 0x4b, 0xa1, 0x0d, 0xaa, 0xf3, 0x70,
-	0xd3, 0x0e, 0xd3, 0xbb, 0x3e, 0xcc, 0xea, 0x10, 0xef, 0xab, 0x9c, 0x1b, 0x48, 0xda, 0x7a, 0xb5,
-	0x79, 0xe2, 0xf4, 0xbd, 0x9f, 0x01, 0xd9, 0x5b, 0xc3, 0xa6, 0x4f, 0xd7, 0xc4, 0x6c, 0x0f, 0x75,
-	0x33, 0xcc, 0x57, 0xe4, 0xb6, 0xb7, 0xb6, 0x87, 0xf8, 0xdf, 0x68, 0x6a, 0x11, 0x7d, 0x46, 0xee,
-	0xf3, 0xcc, 0x88, 0x19, 0x37, 0xcb, 0x50, 0x7c, 0x20, 0xcb, 0xc3, 0x34, 0x93, 0xdd, 0x2b, 0xc0,
-	0x27, 0xc1, 0xc8, 0x5e, 0x0e, 0x37, 0xe9, 0x2d, 0x4b, 0xa7, 0xab, 0x90, 0x13, 0x1c, 0xbf, 0xfb,
-	0x3e, 0x8f, 0x82, 0x8b, 0x79, 0x14, 0x5c, 0xce, 0xa3, 0xe0, 0xd7, 0x3c, 0x0a, 0xbe, 0x2c, 0xa2,
-	0xc6, 0xc5, 0x22, 0x6a, 0x5c, 0x2e, 0xa2, 0xc6, 0x47, 0x56, 0x08, 0x53, 0x4e, 0x47, 0x71, 0x86,
-	0xa7, 0xac, 0xc2, 0xb1, 0x39, 0x90, 0x60, 0xce, 0x50, 0x8d, 0x6d, 0xa1, 0x70, 0x32, 0x61, 0x9f,
-	0xeb, 0x87, 0x6e, 0xce, 0x2b, 0xd0, 0xa3, 0x4d, 0xfb, 0xa2, 0x9f, 0xff, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0x23, 0x44, 0xe8, 0x3a, 0x6e, 0x03, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xd1, 0x6e, 0xd3, 0x3c,
+	0x18, 0x5d, 0xb6, 0x6e, 0xeb, 0xef, 0xfd, 0x13, 0xc5, 0x2b, 0x2c, 0x2b, 0x53, 0xa8, 0x0a, 0x17,
+	0x45, 0xb0, 0x44, 0x1b, 0x97, 0x13, 0x08, 0x56, 0x90, 0x76, 0x83, 0x40, 0x29, 0x48, 0x68, 0x5c,
+	0x44, 0x69, 0xfc, 0x2d, 0xb5, 0xda, 0xd9, 0x91, 0xed, 0x76, 0xec, 0x2d, 0x78, 0x00, 0x9e, 0x02,
+	0xf1, 0x10, 0x5c, 0x4e, 0x5c, 0xed, 0x0a, 0xa1, 0xf6, 0x45, 0x50, 0x6d, 0x67, 0xeb, 0xd2, 0xa2,
+	0x71, 0x17, 0xfb, 0x9c, 0xf3, 0xf9, 0xe4, 0xf8, 0xc8, 0x68, 0x3b, 0xe3, 0x49, 0x0f, 0x54, 0x20,
+	0xbb, 0xb1, 0x00, 0x12, 0xc8, 0x41, 0x96, 0xf5, 0x29, 0x08, 0x3f, 0x13, 0x5c, 0x71, 0xbc, 0x6e,
+	0x50, 0xdf, 0xa0, 0xb5, 0xad, 0x84, 0xcb, 0x13, 0x2e, 0x23, 0x0d, 0x06, 0x66, 0x61, 0x98, 0x35,
+	0xcf, 0xac, 0x82, 0x4e, 0x2c, 0x21, 0x18, 0xee, 0x76, 0x40, 0xc5, 0xbb, 0x41, 0xc2, 0x29, 0xb3,
+	0xf8, 0xbd, 0xc2, 0x39, 0x20, 0x86, 0x34, 0x01, 0x0b, 0x36, 0x0a, 0xa0, 0x8a, 0x7b, 0x10, 0x11,
+	0x48, 0x04, 0xc4, 0x32, 0xe7, 0x54, 0x53, 0x9e, 0x72, 0x73, 0xf0, 0xe4, 0xcb, 0xec, 0x36, 0xbe,
+	0x95, 0x50, 0xb9, 0x6d, 0x3d, 0xe3, 0x67, 0x68, 0x9d, 0x9f, 0x32, 0x10, 0x51, 0x4c, 0x88, 0x00,
+	0x29, 0x5d, 0xa7, 0xee, 0x34, 0xff, 0x3b, 0x70, 0x7f, 0x7e, 0xdf, 0xa9, 0x5a, 0xb3, 0x2f, 0x0d,
+	0xd2, 0x56, 0x82, 0xb2, 0x34, 0xfc, 0x5f, 0xd3, 0xed, 0x1e, 0x6e, 0xa1, 0x0a, 0xcf, 0x40, 0xc4,
+	0x8a, 0x5f, 0x4d, 0x58, 0xbc, 0x61, 0xc2, 0xad, 0x5c, 0x91, 0x0f, 0x09, 0xd0, 0xb2, 0xb6, 0xef,
+	0x2e, 0xd5, 0x9d, 0xe6, 0xda, 0xde, 0x96, 0x6f, 0x65, 0x93, 0x5c, 0x7c, 0x9b, 0x8b, 0xdf, 0xe2,
+	0x94, 0x85, 0x86, 0x87, 0x5f, 0xa0, 0xb2, 0x0d, 0x43, 0xba, 0xa5, 0xfa, 0x52, 0x73, 0x6d, 0xef,
+	0xa1, 0x7f, 0x2d, 0x75, 0x3f, 0xff, 0xbf, 0xb6, 0xa1, 0xb5, 0x38, 0x3b, 0xa6, 0x69, 0x78, 0xa9,
+	0xc2, 0xfb, 0xa8, 0x36, 0x60, 0x26, 0x33, 0x09, 0x52, 0x52, 0xce, 0x22, 0x60, 0x24, 0xea, 0x02,
+	0x4d, 0xbb, 0xca, 0x5d, 0xae, 0x3b, 0xcd, 0x52, 0xb8, 0x69, 0x19, 0x6d, 0x43, 0x78, 0xcd, 0xc8,
+	0xa1, 0x86, 0xf1, 0x47, 0x74, 0xd7, 0x0e, 0x8a, 0x12, 0x3d, 0x38, 0xea, 0x52, 0xa9, 0xb8, 0x38,
+	0x73, 0x57, 0xb4, 0x99, 0x46, 0xd1, 0xcc, 0xb4, 0x89, 0x0f, 0x19, 0x89, 0x15, 0x84, 0x55, 0x39,
+	0xbd, 0x79, 0x68, 0xf4, 0x78, 0x1f, 0x95, 0x95, 0x88, 0x99, 0x3c, 0x06, 0xe1, 0xae, 0xea, 0x30,
+	0xee, 0xff, 0xe5, 0xc7, 0xde, 0x5b, 0x5a, 0x78, 0x29, 0xc0, 0x9f, 0xd0, 0x66, 0x06, 0x8c, 0x50,
+	0x96, 0x46, 0xd7, 0xdb, 0x20, 0xdd, 0xb2, 0xf6, 0xf5, 0xa0, 0x30, 0xeb, 0x9d, 0x61, 0xb7, 0x27,
+	0xe4, 0x57, 0x96, 0x1b, 0xde, 0xc9, 0xe6, 0xec, 0xca, 0xc6, 0x57, 0x07, 0x55, 0x8a, 0x67, 0xe3,
+	0x23, 0xb4, 0x4d, 0x40, 0x2a, 0xca, 0x62, 0x35, 0x49, 0x70, 0xa6, 0x09, 0x37, 0x75, 0xa9, 0x36,
+	0xa5, 0x7e, 0x5b, 0x28, 0xc5, 0x13, 0x84, 0xe7, 0xdc, 0xcc, 0xa2, 0xbe, 0x99, 0x8a, 0x2c, 0x5c,
+	0x49, 0xe3, 0x97, 0x83, 0x36, 0xe6, 0xc4, 0x8c, 0x1f, 0xa1, 0xca, 0x7c, 0x57, 0xb3, 0x2d, 0x7c,
+	0x8e, 0x56, 0xed, 0x9d, 0xe8, 0x53, 0xfe, 0xb5, 0x53, 0xb9, 0x08, 0x3f, 0x46, 0xb7, 0xe3, 0x44,
+	0xd1, 0xa1, 0xc9, 0xc2, 0xfa, 0x9d, 0x34, 0x7a, 0x29, 0xac, 0x5c, 0x01, 0xb6, 0x42, 0x01, 0xda,
+	0x20, 0x30, 0x4b, 0x2f, 0x69, 0x3a, 0x26, 0x50, 0x14, 0x1c, 0xbc, 0xf9, 0x31, 0xf2, 0x9c, 0xf3,
+	0x91, 0xe7, 0x5c, 0x8c, 0x3c, 0xe7, 0xf7, 0xc8, 0x73, 0xbe, 0x8c, 0xbd, 0x85, 0xf3, 0xb1, 0xb7,
+	0x70, 0x31, 0xf6, 0x16, 0x8e, 0x82, 0x94, 0xaa, 0xee, 0xa0, 0xe3, 0x27, 0xfc, 0x24, 0xc8, 0x78,
+	0x4f, 0xed, 0x30, 0x50, 0xa7, 0x5c, 0xf4, 0xf4, 0x42, 0xf0, 0x7e, 0x3f, 0xf8, 0x9c, 0x3f, 0x14,
+	0xea, 0x2c, 0x03, 0xd9, 0x59, 0xd1, 0x4f, 0xc1, 0xd3, 0x3f, 0x03, 0x00, 0xf7, 0x55, 0x4a, 0xe8,
+	0xcb, 0x04, 0x00, 0x00,
 }
 
 func (m *Supplier) Marshal() (dAtA []byte, err error) {
@@ -253,6 +337,32 @@ func (m *Supplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingStakeDecreases) > 0 {
+		for iNdEx := len(m.PendingStakeDecreases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingStakeDecreases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSupplier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSupplier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.ServiceConfigHistory) > 0 {
 		for iNdEx := len(m.ServiceConfigHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -315,6 +425,41 @@ func (m *Supplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplierTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplierTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplierTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintSupplier(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DestinationOperatorAddress) > 0 {
+		i -= len(m.DestinationOperatorAddress)
+		copy(dAtA[i:], m.DestinationOperatorAddress)
+		i = encodeVarintSupplier(dAtA, i, uint64(len(m.DestinationOperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ServiceConfigUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -411,6 +556,32 @@ func (m *Supplier) Size() (n int) {
 			n += 1 + l + sovSupplier(uint64(l))
 		}
 	}
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovSupplier(uint64(l))
+	}
+	if len(m.PendingStakeDecreases) > 0 {
+		for _, e := range m.PendingStakeDecreases {
+			l = e.Size()
+			n += 1 + l + sovSupplier(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplierTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestinationOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSupplier(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovSupplier(uint64(m.SessionEndHeight))
+	}
 	return n
 }
 
@@ -659,6 +830,177 @@ func (m *Supplier) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transfer == nil {
+				m.Transfer = &SupplierTransfer{}
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingStakeDecreases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingStakeDecreases = append(m.PendingStakeDecreases, &PendingStakeDecrease{})
+			if err := m.PendingStakeDecreases[len(m.PendingStakeDecreases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplierTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplierTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplierTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSupplier(dAtA[iNdEx:])
@@ -21,7 +21,8 @@ import (
 //
 // Important notes:
 // - Service configuration changes take effect at the start of the next session
-// - Stake changes are processed immediately with appropriate token transfers
+// - Stake increases are processed immediately with appropriate token transfers
+// - Stake decreases go through their own unbonding period before being returned to the owner
 // - The supplier staking fee is charged for each staking operation
 //
 // TODO_POST_MAINNET(@red-0ne): Update supplier staking documentation to remove the upstaking requirement and introduce the staking fee.
@@ -99,10 +100,12 @@ func (k Keeper) createSupplier(
 //   - additional stake validation (e.g. min stake, etc.)
 //   - EITHER any positive difference between the msg stake and any current stake is transferred
 //     from the staking supplier's account, to the supplier module's accounts.
-//   - OR any negative difference between the msg stake and any current stake is transferred
-//     from the supplier module's account (stake escrow) to the staking supplier's account.
+//   - OR any negative difference between the msg stake and any current stake is queued as a
+//     pending stake decrease, which is transferred from the supplier module's account (stake escrow)
+//     to the supplier owner's account once its unbonding period has elapsed.
 //   - the supplier staking fee is deducted from the staking supplier's account balance.
 //   - the (new or updated) supplier is persisted.
+//   - an EventSupplierStakeDecreaseUnbondingBegin event is emitted if the stake was decreased.
 //   - an EventSupplierStaked event is emitted.
 func (k Keeper) StakeSupplier(
 	ctx context.Context,
@@ -165,6 +168,18 @@ func (k Keeper) StakeSupplier(
 	} else {
 		logger.Info(fmt.Sprintf("Supplier found. About to try updating supplier with address %q", msg.OperatorAddress))
 
+		// A transferring supplier cannot be updated, and a transferred supplier's
+		// stake is held by the destination supplier, so it cannot be re-staked.
+		if supplier.Transfer != nil {
+			return nil, status.Error(
+				codes.FailedPrecondition,
+				suppliertypes.ErrSupplierIsTransferring.Wrapf(
+					"cannot stake supplier %q which is transferred (or transferring) to %q",
+					msg.OperatorAddress, supplier.Transfer.GetDestinationOperatorAddress(),
+				).Error(),
+			)
+		}
+
 		supplierCurrentStake = *supplier.Stake
 
 		// Ensure the signer is either the owner or the operator of the supplier.
@@ -270,7 +285,11 @@ func (k Keeper) StakeSupplier(
 
 	supplierStakingFee := k.GetParams(ctx).StakingFee
 
-	if err = k.reconcileSupplierStakeDiff(ctx, msg, supplierCurrentStake); err != nil {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sessionEndHeight := k.sharedKeeper.GetSessionEndHeight(ctx, sdkCtx.BlockHeight())
+
+	stakeDecrease, err := k.reconcileSupplierStakeDiff(ctx, msg, &supplier, supplierCurrentStake, sessionEndHeight)
+	if err != nil {
 		logger.Error(fmt.Sprintf("Could not transfer supplier stake difference due to %s", err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -287,9 +306,7 @@ func (k Keeper) StakeSupplier(
 	k.SetAndIndexDehydratedSupplier(ctx, supplier)
 	logger.Info(fmt.Sprintf("Successfully updated supplier stake for supplier: %+v", supplier))
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	events := make([]sdk.Msg, 0)
-	sessionEndHeight := k.sharedKeeper.GetSessionEndHeight(ctx, sdkCtx.BlockHeight())
 
 	if wasSupplierUnbonding {
 		events = append(events, &suppliertypes.EventSupplierUnbondingCanceled{
@@ -299,6 +316,20 @@ func (k Keeper) StakeSupplier(
 		})
 	}
 
+	if stakeDecrease != nil {
+		// Use the shared params effective at the session end height, consistently
+		// with EndBlockerUnbondSupplierStakeDecreases.
+		decreaseParams := k.sharedKeeper.GetParamsAtHeight(ctx, sessionEndHeight)
+		events = append(events, &suppliertypes.EventSupplierStakeDecreaseUnbondingBegin{
+			OperatorAddress:    supplier.OperatorAddress,
+			OwnerAddress:       supplier.OwnerAddress,
+			Amount:             stakeDecrease.Amount,
+			Stake:              supplier.Stake,
+			SessionEndHeight:   sessionEndHeight,
+			UnbondingEndHeight: sharedtypes.GetSupplierStakeDecreaseUnbondingEndHeight(&decreaseParams, stakeDecrease),
+		})
+	}
+
 	// Emit an event which signals that the supplier staked.
 	events = append(events, &suppliertypes.EventSupplierStaked{
 		OperatorAddress:  supplier.OperatorAddress,
@@ -415,13 +446,21 @@ func (k Keeper) updateSupplier(
 	return nil
 }
 
-// reconcileSupplierStakeDiff transfers the difference between the current and new stake
-// amounts by either escrowing, when the stake is increased, or unescrowing otherwise.
+// reconcileSupplierStakeDiff reconciles the difference between the current and new stake
+// amounts by either escrowing, when the stake is increased, or queueing a pending stake
+// decrease otherwise.
+//
+// A stake decrease remains escrowed until the end of its unbonding period so that it
+// keeps backing the claims of the sessions served before the decrease. Decreases
+// requested within the same session are merged. The stake decrease is returned, or
+// nil if the stake was not decreased.
 func (k Keeper) reconcileSupplierStakeDiff(
 	ctx context.Context,
 	msg *suppliertypes.MsgStakeSupplier,
+	supplier *sharedtypes.Supplier,
 	currentStake sdk.Coin,
-) error {
+	sessionEndHeight int64,
+) (*sharedtypes.PendingStakeDecrease, error) {
 	logger := k.Logger().With("method", "reconcileSupplierStakeDiff")
 
 	newStake := *msg.Stake
@@ -429,13 +468,7 @@ func (k Keeper) reconcileSupplierStakeDiff(
 	// Parse the signer address - this is the account that will pay for stake increases
 	signerAccAddr, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
-		return err
-	}
-
-	// Parse the owner address - this is the account that will receive stake decreases
-	ownerAccAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
-	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// The Supplier is increasing its stake, so escrow the difference
@@ -443,7 +476,7 @@ func (k Keeper) reconcileSupplierStakeDiff(
 		coinsToEscrow := sdk.NewCoins(newStake.Sub(currentStake))
 
 		// Send the coins from the message signer account to the staked supplier pool
-		return k.bankKeeper.SendCoinsFromAccountToModule(ctx, signerAccAddr, suppliertypes.ModuleName, coinsToEscrow)
+		return nil, k.bankKeeper.SendCoinsFromAccountToModule(ctx, signerAccAddr, suppliertypes.ModuleName, coinsToEscrow)
 	}
 
 	// Ensure that the new stake is at least the minimum stake which is required for:
@@ -455,19 +488,31 @@ func (k Keeper) reconcileSupplierStakeDiff(
 			"supplier with owner %q must stake at least %s",
 			signerAccAddr, minStake,
 		)
-		return err
+		return nil, err
 	}
 
-	// The supplier is decreasing its stake, unescrow the difference.
+	// The supplier is decreasing its stake, queue the difference for unbonding.
+	// It remains escrowed in the supplier module account until its unbonding period elapses.
 	if currentStake.Amount.GT(newStake.Amount) {
-		coinsToUnescrow := sdk.NewCoins(currentStake.Sub(newStake))
-
-		// Send the coins from the staked supplier pool to the supplier owner account
-		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, suppliertypes.ModuleName, ownerAccAddr, coinsToUnescrow)
+		stakeDecreaseAmount := currentStake.Sub(newStake)
+		supplier.PendingStakeDecreases = sharedtypes.AddPendingStakeDecrease(
+			supplier.PendingStakeDecreases,
+			stakeDecreaseAmount,
+			uint64(sessionEndHeight),
+		)
+		logger.Info(fmt.Sprintf(
+			"Queued a %s stake decrease for supplier with address %q",
+			stakeDecreaseAmount, msg.OperatorAddress,
+		))
+
+		return &sharedtypes.PendingStakeDecrease{
+			Amount:           &stakeDecreaseAmount,
+			SessionEndHeight: uint64(sessionEndHeight),
+		}, nil
 	}
 
 	// The supplier is not changing its stake. This can happen if the supplier
 	// is updating its service configurations or owner address but not the stake.
 	logger.Info(fmt.Sprintf("Updating supplier with address %q but stake is unchanged", msg.OperatorAddress))
-	return nil
+	return nil, nil
 }
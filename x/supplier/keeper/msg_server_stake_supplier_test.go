@@ -306,10 +306,12 @@ func TestMsgServer_StakeSupplier_SuccessLoweringStakeAboveMinStake(t *testing.T)
 		_, err = srv.StakeSupplier(ctx, updateMsg)
 		require.NoError(t, err)
 
-		// Verify that the supplier stake is updated
+		// Verify that the supplier stake is updated and the difference is unbonding
 		supplierFound, isSupplierFound := supplierModuleKeepers.GetSupplier(ctx, operatorAddr)
 		require.True(t, isSupplierFound)
 		require.Equal(t, newStake, supplierFound.Stake.Amount.Int64())
+		require.Len(t, supplierFound.PendingStakeDecreases, 1)
+		require.Equal(t, int64(1), supplierFound.PendingStakeDecreases[0].Amount.Amount.Int64())
 	})
 
 	t.Run("operator signed", func(t *testing.T) {
@@ -322,10 +324,13 @@ func TestMsgServer_StakeSupplier_SuccessLoweringStakeAboveMinStake(t *testing.T)
 		_, err = srv.StakeSupplier(ctx, updateMsg)
 		require.NoError(t, err)
 
-		// Verify that the supplier stake is updated.
+		// Verify that the supplier stake is updated and that the decreases of the
+		// same session are merged.
 		supplierFound, isSupplierFound := supplierModuleKeepers.GetSupplier(ctx, operatorAddr)
 		require.True(t, isSupplierFound)
 		require.Equal(t, newStake, supplierFound.Stake.Amount.Int64())
+		require.Len(t, supplierFound.PendingStakeDecreases, 1)
+		require.Equal(t, int64(2), supplierFound.PendingStakeDecreases[0].Amount.Amount.Int64())
 	})
 }
 
@@ -835,12 +840,29 @@ func TestMsgServer_StakeSupplier_SignerOwnerStakeDestination(t *testing.T) {
 	_, err = srv.StakeSupplier(ctx, decreaseStakeMsg)
 	require.NoError(t, err)
 
-	// Verify that the stake difference was returned to the owner, not the operator signer
+	// Verify that the stake difference is unbonding rather than returned immediately
 	stakeDifference := initialStake - lowerStake
 	// Operator should have paid the staking fee but received no stake back
 	expectedOperatorBalance := -supplierStakingFee.Amount.Int64()
 	require.Equal(t, expectedOperatorBalance, supplierModuleKeepers.SupplierBalanceMap[operatorAddr])
+	require.Equal(t, int64(0), supplierModuleKeepers.SupplierBalanceMap[ownerAddr])
+
+	supplier, isSupplierFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, operatorAddr)
+	require.True(t, isSupplierFound)
+	require.Len(t, supplier.PendingStakeDecreases, 1)
+	stakeDecrease := supplier.PendingStakeDecreases[0]
+	require.Equal(t, stakeDifference, stakeDecrease.Amount.Amount.Int64())
+
+	// Complete the stake decrease unbonding period.
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+	unbondingEndHeight := sharedtypes.GetSupplierStakeDecreaseUnbondingEndHeight(&sharedParams, stakeDecrease)
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight)
+	numUnbondedStakeDecreases, err := supplierModuleKeepers.EndBlockerUnbondSupplierStakeDecreases(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), numUnbondedStakeDecreases)
+
 	// Owner should have received the stake difference (return of funds)
+	require.Equal(t, expectedOperatorBalance, supplierModuleKeepers.SupplierBalanceMap[operatorAddr])
 	require.Equal(t, stakeDifference, supplierModuleKeepers.SupplierBalanceMap[ownerAddr])
 }
 
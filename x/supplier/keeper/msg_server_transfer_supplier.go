@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/telemetry"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// TransferSupplier handles the MsgTransferSupplier message to begin the transfer
+// of a supplier to a new (destination) operator address.
+// This initiates a process where:
+//   - The source supplier continues to provide service until the end of the current session
+//   - At the end of the session, the destination supplier is created with the source
+//     supplier's owner, stake and service configs, active from the next session
+//   - The source supplier then unbonds without returning its stake, which is held by
+//     the destination supplier; its pending claims are settled against the destination
+//
+// This avoids having to unstake, wait for the unbonding period and re-stake in
+// order to rotate a supplier's operator key.
+func (k msgServer) TransferSupplier(
+	ctx context.Context,
+	msg *suppliertypes.MsgTransferSupplier,
+) (*suppliertypes.MsgTransferSupplierResponse, error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"transfer_supplier_begin",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	logger := k.Logger().With("method", "TransferSupplier")
+	logger.Info(fmt.Sprintf("About to transfer supplier with msg: %v", msg))
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Ensure the destination supplier does not already exist.
+	if _, isDstFound := k.GetDehydratedSupplier(ctx, msg.GetDestinationOperatorAddress()); isDstFound {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			suppliertypes.ErrSupplierDuplicateAddress.Wrapf(
+				"destination supplier with operator address %q exists", msg.GetDestinationOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	// Ensure the source supplier exists.
+	srcSupplier, isSrcFound := k.GetSupplier(ctx, msg.GetSourceOperatorAddress())
+	if !isSrcFound {
+		return nil, status.Error(
+			codes.NotFound,
+			suppliertypes.ErrSupplierNotFound.Wrapf(
+				"source supplier with operator address %q", msg.GetSourceOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	// Only the owner is allowed to transfer the supplier since it controls the staked funds.
+	if !srcSupplier.HasOwner(msg.GetOwnerAddress()) {
+		logger.Info("only the supplier owner is allowed to transfer the supplier")
+		return nil, status.Error(
+			codes.PermissionDenied,
+			sharedtypes.ErrSharedUnauthorizedSupplierUpdate.Wrapf(
+				"signer %q is not allowed to transfer supplier %q owned by %q",
+				msg.GetOwnerAddress(), msg.GetSourceOperatorAddress(), srcSupplier.GetOwnerAddress(),
+			).Error(),
+		)
+	}
+
+	// Ensure the source supplier is not unbonding (which includes already transferred suppliers).
+	if srcSupplier.IsUnbonding() {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			suppliertypes.ErrSupplierIsUnstaking.Wrapf(
+				"cannot transfer unbonding source supplier %q", msg.GetSourceOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	// Ensure the source supplier is not already transferring.
+	if srcSupplier.HasPendingTransfer() {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			suppliertypes.ErrSupplierIsTransferring.Wrapf(
+				"source supplier %q is already transferring to %q",
+				msg.GetSourceOperatorAddress(), srcSupplier.Transfer.GetDestinationOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, sdkCtx.BlockHeight())
+
+	// The transfer completes at the end of the current session such that the
+	// onchain sessions' suppliers list does not change mid-session.
+	srcSupplier.Transfer = &sharedtypes.SupplierTransfer{
+		DestinationOperatorAddress: msg.GetDestinationOperatorAddress(),
+		SessionEndHeight:           uint64(sessionEndHeight),
+	}
+
+	// Update the source supplier record in state
+	k.SetAndIndexDehydratedSupplier(ctx, srcSupplier)
+	logger.Info(fmt.Sprintf(
+		"Successfully began transfer of supplier from (%s) to (%s)",
+		srcSupplier.GetOperatorAddress(), msg.GetDestinationOperatorAddress(),
+	))
+
+	// dehydrate the supplier to avoid sending the entire object
+	srcSupplier.Services = nil
+	srcSupplier.ServiceConfigHistory = nil
+
+	event := &suppliertypes.EventSupplierTransferBegin{
+		SourceOperatorAddress:      srcSupplier.GetOperatorAddress(),
+		DestinationOperatorAddress: msg.GetDestinationOperatorAddress(),
+		SourceSupplier:             &srcSupplier,
+		SessionEndHeight:           sessionEndHeight,
+		TransferEndHeight:          sessionEndHeight,
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		err = suppliertypes.ErrSupplierEmitEvent.Wrapf("(%+v): %s", event, err)
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	isSuccessful = true
+	return &suppliertypes.MsgTransferSupplierResponse{}, nil
+}
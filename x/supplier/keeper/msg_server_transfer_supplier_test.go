@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_TransferSupplier_Success(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+
+	ownerAddr := sample.AccAddressBech32()
+	srcOperatorAddr := sample.AccAddressBech32()
+	dstOperatorAddr := sample.AccAddressBech32()
+
+	// Stake the source supplier and activate its services.
+	stakeAmount := suppliertypes.DefaultMinStake.Amount.Int64()
+	stakeMsg, _ := newSupplierStakeMsg(ownerAddr, srcOperatorAddr, stakeAmount, serviceID)
+	_, err := srv.StakeSupplier(ctx, stakeMsg)
+	require.NoError(t, err)
+
+	ctx = setBlockHeightToNextSessionStart(ctx, supplierModuleKeepers.SharedKeeper)
+	_, err = supplierModuleKeepers.BeginBlockerActivateSupplierServices(ctx)
+	require.NoError(t, err)
+	ctx, _ = testevents.ResetEventManager(ctx)
+
+	// Begin the transfer of the source supplier.
+	transferMsg := suppliertypes.NewMsgTransferSupplier(ownerAddr, srcOperatorAddr, dstOperatorAddr)
+	_, err = srv.TransferSupplier(ctx, transferMsg)
+	require.NoError(t, err)
+
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, cosmostypes.UnwrapSDKContext(ctx).BlockHeight())
+	srcSupplier, isSupplierFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, srcOperatorAddr)
+	require.True(t, isSupplierFound)
+	require.True(t, srcSupplier.HasPendingTransfer())
+	require.False(t, srcSupplier.IsUnbonding())
+	require.Equal(t, dstOperatorAddr, srcSupplier.Transfer.GetDestinationOperatorAddress())
+	require.Equal(t, uint64(sessionEndHeight), srcSupplier.Transfer.GetSessionEndHeight())
+
+	// Assert that the EventSupplierTransferBegin event is emitted.
+	events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	transferBeginEvents := testevents.FilterEvents[*suppliertypes.EventSupplierTransferBegin](t, events)
+	require.Len(t, transferBeginEvents, 1)
+	require.Equal(t, srcOperatorAddr, transferBeginEvents[0].GetSourceOperatorAddress())
+	require.Equal(t, dstOperatorAddr, transferBeginEvents[0].GetDestinationOperatorAddress())
+	require.Equal(t, sessionEndHeight, transferBeginEvents[0].GetTransferEndHeight())
+
+	// The transfer is not completed before the end of the session.
+	ctx, _ = testevents.ResetEventManager(ctx)
+	numTransferredSuppliers, err := supplierModuleKeepers.EndBlockerTransferSuppliers(ctx)
+	require.NoError(t, err)
+	require.Zero(t, numTransferredSuppliers)
+
+	// Complete the transfer at the end of the session.
+	ctx = keepertest.SetBlockHeight(ctx, sessionEndHeight)
+	numTransferredSuppliers, err = supplierModuleKeepers.EndBlockerTransferSuppliers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), numTransferredSuppliers)
+
+	events = cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	transferEndEvents := testevents.FilterEvents[*suppliertypes.EventSupplierTransferEnd](t, events)
+	require.Len(t, transferEndEvents, 1)
+	require.Equal(t, dstOperatorAddr, transferEndEvents[0].GetDestinationOperatorAddress())
+	require.Equal(t, sessionEndHeight+1, transferEndEvents[0].GetActivationHeight())
+	unbondingBeginEvents := testevents.FilterEvents[*suppliertypes.EventSupplierUnbondingBegin](t, events)
+	require.Len(t, unbondingBeginEvents, 1)
+	require.Equal(t,
+		suppliertypes.SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_TRANSFER,
+		unbondingBeginEvents[0].GetReason(),
+	)
+
+	// The source supplier is unbonding and its services end with the current session.
+	srcSupplier, isSupplierFound = supplierModuleKeepers.GetSupplier(ctx, srcOperatorAddr)
+	require.True(t, isSupplierFound)
+	require.True(t, srcSupplier.IsTransferred())
+	require.Equal(t, uint64(sessionEndHeight), srcSupplier.GetUnstakeSessionEndHeight())
+	require.True(t, srcSupplier.IsActive(sessionEndHeight, serviceID))
+	require.False(t, srcSupplier.IsActive(sessionEndHeight+1, serviceID))
+
+	// The destination supplier holds the stake and provides the services from the next session.
+	dstSupplier, isSupplierFound := supplierModuleKeepers.GetSupplier(ctx, dstOperatorAddr)
+	require.True(t, isSupplierFound)
+	require.Equal(t, ownerAddr, dstSupplier.GetOwnerAddress())
+	require.Equal(t, srcSupplier.GetStake(), dstSupplier.GetStake())
+	require.False(t, dstSupplier.IsUnbonding())
+	require.Nil(t, dstSupplier.Transfer)
+	require.False(t, dstSupplier.IsActive(sessionEndHeight, serviceID))
+	require.True(t, dstSupplier.IsActive(sessionEndHeight+1, serviceID))
+	require.Len(t, dstSupplier.ServiceConfigHistory, 1)
+	require.Equal(t, dstOperatorAddr, dstSupplier.ServiceConfigHistory[0].GetOperatorAddress())
+
+	// The transferred supplier cannot be re-staked.
+	restakeMsg, _ := newSupplierStakeMsg(ownerAddr, srcOperatorAddr, stakeAmount, serviceID)
+	_, err = srv.StakeSupplier(ctx, restakeMsg)
+	require.ErrorContains(t, err, suppliertypes.ErrSupplierIsTransferring.Error())
+
+	// The source supplier stake is NOT returned to the owner once it finishes unbonding.
+	unbondingEndHeight := sharedtypes.GetSupplierUnbondingEndHeight(&sharedParams, &srcSupplier)
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight)
+	numUnbondedSuppliers, err := supplierModuleKeepers.EndBlockerUnbondSuppliers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), numUnbondedSuppliers)
+	require.Zero(t, supplierModuleKeepers.SupplierBalanceMap[ownerAddr])
+
+	_, isSupplierFound = supplierModuleKeepers.GetSupplier(ctx, srcOperatorAddr)
+	require.False(t, isSupplierFound)
+	_, isSupplierFound = supplierModuleKeepers.GetSupplier(ctx, dstOperatorAddr)
+	require.True(t, isSupplierFound)
+}
+
+func TestMsgServer_TransferSupplier_Error(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+
+	ownerAddr := sample.AccAddressBech32()
+	srcOperatorAddr := sample.AccAddressBech32()
+	unbondingOperatorAddr := sample.AccAddressBech32()
+	existingOperatorAddr := sample.AccAddressBech32()
+	stakeAmount := suppliertypes.DefaultMinStake.Amount.Int64()
+
+	for _, operatorAddr := range []string{srcOperatorAddr, unbondingOperatorAddr, existingOperatorAddr} {
+		stakeMsg, _ := newSupplierStakeMsg(ownerAddr, operatorAddr, stakeAmount, serviceID)
+		_, err := srv.StakeSupplier(ctx, stakeMsg)
+		require.NoError(t, err)
+	}
+
+	_, err := srv.UnstakeSupplier(ctx, suppliertypes.NewMsgUnstakeSupplier(ownerAddr, unbondingOperatorAddr))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc         string
+		msg          *suppliertypes.MsgTransferSupplier
+		expectedCode codes.Code
+		expectedErr  error
+	}{
+		{
+			desc:         "invalid destination address",
+			msg:          suppliertypes.NewMsgTransferSupplier(ownerAddr, srcOperatorAddr, "invalid_address"),
+			expectedCode: codes.InvalidArgument,
+			expectedErr:  suppliertypes.ErrSupplierInvalidAddress,
+		},
+		{
+			desc:         "source supplier not found",
+			msg:          suppliertypes.NewMsgTransferSupplier(ownerAddr, sample.AccAddressBech32(), sample.AccAddressBech32()),
+			expectedCode: codes.NotFound,
+			expectedErr:  suppliertypes.ErrSupplierNotFound,
+		},
+		{
+			desc:         "destination supplier exists",
+			msg:          suppliertypes.NewMsgTransferSupplier(ownerAddr, srcOperatorAddr, existingOperatorAddr),
+			expectedCode: codes.FailedPrecondition,
+			expectedErr:  suppliertypes.ErrSupplierDuplicateAddress,
+		},
+		{
+			desc:         "signer is not the owner",
+			msg:          suppliertypes.NewMsgTransferSupplier(srcOperatorAddr, srcOperatorAddr, sample.AccAddressBech32()),
+			expectedCode: codes.PermissionDenied,
+			expectedErr:  sharedtypes.ErrSharedUnauthorizedSupplierUpdate,
+		},
+		{
+			desc:         "source supplier is unbonding",
+			msg:          suppliertypes.NewMsgTransferSupplier(ownerAddr, unbondingOperatorAddr, sample.AccAddressBech32()),
+			expectedCode: codes.FailedPrecondition,
+			expectedErr:  suppliertypes.ErrSupplierIsUnstaking,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := srv.TransferSupplier(ctx, test.msg)
+			require.Error(t, err)
+			require.Equal(t, test.expectedCode, status.Code(err))
+			require.ErrorContains(t, err, test.expectedErr.Error())
+		})
+	}
+
+	// A supplier cannot be transferred, nor unstaked, while it is transferring.
+	_, err = srv.TransferSupplier(ctx, suppliertypes.NewMsgTransferSupplier(ownerAddr, srcOperatorAddr, sample.AccAddressBech32()))
+	require.NoError(t, err)
+
+	_, err = srv.TransferSupplier(ctx, suppliertypes.NewMsgTransferSupplier(ownerAddr, srcOperatorAddr, sample.AccAddressBech32()))
+	require.ErrorContains(t, err, suppliertypes.ErrSupplierIsTransferring.Error())
+
+	_, err = srv.UnstakeSupplier(ctx, suppliertypes.NewMsgUnstakeSupplier(ownerAddr, srcOperatorAddr))
+	require.ErrorContains(t, err, suppliertypes.ErrSupplierIsTransferring.Error())
+}
+
+func TestEndBlockerTransferSuppliers_DestinationStakedDuringTransfer(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+
+	ownerAddr := sample.AccAddressBech32()
+	srcOperatorAddr := sample.AccAddressBech32()
+	dstOperatorAddr := sample.AccAddressBech32()
+	stakeAmount := suppliertypes.DefaultMinStake.Amount.Int64()
+
+	stakeMsg, _ := newSupplierStakeMsg(ownerAddr, srcOperatorAddr, stakeAmount, serviceID)
+	_, err := srv.StakeSupplier(ctx, stakeMsg)
+	require.NoError(t, err)
+
+	_, err = srv.TransferSupplier(ctx, suppliertypes.NewMsgTransferSupplier(ownerAddr, srcOperatorAddr, dstOperatorAddr))
+	require.NoError(t, err)
+
+	// Stake the destination supplier before the transfer completes.
+	stakeMsg, _ = newSupplierStakeMsg(dstOperatorAddr, dstOperatorAddr, stakeAmount, serviceID)
+	_, err = srv.StakeSupplier(ctx, stakeMsg)
+	require.NoError(t, err)
+
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, cosmostypes.UnwrapSDKContext(ctx).BlockHeight())
+	ctx = keepertest.SetBlockHeight(ctx, sessionEndHeight)
+	ctx, _ = testevents.ResetEventManager(ctx)
+
+	numTransferredSuppliers, err := supplierModuleKeepers.EndBlockerTransferSuppliers(ctx)
+	require.NoError(t, err)
+	require.Zero(t, numTransferredSuppliers)
+
+	// Assert that the EventSupplierTransferError event is emitted.
+	events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	transferErrorEvents := testevents.FilterEvents[*suppliertypes.EventSupplierTransferError](t, events)
+	require.Len(t, transferErrorEvents, 1)
+	require.Equal(t, srcOperatorAddr, transferErrorEvents[0].GetSourceOperatorAddress())
+	require.Equal(t, dstOperatorAddr, transferErrorEvents[0].GetDestinationOperatorAddress())
+
+	// The source supplier is no longer transferring and keeps providing service.
+	srcSupplier, isSupplierFound := supplierModuleKeepers.GetSupplier(ctx, srcOperatorAddr)
+	require.True(t, isSupplierFound)
+	require.Nil(t, srcSupplier.Transfer)
+	require.False(t, srcSupplier.IsUnbonding())
+	require.True(t, srcSupplier.IsActive(sessionEndHeight+1, serviceID))
+
+	// The destination supplier is unaffected.
+	dstSupplier, isSupplierFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, dstOperatorAddr)
+	require.True(t, isSupplierFound)
+	require.Equal(t, dstOperatorAddr, dstSupplier.GetOwnerAddress())
+}
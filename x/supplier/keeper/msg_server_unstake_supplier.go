@@ -78,6 +78,18 @@ func (k msgServer) UnstakeSupplier(
 		)
 	}
 
+	// A supplier with a pending transfer cannot be unstaked; its stake will be
+	// moved to the destination supplier at the end of the session.
+	if supplier.HasPendingTransfer() {
+		logger.Info(fmt.Sprintf("Supplier %s is transferring to %s", msg.GetOperatorAddress(), supplier.Transfer.GetDestinationOperatorAddress()))
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			suppliertypes.ErrSupplierIsTransferring.Wrapf(
+				"supplier with operator address %q", msg.GetOperatorAddress(),
+			).Error(),
+		)
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentHeight := sdkCtx.BlockHeight()
 	sharedParams := k.sharedKeeper.GetParams(ctx)
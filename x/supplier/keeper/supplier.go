@@ -18,11 +18,16 @@ import (
 // The function:
 // - Indexes service config updates for efficient retrieval
 // - Indexes unstaking height (if applicable)
+// - Indexes pending transfer (if applicable)
+// - Indexes pending stake decreases (if applicable)
 // - Stores a dehydrated form of the supplier (without services and history)
+// - Records its stake in the stake history (if changed)
 func (k Keeper) SetAndIndexDehydratedSupplier(ctx context.Context, supplier sharedtypes.Supplier) {
 	// Index service config updates for efficient retrieval
 	k.indexSupplierServiceConfigUpdates(ctx, supplier)
 	k.indexSupplierUnstakingHeight(ctx, supplier)
+	k.indexSupplierTransfer(ctx, supplier)
+	k.indexSupplierStakeDecrease(ctx, supplier)
 	// Store the supplier in a dehydrated form to reduce state bloat
 	k.SetDehydratedSupplier(ctx, supplier)
 }
@@ -30,10 +35,14 @@ func (k Keeper) SetAndIndexDehydratedSupplier(ctx context.Context, supplier shar
 // SetDehydratedSupplier stores a dehydrated supplier in the store.
 // It omits service details and history to reduce state bloat.
 // This is useful when the service details are not needed for the current operation.
+// Its stake is recorded in the stake history if it changed, so that sessions are
+// hydrated with the stakes their suppliers had at their start height.
 func (k Keeper) SetDehydratedSupplier(
 	ctx context.Context,
 	supplier sharedtypes.Supplier,
 ) {
+	k.recordSupplierStake(ctx, &supplier)
+
 	// Dehydrate the supplier to reduce state bloat.
 	// These details can be hydrated just-in-time (when queried) using the indexes.
 	supplier.Services = nil
@@ -82,6 +91,9 @@ func (k Keeper) RemoveSupplier(ctx context.Context, supplierOperatorAddress stri
 	// Remove all associated indexes
 	k.removeSupplierServiceConfigUpdateIndexes(ctx, supplierOperatorAddress)
 	k.removeSupplierUnstakingHeightIndex(ctx, supplierOperatorAddress)
+	k.removeSupplierTransferIndex(ctx, supplierOperatorAddress)
+	k.removeSupplierStakeDecreaseIndex(ctx, supplierOperatorAddress)
+	k.removeSupplierStakeHistory(ctx, supplierOperatorAddress)
 
 	// Delete the supplier from the store
 	supplierStore := k.getSupplierStore(ctx)
@@ -118,6 +130,28 @@ func (k Keeper) GetAllUnstakingSuppliersIterator(
 	return storetypes.KVStorePrefixIterator(supplierUnstakingHeightStore, []byte{})
 }
 
+// GetAllTransferringSuppliersIterator returns an iterator for all suppliers that
+// have a pending transfer.
+// It is used to complete the transfers at the end of the session in which they began.
+func (k Keeper) GetAllTransferringSuppliersIterator(
+	ctx context.Context,
+) storetypes.Iterator {
+	supplierTransferStore := k.getSupplierTransferStore(ctx)
+
+	return storetypes.KVStorePrefixIterator(supplierTransferStore, []byte{})
+}
+
+// GetAllStakeDecreasingSuppliersIterator returns an iterator for all suppliers that
+// have pending stake decreases.
+// It is used to return the stake decreases that have completed their unbonding period.
+func (k Keeper) GetAllStakeDecreasingSuppliersIterator(
+	ctx context.Context,
+) storetypes.Iterator {
+	supplierStakeDecreaseStore := k.getSupplierStakeDecreaseStore(ctx)
+
+	return storetypes.KVStorePrefixIterator(supplierStakeDecreaseStore, []byte{})
+}
+
 // hydrateFullSupplierServiceConfigs populates a supplier with all of its service configurations
 // based on the current block height.
 //
@@ -192,6 +226,18 @@ func (k Keeper) getSupplierUnstakingHeightStore(ctx context.Context) storetypes.
 	return prefix.NewStore(storeAdapter, types.KeyPrefix(types.SupplierUnstakingHeightKeyPrefix))
 }
 
+// getSupplierTransferStore returns a KVStore for the supplier pending transfer index
+func (k Keeper) getSupplierTransferStore(ctx context.Context) storetypes.KVStore {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(types.SupplierTransferKeyPrefix))
+}
+
+// getSupplierStakeDecreaseStore returns a KVStore for the supplier pending stake decrease index
+func (k Keeper) getSupplierStakeDecreaseStore(ctx context.Context) storetypes.KVStore {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(types.SupplierStakeDecreaseKeyPrefix))
+}
+
 // storeSupplier marshals and stores the supplier record in the supplier store.
 func (k Keeper) storeSupplier(ctx context.Context, supplier *sharedtypes.Supplier) {
 	supplierBz := k.cdc.MustMarshal(supplier)
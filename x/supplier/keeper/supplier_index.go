@@ -10,6 +10,8 @@ package keeper
 // │ serviceConfigUpdateActivationHeightStore       ActHeight || PK         → PK           │
 // │ serviceConfigUpdateDeactivationHeightStore     DeactHeight || PK       → PK           │
 // │ supplierUnstakingHeightStore                   SupplierAddr            → []byte(addr) │
+// │ supplierTransferStore                          SupplierAddr            → []byte(addr) │
+// │ supplierStakeDecreaseStore                     SupplierAddr            → []byte(addr) │
 // └───────────────────────────────────────────────────────────────────────────────────────┘
 //
 // Legend
@@ -22,6 +24,8 @@ package keeper
 //   • Height (act)  → activationHeightStore            → [PK] → serviceConfigUpdateStore.
 //   • Height (deact)→ deactivationHeightStore          → [PK] → serviceConfigUpdateStore.
 //   • Unbonding set → iterate supplierUnstakingHeightStore keys.
+//   • Transfer set  → iterate supplierTransferStore keys.
+//   • Decrease set  → iterate supplierStakeDecreaseStore keys.
 //
 // Index counts
 //   ① Primary data
@@ -29,6 +33,8 @@ package keeper
 //   ③ By act-height
 //   ④ By deact-height
 //   ⑤ Unstaking suppliers
+//   ⑥ Transferring suppliers
+//   ⑦ Suppliers with pending stake decreases
 
 import (
 	"context"
@@ -129,6 +135,51 @@ func (k Keeper) indexSupplierUnstakingHeight(
 	}
 }
 
+// indexSupplierTransfer maintains an index of suppliers that have a pending transfer.
+//
+// This function either adds or removes a supplier from the transfer index
+// depending on whether the supplier has a pending transfer:
+// - If the supplier is transferring (Transfer set and not yet unbonding), it's added to the index
+// - Otherwise, it's removed from the index
+//
+// This index enables the EndBlocker to efficiently find suppliers that are transferring
+// without iterating over and unmarshaling all suppliers in the store.
+func (k Keeper) indexSupplierTransfer(
+	ctx context.Context,
+	supplier sharedtypes.Supplier,
+) {
+	supplierTransferStore := k.getSupplierTransferStore(ctx)
+	supplierOperatorKey := types.SupplierOperatorKey(supplier.OperatorAddress)
+	if supplier.HasPendingTransfer() {
+		supplierTransferStore.Set(supplierOperatorKey, []byte(supplier.OperatorAddress))
+	} else {
+		supplierTransferStore.Delete(supplierOperatorKey)
+	}
+}
+
+// indexSupplierStakeDecrease maintains an index of suppliers that have pending
+// stake decreases.
+//
+// This function either adds or removes a supplier from the stake decrease index
+// depending on whether the supplier has pending stake decreases:
+// - If the supplier has at least one pending stake decrease, it's added to the index
+// - Otherwise, it's removed from the index
+//
+// This index enables the EndBlocker to efficiently find the stake decreases to
+// unbond without iterating over and unmarshaling all suppliers in the store.
+func (k Keeper) indexSupplierStakeDecrease(
+	ctx context.Context,
+	supplier sharedtypes.Supplier,
+) {
+	supplierStakeDecreaseStore := k.getSupplierStakeDecreaseStore(ctx)
+	supplierOperatorKey := types.SupplierOperatorKey(supplier.OperatorAddress)
+	if len(supplier.PendingStakeDecreases) > 0 {
+		supplierStakeDecreaseStore.Set(supplierOperatorKey, []byte(supplier.OperatorAddress))
+	} else {
+		supplierStakeDecreaseStore.Delete(supplierOperatorKey)
+	}
+}
+
 // getSupplierServiceConfigUpdates retrieves all service configuration updates for a specific supplier.
 //
 // This function uses the supplier-to-service index to efficiently find all service
@@ -262,6 +313,34 @@ func (k Keeper) removeSupplierUnstakingHeightIndex(
 	supplierUnstakingHeightStore.Delete(supplierUnstakeKey)
 }
 
+// removeSupplierTransferIndex removes a supplier from the transfer index.
+//
+// This function is called when a supplier is completely removed from the state or
+// when its pending transfer completes or fails.
+func (k Keeper) removeSupplierTransferIndex(
+	ctx context.Context,
+	supplierOperatorAddress string,
+) {
+	supplierTransferStore := k.getSupplierTransferStore(ctx)
+
+	supplierTransferKey := types.SupplierOperatorKey(supplierOperatorAddress)
+	supplierTransferStore.Delete(supplierTransferKey)
+}
+
+// removeSupplierStakeDecreaseIndex removes a supplier from the stake decrease index.
+//
+// This function is called when a supplier is completely removed from the state or
+// when the stake decrease index is found dangling.
+func (k Keeper) removeSupplierStakeDecreaseIndex(
+	ctx context.Context,
+	supplierOperatorAddress string,
+) {
+	supplierStakeDecreaseStore := k.getSupplierStakeDecreaseStore(ctx)
+
+	supplierStakeDecreaseKey := types.SupplierOperatorKey(supplierOperatorAddress)
+	supplierStakeDecreaseStore.Delete(supplierStakeDecreaseKey)
+}
+
 // MigrateSupplierServiceConfigIndexes migrates the supplier service config indexes
 // for all suppliers:
 // - From the deprecated format: supplierAddress/ActivationHeight/ServiceId
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// GetSupplierStakeAtHeight returns the stake the given supplier had at the given height,
+// i.e. the last stake recorded at or before it.
+//
+// Heights preceding the supplier's stake history (e.g. before the history was
+// introduced) fall back to the oldest recorded stake.
+// It returns false if no stake was ever recorded for the supplier.
+func (k Keeper) GetSupplierStakeAtHeight(
+	ctx context.Context,
+	supplierOperatorAddr string,
+	queryHeight int64,
+) (stake cosmostypes.Coin, found bool) {
+	stakeHistoryStore := k.getSupplierStakeHistoryStore(ctx, supplierOperatorAddr)
+
+	// The last stake recorded at or before the query height.
+	stakeIterator := stakeHistoryStore.ReverseIterator(nil, types.IntKey(queryHeight+1))
+	defer stakeIterator.Close()
+	if stakeIterator.Valid() {
+		k.cdc.MustUnmarshal(stakeIterator.Value(), &stake)
+		return stake, true
+	}
+
+	// The oldest recorded stake, if any.
+	oldestStakeIterator := stakeHistoryStore.Iterator(nil, nil)
+	defer oldestStakeIterator.Close()
+	if oldestStakeIterator.Valid() {
+		k.cdc.MustUnmarshal(oldestStakeIterator.Value(), &stake)
+		return stake, true
+	}
+
+	return stake, false
+}
+
+// MigrateSupplierStakeHistory records the current stake of every supplier, so that
+// stake changes following the upgrade do not change the stake of the sessions that
+// started before them.
+// TODO_DELETE(@red-0ne): Remove this function after the vNEXT upgrade
+func (k Keeper) MigrateSupplierStakeHistory(ctx context.Context) {
+	supplierStore := k.getSupplierStore(ctx)
+	supplierIterator := storetypes.KVStorePrefixIterator(supplierStore, []byte{})
+	defer supplierIterator.Close()
+
+	for ; supplierIterator.Valid(); supplierIterator.Next() {
+		var supplier sharedtypes.Supplier
+		k.cdc.MustUnmarshal(supplierIterator.Value(), &supplier)
+		k.recordSupplierStake(ctx, &supplier)
+	}
+}
+
+// recordSupplierStake records the stake of the given supplier from the current
+// height, unless it is the last recorded one.
+//
+// The stake history entries which can no longer be the stake at the start of a
+// session with pending claims or proofs are pruned.
+func (k Keeper) recordSupplierStake(ctx context.Context, supplier *sharedtypes.Supplier) {
+	if supplier.Stake == nil {
+		return
+	}
+
+	currentHeight := cosmostypes.UnwrapSDKContext(ctx).BlockHeight()
+	stakeHistoryStore := k.getSupplierStakeHistoryStore(ctx, supplier.OperatorAddress)
+
+	lastStakeIterator := stakeHistoryStore.ReverseIterator(nil, nil)
+	isLastRecordedStake := false
+	if lastStakeIterator.Valid() {
+		var lastStake cosmostypes.Coin
+		k.cdc.MustUnmarshal(lastStakeIterator.Value(), &lastStake)
+		isLastRecordedStake = lastStake.Denom == supplier.Stake.Denom &&
+			lastStake.Amount.Equal(supplier.Stake.Amount)
+	}
+	lastStakeIterator.Close()
+
+	if isLastRecordedStake {
+		return
+	}
+
+	stakeHistoryStore.Set(types.IntKey(currentHeight), k.cdc.MustMarshal(supplier.Stake))
+
+	k.pruneSupplierStakeHistory(ctx, stakeHistoryStore, currentHeight)
+}
+
+// pruneSupplierStakeHistory deletes the stake history entries that precede the
+// stake the supplier had at the retention height.
+//
+// The retention height goes back a full supplier unbonding period (plus the current
+// session), which is guaranteed to exceed the claim and proof windows of any session
+// that has not been settled yet.
+func (k Keeper) pruneSupplierStakeHistory(
+	ctx context.Context,
+	stakeHistoryStore storetypes.KVStore,
+	currentHeight int64,
+) {
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	retentionBlocks := int64((sharedParams.SupplierUnbondingPeriodSessions + 1) * sharedParams.NumBlocksPerSession)
+	retentionHeight := currentHeight - retentionBlocks
+	if retentionHeight <= 0 {
+		return
+	}
+
+	// The entries recorded at or before the retention height, latest first.
+	// The latest of them is the stake at the retention height, so it is kept.
+	retainedStakeIterator := stakeHistoryStore.ReverseIterator(nil, types.IntKey(retentionHeight+1))
+	prunedStakeKeys := make([][]byte, 0)
+	for isRetainedStake := true; retainedStakeIterator.Valid(); retainedStakeIterator.Next() {
+		if isRetainedStake {
+			isRetainedStake = false
+			continue
+		}
+		prunedStakeKeys = append(prunedStakeKeys, retainedStakeIterator.Key())
+	}
+	retainedStakeIterator.Close()
+
+	for _, prunedStakeKey := range prunedStakeKeys {
+		stakeHistoryStore.Delete(prunedStakeKey)
+	}
+}
+
+// removeSupplierStakeHistory removes the stake history of a supplier.
+//
+// This function is called when a supplier is completely removed from the state,
+// alongside its service config updates.
+func (k Keeper) removeSupplierStakeHistory(
+	ctx context.Context,
+	supplierOperatorAddress string,
+) {
+	stakeHistoryStore := k.getSupplierStakeHistoryStore(ctx, supplierOperatorAddress)
+
+	stakeHistoryIterator := stakeHistoryStore.Iterator(nil, nil)
+	stakeHistoryKeys := make([][]byte, 0)
+	for ; stakeHistoryIterator.Valid(); stakeHistoryIterator.Next() {
+		stakeHistoryKeys = append(stakeHistoryKeys, stakeHistoryIterator.Key())
+	}
+	stakeHistoryIterator.Close()
+
+	for _, stakeHistoryKey := range stakeHistoryKeys {
+		stakeHistoryStore.Delete(stakeHistoryKey)
+	}
+}
+
+// getSupplierStakeHistoryStore returns a KVStore for the stake history of the given supplier,
+// keyed by the height from which each stake is effective.
+func (k Keeper) getSupplierStakeHistoryStore(
+	ctx context.Context,
+	supplierOperatorAddr string,
+) storetypes.KVStore {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	supplierStakeHistoryPrefix := append(
+		types.KeyPrefix(types.SupplierStakeHistoryKeyPrefix),
+		types.SupplierOperatorKey(supplierOperatorAddr)...,
+	)
+	return prefix.NewStore(storeAdapter, supplierStakeHistoryPrefix)
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestSupplierStakeHistory_GetSupplierStakeAtHeight(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+
+	supplier := sharedtypes.Supplier{
+		OwnerAddress:    sample.AccAddressBech32(),
+		OperatorAddress: sample.AccAddressBech32(),
+	}
+
+	// Stake the supplier at height 5, update unrelated fields at height 7 and
+	// increase its stake at height 10.
+	setSupplierStakeAtHeight(t, supplierModuleKeepers, ctx, supplier, 5, 100)
+	setSupplierStakeAtHeight(t, supplierModuleKeepers, ctx, supplier, 7, 100)
+	setSupplierStakeAtHeight(t, supplierModuleKeepers, ctx, supplier, 10, 200)
+
+	tests := []struct {
+		desc          string
+		queryHeight   int64
+		expectedStake int64
+	}{
+		{desc: "before the first recorded stake", queryHeight: 1, expectedStake: 100},
+		{desc: "at the first recorded stake", queryHeight: 5, expectedStake: 100},
+		{desc: "at an unchanged stake update", queryHeight: 7, expectedStake: 100},
+		{desc: "right before the stake increase", queryHeight: 9, expectedStake: 100},
+		{desc: "at the stake increase", queryHeight: 10, expectedStake: 200},
+		{desc: "after the stake increase", queryHeight: 100, expectedStake: 200},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			stake, found := supplierModuleKeepers.GetSupplierStakeAtHeight(ctx, supplier.OperatorAddress, test.queryHeight)
+			require.True(t, found)
+			require.Equal(t, test.expectedStake, stake.Amount.Int64())
+		})
+	}
+
+	_, found := supplierModuleKeepers.GetSupplierStakeAtHeight(ctx, sample.AccAddressBech32(), 10)
+	require.False(t, found)
+}
+
+func TestSupplierStakeHistory_Pruning(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+	retentionBlocks := int64((sharedParams.SupplierUnbondingPeriodSessions + 1) * sharedParams.NumBlocksPerSession)
+
+	supplier := sharedtypes.Supplier{
+		OwnerAddress:    sample.AccAddressBech32(),
+		OperatorAddress: sample.AccAddressBech32(),
+	}
+
+	setSupplierStakeAtHeight(t, supplierModuleKeepers, ctx, supplier, 1, 100)
+	setSupplierStakeAtHeight(t, supplierModuleKeepers, ctx, supplier, 2, 200)
+	setSupplierStakeAtHeight(t, supplierModuleKeepers, ctx, supplier, 3, 300)
+
+	// Update the stake once the stake recorded at height 3 is the stake at the retention height.
+	lastStakeHeight := 4 + retentionBlocks
+	setSupplierStakeAtHeight(t, supplierModuleKeepers, ctx, supplier, lastStakeHeight, 400)
+
+	// The stake at the retention height is kept.
+	stake, found := supplierModuleKeepers.GetSupplierStakeAtHeight(ctx, supplier.OperatorAddress, lastStakeHeight-retentionBlocks)
+	require.True(t, found)
+	require.Equal(t, int64(300), stake.Amount.Int64())
+
+	// The stakes preceding it are pruned and fall back to the oldest retained stake.
+	stake, found = supplierModuleKeepers.GetSupplierStakeAtHeight(ctx, supplier.OperatorAddress, 1)
+	require.True(t, found)
+	require.Equal(t, int64(300), stake.Amount.Int64())
+
+	stake, found = supplierModuleKeepers.GetSupplierStakeAtHeight(ctx, supplier.OperatorAddress, lastStakeHeight)
+	require.True(t, found)
+	require.Equal(t, int64(400), stake.Amount.Int64())
+}
+
+func TestSupplierStakeHistory_RemovedWithSupplier(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+
+	supplier := sharedtypes.Supplier{
+		OwnerAddress:    sample.AccAddressBech32(),
+		OperatorAddress: sample.AccAddressBech32(),
+	}
+	setSupplierStakeAtHeight(t, supplierModuleKeepers, ctx, supplier, 1, 100)
+
+	_, found := supplierModuleKeepers.GetSupplierStakeAtHeight(ctx, supplier.OperatorAddress, 1)
+	require.True(t, found)
+
+	supplierModuleKeepers.RemoveSupplier(ctx, supplier.OperatorAddress)
+
+	_, found = supplierModuleKeepers.GetSupplierStakeAtHeight(ctx, supplier.OperatorAddress, 1)
+	require.False(t, found)
+}
+
+// setSupplierStakeAtHeight stores the given supplier with the given stake at the given height.
+func setSupplierStakeAtHeight(
+	t *testing.T,
+	supplierModuleKeepers keepertest.SupplierModuleKeepers,
+	ctx context.Context,
+	supplier sharedtypes.Supplier,
+	height int64,
+	stakeAmount int64,
+) {
+	t.Helper()
+
+	supplier.Stake = &cosmostypes.Coin{Denom: "upokt", Amount: math.NewInt(stakeAmount)}
+	supplierModuleKeepers.SetAndIndexDehydratedSupplier(keepertest.SetBlockHeight(ctx, height), supplier)
+}
@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/telemetry"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// EndBlockerTransferSuppliers completes pending supplier transfers.
+// This always happens on the last block of the session during which the transfer started.
+// It is accomplished by:
+//  1. Creating the destination supplier from the current state of the source supplier,
+//     with its service configs activated at the start of the next session
+//  2. Unbonding the source supplier, with its service configs deactivated at the start
+//     of the next session
+//
+// The source supplier stake is NOT returned to its owner when it finishes unbonding
+// since it is held by the destination supplier. The source supplier record is retained
+// until then so that its pending claims can be proven and settled.
+func (k Keeper) EndBlockerTransferSuppliers(ctx context.Context) (numTransferredSuppliers uint64, err error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"transfer_supplier_end",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+
+	// Only process supplier transfers at the end of the session in
+	// order to avoid inconsistent/unpredictable mid-session behavior.
+	if !sharedtypes.IsSessionEndHeight(&sharedParams, currentHeight) {
+		isSuccessful = true
+		return numTransferredSuppliers, nil
+	}
+
+	logger := k.Logger().
+		With("method", "EndBlockerTransferSuppliers").
+		With("current_height", currentHeight)
+
+	// Collect the transferring suppliers before processing them since completing
+	// (or failing) a transfer removes the supplier from the iterated index.
+	transferringSupplierAddresses := make([]string, 0)
+	allTransferringSuppliersIterator := k.GetAllTransferringSuppliersIterator(ctx)
+	for ; allTransferringSuppliersIterator.Valid(); allTransferringSuppliersIterator.Next() {
+		transferringSupplierAddresses = append(transferringSupplierAddresses, string(allTransferringSuppliersIterator.Value()))
+	}
+	allTransferringSuppliersIterator.Close()
+
+	for _, srcOperatorAddress := range transferringSupplierAddresses {
+		srcSupplier, found := k.GetSupplier(ctx, srcOperatorAddress)
+		if !found {
+			// We should be able to find the supplier if it is in the index.
+			err = fmt.Errorf("should never happen: could not find transferring supplier %s", srcOperatorAddress)
+			logger.Error(err.Error())
+			return numTransferredSuppliers, err
+		}
+
+		// Ignore suppliers that do not have a pending transfer.
+		if !srcSupplier.HasPendingTransfer() {
+			// If we are getting the supplier from the transfer index and it is not
+			// transferring, this means that there is a dangling entry in the index.
+			// Log the error, remove the index entry but continue to the next supplier.
+			logger.Error(fmt.Sprintf(
+				"should never happen: found supplier %s in transfer store but it is not transferring",
+				srcOperatorAddress,
+			))
+			k.removeSupplierTransferIndex(ctx, srcOperatorAddress)
+			continue
+		}
+
+		// Ignore suppliers whose transfer completes at a later session end height.
+		if currentHeight < int64(srcSupplier.Transfer.GetSessionEndHeight()) {
+			continue
+		}
+
+		// Ensure the destination supplier was not staked since the transfer began.
+		dstOperatorAddress := srcSupplier.Transfer.GetDestinationOperatorAddress()
+		if _, isDstFound := k.GetDehydratedSupplier(ctx, dstOperatorAddress); isDstFound {
+			transferErr := suppliertypes.ErrSupplierDuplicateAddress.Wrapf(
+				"cannot transfer supplier %q to existing supplier %q",
+				srcOperatorAddress, dstOperatorAddress,
+			)
+			logger.Warn(transferErr.Error())
+
+			// Supplier transfer failed, removing the pending transfer from the source supplier.
+			srcSupplier.Transfer = nil
+			k.SetAndIndexDehydratedSupplier(ctx, srcSupplier)
+
+			srcSupplier.Services = nil
+			srcSupplier.ServiceConfigHistory = nil
+			transferErrorEvent := &suppliertypes.EventSupplierTransferError{
+				SourceOperatorAddress:      srcOperatorAddress,
+				DestinationOperatorAddress: dstOperatorAddress,
+				SourceSupplier:             &srcSupplier,
+				SessionEndHeight:           currentHeight,
+				Error:                      transferErr.Error(),
+			}
+			if err = sdkCtx.EventManager().EmitTypedEvent(transferErrorEvent); err != nil {
+				err = suppliertypes.ErrSupplierEmitEvent.Wrapf("(%+v): %s", transferErrorEvent, err)
+				logger.Error(err.Error())
+				return numTransferredSuppliers, err
+			}
+			continue
+		}
+
+		if err = k.transferSupplier(ctx, srcSupplier); err != nil {
+			return numTransferredSuppliers, err
+		}
+
+		numTransferredSuppliers += 1
+	}
+
+	isSuccessful = true
+	return numTransferredSuppliers, nil
+}
+
+// transferSupplier transfers srcSupplier to srcSupplier.Transfer.DestinationOperatorAddress:
+//   - The destination supplier is created with the owner, stake and service configs
+//     of the source supplier. Its service configs are active from the next session.
+//   - The source supplier begins unbonding and its service configs are deactivated
+//     at the start of the next session.
+//
+// The caller MUST ensure that the destination supplier does not exist.
+// It is intended to be called during the EndBlock ABCI method.
+func (k Keeper) transferSupplier(
+	ctx context.Context,
+	srcSupplier sharedtypes.Supplier,
+) error {
+	logger := k.Logger().With("method", "transferSupplier")
+
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)
+	nextSessionStartHeight := sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight)
+	dstOperatorAddress := srcSupplier.Transfer.GetDestinationOperatorAddress()
+
+	// The destination supplier provides the services which the source supplier
+	// would have provided from the start of the next session.
+	dstStake := *srcSupplier.Stake
+	dstSupplier := sharedtypes.Supplier{
+		OwnerAddress:            srcSupplier.GetOwnerAddress(),
+		OperatorAddress:         dstOperatorAddress,
+		Stake:                   &dstStake,
+		Services:                make([]*sharedtypes.SupplierServiceConfig, 0),
+		ServiceConfigHistory:    make([]*sharedtypes.ServiceConfigUpdate, 0),
+		UnstakeSessionEndHeight: sharedtypes.SupplierNotUnstaking,
+	}
+	srcServiceConfigs := sharedtypes.GetActiveServiceConfigsFromHistory(
+		srcSupplier.ServiceConfigHistory,
+		nextSessionStartHeight,
+	)
+	for _, serviceConfig := range srcServiceConfigs {
+		dstSupplier.ServiceConfigHistory = append(dstSupplier.ServiceConfigHistory, &sharedtypes.ServiceConfigUpdate{
+			OperatorAddress:  dstOperatorAddress,
+			Service:          serviceConfig,
+			ActivationHeight: nextSessionStartHeight,
+		})
+	}
+
+	// Unbond the source supplier, retaining its transfer as a record of where its
+	// stake went. Its service configs are deactivated at the start of the next
+	// session, unless they already are.
+	srcSupplier.UnstakeSessionEndHeight = uint64(sessionEndHeight)
+	for _, serviceConfigUpdate := range srcSupplier.ServiceConfigHistory {
+		if serviceConfigUpdate.DeactivationHeight == 0 ||
+			serviceConfigUpdate.DeactivationHeight > nextSessionStartHeight {
+			serviceConfigUpdate.DeactivationHeight = nextSessionStartHeight
+		}
+	}
+
+	k.SetAndIndexDehydratedSupplier(ctx, srcSupplier)
+	k.SetAndIndexDehydratedSupplier(ctx, dstSupplier)
+
+	logger.Info(fmt.Sprintf(
+		"Successfully transferred supplier from (%s) to (%s)",
+		srcSupplier.GetOperatorAddress(), dstSupplier.GetOperatorAddress(),
+	))
+
+	// dehydrate the suppliers to avoid sending the entire objects
+	srcSupplier.Services = nil
+	srcSupplier.ServiceConfigHistory = nil
+	dstSupplier.ServiceConfigHistory = nil
+
+	unbondingEndHeight := sharedtypes.GetSupplierUnbondingEndHeight(&sharedParams, &srcSupplier)
+	events := []cosmostypes.Msg{
+		&suppliertypes.EventSupplierTransferEnd{
+			SourceOperatorAddress:      srcSupplier.GetOperatorAddress(),
+			DestinationOperatorAddress: dstSupplier.GetOperatorAddress(),
+			DestinationSupplier:        &dstSupplier,
+			SessionEndHeight:           sessionEndHeight,
+			ActivationHeight:           nextSessionStartHeight,
+		},
+		&suppliertypes.EventSupplierUnbondingBegin{
+			Supplier:           &srcSupplier,
+			Reason:             suppliertypes.SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_TRANSFER,
+			SessionEndHeight:   sessionEndHeight,
+			UnbondingEndHeight: unbondingEndHeight,
+		},
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvents(events...); err != nil {
+		err = suppliertypes.ErrSupplierEmitEvent.Wrapf("(%+v): %s", events, err)
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
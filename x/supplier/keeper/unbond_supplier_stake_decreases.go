@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// EndBlockerUnbondSupplierStakeDecreases returns the supplier stake decreases whose
+// unbonding period has elapsed to the supplier owners.
+func (k Keeper) EndBlockerUnbondSupplierStakeDecreases(ctx context.Context) (numUnbondedStakeDecreases uint64, err error) {
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+
+	// Only process unbonding stake decreases at the end of the session.
+	if !sharedtypes.IsSessionEndHeight(&sharedParams, currentHeight) {
+		return numUnbondedStakeDecreases, nil
+	}
+
+	logger := k.Logger().With("method", "UnbondSupplierStakeDecreases")
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)
+
+	// Collect the suppliers with pending stake decreases before updating any of them
+	// since updating a supplier may delete its entry from the iterated index.
+	stakeDecreasingSupplierAddresses := make([]string, 0)
+	allStakeDecreasingSuppliersIterator := k.GetAllStakeDecreasingSuppliersIterator(ctx)
+	for ; allStakeDecreasingSuppliersIterator.Valid(); allStakeDecreasingSuppliersIterator.Next() {
+		stakeDecreasingSupplierAddresses = append(
+			stakeDecreasingSupplierAddresses,
+			string(allStakeDecreasingSuppliersIterator.Value()),
+		)
+	}
+	allStakeDecreasingSuppliersIterator.Close()
+
+	for _, supplierAddress := range stakeDecreasingSupplierAddresses {
+		// Get dehydrated supplier from the store to avoid unmarshalling all the supplier service configs.
+		supplier, found := k.GetDehydratedSupplier(ctx, supplierAddress)
+		if !found {
+			// The supplier may have been removed without its index entry being cleaned up.
+			// Log the error, remove the index entry but continue to the next supplier.
+			logger.Error(fmt.Sprintf(
+				"should never happen: could not find supplier %s with pending stake decreases, removing index entry",
+				supplierAddress,
+			))
+			k.removeSupplierStakeDecreaseIndex(ctx, supplierAddress)
+			continue
+		}
+
+		// If the supplier has no pending stake decreases, this means that there is
+		// a dangling entry in the index. Remove it and continue to the next supplier.
+		if len(supplier.PendingStakeDecreases) == 0 {
+			logger.Error(fmt.Sprintf(
+				"should never happen: found supplier %s in stake decrease store but it has no pending stake decreases",
+				supplierAddress,
+			))
+			k.removeSupplierStakeDecreaseIndex(ctx, supplierAddress)
+			continue
+		}
+
+		// Retrieve the owner address of the supplier.
+		ownerAddress, err := cosmostypes.AccAddressFromBech32(supplier.OwnerAddress)
+		if err != nil {
+			logger.Error(fmt.Sprintf("could not parse the owner address %s", supplier.OwnerAddress))
+			return numUnbondedStakeDecreases, err
+		}
+
+		events := make([]cosmostypes.Msg, 0)
+		remainingStakeDecreases := make([]*sharedtypes.PendingStakeDecrease, 0, len(supplier.PendingStakeDecreases))
+		for _, stakeDecrease := range supplier.PendingStakeDecreases {
+			// Compute the unbonding end height using the shared params that were effective
+			// when the stake decrease began unbonding, NOT the live params.
+			decreaseParams := k.sharedKeeper.GetParamsAtHeight(ctx, int64(stakeDecrease.GetSessionEndHeight()))
+			unbondingEndHeight := sharedtypes.GetSupplierStakeDecreaseUnbondingEndHeight(&decreaseParams, stakeDecrease)
+
+			// If the unbonding height is ahead of the current height, the stake
+			// decrease stays in the unbonding state.
+			if unbondingEndHeight > currentHeight {
+				remainingStakeDecreases = append(remainingStakeDecreases, stakeDecrease)
+				continue
+			}
+
+			// If the stake decrease was fully slashed, then do not move 0 coins
+			// to the owner account.
+			if stakeDecrease.Amount.IsPositive() {
+				// Send the coins from the supplier pool back to the supplier owner.
+				// If the transfer fails, the coins remain in the supplier module pool and
+				// EventSupplierStakeStuckInModulePool surfaces them, mirroring EndBlockerUnbondSuppliers.
+				if err = k.bankKeeper.SendCoinsFromModuleToAccount(
+					ctx, suppliertypes.ModuleName, ownerAddress, []cosmostypes.Coin{*stakeDecrease.Amount},
+				); err != nil {
+					logger.Error(fmt.Sprintf(
+						"could not send %s coins from module %s to account %s due to %s; stake decrease will be dropped and coins will remain in module pool (see EventSupplierStakeStuckInModulePool)",
+						stakeDecrease.Amount, suppliertypes.ModuleName, ownerAddress, err,
+					))
+
+					events = append(events, &suppliertypes.EventSupplierStakeStuckInModulePool{
+						OperatorAddress:  supplier.OperatorAddress,
+						OwnerAddress:     supplier.OwnerAddress,
+						StuckCoin:        stakeDecrease.Amount,
+						Reason:           err.Error(),
+						SessionEndHeight: sessionEndHeight,
+					})
+					continue
+				}
+			}
+
+			events = append(events, &suppliertypes.EventSupplierStakeDecreaseUnbondingEnd{
+				OperatorAddress:    supplier.OperatorAddress,
+				OwnerAddress:       supplier.OwnerAddress,
+				Amount:             stakeDecrease.Amount,
+				SessionEndHeight:   sessionEndHeight,
+				UnbondingEndHeight: unbondingEndHeight,
+			})
+			numUnbondedStakeDecreases += 1
+		}
+
+		// Only update the supplier if any of its stake decreases completed unbonding.
+		if len(remainingStakeDecreases) == len(supplier.PendingStakeDecreases) {
+			continue
+		}
+
+		supplier.PendingStakeDecreases = remainingStakeDecreases
+		k.SetDehydratedSupplier(ctx, supplier)
+		k.indexSupplierStakeDecrease(ctx, supplier)
+
+		if err = sdkCtx.EventManager().EmitTypedEvents(events...); err != nil {
+			err = suppliertypes.ErrSupplierEmitEvent.Wrapf("(%+v): %s", events, err)
+			logger.Error(err.Error())
+			return numUnbondedStakeDecreases, err
+		}
+	}
+
+	return numUnbondedStakeDecreases, nil
+}
@@ -0,0 +1,126 @@
+//go:build test
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// TestEndBlockerUnbondSupplierStakeDecreases_ReturnedAfterUnbondingPeriod verifies
+// that a supplier stake decrease is only returned to the owner once it went
+// through the supplier unbonding period.
+func TestEndBlockerUnbondSupplierStakeDecreases_ReturnedAfterUnbondingPeriod(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+	numBlocksPerSession := int64(sharedParams.GetNumBlocksPerSession())
+	stakingFee := supplierModuleKeepers.Keeper.GetParams(ctx).StakingFee.Amount.Int64()
+
+	// Stake a supplier above the minimum stake.
+	supplierAddr := sample.AccAddressBech32()
+	initialStake := suppliertypes.DefaultMinStake.Amount.Int64() + 100
+	stakeMsg, _ := newSupplierStakeMsg(supplierAddr, supplierAddr, initialStake, serviceID)
+	_, err := srv.StakeSupplier(ctx, stakeMsg)
+	require.NoError(t, err)
+
+	// Lower the supplier stake.
+	lowerStake := initialStake - 100
+	lowerStakeMsg, _ := newSupplierStakeMsg(supplierAddr, supplierAddr, lowerStake)
+	_, err = srv.StakeSupplier(ctx, lowerStakeMsg)
+	require.NoError(t, err)
+
+	// The stake decrease is unbonding and not yet returned to the owner.
+	expectedDeduction := -(initialStake + 2*stakingFee)
+	require.Equal(t, expectedDeduction, supplierModuleKeepers.SupplierBalanceMap[supplierAddr])
+
+	supplier, isFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, supplierAddr)
+	require.True(t, isFound)
+	require.Equal(t, lowerStake, supplier.GetStake().Amount.Int64())
+	require.Len(t, supplier.PendingStakeDecreases, 1)
+
+	stakeDecrease := supplier.PendingStakeDecreases[0]
+	require.Equal(t, int64(100), stakeDecrease.GetAmount().Amount.Int64())
+	unbondingEndHeight := sharedtypes.GetSupplierStakeDecreaseUnbondingEndHeight(&sharedParams, stakeDecrease)
+
+	// The stake decrease is still unbonding at the session end preceding the unbonding end height.
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight-numBlocksPerSession)
+	numUnbonded, err := supplierModuleKeepers.EndBlockerUnbondSupplierStakeDecreases(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), numUnbonded)
+	require.Equal(t, expectedDeduction, supplierModuleKeepers.SupplierBalanceMap[supplierAddr])
+
+	// The stake decrease is returned to the owner at the unbonding end height.
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight)
+	numUnbonded, err = supplierModuleKeepers.EndBlockerUnbondSupplierStakeDecreases(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), numUnbonded)
+	require.Equal(t, -(lowerStake + 2*stakingFee), supplierModuleKeepers.SupplierBalanceMap[supplierAddr])
+
+	// The supplier keeps its lowered stake and has no pending stake decreases left.
+	supplier, isFound = supplierModuleKeepers.GetDehydratedSupplier(ctx, supplierAddr)
+	require.True(t, isFound)
+	require.Equal(t, lowerStake, supplier.GetStake().Amount.Int64())
+	require.Empty(t, supplier.PendingStakeDecreases)
+
+	// The supplier is no longer indexed as having pending stake decreases.
+	iterator := supplierModuleKeepers.GetAllStakeDecreasingSuppliersIterator(ctx)
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+}
+
+// TestEndBlockerUnbondSuppliers_ReturnsPendingStakeDecreases verifies that
+// unbonding an unstaked supplier also returns its pending stake decreases.
+func TestEndBlockerUnbondSuppliers_ReturnsPendingStakeDecreases(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+	stakingFee := supplierModuleKeepers.Keeper.GetParams(ctx).StakingFee.Amount.Int64()
+
+	// Stake a supplier, lower its stake then unstake it within the same session.
+	supplierAddr := sample.AccAddressBech32()
+	initialStake := suppliertypes.DefaultMinStake.Amount.Int64() + 100
+	stakeMsg, _ := newSupplierStakeMsg(supplierAddr, supplierAddr, initialStake, serviceID)
+	_, err := srv.StakeSupplier(ctx, stakeMsg)
+	require.NoError(t, err)
+
+	lowerStakeMsg, _ := newSupplierStakeMsg(supplierAddr, supplierAddr, initialStake-100)
+	_, err = srv.StakeSupplier(ctx, lowerStakeMsg)
+	require.NoError(t, err)
+
+	_, err = srv.UnstakeSupplier(ctx, &suppliertypes.MsgUnstakeSupplier{
+		Signer:          supplierAddr,
+		OperatorAddress: supplierAddr,
+	})
+	require.NoError(t, err)
+
+	supplier, isFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, supplierAddr)
+	require.True(t, isFound)
+	require.Len(t, supplier.PendingStakeDecreases, 1)
+
+	// Unbond the supplier without processing the stake decreases beforehand.
+	unbondingEndHeight := sharedtypes.GetSupplierUnbondingEndHeight(&sharedParams, &supplier)
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight)
+	numUnbonded, err := supplierModuleKeepers.EndBlockerUnbondSuppliers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), numUnbonded)
+
+	// Both the remaining stake and the pending stake decrease are returned.
+	require.Equal(t, -2*stakingFee, supplierModuleKeepers.SupplierBalanceMap[supplierAddr])
+
+	_, isFound = supplierModuleKeepers.GetSupplier(ctx, supplierAddr)
+	require.False(t, isFound)
+
+	iterator := supplierModuleKeepers.GetAllStakeDecreasingSuppliersIterator(ctx)
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+}
@@ -77,10 +77,19 @@ func (k Keeper) EndBlockerUnbondSuppliers(ctx context.Context) (numUnbondedSuppl
 			return numUnbondedSuppliers, err
 		}
 
+		// The pending stake decreases have been backing the supplier claims along
+		// with its stake, they complete unbonding together with the supplier.
+		// A transferred supplier's stake is held by the destination supplier, so
+		// it MUST NOT be returned to its owner, but its pending stake decreases are.
+		unbondedCoin := sharedtypes.GetPendingStakeDecreasesAmount(supplier.PendingStakeDecreases)
+		if !supplier.IsTransferred() {
+			unbondedCoin = unbondedCoin.Add(*supplier.Stake)
+		}
+
 		// If the supplier stake is 0 due to slashing, then do not move 0 coins
 		// to its account.
 		// Coin#IsPositive returns false if the coin is 0.
-		if supplier.Stake.IsPositive() {
+		if unbondedCoin.IsPositive() {
 			// Send the coins from the supplier pool back to the supplier.
 			// If the transfer fails (e.g., a legacy module-account owner — new
 			// occurrences are blocked by the stake-time module-account-owner
@@ -95,18 +104,18 @@ func (k Keeper) EndBlockerUnbondSuppliers(ctx context.Context) (numUnbondedSuppl
 			// making progress and prevents an infinite-retry on the same dead
 			// entry every session-end.
 			if err = k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx, suppliertypes.ModuleName, ownerAddress, []cosmostypes.Coin{*supplier.Stake},
+				ctx, suppliertypes.ModuleName, ownerAddress, []cosmostypes.Coin{unbondedCoin},
 			); err != nil {
 				logger.Error(fmt.Sprintf(
 					"could not send %s coins from module %s to account %s due to %s; supplier will be removed and coins will remain in module pool (see EventSupplierStakeStuckInModulePool)",
-					supplier.Stake.String(), suppliertypes.ModuleName, ownerAddress, err,
+					unbondedCoin.String(), suppliertypes.ModuleName, ownerAddress, err,
 				))
 
 				stuckSessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)
 				stuckEvent := &suppliertypes.EventSupplierStakeStuckInModulePool{
 					OperatorAddress:  supplier.OperatorAddress,
 					OwnerAddress:     supplier.OwnerAddress,
-					StuckCoin:        supplier.Stake,
+					StuckCoin:        &unbondedCoin,
 					Reason:           err.Error(),
 					SessionEndHeight: stuckSessionEndHeight,
 				}
@@ -128,7 +137,10 @@ func (k Keeper) EndBlockerUnbondSuppliers(ctx context.Context) (numUnbondedSuppl
 			minStake = *supMinStake
 		}
 		unbondingReason := suppliertypes.SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_VOLUNTARY
-		if supplier.GetStake().Amount.LT(minStake.Amount) {
+		switch {
+		case supplier.IsTransferred():
+			unbondingReason = suppliertypes.SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_TRANSFER
+		case supplier.GetStake().Amount.LT(minStake.Amount):
 			unbondingReason = suppliertypes.SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE
 		}
 
@@ -17,6 +17,22 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 
 	logger := k.Logger().With("method", "EndBlocker")
 
+	numTransferredSuppliers, err := k.EndBlockerTransferSuppliers(ctx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("could not transfer suppliers due to error %v", err))
+		return err
+	}
+
+	k.Logger().Info(fmt.Sprintf("transferred %d suppliers", numTransferredSuppliers))
+
+	numUnbondedStakeDecreases, err := k.EndBlockerUnbondSupplierStakeDecreases(ctx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("could not unbond supplier stake decreases due to error %v", err))
+		return err
+	}
+
+	k.Logger().Info(fmt.Sprintf("unbonded %d supplier stake decreases", numUnbondedStakeDecreases))
+
 	numUnbondedSuppliers, err := k.EndBlockerUnbondSuppliers(ctx)
 	if err != nil {
 		logger.Error(fmt.Sprintf("could not unbond suppliers due to error %v", err))
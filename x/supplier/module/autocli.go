@@ -127,6 +127,37 @@ The supplier will continue providing service until the current session ends, at
 						},
 					},
 				},
+				{
+					RpcMethod: "TransferSupplier",
+					Use:       "transfer-supplier [source_operator_address] [destination_operator_address]",
+					Short:     "Transfer a supplier to a new operator address",
+					Long: `Transfer the supplier with the provided source operator address to the destination operator address.
+
+This is an onchain transaction that will initiate the transfer of the supplier.
+
+The --from flag specifies the signer and MUST be the supplier owner address.
+
+The [destination_operator_address] MUST NOT be the operator address of an existing supplier.
+
+The source supplier will continue providing service until the current session ends.
+The destination supplier is then created with the same owner, stake and service configs, and provides service from the next session.
+The source supplier's pending claims are settled against the destination supplier, which holds the stake.`,
+
+					Example: `
+	# Transfer supplier to a new operator address
+	pocketd tx supplier transfer-supplier pokt1srcoperator... pokt1dstoperator... --from pokt1owner... --keyring-backend test --network mainnet
+
+	# With custom home directory
+	pocketd tx supplier transfer-supplier pokt1srcoperator... pokt1dstoperator... --from pokt1owner... --home ./pocket --keyring-backend test --network mainnet`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "source_operator_address",
+						},
+						{
+							ProtoField: "destination_operator_address",
+						},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
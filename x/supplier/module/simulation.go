@@ -32,6 +32,9 @@ const (
 	opWeightMsgUpdateParam          = "op_weight_msg_update_param"
 	defaultWeightMsgUpdateParam int = 100
 
+	opWeightMsgTransferSupplier          = "op_weight_msg_transfer_supplier"
+	defaultWeightMsgTransferSupplier int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -93,6 +96,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		suppliersimulation.SimulateMsgUpdateParam(am.accountKeeper, am.bankKeeper, am.supplierKeeper),
 	))
 
+	var weightMsgTransferSupplier int
+	simState.AppParams.GetOrGenerate(opWeightMsgTransferSupplier, &weightMsgTransferSupplier, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferSupplier = defaultWeightMsgTransferSupplier
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferSupplier,
+		suppliersimulation.SimulateMsgTransferSupplier(am.accountKeeper, am.bankKeeper, am.supplierKeeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -125,6 +139,14 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgTransferSupplier,
+			defaultWeightMsgTransferSupplier,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				suppliersimulation.SimulateMsgTransferSupplier(am.accountKeeper, am.bankKeeper, am.supplierKeeper)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
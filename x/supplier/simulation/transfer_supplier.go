@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func SimulateMsgTransferSupplier(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simOwnerAccount, _ := simtypes.RandomAcc(r, accs)
+		simSrcAccount, _ := simtypes.RandomAcc(r, accs)
+		simDstAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgTransferSupplier{
+			OwnerAddress:               simOwnerAccount.Address.String(),
+			SourceOperatorAddress:      simSrcAccount.Address.String(),
+			DestinationOperatorAddress: simDstAccount.Address.String(),
+		}
+
+		// TODO_TECHDEBT: Handling the TransferSupplier simulation
+
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "TransferSupplier simulation not implemented"), nil, nil
+	}
+}
@@ -16,4 +16,6 @@ var (
 	ErrSupplierParamInvalid         = sdkerrors.Register(ModuleName, 1107, "the provided param is invalid")
 	ErrSupplierEmitEvent            = sdkerrors.Register(ModuleName, 1108, "failed to emit event")
 	ErrSupplierInvalidServiceId     = sdkerrors.Register(ModuleName, 1109, "invalid service ID")
+	ErrSupplierIsTransferring       = sdkerrors.Register(ModuleName, 1110, "supplier is transferring to a new operator")
+	ErrSupplierDuplicateAddress     = sdkerrors.Register(ModuleName, 1111, "duplicate supplier address")
 )
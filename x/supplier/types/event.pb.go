@@ -33,6 +33,8 @@ const (
 	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_VOLUNTARY       SupplierUnbondingReason = 1
 	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE SupplierUnbondingReason = 2
 	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_MIGRATION       SupplierUnbondingReason = 3
+	// The supplier was transferred to a new operator address, which holds its stake.
+	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_TRANSFER SupplierUnbondingReason = 4
 )
 
 var SupplierUnbondingReason_name = map[int32]string{
@@ -40,6 +42,7 @@ var SupplierUnbondingReason_name = map[int32]string{
 	1: "SUPPLIER_UNBONDING_REASON_VOLUNTARY",
 	2: "SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE",
 	3: "SUPPLIER_UNBONDING_REASON_MIGRATION",
+	4: "SUPPLIER_UNBONDING_REASON_TRANSFER",
 }
 
 var SupplierUnbondingReason_value = map[string]int32{
@@ -47,6 +50,7 @@ var SupplierUnbondingReason_value = map[string]int32{
 	"SUPPLIER_UNBONDING_REASON_VOLUNTARY":       1,
 	"SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE": 2,
 	"SUPPLIER_UNBONDING_REASON_MIGRATION":       3,
+	"SUPPLIER_UNBONDING_REASON_TRANSFER":        4,
 }
 
 func (x SupplierUnbondingReason) String() string {
@@ -305,6 +309,410 @@ func (m *EventSupplierUnbondingCanceled) GetSessionEndHeight() int64 {
 	return 0
 }
 
+// EventSupplierStakeDecreaseUnbondingBegin is emitted when a supplier stake
+// message lowering the supplier stake is committed onchain, indicating that the
+// removed amount will now begin unbonding while the supplier keeps serving with
+// its remaining stake.
+type EventSupplierStakeDecreaseUnbondingBegin struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	OwnerAddress    string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// The amount removed from the supplier stake.
+	Amount *types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// The supplier stake remaining after the decrease.
+	Stake *types1.Coin `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake"`
+	// The end height of the session in which the stake decrease began unbonding.
+	SessionEndHeight int64 `protobuf:"varint,5,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The height at which the stake decrease unbonding will end.
+	UnbondingEndHeight int64 `protobuf:"varint,6,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height"`
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingBegin) Reset() {
+	*m = EventSupplierStakeDecreaseUnbondingBegin{}
+}
+func (m *EventSupplierStakeDecreaseUnbondingBegin) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierStakeDecreaseUnbondingBegin) ProtoMessage()    {}
+func (*EventSupplierStakeDecreaseUnbondingBegin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{4}
+}
+func (m *EventSupplierStakeDecreaseUnbondingBegin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierStakeDecreaseUnbondingBegin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSupplierStakeDecreaseUnbondingBegin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierStakeDecreaseUnbondingBegin.Merge(m, src)
+}
+func (m *EventSupplierStakeDecreaseUnbondingBegin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierStakeDecreaseUnbondingBegin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierStakeDecreaseUnbondingBegin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSupplierStakeDecreaseUnbondingBegin proto.InternalMessageInfo
+
+func (m *EventSupplierStakeDecreaseUnbondingBegin) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingBegin) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingBegin) GetAmount() *types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingBegin) GetStake() *types1.Coin {
+	if m != nil {
+		return m.Stake
+	}
+	return nil
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingBegin) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingBegin) GetUnbondingEndHeight() int64 {
+	if m != nil {
+		return m.UnbondingEndHeight
+	}
+	return 0
+}
+
+// EventSupplierStakeDecreaseUnbondingEnd is emitted when a supplier stake decrease
+// has completed unbonding and was returned to the supplier owner. The unbonding
+// period is determined by the shared param, supplier_unbonding_period_sessions.
+type EventSupplierStakeDecreaseUnbondingEnd struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	OwnerAddress    string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// The amount returned to the supplier owner. It is less than the amount removed
+	// from the stake if claims were slashed against it while it was unbonding.
+	Amount *types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// The end height of the session in which the stake decrease unbonding ended.
+	SessionEndHeight int64 `protobuf:"varint,4,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The height at which the stake decrease unbonding ended.
+	UnbondingEndHeight int64 `protobuf:"varint,5,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height"`
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingEnd) Reset() {
+	*m = EventSupplierStakeDecreaseUnbondingEnd{}
+}
+func (m *EventSupplierStakeDecreaseUnbondingEnd) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierStakeDecreaseUnbondingEnd) ProtoMessage()    {}
+func (*EventSupplierStakeDecreaseUnbondingEnd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{5}
+}
+func (m *EventSupplierStakeDecreaseUnbondingEnd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierStakeDecreaseUnbondingEnd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSupplierStakeDecreaseUnbondingEnd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierStakeDecreaseUnbondingEnd.Merge(m, src)
+}
+func (m *EventSupplierStakeDecreaseUnbondingEnd) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierStakeDecreaseUnbondingEnd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierStakeDecreaseUnbondingEnd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSupplierStakeDecreaseUnbondingEnd proto.InternalMessageInfo
+
+func (m *EventSupplierStakeDecreaseUnbondingEnd) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingEnd) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingEnd) GetAmount() *types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingEnd) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingEnd) GetUnbondingEndHeight() int64 {
+	if m != nil {
+		return m.UnbondingEndHeight
+	}
+	return 0
+}
+
+// EventSupplierTransferBegin is emitted when a supplier transfer message is
+// committed onchain, indicating that the supplier will be transferred to the
+// destination operator address at the end of the current session.
+type EventSupplierTransferBegin struct {
+	SourceOperatorAddress      string          `protobuf:"bytes,1,opt,name=source_operator_address,json=sourceOperatorAddress,proto3" json:"source_operator_address,omitempty"`
+	DestinationOperatorAddress string          `protobuf:"bytes,2,opt,name=destination_operator_address,json=destinationOperatorAddress,proto3" json:"destination_operator_address,omitempty"`
+	SourceSupplier             *types.Supplier `protobuf:"bytes,3,opt,name=source_supplier,json=sourceSupplier,proto3" json:"source_supplier"`
+	// The end height of the session in which the transfer began.
+	SessionEndHeight int64 `protobuf:"varint,4,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The height at which the transfer will complete.
+	TransferEndHeight int64 `protobuf:"varint,5,opt,name=transfer_end_height,json=transferEndHeight,proto3" json:"transfer_end_height"`
+}
+
+func (m *EventSupplierTransferBegin) Reset()         { *m = EventSupplierTransferBegin{} }
+func (m *EventSupplierTransferBegin) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierTransferBegin) ProtoMessage()    {}
+func (*EventSupplierTransferBegin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{6}
+}
+func (m *EventSupplierTransferBegin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierTransferBegin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSupplierTransferBegin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierTransferBegin.Merge(m, src)
+}
+func (m *EventSupplierTransferBegin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierTransferBegin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierTransferBegin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSupplierTransferBegin proto.InternalMessageInfo
+
+func (m *EventSupplierTransferBegin) GetSourceOperatorAddress() string {
+	if m != nil {
+		return m.SourceOperatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierTransferBegin) GetDestinationOperatorAddress() string {
+	if m != nil {
+		return m.DestinationOperatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierTransferBegin) GetSourceSupplier() *types.Supplier {
+	if m != nil {
+		return m.SourceSupplier
+	}
+	return nil
+}
+
+func (m *EventSupplierTransferBegin) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func (m *EventSupplierTransferBegin) GetTransferEndHeight() int64 {
+	if m != nil {
+		return m.TransferEndHeight
+	}
+	return 0
+}
+
+// EventSupplierTransferEnd is emitted whenever a supplier transfer is completed.
+// It includes the destination supplier state at the time the transfer completed.
+// Either EventSupplierTransferEnd or EventSupplierTransferError will be emitted
+// corresponding to any given EventSupplierTransferBegin event.
+type EventSupplierTransferEnd struct {
+	SourceOperatorAddress      string          `protobuf:"bytes,1,opt,name=source_operator_address,json=sourceOperatorAddress,proto3" json:"source_operator_address,omitempty"`
+	DestinationOperatorAddress string          `protobuf:"bytes,2,opt,name=destination_operator_address,json=destinationOperatorAddress,proto3" json:"destination_operator_address,omitempty"`
+	DestinationSupplier        *types.Supplier `protobuf:"bytes,3,opt,name=destination_supplier,json=destinationSupplier,proto3" json:"destination_supplier"`
+	// The end height of the session in which the transfer ended.
+	SessionEndHeight int64 `protobuf:"varint,4,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The height at which the destination supplier services are activated.
+	ActivationHeight int64 `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height"`
+}
+
+func (m *EventSupplierTransferEnd) Reset()         { *m = EventSupplierTransferEnd{} }
+func (m *EventSupplierTransferEnd) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierTransferEnd) ProtoMessage()    {}
+func (*EventSupplierTransferEnd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{7}
+}
+func (m *EventSupplierTransferEnd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierTransferEnd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSupplierTransferEnd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierTransferEnd.Merge(m, src)
+}
+func (m *EventSupplierTransferEnd) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierTransferEnd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierTransferEnd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSupplierTransferEnd proto.InternalMessageInfo
+
+func (m *EventSupplierTransferEnd) GetSourceOperatorAddress() string {
+	if m != nil {
+		return m.SourceOperatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierTransferEnd) GetDestinationOperatorAddress() string {
+	if m != nil {
+		return m.DestinationOperatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierTransferEnd) GetDestinationSupplier() *types.Supplier {
+	if m != nil {
+		return m.DestinationSupplier
+	}
+	return nil
+}
+
+func (m *EventSupplierTransferEnd) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func (m *EventSupplierTransferEnd) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// EventSupplierTransferError is emitted whenever a supplier transfer fails.
+// It includes the source supplier state at the time the transfer failed and
+// the error message.
+// Either EventSupplierTransferEnd or EventSupplierTransferError will be emitted
+// corresponding to any given EventSupplierTransferBegin event.
+type EventSupplierTransferError struct {
+	SourceOperatorAddress      string          `protobuf:"bytes,1,opt,name=source_operator_address,json=sourceOperatorAddress,proto3" json:"source_operator_address,omitempty"`
+	DestinationOperatorAddress string          `protobuf:"bytes,2,opt,name=destination_operator_address,json=destinationOperatorAddress,proto3" json:"destination_operator_address,omitempty"`
+	SourceSupplier             *types.Supplier `protobuf:"bytes,3,opt,name=source_supplier,json=sourceSupplier,proto3" json:"source_supplier"`
+	// The end height of the session in which the transfer failed.
+	SessionEndHeight int64  `protobuf:"varint,4,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	Error            string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventSupplierTransferError) Reset()         { *m = EventSupplierTransferError{} }
+func (m *EventSupplierTransferError) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierTransferError) ProtoMessage()    {}
+func (*EventSupplierTransferError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{8}
+}
+func (m *EventSupplierTransferError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierTransferError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSupplierTransferError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierTransferError.Merge(m, src)
+}
+func (m *EventSupplierTransferError) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierTransferError) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierTransferError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSupplierTransferError proto.InternalMessageInfo
+
+func (m *EventSupplierTransferError) GetSourceOperatorAddress() string {
+	if m != nil {
+		return m.SourceOperatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierTransferError) GetDestinationOperatorAddress() string {
+	if m != nil {
+		return m.DestinationOperatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierTransferError) GetSourceSupplier() *types.Supplier {
+	if m != nil {
+		return m.SourceSupplier
+	}
+	return nil
+}
+
+func (m *EventSupplierTransferError) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+func (m *EventSupplierTransferError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // EventSupplierStakeStuckInModulePool is emitted when EndBlockerUnbondSuppliers
 // could NOT return the supplier's bonded stake to its owner account (e.g., the
 // owner is a blocked module account, the bank module rejected the send). The
@@ -312,6 +720,9 @@ func (m *EventSupplierUnbondingCanceled) GetSessionEndHeight() int64 {
 // the coins remain stranded in the supplier module pool. Indexers should track
 // these events so governance can propose a reclaim transfer; without this event
 // the loss would be invisible to off-chain observers.
+// It is also emitted by EndBlockerUnbondSupplierStakeDecreases for a stake
+// decrease which completed unbonding but could not be returned to the owner, in
+// which case the stake decrease is dropped.
 //
 // Pre-v0.1.34 the same scenario only produced a Logger().Error line — easy to
 // miss in operator workflows. The new stake-time module-account-owner check
@@ -338,7 +749,7 @@ func (m *EventSupplierStakeStuckInModulePool) Reset()         { *m = EventSuppli
 func (m *EventSupplierStakeStuckInModulePool) String() string { return proto.CompactTextString(m) }
 func (*EventSupplierStakeStuckInModulePool) ProtoMessage()    {}
 func (*EventSupplierStakeStuckInModulePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ff4bce83a0142ab, []int{4}
+	return fileDescriptor_0ff4bce83a0142ab, []int{9}
 }
 func (m *EventSupplierStakeStuckInModulePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +823,7 @@ func (m *EventSupplierServiceConfigActivated) Reset()         { *m = EventSuppli
 func (m *EventSupplierServiceConfigActivated) String() string { return proto.CompactTextString(m) }
 func (*EventSupplierServiceConfigActivated) ProtoMessage()    {}
 func (*EventSupplierServiceConfigActivated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ff4bce83a0142ab, []int{5}
+	return fileDescriptor_0ff4bce83a0142ab, []int{10}
 }
 func (m *EventSupplierServiceConfigActivated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,6 +875,11 @@ func init() {
 	proto.RegisterType((*EventSupplierUnbondingBegin)(nil), "pocket.supplier.EventSupplierUnbondingBegin")
 	proto.RegisterType((*EventSupplierUnbondingEnd)(nil), "pocket.supplier.EventSupplierUnbondingEnd")
 	proto.RegisterType((*EventSupplierUnbondingCanceled)(nil), "pocket.supplier.EventSupplierUnbondingCanceled")
+	proto.RegisterType((*EventSupplierStakeDecreaseUnbondingBegin)(nil), "pocket.supplier.EventSupplierStakeDecreaseUnbondingBegin")
+	proto.RegisterType((*EventSupplierStakeDecreaseUnbondingEnd)(nil), "pocket.supplier.EventSupplierStakeDecreaseUnbondingEnd")
+	proto.RegisterType((*EventSupplierTransferBegin)(nil), "pocket.supplier.EventSupplierTransferBegin")
+	proto.RegisterType((*EventSupplierTransferEnd)(nil), "pocket.supplier.EventSupplierTransferEnd")
+	proto.RegisterType((*EventSupplierTransferError)(nil), "pocket.supplier.EventSupplierTransferError")
 	proto.RegisterType((*EventSupplierStakeStuckInModulePool)(nil), "pocket.supplier.EventSupplierStakeStuckInModulePool")
 	proto.RegisterType((*EventSupplierServiceConfigActivated)(nil), "pocket.supplier.EventSupplierServiceConfigActivated")
 }
@@ -471,53 +887,70 @@ func init() {
 func init() { proto.RegisterFile("pocket/supplier/event.proto", fileDescriptor_0ff4bce83a0142ab) }
 
 var fileDescriptor_0ff4bce83a0142ab = []byte{
-	// 731 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xce, 0x24, 0xbd, 0x55, 0x3b, 0xed, 0x6d, 0x7d, 0x7d, 0x0b, 0x4d, 0x5b, 0x70, 0xaa, 0x54,
-	0x88, 0x14, 0xa9, 0xb6, 0x5a, 0xd6, 0x2c, 0xe2, 0xd4, 0xb4, 0x2e, 0xa9, 0x13, 0xd9, 0x0d, 0x08,
-	0x36, 0x96, 0x63, 0x0f, 0x8e, 0x95, 0x74, 0x26, 0xb2, 0x27, 0x29, 0xbc, 0x05, 0x4f, 0xc1, 0x13,
-	0xb0, 0x46, 0x6c, 0x90, 0xd8, 0x20, 0x55, 0xb0, 0xe9, 0x86, 0x08, 0xa5, 0xbb, 0x3c, 0x05, 0xb2,
-	0x3d, 0x4e, 0xff, 0x12, 0x4a, 0xa5, 0x2e, 0x59, 0x79, 0xe6, 0xfb, 0xce, 0x39, 0x73, 0xce, 0xe7,
-	0x39, 0x67, 0xe0, 0x4a, 0x9b, 0xd8, 0x4d, 0x44, 0xa5, 0xa0, 0xd3, 0x6e, 0xb7, 0x3c, 0xe4, 0x4b,
-	0xa8, 0x8b, 0x30, 0x15, 0xdb, 0x3e, 0xa1, 0x84, 0x9f, 0x8f, 0x49, 0x31, 0x21, 0x97, 0x97, 0x6c,
-	0x12, 0x1c, 0x92, 0xc0, 0x8c, 0x68, 0x29, 0xde, 0xc4, 0xb6, 0xcb, 0x0b, 0x2e, 0x71, 0x49, 0x8c,
-	0x87, 0x2b, 0x86, 0x0a, 0xb1, 0x8d, 0x54, 0xb7, 0x02, 0x24, 0x75, 0x37, 0xeb, 0x88, 0x5a, 0x9b,
-	0x92, 0x4d, 0x3c, 0xcc, 0xf8, 0x7b, 0xc9, 0xf1, 0x0d, 0xcb, 0x47, 0xce, 0x30, 0x8b, 0x98, 0xcd,
-	0xbf, 0x07, 0xf0, 0x7f, 0x25, 0xcc, 0xc7, 0x60, 0xb8, 0x41, 0xad, 0x26, 0x72, 0xf8, 0x6d, 0xc8,
-	0x07, 0x28, 0x08, 0x3c, 0x82, 0x4d, 0x84, 0x1d, 0xb3, 0x81, 0x3c, 0xb7, 0x41, 0xb3, 0xe9, 0x55,
-	0x50, 0xc8, 0xc8, 0x77, 0x07, 0xbd, 0xdc, 0x08, 0x56, 0xe7, 0x18, 0xa6, 0x60, 0x67, 0x37, 0x42,
-	0xf8, 0x12, 0xe4, 0x48, 0x1b, 0xf9, 0x16, 0x25, 0xbe, 0x69, 0x39, 0x8e, 0x8f, 0x82, 0x20, 0x9b,
-	0x59, 0x05, 0x85, 0x69, 0x39, 0xfb, 0xed, 0xc3, 0xc6, 0x02, 0xab, 0xae, 0x18, 0x33, 0x06, 0xf5,
-	0x3d, 0xec, 0xea, 0xf3, 0x89, 0x07, 0x83, 0xf7, 0x26, 0xa6, 0x00, 0x97, 0xce, 0x7f, 0x4a, 0xc3,
-	0x95, 0x0b, 0x89, 0xd6, 0x70, 0x9d, 0x60, 0xc7, 0xc3, 0xae, 0x8c, 0x5c, 0x0f, 0xf3, 0x45, 0x38,
-	0x95, 0x94, 0x96, 0x05, 0xab, 0xa0, 0x30, 0xb3, 0xb5, 0x28, 0x26, 0xda, 0x46, 0x95, 0x8b, 0x89,
-	0xa3, 0x3c, 0x3b, 0xe8, 0xe5, 0x86, 0xc6, 0xfa, 0x70, 0xc5, 0x97, 0xe1, 0xa4, 0x8f, 0xac, 0x80,
-	0xe0, 0xa8, 0xce, 0xb9, 0xad, 0x82, 0x78, 0xe9, 0xe7, 0x88, 0x57, 0xce, 0xd6, 0x23, 0x7b, 0x19,
-	0x0e, 0x7a, 0x39, 0xe6, 0xab, 0xb3, 0xef, 0x18, 0x05, 0x33, 0x37, 0x54, 0x70, 0x0f, 0x2e, 0x74,
-	0x92, 0xc3, 0xce, 0xc7, 0x99, 0x88, 0xe2, 0x64, 0x07, 0xbd, 0xdc, 0x48, 0x5e, 0xe7, 0x87, 0xe8,
-	0x30, 0x56, 0xfe, 0x63, 0x1a, 0x2e, 0x8d, 0x96, 0x50, 0xc1, 0xce, 0x5f, 0x01, 0xaf, 0x17, 0xf0,
-	0x2b, 0x80, 0xc2, 0x68, 0x01, 0x4b, 0x16, 0xb6, 0x51, 0x0b, 0xdd, 0x8a, 0x8a, 0x79, 0x38, 0x79,
-	0xa1, 0xd6, 0x48, 0x1b, 0x96, 0x15, 0xfb, 0xde, 0x4e, 0x7b, 0xe6, 0x7f, 0xa4, 0xe1, 0xda, 0xd5,
-	0xe6, 0x37, 0x68, 0xc7, 0x6e, 0xaa, 0x78, 0x9f, 0x38, 0x9d, 0x16, 0xaa, 0x12, 0xd2, 0x1a, 0xd9,
-	0xc6, 0xe0, 0x86, 0x6d, 0xcc, 0x3f, 0x81, 0xff, 0x92, 0x23, 0x8c, 0xce, 0x22, 0xa4, 0xaf, 0x89,
-	0x30, 0x1b, 0x99, 0x27, 0xee, 0xbb, 0x10, 0x06, 0x61, 0x62, 0x66, 0x38, 0xda, 0x22, 0x65, 0x66,
-	0xb6, 0x96, 0x44, 0xe6, 0x18, 0xce, 0x3e, 0x91, 0xcd, 0x3e, 0xb1, 0x44, 0x3c, 0x2c, 0xcf, 0x0d,
-	0x7a, 0xb9, 0x73, 0x0e, 0xfa, 0x74, 0xb4, 0x0e, 0xa9, 0x50, 0x5f, 0x76, 0x4b, 0x27, 0xa2, 0x0c,
-	0xfe, 0xfc, 0xee, 0xfd, 0x73, 0x43, 0x7d, 0xbf, 0x83, 0xcb, 0xfa, 0x22, 0xbf, 0xeb, 0xd9, 0xa8,
-	0x44, 0xf0, 0x6b, 0xcf, 0x2d, 0xda, 0xd4, 0xeb, 0x5a, 0x14, 0x39, 0xbc, 0x0c, 0xff, 0xb3, 0xe2,
-	0x4d, 0x18, 0xf2, 0xc2, 0xcf, 0xbc, 0x33, 0xe8, 0xe5, 0xae, 0x92, 0x3a, 0x77, 0x06, 0xdd, 0xe2,
-	0xa8, 0xe5, 0xef, 0x43, 0x18, 0xc4, 0x29, 0x9a, 0x9e, 0x13, 0xcb, 0xa3, 0x4f, 0x33, 0x44, 0x75,
-	0xe2, 0x49, 0xfc, 0xe8, 0x33, 0x80, 0x8b, 0x63, 0xfa, 0x98, 0x5f, 0x87, 0x0f, 0x8c, 0x5a, 0xb5,
-	0x5a, 0x56, 0x15, 0xdd, 0xac, 0x69, 0x72, 0x45, 0xdb, 0x56, 0xb5, 0x1d, 0x53, 0x57, 0x8a, 0x46,
-	0x45, 0x33, 0x6b, 0x9a, 0x51, 0x55, 0x4a, 0xea, 0x53, 0x55, 0xd9, 0xe6, 0x52, 0xfc, 0x43, 0xb8,
-	0x36, 0xde, 0xf4, 0x79, 0xa5, 0x5c, 0xd3, 0x0e, 0x8a, 0xfa, 0x4b, 0x0e, 0xf0, 0x1b, 0x70, 0x7d,
-	0xbc, 0xa1, 0xac, 0x94, 0x2b, 0x2f, 0xcc, 0x7d, 0x55, 0x33, 0x8d, 0x83, 0xe2, 0x33, 0x85, 0x4b,
-	0xff, 0x3e, 0xee, 0xbe, 0xba, 0xa3, 0x17, 0x0f, 0xd4, 0x8a, 0xc6, 0x65, 0xe4, 0xca, 0x97, 0xbe,
-	0x00, 0x8e, 0xfb, 0x02, 0x38, 0xe9, 0x0b, 0xe0, 0x67, 0x5f, 0x00, 0xef, 0x4e, 0x85, 0xd4, 0xf1,
-	0xa9, 0x90, 0x3a, 0x39, 0x15, 0x52, 0xaf, 0x36, 0x5d, 0x8f, 0x36, 0x3a, 0x75, 0xd1, 0x26, 0x87,
-	0x52, 0x9b, 0x34, 0xe9, 0x06, 0x46, 0xf4, 0x88, 0xf8, 0xcd, 0x68, 0xe3, 0x93, 0x56, 0x4b, 0x7a,
-	0x73, 0xf6, 0xa2, 0xd3, 0xb7, 0x6d, 0x14, 0xd4, 0x27, 0xa3, 0x27, 0xf5, 0xf1, 0xaf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0a, 0x19, 0xae, 0x27, 0xf1, 0x07, 0x00, 0x00,
+	// 993 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x3f, 0x6f, 0xdb, 0x46,
+	0x1b, 0x37, 0x25, 0x59, 0x88, 0x2e, 0x79, 0x6d, 0x85, 0x56, 0x5e, 0xcb, 0x4e, 0x2a, 0x1a, 0x0a,
+	0x9a, 0x2a, 0x05, 0x4c, 0xc1, 0xee, 0x56, 0xa0, 0x83, 0x28, 0x33, 0x0e, 0x53, 0x9b, 0x12, 0x8e,
+	0x52, 0x8b, 0x66, 0x21, 0x28, 0xf2, 0x22, 0x13, 0x92, 0xef, 0x04, 0x92, 0x72, 0xda, 0x6f, 0xd1,
+	0x4f, 0xd1, 0xb9, 0x43, 0x87, 0x02, 0x05, 0x8a, 0x8e, 0x5d, 0x0a, 0x04, 0xed, 0x92, 0xa5, 0x44,
+	0x61, 0x6f, 0x04, 0xfa, 0x11, 0x0a, 0x14, 0xe4, 0x1d, 0x25, 0x59, 0xa2, 0x22, 0x2b, 0xd0, 0xd0,
+	0x16, 0x99, 0x8e, 0x7c, 0xfe, 0xdd, 0xf3, 0xfc, 0x7e, 0xf7, 0x1c, 0xef, 0x08, 0xee, 0x0f, 0x88,
+	0xd9, 0x43, 0x5e, 0xd5, 0x1d, 0x0e, 0x06, 0x7d, 0x1b, 0x39, 0x55, 0x74, 0x81, 0xb0, 0x27, 0x0e,
+	0x1c, 0xe2, 0x11, 0x7e, 0x93, 0x2a, 0xc5, 0x58, 0xb9, 0xbb, 0x63, 0x12, 0xf7, 0x9c, 0xb8, 0x7a,
+	0xa4, 0xae, 0xd2, 0x17, 0x6a, 0xbb, 0x5b, 0xe8, 0x92, 0x2e, 0xa1, 0xf2, 0xf0, 0x89, 0x49, 0x4b,
+	0xd4, 0xa6, 0xda, 0x31, 0x5c, 0x54, 0xbd, 0x38, 0xe8, 0x20, 0xcf, 0x38, 0xa8, 0x9a, 0xc4, 0xc6,
+	0x4c, 0xff, 0x20, 0x9e, 0xfe, 0xcc, 0x70, 0x90, 0x35, 0xca, 0x82, 0x6a, 0xcb, 0xdf, 0x70, 0x60,
+	0x4b, 0x0e, 0xf3, 0xd1, 0x98, 0x5c, 0xf3, 0x8c, 0x1e, 0xb2, 0xf8, 0x23, 0xc0, 0xbb, 0xc8, 0x75,
+	0x6d, 0x82, 0x75, 0x84, 0x2d, 0xfd, 0x0c, 0xd9, 0xdd, 0x33, 0xaf, 0x98, 0xda, 0xe3, 0x2a, 0x69,
+	0xe9, 0xff, 0x81, 0x2f, 0x24, 0x68, 0x61, 0x9e, 0xc9, 0x64, 0x6c, 0x3d, 0x8d, 0x24, 0x7c, 0x1d,
+	0xe4, 0xc9, 0x00, 0x39, 0x86, 0x47, 0x1c, 0xdd, 0xb0, 0x2c, 0x07, 0xb9, 0x6e, 0x31, 0xbd, 0xc7,
+	0x55, 0x72, 0x52, 0xf1, 0xd7, 0xef, 0xf6, 0x0b, 0xac, 0xba, 0x1a, 0xd5, 0x68, 0x9e, 0x63, 0xe3,
+	0x2e, 0xdc, 0x8c, 0x3d, 0x98, 0xf8, 0x59, 0xe6, 0x16, 0x97, 0x4f, 0x95, 0x7f, 0x4a, 0x81, 0xfb,
+	0xd7, 0x12, 0x6d, 0xe3, 0x0e, 0xc1, 0x96, 0x8d, 0xbb, 0x12, 0xea, 0xda, 0x98, 0xaf, 0x81, 0x5b,
+	0x71, 0x69, 0x45, 0x6e, 0x8f, 0xab, 0xdc, 0x3e, 0xdc, 0x16, 0x63, 0x6c, 0xa3, 0xca, 0xc5, 0xd8,
+	0x51, 0xba, 0x13, 0xf8, 0xc2, 0xc8, 0x18, 0x8e, 0x9e, 0xf8, 0x13, 0x90, 0x75, 0x90, 0xe1, 0x12,
+	0x1c, 0xd5, 0xb9, 0x71, 0x58, 0x11, 0xa7, 0xc8, 0x11, 0x67, 0xe6, 0x86, 0x91, 0xbd, 0x04, 0x02,
+	0x5f, 0x60, 0xbe, 0x90, 0x8d, 0x73, 0x10, 0x4c, 0x2f, 0x89, 0xe0, 0x33, 0x50, 0x18, 0xc6, 0x93,
+	0x4d, 0xc6, 0xc9, 0x44, 0x71, 0x8a, 0x81, 0x2f, 0x24, 0xea, 0x21, 0x3f, 0x92, 0x8e, 0x62, 0x95,
+	0x7f, 0x4c, 0x81, 0x9d, 0x64, 0x08, 0x65, 0x6c, 0xbd, 0x03, 0x70, 0x31, 0x80, 0xbf, 0x70, 0xa0,
+	0x94, 0x0c, 0x60, 0xdd, 0xc0, 0x26, 0xea, 0xa3, 0x95, 0xa0, 0x58, 0x06, 0xd9, 0x6b, 0xb5, 0x46,
+	0xd8, 0xb0, 0xac, 0xd8, 0xb8, 0x9a, 0xf6, 0x2c, 0xff, 0x90, 0x06, 0x95, 0xd9, 0xe6, 0x3f, 0x42,
+	0x66, 0xc8, 0x00, 0x9a, 0x6a, 0xb0, 0xa4, 0x5e, 0xe6, 0x96, 0xec, 0x65, 0xfe, 0x13, 0xf0, 0x3f,
+	0xf2, 0x12, 0xa3, 0x71, 0x84, 0xd4, 0x82, 0x08, 0x77, 0x22, 0xf3, 0xb1, 0x7b, 0xd6, 0x38, 0x27,
+	0x43, 0x4c, 0xa1, 0xb9, 0x7d, 0xb8, 0x23, 0x32, 0xa7, 0x70, 0xf3, 0x13, 0xd9, 0xe6, 0x27, 0xd6,
+	0x89, 0xcd, 0x56, 0x14, 0x35, 0x86, 0x6c, 0xe4, 0x3f, 0x06, 0xeb, 0x6e, 0x58, 0x61, 0x31, 0xb3,
+	0xc8, 0x3b, 0x17, 0xf8, 0x02, 0xb5, 0x85, 0x74, 0x98, 0x83, 0xf8, 0xfa, 0x8a, 0x56, 0x63, 0xf6,
+	0x2d, 0x56, 0xe3, 0x5f, 0x29, 0xf0, 0xe8, 0x06, 0xec, 0x85, 0xbd, 0xfd, 0x1f, 0xe0, 0x2e, 0x19,
+	0xff, 0xcc, 0x8a, 0xf0, 0x5f, 0x7f, 0x0b, 0xfc, 0xbf, 0x4d, 0x83, 0xdd, 0x6b, 0xf8, 0xb7, 0x1c,
+	0x03, 0xbb, 0x2f, 0x90, 0x43, 0xfb, 0xa5, 0x09, 0xb6, 0x5d, 0x32, 0x74, 0x4c, 0xa4, 0x2f, 0x0d,
+	0xfd, 0x3d, 0xea, 0xd8, 0x98, 0x22, 0xe0, 0x39, 0x78, 0x60, 0x21, 0xd7, 0xb3, 0xb1, 0xe1, 0x85,
+	0x85, 0xce, 0x84, 0x5d, 0xc4, 0xc7, 0xee, 0x84, 0xf7, 0x74, 0xec, 0x36, 0xd8, 0x64, 0xd9, 0x8e,
+	0xb6, 0xaf, 0xf4, 0x9b, 0xb7, 0xaf, 0xad, 0xc0, 0x17, 0xa6, 0x7d, 0xe0, 0x06, 0x15, 0xc4, 0x46,
+	0x2b, 0x62, 0xed, 0x18, 0x6c, 0x79, 0x0c, 0xdb, 0x59, 0xd2, 0xb6, 0x03, 0x5f, 0x48, 0x52, 0xc3,
+	0xbb, 0xb1, 0x70, 0x4c, 0xd9, 0xf7, 0x69, 0x50, 0x4c, 0xa4, 0x2c, 0x6c, 0x92, 0x7f, 0x17, 0x61,
+	0x16, 0x28, 0x4c, 0xc6, 0xbe, 0x29, 0x6b, 0xd1, 0x12, 0x4f, 0x72, 0x84, 0x5b, 0x13, 0xd2, 0x15,
+	0xf3, 0x27, 0x81, 0xbb, 0x86, 0xe9, 0xd9, 0x17, 0x74, 0xc6, 0x6b, 0xec, 0xdd, 0x0b, 0x7c, 0x61,
+	0x56, 0x09, 0xf3, 0x63, 0xd1, 0x78, 0xb7, 0x4b, 0xee, 0x36, 0xd9, 0x71, 0x88, 0xf3, 0xae, 0xdb,
+	0x56, 0xc7, 0x56, 0x01, 0xac, 0xa3, 0x10, 0xd3, 0x88, 0xa1, 0x1c, 0xa4, 0x2f, 0xe5, 0xdf, 0x53,
+	0xe0, 0xe1, 0xec, 0xd7, 0x46, 0xf3, 0x86, 0x66, 0x4f, 0xc1, 0xa7, 0xc4, 0x1a, 0xf6, 0x51, 0x93,
+	0x90, 0xfe, 0x3f, 0xe2, 0x53, 0xf3, 0x14, 0x00, 0x37, 0x4c, 0x4c, 0x0f, 0xaf, 0x41, 0x8b, 0x3f,
+	0x37, 0x1b, 0x81, 0x2f, 0x4c, 0x38, 0xc0, 0x5c, 0xf4, 0x1c, 0xaa, 0xc2, 0xb3, 0x18, 0x3b, 0xd1,
+	0x66, 0xa2, 0x0c, 0x6e, 0x7e, 0x4e, 0x5d, 0xf2, 0x64, 0x50, 0xfe, 0x8d, 0x9b, 0xc6, 0x17, 0x39,
+	0x17, 0xb6, 0x89, 0xea, 0x04, 0xbf, 0xb0, 0xbb, 0x35, 0xda, 0x0e, 0xc8, 0x4a, 0xee, 0xa5, 0xd4,
+	0x52, 0xbd, 0xb4, 0x92, 0x6b, 0x19, 0xff, 0x1e, 0x00, 0x2e, 0x4d, 0x51, 0xb7, 0x2d, 0x0a, 0x0f,
+	0xcc, 0x31, 0x89, 0x62, 0xd1, 0x5b, 0xdb, 0x87, 0x7f, 0x72, 0x60, 0x7b, 0xce, 0x99, 0x9f, 0x7f,
+	0x0c, 0xde, 0xd7, 0xda, 0xcd, 0xe6, 0x89, 0x22, 0x43, 0xbd, 0xad, 0x4a, 0x0d, 0xf5, 0x48, 0x51,
+	0x8f, 0x75, 0x28, 0xd7, 0xb4, 0x86, 0xaa, 0xb7, 0x55, 0xad, 0x29, 0xd7, 0x95, 0x27, 0x8a, 0x7c,
+	0x94, 0x5f, 0xe3, 0x3f, 0x00, 0x0f, 0xe7, 0x9b, 0x7e, 0xd6, 0x38, 0x69, 0xab, 0xad, 0x1a, 0xfc,
+	0x22, 0xcf, 0xf1, 0xfb, 0xe0, 0xf1, 0x7c, 0x43, 0x49, 0x3e, 0x69, 0x7c, 0xae, 0x9f, 0x2a, 0xaa,
+	0xae, 0xb5, 0x6a, 0x9f, 0xca, 0xf9, 0xd4, 0x9b, 0xe3, 0x9e, 0x2a, 0xc7, 0xb0, 0xd6, 0x52, 0x1a,
+	0x6a, 0x3e, 0xcd, 0x3f, 0x02, 0xe5, 0xf9, 0x86, 0x2d, 0x58, 0x53, 0xb5, 0x27, 0x32, 0xcc, 0x67,
+	0xa4, 0xc6, 0xcf, 0x97, 0x25, 0xee, 0xd5, 0x65, 0x89, 0x7b, 0x7d, 0x59, 0xe2, 0xfe, 0xb8, 0x2c,
+	0x71, 0x5f, 0x5f, 0x95, 0xd6, 0x5e, 0x5d, 0x95, 0xd6, 0x5e, 0x5f, 0x95, 0xd6, 0x9e, 0x1f, 0x74,
+	0x6d, 0xef, 0x6c, 0xd8, 0x11, 0x4d, 0x72, 0x5e, 0x1d, 0x90, 0x9e, 0xb7, 0x8f, 0x91, 0xf7, 0x92,
+	0x38, 0xbd, 0xe8, 0xc5, 0x21, 0xfd, 0x7e, 0xf5, 0xcb, 0xf1, 0x5f, 0x02, 0xef, 0xab, 0x01, 0x72,
+	0x3b, 0xd9, 0xe8, 0x9a, 0xfe, 0xd1, 0xdf, 0x03, 0x00, 0x28, 0x25, 0xd8, 0x76, 0x45, 0x10, 0x00,
+	0x00,
 }
 
 func (m *EventSupplierStaked) Marshal() (dAtA []byte, err error) {
@@ -700,7 +1133,7 @@ func (m *EventSupplierUnbondingCanceled) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSupplierStakeStuckInModulePool) Marshal() (dAtA []byte, err error) {
+func (m *EventSupplierStakeDecreaseUnbondingBegin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -710,31 +1143,29 @@ func (m *EventSupplierStakeStuckInModulePool) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *EventSupplierStakeStuckInModulePool) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSupplierStakeDecreaseUnbondingBegin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSupplierStakeStuckInModulePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSupplierStakeDecreaseUnbondingBegin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SessionEndHeight != 0 {
 		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
 		i--
 		dAtA[i] = 0x28
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.StuckCoin != nil {
+	if m.Stake != nil {
 		{
-			size, err := m.StuckCoin.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -742,7 +1173,19 @@ func (m *EventSupplierStakeStuckInModulePool) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintEvent(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.OwnerAddress) > 0 {
 		i -= len(m.OwnerAddress)
@@ -761,7 +1204,7 @@ func (m *EventSupplierStakeStuckInModulePool) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSupplierServiceConfigActivated) Marshal() (dAtA []byte, err error) {
+func (m *EventSupplierStakeDecreaseUnbondingEnd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -771,183 +1214,1357 @@ func (m *EventSupplierServiceConfigActivated) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *EventSupplierServiceConfigActivated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSupplierStakeDecreaseUnbondingEnd) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSupplierServiceConfigActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSupplierStakeDecreaseUnbondingEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ServiceId)))
+	if m.UnbondingEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x28
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.OperatorAddress) > 0 {
 		i -= len(m.OperatorAddress)
 		copy(dAtA[i:], m.OperatorAddress)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.OperatorAddress)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ActivationHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.ActivationHeight))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventSupplierTransferBegin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventSupplierStaked) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SessionEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.SessionEndHeight))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
+
+func (m *EventSupplierTransferBegin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSupplierUnbondingBegin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSupplierTransferBegin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Reason != 0 {
-		n += 1 + sovEvent(uint64(m.Reason))
+	if m.TransferEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TransferEndHeight))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.SessionEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.SessionEndHeight))
-	}
-	if m.UnbondingEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
-	}
-	return n
-}
-
-func (m *EventSupplierUnbondingEnd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Reason != 0 {
-		n += 1 + sovEvent(uint64(m.Reason))
+	if m.SourceSupplier != nil {
+		{
+			size, err := m.SourceSupplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SessionEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	if len(m.DestinationOperatorAddress) > 0 {
+		i -= len(m.DestinationOperatorAddress)
+		copy(dAtA[i:], m.DestinationOperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationOperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UnbondingEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	if len(m.SourceOperatorAddress) > 0 {
+		i -= len(m.SourceOperatorAddress)
+		copy(dAtA[i:], m.SourceOperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SourceOperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSupplierUnbondingCanceled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSupplierTransferEnd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierTransferEnd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierTransferEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.SessionEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Height != 0 {
-		n += 1 + sovEvent(uint64(m.Height))
+	if m.DestinationSupplier != nil {
+		{
+			size, err := m.DestinationSupplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.DestinationOperatorAddress) > 0 {
+		i -= len(m.DestinationOperatorAddress)
+		copy(dAtA[i:], m.DestinationOperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationOperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceOperatorAddress) > 0 {
+		i -= len(m.SourceOperatorAddress)
+		copy(dAtA[i:], m.SourceOperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SourceOperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSupplierStakeStuckInModulePool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSupplierTransferError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierTransferError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierTransferError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.StuckCoin != nil {
-		l = m.StuckCoin.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if m.SourceSupplier != nil {
+		{
+			size, err := m.SourceSupplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.DestinationOperatorAddress) > 0 {
+		i -= len(m.DestinationOperatorAddress)
+		copy(dAtA[i:], m.DestinationOperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationOperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SessionEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	if len(m.SourceOperatorAddress) > 0 {
+		i -= len(m.SourceOperatorAddress)
+		copy(dAtA[i:], m.SourceOperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SourceOperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSupplierServiceConfigActivated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSupplierStakeStuckInModulePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierStakeStuckInModulePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierStakeStuckInModulePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ActivationHeight != 0 {
-		n += 1 + sovEvent(uint64(m.ActivationHeight))
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StuckCoin != nil {
+		{
+			size, err := m.StuckCoin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierServiceConfigActivated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierServiceConfigActivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierServiceConfigActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSupplierStaked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
 	}
 	l = len(m.OperatorAddress)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	l = len(m.ServiceId)
+	return n
+}
+
+func (m *EventSupplierUnbondingBegin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierUnbondingEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierUnbondingCanceled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvent(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingBegin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Stake != nil {
+		l = m.Stake.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierStakeDecreaseUnbondingEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierTransferBegin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DestinationOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SourceSupplier != nil {
+		l = m.SourceSupplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.TransferEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.TransferEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierTransferEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DestinationOperatorAddress)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	return n
-}
+	if m.DestinationSupplier != nil {
+		l = m.DestinationSupplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierTransferError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DestinationOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SourceSupplier != nil {
+		l = m.SourceSupplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSupplierStakeStuckInModulePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.StuckCoin != nil {
+		l = m.StuckCoin.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierServiceConfigActivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ActivationHeight))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierStaked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierStaked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierUnbondingBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= SupplierUnbondingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierUnbondingEnd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= SupplierUnbondingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierUnbondingCanceled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierUnbondingCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierStakeDecreaseUnbondingBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierStakeDecreaseUnbondingBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierStakeDecreaseUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types1.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stake == nil {
+				m.Stake = &types1.Coin{}
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
+func (m *EventSupplierStakeDecreaseUnbondingEnd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -970,13 +2587,113 @@ func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupplierStaked: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSupplierStakeDecreaseUnbondingEnd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupplierStaked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSupplierStakeDecreaseUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types1.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
@@ -995,11 +2712,11 @@ func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
 			}
-			var stringLen uint64
+			m.UnbondingEndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1009,24 +2726,11 @@ func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1048,7 +2752,7 @@ func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
+func (m *EventSupplierTransferBegin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1071,17 +2775,17 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupplierUnbondingBegin: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSupplierTransferBegin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupplierUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSupplierTransferBegin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceOperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1091,33 +2795,29 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supplier == nil {
-				m.Supplier = &types.Supplier{}
-			}
-			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceOperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationOperatorAddress", wireType)
 			}
-			m.Reason = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1127,12 +2827,61 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= SupplierUnbondingReason(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceSupplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceSupplier == nil {
+				m.SourceSupplier = &types.Supplier{}
+			}
+			if err := m.SourceSupplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
@@ -1151,11 +2900,11 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferEndHeight", wireType)
 			}
-			m.UnbondingEndHeight = 0
+			m.TransferEndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1165,7 +2914,7 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				m.TransferEndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1191,7 +2940,7 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
+func (m *EventSupplierTransferEnd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1214,17 +2963,17 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupplierUnbondingEnd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSupplierTransferEnd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupplierUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSupplierTransferEnd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceOperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1234,33 +2983,29 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supplier == nil {
-				m.Supplier = &types.Supplier{}
-			}
-			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceOperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationOperatorAddress", wireType)
 			}
-			m.Reason = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1270,12 +3015,61 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= SupplierUnbondingReason(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationSupplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DestinationSupplier == nil {
+				m.DestinationSupplier = &types.Supplier{}
+			}
+			if err := m.DestinationSupplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
@@ -1294,11 +3088,11 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.UnbondingEndHeight = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1308,7 +3102,7 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1334,7 +3128,7 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
+func (m *EventSupplierTransferError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1357,15 +3151,79 @@ func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupplierUnbondingCanceled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSupplierTransferError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupplierUnbondingCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSupplierTransferError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceSupplier", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1392,14 +3250,14 @@ func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supplier == nil {
-				m.Supplier = &types.Supplier{}
+			if m.SourceSupplier == nil {
+				m.SourceSupplier = &types.Supplier{}
 			}
-			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SourceSupplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
@@ -1418,11 +3276,11 @@ func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1432,11 +3290,24 @@ func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
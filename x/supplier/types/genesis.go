@@ -51,6 +51,11 @@ func (gs GenesisState) Validate() error {
 			return ErrSupplierInvalidStake.Wrapf("invalid stake amount denom for supplier %v", supplier.Stake)
 		}
 
+		// Validate the supplier pending stake decreases
+		if err := sharedtypes.ValidatePendingStakeDecreases(supplier.PendingStakeDecreases); err != nil {
+			return ErrSupplierInvalidStake.Wrapf("invalid pending stake decreases for supplier %q: %s", supplier.OperatorAddress, err)
+		}
+
 		// Validate the application service configs
 		if err := sharedtypes.ValidateSupplierServiceConfigs(supplier.Services); err != nil {
 			return ErrSupplierInvalidServiceConfig.Wrapf("%s", err.Error())
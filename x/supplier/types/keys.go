@@ -11,6 +11,16 @@ package types
 // │ SupplierUnstakingHeightKeyPrefix +       Supplier/unbonding_height/                │
 // │                                         └── <SupplierAddr>/                        │
 // │                                                                                    │
+// │ SupplierTransferKeyPrefix +              Supplier/transfer/                        │
+// │                                         └── <SupplierAddr>/                        │
+// │                                                                                    │
+// │ SupplierStakeDecreaseKeyPrefix +         Supplier/stake_decrease/                  │
+// │                                         └── <SupplierAddr>/                        │
+// │                                                                                    │
+// │ SupplierStakeHistoryKeyPrefix +          Supplier/stake_history/                   │
+// │                                         └── <SupplierAddr>/                        │
+// │                                             <Height>/                              │
+// │                                                                                    │
 // │ ServiceConfigUpdateKey()                 ServiceConfigUpdate/service_id/           │
 // │                                         └── <ServiceID>/                           │
 // │                                             <ActHeight>/                           │
@@ -38,6 +48,7 @@ package types
 //   • <ServiceID>        : UTF-8 bytes of service identifier.
 //   • <ActHeight>        : 8-byte big-endian encoded activation height.
 //   • <DeactHeight>      : 8-byte big-endian encoded deactivation height.
+//   • <Height>           : 8-byte big-endian encoded height from which a stake is effective.
 //   • Every segment (including the encoded heights) is followed by "/" to maintain prefix-scan friendliness.
 
 import (
@@ -70,6 +81,15 @@ const (
 	// SupplierUnstakingHeightKeyPrefix is the prefix for indexing suppliers by their unstaking height
 	SupplierUnstakingHeightKeyPrefix = "Supplier/unbonding_height/"
 
+	// SupplierTransferKeyPrefix is the prefix for indexing suppliers with a pending transfer
+	SupplierTransferKeyPrefix = "Supplier/transfer/"
+
+	// SupplierStakeDecreaseKeyPrefix is the prefix for indexing suppliers with pending stake decreases
+	SupplierStakeDecreaseKeyPrefix = "Supplier/stake_decrease/"
+
+	// SupplierStakeHistoryKeyPrefix is the prefix for the history of the suppliers stakes
+	SupplierStakeHistoryKeyPrefix = "Supplier/stake_history/"
+
 	// ServiceConfigUpdateKeyPrefix is the prefix for indexing service configs by service ID
 	ServiceConfigUpdateKeyPrefix = "ServiceConfigUpdate/service_id/"
 
@@ -0,0 +1,42 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const TypeMsgTransferSupplier = "transfer_supplier"
+
+var _ sdk.Msg = (*MsgTransferSupplier)(nil)
+
+func NewMsgTransferSupplier(
+	ownerAddress string,
+	sourceOperatorAddress string,
+	destinationOperatorAddress string,
+) *MsgTransferSupplier {
+	return &MsgTransferSupplier{
+		OwnerAddress:               ownerAddress,
+		SourceOperatorAddress:      sourceOperatorAddress,
+		DestinationOperatorAddress: destinationOperatorAddress,
+	}
+}
+
+func (msg *MsgTransferSupplier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid owner address %q; (%v)", msg.OwnerAddress, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.SourceOperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid source operator address %q; (%v)", msg.SourceOperatorAddress, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.DestinationOperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid destination operator address %q; (%v)", msg.DestinationOperatorAddress, err)
+	}
+
+	if msg.SourceOperatorAddress == msg.DestinationOperatorAddress {
+		return ErrSupplierDuplicateAddress.Wrapf(
+			"source and destination operator addresses are the same: %s",
+			msg.SourceOperatorAddress,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestMsgTransferSupplier_ValidateBasic(t *testing.T) {
+	ownerAddress := sample.AccAddressBech32()
+	srcOperatorAddress := sample.AccAddressBech32()
+	dstOperatorAddress := sample.AccAddressBech32()
+	tests := []struct {
+		desc        string
+		msg         MsgTransferSupplier
+		expectedErr error
+	}{
+		{
+			desc: "invalid owner address",
+			msg: MsgTransferSupplier{
+				OwnerAddress:               "invalid_address",
+				SourceOperatorAddress:      srcOperatorAddress,
+				DestinationOperatorAddress: dstOperatorAddress,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "missing owner address",
+			msg: MsgTransferSupplier{
+				SourceOperatorAddress:      srcOperatorAddress,
+				DestinationOperatorAddress: dstOperatorAddress,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "invalid source operator address",
+			msg: MsgTransferSupplier{
+				OwnerAddress:               ownerAddress,
+				SourceOperatorAddress:      "invalid_address",
+				DestinationOperatorAddress: dstOperatorAddress,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "missing destination operator address",
+			msg: MsgTransferSupplier{
+				OwnerAddress:          ownerAddress,
+				SourceOperatorAddress: srcOperatorAddress,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "same source and destination operator addresses",
+			msg: MsgTransferSupplier{
+				OwnerAddress:               ownerAddress,
+				SourceOperatorAddress:      srcOperatorAddress,
+				DestinationOperatorAddress: srcOperatorAddress,
+			},
+			expectedErr: ErrSupplierDuplicateAddress,
+		},
+		{
+			desc: "valid message",
+			msg: MsgTransferSupplier{
+				OwnerAddress:               ownerAddress,
+				SourceOperatorAddress:      srcOperatorAddress,
+				DestinationOperatorAddress: dstOperatorAddress,
+			},
+		},
+		{
+			desc: "valid message - same owner and source operator addresses",
+			msg: MsgTransferSupplier{
+				OwnerAddress:               srcOperatorAddress,
+				SourceOperatorAddress:      srcOperatorAddress,
+				DestinationOperatorAddress: dstOperatorAddress,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
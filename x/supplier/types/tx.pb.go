@@ -303,13 +303,103 @@ func (m *MsgUnstakeSupplierResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnstakeSupplierResponse proto.InternalMessageInfo
 
+// MsgTransferSupplier begins the transfer of a supplier (i.e. its stake, owner and
+// service configs) to a new operator address. The transfer completes at the end of
+// the current session; the destination supplier is active from the next session.
+type MsgTransferSupplier struct {
+	OwnerAddress               string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	SourceOperatorAddress      string `protobuf:"bytes,2,opt,name=source_operator_address,json=sourceOperatorAddress,proto3" json:"source_operator_address,omitempty"`
+	DestinationOperatorAddress string `protobuf:"bytes,3,opt,name=destination_operator_address,json=destinationOperatorAddress,proto3" json:"destination_operator_address,omitempty"`
+}
+
+func (m *MsgTransferSupplier) Reset()         { *m = MsgTransferSupplier{} }
+func (m *MsgTransferSupplier) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferSupplier) ProtoMessage()    {}
+func (*MsgTransferSupplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{6}
+}
+func (m *MsgTransferSupplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferSupplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgTransferSupplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferSupplier.Merge(m, src)
+}
+func (m *MsgTransferSupplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferSupplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferSupplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferSupplier proto.InternalMessageInfo
+
+func (m *MsgTransferSupplier) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgTransferSupplier) GetSourceOperatorAddress() string {
+	if m != nil {
+		return m.SourceOperatorAddress
+	}
+	return ""
+}
+
+func (m *MsgTransferSupplier) GetDestinationOperatorAddress() string {
+	if m != nil {
+		return m.DestinationOperatorAddress
+	}
+	return ""
+}
+
+type MsgTransferSupplierResponse struct {
+}
+
+func (m *MsgTransferSupplierResponse) Reset()         { *m = MsgTransferSupplierResponse{} }
+func (m *MsgTransferSupplierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferSupplierResponse) ProtoMessage()    {}
+func (*MsgTransferSupplierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{7}
+}
+func (m *MsgTransferSupplierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferSupplierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgTransferSupplierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferSupplierResponse.Merge(m, src)
+}
+func (m *MsgTransferSupplierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferSupplierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferSupplierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferSupplierResponse proto.InternalMessageInfo
+
 // MsgUpdateParam is the Msg/UpdateParam request type to update a single param.
 type MsgUpdateParam struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Types that are valid to be assigned to AsType:
-	//
 	//	*MsgUpdateParam_AsCoin
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"asType"`
 }
@@ -318,7 +408,7 @@ func (m *MsgUpdateParam) Reset()         { *m = MsgUpdateParam{} }
 func (m *MsgUpdateParam) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParam) ProtoMessage()    {}
 func (*MsgUpdateParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fde5318adc7c16a9, []int{6}
+	return fileDescriptor_fde5318adc7c16a9, []int{8}
 }
 func (m *MsgUpdateParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +487,7 @@ func (m *MsgUpdateParamResponse) Reset()         { *m = MsgUpdateParamResponse{}
 func (m *MsgUpdateParamResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamResponse) ProtoMessage()    {}
 func (*MsgUpdateParamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fde5318adc7c16a9, []int{7}
+	return fileDescriptor_fde5318adc7c16a9, []int{9}
 }
 func (m *MsgUpdateParamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,6 +519,8 @@ func init() {
 	proto.RegisterType((*MsgStakeSupplierResponse)(nil), "pocket.supplier.MsgStakeSupplierResponse")
 	proto.RegisterType((*MsgUnstakeSupplier)(nil), "pocket.supplier.MsgUnstakeSupplier")
 	proto.RegisterType((*MsgUnstakeSupplierResponse)(nil), "pocket.supplier.MsgUnstakeSupplierResponse")
+	proto.RegisterType((*MsgTransferSupplier)(nil), "pocket.supplier.MsgTransferSupplier")
+	proto.RegisterType((*MsgTransferSupplierResponse)(nil), "pocket.supplier.MsgTransferSupplierResponse")
 	proto.RegisterType((*MsgUpdateParam)(nil), "pocket.supplier.MsgUpdateParam")
 	proto.RegisterType((*MsgUpdateParamResponse)(nil), "pocket.supplier.MsgUpdateParamResponse")
 }
@@ -436,50 +528,56 @@ func init() {
 func init() { proto.RegisterFile("pocket/supplier/tx.proto", fileDescriptor_fde5318adc7c16a9) }
 
 var fileDescriptor_fde5318adc7c16a9 = []byte{
-	// 687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3d, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8f, 0x93, 0x36, 0xff, 0xf6, 0x49, 0xdb, 0xf4, 0x6f, 0x55, 0xd4, 0x35, 0xc8, 0x4d, 0x0d,
-	0x12, 0xa1, 0xa8, 0x36, 0x29, 0x15, 0x43, 0x24, 0x24, 0x48, 0x17, 0x84, 0x54, 0x81, 0x1c, 0x10,
-	0x52, 0x25, 0x54, 0x5d, 0x92, 0xc3, 0xb5, 0xd2, 0xf8, 0xac, 0xbb, 0xeb, 0xdb, 0x86, 0x90, 0x58,
-	0x98, 0x18, 0xf9, 0x08, 0x0c, 0x0c, 0x1d, 0xd8, 0xf8, 0x02, 0x1d, 0x2b, 0xa6, 0x4e, 0x08, 0xa5,
-	0x43, 0xbf, 0x06, 0xb2, 0xef, 0x9c, 0xd4, 0x6e, 0x54, 0xf3, 0xb2, 0xd8, 0x77, 0xf7, 0xfc, 0x9e,
-	0xdf, 0xfd, 0x9e, 0xb7, 0x03, 0x2d, 0x20, 0xed, 0x2e, 0xe6, 0x36, 0xdb, 0x0d, 0x82, 0x1d, 0x0f,
-	0x53, 0x9b, 0x1f, 0x58, 0x01, 0x25, 0x9c, 0xa8, 0x65, 0x61, 0xb1, 0x62, 0x8b, 0xfe, 0x3f, 0xea,
-	0x79, 0x3e, 0xb1, 0xa3, 0xaf, 0xc0, 0xe8, 0xf3, 0x6d, 0xc2, 0x7a, 0x84, 0xd9, 0x3d, 0xe6, 0xda,
-	0x7b, 0xb5, 0xf0, 0x27, 0x0d, 0x0b, 0xc2, 0xb0, 0x15, 0xed, 0x6c, 0xb1, 0x91, 0xa6, 0x39, 0x97,
-	0xb8, 0x44, 0x9c, 0x87, 0x2b, 0x79, 0x6a, 0x48, 0xa6, 0x16, 0x62, 0xd8, 0xde, 0xab, 0xb5, 0x30,
-	0x47, 0x35, 0xbb, 0x4d, 0x3c, 0x5f, 0xda, 0x6f, 0xa4, 0x75, 0x06, 0x88, 0xa2, 0x5e, 0xcc, 0x79,
-	0x3d, 0xb6, 0x6e, 0x23, 0x8a, 0x3b, 0x36, 0xc3, 0x74, 0xcf, 0x6b, 0xe3, 0xb4, 0xab, 0x34, 0x4a,
-	0x06, 0x61, 0x35, 0xbf, 0x29, 0x50, 0xde, 0x60, 0xee, 0xcb, 0xa0, 0x83, 0x38, 0x7e, 0x1e, 0x91,
-	0xaa, 0x0f, 0x60, 0x12, 0xed, 0xf2, 0x6d, 0x42, 0x3d, 0x7e, 0xa8, 0x29, 0x15, 0xa5, 0x3a, 0xd9,
-	0xd0, 0xbe, 0x7f, 0x5d, 0x99, 0x93, 0x71, 0x3c, 0xee, 0x74, 0x28, 0x66, 0xac, 0xc9, 0xa9, 0xe7,
-	0xbb, 0xce, 0x10, 0xaa, 0xd6, 0xa1, 0x28, 0x64, 0x69, 0xf9, 0x8a, 0x52, 0x2d, 0xad, 0xce, 0x5b,
-	0xa9, 0x1c, 0x5a, 0xe2, 0x82, 0xc6, 0xe4, 0xf1, 0x8f, 0xc5, 0xdc, 0xe7, 0xf3, 0xa3, 0x65, 0xc5,
-	0x91, 0x1e, 0xf5, 0xb5, 0x77, 0xe7, 0x47, 0xcb, 0x43, 0xae, 0x0f, 0xe7, 0x47, 0xcb, 0x4b, 0x52,
-	0xf8, 0xc1, 0x30, 0xea, 0x94, 0x52, 0x73, 0x01, 0xe6, 0x53, 0x47, 0x0e, 0x66, 0x01, 0xf1, 0x19,
-	0x36, 0x8f, 0xf3, 0x30, 0xbb, 0xc1, 0xdc, 0x26, 0x47, 0x5d, 0xdc, 0x94, 0xfe, 0xea, 0x3d, 0x28,
-	0x32, 0xcf, 0xf5, 0x31, 0xcd, 0x0c, 0x4b, 0xe2, 0xd4, 0x87, 0x30, 0x4d, 0xf6, 0x7d, 0x4c, 0xb7,
-	0x90, 0x30, 0x47, 0xa1, 0x5d, 0xe5, 0x38, 0x15, 0xc1, 0xe5, 0x99, 0xba, 0x0e, 0xb3, 0x24, 0xc0,
-	0x14, 0x71, 0x32, 0x64, 0x28, 0x64, 0x30, 0x94, 0x63, 0x8f, 0x98, 0xc4, 0x86, 0x71, 0x16, 0x86,
-	0xa1, 0x8d, 0x45, 0x69, 0x5d, 0xb0, 0xa4, 0x5b, 0xd8, 0x2c, 0x96, 0x6c, 0x16, 0x6b, 0x9d, 0x78,
-	0xbe, 0x23, 0x70, 0xea, 0x23, 0x98, 0x90, 0x3d, 0xc0, 0xb4, 0xf1, 0x4a, 0xa1, 0x5a, 0x5a, 0xbd,
-	0x35, 0x28, 0x45, 0xd4, 0x05, 0x56, 0x9c, 0x91, 0xa6, 0x80, 0xad, 0x13, 0xff, 0x8d, 0xe7, 0x3a,
-	0x03, 0xaf, 0x7a, 0x29, 0x2c, 0x87, 0xcc, 0x81, 0x59, 0x01, 0x2d, 0x9d, 0xc9, 0x38, 0xcd, 0x4f,
-	0xc7, 0x26, 0x94, 0xd9, 0xbc, 0xf9, 0x49, 0x01, 0x35, 0x2c, 0x84, 0xcf, 0xfe, 0x31, 0xdd, 0xa3,
-	0xf2, 0x95, 0xff, 0xc3, 0x7c, 0x25, 0xc5, 0x9b, 0xa0, 0x5f, 0x56, 0x96, 0x92, 0xff, 0x45, 0x81,
-	0x99, 0x64, 0x1f, 0xfd, 0xf5, 0x0c, 0xa8, 0x30, 0xe6, 0xa3, 0x1e, 0x16, 0xa2, 0x9d, 0x68, 0xad,
-	0xae, 0xc1, 0x7f, 0x88, 0x6d, 0x85, 0xd3, 0x1c, 0xd5, 0xfe, 0xaa, 0x0a, 0x3e, 0xc9, 0x39, 0x45,
-	0xc4, 0xc2, 0x55, 0x7d, 0x26, 0x39, 0x11, 0x8d, 0x09, 0x28, 0x22, 0xf6, 0xe2, 0x30, 0xc0, 0xa6,
-	0x01, 0xd7, 0x92, 0x6a, 0x93, 0xe1, 0xac, 0xbe, 0x2f, 0x40, 0x61, 0x83, 0xb9, 0xea, 0x26, 0x4c,
-	0x25, 0xe6, 0xba, 0x72, 0x69, 0x1e, 0x53, 0xc3, 0xa3, 0x57, 0xb3, 0x10, 0xf1, 0x4d, 0xea, 0x6b,
-	0x98, 0x4e, 0x8e, 0xd6, 0xd2, 0x28, 0xd7, 0x04, 0x44, 0xbf, 0x93, 0x09, 0x19, 0xd0, 0xb7, 0xa1,
-	0x9c, 0x6e, 0xa6, 0x9b, 0x23, 0xb5, 0x25, 0x41, 0xfa, 0xdd, 0xdf, 0x00, 0x0d, 0x2e, 0x79, 0x05,
-	0xa5, 0x8b, 0x25, 0x5f, 0xcc, 0x08, 0x5e, 0xbf, 0x9d, 0x01, 0x88, 0x89, 0xf5, 0xf1, 0xb7, 0xe1,
-	0xdb, 0xd6, 0x78, 0x76, 0xdc, 0x37, 0x94, 0x93, 0xbe, 0xa1, 0x9c, 0xf6, 0x0d, 0xe5, 0x67, 0xdf,
-	0x50, 0x3e, 0x9e, 0x19, 0xb9, 0x93, 0x33, 0x23, 0x77, 0x7a, 0x66, 0xe4, 0x36, 0x6b, 0xae, 0xc7,
-	0xb7, 0x77, 0x5b, 0x56, 0x9b, 0xf4, 0xec, 0x80, 0x74, 0xf9, 0x8a, 0x8f, 0xf9, 0x3e, 0xa1, 0xdd,
-	0x68, 0x43, 0xc9, 0xce, 0xce, 0xc5, 0x87, 0x8f, 0x1f, 0x06, 0x98, 0xb5, 0x8a, 0xd1, 0x9b, 0x7d,
-	0xff, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x8f, 0x96, 0x9a, 0xb6, 0x06, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0xdb, 0x4a,
+	0x10, 0x8f, 0x13, 0xc8, 0x83, 0x0d, 0x90, 0x3c, 0x3f, 0xde, 0x8b, 0x31, 0x3c, 0x13, 0x5c, 0xa4,
+	0xa6, 0xb4, 0xd8, 0x0d, 0x45, 0x3d, 0x44, 0xaa, 0xd4, 0x86, 0x4b, 0x55, 0x29, 0x02, 0x25, 0x54,
+	0x95, 0x90, 0xaa, 0x68, 0x93, 0x2c, 0xc6, 0x0a, 0xf1, 0x5a, 0xbb, 0x1b, 0xfe, 0xdc, 0xaa, 0x1e,
+	0x7b, 0xea, 0xa9, 0xea, 0x47, 0xe8, 0xa1, 0x07, 0x0e, 0xbd, 0xf5, 0x0b, 0x70, 0x44, 0x3d, 0x71,
+	0xaa, 0xaa, 0x70, 0xe0, 0xd4, 0xef, 0x50, 0xd9, 0x5e, 0x3b, 0xd8, 0x89, 0x64, 0xd2, 0x5e, 0x12,
+	0xef, 0xce, 0x6f, 0x7e, 0x3b, 0xf3, 0x9b, 0xd9, 0x59, 0x20, 0xd9, 0xb8, 0xd5, 0x41, 0x4c, 0xa7,
+	0x3d, 0xdb, 0x3e, 0x34, 0x11, 0xd1, 0xd9, 0x89, 0x66, 0x13, 0xcc, 0xb0, 0x98, 0xf5, 0x2c, 0x9a,
+	0x6f, 0x91, 0xff, 0x86, 0x5d, 0xd3, 0xc2, 0xba, 0xfb, 0xeb, 0x61, 0xe4, 0x7c, 0x0b, 0xd3, 0x2e,
+	0xa6, 0x7a, 0x97, 0x1a, 0xfa, 0x51, 0xc9, 0xf9, 0xe3, 0x86, 0x05, 0xcf, 0xd0, 0x70, 0x57, 0xba,
+	0xb7, 0xe0, 0xa6, 0x79, 0x03, 0x1b, 0xd8, 0xdb, 0x77, 0xbe, 0xf8, 0xae, 0xc2, 0x99, 0x9a, 0x90,
+	0x22, 0xfd, 0xa8, 0xd4, 0x44, 0x0c, 0x96, 0xf4, 0x16, 0x36, 0x2d, 0x6e, 0x5f, 0x8a, 0xc6, 0x69,
+	0x43, 0x02, 0xbb, 0x3e, 0xe7, 0xa2, 0x6f, 0x3d, 0x80, 0x04, 0xb5, 0x75, 0x8a, 0xc8, 0x91, 0xd9,
+	0x42, 0x51, 0x57, 0x6e, 0xe4, 0x0c, 0x9e, 0x55, 0xfd, 0x2a, 0x80, 0x6c, 0x95, 0x1a, 0x2f, 0xed,
+	0x36, 0x64, 0x68, 0xc7, 0x25, 0x15, 0x1f, 0x83, 0x69, 0xd8, 0x63, 0x07, 0x98, 0x98, 0xec, 0x54,
+	0x12, 0x0a, 0x42, 0x71, 0xba, 0x22, 0x7d, 0xfb, 0xb2, 0x3e, 0xcf, 0xf3, 0x78, 0xd6, 0x6e, 0x13,
+	0x44, 0x69, 0x9d, 0x11, 0xd3, 0x32, 0x6a, 0x03, 0xa8, 0x58, 0x06, 0x69, 0x2f, 0x2c, 0x29, 0x59,
+	0x10, 0x8a, 0x99, 0x8d, 0xbc, 0x16, 0xd1, 0x50, 0xf3, 0x0e, 0xa8, 0x4c, 0x9f, 0x7f, 0x5f, 0x4e,
+	0x7c, 0xba, 0x3e, 0x5b, 0x13, 0x6a, 0xdc, 0xa3, 0xbc, 0xf9, 0xf6, 0xfa, 0x6c, 0x6d, 0xc0, 0xf5,
+	0xee, 0xfa, 0x6c, 0x6d, 0x85, 0x07, 0x7e, 0x32, 0xc8, 0x3a, 0x12, 0xa9, 0xba, 0x00, 0xf2, 0x91,
+	0xad, 0x1a, 0xa2, 0x36, 0xb6, 0x28, 0x52, 0xcf, 0x93, 0x20, 0x57, 0xa5, 0x46, 0x9d, 0xc1, 0x0e,
+	0xaa, 0x73, 0x7f, 0xf1, 0x21, 0x48, 0x53, 0xd3, 0xb0, 0x10, 0x89, 0x4d, 0x8b, 0xe3, 0xc4, 0x27,
+	0x60, 0x16, 0x1f, 0x5b, 0x88, 0x34, 0xa0, 0x67, 0x96, 0x92, 0x31, 0x8e, 0x33, 0x2e, 0x9c, 0xef,
+	0x89, 0x5b, 0x20, 0x87, 0x6d, 0x44, 0x20, 0xc3, 0x03, 0x86, 0x54, 0x0c, 0x43, 0xd6, 0xf7, 0xf0,
+	0x49, 0x74, 0x30, 0x49, 0x9d, 0x34, 0xa4, 0x09, 0x57, 0xd6, 0x05, 0x8d, 0xbb, 0x39, 0xcd, 0xa2,
+	0xf1, 0x66, 0xd1, 0xb6, 0xb0, 0x69, 0xd5, 0x3c, 0x9c, 0xf8, 0x14, 0x4c, 0xf1, 0x1e, 0xa0, 0xd2,
+	0x64, 0x21, 0x55, 0xcc, 0x6c, 0xac, 0x06, 0xa5, 0x70, 0xbb, 0x40, 0xf3, 0x15, 0xa9, 0x7b, 0xb0,
+	0x2d, 0x6c, 0xed, 0x9b, 0x46, 0x2d, 0xf0, 0x2a, 0x67, 0x9c, 0x72, 0x70, 0x0d, 0xd4, 0x02, 0x90,
+	0xa2, 0x4a, 0xfa, 0x32, 0xbf, 0x98, 0x98, 0x12, 0x72, 0x49, 0xf5, 0xa3, 0x00, 0x44, 0xa7, 0x10,
+	0x16, 0xfd, 0x43, 0xb9, 0x47, 0xe9, 0x95, 0x1c, 0x53, 0xaf, 0x70, 0xf0, 0x2a, 0x90, 0x87, 0x23,
+	0x8b, 0x84, 0xff, 0x21, 0x09, 0xfe, 0xa9, 0x52, 0x63, 0x97, 0x40, 0x8b, 0xee, 0x23, 0x12, 0xc4,
+	0x3f, 0x54, 0x7c, 0x61, 0xac, 0xe2, 0xef, 0x80, 0x3c, 0xc5, 0x3d, 0xd2, 0x42, 0x8d, 0xb1, 0x73,
+	0xfa, 0xd7, 0x73, 0xdc, 0x8e, 0x74, 0xc2, 0x1e, 0x58, 0x6a, 0x23, 0xca, 0x4c, 0x0b, 0x32, 0x13,
+	0x5b, 0x8d, 0xb1, 0x5b, 0x4b, 0xbe, 0xe1, 0x1d, 0xe1, 0x2e, 0x8b, 0x8e, 0x6a, 0xe1, 0x7c, 0xd5,
+	0xff, 0xc1, 0xe2, 0x08, 0x5d, 0x82, 0x3b, 0xf6, 0x59, 0x00, 0x73, 0xe1, 0xfb, 0xf7, 0xdb, 0xb3,
+	0x43, 0x04, 0x13, 0x16, 0xec, 0x22, 0x4f, 0x98, 0x9a, 0xfb, 0x2d, 0x6e, 0x82, 0xbf, 0x20, 0x6d,
+	0x38, 0x53, 0x50, 0x4a, 0xc5, 0x74, 0xfe, 0xf3, 0x44, 0x2d, 0x0d, 0xa9, 0xf3, 0x55, 0x9e, 0x0b,
+	0x4f, 0x92, 0xca, 0x14, 0x48, 0x43, 0xba, 0x7b, 0x6a, 0x23, 0x55, 0x01, 0xff, 0x85, 0xa3, 0x0d,
+	0xb7, 0xc1, 0xc6, 0xcf, 0x14, 0x48, 0x55, 0xa9, 0x21, 0xee, 0x81, 0x99, 0xd0, 0x3c, 0x2c, 0x0c,
+	0xcd, 0xb1, 0x30, 0x0d, 0x95, 0x8b, 0x71, 0x08, 0xff, 0x24, 0xf1, 0x35, 0x98, 0x0d, 0x8f, 0xa4,
+	0x95, 0x51, 0xae, 0x21, 0x88, 0x7c, 0x2f, 0x16, 0x12, 0xd0, 0xb7, 0x40, 0x36, 0x7a, 0x09, 0xef,
+	0x8c, 0x8c, 0x2d, 0x0c, 0x92, 0xef, 0xdf, 0x02, 0x14, 0x1c, 0xf2, 0x0a, 0x64, 0x6e, 0x96, 0x7c,
+	0x39, 0x26, 0x79, 0xf9, 0x6e, 0x0c, 0x20, 0x20, 0xde, 0x07, 0xb9, 0xa1, 0x3b, 0xb8, 0x3a, 0xca,
+	0x39, 0x8a, 0x92, 0x1f, 0xdc, 0x06, 0xe5, 0x9f, 0x23, 0x4f, 0xbe, 0x71, 0xde, 0x9e, 0xca, 0xf6,
+	0x79, 0x5f, 0x11, 0x2e, 0xfa, 0x8a, 0x70, 0xd9, 0x57, 0x84, 0x1f, 0x7d, 0x45, 0x78, 0x7f, 0xa5,
+	0x24, 0x2e, 0xae, 0x94, 0xc4, 0xe5, 0x95, 0x92, 0xd8, 0x2b, 0x19, 0x26, 0x3b, 0xe8, 0x35, 0xb5,
+	0x16, 0xee, 0xea, 0x36, 0xee, 0xb0, 0x75, 0x0b, 0xb1, 0x63, 0x4c, 0x3a, 0xee, 0x82, 0xe0, 0xc3,
+	0xc3, 0x9b, 0x0f, 0x13, 0x3b, 0xb5, 0x11, 0x6d, 0xa6, 0xdd, 0x37, 0xf5, 0xd1, 0xaf, 0x01, 0x00,
+	0x8d, 0x97, 0x2a, 0xbe, 0x56, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -500,6 +598,7 @@ type MsgClient interface {
 	StakeSupplier(ctx context.Context, in *MsgStakeSupplier, opts ...grpc.CallOption) (*MsgStakeSupplierResponse, error)
 	UnstakeSupplier(ctx context.Context, in *MsgUnstakeSupplier, opts ...grpc.CallOption) (*MsgUnstakeSupplierResponse, error)
 	UpdateParam(ctx context.Context, in *MsgUpdateParam, opts ...grpc.CallOption) (*MsgUpdateParamResponse, error)
+	TransferSupplier(ctx context.Context, in *MsgTransferSupplier, opts ...grpc.CallOption) (*MsgTransferSupplierResponse, error)
 }
 
 type msgClient struct {
@@ -546,6 +645,15 @@ func (c *msgClient) UpdateParam(ctx context.Context, in *MsgUpdateParam, opts ..
 	return out, nil
 }
 
+func (c *msgClient) TransferSupplier(ctx context.Context, in *MsgTransferSupplier, opts ...grpc.CallOption) (*MsgTransferSupplierResponse, error) {
+	out := new(MsgTransferSupplierResponse)
+	err := c.cc.Invoke(ctx, "/pocket.supplier.Msg/TransferSupplier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -554,6 +662,7 @@ type MsgServer interface {
 	StakeSupplier(context.Context, *MsgStakeSupplier) (*MsgStakeSupplierResponse, error)
 	UnstakeSupplier(context.Context, *MsgUnstakeSupplier) (*MsgUnstakeSupplierResponse, error)
 	UpdateParam(context.Context, *MsgUpdateParam) (*MsgUpdateParamResponse, error)
+	TransferSupplier(context.Context, *MsgTransferSupplier) (*MsgTransferSupplierResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -572,6 +681,9 @@ func (*UnimplementedMsgServer) UnstakeSupplier(ctx context.Context, req *MsgUnst
 func (*UnimplementedMsgServer) UpdateParam(ctx context.Context, req *MsgUpdateParam) (*MsgUpdateParamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParam not implemented")
 }
+func (*UnimplementedMsgServer) TransferSupplier(ctx context.Context, req *MsgTransferSupplier) (*MsgTransferSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferSupplier not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -649,6 +761,24 @@ func _Msg_UpdateParam_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferSupplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.supplier.Msg/TransferSupplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferSupplier(ctx, req.(*MsgTransferSupplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pocket.supplier.Msg",
@@ -670,6 +800,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParam",
 			Handler:    _Msg_UpdateParam_Handler,
 		},
+		{
+			MethodName: "TransferSupplier",
+			Handler:    _Msg_TransferSupplier_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pocket/supplier/tx.proto",
@@ -891,6 +1025,73 @@ func (m *MsgUnstakeSupplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferSupplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferSupplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferSupplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationOperatorAddress) > 0 {
+		i -= len(m.DestinationOperatorAddress)
+		copy(dAtA[i:], m.DestinationOperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationOperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceOperatorAddress) > 0 {
+		i -= len(m.SourceOperatorAddress)
+		copy(dAtA[i:], m.SourceOperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceOperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferSupplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferSupplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferSupplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1082,6 +1283,36 @@ func (m *MsgUnstakeSupplierResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferSupplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferSupplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParam) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1724,6 +1955,202 @@ func (m *MsgUnstakeSupplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferSupplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferSupplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferSupplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferSupplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferSupplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -52,8 +52,12 @@ func (k msgServer) UpdateParam(
 
 	// GlobalInflationPerClaim
 	case tokenomicstypes.ParamGlobalInflationPerClaim:
-		logger = logger.With("param_value", msg.GetAsFloat())
-		params.GlobalInflationPerClaim = msg.GetAsFloat()
+		globalInflationPerClaim, err := msg.GetAsLegacyDec()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger = logger.With("param_value", globalInflationPerClaim.String())
+		params.GlobalInflationPerClaim = globalInflationPerClaim
 
 	// MintEqualsBurnClaimDistribution
 	case tokenomicstypes.ParamMintEqualsBurnClaimDistribution:
@@ -62,14 +66,23 @@ func (k msgServer) UpdateParam(
 
 	// MintRatio (PIP-41: deflationary mint mechanism)
 	case tokenomicstypes.ParamMintRatio:
-		logger = logger.With("param_value", msg.GetAsFloat())
-		params.MintRatio = msg.GetAsFloat()
+		mintRatio, err := msg.GetAsLegacyDec()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger = logger.With("param_value", mintRatio.String())
+		params.MintRatio = mintRatio
 
 	// OverservicingBonusMultiplier (settlement budget redistribution)
 	case tokenomicstypes.ParamOverservicingBonusMultiplier:
 		logger = logger.With("param_value", msg.GetAsUint64())
 		params.OverservicingBonusMultiplier = msg.GetAsUint64()
 
+	// SettlementHistoryRetentionBlocks (settlement history index)
+	case tokenomicstypes.ParamSettlementHistoryRetentionBlocks:
+		logger = logger.With("param_value", msg.GetAsUint64())
+		params.SettlementHistoryRetentionBlocks = msg.GetAsUint64()
+
 	// Default
 	default:
 		return nil, status.Error(
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
@@ -14,11 +15,11 @@ import (
 
 func TestMsgUpdateParam_UpdateMintAllocationPercentagesOnly(t *testing.T) {
 	expectedMintAllocationPercentages := tokenomicstypes.MintAllocationPercentages{
-		Dao:         0.1,
-		Proposer:    0.2,
-		Supplier:    0.3,
-		SourceOwner: 0.4,
-		Application: 0.0,
+		Dao:         math.LegacyMustNewDecFromStr("0.1"),
+		Proposer:    math.LegacyMustNewDecFromStr("0.2"),
+		Supplier:    math.LegacyMustNewDecFromStr("0.3"),
+		SourceOwner: math.LegacyMustNewDecFromStr("0.4"),
+		Application: math.LegacyZeroDec(),
 	}
 
 	// Set the parameters to their default values
@@ -77,7 +78,7 @@ func TestMsgUpdateParam_UpdateDaoRewardAddressOnly(t *testing.T) {
 }
 
 func TestMsgUpdateParam_UpdateGlobalInflationPerClaimOnly(t *testing.T) {
-	expectedGlobalInflationPerClaim := 0.666
+	expectedGlobalInflationPerClaim := math.LegacyMustNewDecFromStr("0.666")
 
 	// Set the parameters to their default values
 	k, msgSrv, ctx := setupMsgServer(t)
@@ -91,7 +92,7 @@ func TestMsgUpdateParam_UpdateGlobalInflationPerClaimOnly(t *testing.T) {
 	updateParamMsg := &tokenomicstypes.MsgUpdateParam{
 		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		Name:      tokenomicstypes.ParamGlobalInflationPerClaim,
-		AsType:    &tokenomicstypes.MsgUpdateParam_AsFloat{AsFloat: expectedGlobalInflationPerClaim},
+		AsType:    &tokenomicstypes.MsgUpdateParam_AsDec{AsDec: expectedGlobalInflationPerClaim.String()},
 	}
 	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
 	require.NoError(t, err)
@@ -108,7 +109,7 @@ func TestMsgUpdateParam_UpdateGlobalInflationPerClaimOnly(t *testing.T) {
 // TestMsgUpdateParam_UpdateMintRatioOnly tests updating the MintRatio parameter (PIP-41).
 func TestMsgUpdateParam_UpdateMintRatioOnly(t *testing.T) {
 	// PIP-41 target: 0.975 (2.5% deflation)
-	expectedMintRatio := 0.975
+	expectedMintRatio := math.LegacyMustNewDecFromStr("0.975")
 
 	// Set the parameters to their default values
 	k, msgSrv, ctx := setupMsgServer(t)
@@ -122,7 +123,7 @@ func TestMsgUpdateParam_UpdateMintRatioOnly(t *testing.T) {
 	updateParamMsg := &tokenomicstypes.MsgUpdateParam{
 		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		Name:      tokenomicstypes.ParamMintRatio,
-		AsType:    &tokenomicstypes.MsgUpdateParam_AsFloat{AsFloat: expectedMintRatio},
+		AsType:    &tokenomicstypes.MsgUpdateParam_AsDec{AsDec: expectedMintRatio.String()},
 	}
 	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
 	require.NoError(t, err)
@@ -136,23 +137,62 @@ func TestMsgUpdateParam_UpdateMintRatioOnly(t *testing.T) {
 	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(tokenomicstypes.KeyMintRatio))
 }
 
+func TestMsgUpdateParam_UpdateSettlementHistoryRetentionBlocksOnly(t *testing.T) {
+	expectedSettlementHistoryRetentionBlocks := uint64(500)
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := tokenomicstypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.NotEqual(t, expectedSettlementHistoryRetentionBlocks, defaultParams.SettlementHistoryRetentionBlocks)
+
+	// Update the settlement history retention blocks.
+	updateParamMsg := &tokenomicstypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      tokenomicstypes.ParamSettlementHistoryRetentionBlocks,
+		AsType:    &tokenomicstypes.MsgUpdateParam_AsUint64{AsUint64: expectedSettlementHistoryRetentionBlocks},
+	}
+	_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	// Query the updated params from the keeper
+	updatedParams := k.GetParams(ctx)
+	require.NotEqual(t, defaultParams.SettlementHistoryRetentionBlocks, updatedParams.SettlementHistoryRetentionBlocks)
+	require.Equal(t, expectedSettlementHistoryRetentionBlocks, updatedParams.SettlementHistoryRetentionBlocks)
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, &updatedParams, string(tokenomicstypes.KeySettlementHistoryRetentionBlocks))
+}
+
 // TestMsgUpdateParam_UpdateMintRatioInvalid tests that invalid MintRatio values are rejected.
 func TestMsgUpdateParam_UpdateMintRatioInvalid(t *testing.T) {
 	tests := []struct {
 		desc         string
-		mintRatio    float64
+		mintRatio    string
 		expectErrMsg string
 	}{
 		{
 			desc:         "greater than 1",
-			mintRatio:    1.1,
+			mintRatio:    "1.1",
 			expectErrMsg: "mint_ratio must be in range (0, 1]",
 		},
 		{
 			desc:         "negative value",
-			mintRatio:    -0.5,
+			mintRatio:    "-0.5",
 			expectErrMsg: "mint_ratio must be in range (0, 1]",
 		},
+		{
+			desc:         "not a decimal",
+			mintRatio:    "0.975e0",
+			expectErrMsg: "invalid decimal value",
+		},
+		{
+			desc:         "more than 18 decimal places",
+			mintRatio:    "0.9750000000000000001",
+			expectErrMsg: "invalid decimal value",
+		},
 	}
 
 	for _, test := range tests {
@@ -166,7 +206,7 @@ func TestMsgUpdateParam_UpdateMintRatioInvalid(t *testing.T) {
 			updateParamMsg := &tokenomicstypes.MsgUpdateParam{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				Name:      tokenomicstypes.ParamMintRatio,
-				AsType:    &tokenomicstypes.MsgUpdateParam_AsFloat{AsFloat: test.mintRatio},
+				AsType:    &tokenomicstypes.MsgUpdateParam_AsDec{AsDec: test.mintRatio},
 			}
 			_, err := msgSrv.UpdateParam(ctx, updateParamMsg)
 			require.Error(t, err)
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pokt-network/poktroll/testutil/sample"
@@ -58,11 +59,11 @@ func TestMsgUpdateParams(t *testing.T) {
 
 					// MintAllocationXXX params MUST sum to 1. This part of the config WILL NOT make the test fail.
 					MintAllocationPercentages: tokenomicstypes.MintAllocationPercentages{
-						Dao:         0.1,
-						Proposer:    0.0,
-						Supplier:    0.1,
-						SourceOwner: 0.1,
-						Application: 0.7,
+						Dao:         math.LegacyMustNewDecFromStr("0.1"),
+						Proposer:    math.LegacyZeroDec(),
+						Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+						SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+						Application: math.LegacyMustNewDecFromStr("0.7"),
 					},
 				},
 			},
@@ -77,7 +78,7 @@ func TestMsgUpdateParams(t *testing.T) {
 				Authority: tokenomicsKeeper.GetAuthority(),
 				Params: tokenomicstypes.Params{
 					// GlobalInflationPerClaim MUST be positive.
-					GlobalInflationPerClaim: -0.1,
+					GlobalInflationPerClaim: math.LegacyMustNewDecFromStr("-0.1"),
 
 					// DaoRewardAddress MUST NOT be empty string
 					// when MintAllocationDao is greater than 0.
@@ -85,11 +86,11 @@ func TestMsgUpdateParams(t *testing.T) {
 
 					// MintAllocationXXX params MUST sum to 1. This part of the config WILL NOT make the test fail.
 					MintAllocationPercentages: tokenomicstypes.MintAllocationPercentages{
-						Dao:         0.1,
-						Proposer:    0.1,
-						Supplier:    0.1,
-						SourceOwner: 0.1,
-						Application: 0.6,
+						Dao:         math.LegacyMustNewDecFromStr("0.1"),
+						Proposer:    math.LegacyMustNewDecFromStr("0.1"),
+						Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+						SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+						Application: math.LegacyMustNewDecFromStr("0.6"),
 					},
 				},
 			},
@@ -104,7 +105,7 @@ func TestMsgUpdateParams(t *testing.T) {
 				Authority: tokenomicsKeeper.GetAuthority(),
 				Params: tokenomicstypes.Params{
 					// GlobalInflationPerClaim MUST be positive.
-					GlobalInflationPerClaim: 0.1,
+					GlobalInflationPerClaim: math.LegacyMustNewDecFromStr("0.1"),
 
 					// DaoRewardAddress MUST NOT be empty string
 					// when MintAllocationDao is greater than 0.
@@ -112,25 +113,25 @@ func TestMsgUpdateParams(t *testing.T) {
 
 					// MintAllocationXXX params MUST sum to 1. This part of the config WILL make the test fail.
 					MintAllocationPercentages: tokenomicstypes.MintAllocationPercentages{
-						Dao:         0.1,
-						Proposer:    0.0,
-						Supplier:    0.1,
-						SourceOwner: 0.1,
-						Application: 0.6,
+						Dao:         math.LegacyMustNewDecFromStr("0.1"),
+						Proposer:    math.LegacyZeroDec(),
+						Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+						SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+						Application: math.LegacyMustNewDecFromStr("0.6"),
 					},
 					// MintEqualsBurnClaimDistribution MUST sum to 1. This part of the config WILL NOT make the test fail.
 					MintEqualsBurnClaimDistribution: tokenomicstypes.MintEqualsBurnClaimDistribution{
-						Dao:         0.1,
-						Proposer:    0.1,
-						Supplier:    0.1,
-						SourceOwner: 0.1,
-						Application: 0.6,
+						Dao:         math.LegacyMustNewDecFromStr("0.1"),
+						Proposer:    math.LegacyMustNewDecFromStr("0.1"),
+						Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+						SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+						Application: math.LegacyMustNewDecFromStr("0.6"),
 					},
 				},
 			},
 
 			shouldError:    true,
-			expectedErrMsg: "do not add to 1.0: got 0.900000",
+			expectedErrMsg: "do not add to 1.0: got 0.900000000000000000",
 		},
 		{
 			desc: "invalid: MintEqualsBurnClaimDistribution percentages don't sum to 1",
@@ -139,7 +140,7 @@ func TestMsgUpdateParams(t *testing.T) {
 				Authority: tokenomicsKeeper.GetAuthority(),
 				Params: tokenomicstypes.Params{
 					// GlobalInflationPerClaim MUST be positive.
-					GlobalInflationPerClaim: 0.1,
+					GlobalInflationPerClaim: math.LegacyMustNewDecFromStr("0.1"),
 
 					// DaoRewardAddress MUST NOT be empty string
 					// when MintAllocationDao is greater than 0.
@@ -147,25 +148,25 @@ func TestMsgUpdateParams(t *testing.T) {
 
 					// MintAllocationXXX params MUST sum to 1. This part of the config WILL NOT make the test fail.
 					MintAllocationPercentages: tokenomicstypes.MintAllocationPercentages{
-						Dao:         0.1,
-						Proposer:    0.1,
-						Supplier:    0.1,
-						SourceOwner: 0.1,
-						Application: 0.6,
+						Dao:         math.LegacyMustNewDecFromStr("0.1"),
+						Proposer:    math.LegacyMustNewDecFromStr("0.1"),
+						Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+						SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+						Application: math.LegacyMustNewDecFromStr("0.6"),
 					},
 					// MintEqualsBurnClaimDistribution MUST sum to 1. This part of the config WILL make the test fail.
 					MintEqualsBurnClaimDistribution: tokenomicstypes.MintEqualsBurnClaimDistribution{
-						Dao:         0.1,
-						Proposer:    0.0,
-						Supplier:    0.1,
-						SourceOwner: 0.1,
-						Application: 0.6,
+						Dao:         math.LegacyMustNewDecFromStr("0.1"),
+						Proposer:    math.LegacyZeroDec(),
+						Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+						SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+						Application: math.LegacyMustNewDecFromStr("0.6"),
 					},
 				},
 			},
 
 			shouldError:    true,
-			expectedErrMsg: "do not add to 1.0: got 0.900000",
+			expectedErrMsg: "do not add to 1.0: got 0.900000000000000000",
 		},
 		{
 			desc: "valid: successful param update",
@@ -174,14 +175,14 @@ func TestMsgUpdateParams(t *testing.T) {
 				Authority: tokenomicsKeeper.GetAuthority(),
 				Params: tokenomicstypes.Params{
 					MintAllocationPercentages: tokenomicstypes.MintAllocationPercentages{
-						Dao:         0.1,
-						Proposer:    0.1,
-						Supplier:    0.1,
-						SourceOwner: 0.1,
-						Application: 0.6,
+						Dao:         math.LegacyMustNewDecFromStr("0.1"),
+						Proposer:    math.LegacyMustNewDecFromStr("0.1"),
+						Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+						SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+						Application: math.LegacyMustNewDecFromStr("0.6"),
 					},
 					DaoRewardAddress:                sample.AccAddressBech32(),
-					GlobalInflationPerClaim:         0,
+					GlobalInflationPerClaim:         math.LegacyZeroDec(),
 					MintEqualsBurnClaimDistribution: tokenomicstypes.DefaultMintEqualsBurnClaimDistribution,
 					MintRatio:                       tokenomicstypes.DefaultMintRatio,
 				},
@@ -31,3 +31,62 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 
 	return nil
 }
+
+// MigrateLegacyFloat64Params migrates the tokenomics params stored before their
+// percentages and ratios were migrated from float64 to decimals.
+// The legacy float64 values are decoded from the raw params bytes, since their
+// field numbers are reserved, and only fill the decimal params which are still unset:
+// calling it on already migrated params is a no-op.
+//
+// The proof missing penalty ratios were introduced as decimals and are never stored
+// as float64, so they are set by applyNewProofParams in the vNEXT upgrade instead.
+//
+// TODO_DELETE(@olshansk): Remove this function after the vNEXT upgrade
+func (k Keeper) MigrateLegacyFloat64Params(ctx context.Context) (types.Params, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	paramsBz := store.Get(types.ParamsKey)
+
+	var params types.Params
+	if err := k.cdc.Unmarshal(paramsBz, &params); err != nil {
+		return params, err
+	}
+
+	legacyParams, err := types.DecodeLegacyFloat64Params(paramsBz)
+	if err != nil {
+		return params, err
+	}
+
+	if err = legacyParams.FillUnsetDecParams(&params); err != nil {
+		return params, err
+	}
+
+	// The legacy float64 validation summed the percentages rounded to basis points,
+	// while the decimal one requires them to sum to exactly 1: legacy percentages
+	// which only passed thanks to that rounding are scaled rather than failing
+	// the upgrade, which would halt the chain.
+	logger := k.Logger().With("method", "MigrateLegacyFloat64Params")
+	if prevSum, isNormalized := params.MintAllocationPercentages.NormalizeSum(); isNormalized {
+		logger.Warn(
+			"legacy mint allocation percentages do not sum to 1; scaling them",
+			"prev_sum", prevSum.String(),
+			"mint_allocation_percentages", params.MintAllocationPercentages.String(),
+		)
+	}
+	if prevSum, isNormalized := params.MintEqualsBurnClaimDistribution.NormalizeSum(); isNormalized {
+		logger.Warn(
+			"legacy mint equals burn claim distribution does not sum to 1; scaling it",
+			"prev_sum", prevSum.String(),
+			"mint_equals_burn_claim_distribution", params.MintEqualsBurnClaimDistribution.String(),
+		)
+	}
+
+	if err = params.ValidateBasic(); err != nil {
+		return params, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
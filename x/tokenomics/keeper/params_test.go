@@ -1,10 +1,24 @@
 package keeper_test
 
 import (
+	"context"
+	gomath "math"
 	"testing"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 
+	tokenomicskeeper "github.com/pokt-network/poktroll/x/tokenomics/keeper"
+	tlm "github.com/pokt-network/poktroll/x/tokenomics/token_logic_module"
 	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 )
 
@@ -21,8 +35,8 @@ func TestParams_ValidateMintAllocationDao(t *testing.T) {
 		},
 		{
 			desc:              "invalid MintAllocationDao (<0)",
-			mintAllocationDao: -0.1,
-			expectedErr:       tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to DAO must be greater than or equal to 0: got %f", -0.1),
+			mintAllocationDao: math.LegacyMustNewDecFromStr("-0.1"),
+			expectedErr:       tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to DAO must be greater than or equal to 0: got %s", math.LegacyMustNewDecFromStr("-0.1")),
 		},
 		{
 			desc:              "valid MintAllocationDao",
@@ -55,8 +69,8 @@ func TestParams_ValidateMintAllocationProposer(t *testing.T) {
 		},
 		{
 			desc:                   "invalid MintAllocationProposer (<0)",
-			mintAllocationProposer: -0.1,
-			expectedErr:            tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to proposer must be greater than or equal to 0: got %f", -0.1),
+			mintAllocationProposer: math.LegacyMustNewDecFromStr("-0.1"),
+			expectedErr:            tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to proposer must be greater than or equal to 0: got %s", math.LegacyMustNewDecFromStr("-0.1")),
 		},
 		{
 			desc:                   "valid MintAllocationProposer",
@@ -89,8 +103,8 @@ func TestParams_ValidateMintAllocationSupplier(t *testing.T) {
 		},
 		{
 			desc:                   "invalid MintAllocationSupplier (<0)",
-			mintAllocationSupplier: -0.1,
-			expectedErr:            tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to supplier must be greater than or equal to 0: got %f", -0.1),
+			mintAllocationSupplier: math.LegacyMustNewDecFromStr("-0.1"),
+			expectedErr:            tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to supplier must be greater than or equal to 0: got %s", math.LegacyMustNewDecFromStr("-0.1")),
 		},
 		{
 			desc:                   "valid MintAllocationSupplier",
@@ -123,8 +137,8 @@ func TestParams_ValidateMintAllocationSourceOwner(t *testing.T) {
 		},
 		{
 			desc:                      "invalid MintAllocationSourceOwner (<0)",
-			mintAllocationSourceOwner: -0.1,
-			expectedErr:               tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to source owner must be greater than or equal to 0: got %f", -0.1),
+			mintAllocationSourceOwner: math.LegacyMustNewDecFromStr("-0.1"),
+			expectedErr:               tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to source owner must be greater than or equal to 0: got %s", math.LegacyMustNewDecFromStr("-0.1")),
 		},
 		{
 			desc:                      "valid MintAllocationSourceOwner",
@@ -157,8 +171,8 @@ func TestParams_ValidateMintApplication(t *testing.T) {
 		},
 		{
 			desc:                      "invalid MintAllocationApplication (<0)",
-			mintAllocationApplication: -0.1,
-			expectedErr:               tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to application must be greater than or equal to 0: got %f", -0.1),
+			mintAllocationApplication: math.LegacyMustNewDecFromStr("-0.1"),
+			expectedErr:               tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint allocation to application must be greater than or equal to 0: got %s", math.LegacyMustNewDecFromStr("-0.1")),
 		},
 		{
 			desc:                      "valid MintAllocationApplication",
@@ -225,11 +239,20 @@ func TestParams_ValidateGlobalInflationPerClaim(t *testing.T) {
 		},
 		{
 			desc:                    "less than zero",
-			globalInflationPerClaim: float64(-0.1),
+			globalInflationPerClaim: math.LegacyMustNewDecFromStr("-0.1"),
 			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf(
-				"GlobalInflationPerClaim must be greater than or equal to 0: %f", float64(-0.1),
+				"GlobalInflationPerClaim must be greater than or equal to 0: %s", math.LegacyMustNewDecFromStr("-0.1"),
 			),
 		},
+		{
+			desc:                    "unset",
+			globalInflationPerClaim: math.LegacyDec{},
+			expectedErr:             tokenomicstypes.ErrTokenomicsParamInvalid.Wrap("GlobalInflationPerClaim must be set"),
+		},
+		{
+			desc:                    "valid zero GlobalInflationPerClaim",
+			globalInflationPerClaim: math.LegacyZeroDec(),
+		},
 		{
 			desc:                    "valid GlobalInflationPerClaim",
 			globalInflationPerClaim: tokenomicstypes.DefaultGlobalInflationPerClaim,
@@ -265,24 +288,34 @@ func TestParams_ValidateMintRatio(t *testing.T) {
 			mintRatio:   1,
 			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrap("invalid parameter type: int"),
 		},
+		{
+			desc:        "invalid type - float64",
+			mintRatio:   0.975,
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrap("invalid parameter type: float64"),
+		},
+		{
+			desc:        "unset",
+			mintRatio:   math.LegacyDec{},
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrap("mint_ratio must be set"),
+		},
 		{
 			desc:        "negative value",
-			mintRatio:   float64(-0.5),
-			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint_ratio must be in range (0, 1]: got %f", float64(-0.5)),
+			mintRatio:   math.LegacyMustNewDecFromStr("-0.5"),
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint_ratio must be in range (0, 1]: got %s", math.LegacyMustNewDecFromStr("-0.5")),
 		},
 		{
 			desc:        "greater than 1",
-			mintRatio:   float64(1.1),
-			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint_ratio must be in range (0, 1]: got %f", float64(1.1)),
+			mintRatio:   math.LegacyMustNewDecFromStr("1.1"),
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint_ratio must be in range (0, 1]: got %s", math.LegacyMustNewDecFromStr("1.1")),
 		},
 		{
 			desc:        "zero (rejected)",
-			mintRatio:   float64(0),
-			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint_ratio must be in range (0, 1]: got %f", float64(0)),
+			mintRatio:   math.LegacyMustNewDecFromStr("0"),
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrapf("mint_ratio must be in range (0, 1]: got %s", math.LegacyMustNewDecFromStr("0")),
 		},
 		{
 			desc:        "valid 0.975 (PIP-41 target)",
-			mintRatio:   float64(0.975),
+			mintRatio:   math.LegacyMustNewDecFromStr("0.975"),
 			expectedErr: nil,
 		},
 		{
@@ -292,12 +325,12 @@ func TestParams_ValidateMintRatio(t *testing.T) {
 		},
 		{
 			desc:        "valid edge case - very small",
-			mintRatio:   float64(0.001),
+			mintRatio:   math.LegacyMustNewDecFromStr("0.001"),
 			expectedErr: nil,
 		},
 		{
 			desc:        "valid edge case - exactly 1",
-			mintRatio:   float64(1.0),
+			mintRatio:   math.LegacyMustNewDecFromStr("1.0"),
 			expectedErr: nil,
 		},
 	}
@@ -313,3 +346,205 @@ func TestParams_ValidateMintRatio(t *testing.T) {
 		})
 	}
 }
+
+// legacyFloat64Params are the values of the tokenomics params which used to be
+// float64, as set on a network before their migration to decimals.
+type legacyFloat64Params struct {
+	mintAllocationPercentages       [5]float64
+	daoRewardAddress                string
+	globalInflationPerClaim         float64
+	mintEqualsBurnClaimDistribution [5]float64
+	mintRatio                       float64
+}
+
+func TestMigrateLegacyFloat64Params(t *testing.T) {
+	// The params of every network, as last set by tools/scripts/params/bulk_params_<network>/tokenomics_params.json
+	// before their migration to decimals.
+	betaAndMainParams := legacyFloat64Params{
+		mintAllocationPercentages:       [5]float64{0.1, 0, 0.8, 0.1, 0},
+		daoRewardAddress:                "pokt10d07y265gmmuvt4z0w9aw880jnsr700j8yv32t",
+		globalInflationPerClaim:         0.000001,
+		mintEqualsBurnClaimDistribution: [5]float64{0.045, 0.14, 0.79, 0.025, 0},
+		mintRatio:                       0.975,
+	}
+	mainParams := betaAndMainParams
+	mainParams.daoRewardAddress = "pokt1dr5jtqaaz4wk8wevl33e7vkxsjlphljnjhyq2l"
+
+	betaAndMainExpectedParams := tokenomicstypes.Params{
+		MintAllocationPercentages: tokenomicstypes.MintAllocationPercentages{
+			Dao:         math.LegacyMustNewDecFromStr("0.1"),
+			Proposer:    math.LegacyZeroDec(),
+			Supplier:    math.LegacyMustNewDecFromStr("0.8"),
+			SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+			Application: math.LegacyZeroDec(),
+		},
+		DaoRewardAddress:        betaAndMainParams.daoRewardAddress,
+		GlobalInflationPerClaim: math.LegacyMustNewDecFromStr("0.000001"),
+		MintEqualsBurnClaimDistribution: tokenomicstypes.MintEqualsBurnClaimDistribution{
+			Dao:         math.LegacyMustNewDecFromStr("0.045"),
+			Proposer:    math.LegacyMustNewDecFromStr("0.14"),
+			Supplier:    math.LegacyMustNewDecFromStr("0.79"),
+			SourceOwner: math.LegacyMustNewDecFromStr("0.025"),
+			Application: math.LegacyZeroDec(),
+		},
+		MintRatio:                        math.LegacyMustNewDecFromStr("0.975"),
+		OverservicingBonusMultiplier:     1,
+		SettlementHistoryRetentionBlocks: 10000,
+	}
+	mainExpectedParams := betaAndMainExpectedParams
+	mainExpectedParams.DaoRewardAddress = mainParams.daoRewardAddress
+
+	tests := []struct {
+		desc           string
+		legacyParams   legacyFloat64Params
+		expectedParams *tokenomicstypes.Params
+		expectedErr    error
+	}{
+		{
+			desc: "alpha",
+			legacyParams: legacyFloat64Params{
+				mintAllocationPercentages:       [5]float64{0.1, 0.05, 0.7, 0.15, 0},
+				daoRewardAddress:                "pokt1r6ja6rz6rpae58njfrsgs5n5sp3r36r2q9j04h",
+				globalInflationPerClaim:         0.1,
+				mintEqualsBurnClaimDistribution: [5]float64{0.1, 0.05, 0.7, 0.15, 0},
+				mintRatio:                       1.0,
+			},
+			expectedParams: &tokenomicstypes.Params{
+				MintAllocationPercentages: tokenomicstypes.MintAllocationPercentages{
+					Dao:         math.LegacyMustNewDecFromStr("0.1"),
+					Proposer:    math.LegacyMustNewDecFromStr("0.05"),
+					Supplier:    math.LegacyMustNewDecFromStr("0.7"),
+					SourceOwner: math.LegacyMustNewDecFromStr("0.15"),
+					Application: math.LegacyZeroDec(),
+				},
+				DaoRewardAddress:        "pokt1r6ja6rz6rpae58njfrsgs5n5sp3r36r2q9j04h",
+				GlobalInflationPerClaim: math.LegacyMustNewDecFromStr("0.1"),
+				MintEqualsBurnClaimDistribution: tokenomicstypes.MintEqualsBurnClaimDistribution{
+					Dao:         math.LegacyMustNewDecFromStr("0.1"),
+					Proposer:    math.LegacyMustNewDecFromStr("0.05"),
+					Supplier:    math.LegacyMustNewDecFromStr("0.7"),
+					SourceOwner: math.LegacyMustNewDecFromStr("0.15"),
+					Application: math.LegacyZeroDec(),
+				},
+				MintRatio:                        math.LegacyOneDec(),
+				OverservicingBonusMultiplier:     1,
+				SettlementHistoryRetentionBlocks: 10000,
+			},
+		},
+		{
+			desc:           "beta",
+			legacyParams:   betaAndMainParams,
+			expectedParams: &betaAndMainExpectedParams,
+		},
+		{
+			desc:           "main",
+			legacyParams:   mainParams,
+			expectedParams: &mainExpectedParams,
+		},
+		{
+			desc: "percentages summing to 1 only once rounded to basis points are scaled",
+			legacyParams: legacyFloat64Params{
+				mintAllocationPercentages:       [5]float64{0.1, 0, 0.80004, 0.1, 0},
+				daoRewardAddress:                tokenomicstypes.DefaultDaoRewardAddress,
+				globalInflationPerClaim:         0.000001,
+				mintEqualsBurnClaimDistribution: [5]float64{0.045, 0.14, 0.79, 0.02497, 0},
+				mintRatio:                       0.975,
+			},
+		},
+		{
+			desc: "negative percentage",
+			legacyParams: legacyFloat64Params{
+				mintAllocationPercentages:       [5]float64{-0.1, 0, 1, 0.1, 0},
+				daoRewardAddress:                tokenomicstypes.DefaultDaoRewardAddress,
+				globalInflationPerClaim:         0.000001,
+				mintEqualsBurnClaimDistribution: [5]float64{0.045, 0.14, 0.79, 0.025, 0},
+				mintRatio:                       0.975,
+			},
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			k, ctx := newTokenomicsKeeperWithParamsBz(t, test.legacyParams.marshal())
+
+			params, err := k.MigrateLegacyFloat64Params(ctx)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, params.ValidateBasic())
+			require.True(t, params.Equal(k.GetParams(ctx)))
+
+			if test.expectedParams != nil {
+				require.Truef(t, test.expectedParams.Equal(params), "expected %s, got %s", test.expectedParams, &params)
+				return
+			}
+
+			// The DAO receives the remainder of the scaled percentages.
+			require.True(t, params.MintAllocationPercentages.Sum().Equal(math.LegacyOneDec()))
+			require.Equal(t, "0.800007999680012799", params.MintAllocationPercentages.Supplier.String())
+			require.Equal(t, "0.099996000159993601", params.MintAllocationPercentages.Dao.String())
+			require.True(t, params.MintEqualsBurnClaimDistribution.Sum().Equal(math.LegacyOneDec()))
+			require.Equal(t, "0.790023700711021330", params.MintEqualsBurnClaimDistribution.Supplier.String())
+			require.Equal(t, "0.045001350040501216", params.MintEqualsBurnClaimDistribution.Dao.String())
+		})
+	}
+}
+
+// newTokenomicsKeeperWithParamsBz returns a tokenomics keeper whose store holds
+// the given raw params bytes.
+func newTokenomicsKeeperWithParamsBz(t *testing.T, paramsBz []byte) (tokenomicskeeper.Keeper, context.Context) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(tokenomicstypes.StoreKey)
+	ctx := sdktestutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	ctx.KVStore(storeKey).Set(tokenomicstypes.ParamsKey, paramsBz)
+
+	k := tokenomicskeeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		tlm.NewDefaultTokenLogicModules(),
+	)
+
+	return k, ctx
+}
+
+// marshal returns the params encoded as they were before their migration to decimals.
+// A 0 float64 is omitted, like a proto3 double.
+func (lp legacyFloat64Params) marshal() []byte {
+	appendDouble := func(bz []byte, fieldNum protowire.Number, value float64) []byte {
+		if value == 0 {
+			return bz
+		}
+		bz = protowire.AppendTag(bz, fieldNum, protowire.Fixed64Type)
+		return protowire.AppendFixed64(bz, gomath.Float64bits(value))
+	}
+	appendDistribution := func(bz []byte, fieldNum protowire.Number, percentages [5]float64) []byte {
+		var distributionBz []byte
+		for i, percentage := range percentages {
+			distributionBz = appendDouble(distributionBz, protowire.Number(i+1), percentage)
+		}
+		bz = protowire.AppendTag(bz, fieldNum, protowire.BytesType)
+		return protowire.AppendBytes(bz, distributionBz)
+	}
+
+	paramsBz := appendDistribution(nil, 1, lp.mintAllocationPercentages)
+	paramsBz = protowire.AppendTag(paramsBz, 6, protowire.BytesType)
+	paramsBz = protowire.AppendString(paramsBz, lp.daoRewardAddress)
+	paramsBz = appendDouble(paramsBz, 7, lp.globalInflationPerClaim)
+	paramsBz = appendDistribution(paramsBz, 8, lp.mintEqualsBurnClaimDistribution)
+	paramsBz = appendDouble(paramsBz, 9, lp.mintRatio)
+	// overservicing_bonus_multiplier
+	paramsBz = protowire.AppendTag(paramsBz, 10, protowire.VarintType)
+	paramsBz = protowire.AppendVarint(paramsBz, 1)
+	// settlement_history_retention_blocks
+	paramsBz = protowire.AppendTag(paramsBz, 11, protowire.VarintType)
+	paramsBz = protowire.AppendVarint(paramsBz, 10000)
+
+	return paramsBz
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+func (k Keeper) AllSettlementResults(
+	ctx context.Context,
+	req *types.QueryAllSettlementResultsRequest,
+) (*types.QueryAllSettlementResultsResponse, error) {
+	logger := k.Logger().With("method", "AllSettlementResults")
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// isCustomIndex is used to determined if we'll be using the store that points
+	// to the actual settlement result values, or a secondary index that points to the primary keys.
+	var (
+		isCustomIndex bool
+		keyPrefix     []byte
+	)
+
+	switch filter := req.Filter.(type) {
+	case *types.QueryAllSettlementResultsRequest_SupplierOperatorAddress:
+		isCustomIndex = true
+		keyPrefix = types.KeyPrefix(types.SettlementResultSupplierOperatorAddressPrefix)
+		keyPrefix = append(keyPrefix, types.SettlementResultIndexKeyPrefix(filter.SupplierOperatorAddress)...)
+
+	case *types.QueryAllSettlementResultsRequest_ApplicationAddress:
+		isCustomIndex = true
+		keyPrefix = types.KeyPrefix(types.SettlementResultApplicationAddressPrefix)
+		keyPrefix = append(keyPrefix, types.SettlementResultIndexKeyPrefix(filter.ApplicationAddress)...)
+
+	case *types.QueryAllSettlementResultsRequest_ServiceId:
+		isCustomIndex = true
+		keyPrefix = types.KeyPrefix(types.SettlementResultServiceIdPrefix)
+		keyPrefix = append(keyPrefix, types.SettlementResultIndexKeyPrefix(filter.ServiceId)...)
+
+	case *types.QueryAllSettlementResultsRequest_SessionEndHeight:
+		// The primary store is keyed by session end height first.
+		isCustomIndex = false
+		keyPrefix = types.KeyPrefix(types.SettlementResultPrimaryKeyPrefix)
+		keyPrefix = append(keyPrefix, types.SettlementResultSessionEndHeightKey(int64(filter.SessionEndHeight))...)
+
+	default:
+		isCustomIndex = false
+		keyPrefix = types.KeyPrefix(types.SettlementResultPrimaryKeyPrefix)
+	}
+
+	settlementResultStore := prefix.NewStore(storeAdapter, keyPrefix)
+
+	var settlementResults []types.ClaimSettlementResult
+	pageRes, err := query.Paginate(settlementResultStore, req.Pagination, func(key []byte, value []byte) error {
+		if isCustomIndex {
+			// If a custom index is used, the value is a primaryKey.
+			// Then we retrieve the settlement result using the given primaryKey.
+			foundSettlementResult, isSettlementResultFound := k.getSettlementResultByPrimaryKey(ctx, value)
+			if isSettlementResultFound {
+				settlementResults = append(settlementResults, foundSettlementResult)
+			}
+		} else {
+			// The value is the encoded settlement result.
+			var settlementResult types.ClaimSettlementResult
+			if err := k.cdc.Unmarshal(value, &settlementResult); err != nil {
+				err = fmt.Errorf("unable to unmarshal settlement result with key (hex): %x: %+v", key, err)
+				logger.Error(err.Error())
+				return status.Error(codes.Internal, err.Error())
+			}
+			settlementResults = append(settlementResults, settlementResult)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSettlementResultsResponse{SettlementResults: settlementResults, Pagination: pageRes}, nil
+}
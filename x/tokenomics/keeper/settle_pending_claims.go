@@ -267,6 +267,9 @@ func (k Keeper) SettlePendingClaims(ctx cosmostypes.Context) (
 		return settledResults, expiredResults, numDiscardedFaultyClaims, err
 	}
 
+	// Record the settled claims results so they can be queried after their claims are removed.
+	k.recordSettlementHistory(ctx, settledResults)
+
 	// Slash all suppliers who failed to submit a required proof.
 	if err = k.ExecutePendingExpiredResults(ctx, settlementContext, expiredResults); err != nil {
 		return settledResults, expiredResults, numDiscardedFaultyClaims, err
@@ -719,7 +722,8 @@ func (k Keeper) candidateSessionEndHeightsForLiveParams(
 
 // slashSupplierStake slashes the stake of a supplier and transfers the total
 // slashing amount from the supplier bank module to the tokenomics module account.
-// TODO_FUTURE: Slash the supplier in proportion to their stake.
+// The slashing amount is determined by the proof module's proof_missing_penalty_mode
+// param, and may scale with the value of the claim and/or the supplier's stake.
 func (k Keeper) slashSupplierStake(
 	ctx cosmostypes.Context,
 	settlementContext *settlementContext,
@@ -728,22 +732,56 @@ func (k Keeper) slashSupplierStake(
 	logger := k.logger.With("method", "slashSupplierStake")
 
 	// Retrieve the supplier to slash.
-	supplierOperatorAddress := claimSettlementResult.GetClaim().SupplierOperatorAddress
-	proofParams := k.proofKeeper.GetParams(ctx)
-	slashingCoin := *proofParams.GetProofMissingPenalty()
+	claim := claimSettlementResult.GetClaim()
+	supplierOperatorAddress := claim.SupplierOperatorAddress
 	supplierToSlash, err := settlementContext.GetSupplier(supplierOperatorAddress)
 	if err != nil {
 		logger.Error("failed to retrieve supplier to slash with operator address %s: %v", supplierOperatorAddress, err)
 		return err
 	}
 
-	// Retrieve the supplier's initial stake.
-	slashedSupplierInitialStakeCoin := supplierToSlash.GetStake()
+	// Price the unproven claim the same way it would have been priced had it been
+	// settled (i.e. under the shared params effective at its session start).
+	sessionHeader := claim.GetSessionHeader()
+
+	// Retrieve the supplier's initial stake backing the claim: its stake plus the
+	// pending stake decreases requested during or after the claim's session, since
+	// the claimed relays were served against the stake prior to these decreases.
+	claimSessionEndHeight := uint64(sessionHeader.GetSessionEndBlockHeight())
+	backingStakeDecreasesCoin := sharedtypes.GetBackingStakeDecreasesAmount(
+		supplierToSlash.PendingStakeDecreases,
+		claimSessionEndHeight,
+	)
+	slashedSupplierInitialStakeCoin := supplierToSlash.GetStake().Add(backingStakeDecreasesCoin)
+	sessionStartHeight := sessionHeader.GetSessionStartBlockHeight()
+	relayMiningDifficulty, err := settlementContext.GetRelayMiningDifficulty(sessionHeader.GetServiceId(), sessionStartHeight)
+	if err != nil {
+		return err
+	}
+	pricingParams := settlementContext.GetSharedParamsAtHeight(ctx, sessionStartHeight)
+	claimeduPOKT, err := claim.GetClaimeduPOKT(pricingParams, relayMiningDifficulty)
+	if err != nil {
+		return err
+	}
+
+	// Compute the penalty according to the current proof_missing_penalty_mode.
+	proofParams := k.proofKeeper.GetParams(ctx)
+	slashingCoin, err := proofParams.ComputeProofMissingPenalty(claimeduPOKT, *slashedSupplierInitialStakeCoin)
+	if err != nil {
+		return err
+	}
 
 	// Determine the supplier's remaining stake after the slashing.
+	// The backing pending stake decreases are slashed first, the remainder is
+	// slashed from the stake.
 	var remainingStakeCoin cosmostypes.Coin
 	if slashedSupplierInitialStakeCoin.IsGTE(slashingCoin) {
-		remainingStakeCoin = slashedSupplierInitialStakeCoin.Sub(slashingCoin)
+		stakeSlashingCoin := sharedtypes.DeductFromBackingStakeDecreases(
+			supplierToSlash.PendingStakeDecreases,
+			claimSessionEndHeight,
+			slashingCoin,
+		)
+		remainingStakeCoin = supplierToSlash.GetStake().Sub(stakeSlashingCoin)
 	} else {
 		// TODO: Emit a custom event for this case and consider custom logic where the
 		// the protocol takes the remaining amount from the supplier's owner or operator balances.
@@ -755,8 +793,14 @@ func (k Keeper) slashSupplierStake(
 		))
 		// Set the remaining stake to 0 if the slashing amount is greater than the stake.
 		remainingStakeCoin = cosmostypes.NewCoin(pocket.DenomuPOKT, math.NewInt(0))
-		// Total slashing amount is the whole supplier's stake.
+		// Total slashing amount is the whole supplier's stake, including the backing
+		// pending stake decreases.
 		slashingCoin = cosmostypes.NewCoin(pocket.DenomuPOKT, slashedSupplierInitialStakeCoin.Amount)
+		sharedtypes.DeductFromBackingStakeDecreases(
+			supplierToSlash.PendingStakeDecreases,
+			claimSessionEndHeight,
+			backingStakeDecreasesCoin,
+		)
 	}
 
 	// Slashing a supplier's stake involves:
@@ -850,7 +894,6 @@ func (k Keeper) slashSupplierStake(
 	k.supplierKeeper.SetDehydratedSupplier(ctx, *supplierToSlash)
 
 	// Emit an event that a supplier has been slashed.
-	claim := claimSettlementResult.GetClaim()
 	events = append(events, &tokenomicstypes.EventSupplierSlashed{
 		ProofMissingPenalty:     slashingCoin.String(),
 		SupplierStakeAfterSlash: remainingStakeCoin.String(),
@@ -859,6 +902,8 @@ func (k Keeper) slashSupplierStake(
 		SessionEndBlockHeight:   claim.SessionHeader.SessionEndBlockHeight,
 		ClaimProofStatusInt:     int32(claim.ProofValidationStatus),
 		SupplierOperatorAddress: claim.SupplierOperatorAddress,
+		ProofMissingPenaltyMode: proofParams.GetProofMissingPenaltyMode(),
+		ClaimedUpokt:            claimeduPOKT.String(),
 	})
 
 	// Emit all events.
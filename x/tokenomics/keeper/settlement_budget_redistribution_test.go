@@ -74,9 +74,9 @@ func newBudgetRedistributionFixture(t testing.TB, floorTarget int64, relayCounts
 	// No global inflation (stake terms == settlement terms) and pay the supplier 100% so the
 	// TLM output equals the cap decided by ensureClaimAmountLimits.
 	tokenomicsParams := keepers.Keeper.GetParams(sdkCtx)
-	tokenomicsParams.GlobalInflationPerClaim = 0
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyZeroDec()
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Supplier: 1,
+		Supplier: cosmosmath.LegacyOneDec(),
 	}
 	require.NoError(t, keepers.Keeper.SetParams(sdkCtx, tokenomicsParams))
 
@@ -117,6 +117,10 @@ type settlementContext struct {
 	applicationMap             map[string]int
 	settledApplications        []*apptypes.Application
 	applicationInitialStakeMap map[string]cosmostypes.Coin
+	// applicationInitialPendingStakeDecreasesMap holds a copy of the pending stake
+	// decreases of each application at the beginning of settlement. They keep backing
+	// the claims of the sessions which ended before they were requested.
+	applicationInitialPendingStakeDecreasesMap map[string][]*sharedtypes.PendingStakeDecrease
 
 	// Cache of services and relay mining difficulties encountered during settlement.
 	// Each service is only fetched once from the store and reused for all related claims.
@@ -150,7 +154,7 @@ type settlementContext struct {
 	sharedParamsAtHeightMap map[int64]sharedtypes.Params
 
 	// globalInflationPerClaimRat memoizes tokenomicsParams.GlobalInflationPerClaim as a
-	// big.Rat. The conversion (strconv.FormatFloat + big.Rat.SetString) is identical for
+	// big.Rat. The conversion (encoding.LegacyDecToRat) is identical for
 	// every claim in a block, but was previously redone up to 4x per claim (once in Phase
 	// 1.5, plus once in claimStakeTermsAmount and twice in supplierAppStakeToMaxSettlementAmount
 	// during Phase 2) — tens of thousands of redundant conversions per mainnet settlement
@@ -207,6 +211,10 @@ func NewSettlementContext(
 		applicationMap:             make(map[string]int, estimatedApplications),
 		settledApplications:        make([]*apptypes.Application, 0, estimatedApplications),
 		applicationInitialStakeMap: make(map[string]cosmostypes.Coin, estimatedApplications),
+		applicationInitialPendingStakeDecreasesMap: make(
+			map[string][]*sharedtypes.PendingStakeDecrease,
+			estimatedApplications,
+		),
 
 		serviceMap:               make(map[string]*sharedtypes.Service, estimatedServices),
 		relayMiningDifficultyMap: make(map[string]servicetypes.RelayMiningDifficulty, estimatedServices),
@@ -287,10 +295,18 @@ func (sctx *settlementContext) ClaimCacheWarmUp(ctx context.Context, claim *proo
 }
 
 // GetApplicationInitialStake retrieves the initial stake of an application at the
-// beginning of the settlement process.
-func (sctx *settlementContext) GetApplicationInitialStake(appAddress string) (cosmostypes.Coin, error) {
+// beginning of the settlement process, including the pending stake decreases which
+// back the claims of the session ending at sessionEndHeight.
+func (sctx *settlementContext) GetApplicationInitialStake(
+	appAddress string,
+	sessionEndHeight int64,
+) (cosmostypes.Coin, error) {
 	if stake, ok := sctx.applicationInitialStakeMap[appAddress]; ok {
-		return stake, nil
+		backingStakeDecreasesAmount := sharedtypes.GetBackingStakeDecreasesAmount(
+			sctx.applicationInitialPendingStakeDecreasesMap[appAddress],
+			uint64(sessionEndHeight),
+		)
+		return stake.Add(backingStakeDecreasesAmount), nil
 	}
 
 	sctx.logger.Error(fmt.Sprintf("initial stake for application with address %q not found", appAddress))
@@ -379,7 +395,7 @@ func (sctx *settlementContext) GetSharedParamsAtHeight(
 }
 
 // getGlobalInflationPerClaimRat returns the settlement block's global_inflation_per_claim
-// as a big.Rat, performing the float64->Rat conversion at most once per settlement block.
+// as a big.Rat, performing the LegacyDec->Rat conversion at most once per settlement block.
 //
 // The returned pointer is shared by every caller and MUST NOT be mutated.
 func (sctx *settlementContext) getGlobalInflationPerClaimRat() (*big.Rat, error) {
@@ -387,10 +403,7 @@ func (sctx *settlementContext) getGlobalInflationPerClaimRat() (*big.Rat, error)
 		return sctx.globalInflationPerClaimRat, nil
 	}
 
-	globalInflationPerClaimRat, err := encoding.Float64ToRat(sctx.tokenomicsParams.GlobalInflationPerClaim)
-	if err != nil {
-		return nil, err
-	}
+	globalInflationPerClaimRat := encoding.LegacyDecToRat(sctx.tokenomicsParams.GlobalInflationPerClaim)
 	sctx.globalInflationPerClaimRat = globalInflationPerClaimRat
 
 	return globalInflationPerClaimRat, nil
@@ -497,6 +510,25 @@ func (sctx *settlementContext) cacheSupplier(
 		return tokenomicstypes.ErrTokenomicsSupplierNotFound
 	}
 
+	// Claims of a supplier which was transferred to a new operator address are
+	// settled against the destination supplier, which holds its stake.
+	if supplier.IsTransferred() {
+		dstSupplier, err := sctx.getTransferDestinationSupplier(ctx, supplier)
+		if err != nil {
+			return err
+		}
+
+		// The destination supplier MAY already be cached by one of its own claims.
+		if idx, ok := sctx.supplierMap[dstSupplier.OperatorAddress]; ok {
+			sctx.supplierMap[supplierOperatorAddress] = idx
+			sctx.cacheSupplierServiceConfig(ctx, sctx.settledSuppliers[idx], serviceId)
+			return nil
+		}
+
+		sctx.supplierMap[dstSupplier.OperatorAddress] = len(sctx.settledSuppliers)
+		supplier = dstSupplier
+	}
+
 	// Hydrate the supplier service configuration with the claim's service ID.
 	// This is needed to ensure the dehydrated supplier has the correct service
 	// revenue share configuration for the claim settlement.
@@ -510,6 +542,41 @@ func (sctx *settlementContext) cacheSupplier(
 	return nil
 }
 
+// getTransferDestinationSupplier returns the (dehydrated) supplier which holds the
+// stake of the given transferred supplier, following successive transfers.
+func (sctx *settlementContext) getTransferDestinationSupplier(
+	ctx context.Context,
+	transferredSupplier sharedtypes.Supplier,
+) (sharedtypes.Supplier, error) {
+	supplier := transferredSupplier
+	visitedOperatorAddresses := map[string]struct{}{supplier.OperatorAddress: {}}
+	for supplier.IsTransferred() {
+		dstOperatorAddress := supplier.Transfer.GetDestinationOperatorAddress()
+		if _, isVisited := visitedOperatorAddresses[dstOperatorAddress]; isVisited {
+			return supplier, tokenomicstypes.ErrTokenomicsSupplierNotFound.Wrapf(
+				"should never happen: cyclic transfer of supplier %q to %q",
+				supplier.OperatorAddress, dstOperatorAddress,
+			)
+		}
+		visitedOperatorAddresses[dstOperatorAddress] = struct{}{}
+
+		dstSupplier, isDstFound := sctx.keeper.supplierKeeper.GetDehydratedSupplier(ctx, dstOperatorAddress)
+		if !isDstFound {
+			sctx.logger.Warn(fmt.Sprintf(
+				"destination supplier %q of transferred supplier %q not found",
+				dstOperatorAddress, transferredSupplier.OperatorAddress,
+			))
+			return supplier, tokenomicstypes.ErrTokenomicsSupplierNotFound.Wrapf(
+				"destination supplier %q of transferred supplier %q not found",
+				dstOperatorAddress, transferredSupplier.OperatorAddress,
+			)
+		}
+		supplier = dstSupplier
+	}
+
+	return supplier, nil
+}
+
 // cacheSupplierServiceConfig ensures the supplier service configuration for a claim
 // is cached in the settlement context.
 func (sctx *settlementContext) cacheSupplierServiceConfig(
@@ -563,6 +630,15 @@ func (sctx *settlementContext) cacheApplication(ctx context.Context, appAddress
 	//   during the settlement process.
 	sctx.applicationInitialStakeMap[appAddress] = *application.Stake
 
+	// Copy the pending stake decreases since their amounts are deducted in place
+	// when claims backed by them are settled.
+	initialPendingStakeDecreases := make([]*sharedtypes.PendingStakeDecrease, 0, len(application.PendingStakeDecreases))
+	for _, pendingStakeDecrease := range application.PendingStakeDecreases {
+		initialPendingStakeDecrease := *pendingStakeDecrease
+		initialPendingStakeDecreases = append(initialPendingStakeDecreases, &initialPendingStakeDecrease)
+	}
+	sctx.applicationInitialPendingStakeDecreasesMap[appAddress] = initialPendingStakeDecreases
+
 	return nil
 }
 
@@ -699,7 +775,7 @@ func (sctx *settlementContext) getOrInitSessionBudget(
 		return sb, nil
 	}
 
-	appStake, err := sctx.GetApplicationInitialStake(appAddress)
+	appStake, err := sctx.GetApplicationInitialStake(appAddress, sessionEndBlockHeight)
 	if err != nil {
 		return nil, err
 	}
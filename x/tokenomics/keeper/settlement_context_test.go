@@ -444,6 +444,342 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ProofRequired_InvalidOn
 	require.Equal(t, belowStakeAmountProofMissingPenalty.String(), expectedSlashingEvent.GetProofMissingPenalty())
 }
 
+func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ClaimProportionalPenalty_SmallClaimIsFloored() {
+	t := s.T()
+
+	// A small claim (i.e. the default 210 uPOKT) whose proportional penalty
+	// (105 uPOKT) is below proof_missing_penalty.
+	proofMissingPenaltyFloor := uPOKTCoin(10_000)
+	proofMissingPenaltyMax := uPOKTCoin(500_000)
+	proofParams := s.keepers.ProofKeeper.GetParams(s.ctx)
+	proofParams.ProofMissingPenalty = &proofMissingPenaltyFloor
+	proofParams.ProofMissingPenaltyMode = prooftypes.ProofMissingPenaltyModeClaimProportional
+	proofParams.ProofMissingPenaltyClaimRatio = math.LegacyMustNewDecFromStr("0.5")
+	proofParams.ProofMissingPenaltyMax = &proofMissingPenaltyMax
+
+	claimeduPOKT, slashingEvent := s.settleClaimWithMissingProof(1, proofParams)
+	require.True(t, claimeduPOKT.Amount.QuoRaw(2).LT(proofMissingPenaltyFloor.Amount))
+
+	// The supplier is slashed by the penalty floor.
+	s.requireSupplierSlashed(proofMissingPenaltyFloor, claimeduPOKT, slashingEvent)
+	require.Equal(t, prooftypes.ProofMissingPenaltyModeClaimProportional, slashingEvent.GetProofMissingPenaltyMode())
+}
+
+func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ClaimProportionalPenalty_LargeClaimIsScaled() {
+	t := s.T()
+
+	// A large claim (i.e. 1000x the default 210 uPOKT) whose proportional penalty
+	// (105,000 uPOKT) is between proof_missing_penalty and proof_missing_penalty_max.
+	proofMissingPenaltyFloor := uPOKTCoin(10_000)
+	proofMissingPenaltyMax := uPOKTCoin(500_000)
+	proofParams := s.keepers.ProofKeeper.GetParams(s.ctx)
+	proofParams.ProofMissingPenalty = &proofMissingPenaltyFloor
+	proofParams.ProofMissingPenaltyMode = prooftypes.ProofMissingPenaltyModeClaimProportional
+	proofParams.ProofMissingPenaltyClaimRatio = math.LegacyMustNewDecFromStr("0.5")
+	proofParams.ProofMissingPenaltyMax = &proofMissingPenaltyMax
+
+	claimeduPOKT, slashingEvent := s.settleClaimWithMissingProof(1000, proofParams)
+	expectedSlashingCoin := cosmostypes.NewCoin(pocket.DenomuPOKT, claimeduPOKT.Amount.QuoRaw(2))
+	require.True(t, expectedSlashingCoin.Amount.GT(proofMissingPenaltyFloor.Amount))
+	require.True(t, expectedSlashingCoin.Amount.LT(proofMissingPenaltyMax.Amount))
+
+	// The supplier is slashed in proportion to the claim.
+	s.requireSupplierSlashed(expectedSlashingCoin, claimeduPOKT, slashingEvent)
+}
+
+func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ClaimProportionalPenalty_LargeClaimIsCapped() {
+	t := s.T()
+
+	// A very large claim (i.e. 10,000x the default 210 uPOKT) whose proportional
+	// penalty (1,050,000 uPOKT) is above proof_missing_penalty_max.
+	proofMissingPenaltyFloor := uPOKTCoin(10_000)
+	proofMissingPenaltyMax := uPOKTCoin(500_000)
+	proofParams := s.keepers.ProofKeeper.GetParams(s.ctx)
+	proofParams.ProofMissingPenalty = &proofMissingPenaltyFloor
+	proofParams.ProofMissingPenaltyMode = prooftypes.ProofMissingPenaltyModeClaimProportional
+	proofParams.ProofMissingPenaltyClaimRatio = math.LegacyMustNewDecFromStr("0.5")
+	proofParams.ProofMissingPenaltyMax = &proofMissingPenaltyMax
+
+	claimeduPOKT, slashingEvent := s.settleClaimWithMissingProof(10_000, proofParams)
+	require.True(t, claimeduPOKT.Amount.QuoRaw(2).GT(proofMissingPenaltyMax.Amount))
+
+	// The supplier is slashed by the penalty cap.
+	s.requireSupplierSlashed(proofMissingPenaltyMax, claimeduPOKT, slashingEvent)
+}
+
+func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_StakeProportionalPenalty() {
+	proofMissingPenaltyFloor := uPOKTCoin(10_000)
+	proofParams := s.keepers.ProofKeeper.GetParams(s.ctx)
+	proofParams.ProofMissingPenalty = &proofMissingPenaltyFloor
+	proofParams.ProofMissingPenaltyMode = prooftypes.ProofMissingPenaltyModeStakeProportional
+	proofParams.ProofMissingPenaltyStakeRatio = math.LegacyMustNewDecFromStr("0.1")
+
+	claimeduPOKT, slashingEvent := s.settleClaimWithMissingProof(1, proofParams)
+
+	// The supplier is slashed by 10% of its stake, regardless of the claim.
+	expectedSlashingCoin := uPOKTCoin(supplierStakeAmt / 10)
+	s.requireSupplierSlashed(expectedSlashingCoin, claimeduPOKT, slashingEvent)
+	require.Equal(s.T(), prooftypes.ProofMissingPenaltyModeStakeProportional, slashingEvent.GetProofMissingPenaltyMode())
+}
+
+// settleClaimWithMissingProof settles s.claims[0], which requires a proof that is
+// never submitted, with the given proof params and the compute units to tokens
+// multiplier scaled by cuttmScale (i.e. to make the claim larger).
+// It returns the claimed uPOKT of the expired claim and the emitted slashing event.
+func (s *TestSuite) settleClaimWithMissingProof(
+	cuttmScale uint64,
+	proofParams prooftypes.Params,
+) (cosmostypes.Coin, *tokenomicstypes.EventSupplierSlashed) {
+	t := s.T()
+	ctx := s.ctx
+	claim := s.claims[0]
+	relayMiningDifficulty := s.relayMiningDifficulties[0]
+
+	// Scale the value of the claim by scaling CUTTM. Only the multiplier changes,
+	// so the session grid (and therefore the settlement height) is unaffected.
+	sharedParams := s.keepers.SharedKeeper.GetParams(ctx)
+	sharedParams.ComputeUnitsToTokensMultiplier *= cuttmScale
+	require.NoError(t, s.keepers.SharedKeeper.SetParams(ctx, sharedParams))
+
+	claimeduPOKT, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	require.NoError(t, err)
+
+	// Set the proof parameters such that the claim requires a proof because:
+	// - proof_request_probability is 0%
+	// - proof_requirement_threshold is below the claim (i.e. claim is above threshold)
+	proofRequirementThreshold := claimeduPOKT.Sub(uPOKTCoin(1))
+	proofParams.ProofRequestProbability = 0
+	proofParams.ProofRequirementThreshold = &proofRequirementThreshold
+	require.NoError(t, proofParams.ValidateBasic())
+	require.NoError(t, s.keepers.ProofKeeper.SetParams(ctx, proofParams))
+
+	// Upsert the claim ONLY
+	s.keepers.UpsertClaim(ctx, claim)
+
+	// Settle pending claims after proof window closes
+	sessionEndHeight := claim.SessionHeader.SessionEndBlockHeight
+	blockHeight := sharedtypes.GetProofWindowCloseHeight(&sharedParams, sessionEndHeight)
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(blockHeight)
+	settledResults, expiredResults, numDiscardedFaultyClaims, err := s.keepers.SettlePendingClaims(sdkCtx)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(0), settledResults.GetNumClaims())
+	require.Equal(t, uint64(1), expiredResults.GetNumClaims())
+	require.Equal(t, uint64(0), numDiscardedFaultyClaims)
+
+	slashingEvents := testutilevents.FilterEvents[*tokenomicstypes.EventSupplierSlashed](t, sdkCtx.EventManager().Events())
+	require.Equal(t, 1, len(slashingEvents))
+
+	return claimeduPOKT, slashingEvents[0]
+}
+
+// requireSupplierSlashed asserts that the supplier of s.claims[0] was slashed by
+// expectedSlashingCoin, and that slashingEvent reports it.
+func (s *TestSuite) requireSupplierSlashed(
+	expectedSlashingCoin cosmostypes.Coin,
+	claimeduPOKT cosmostypes.Coin,
+	slashingEvent *tokenomicstypes.EventSupplierSlashed,
+) {
+	t := s.T()
+	claim := s.claims[0]
+	expectedRemainingStakeCoin := uPOKTCoin(supplierStakeAmt).Sub(expectedSlashingCoin)
+
+	slashedSupplier, supplierFound := s.keepers.GetSupplier(s.ctx, claim.SupplierOperatorAddress)
+	require.True(t, supplierFound)
+	require.Equal(t, expectedRemainingStakeCoin.Amount, slashedSupplier.Stake.Amount)
+	require.Equal(t, uint64(0), slashedSupplier.UnstakeSessionEndHeight)
+
+	supplierModuleBalRes, err := s.keepers.Balance(s.ctx, &banktypes.QueryBalanceRequest{
+		Address: authtypes.NewModuleAddress(suppliertypes.ModuleName).String(),
+		Denom:   pocket.DenomuPOKT,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedRemainingStakeCoin.Amount, supplierModuleBalRes.Balance.Amount)
+
+	require.Equal(t, claim.SupplierOperatorAddress, slashingEvent.GetSupplierOperatorAddress())
+	require.Equal(t, expectedSlashingCoin.String(), slashingEvent.GetProofMissingPenalty())
+	require.Equal(t, expectedRemainingStakeCoin.String(), slashingEvent.GetSupplierStakeAfterSlash())
+	require.Equal(t, claimeduPOKT.String(), slashingEvent.GetClaimedUpokt())
+}
+
+func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_PenaltySlashedFromPendingStakeDecrease() {
+	// The supplier lowers its stake by 20% after the claim's session ended but
+	// before the claim settles: the decrease still backs the claim.
+	stakeDecreaseCoin := uPOKTCoin(supplierStakeAmt / 5)
+	stakeDecreaseSessionEndHeight := s.queueSupplierStakeDecrease(stakeDecreaseCoin)
+
+	proofMissingPenaltyFloor := uPOKTCoin(10_000)
+	proofParams := s.keepers.ProofKeeper.GetParams(s.ctx)
+	proofParams.ProofMissingPenalty = &proofMissingPenaltyFloor
+	proofParams.ProofMissingPenaltyMode = prooftypes.ProofMissingPenaltyModeStakeProportional
+	proofParams.ProofMissingPenaltyStakeRatio = math.LegacyMustNewDecFromStr("0.1")
+
+	_, slashingEvent := s.settleClaimWithMissingProof(1, proofParams)
+
+	// The penalty is 10% of the initial stake, including the backing decrease,
+	// and is fully slashed from the decrease: the stake is left untouched.
+	expectedSlashingCoin := uPOKTCoin(supplierStakeAmt / 10)
+	expectedStakeCoin := uPOKTCoin(supplierStakeAmt).Sub(stakeDecreaseCoin)
+	expectedStakeDecreaseCoin := stakeDecreaseCoin.Sub(expectedSlashingCoin)
+	s.requireSupplierSlashedWithStakeDecrease(
+		expectedSlashingCoin,
+		expectedStakeCoin,
+		expectedStakeDecreaseCoin,
+		stakeDecreaseSessionEndHeight,
+		slashingEvent,
+	)
+}
+
+func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_PenaltyExceedsPendingStakeDecrease() {
+	// The supplier lowers its stake by 5% after the claim's session ended but
+	// before the claim settles: the decrease still backs the claim.
+	stakeDecreaseCoin := uPOKTCoin(supplierStakeAmt / 20)
+	stakeDecreaseSessionEndHeight := s.queueSupplierStakeDecrease(stakeDecreaseCoin)
+
+	proofMissingPenaltyFloor := uPOKTCoin(10_000)
+	proofParams := s.keepers.ProofKeeper.GetParams(s.ctx)
+	proofParams.ProofMissingPenalty = &proofMissingPenaltyFloor
+	proofParams.ProofMissingPenaltyMode = prooftypes.ProofMissingPenaltyModeStakeProportional
+	proofParams.ProofMissingPenaltyStakeRatio = math.LegacyMustNewDecFromStr("0.1")
+
+	_, slashingEvent := s.settleClaimWithMissingProof(1, proofParams)
+
+	// The decrease is fully consumed by the penalty, the remainder of which is
+	// slashed from the stake.
+	expectedSlashingCoin := uPOKTCoin(supplierStakeAmt / 10)
+	expectedStakeCoin := uPOKTCoin(supplierStakeAmt).Sub(expectedSlashingCoin)
+	s.requireSupplierSlashedWithStakeDecrease(
+		expectedSlashingCoin,
+		expectedStakeCoin,
+		uPOKTCoin(0),
+		stakeDecreaseSessionEndHeight,
+		slashingEvent,
+	)
+}
+
+// queueSupplierStakeDecrease lowers the stake of the supplier of s.claims[0] by
+// the given amount as if it was requested after the claim's session ended, and
+// returns the session end height of the resulting pending stake decrease.
+func (s *TestSuite) queueSupplierStakeDecrease(stakeDecreaseCoin cosmostypes.Coin) uint64 {
+	t := s.T()
+	claim := s.claims[0]
+
+	sharedParams := s.keepers.SharedKeeper.GetParams(s.ctx)
+	claimSessionEndHeight := claim.SessionHeader.SessionEndBlockHeight
+	stakeDecreaseSessionEndHeight := uint64(sharedtypes.GetSessionEndHeight(&sharedParams, claimSessionEndHeight+1))
+
+	supplier, supplierFound := s.keepers.GetSupplier(s.ctx, claim.SupplierOperatorAddress)
+	require.True(t, supplierFound)
+
+	newStake := supplier.Stake.Sub(stakeDecreaseCoin)
+	supplier.Stake = &newStake
+	supplier.PendingStakeDecreases = sharedtypes.AddPendingStakeDecrease(
+		supplier.PendingStakeDecreases,
+		stakeDecreaseCoin,
+		stakeDecreaseSessionEndHeight,
+	)
+	s.keepers.SetAndIndexDehydratedSupplier(s.ctx, supplier)
+
+	return stakeDecreaseSessionEndHeight
+}
+
+// requireSupplierSlashedWithStakeDecrease asserts that the supplier of s.claims[0],
+// which has a single pending stake decrease, was slashed by expectedSlashingCoin
+// and is left with the given stake and pending stake decrease.
+func (s *TestSuite) requireSupplierSlashedWithStakeDecrease(
+	expectedSlashingCoin cosmostypes.Coin,
+	expectedStakeCoin cosmostypes.Coin,
+	expectedStakeDecreaseCoin cosmostypes.Coin,
+	stakeDecreaseSessionEndHeight uint64,
+	slashingEvent *tokenomicstypes.EventSupplierSlashed,
+) {
+	t := s.T()
+	claim := s.claims[0]
+
+	slashedSupplier, supplierFound := s.keepers.GetSupplier(s.ctx, claim.SupplierOperatorAddress)
+	require.True(t, supplierFound)
+	require.Equal(t, expectedStakeCoin.Amount, slashedSupplier.Stake.Amount)
+	require.Equal(t, uint64(0), slashedSupplier.UnstakeSessionEndHeight)
+
+	// Fully consumed decreases are kept until they complete unbonding.
+	require.Len(t, slashedSupplier.PendingStakeDecreases, 1)
+	require.Equal(t, expectedStakeDecreaseCoin.Amount, slashedSupplier.PendingStakeDecreases[0].Amount.Amount)
+	require.Equal(t, stakeDecreaseSessionEndHeight, slashedSupplier.PendingStakeDecreases[0].SessionEndHeight)
+
+	// The supplier module escrows both the stake and the pending stake decrease.
+	supplierModuleBalRes, err := s.keepers.Balance(s.ctx, &banktypes.QueryBalanceRequest{
+		Address: authtypes.NewModuleAddress(suppliertypes.ModuleName).String(),
+		Denom:   pocket.DenomuPOKT,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uPOKTCoin(supplierStakeAmt).Sub(expectedSlashingCoin).Amount, supplierModuleBalRes.Balance.Amount)
+
+	require.Equal(t, expectedSlashingCoin.String(), slashingEvent.GetProofMissingPenalty())
+	require.Equal(t, expectedStakeCoin.String(), slashingEvent.GetSupplierStakeAfterSlash())
+}
+
+func (s *TestSuite) TestSettlePendingClaims_Settles_AgainstApplicationPendingStakeDecrease() {
+	t := s.T()
+	ctx := s.ctx
+	sharedParams := s.keepers.SharedKeeper.GetParams(ctx)
+	claim := s.claims[0]
+	relayMiningDifficulty := s.relayMiningDifficulties[0]
+
+	// Set the proof parameters such that the claim DOES NOT require a proof.
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	require.NoError(t, err)
+	proofRequirementThreshold = proofRequirementThreshold.Add(uPOKTCoin(1))
+	proofParams := s.keepers.ProofKeeper.GetParams(ctx)
+	proofParams.ProofRequestProbability = 0
+	proofParams.ProofRequirementThreshold = &proofRequirementThreshold
+	require.NoError(t, s.keepers.ProofKeeper.SetParams(ctx, proofParams))
+
+	// The application lowers its stake to a tiny amount after the claim's session
+	// ended but before it settles. Settled against its remaining stake alone, the
+	// claim would be overserviced (see TestSettlePendingClaims_Overservicing_SettledUpoktReflectsCap).
+	app, appFound := s.keepers.GetApplication(ctx, claim.SessionHeader.ApplicationAddress)
+	require.True(t, appFound)
+	initialStakeCoin := *app.Stake
+	remainingStakeCoin := uPOKTCoin(100)
+	stakeDecreaseCoin := initialStakeCoin.Sub(remainingStakeCoin)
+	stakeDecreaseSessionEndHeight := uint64(sharedtypes.GetSessionEndHeight(
+		&sharedParams,
+		claim.SessionHeader.SessionEndBlockHeight+1,
+	))
+	app.Stake = &remainingStakeCoin
+	app.PendingStakeDecreases = sharedtypes.AddPendingStakeDecrease(nil, stakeDecreaseCoin, stakeDecreaseSessionEndHeight)
+	s.keepers.SetApplication(ctx, app)
+
+	s.keepers.UpsertClaim(ctx, claim)
+
+	sessionEndHeight := claim.SessionHeader.SessionEndBlockHeight
+	blockHeight := sharedtypes.GetProofWindowCloseHeight(&sharedParams, sessionEndHeight)
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(blockHeight)
+	settledResults, expiredResults, _, err := s.keepers.SettlePendingClaims(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), settledResults.GetNumClaims())
+	require.Equal(t, uint64(0), expiredResults.GetNumClaims())
+
+	// The session budget is computed from the initial stake, including the
+	// backing decrease: the application is not overserviced.
+	events := sdkCtx.EventManager().Events()
+	overservicedEvents := testutilevents.FilterEvents[*tokenomicstypes.EventApplicationOverserviced](t, events)
+	require.Empty(t, overservicedEvents)
+
+	claimSettledEvents := testutilevents.FilterEvents[*tokenomicstypes.EventClaimSettled](t, events)
+	require.Len(t, claimSettledEvents, 1)
+	require.Equal(t, s.claimedUpokt.String(), claimSettledEvents[0].GetClaimedUpokt())
+	require.Equal(t, s.claimedUpokt.String(), claimSettledEvents[0].GetSettledUpokt())
+
+	// The settled amount is deducted from the backing decrease first: the
+	// remaining stake is left untouched.
+	settledApp, appFound := s.keepers.GetApplication(ctx, claim.SessionHeader.ApplicationAddress)
+	require.True(t, appFound)
+	require.Equal(t, remainingStakeCoin.Amount, settledApp.Stake.Amount)
+	require.Len(t, settledApp.PendingStakeDecreases, 1)
+	require.Equal(t, stakeDecreaseSessionEndHeight, settledApp.PendingStakeDecreases[0].SessionEndHeight)
+	require.True(t, settledApp.PendingStakeDecreases[0].Amount.IsLTE(stakeDecreaseCoin.Sub(s.claimedUpokt)))
+}
 func (s *TestSuite) TestClaimSettlement_ClaimSettled_ProofRequiredAndProvided_ViaProbability() {
 	// Retrieve default values
 	t := s.T()
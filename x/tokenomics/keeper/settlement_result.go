@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	tlm "github.com/pokt-network/poktroll/x/tokenomics/token_logic_module"
+	"github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+// SetSettlementResult stores the given settlement result in the settlement history
+// and indexes it by supplier operator address, application address and service ID.
+func (k Keeper) SetSettlementResult(ctx context.Context, settlementResult types.ClaimSettlementResult) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	primaryStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SettlementResultPrimaryKeyPrefix))
+
+	primaryKey := types.SettlementResultPrimaryKey(
+		settlementResult.GetSessionEndHeight(),
+		settlementResult.GetSessionId(),
+		settlementResult.GetSupplierOperatorAddr(),
+	)
+	primaryStore.Set(primaryKey, k.cdc.MustMarshal(&settlementResult))
+
+	// Update the secondary indices: indexedValue -> [SettlementResultPrimaryKey]
+	for _, index := range getSettlementResultIndices(&settlementResult) {
+		indexStore := prefix.NewStore(storeAdapter, types.KeyPrefix(index.keyPrefix))
+		indexStore.Set(types.SettlementResultIndexKey(index.indexedValue, primaryKey), primaryKey)
+	}
+}
+
+// GetSettlementResult returns the settlement result of the claim of the given
+// supplier for the given session, if it is retained in the settlement history.
+func (k Keeper) GetSettlementResult(
+	ctx context.Context,
+	sessionEndHeight int64,
+	sessionId string,
+	supplierOperatorAddr string,
+) (_ types.ClaimSettlementResult, isSettlementResultFound bool) {
+	primaryKey := types.SettlementResultPrimaryKey(sessionEndHeight, sessionId, supplierOperatorAddr)
+	return k.getSettlementResultByPrimaryKey(ctx, primaryKey)
+}
+
+// PruneSettlementHistory removes the settlement results whose session ended more
+// than settlement_history_retention_blocks blocks before the current height.
+// If the settlement history is disabled (i.e. a retention of 0), all the retained
+// settlement results are removed.
+// It returns the number of removed settlement results.
+func (k Keeper) PruneSettlementHistory(ctx context.Context) (numPrunedSettlementResults int) {
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	retentionBlocks := int64(k.GetParams(ctx).SettlementHistoryRetentionBlocks)
+
+	return k.PruneSettlementResults(ctx, sdkCtx.BlockHeight()-retentionBlocks)
+}
+
+// PruneSettlementResults removes, along with their secondary index entries, all
+// the settlement results whose session ended strictly before the given height.
+// It returns the number of removed settlement results.
+func (k Keeper) PruneSettlementResults(ctx context.Context, pruneBeforeHeight int64) (numPrunedSettlementResults int) {
+	logger := k.Logger().With("method", "PruneSettlementResults")
+
+	if pruneBeforeHeight <= 0 {
+		return 0
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	primaryStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SettlementResultPrimaryKeyPrefix))
+
+	// The primary keys are prefixed by the big endian encoded session end height,
+	// so all the settlement results to prune are before the cutoff height key.
+	iterator := primaryStore.Iterator(nil, types.SettlementResultSessionEndHeightKey(pruneBeforeHeight))
+
+	// Collect the results before deleting them to avoid mutating the store while iterating.
+	var prunedPrimaryKeys [][]byte
+	var prunedSettlementResults []types.ClaimSettlementResult
+	for ; iterator.Valid(); iterator.Next() {
+		var settlementResult types.ClaimSettlementResult
+		k.cdc.MustUnmarshal(iterator.Value(), &settlementResult)
+
+		prunedPrimaryKeys = append(prunedPrimaryKeys, slices.Clone(iterator.Key()))
+		prunedSettlementResults = append(prunedSettlementResults, settlementResult)
+	}
+	iterator.Close()
+
+	// Delete all the entries (primary store and secondary indices)
+	for i, primaryKey := range prunedPrimaryKeys {
+		for _, index := range getSettlementResultIndices(&prunedSettlementResults[i]) {
+			indexStore := prefix.NewStore(storeAdapter, types.KeyPrefix(index.keyPrefix))
+			indexStore.Delete(types.SettlementResultIndexKey(index.indexedValue, primaryKey))
+		}
+		primaryStore.Delete(primaryKey)
+	}
+
+	if len(prunedPrimaryKeys) > 0 {
+		logger.Info(fmt.Sprintf(
+			"pruned %d settlement results of sessions ending before height %d",
+			len(prunedPrimaryKeys),
+			pruneBeforeHeight,
+		))
+	}
+
+	return len(prunedPrimaryKeys)
+}
+
+// GetAllSettlementResults returns all the settlement results retained in the settlement history.
+func (k Keeper) GetAllSettlementResults(ctx context.Context) (settlementResults []types.ClaimSettlementResult) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	primaryStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SettlementResultPrimaryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(primaryStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var settlementResult types.ClaimSettlementResult
+		k.cdc.MustUnmarshal(iterator.Value(), &settlementResult)
+		settlementResults = append(settlementResults, settlementResult)
+	}
+
+	return settlementResults
+}
+
+// recordSettlementHistory stores the given settled claims results in the settlement
+// history, unless it is disabled (i.e. settlement_history_retention_blocks is 0).
+//
+// DEV_NOTE: Only the results of settled claims are recorded. Expired claims are not
+// settled and their results only reflect the supplier slashing, which is already
+// observable through the supplier slashing events.
+func (k Keeper) recordSettlementHistory(ctx context.Context, settledResults tlm.ClaimSettlementResults) {
+	if k.GetParams(ctx).SettlementHistoryRetentionBlocks == 0 {
+		return
+	}
+
+	for _, settledResult := range settledResults {
+		// Skip the synthetic results which are not associated with a claim
+		// (e.g. the batched validator rewards result).
+		if settledResult.Claim.GetSessionHeader() == nil {
+			continue
+		}
+
+		k.SetSettlementResult(ctx, *settledResult)
+	}
+}
+
+// getSettlementResultByPrimaryKey is a helper that retrieves, if exists, the
+// ClaimSettlementResult associated with the key provided.
+func (k Keeper) getSettlementResultByPrimaryKey(
+	ctx context.Context,
+	primaryKey []byte,
+) (settlementResult types.ClaimSettlementResult, isSettlementResultFound bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	primaryStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SettlementResultPrimaryKeyPrefix))
+	settlementResultBz := primaryStore.Get(primaryKey)
+
+	if settlementResultBz == nil {
+		return types.ClaimSettlementResult{}, false
+	}
+
+	k.cdc.MustUnmarshal(settlementResultBz, &settlementResult)
+
+	return settlementResult, true
+}
+
+// settlementResultIndex is a secondary index entry of a settlement result.
+type settlementResultIndex struct {
+	keyPrefix    string
+	indexedValue string
+}
+
+// getSettlementResultIndices returns the secondary index entries of the given settlement result.
+func getSettlementResultIndices(settlementResult *types.ClaimSettlementResult) []settlementResultIndex {
+	return []settlementResultIndex{
+		{types.SettlementResultSupplierOperatorAddressPrefix, settlementResult.GetSupplierOperatorAddr()},
+		{types.SettlementResultApplicationAddressPrefix, settlementResult.GetApplicationAddr()},
+		{types.SettlementResultServiceIdPrefix, settlementResult.GetServiceId()},
+	}
+}
@@ -0,0 +1,319 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	tokenomicskeeper "github.com/pokt-network/poktroll/x/tokenomics/keeper"
+	"github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+// settlementResultsFixture holds the settlement results created by createSettlementResults.
+type settlementResultsFixture struct {
+	supplierOperatorAddrs []string
+	appAddrs              []string
+	serviceIds            []string
+	sessionEndHeights     []int64
+	settlementResults     []types.ClaimSettlementResult
+}
+
+func TestSettlementResult_SetAndGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenomicsKeeper(t)
+	fixture := createSettlementResults(t, keeper, ctx)
+
+	for _, settlementResult := range fixture.settlementResults {
+		foundSettlementResult, isSettlementResultFound := keeper.GetSettlementResult(
+			ctx,
+			settlementResult.GetSessionEndHeight(),
+			settlementResult.GetSessionId(),
+			settlementResult.GetSupplierOperatorAddr(),
+		)
+		require.True(t, isSettlementResultFound)
+		require.Equal(t, settlementResult, foundSettlementResult)
+	}
+
+	_, isSettlementResultFound := keeper.GetSettlementResult(ctx, 1, "not a real session id", sample.AccAddressBech32())
+	require.False(t, isSettlementResultFound)
+
+	require.ElementsMatch(t, fixture.settlementResults, keeper.GetAllSettlementResults(ctx))
+}
+
+func TestSettlementResultQuery_Filters(t *testing.T) {
+	keeper, ctx := keepertest.TokenomicsKeeper(t)
+	fixture := createSettlementResults(t, keeper, ctx)
+
+	tests := []struct {
+		desc                      string
+		request                   *types.QueryAllSettlementResultsRequest
+		expectedSettlementResults []types.ClaimSettlementResult
+	}{
+		{
+			desc:                      "no filter",
+			request:                   &types.QueryAllSettlementResultsRequest{},
+			expectedSettlementResults: fixture.settlementResults,
+		},
+		{
+			desc: "supplier operator address",
+			request: &types.QueryAllSettlementResultsRequest{
+				Filter: &types.QueryAllSettlementResultsRequest_SupplierOperatorAddress{
+					SupplierOperatorAddress: fixture.supplierOperatorAddrs[0],
+				},
+			},
+			expectedSettlementResults: filterSettlementResults(fixture, func(r types.ClaimSettlementResult) bool {
+				return r.GetSupplierOperatorAddr() == fixture.supplierOperatorAddrs[0]
+			}),
+		},
+		{
+			desc: "application address",
+			request: &types.QueryAllSettlementResultsRequest{
+				Filter: &types.QueryAllSettlementResultsRequest_ApplicationAddress{
+					ApplicationAddress: fixture.appAddrs[1],
+				},
+			},
+			expectedSettlementResults: filterSettlementResults(fixture, func(r types.ClaimSettlementResult) bool {
+				return r.GetApplicationAddr() == fixture.appAddrs[1]
+			}),
+		},
+		{
+			// "svc1" is a prefix of "svc10", whose settlement results must not be returned.
+			desc: "service id",
+			request: &types.QueryAllSettlementResultsRequest{
+				Filter: &types.QueryAllSettlementResultsRequest_ServiceId{
+					ServiceId: fixture.serviceIds[0],
+				},
+			},
+			expectedSettlementResults: filterSettlementResults(fixture, func(r types.ClaimSettlementResult) bool {
+				return r.GetServiceId() == fixture.serviceIds[0]
+			}),
+		},
+		{
+			desc: "session end height",
+			request: &types.QueryAllSettlementResultsRequest{
+				Filter: &types.QueryAllSettlementResultsRequest_SessionEndHeight{
+					SessionEndHeight: uint64(fixture.sessionEndHeights[1]),
+				},
+			},
+			expectedSettlementResults: filterSettlementResults(fixture, func(r types.ClaimSettlementResult) bool {
+				return r.GetSessionEndHeight() == fixture.sessionEndHeights[1]
+			}),
+		},
+		{
+			desc: "no matching settlement result",
+			request: &types.QueryAllSettlementResultsRequest{
+				Filter: &types.QueryAllSettlementResultsRequest_SessionEndHeight{
+					SessionEndHeight: 1,
+				},
+			},
+			expectedSettlementResults: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			response, err := keeper.AllSettlementResults(ctx, test.request)
+			require.NoError(t, err)
+			require.ElementsMatch(t, test.expectedSettlementResults, response.GetSettlementResults())
+		})
+	}
+}
+
+func TestSettlementResultQuery_Paginated(t *testing.T) {
+	keeper, ctx := keepertest.TokenomicsKeeper(t)
+	fixture := createSettlementResults(t, keeper, ctx)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllSettlementResultsRequest {
+		return &types.QueryAllSettlementResultsRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		var settlementResults []types.ClaimSettlementResult
+		for i := 0; i < len(fixture.settlementResults); i += step {
+			response, err := keeper.AllSettlementResults(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(response.GetSettlementResults()), step)
+			settlementResults = append(settlementResults, response.GetSettlementResults()...)
+		}
+		require.ElementsMatch(t, fixture.settlementResults, settlementResults)
+	})
+
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		var settlementResults []types.ClaimSettlementResult
+		for i := 0; i < len(fixture.settlementResults); i += step {
+			response, err := keeper.AllSettlementResults(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(response.GetSettlementResults()), step)
+			settlementResults = append(settlementResults, response.GetSettlementResults()...)
+			next = response.GetPagination().GetNextKey()
+		}
+		require.ElementsMatch(t, fixture.settlementResults, settlementResults)
+	})
+
+	t.Run("Total", func(t *testing.T) {
+		response, err := keeper.AllSettlementResults(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(fixture.settlementResults), int(response.GetPagination().GetTotal()))
+		require.ElementsMatch(t, fixture.settlementResults, response.GetSettlementResults())
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.AllSettlementResults(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+		_, err = keeper.AllSettlementResults(ctx, &types.QueryAllSettlementResultsRequest{
+			Filter: &types.QueryAllSettlementResultsRequest_SupplierOperatorAddress{
+				SupplierOperatorAddress: "invalid address",
+			},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSettlementResult_Prune(t *testing.T) {
+	keeper, ctx := keepertest.TokenomicsKeeper(t)
+	fixture := createSettlementResults(t, keeper, ctx)
+
+	// Nothing is pruned before the earliest session end height.
+	numPruned := keeper.PruneSettlementResults(ctx, fixture.sessionEndHeights[0])
+	require.Zero(t, numPruned)
+
+	// Prune the settlement results of the earliest session end height only.
+	numPruned = keeper.PruneSettlementResults(ctx, fixture.sessionEndHeights[1])
+	expectedRemainingSettlementResults := filterSettlementResults(fixture, func(r types.ClaimSettlementResult) bool {
+		return r.GetSessionEndHeight() >= fixture.sessionEndHeights[1]
+	})
+	require.Equal(t, len(fixture.settlementResults)-len(expectedRemainingSettlementResults), numPruned)
+	require.ElementsMatch(t, expectedRemainingSettlementResults, keeper.GetAllSettlementResults(ctx))
+
+	// The secondary indices of the pruned settlement results are removed too.
+	for _, serviceId := range fixture.serviceIds {
+		response, err := keeper.AllSettlementResults(ctx, &types.QueryAllSettlementResultsRequest{
+			Filter: &types.QueryAllSettlementResultsRequest_ServiceId{ServiceId: serviceId},
+		})
+		require.NoError(t, err)
+		for _, settlementResult := range response.GetSettlementResults() {
+			require.GreaterOrEqual(t, settlementResult.GetSessionEndHeight(), fixture.sessionEndHeights[1])
+		}
+	}
+}
+
+func TestSettlementResult_PruneSettlementHistory(t *testing.T) {
+	keeper, ctx := keepertest.TokenomicsKeeper(t)
+	fixture := createSettlementResults(t, keeper, ctx)
+
+	params := keeper.GetParams(ctx)
+	params.SettlementHistoryRetentionBlocks = 10
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	// The settlement results of the last session end height are retained for
+	// SettlementHistoryRetentionBlocks blocks after it.
+	lastSessionEndHeight := fixture.sessionEndHeights[len(fixture.sessionEndHeights)-1]
+	retentionEndHeight := lastSessionEndHeight + int64(params.SettlementHistoryRetentionBlocks)
+
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(retentionEndHeight)
+	keeper.PruneSettlementHistory(sdkCtx)
+	require.ElementsMatch(t,
+		filterSettlementResults(fixture, func(r types.ClaimSettlementResult) bool {
+			return r.GetSessionEndHeight() == lastSessionEndHeight
+		}),
+		keeper.GetAllSettlementResults(sdkCtx),
+	)
+
+	sdkCtx = sdkCtx.WithBlockHeight(retentionEndHeight + 1)
+	keeper.PruneSettlementHistory(sdkCtx)
+	require.Empty(t, keeper.GetAllSettlementResults(sdkCtx))
+}
+
+func TestSettlementResult_PruneSettlementHistoryDisabled(t *testing.T) {
+	keeper, ctx := keepertest.TokenomicsKeeper(t)
+	fixture := createSettlementResults(t, keeper, ctx)
+
+	// Disabling the settlement history prunes all the retained settlement results.
+	params := keeper.GetParams(ctx)
+	params.SettlementHistoryRetentionBlocks = 0
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	lastSessionEndHeight := fixture.sessionEndHeights[len(fixture.sessionEndHeights)-1]
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(lastSessionEndHeight + 1)
+	numPruned := keeper.PruneSettlementHistory(sdkCtx)
+	require.Equal(t, len(fixture.settlementResults), numPruned)
+	require.Empty(t, keeper.GetAllSettlementResults(sdkCtx))
+}
+
+// createSettlementResults stores the settlement results of every combination of
+// 2 suppliers, 2 applications and 2 services over 3 session end heights.
+func createSettlementResults(
+	t *testing.T,
+	keeper tokenomicskeeper.Keeper,
+	ctx context.Context,
+) *settlementResultsFixture {
+	t.Helper()
+
+	fixture := &settlementResultsFixture{
+		supplierOperatorAddrs: []string{sample.AccAddressBech32(), sample.AccAddressBech32()},
+		appAddrs:              []string{sample.AccAddressBech32(), sample.AccAddressBech32()},
+		serviceIds:            []string{"svc1", "svc10"},
+		sessionEndHeights:     []int64{10, 20, 300},
+	}
+
+	for _, sessionEndHeight := range fixture.sessionEndHeights {
+		for _, serviceId := range fixture.serviceIds {
+			for _, appAddr := range fixture.appAddrs {
+				sessionHeader := &sessiontypes.SessionHeader{
+					ApplicationAddress:      appAddr,
+					ServiceId:               serviceId,
+					SessionId:               fmt.Sprintf("session_%s_%s_%d", serviceId, appAddr, sessionEndHeight),
+					SessionStartBlockHeight: sessionEndHeight - 9,
+					SessionEndBlockHeight:   sessionEndHeight,
+				}
+
+				for _, supplierOperatorAddr := range fixture.supplierOperatorAddrs {
+					settlementResult := types.ClaimSettlementResult{
+						Claim: prooftypes.Claim{
+							SupplierOperatorAddress: supplierOperatorAddr,
+							SessionHeader:           sessionHeader,
+							RootHash:                []byte("root_hash"),
+						},
+					}
+					keeper.SetSettlementResult(ctx, settlementResult)
+					fixture.settlementResults = append(fixture.settlementResults, settlementResult)
+				}
+			}
+		}
+	}
+
+	return fixture
+}
+
+// filterSettlementResults returns the fixture settlement results matching the given predicate.
+func filterSettlementResults(
+	fixture *settlementResultsFixture,
+	isMatch func(types.ClaimSettlementResult) bool,
+) (settlementResults []types.ClaimSettlementResult) {
+	for _, settlementResult := range fixture.settlementResults {
+		if isMatch(settlementResult) {
+			settlementResults = append(settlementResults, settlementResult)
+		}
+	}
+	return settlementResults
+}
@@ -459,7 +459,7 @@ func (k Keeper) ensureClaimAmountLimits(
 // per-claim cap (ensureClaimAmountLimits) MUST derive it identically — hence the shared
 // helper.
 //
-// Takes the already-converted big.Rat rather than the float64 param: the conversion is
+// Takes the already-converted big.Rat rather than the decimal param: the conversion is
 // identical for every claim in a block, so it is done once per settlement block by
 // settlementContext.getGlobalInflationPerClaimRat. The Rat is read-only here.
 func claimStakeTermsAmount(claimSettlementCoin cosmostypes.Coin, globalInflationPerClaimRat *big.Rat) math.Int {
@@ -477,7 +477,7 @@ func claimStakeTermsAmount(claimSettlementCoin cosmostypes.Coin, globalInflation
 // maxSettlementAmt = stake / (1 + GlobalInflationPerClaim)
 //
 // Takes the already-converted big.Rat (see claimStakeTermsAmount) so the per-block
-// float64->Rat conversion is not repeated for every claim. The Rat is read-only here.
+// LegacyDec->Rat conversion is not repeated for every claim. The Rat is read-only here.
 func supplierAppStakeToMaxSettlementAmount(stakeAmount math.Int, globalInflationPerClaimRat *big.Rat) math.Int {
 	// divisor = 1 + globalInflationPerClaim
 	divisor := new(big.Rat).Add(new(big.Rat).SetInt64(1), globalInflationPerClaimRat)
@@ -91,13 +91,13 @@ func TestProcessTokenLogicModules_TLMBurnEqualsMint_AppToSupplierOnly_Valid(t *t
 
 	// Setting inflation to zero so we are testing the BurnEqualsMint logic exclusively.
 	tokenomicsParams := keepers.Keeper.GetParams(ctx)
-	tokenomicsParams.GlobalInflationPerClaim = 0
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyZeroDec()
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         0,
-		Proposer:    0,
-		Supplier:    1,
-		SourceOwner: 0,
-		Application: 0,
+		Dao:         cosmosmath.LegacyZeroDec(),
+		Proposer:    cosmosmath.LegacyZeroDec(),
+		Supplier:    cosmosmath.LegacyOneDec(),
+		SourceOwner: cosmosmath.LegacyZeroDec(),
+		Application: cosmosmath.LegacyZeroDec(),
 	}
 	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
 	require.NoError(t, err)
@@ -216,7 +216,7 @@ func TestProcessTokenLogicModules_TLMBurnEqualsMint_AppToSupplierOnly_Valid(t *t
 	// Assert that the supplier shareholders account balances have *increased* by
 	// the appropriate amount w.r.t token distribution.
 	// The supplier gets a percentage of the total settlement based on MintEqualsBurnClaimDistribution
-	supplierAllocation := appBurn.MulRaw(int64(keepers.Keeper.GetParams(ctx).MintEqualsBurnClaimDistribution.Supplier * 100)).QuoRaw(100)
+	supplierAllocation := appBurn.ToLegacyDec().Mul(keepers.Keeper.GetParams(ctx).MintEqualsBurnClaimDistribution.Supplier).TruncateInt()
 	shareAmounts, err := tlm.GetSupplierShareholderAmountMap(supplierRevShares, supplierAllocation)
 	require.NoError(t, err)
 	for shareHolderAddr, expectedShareAmount := range shareAmounts {
@@ -284,13 +284,13 @@ func TestProcessTokenLogicModules_TLMBurnEqualsMint_AppToSupplierExceedsMaxClaim
 
 	// Setting inflation to zero so we are testing the BurnEqualsMint logic exclusively.
 	tokenomicsParams := keepers.Keeper.GetParams(ctx)
-	tokenomicsParams.GlobalInflationPerClaim = 0
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyZeroDec()
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         0,
-		Proposer:    0,
-		Supplier:    1,
-		SourceOwner: 0,
-		Application: 0,
+		Dao:         cosmosmath.LegacyZeroDec(),
+		Proposer:    cosmosmath.LegacyZeroDec(),
+		Supplier:    cosmosmath.LegacyOneDec(),
+		SourceOwner: cosmosmath.LegacyZeroDec(),
+		Application: cosmosmath.LegacyZeroDec(),
 	}
 	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
 	require.NoError(t, err)
@@ -415,7 +415,7 @@ func TestProcessTokenLogicModules_TLMBurnEqualsMint_AppToSupplierExceedsMaxClaim
 	// Assert that the supplier shareholders account balances have *increased* by
 	// the appropriate amount w.r.t token distribution.
 	// The supplier gets a percentage of the total settlement based on MintEqualsBurnClaimDistribution
-	supplierAllocation := appBurn.MulRaw(int64(keepers.Keeper.GetParams(ctx).MintEqualsBurnClaimDistribution.Supplier * 100)).QuoRaw(100)
+	supplierAllocation := appBurn.ToLegacyDec().Mul(keepers.Keeper.GetParams(ctx).MintEqualsBurnClaimDistribution.Supplier).TruncateInt()
 	shareAmounts, err := tlm.GetSupplierShareholderAmountMap(supplierRevShares, supplierAllocation)
 	require.NoError(t, err)
 	for shareHolderAddr, expectedShareAmount := range shareAmounts {
@@ -501,13 +501,13 @@ func TestProcessTokenLogicModules_PerSessionSpendLimit(t *testing.T) {
 
 	// Setting inflation to zero for isolated testing.
 	tokenomicsParams := keepers.Keeper.GetParams(ctx)
-	tokenomicsParams.GlobalInflationPerClaim = 0
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyZeroDec()
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         0,
-		Proposer:    0,
-		Supplier:    1,
-		SourceOwner: 0,
-		Application: 0,
+		Dao:         cosmosmath.LegacyZeroDec(),
+		Proposer:    cosmosmath.LegacyZeroDec(),
+		Supplier:    cosmosmath.LegacyOneDec(),
+		SourceOwner: cosmosmath.LegacyZeroDec(),
+		Application: cosmosmath.LegacyZeroDec(),
 	}
 	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
 	require.NoError(t, err)
@@ -612,13 +612,13 @@ func TestProcessTokenLogicModules_PerSessionSpendLimit_NilNoEffect(t *testing.T)
 	require.NoError(t, err)
 
 	tokenomicsParams := keepers.Keeper.GetParams(ctx)
-	tokenomicsParams.GlobalInflationPerClaim = 0
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyZeroDec()
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         0,
-		Proposer:    0,
-		Supplier:    1,
-		SourceOwner: 0,
-		Application: 0,
+		Dao:         cosmosmath.LegacyZeroDec(),
+		Proposer:    cosmosmath.LegacyZeroDec(),
+		Supplier:    cosmosmath.LegacyOneDec(),
+		SourceOwner: cosmosmath.LegacyZeroDec(),
+		Application: cosmosmath.LegacyZeroDec(),
 	}
 	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
 	require.NoError(t, err)
@@ -840,8 +840,7 @@ func TestProcessTokenLogicModules_TLMGlobalMint_Valid_MintDistributionCorrect(t
 	}
 
 	// Compute the expected amount to mint.
-	globalInflationPerClaimRat, err := encoding.Float64ToRat(tokenomicsParams.GlobalInflationPerClaim)
-	require.NoError(t, err)
+	globalInflationPerClaimRat := encoding.LegacyDecToRat(tokenomicsParams.GlobalInflationPerClaim)
 
 	numTokensClaimedRat := new(big.Rat).SetInt(numTokensClaimedInt.BigInt())
 	numTokensMintedRat := new(big.Rat).Mul(numTokensClaimedRat, globalInflationPerClaimRat)
@@ -859,19 +858,19 @@ func TestProcessTokenLogicModules_TLMGlobalMint_Valid_MintDistributionCorrect(t
 	numTokensMinted := cosmosmath.NewIntFromBigInt(numTokensMintedInt)
 
 	// Compute the expected amount minted to each module from Global Mint TLM.
-	propMintFromGlobalMint := computeShare(t, numTokensMintedRat, tokenomicsParams.MintAllocationPercentages.Proposer)
-	serviceOwnerMintFromGlobalMint := computeShare(t, numTokensMintedRat, tokenomicsParams.MintAllocationPercentages.SourceOwner)
-	appMintFromGlobalMint := computeShare(t, numTokensMintedRat, tokenomicsParams.MintAllocationPercentages.Application)
-	supplierMintFromGlobalMint := computeShare(t, numTokensMintedRat, tokenomicsParams.MintAllocationPercentages.Supplier)
+	propMintFromGlobalMint := computeShare(numTokensMintedRat, tokenomicsParams.MintAllocationPercentages.Proposer)
+	serviceOwnerMintFromGlobalMint := computeShare(numTokensMintedRat, tokenomicsParams.MintAllocationPercentages.SourceOwner)
+	appMintFromGlobalMint := computeShare(numTokensMintedRat, tokenomicsParams.MintAllocationPercentages.Application)
+	supplierMintFromGlobalMint := computeShare(numTokensMintedRat, tokenomicsParams.MintAllocationPercentages.Supplier)
 	// The DAO mint gets any remainder resulting from integer division.
 	daoMintFromGlobalMint := numTokensMinted.Sub(propMintFromGlobalMint).Sub(serviceOwnerMintFromGlobalMint).Sub(appMintFromGlobalMint).Sub(supplierMintFromGlobalMint)
 
 	// Compute the expected amount from Relay Burn Equals Mint TLM distribution.
 	settlementAmount := numTokensClaimedInt
-	propDistributionFromBurnEqualsMint := settlementAmount.MulRaw(int64(tokenomicsParams.MintEqualsBurnClaimDistribution.Proposer * 100)).QuoRaw(100)
-	serviceOwnerDistributionFromBurnEqualsMint := settlementAmount.MulRaw(int64(tokenomicsParams.MintEqualsBurnClaimDistribution.SourceOwner * 100)).QuoRaw(100)
-	appDistributionFromBurnEqualsMint := settlementAmount.MulRaw(int64(tokenomicsParams.MintEqualsBurnClaimDistribution.Application * 100)).QuoRaw(100)
-	supplierDistributionFromBurnEqualsMint := settlementAmount.MulRaw(int64(tokenomicsParams.MintEqualsBurnClaimDistribution.Supplier * 100)).QuoRaw(100)
+	propDistributionFromBurnEqualsMint := settlementAmount.ToLegacyDec().Mul(tokenomicsParams.MintEqualsBurnClaimDistribution.Proposer).TruncateInt()
+	serviceOwnerDistributionFromBurnEqualsMint := settlementAmount.ToLegacyDec().Mul(tokenomicsParams.MintEqualsBurnClaimDistribution.SourceOwner).TruncateInt()
+	appDistributionFromBurnEqualsMint := settlementAmount.ToLegacyDec().Mul(tokenomicsParams.MintEqualsBurnClaimDistribution.Application).TruncateInt()
+	supplierDistributionFromBurnEqualsMint := settlementAmount.ToLegacyDec().Mul(tokenomicsParams.MintEqualsBurnClaimDistribution.Supplier).TruncateInt()
 	// The DAO gets the remainder to ensure all settlement tokens are distributed
 	daoDistributionFromBurnEqualsMint := settlementAmount.Sub(propDistributionFromBurnEqualsMint).Sub(serviceOwnerDistributionFromBurnEqualsMint).Sub(appDistributionFromBurnEqualsMint).Sub(supplierDistributionFromBurnEqualsMint)
 
@@ -896,11 +895,11 @@ func TestProcessTokenLogicModules_TLMGlobalMint_Valid_MintDistributionCorrect(t
 		addr := revShare.Address
 
 		// Compute the expected balance increase for the shareholder
-		mintShareFloat := float64(revShare.RevSharePercentage) / 100.0
+		revSharePercentage := cosmosmath.LegacyNewDecWithPrec(int64(revShare.RevSharePercentage), 2)
 		// From Relay Burn Equals Mint TLM distribution
-		distributionShare := computeShare(t, supplierDistributionRat, mintShareFloat)
+		distributionShare := computeShare(supplierDistributionRat, revSharePercentage)
 		// From Global Mint TLM distribution
-		mintShare := computeShare(t, supplierMintRat, mintShareFloat)
+		mintShare := computeShare(supplierMintRat, revSharePercentage)
 		balanceIncrease := distributionShare.Add(mintShare)
 
 		// Compute the expected balance after minting
@@ -1240,13 +1239,13 @@ func TestProcessTokenLogicModules_AppStakeDropsBelowMinStakeAfterSession(t *test
 	require.NoError(t, keepers.SharedKeeper.SetParams(ctx, sharedParams))
 
 	tokenomicsParams := keepers.Keeper.GetParams(ctx)
-	tokenomicsParams.GlobalInflationPerClaim = 0
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyZeroDec()
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         0,
-		Proposer:    0,
-		Supplier:    1,
-		SourceOwner: 0,
-		Application: 0,
+		Dao:         cosmosmath.LegacyZeroDec(),
+		Proposer:    cosmosmath.LegacyZeroDec(),
+		Supplier:    cosmosmath.LegacyOneDec(),
+		SourceOwner: cosmosmath.LegacyZeroDec(),
+		Application: cosmosmath.LegacyZeroDec(),
 	}
 	require.NoError(t, keepers.Keeper.SetParams(ctx, tokenomicsParams))
 
@@ -1369,11 +1368,8 @@ func getBalance(
 }
 
 // computeShare computes the share of the given amount based a percentage.
-func computeShare(t *testing.T, amount *big.Rat, sharePercentage float64) cosmosmath.Int {
-	amountRat, err := encoding.Float64ToRat(sharePercentage)
-	require.NoError(t, err)
-
-	mintRat := new(big.Rat).Mul(amount, amountRat)
+func computeShare(amount *big.Rat, sharePercentage cosmosmath.LegacyDec) cosmosmath.Int {
+	mintRat := new(big.Rat).Mul(amount, encoding.LegacyDecToRat(sharePercentage))
 	flooredShare := new(big.Int).Quo(mintRat.Num(), mintRat.Denom())
 
 	return cosmosmath.NewIntFromBigInt(flooredShare)
@@ -1408,14 +1404,14 @@ func TestProcessTokenLogicModules_TLMBurnEqualsMint_Valid_WithRewardDistribution
 		testComputeUnitCostGranularity  = 1000000
 		testServiceComputeUnitsPerRelay = 1
 		testNumberOfRelaysInClaim       = 1000
-		testGlobalInflationPerClaim     = 0.0 // Disable global inflation for this test
+		testGlobalInflationPerClaim     = "0" // Disable global inflation for this test
 
 		// MintEqualsBurnClaimDistribution percentages
-		testMintEqualsBurnDaoPercentage         = 0.1
-		testMintEqualsBurnProposerPercentage    = 0.14
-		testMintEqualsBurnSupplierPercentage    = 0.73
-		testMintEqualsBurnSourceOwnerPercentage = 0.03
-		testMintEqualsBurnApplicationPercentage = 0.0
+		testMintEqualsBurnDaoPercentage         = "0.1"
+		testMintEqualsBurnProposerPercentage    = "0.14"
+		testMintEqualsBurnSupplierPercentage    = "0.73"
+		testMintEqualsBurnSourceOwnerPercentage = "0.03"
+		testMintEqualsBurnApplicationPercentage = "0"
 
 		// Supplier revenue share percentages (must add up to 100)
 		testSupplierRevShareShareholder1Percentage = 12
@@ -1463,13 +1459,13 @@ func TestProcessTokenLogicModules_TLMBurnEqualsMint_Valid_WithRewardDistribution
 
 	// Configure tokenomics parameters with specific reward distribution
 	tokenomicsParams := keepers.Keeper.GetParams(ctx)
-	tokenomicsParams.GlobalInflationPerClaim = testGlobalInflationPerClaim
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyMustNewDecFromStr(testGlobalInflationPerClaim)
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         testMintEqualsBurnDaoPercentage,
-		Proposer:    testMintEqualsBurnProposerPercentage,
-		Supplier:    testMintEqualsBurnSupplierPercentage,
-		SourceOwner: testMintEqualsBurnSourceOwnerPercentage,
-		Application: testMintEqualsBurnApplicationPercentage,
+		Dao:         cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnDaoPercentage),
+		Proposer:    cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnProposerPercentage),
+		Supplier:    cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnSupplierPercentage),
+		SourceOwner: cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnSourceOwnerPercentage),
+		Application: cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnApplicationPercentage),
 	}
 	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
 	require.NoError(t, err)
@@ -1567,11 +1563,11 @@ func TestProcessTokenLogicModules_TLMBurnEqualsMint_Valid_WithRewardDistribution
 
 	// Calculate expected reward distributions from total settlement amount
 	totalSettlementAmount := cosmosmath.NewInt(totalTokensClaimedInSession)
-	expectedDaoRewardAmount := cosmosmath.NewInt(int64(float64(totalTokensClaimedInSession) * testMintEqualsBurnDaoPercentage))
-	expectedProposerRewardAmount := cosmosmath.NewInt(int64(float64(totalTokensClaimedInSession) * testMintEqualsBurnProposerPercentage))
-	expectedSupplierRewardAmount := cosmosmath.NewInt(int64(float64(totalTokensClaimedInSession) * testMintEqualsBurnSupplierPercentage))
-	expectedSourceOwnerRewardAmount := cosmosmath.NewInt(int64(float64(totalTokensClaimedInSession) * testMintEqualsBurnSourceOwnerPercentage))
-	expectedApplicationCostAmount := cosmosmath.NewInt(int64(float64(totalTokensClaimedInSession) * testMintEqualsBurnApplicationPercentage))
+	expectedDaoRewardAmount := totalSettlementAmount.ToLegacyDec().Mul(cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnDaoPercentage)).TruncateInt()
+	expectedProposerRewardAmount := totalSettlementAmount.ToLegacyDec().Mul(cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnProposerPercentage)).TruncateInt()
+	expectedSupplierRewardAmount := totalSettlementAmount.ToLegacyDec().Mul(cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnSupplierPercentage)).TruncateInt()
+	expectedSourceOwnerRewardAmount := totalSettlementAmount.ToLegacyDec().Mul(cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnSourceOwnerPercentage)).TruncateInt()
+	expectedApplicationCostAmount := totalSettlementAmount.ToLegacyDec().Mul(cosmosmath.LegacyMustNewDecFromStr(testMintEqualsBurnApplicationPercentage)).TruncateInt()
 
 	// Account for rounding by ensuring all distributions sum to the total
 	calculatedTotal := expectedDaoRewardAmount.Add(expectedProposerRewardAmount).Add(expectedSupplierRewardAmount).Add(expectedSourceOwnerRewardAmount).Add(expectedApplicationCostAmount)
@@ -1673,13 +1669,13 @@ func TestProcessTokenLogicModules_PerSessionSpendLimit_MultipleSuppliers(t *test
 	require.NoError(t, err)
 
 	tokenomicsParams := keepers.Keeper.GetParams(ctx)
-	tokenomicsParams.GlobalInflationPerClaim = 0
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyZeroDec()
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         0,
-		Proposer:    0,
-		Supplier:    1,
-		SourceOwner: 0,
-		Application: 0,
+		Dao:         cosmosmath.LegacyZeroDec(),
+		Proposer:    cosmosmath.LegacyZeroDec(),
+		Supplier:    cosmosmath.LegacyOneDec(),
+		SourceOwner: cosmosmath.LegacyZeroDec(),
+		Application: cosmosmath.LegacyZeroDec(),
 	}
 	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
 	require.NoError(t, err)
@@ -1798,13 +1794,13 @@ func TestProcessTokenLogicModules_PerSessionSpendLimit_ExceedsStakeBudget(t *tes
 
 	// Setting inflation to zero for isolated testing.
 	tokenomicsParams := keepers.Keeper.GetParams(ctx)
-	tokenomicsParams.GlobalInflationPerClaim = 0
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyZeroDec()
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         0,
-		Proposer:    0,
-		Supplier:    1,
-		SourceOwner: 0,
-		Application: 0,
+		Dao:         cosmosmath.LegacyZeroDec(),
+		Proposer:    cosmosmath.LegacyZeroDec(),
+		Supplier:    cosmosmath.LegacyOneDec(),
+		SourceOwner: cosmosmath.LegacyZeroDec(),
+		Application: cosmosmath.LegacyZeroDec(),
 	}
 	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
 	require.NoError(t, err)
@@ -1946,13 +1942,13 @@ func TestProcessTokenLogicModules_ManySuppliers_SmallStake(t *testing.T) {
 
 	// Setting inflation to zero for isolated testing.
 	tokenomicsParams := keepers.Keeper.GetParams(ctx)
-	tokenomicsParams.GlobalInflationPerClaim = 0
+	tokenomicsParams.GlobalInflationPerClaim = cosmosmath.LegacyZeroDec()
 	tokenomicsParams.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Dao:         0,
-		Proposer:    0,
-		Supplier:    1,
-		SourceOwner: 0,
-		Application: 0,
+		Dao:         cosmosmath.LegacyZeroDec(),
+		Proposer:    cosmosmath.LegacyZeroDec(),
+		Supplier:    cosmosmath.LegacyOneDec(),
+		SourceOwner: cosmosmath.LegacyZeroDec(),
+		Application: cosmosmath.LegacyZeroDec(),
 	}
 	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
 	require.NoError(t, err)
@@ -40,6 +40,10 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) (err error) {
 		logger.Warn(fmt.Sprintf("discarded %d faulty claims", numDiscardedFaultyClaims))
 	}
 
+	// Prune the settlement results which are no longer retained in the settlement history.
+	numPrunedSettlementResults := k.PruneSettlementHistory(ctx)
+	logger.Debug(fmt.Sprintf("pruned %d settlement results from the settlement history", numPrunedSettlementResults))
+
 	// Update the relay mining difficulty for every service that settled pending claims.
 	settledRelaysPerServiceIdMap, err := settledResults.GetRelaysPerServiceMap()
 	if err != nil {
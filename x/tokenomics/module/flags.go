@@ -0,0 +1,8 @@
+package tokenomics
+
+const (
+	FlagSupplierOperatorAddress = "supplier-operator-address"
+	FlagApplicationAddress      = "application-address"
+	FlagServiceId               = "service-id"
+	FlagSessionEndHeight        = "session-end-height"
+)
@@ -9,6 +9,10 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the settlement results of the settlement history
+	for _, settlementResult := range genState.SettlementResultList {
+		k.SetSettlementResult(ctx, settlementResult)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -20,6 +24,7 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.SettlementResultList = k.GetAllSettlementResults(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -7,6 +7,9 @@ import (
 
 	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
 	"github.com/pokt-network/poktroll/testutil/nullify"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 	tokenomics "github.com/pokt-network/poktroll/x/tokenomics/module"
 	"github.com/pokt-network/poktroll/x/tokenomics/types"
 )
@@ -14,6 +17,10 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		SettlementResultList: []types.ClaimSettlementResult{
+			newGenesisSettlementResult(10, "session_1"),
+			newGenesisSettlementResult(20, "session_2"),
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -25,5 +32,62 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.SettlementResultList, got.SettlementResultList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesis_SettlementHistoryRoundTrip(t *testing.T) {
+	exportingKeeper, exportingCtx, _, _, _ := keepertest.TokenomicsKeeperWithActorAddrs(t)
+
+	settlementResults := []types.ClaimSettlementResult{
+		newGenesisSettlementResult(10, "session_1"),
+		newGenesisSettlementResult(10, "session_2"),
+		newGenesisSettlementResult(20, "session_3"),
+	}
+	for _, settlementResult := range settlementResults {
+		exportingKeeper.SetSettlementResult(exportingCtx, settlementResult)
+	}
+
+	// Export the settlement history and import it in a new chain.
+	exportedGenesis := tokenomics.ExportGenesis(exportingCtx, exportingKeeper)
+	require.NoError(t, exportedGenesis.Validate())
+	require.ElementsMatch(t, settlementResults, exportedGenesis.SettlementResultList)
+
+	importingKeeper, importingCtx, _, _, _ := keepertest.TokenomicsKeeperWithActorAddrs(t)
+	tokenomics.InitGenesis(importingCtx, importingKeeper, *exportedGenesis)
+
+	require.ElementsMatch(t, settlementResults, importingKeeper.GetAllSettlementResults(importingCtx))
+
+	// The secondary indices are rebuilt on import.
+	for _, settlementResult := range settlementResults {
+		response, err := importingKeeper.AllSettlementResults(importingCtx, &types.QueryAllSettlementResultsRequest{
+			Filter: &types.QueryAllSettlementResultsRequest_SupplierOperatorAddress{
+				SupplierOperatorAddress: settlementResult.GetSupplierOperatorAddr(),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []types.ClaimSettlementResult{settlementResult}, response.GetSettlementResults())
+	}
+
+	// Re-exporting the imported settlement history is lossless.
+	reexportedGenesis := tokenomics.ExportGenesis(importingCtx, importingKeeper)
+	require.Equal(t, exportedGenesis.SettlementResultList, reexportedGenesis.SettlementResultList)
+}
+
+// newGenesisSettlementResult returns a settlement result of a new supplier and
+// application for the given session.
+func newGenesisSettlementResult(sessionEndHeight int64, sessionId string) types.ClaimSettlementResult {
+	return types.ClaimSettlementResult{
+		Claim: prooftypes.Claim{
+			SupplierOperatorAddress: sample.AccAddressBech32(),
+			SessionHeader: &sessiontypes.SessionHeader{
+				ApplicationAddress:      sample.AccAddressBech32(),
+				ServiceId:               "svc1",
+				SessionId:               sessionId,
+				SessionStartBlockHeight: sessionEndHeight - 9,
+				SessionEndBlockHeight:   sessionEndHeight,
+			},
+			RootHash: []byte("root_hash"),
+		},
+	}
+}
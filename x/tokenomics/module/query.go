@@ -22,6 +22,7 @@ func (am AppModule) GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdListSettlementResults())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,117 @@
+package tokenomics
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+func CmdListSettlementResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-settlement-results",
+		Short: "list all settlement results",
+		Long: `List the claim settlement results retained in the settlement history of the node being queried.
+
+Settlement results are pruned once their session ended more than ` + "`settlement_history_retention_blocks`" + ` blocks ago.
+
+The settlement results can be optionally filtered by one of --supplier-operator-address --application-address --service-id or --session-end-height flags
+
+Example:
+$ pocketd q tokenomics list-settlement-results --network=<network> --home $(POCKETD_HOME)
+$ pocketd q tokenomics list-settlement-results --supplier-operator-address <supplier_operator_address> --network=<network> --home $(POCKETD_HOME)
+$ pocketd q tokenomics list-settlement-results --application-address <application_address> --network=<network> --home $(POCKETD_HOME)
+$ pocketd q tokenomics list-settlement-results --service-id <service_id> --network=<network> --home $(POCKETD_HOME)
+$ pocketd q tokenomics list-settlement-results --session-end-height <session_end_height> --network=<network> --home $(POCKETD_HOME)`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			pageReq, pageErr := client.ReadPageRequest(cmd.Flags())
+			if pageErr != nil {
+				return pageErr
+			}
+
+			req := &types.QueryAllSettlementResultsRequest{
+				Pagination: pageReq,
+			}
+			if err = updateSettlementResultsFilter(cmd, req); err != nil {
+				return err
+			}
+			if err = req.ValidateBasic(); err != nil {
+				return err
+			}
+
+			clientCtx, ctxErr := client.GetClientQueryContext(cmd)
+			if ctxErr != nil {
+				return ctxErr
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, settlementResultsErr := queryClient.AllSettlementResults(cmd.Context(), req)
+			if settlementResultsErr != nil {
+				return settlementResultsErr
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSupplierOperatorAddress, "", "settlement results of the claims submitted by the supplier matching this operator address will be returned")
+	cmd.Flags().String(FlagApplicationAddress, "", "settlement results of the claims for sessions of the application matching this address will be returned")
+	cmd.Flags().String(FlagServiceId, "", "settlement results of the claims for sessions of this service will be returned")
+	cmd.Flags().Uint64(FlagSessionEndHeight, 0, "settlement results of the claims whose session ends at this height will be returned")
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// updateSettlementResultsFilter updates the settlement results filter request based on the flags set provided
+func updateSettlementResultsFilter(cmd *cobra.Command, req *types.QueryAllSettlementResultsRequest) error {
+	supplierOperatorAddr, _ := cmd.Flags().GetString(FlagSupplierOperatorAddress)
+	applicationAddr, _ := cmd.Flags().GetString(FlagApplicationAddress)
+	serviceId, _ := cmd.Flags().GetString(FlagServiceId)
+	sessionEndHeight, _ := cmd.Flags().GetUint64(FlagSessionEndHeight)
+
+	// Set the filter of every flag that was provided, counting them to
+	// ensure that at most one of them was.
+	numFilters := 0
+	if supplierOperatorAddr != "" {
+		numFilters++
+		req.Filter = &types.QueryAllSettlementResultsRequest_SupplierOperatorAddress{
+			SupplierOperatorAddress: supplierOperatorAddr,
+		}
+	}
+	if applicationAddr != "" {
+		numFilters++
+		req.Filter = &types.QueryAllSettlementResultsRequest_ApplicationAddress{
+			ApplicationAddress: applicationAddr,
+		}
+	}
+	if serviceId != "" {
+		numFilters++
+		req.Filter = &types.QueryAllSettlementResultsRequest_ServiceId{
+			ServiceId: serviceId,
+		}
+	}
+	if sessionEndHeight > 0 {
+		numFilters++
+		req.Filter = &types.QueryAllSettlementResultsRequest_SessionEndHeight{
+			SessionEndHeight: sessionEndHeight,
+		}
+	}
+
+	if numFilters > 1 {
+		return fmt.Errorf(
+			"can only specify one flag filter but got supplierOperatorAddr (%s), applicationAddr (%s), serviceId (%s) and sessionEndHeight (%d)",
+			supplierOperatorAddr,
+			applicationAddr,
+			serviceId,
+			sessionEndHeight,
+		)
+	}
+
+	return nil
+}
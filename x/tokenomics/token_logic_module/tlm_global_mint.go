@@ -82,18 +82,14 @@ func (tlmgm *tlmGlobalMint) processInflationMint() (cosmostypes.Coin, error) {
 	// === PARAMETER EXTRACTION ===
 
 	// Retrieve the global inflation per claim
-	globalInflationPerClaim := tlmgm.tlmCtx.TokenomicsParams.GetGlobalInflationPerClaim()
-	if globalInflationPerClaim == 0 {
+	globalInflationPerClaim := tlmgm.tlmCtx.TokenomicsParams.GlobalInflationPerClaim
+	if globalInflationPerClaim.IsZero() {
 		tlmgm.logger.Warn("global inflation is set to zero. Skipping Global Mint TLM.")
 		return cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 0), nil
 	}
 
 	// Convert to rat for safe numeric operators
-	globalInflationPerClaimRat, err := encoding.Float64ToRat(globalInflationPerClaim)
-	if err != nil {
-		tlmgm.logger.Error(fmt.Sprintf("error converting global inflation per claim due to: %v", err))
-		return cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 0), err
-	}
+	globalInflationPerClaimRat := encoding.LegacyDecToRat(globalInflationPerClaim)
 
 	// === MINT CALCULATION ===
 
@@ -133,31 +129,19 @@ func (tlmgm *tlmGlobalMint) processMintDistribution(newMintCoin cosmostypes.Coin
 	// Calculate how much each participant gets from the newly minted amount
 
 	// Calculate supplier allocation
-	supplierMintAllocationRat, err := encoding.Float64ToRat(mintAllocationPercentages.Supplier)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting supplier mint allocation percentage: %v", err)
-	}
+	supplierMintAllocationRat := encoding.LegacyDecToRat(mintAllocationPercentages.Supplier)
 	supplierCoinsToShareAmt := calculateAllocationAmount(newMintCoin.Amount, supplierMintAllocationRat)
 
 	// Calculate application allocation
-	appMintAllocationRat, err := encoding.Float64ToRat(mintAllocationPercentages.Application)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting application mint allocation percentage: %v", err)
-	}
+	appMintAllocationRat := encoding.LegacyDecToRat(mintAllocationPercentages.Application)
 	appAmount := calculateAllocationAmount(newMintCoin.Amount, appMintAllocationRat)
 
 	// Calculate source owner allocation
-	sourceOwnerMintAllocationRat, err := encoding.Float64ToRat(mintAllocationPercentages.SourceOwner)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting source owner mint allocation percentage: %v", err)
-	}
+	sourceOwnerMintAllocationRat := encoding.LegacyDecToRat(mintAllocationPercentages.SourceOwner)
 	sourceOwnerAmount := calculateAllocationAmount(newMintCoin.Amount, sourceOwnerMintAllocationRat)
 
 	// Calculate proposer allocation
-	proposerMintAllocationRat, err := encoding.Float64ToRat(mintAllocationPercentages.Proposer)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting proposer mint allocation percentage: %v", err)
-	}
+	proposerMintAllocationRat := encoding.LegacyDecToRat(mintAllocationPercentages.Proposer)
 	proposerAmount := calculateAllocationAmount(newMintCoin.Amount, proposerMintAllocationRat)
 
 	// === REWARD DISTRIBUTION ===
@@ -0,0 +1,145 @@
+package token_logic_module
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/app/pocket"
+	"github.com/pokt-network/poktroll/pkg/encoding"
+)
+
+// TestCalculateGlobalPerClaimMintInflationFromSettlementAmount_GoldenVectors pins
+// the exact uPOKT minted for a given settlement amount and global inflation per claim.
+// Any implementation of the protocol MUST reproduce these amounts.
+func TestCalculateGlobalPerClaimMintInflationFromSettlementAmount_GoldenVectors(t *testing.T) {
+	tests := []struct {
+		desc                    string
+		settlementAmount        int64
+		globalInflationPerClaim string
+		expectedMintAmount      int64
+	}{
+		{
+			desc:                    "exact product",
+			settlementAmount:        1_000_000_000_000_000_000,
+			globalInflationPerClaim: "0.1",
+			expectedMintAmount:      100_000_000_000_000_000,
+		},
+		{
+			desc:                    "fractional product: rounded up",
+			settlementAmount:        123_456_789,
+			globalInflationPerClaim: "0.2",
+			expectedMintAmount:      24_691_358,
+		},
+		{
+			desc:                    "fractional product below 1: rounded up",
+			settlementAmount:        3,
+			globalInflationPerClaim: "0.1",
+			expectedMintAmount:      1,
+		},
+		{
+			desc:                    "smallest decimal",
+			settlementAmount:        7,
+			globalInflationPerClaim: "0.000000000000000001",
+			expectedMintAmount:      1,
+		},
+		{
+			desc:                    "no inflation",
+			settlementAmount:        123_456_789,
+			globalInflationPerClaim: "0",
+			expectedMintAmount:      0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			settlementCoin := cosmostypes.NewInt64Coin(pocket.DenomuPOKT, test.settlementAmount)
+			globalInflationPerClaimRat := encoding.LegacyDecToRat(math.LegacyMustNewDecFromStr(test.globalInflationPerClaim))
+
+			mintCoin := CalculateGlobalPerClaimMintInflationFromSettlementAmount(settlementCoin, globalInflationPerClaimRat)
+			require.Equal(t, pocket.DenomuPOKT, mintCoin.Denom)
+			require.Equal(t, test.expectedMintAmount, mintCoin.Amount.Int64())
+		})
+	}
+}
+
+func TestCalculateGlobalPerClaimMintInflationFromSettlementAmount_NotFloat64(t *testing.T) {
+	// The binary float64 closest to 0.1 is slightly greater than 0.1: minting with
+	// it rounds 1e18 * 0.1 up to the next uPOKT.
+	settlementCoin := cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 1_000_000_000_000_000_000)
+	float64MintCoin := CalculateGlobalPerClaimMintInflationFromSettlementAmount(settlementCoin, new(big.Rat).SetFloat64(0.1))
+	require.Equal(t, int64(100_000_000_000_000_006), float64MintCoin.Amount.Int64())
+
+	decMintCoin := CalculateGlobalPerClaimMintInflationFromSettlementAmount(
+		settlementCoin,
+		encoding.LegacyDecToRat(math.LegacyMustNewDecFromStr("0.1")),
+	)
+	require.Equal(t, int64(100_000_000_000_000_000), decMintCoin.Amount.Int64())
+}
+
+// TestCalculateAllocationAmount_GoldenVectors pins the exact uPOKT allocated
+// from a given amount and allocation percentage.
+// Any implementation of the protocol MUST reproduce these amounts.
+func TestCalculateAllocationAmount_GoldenVectors(t *testing.T) {
+	tests := []struct {
+		desc                     string
+		amount                   int64
+		allocationPercentage     string
+		expectedAllocationAmount int64
+	}{
+		{
+			desc:                     "exact product",
+			amount:                   100_000_000_000_000_000,
+			allocationPercentage:     "0.7",
+			expectedAllocationAmount: 70_000_000_000_000_000,
+		},
+		{
+			desc:                     "exact product with 2 decimal places",
+			amount:                   100_000_000_000_000_000,
+			allocationPercentage:     "0.15",
+			expectedAllocationAmount: 15_000_000_000_000_000,
+		},
+		{
+			desc:                     "fractional product: rounded down",
+			amount:                   24_691_358,
+			allocationPercentage:     "0.7",
+			expectedAllocationAmount: 17_283_950,
+		},
+		{
+			desc:                     "fractional product with 2 decimal places: rounded down",
+			amount:                   24_691_358,
+			allocationPercentage:     "0.05",
+			expectedAllocationAmount: 1_234_567,
+		},
+		{
+			desc:                     "18 decimal places",
+			amount:                   10,
+			allocationPercentage:     "0.333333333333333333",
+			expectedAllocationAmount: 3,
+		},
+		{
+			desc:                     "smallest decimal",
+			amount:                   1_000_000_000_000_000_000,
+			allocationPercentage:     "0.000000000000000001",
+			expectedAllocationAmount: 1,
+		},
+		{
+			desc:                     "whole amount",
+			amount:                   24_691_358,
+			allocationPercentage:     "1",
+			expectedAllocationAmount: 24_691_358,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			allocationPercentageRat := encoding.LegacyDecToRat(math.LegacyMustNewDecFromStr(test.allocationPercentage))
+
+			allocationAmount := calculateAllocationAmount(math.NewInt(test.amount), allocationPercentageRat)
+			require.Equal(t, test.expectedAllocationAmount, allocationAmount.Int64())
+		})
+	}
+}
@@ -40,15 +40,11 @@ func (tlm tlmGlobalMintReimbursementRequest) Process(
 
 	logger = logger.With("method", "TokenLogicModuleGlobalMintReimbursementRequest")
 
-	globalInflationPerClaim := tlmCtx.TokenomicsParams.GetGlobalInflationPerClaim()
-	globalInflationPerClaimRat, err := encoding.Float64ToRat(globalInflationPerClaim)
-	if err != nil {
-		logger.Error(fmt.Sprintf("error processing TLM due to: %v", err))
-		return err
-	}
+	globalInflationPerClaim := tlmCtx.TokenomicsParams.GlobalInflationPerClaim
+	globalInflationPerClaimRat := encoding.LegacyDecToRat(globalInflationPerClaim)
 
 	// Do not process the reimbursement request if there is no global inflation.
-	if globalInflationPerClaim == 0 {
+	if globalInflationPerClaim.IsZero() {
 		logger.Warn("global inflation is set to zero. Skipping Global Mint Reimbursement Request TLM.")
 		return nil
 	}
@@ -59,14 +55,12 @@ func (tlm tlmGlobalMintReimbursementRequest) Process(
 		return tokenomicstypes.ErrTokenomicsCoinIsZero
 	}
 
-	newAppStake, err := application.Stake.SafeSub(newMintCoin)
 	// This should THEORETICALLY NEVER happen because `ensureClaimAmountLimits` should have handled it.
-	if err != nil {
+	if err := application.DeductSettledAmount(sessionHeader.GetSessionEndBlockHeight(), newMintCoin); err != nil {
 		logger.Error(fmt.Sprintf("SHOULD NEVER HAPPEN: application stake should never fall below zero. Trying to subtract %s from %s causing error: %v", newMintCoin, application.Stake, err))
 		return err
 	}
-	application.Stake = &newAppStake
-	logger.Info(fmt.Sprintf("updated application %q stake to %s", application.Address, newAppStake))
+	logger.Info(fmt.Sprintf("updated application %q stake to %s", application.Address, application.Stake))
 
 	// Send the global per claim mint inflation uPOKT from the tokenomics module
 	// account to PNF/DAO.
@@ -112,7 +106,7 @@ func (tlm tlmGlobalMintReimbursementRequest) Process(
 	}
 
 	eventManger := cosmostypes.UnwrapSDKContext(ctx).EventManager()
-	if err = eventManger.EmitTypedEvent(reimbursementRequestEvent); err != nil {
+	if err := eventManger.EmitTypedEvent(reimbursementRequestEvent); err != nil {
 		err = tokenomicstypes.ErrTokenomicsEmittingEventFailed.Wrapf(
 			"(%+v): %s",
 			reimbursementRequestEvent, err,
@@ -90,17 +90,18 @@ func (tlmbem *tlmRelayBurnEqualsMint) processApplicationBurn() error {
 	})
 	tlmbem.logger.Info(fmt.Sprintf("operation queued: burn (%v) from the application module account", tlmbem.tlmCtx.SettlementCoin))
 
-	// Update the application's onchain stake
-	newAppStake, err := tlmbem.tlmCtx.Application.Stake.SafeSub(tlmbem.tlmCtx.SettlementCoin)
+	// Update the application's onchain stake.
+	// The pending stake decreases requested during or after the claim's session
+	// still back it, so they are deducted before the stake.
+	sessionEndHeight := tlmbem.tlmCtx.SessionHeader.GetSessionEndBlockHeight()
 	// DEV_NOTE: This should never occur because:
 	//   1. Application over-servicing SHOULD be mitigated by the protocol.
 	//   2. tokenomicskeeper.Keeper#ensureClaimAmountLimits deducts the
 	//      overserviced amount from the claimable amount ("free work").
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsApplicationNewStakeInvalid.Wrapf("application %q stake cannot be reduced to a negative amount %v", tlmbem.tlmCtx.Application.Address, newAppStake)
+	if err := tlmbem.tlmCtx.Application.DeductSettledAmount(sessionEndHeight, tlmbem.tlmCtx.SettlementCoin); err != nil {
+		return tokenomicstypes.ErrTokenomicsApplicationNewStakeInvalid.Wrapf("application %q stake cannot be reduced to a negative amount: %s", tlmbem.tlmCtx.Application.Address, err)
 	}
-	tlmbem.tlmCtx.Application.Stake = &newAppStake
-	tlmbem.logger.Info(fmt.Sprintf("operation scheduled: update application %q stake to %v", tlmbem.tlmCtx.Application.Address, newAppStake))
+	tlmbem.logger.Info(fmt.Sprintf("operation scheduled: update application %q stake to %v", tlmbem.tlmCtx.Application.Address, tlmbem.tlmCtx.Application.Stake))
 
 	return nil
 }
@@ -114,13 +115,10 @@ func (tlmbem *tlmRelayBurnEqualsMint) processTokenomicsMint() error {
 	settlementAmount := tlmbem.tlmCtx.SettlementCoin.Amount
 
 	// PIP-41: Apply mint ratio for deflationary mechanism
-	mintRatio := tlmbem.tlmCtx.TokenomicsParams.GetMintRatio()
+	mintRatio := tlmbem.tlmCtx.TokenomicsParams.MintRatio
 
 	// Convert mint ratio to rational for precise calculation
-	mintRatioRat, err := encoding.Float64ToRat(mintRatio)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting mint_ratio: %v", err)
-	}
+	mintRatioRat := encoding.LegacyDecToRat(mintRatio)
 
 	// Calculate minted amount = settlement * mint_ratio
 	mintAmountRat := new(big.Rat).Mul(
@@ -172,11 +170,7 @@ func (tlmbem *tlmRelayBurnEqualsMint) processRewardDistribution() error {
 
 	// PIP-41: Apply mint ratio to get the actual amount to distribute
 	// This must match the amount minted in processTokenomicsMint()
-	mintRatio := tlmbem.tlmCtx.TokenomicsParams.GetMintRatio()
-	mintRatioRat, err := encoding.Float64ToRat(mintRatio)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting mint_ratio: %v", err)
-	}
+	mintRatioRat := encoding.LegacyDecToRat(tlmbem.tlmCtx.TokenomicsParams.MintRatio)
 	mintAmountRat := new(big.Rat).Mul(
 		new(big.Rat).SetInt(settlementAmount.BigInt()),
 		mintRatioRat,
@@ -187,31 +181,19 @@ func (tlmbem *tlmRelayBurnEqualsMint) processRewardDistribution() error {
 	// Calculate how much each participant gets from the MINTED amount (not burned amount)
 
 	// Calculate supplier allocation
-	supplierAllocationRat, err := encoding.Float64ToRat(mintEqualsBurnClaimDistribution.Supplier)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting supplier allocation percentage: %v", err)
-	}
+	supplierAllocationRat := encoding.LegacyDecToRat(mintEqualsBurnClaimDistribution.Supplier)
 	supplierAmount := calculateAllocationAmount(distributionAmount, supplierAllocationRat)
 
 	// Calculate proposer allocation
-	proposerAllocationRat, err := encoding.Float64ToRat(mintEqualsBurnClaimDistribution.Proposer)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting proposer allocation percentage: %v", err)
-	}
+	proposerAllocationRat := encoding.LegacyDecToRat(mintEqualsBurnClaimDistribution.Proposer)
 	proposerAmount := calculateAllocationAmount(distributionAmount, proposerAllocationRat)
 
 	// Calculate source owner allocation
-	sourceOwnerAllocationRat, err := encoding.Float64ToRat(mintEqualsBurnClaimDistribution.SourceOwner)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting source owner allocation percentage: %v", err)
-	}
+	sourceOwnerAllocationRat := encoding.LegacyDecToRat(mintEqualsBurnClaimDistribution.SourceOwner)
 	sourceOwnerAmount := calculateAllocationAmount(distributionAmount, sourceOwnerAllocationRat)
 
 	// Calculate application allocation
-	applicationAllocationRat, err := encoding.Float64ToRat(mintEqualsBurnClaimDistribution.Application)
-	if err != nil {
-		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf("error converting application allocation percentage: %v", err)
-	}
+	applicationAllocationRat := encoding.LegacyDecToRat(mintEqualsBurnClaimDistribution.Application)
 	applicationAmount := calculateAllocationAmount(distributionAmount, applicationAllocationRat)
 
 	// DAO gets the remainder to ensure all MINTED tokens are distributed
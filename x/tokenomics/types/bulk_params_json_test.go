@@ -19,7 +19,7 @@ import (
 // MsgUpdateParams REPLACES the whole params struct, so a field missing from one of
 // these files is silently written as its proto3 zero value on a live chain. That has
 // already bitten twice:
-//   - mint_ratio omitted => 0, which ValidateMintRatio rejects (fails loud, but the
+//   - mint_ratio omitted => unset, which ValidateMintRatio rejects (fails loud, but the
 //     file is unusable until fixed).
 //   - overservicing_bonus_multiplier omitted => 0, which settlement coerces to 1 —
 //     silently reverting settlement budget redistribution to OFF after governance
@@ -34,7 +34,7 @@ func TestBulkParamsJSON_RoundTripsAndValidates(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, networkDirs, "no bulk_params_* directories found under %s", paramsDir)
 
-	// Same proto-JSON codec the tx decoder uses, so uint64-as-string and double
+	// Same proto-JSON codec the tx decoder uses, so uint64-as-string and decimal-as-string
 	// encodings are exercised exactly as they will be when the tx is submitted.
 	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 
@@ -47,6 +47,7 @@ func TestBulkParamsJSON_RoundTripsAndValidates(t *testing.T) {
 		"mint_equals_burn_claim_distribution",
 		"mint_ratio",
 		"overservicing_bonus_multiplier",
+		"settlement_history_retention_blocks",
 	}
 
 	for _, networkDir := range networkDirs {
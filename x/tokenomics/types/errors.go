@@ -42,4 +42,7 @@ var (
 	ErrTokenomicsSettlementMint     = sdkerrors.Register(ModuleName, 1121, "failed to mint uPOKT while executing settlement state transitions")
 	ErrTokenomicsSettlementBurn     = sdkerrors.Register(ModuleName, 1122, "failed to burn uPOKT while executing settlement state transitions")
 	ErrTokenomicsSettlementTransfer = sdkerrors.Register(ModuleName, 1123, "failed to send coins while executing settlement state transitions")
+
+	// Query errors
+	ErrTokenomicsInvalidQueryRequest = sdkerrors.Register(ModuleName, 1124, "invalid query request")
 )
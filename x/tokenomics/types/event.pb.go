@@ -590,6 +590,11 @@ type EventSupplierSlashed struct {
 	// the post-slash stake directly instead of subtracting proof_missing_penalty
 	// from a cached prior stake, removing dependence on indexer cache accuracy.
 	SupplierStakeAfterSlash string `protobuf:"bytes,9,opt,name=supplier_stake_after_slash,json=supplierStakeAfterSlash,proto3" json:"supplier_stake_after_slash"`
+	// The proof_missing_penalty_mode param used to compute proof_missing_penalty.
+	ProofMissingPenaltyMode string `protobuf:"bytes,10,opt,name=proof_missing_penalty_mode,json=proofMissingPenaltyMode,proto3" json:"proof_missing_penalty_mode"`
+	// The uPOKT value of the claim the supplier failed to prove, from which
+	// proof_missing_penalty is computed in the claim proportional modes.
+	ClaimedUpokt string `protobuf:"bytes,11,opt,name=claimed_upokt,json=claimedUpokt,proto3" json:"claimed_upokt"`
 }
 
 func (m *EventSupplierSlashed) Reset()         { *m = EventSupplierSlashed{} }
@@ -670,6 +675,20 @@ func (m *EventSupplierSlashed) GetSupplierStakeAfterSlash() string {
 	return ""
 }
 
+func (m *EventSupplierSlashed) GetProofMissingPenaltyMode() string {
+	if m != nil {
+		return m.ProofMissingPenaltyMode
+	}
+	return ""
+}
+
+func (m *EventSupplierSlashed) GetClaimedUpokt() string {
+	if m != nil {
+		return m.ClaimedUpokt
+	}
+	return ""
+}
+
 // EventClaimDiscarded is emitted when a claim is discarded due to unexpected situations.
 // It is used to prevent chain halts in favor of some missing claims.
 type EventClaimDiscarded struct {
@@ -1349,121 +1368,122 @@ func init() {
 func init() { proto.RegisterFile("pocket/tokenomics/event.proto", fileDescriptor_146818b9f891ddf6) }
 
 var fileDescriptor_146818b9f891ddf6 = []byte{
-	// 1813 bytes of a gzipped FileDescriptorProto
+	// 1834 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0x25, 0x59, 0x96, 0xc6, 0x92, 0x97, 0xa6, 0x65, 0x9b, 0xf6, 0xda, 0x96, 0x57, 0xbb,
-	0x41, 0x9d, 0x16, 0xb1, 0x8b, 0xa4, 0x48, 0x8b, 0xc5, 0x16, 0x85, 0x15, 0x2b, 0xad, 0x8c, 0xc4,
-	0x76, 0x47, 0x49, 0xb0, 0x68, 0x81, 0xb2, 0x34, 0xf9, 0x6c, 0x13, 0x26, 0x39, 0x5c, 0xce, 0xd0,
-	0x89, 0xbf, 0x41, 0x8f, 0x05, 0xf6, 0x4b, 0xf4, 0x5a, 0xa0, 0x3d, 0xf6, 0xbe, 0xc7, 0x45, 0x81,
-	0x02, 0x7b, 0x52, 0xbb, 0xc9, 0x4d, 0x1f, 0xa0, 0xe7, 0x62, 0x66, 0xf8, 0x4f, 0x96, 0x2c, 0xa7,
-	0x42, 0x7a, 0xea, 0x6d, 0xe6, 0xfd, 0xde, 0x7b, 0x7c, 0x33, 0xef, 0x37, 0xef, 0xcd, 0x10, 0x6d,
-	0x06, 0xc4, 0xba, 0x04, 0xb6, 0xc7, 0xc8, 0x25, 0xf8, 0xc4, 0x73, 0x2c, 0xba, 0x07, 0x57, 0xe0,
-	0xb3, 0xdd, 0x20, 0x24, 0x8c, 0x68, 0x8b, 0x12, 0xde, 0xcd, 0xe0, 0xf5, 0x35, 0x8b, 0x50, 0x8f,
-	0x50, 0x43, 0x28, 0xec, 0xc9, 0x89, 0xd4, 0x5e, 0x6f, 0x9c, 0x93, 0x73, 0x22, 0xe5, 0x7c, 0x14,
-	0x4b, 0xf5, 0xf8, 0x13, 0x41, 0x48, 0xc8, 0xd9, 0x1e, 0xbb, 0x0e, 0x20, 0xd1, 0x1f, 0xf3, 0xf1,
-	0x1c, 0xdc, 0xfa, 0x53, 0x19, 0x2d, 0x76, 0x78, 0x30, 0x4f, 0x5c, 0xd3, 0xf1, 0x3a, 0x6f, 0x02,
-	0x27, 0x04, 0x5b, 0x73, 0xd1, 0x22, 0xf0, 0xa1, 0xc9, 0x1c, 0xe2, 0x1b, 0x21, 0x98, 0x94, 0xf8,
-	0x7a, 0x61, 0x5b, 0xd9, 0x59, 0x78, 0xb8, 0xb3, 0x3b, 0x12, 0xee, 0x6e, 0x66, 0x2b, 0x0c, 0xb0,
-	0xd0, 0x6f, 0x2f, 0x0f, 0xfa, 0xcd, 0x51, 0x37, 0x58, 0x85, 0x1b, 0x8a, 0xda, 0x03, 0x84, 0xfc,
-	0xc8, 0x33, 0x42, 0x70, 0xcd, 0x6b, 0xaa, 0x17, 0xb7, 0x95, 0x9d, 0x52, 0x7b, 0x61, 0xd0, 0x6f,
-	0xe6, 0xa4, 0xb8, 0xea, 0x47, 0x1e, 0x16, 0x43, 0xed, 0x4b, 0xb4, 0xc6, 0x01, 0x8b, 0x7f, 0x14,
-	0x6c, 0xc3, 0x22, 0x5e, 0x10, 0x31, 0x30, 0x22, 0xdf, 0x61, 0x54, 0x2f, 0x09, 0xeb, 0xcd, 0x41,
-	0xbf, 0x79, 0xbb, 0x12, 0x5e, 0xf1, 0x23, 0xef, 0x89, 0x44, 0x9e, 0x48, 0xe0, 0x25, 0x97, 0x6b,
-	0xbf, 0x43, 0x1f, 0x73, 0x23, 0xa0, 0xcc, 0xf1, 0x4c, 0x36, 0xe2, 0x7b, 0x56, 0xf8, 0x6e, 0x0e,
-	0xfa, 0xcd, 0x49, 0x6a, 0x58, 0xf7, 0x23, 0xaf, 0x93, 0x60, 0x43, 0xfe, 0x1f, 0xa3, 0x7a, 0x12,
-	0x50, 0x14, 0x90, 0x4b, 0xa6, 0xcf, 0x6d, 0x2b, 0x3b, 0xd5, 0xf6, 0xe2, 0xa0, 0xdf, 0x1c, 0x06,
-	0x70, 0x2d, 0x9e, 0xbe, 0xe4, 0x33, 0x6d, 0x13, 0x21, 0x0a, 0xe1, 0x95, 0x63, 0x81, 0xe1, 0xd8,
-	0x7a, 0x85, 0x1b, 0xe1, 0x6a, 0x2c, 0xe9, 0xda, 0x5a, 0x17, 0x2d, 0x99, 0x41, 0xe0, 0x3a, 0x96,
-	0xdc, 0x67, 0xd3, 0xb6, 0x43, 0xa0, 0x54, 0xaf, 0x0a, 0xe7, 0xfa, 0xdf, 0xff, 0xf2, 0xa0, 0x11,
-	0x33, 0x68, 0x5f, 0x22, 0x3d, 0x16, 0x3a, 0xfe, 0x39, 0xd6, 0x72, 0x46, 0x31, 0xa2, 0xfd, 0x14,
-	0xe9, 0x14, 0x28, 0xe5, 0x6e, 0xc0, 0xb7, 0x8d, 0x53, 0x97, 0x58, 0x97, 0xc6, 0x05, 0x38, 0xe7,
-	0x17, 0x4c, 0x47, 0xdb, 0xca, 0x4e, 0x11, 0x2f, 0xc7, 0x78, 0xc7, 0xb7, 0xdb, 0x1c, 0xfd, 0x95,
-	0x00, 0xb5, 0x47, 0x68, 0x45, 0x84, 0x6c, 0x08, 0x06, 0x1a, 0x94, 0x99, 0x2c, 0xa2, 0x86, 0xe3,
-	0x33, 0x7d, 0x7e, 0x5b, 0xd9, 0x99, 0xc5, 0x4b, 0x02, 0x3d, 0xe1, 0x60, 0x4f, 0x60, 0x5d, 0x9f,
-	0x69, 0x2f, 0xd0, 0x1a, 0x8d, 0x78, 0x10, 0x10, 0x1a, 0x24, 0x80, 0xd0, 0x64, 0x24, 0x4c, 0xc3,
-	0xaf, 0xdd, 0x11, 0xfe, 0x6a, 0x62, 0x7a, 0x1c, 0x5b, 0x26, 0x6b, 0x38, 0x44, 0x8d, 0xe1, 0xf4,
-	0xc4, 0xc4, 0xaa, 0x8b, 0xf4, 0xe9, 0x83, 0x7e, 0x73, 0x2c, 0x8e, 0xb5, 0x7c, 0xde, 0x24, 0xd7,
-	0x0e, 0x4b, 0x15, 0x45, 0x2d, 0x1c, 0x96, 0x2a, 0x65, 0x75, 0xae, 0xf5, 0xd7, 0x5a, 0xfe, 0xa8,
-	0xf4, 0x80, 0x31, 0x17, 0x6c, 0xed, 0x21, 0x5a, 0x96, 0x4b, 0x0e, 0xe1, 0xab, 0xc8, 0x09, 0xc1,
-	0x03, 0x9f, 0x89, 0x75, 0x17, 0xe4, 0xba, 0x05, 0x88, 0x33, 0x8c, 0xaf, 0xfb, 0xff, 0x97, 0xf0,
-	0x95, 0x69, 0x08, 0x5f, 0x7d, 0x4f, 0xc2, 0xa3, 0x0f, 0x4c, 0xf8, 0xf9, 0xe9, 0x08, 0x5f, 0x9b,
-	0x92, 0xf0, 0xf5, 0x69, 0x09, 0xff, 0x07, 0x05, 0x2d, 0x85, 0xf0, 0xda, 0x0c, 0x6d, 0xc3, 0x76,
-	0x28, 0x0b, 0x9d, 0xd3, 0x88, 0x2f, 0x51, 0x5f, 0xd8, 0x2e, 0xee, 0xcc, 0x3f, 0xfc, 0x62, 0x4c,
-	0xc1, 0x1e, 0xa1, 0xf1, 0x2e, 0x16, 0xf6, 0x07, 0x39, 0xf3, 0x8e, 0xcf, 0xc2, 0xeb, 0xf6, 0xda,
-	0x37, 0xfd, 0xe6, 0x0c, 0x2f, 0xe4, 0x9e, 0xe3, 0xb3, 0x21, 0xf7, 0x58, 0x0b, 0x47, 0x6c, 0xb4,
-	0xaf, 0x15, 0xb4, 0x31, 0x26, 0x14, 0xc3, 0x06, 0x66, 0x3a, 0x2e, 0xd8, 0xfa, 0x47, 0x22, 0xa6,
-	0x1f, 0x8d, 0x89, 0x69, 0x34, 0x82, 0x03, 0x61, 0xd4, 0xfe, 0x2c, 0x0e, 0x61, 0xa2, 0x63, 0xbc,
-	0x1e, 0xde, 0x62, 0x0f, 0x36, 0xa7, 0x21, 0x95, 0xeb, 0x8c, 0x69, 0xa8, 0x66, 0x34, 0x1c, 0x02,
-	0x70, 0x2d, 0x9e, 0x4a, 0x1a, 0x3e, 0x40, 0x48, 0x2c, 0x5b, 0x74, 0x2b, 0x7d, 0x51, 0x18, 0x89,
-	0x73, 0x9a, 0x49, 0x71, 0x95, 0x8f, 0x31, 0x1f, 0x72, 0xf5, 0x84, 0x4b, 0x8e, 0xad, 0x6b, 0x99,
-	0x7a, 0x26, 0xe5, 0x2c, 0x16, 0xe3, 0xae, 0xad, 0x5d, 0xa0, 0x95, 0x8c, 0x0c, 0xaf, 0x7d, 0xc8,
-	0x98, 0xb0, 0x24, 0x4c, 0x1f, 0x0e, 0xfa, 0xcd, 0x5b, 0x34, 0x6e, 0xe5, 0x48, 0x23, 0xe5, 0x08,
-	0x57, 0xbf, 0xab, 0x22, 0x36, 0xfe, 0xfb, 0x8a, 0xa8, 0x3d, 0x42, 0x35, 0xbe, 0xe2, 0x74, 0x2b,
-	0x97, 0x45, 0xac, 0xea, 0xa0, 0xdf, 0x1c, 0x92, 0xe3, 0x79, 0x39, 0x93, 0x1b, 0xf9, 0x0a, 0xe9,
-	0xe4, 0x0a, 0x42, 0x79, 0x82, 0x1d, 0xff, 0xdc, 0x70, 0x09, 0xa5, 0xb1, 0x83, 0x15, 0xe1, 0x60,
-	0x63, 0xd0, 0x6f, 0xde, 0xaa, 0x83, 0x57, 0x86, 0x90, 0x67, 0x84, 0x52, 0xe9, 0xf7, 0x10, 0x35,
-	0x6c, 0x38, 0x73, 0x65, 0x19, 0xc8, 0xf9, 0x5c, 0x95, 0x47, 0x89, 0x2f, 0x6c, 0x1c, 0x8e, 0xb5,
-	0x54, 0x9a, 0xfa, 0x5a, 0xef, 0xa0, 0xd5, 0x5b, 0x0e, 0x81, 0xa6, 0xa2, 0xe2, 0x25, 0x5c, 0xeb,
-	0x8a, 0xa8, 0x43, 0x7c, 0xa8, 0x35, 0xd0, 0xec, 0x95, 0xe9, 0x46, 0x20, 0xaa, 0x7c, 0x15, 0xcb,
-	0xc9, 0xe7, 0x85, 0x9f, 0x29, 0xf9, 0x8e, 0x71, 0x58, 0xaa, 0xcc, 0xa9, 0x95, 0xd6, 0xf7, 0x05,
-	0xb4, 0x21, 0x0e, 0xdc, 0x7e, 0x56, 0x7e, 0x8e, 0xd3, 0xf5, 0x80, 0xad, 0xdd, 0x47, 0xea, 0xcd,
-	0x72, 0x16, 0x7f, 0xeb, 0xa3, 0x1b, 0x15, 0x4b, 0xfb, 0x49, 0x9e, 0x33, 0xf9, 0x02, 0x12, 0x07,
-	0xd2, 0x18, 0x57, 0x23, 0xb4, 0x4f, 0x51, 0x1d, 0xde, 0x04, 0x60, 0xf1, 0xec, 0x9c, 0x46, 0xa1,
-	0x2f, 0x0a, 0x7b, 0x15, 0xd7, 0x12, 0x61, 0x3b, 0x0a, 0x7d, 0xed, 0x1e, 0x5a, 0x80, 0xb3, 0x33,
-	0xb0, 0x98, 0x73, 0x05, 0x52, 0xab, 0x2c, 0xb4, 0xea, 0xa9, 0x54, 0xa8, 0x0d, 0x97, 0xe6, 0xb9,
-	0x9b, 0xa5, 0x79, 0x52, 0x3d, 0xad, 0x4c, 0xaa, 0xa7, 0x3f, 0x46, 0x0d, 0x1a, 0x70, 0x13, 0xd7,
-	0xf1, 0x1c, 0x66, 0xc0, 0x1b, 0x0b, 0xc0, 0x06, 0x59, 0xfc, 0x2b, 0x58, 0x13, 0xd8, 0x33, 0x0e,
-	0x75, 0x62, 0xe4, 0xb0, 0x54, 0x29, 0xaa, 0xa5, 0xc3, 0x52, 0xa5, 0xa4, 0xce, 0xb6, 0xbe, 0x2f,
-	0xa2, 0x86, 0xd8, 0xe3, 0x5e, 0xbc, 0xfe, 0x9e, 0x6b, 0xd2, 0x8b, 0x7c, 0x7b, 0xf6, 0x1c, 0x4a,
-	0x39, 0xab, 0x02, 0xf0, 0x4d, 0x97, 0x5d, 0x8b, 0xae, 0x5b, 0x8d, 0xdb, 0xf3, 0x73, 0x89, 0x9d,
-	0x48, 0xe8, 0xc6, 0x12, 0x4b, 0xef, 0xd9, 0x7d, 0x66, 0x3f, 0x70, 0xf7, 0x29, 0x4f, 0xd7, 0x7d,
-	0xe6, 0xa6, 0xec, 0x3e, 0x95, 0x69, 0xbb, 0xcf, 0x6f, 0xd1, 0x7a, 0xea, 0x95, 0x32, 0xf3, 0x12,
-	0x0c, 0xf3, 0x8c, 0xf1, 0x31, 0xcf, 0x40, 0x7c, 0x09, 0xdd, 0x1a, 0xf4, 0x9b, 0x13, 0xb4, 0x32,
-	0xe7, 0x3d, 0x0e, 0xed, 0x73, 0x44, 0x24, 0x30, 0x3d, 0x4d, 0x05, 0xb5, 0xd8, 0xfa, 0x67, 0x01,
-	0x2d, 0x65, 0x8d, 0xeb, 0xc0, 0xa1, 0x96, 0x19, 0xda, 0x60, 0xf3, 0xb3, 0x08, 0x61, 0x48, 0x92,
-	0x23, 0x20, 0x27, 0x37, 0x92, 0x58, 0x7c, 0xcf, 0x24, 0x96, 0x3e, 0x70, 0x12, 0x67, 0xa7, 0x4b,
-	0x62, 0x79, 0xca, 0x24, 0xce, 0x4d, 0x99, 0x44, 0xb9, 0xcf, 0xad, 0xbf, 0x29, 0x48, 0xbf, 0xad,
-	0x0d, 0x6b, 0x1d, 0xb4, 0x18, 0x82, 0xe5, 0x04, 0x0e, 0xbf, 0xe0, 0x26, 0x1f, 0x54, 0xee, 0xf8,
-	0xa0, 0x9a, 0x9a, 0x24, 0xbb, 0xd5, 0x46, 0x55, 0x12, 0x0c, 0x3f, 0x29, 0xef, 0x8d, 0xb9, 0x0d,
-	0xc8, 0x7b, 0x09, 0xbf, 0x30, 0x1f, 0x07, 0xf2, 0x99, 0x88, 0x2b, 0x24, 0x1e, 0x69, 0x2b, 0xa8,
-	0x6c, 0x7a, 0x24, 0xf2, 0x59, 0x9c, 0xd7, 0x78, 0xd6, 0xfa, 0x73, 0x21, 0xa9, 0x02, 0xa9, 0x75,
-	0xdb, 0x64, 0xd6, 0xc5, 0xc4, 0x14, 0x29, 0x93, 0x52, 0xf4, 0x29, 0xbf, 0x39, 0xf8, 0x36, 0x84,
-	0x86, 0x47, 0xec, 0xc8, 0x4d, 0xea, 0x7d, 0x4d, 0x0a, 0x9f, 0x0b, 0x99, 0xb6, 0x81, 0xaa, 0xe9,
-	0x32, 0x13, 0xa6, 0xa5, 0x82, 0xe1, 0x05, 0x97, 0xa6, 0x5b, 0xf0, 0x27, 0xa8, 0xc6, 0x08, 0x33,
-	0x5d, 0x23, 0x5e, 0xb6, 0xac, 0xdf, 0xf3, 0x42, 0xb6, 0x2f, 0x44, 0x9c, 0xef, 0xe9, 0xfd, 0x9f,
-	0x0a, 0x02, 0xd5, 0xc5, 0x1b, 0x42, 0x1c, 0x16, 0xaa, 0xad, 0xa2, 0x39, 0x12, 0x18, 0xfc, 0xe5,
-	0x1f, 0xd7, 0xec, 0x32, 0x09, 0x5e, 0x5c, 0x07, 0xd0, 0xfa, 0x77, 0x09, 0x6d, 0x8b, 0x3d, 0x7b,
-	0x65, 0xba, 0x8e, 0xcd, 0x39, 0x31, 0xca, 0x80, 0xe9, 0xf7, 0xef, 0x43, 0x64, 0xfb, 0x0b, 0xb4,
-	0x7e, 0x95, 0xc4, 0x36, 0x4a, 0x79, 0xb9, 0xdf, 0x7a, 0xaa, 0x71, 0xb3, 0x3c, 0x7d, 0x8e, 0xd6,
-	0x32, 0x6b, 0xd3, 0xb2, 0xf8, 0x66, 0x0d, 0x1f, 0x77, 0xbc, 0x9a, 0x2a, 0xec, 0x4b, 0x3c, 0xb1,
-	0xfd, 0x01, 0xfa, 0xc8, 0x22, 0x9e, 0xe8, 0x1c, 0xc4, 0xe7, 0xf7, 0x3d, 0x88, 0x77, 0x7e, 0x21,
-	0x13, 0x63, 0x93, 0x81, 0xb6, 0x83, 0xd4, 0x80, 0x10, 0xd7, 0xa0, 0x17, 0x66, 0x08, 0xf1, 0x1d,
-	0x44, 0x76, 0xcf, 0x05, 0x2e, 0xef, 0x71, 0xb1, 0xbc, 0xb1, 0xdc, 0x47, 0x6a, 0xce, 0x65, 0xee,
-	0x2f, 0x00, 0xce, 0x7d, 0x4a, 0xaa, 0xfe, 0x02, 0x6d, 0x50, 0x70, 0xcf, 0x0c, 0x1b, 0x5c, 0x38,
-	0x4f, 0x7e, 0xa1, 0x88, 0x1b, 0x70, 0xee, 0x2d, 0x85, 0xd7, 0xb8, 0xce, 0x41, 0xaa, 0x22, 0x93,
-	0x27, 0x1d, 0x3c, 0x46, 0xab, 0xb1, 0x2d, 0x09, 0xe9, 0xb0, 0xad, 0x7c, 0x52, 0x2d, 0x67, 0x70,
-	0xde, 0xee, 0xe7, 0xe8, 0x63, 0xc9, 0xb6, 0x18, 0x06, 0x3b, 0x2e, 0xd9, 0xd2, 0x16, 0xc9, 0x1d,
-	0x17, 0x2a, 0x07, 0x89, 0x86, 0x28, 0xdc, 0xd2, 0xfc, 0x1e, 0x5a, 0xe0, 0x4c, 0xcc, 0x7c, 0x8b,
-	0x87, 0x54, 0x1d, 0xd7, 0xfd, 0xc8, 0x3b, 0x48, 0x85, 0xad, 0x7f, 0x14, 0xd1, 0xfd, 0xa1, 0x96,
-	0x8d, 0xe1, 0x4a, 0x6c, 0xd4, 0x53, 0xd3, 0x75, 0x4f, 0x4d, 0xeb, 0x72, 0x88, 0x81, 0x13, 0xcb,
-	0x9e, 0x32, 0x6d, 0xef, 0x3a, 0xba, 0xf5, 0x0a, 0x5e, 0xb8, 0xc3, 0xe5, 0xf8, 0x8b, 0xf6, 0x1d,
-	0x4d, 0x67, 0xd2, 0x31, 0x2a, 0x4d, 0x3a, 0x46, 0x59, 0xc1, 0x9b, 0xcd, 0x17, 0xbc, 0xe1, 0xe3,
-	0x55, 0x9e, 0xee, 0x78, 0x3d, 0x46, 0xab, 0xe4, 0x94, 0xc7, 0xc8, 0xd3, 0x1c, 0x79, 0x46, 0x00,
-	0xa1, 0x05, 0x3e, 0x33, 0xcf, 0x65, 0xa5, 0x28, 0xe1, 0xe5, 0x04, 0xee, 0x45, 0xde, 0x49, 0x0a,
-	0xf2, 0x98, 0xe2, 0x0f, 0x4b, 0x22, 0xc6, 0xb3, 0xd6, 0xd7, 0x05, 0xf4, 0xd9, 0xcd, 0xeb, 0x2e,
-	0x06, 0xc7, 0x3b, 0x8d, 0x42, 0x2a, 0x62, 0xc0, 0xf0, 0x55, 0x04, 0x94, 0xfd, 0xef, 0xaf, 0xbd,
-	0xbb, 0x68, 0x69, 0x4c, 0x76, 0xe3, 0xb4, 0x2c, 0x8e, 0x24, 0xf0, 0xae, 0x7b, 0xdf, 0xe6, 0xd0,
-	0xf3, 0x6e, 0x36, 0x81, 0x93, 0xe7, 0x5c, 0x96, 0xa3, 0xb9, 0x7c, 0x8e, 0xe4, 0x53, 0xe0, 0x87,
-	0xbf, 0x47, 0xcb, 0x63, 0xff, 0x92, 0x6a, 0x9f, 0xa0, 0xcd, 0xce, 0x97, 0x27, 0x5d, 0xbc, 0xff,
-	0xa2, 0x7b, 0x7c, 0x64, 0xe0, 0xce, 0x7e, 0xef, 0xf8, 0xc8, 0x78, 0x79, 0xd4, 0x3b, 0xe9, 0x3c,
-	0xe9, 0x3e, 0xed, 0x76, 0x0e, 0xd4, 0x19, 0x6d, 0x11, 0xd5, 0x4f, 0xf0, 0xf1, 0xf1, 0x53, 0xe3,
-	0x79, 0xb7, 0xd7, 0xeb, 0x1e, 0xfd, 0x52, 0x55, 0x32, 0x51, 0xf7, 0xe8, 0xd5, 0xfe, 0xb3, 0xee,
-	0x81, 0x5a, 0x68, 0xff, 0xfa, 0x9b, 0xb7, 0x5b, 0xca, 0xb7, 0x6f, 0xb7, 0x94, 0xef, 0xde, 0x6e,
-	0x29, 0xff, 0x7a, 0xbb, 0xa5, 0xfc, 0xf1, 0xdd, 0xd6, 0xcc, 0xb7, 0xef, 0xb6, 0x66, 0xbe, 0x7b,
-	0xb7, 0x35, 0xf3, 0x9b, 0x47, 0xe7, 0x0e, 0xbb, 0x88, 0x4e, 0x77, 0x2d, 0xe2, 0xed, 0xf1, 0x53,
-	0xfa, 0xc0, 0x07, 0xf6, 0x9a, 0x84, 0x97, 0x62, 0x12, 0x12, 0xd7, 0xdd, 0x7b, 0x33, 0xf2, 0x8b,
-	0xf8, 0xb4, 0x2c, 0xfe, 0x11, 0x3f, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x50, 0x16,
-	0xe5, 0xc1, 0x16, 0x00, 0x00,
+	0x15, 0x37, 0x65, 0x59, 0x96, 0xc6, 0x92, 0x43, 0xd3, 0xb2, 0x4d, 0x7b, 0x6d, 0xcb, 0xab, 0xdd,
+	0xa0, 0x4e, 0x8b, 0xd8, 0x45, 0x52, 0xa4, 0xc5, 0x62, 0x8b, 0xc2, 0x8a, 0x95, 0x56, 0x46, 0x62,
+	0xbb, 0xa3, 0x24, 0x58, 0xb4, 0x40, 0x59, 0x9a, 0x7c, 0xb6, 0x09, 0x93, 0x1c, 0x96, 0x33, 0x74,
+	0xe2, 0x6f, 0xd0, 0x63, 0x81, 0xfd, 0x12, 0xbd, 0x16, 0x68, 0x8f, 0xbd, 0xef, 0x71, 0x51, 0xa0,
+	0xc0, 0x9e, 0xd4, 0x36, 0xb9, 0xe9, 0x03, 0xf4, 0x5c, 0xcc, 0x0c, 0xff, 0xc9, 0xfa, 0xe3, 0x54,
+	0x48, 0x4f, 0xbd, 0xcd, 0xbc, 0xdf, 0x7b, 0x8f, 0x6f, 0xe6, 0xfd, 0xe6, 0xbd, 0x19, 0xa2, 0xad,
+	0x80, 0x58, 0x57, 0xc0, 0xf6, 0x19, 0xb9, 0x02, 0x9f, 0x78, 0x8e, 0x45, 0xf7, 0xe1, 0x1a, 0x7c,
+	0xb6, 0x17, 0x84, 0x84, 0x11, 0x6d, 0x49, 0xc2, 0x7b, 0x19, 0xbc, 0xb1, 0x6e, 0x11, 0xea, 0x11,
+	0x6a, 0x08, 0x85, 0x7d, 0x39, 0x91, 0xda, 0x1b, 0xf5, 0x0b, 0x72, 0x41, 0xa4, 0x9c, 0x8f, 0x62,
+	0xa9, 0x1e, 0x7f, 0x22, 0x08, 0x09, 0x39, 0xdf, 0x67, 0x37, 0x01, 0x24, 0xfa, 0x23, 0x3e, 0x9e,
+	0x83, 0x9b, 0x7f, 0x2c, 0xa1, 0xa5, 0x36, 0x0f, 0xe6, 0xa9, 0x6b, 0x3a, 0x5e, 0xfb, 0x6d, 0xe0,
+	0x84, 0x60, 0x6b, 0x2e, 0x5a, 0x02, 0x3e, 0x34, 0x99, 0x43, 0x7c, 0x23, 0x04, 0x93, 0x12, 0x5f,
+	0x2f, 0xec, 0x28, 0xbb, 0x8b, 0x8f, 0x76, 0xf7, 0x86, 0xc2, 0xdd, 0xcb, 0x6c, 0x85, 0x01, 0x16,
+	0xfa, 0xad, 0x95, 0x7e, 0xaf, 0x31, 0xec, 0x06, 0xab, 0x70, 0x4b, 0x51, 0x7b, 0x88, 0x90, 0x1f,
+	0x79, 0x46, 0x08, 0xae, 0x79, 0x43, 0xf5, 0xd9, 0x1d, 0x65, 0xb7, 0xd8, 0x5a, 0xec, 0xf7, 0x1a,
+	0x39, 0x29, 0xae, 0xf8, 0x91, 0x87, 0xc5, 0x50, 0xfb, 0x0a, 0xad, 0x73, 0xc0, 0xe2, 0x1f, 0x05,
+	0xdb, 0xb0, 0x88, 0x17, 0x44, 0x0c, 0x8c, 0xc8, 0x77, 0x18, 0xd5, 0x8b, 0xc2, 0x7a, 0xab, 0xdf,
+	0x6b, 0x8c, 0x57, 0xc2, 0xab, 0x7e, 0xe4, 0x3d, 0x95, 0xc8, 0x53, 0x09, 0xbc, 0xe2, 0x72, 0xed,
+	0x37, 0xe8, 0x13, 0x6e, 0x04, 0x94, 0x39, 0x9e, 0xc9, 0x86, 0x7c, 0xcf, 0x09, 0xdf, 0x8d, 0x7e,
+	0xaf, 0x31, 0x49, 0x0d, 0xeb, 0x7e, 0xe4, 0xb5, 0x13, 0x6c, 0xc0, 0xff, 0x13, 0x54, 0x4b, 0x02,
+	0x8a, 0x02, 0x72, 0xc5, 0xf4, 0xf9, 0x1d, 0x65, 0xb7, 0xd2, 0x5a, 0xea, 0xf7, 0x1a, 0x83, 0x00,
+	0xae, 0xc6, 0xd3, 0x57, 0x7c, 0xa6, 0x6d, 0x21, 0x44, 0x21, 0xbc, 0x76, 0x2c, 0x30, 0x1c, 0x5b,
+	0x2f, 0x73, 0x23, 0x5c, 0x89, 0x25, 0x1d, 0x5b, 0xeb, 0xa0, 0x65, 0x33, 0x08, 0x5c, 0xc7, 0x92,
+	0xfb, 0x6c, 0xda, 0x76, 0x08, 0x94, 0xea, 0x15, 0xe1, 0x5c, 0xff, 0xdb, 0x9f, 0x1f, 0xd6, 0x63,
+	0x06, 0x1d, 0x48, 0xa4, 0xcb, 0x42, 0xc7, 0xbf, 0xc0, 0x5a, 0xce, 0x28, 0x46, 0xb4, 0x1f, 0x23,
+	0x9d, 0x02, 0xa5, 0xdc, 0x0d, 0xf8, 0xb6, 0x71, 0xe6, 0x12, 0xeb, 0xca, 0xb8, 0x04, 0xe7, 0xe2,
+	0x92, 0xe9, 0x68, 0x47, 0xd9, 0x9d, 0xc5, 0x2b, 0x31, 0xde, 0xf6, 0xed, 0x16, 0x47, 0x7f, 0x21,
+	0x40, 0xed, 0x31, 0x5a, 0x15, 0x21, 0x1b, 0x82, 0x81, 0x06, 0x65, 0x26, 0x8b, 0xa8, 0xe1, 0xf8,
+	0x4c, 0x5f, 0xd8, 0x51, 0x76, 0xe7, 0xf0, 0xb2, 0x40, 0x4f, 0x39, 0xd8, 0x15, 0x58, 0xc7, 0x67,
+	0xda, 0x4b, 0xb4, 0x4e, 0x23, 0x1e, 0x04, 0x84, 0x06, 0x09, 0x20, 0x34, 0x19, 0x09, 0xd3, 0xf0,
+	0xab, 0x77, 0x84, 0xbf, 0x96, 0x98, 0x9e, 0xc4, 0x96, 0xc9, 0x1a, 0x8e, 0x50, 0x7d, 0x30, 0x3d,
+	0x31, 0xb1, 0x6a, 0x22, 0x7d, 0x7a, 0xbf, 0xd7, 0x18, 0x89, 0x63, 0x2d, 0x9f, 0x37, 0xc9, 0xb5,
+	0xa3, 0x62, 0x59, 0x51, 0x0b, 0x47, 0xc5, 0x72, 0x49, 0x9d, 0x6f, 0xfe, 0xa5, 0x9a, 0x3f, 0x2a,
+	0x5d, 0x60, 0xcc, 0x05, 0x5b, 0x7b, 0x84, 0x56, 0xe4, 0x92, 0x43, 0xf8, 0x5d, 0xe4, 0x84, 0xe0,
+	0x81, 0xcf, 0xc4, 0xba, 0x0b, 0x72, 0xdd, 0x02, 0xc4, 0x19, 0xc6, 0xd7, 0xfd, 0xff, 0x4b, 0xf8,
+	0xf2, 0x34, 0x84, 0xaf, 0x7c, 0x20, 0xe1, 0xd1, 0x47, 0x26, 0xfc, 0xc2, 0x74, 0x84, 0xaf, 0x4e,
+	0x49, 0xf8, 0xda, 0xb4, 0x84, 0xff, 0xbd, 0x82, 0x96, 0x43, 0x78, 0x63, 0x86, 0xb6, 0x61, 0x3b,
+	0x94, 0x85, 0xce, 0x59, 0xc4, 0x97, 0xa8, 0x2f, 0xee, 0xcc, 0xee, 0x2e, 0x3c, 0xfa, 0x72, 0x44,
+	0xc1, 0x1e, 0xa2, 0xf1, 0x1e, 0x16, 0xf6, 0x87, 0x39, 0xf3, 0xb6, 0xcf, 0xc2, 0x9b, 0xd6, 0xfa,
+	0x37, 0xbd, 0xc6, 0x0c, 0x2f, 0xe4, 0x9e, 0xe3, 0xb3, 0x01, 0xf7, 0x58, 0x0b, 0x87, 0x6c, 0xb4,
+	0xaf, 0x15, 0xb4, 0x39, 0x22, 0x14, 0xc3, 0x06, 0x66, 0x3a, 0x2e, 0xd8, 0xfa, 0x3d, 0x11, 0xd3,
+	0x0f, 0x46, 0xc4, 0x34, 0x1c, 0xc1, 0xa1, 0x30, 0x6a, 0x7d, 0x1e, 0x87, 0x30, 0xd1, 0x31, 0xde,
+	0x08, 0xc7, 0xd8, 0x83, 0xcd, 0x69, 0x48, 0xe5, 0x3a, 0x63, 0x1a, 0xaa, 0x19, 0x0d, 0x07, 0x00,
+	0x5c, 0x8d, 0xa7, 0x92, 0x86, 0x0f, 0x11, 0x12, 0xcb, 0x16, 0xdd, 0x4a, 0x5f, 0x12, 0x46, 0xe2,
+	0x9c, 0x66, 0x52, 0x5c, 0xe1, 0x63, 0xcc, 0x87, 0x5c, 0x3d, 0xe1, 0x92, 0x63, 0xeb, 0x5a, 0xa6,
+	0x9e, 0x49, 0x71, 0x25, 0x1e, 0x77, 0x6c, 0xed, 0x12, 0xad, 0x66, 0x64, 0x78, 0xe3, 0x43, 0xc6,
+	0x84, 0x65, 0x61, 0xfa, 0xa8, 0xdf, 0x6b, 0x8c, 0xd1, 0x18, 0xcb, 0x91, 0x7a, 0xca, 0x11, 0xae,
+	0x7e, 0x57, 0x45, 0xac, 0xff, 0xf7, 0x15, 0x51, 0x7b, 0x8c, 0xaa, 0x7c, 0xc5, 0xe9, 0x56, 0xae,
+	0x88, 0x58, 0xd5, 0x7e, 0xaf, 0x31, 0x20, 0xc7, 0x0b, 0x72, 0x26, 0x37, 0xf2, 0x35, 0xd2, 0xc9,
+	0x35, 0x84, 0xf2, 0x04, 0x3b, 0xfe, 0x85, 0xe1, 0x12, 0x4a, 0x63, 0x07, 0xab, 0xc2, 0xc1, 0x66,
+	0xbf, 0xd7, 0x18, 0xab, 0x83, 0x57, 0x07, 0x90, 0xe7, 0x84, 0x52, 0xe9, 0xf7, 0x08, 0xd5, 0x6d,
+	0x38, 0x77, 0x65, 0x19, 0xc8, 0xf9, 0x5c, 0x93, 0x47, 0x89, 0x2f, 0x6c, 0x14, 0x8e, 0xb5, 0x54,
+	0x9a, 0xfa, 0xda, 0x68, 0xa3, 0xb5, 0x31, 0x87, 0x40, 0x53, 0xd1, 0xec, 0x15, 0xdc, 0xe8, 0x8a,
+	0xa8, 0x43, 0x7c, 0xa8, 0xd5, 0xd1, 0xdc, 0xb5, 0xe9, 0x46, 0x20, 0xaa, 0x7c, 0x05, 0xcb, 0xc9,
+	0x17, 0x85, 0x9f, 0x28, 0xf9, 0x8e, 0x71, 0x54, 0x2c, 0xcf, 0xab, 0xe5, 0xe6, 0xbf, 0x0a, 0x68,
+	0x53, 0x1c, 0xb8, 0x83, 0xac, 0xfc, 0x9c, 0xa4, 0xeb, 0x01, 0x5b, 0x7b, 0x80, 0xd4, 0xdb, 0xe5,
+	0x2c, 0xfe, 0xd6, 0xbd, 0x5b, 0x15, 0x4b, 0xfb, 0x51, 0x9e, 0x33, 0xf9, 0x02, 0x12, 0x07, 0x52,
+	0x1f, 0x55, 0x23, 0xb4, 0xcf, 0x50, 0x0d, 0xde, 0x06, 0x60, 0xf1, 0xec, 0x9c, 0x45, 0xa1, 0x2f,
+	0x0a, 0x7b, 0x05, 0x57, 0x13, 0x61, 0x2b, 0x0a, 0x7d, 0xed, 0x3e, 0x5a, 0x84, 0xf3, 0x73, 0xb0,
+	0x98, 0x73, 0x0d, 0x52, 0xab, 0x24, 0xb4, 0x6a, 0xa9, 0x54, 0xa8, 0x0d, 0x96, 0xe6, 0xf9, 0xdb,
+	0xa5, 0x79, 0x52, 0x3d, 0x2d, 0x4f, 0xaa, 0xa7, 0x3f, 0x44, 0x75, 0x1a, 0x70, 0x13, 0xd7, 0xf1,
+	0x1c, 0x66, 0xc0, 0x5b, 0x0b, 0xc0, 0x06, 0x59, 0xfc, 0xcb, 0x58, 0x13, 0xd8, 0x73, 0x0e, 0xb5,
+	0x63, 0xe4, 0xa8, 0x58, 0x9e, 0x55, 0x8b, 0x47, 0xc5, 0x72, 0x51, 0x9d, 0x6b, 0xfe, 0xbb, 0x88,
+	0xea, 0x62, 0x8f, 0xbb, 0xf1, 0xfa, 0xbb, 0xae, 0x49, 0x2f, 0xf3, 0xed, 0xd9, 0x73, 0x28, 0xe5,
+	0xac, 0x0a, 0xc0, 0x37, 0x5d, 0x76, 0x23, 0xba, 0x6e, 0x25, 0x6e, 0xcf, 0x2f, 0x24, 0x76, 0x2a,
+	0xa1, 0x5b, 0x4b, 0x2c, 0x7e, 0x60, 0xf7, 0x99, 0xfb, 0xc8, 0xdd, 0xa7, 0x34, 0x5d, 0xf7, 0x99,
+	0x9f, 0xb2, 0xfb, 0x94, 0xa7, 0xed, 0x3e, 0xbf, 0x46, 0x1b, 0xa9, 0x57, 0xca, 0xcc, 0x2b, 0x30,
+	0xcc, 0x73, 0xc6, 0xc7, 0x3c, 0x03, 0xf1, 0x25, 0x74, 0xbb, 0xdf, 0x6b, 0x4c, 0xd0, 0xca, 0x9c,
+	0x77, 0x39, 0x74, 0x70, 0xce, 0xe2, 0x04, 0x72, 0xe7, 0x23, 0xd3, 0x67, 0x78, 0xc4, 0x06, 0x1d,
+	0x65, 0xce, 0xc7, 0x6b, 0xe1, 0xb5, 0x11, 0x39, 0x7e, 0x41, 0x6c, 0x18, 0xbe, 0x9d, 0x2c, 0x7c,
+	0xd0, 0xed, 0x24, 0x3d, 0xe2, 0x05, 0x75, 0xb6, 0xf9, 0x8f, 0x02, 0x5a, 0xce, 0xba, 0xe9, 0xa1,
+	0x43, 0x2d, 0x33, 0xb4, 0xc1, 0xe6, 0x05, 0x02, 0xc2, 0x90, 0x24, 0xe7, 0x52, 0x4e, 0x6e, 0x31,
+	0x6b, 0xf6, 0x03, 0x99, 0x55, 0xfc, 0xc8, 0xcc, 0x9a, 0x9b, 0x8e, 0x59, 0xa5, 0x29, 0x99, 0x35,
+	0x3f, 0x25, 0xb3, 0xe4, 0x3e, 0x37, 0xff, 0xaa, 0x20, 0x7d, 0xdc, 0xdd, 0x40, 0x6b, 0xa3, 0xa5,
+	0x10, 0x2c, 0x27, 0x70, 0xf8, 0xad, 0x3b, 0xf9, 0xa0, 0x72, 0xc7, 0x07, 0xd5, 0xd4, 0x24, 0xd9,
+	0xad, 0x16, 0xaa, 0x90, 0x60, 0xf0, 0x9d, 0x7b, 0x7f, 0xc4, 0x15, 0x45, 0x5e, 0x96, 0xf8, 0x2d,
+	0xfe, 0x24, 0x90, 0x6f, 0x57, 0x5c, 0x26, 0xf1, 0x48, 0x5b, 0x45, 0x25, 0xd3, 0x23, 0x91, 0xcf,
+	0xe2, 0xbc, 0xc6, 0xb3, 0xe6, 0x9f, 0x0a, 0x49, 0x69, 0x4a, 0xad, 0x5b, 0x26, 0xb3, 0x2e, 0x27,
+	0xa6, 0x48, 0x99, 0x94, 0xa2, 0xcf, 0xf8, 0x75, 0xc6, 0xb7, 0x21, 0xe4, 0xfc, 0x8e, 0xdc, 0xa4,
+	0x09, 0x55, 0xa5, 0xf0, 0x85, 0x90, 0x69, 0x9b, 0xa8, 0x92, 0x2e, 0x33, 0x61, 0x5a, 0x2a, 0x18,
+	0x5c, 0x70, 0x71, 0xba, 0x05, 0x7f, 0x8a, 0xaa, 0x8c, 0x30, 0xd3, 0x35, 0xe2, 0x65, 0xcb, 0xa6,
+	0xb2, 0x20, 0x64, 0x07, 0x42, 0xc4, 0xf9, 0x9e, 0x3e, 0x4a, 0xa8, 0x20, 0x50, 0x4d, 0x3c, 0x6c,
+	0xc4, 0x61, 0xa1, 0xda, 0x1a, 0x9a, 0x27, 0x81, 0xc1, 0x7f, 0x47, 0xc4, 0x8d, 0xa4, 0x44, 0x82,
+	0x97, 0x37, 0x01, 0xf0, 0x72, 0xbe, 0x23, 0xf6, 0xec, 0xb5, 0xe9, 0x3a, 0x36, 0xe7, 0xc4, 0x30,
+	0x03, 0xa6, 0xdf, 0xbf, 0x8f, 0x91, 0xed, 0x2f, 0xd1, 0xc6, 0x75, 0x12, 0xdb, 0x30, 0xe5, 0xe5,
+	0x7e, 0xeb, 0xa9, 0xc6, 0xed, 0x9a, 0xf9, 0x05, 0x5a, 0xcf, 0xac, 0x4d, 0xcb, 0xe2, 0x9b, 0x35,
+	0x78, 0xdc, 0xf1, 0x5a, 0xaa, 0x70, 0x20, 0xf1, 0xc4, 0xf6, 0x7b, 0xe8, 0x9e, 0x45, 0x3c, 0x51,
+	0xe9, 0x88, 0xcf, 0x2f, 0xa1, 0x10, 0xef, 0xfc, 0x62, 0x26, 0xc6, 0x26, 0x03, 0x6d, 0x17, 0xa9,
+	0x01, 0x21, 0xae, 0x41, 0x2f, 0xcd, 0x10, 0xe2, 0x0a, 0x27, 0x5b, 0xfa, 0x22, 0x97, 0x77, 0xb9,
+	0x58, 0x5e, 0xa3, 0x1e, 0x20, 0x35, 0xe7, 0x32, 0xf7, 0x6b, 0x02, 0xe7, 0x3e, 0x25, 0x55, 0x7f,
+	0x86, 0x36, 0x29, 0xb8, 0xe7, 0x86, 0x0d, 0x2e, 0x5c, 0x24, 0xff, 0x75, 0xc4, 0xb5, 0x3c, 0xf7,
+	0xc0, 0xc3, 0xeb, 0x5c, 0xe7, 0x30, 0x55, 0x91, 0xc9, 0x93, 0x0e, 0x9e, 0xa0, 0xb5, 0xd8, 0x96,
+	0x84, 0x74, 0xd0, 0x56, 0xbe, 0xf3, 0x56, 0x32, 0x38, 0x6f, 0xf7, 0x53, 0xf4, 0x89, 0x64, 0x5b,
+	0x0c, 0x83, 0x1d, 0xf7, 0x11, 0x69, 0x8b, 0xe4, 0x8e, 0x0b, 0x95, 0xc3, 0x44, 0x43, 0x74, 0x13,
+	0x69, 0x7e, 0x1f, 0x2d, 0x72, 0x26, 0x66, 0xbe, 0x45, 0xb1, 0xaf, 0xe1, 0x9a, 0x1f, 0x79, 0x87,
+	0xa9, 0xb0, 0xf9, 0xf7, 0x59, 0xf4, 0x60, 0xe0, 0x1e, 0x81, 0xe1, 0x5a, 0x6c, 0xd4, 0x33, 0xd3,
+	0x75, 0xcf, 0x4c, 0xeb, 0x6a, 0x80, 0x81, 0x13, 0xcb, 0x9e, 0x32, 0x6d, 0x43, 0x3d, 0x1e, 0xfb,
+	0x2e, 0x28, 0xdc, 0xe1, 0x72, 0xf4, 0xed, 0xff, 0x8e, 0xa6, 0x33, 0xe9, 0x18, 0x15, 0x27, 0x1d,
+	0xa3, 0xac, 0xe0, 0xcd, 0xe5, 0x0b, 0xde, 0xe0, 0xf1, 0x2a, 0x4d, 0x77, 0xbc, 0x9e, 0xa0, 0x35,
+	0x72, 0xc6, 0x63, 0xe4, 0x69, 0x8e, 0x3c, 0x23, 0x80, 0xd0, 0x02, 0x9f, 0x99, 0x17, 0xb2, 0x52,
+	0x14, 0xf1, 0x4a, 0x02, 0x77, 0x23, 0xef, 0x34, 0x05, 0x79, 0x4c, 0xf1, 0x87, 0x25, 0x11, 0xe3,
+	0x59, 0xf3, 0xeb, 0x02, 0xfa, 0xfc, 0xf6, 0x1d, 0x1c, 0x83, 0xe3, 0x9d, 0x45, 0x21, 0x15, 0x31,
+	0xf0, 0x3f, 0x34, 0x40, 0xd9, 0xff, 0xfe, 0x2e, 0xbe, 0x87, 0x96, 0x47, 0x64, 0x37, 0x4e, 0xcb,
+	0xd2, 0x50, 0x02, 0xef, 0xba, 0x8c, 0x6e, 0x0d, 0xbc, 0x39, 0xe7, 0x12, 0x38, 0x79, 0x63, 0x66,
+	0x39, 0x9a, 0xcf, 0xe7, 0x48, 0xbe, 0x4f, 0xbe, 0xff, 0x5b, 0xb4, 0x32, 0xf2, 0xd7, 0xad, 0xf6,
+	0x29, 0xda, 0x6a, 0x7f, 0x75, 0xda, 0xc1, 0x07, 0x2f, 0x3b, 0x27, 0xc7, 0x06, 0x6e, 0x1f, 0x74,
+	0x4f, 0x8e, 0x8d, 0x57, 0xc7, 0xdd, 0xd3, 0xf6, 0xd3, 0xce, 0xb3, 0x4e, 0xfb, 0x50, 0x9d, 0xd1,
+	0x96, 0x50, 0xed, 0x14, 0x9f, 0x9c, 0x3c, 0x33, 0x5e, 0x74, 0xba, 0xdd, 0xce, 0xf1, 0xcf, 0x55,
+	0x25, 0x13, 0x75, 0x8e, 0x5f, 0x1f, 0x3c, 0xef, 0x1c, 0xaa, 0x85, 0xd6, 0x2f, 0xbf, 0x79, 0xb7,
+	0xad, 0x7c, 0xfb, 0x6e, 0x5b, 0xf9, 0xee, 0xdd, 0xb6, 0xf2, 0xcf, 0x77, 0xdb, 0xca, 0x1f, 0xde,
+	0x6f, 0xcf, 0x7c, 0xfb, 0x7e, 0x7b, 0xe6, 0xbb, 0xf7, 0xdb, 0x33, 0xbf, 0x7a, 0x7c, 0xe1, 0xb0,
+	0xcb, 0xe8, 0x6c, 0xcf, 0x22, 0xde, 0x3e, 0x3f, 0xa5, 0x0f, 0x7d, 0x60, 0x6f, 0x48, 0x78, 0x25,
+	0x26, 0x21, 0x71, 0xdd, 0xfd, 0xb7, 0x43, 0xff, 0xad, 0xcf, 0x4a, 0xe2, 0xc7, 0xf5, 0xe3, 0xff,
+	0x0c, 0x00, 0xee, 0x14, 0xd0, 0xc8, 0x56, 0x17, 0x00, 0x00,
 }
 
 func (m *EventClaimExpired) Marshal() (dAtA []byte, err error) {
@@ -1834,6 +1854,20 @@ func (m *EventSupplierSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimedUpokt) > 0 {
+		i -= len(m.ClaimedUpokt)
+		copy(dAtA[i:], m.ClaimedUpokt)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClaimedUpokt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ProofMissingPenaltyMode) > 0 {
+		i -= len(m.ProofMissingPenaltyMode)
+		copy(dAtA[i:], m.ProofMissingPenaltyMode)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ProofMissingPenaltyMode)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.SupplierStakeAfterSlash) > 0 {
 		i -= len(m.SupplierStakeAfterSlash)
 		copy(dAtA[i:], m.SupplierStakeAfterSlash)
@@ -2495,6 +2529,14 @@ func (m *EventSupplierSlashed) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	l = len(m.ProofMissingPenaltyMode)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ClaimedUpokt)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -4193,6 +4235,70 @@ func (m *EventSupplierSlashed) Unmarshal(dAtA []byte) error {
 			}
 			m.SupplierStakeAfterSlash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofMissingPenaltyMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofMissingPenaltyMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedUpokt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedUpokt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2,7 +2,7 @@ package types
 
 import (
 	"math/big"
-	"strconv"
+	"strings"
 
 	"cosmossdk.io/math"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
@@ -21,7 +21,7 @@ func NewEventClaimSettled(
 	claimeduPOKT *cosmostypes.Coin,
 	claimSettlementResult *ClaimSettlementResult,
 	settledUpokt *cosmostypes.Coin,
-	mintRatio float64,
+	mintRatio math.LegacyDec,
 	supplierOwnerAddress string,
 ) *EventClaimSettled {
 	claim := claimSettlementResult.GetClaim()
@@ -29,7 +29,7 @@ func NewEventClaimSettled(
 	rewardDistributionDetailed := claimSettlementResult.GetRewardDistributionDetailed()
 
 	// Compute the derived settlement breakdown fields.
-	// These use the same Float64ToRat conversion as the TLM to ensure identical rounding.
+	// These use the same LegacyDecToRat conversion as the TLM to ensure identical rounding.
 	mintedUpokt, overservicingLossUpokt, deflationLossUpokt := computeSettlementBreakdown(
 		claimeduPOKT, settledUpokt, mintRatio,
 	)
@@ -49,7 +49,7 @@ func NewEventClaimSettled(
 		RewardDistribution:         rewardDistribution,
 		RewardDistributionDetailed: rewardDistributionDetailed,
 		SettledUpokt:               settledUpokt.String(),
-		MintRatio:                  strconv.FormatFloat(mintRatio, 'f', -1, 64),
+		MintRatio:                  formatMintRatio(mintRatio),
 		SessionId:                  claim.SessionHeader.SessionId,
 		SupplierOwnerAddress:       supplierOwnerAddress,
 		MintedUpokt:                mintedUpokt,
@@ -60,17 +60,13 @@ func NewEventClaimSettled(
 
 // computeSettlementBreakdown derives the three settlement breakdown coin strings
 // from the claimed, settled, and mint ratio values.
-// Uses encoding.Float64ToRat for identical rounding to the TLM.
+// Uses encoding.LegacyDecToRat for identical rounding to the TLM.
 func computeSettlementBreakdown(
 	claimeduPOKT, settledUpokt *cosmostypes.Coin,
-	mintRatio float64,
+	mintRatio math.LegacyDec,
 ) (mintedUpokt, overservicingLossUpokt, deflationLossUpokt string) {
 	// minted = settled * mint_ratio (truncated to integer, matching TLM rounding).
-	mintRatioRat, err := encoding.Float64ToRat(mintRatio)
-	if err != nil {
-		// Fallback: should never happen since the TLM already validated this value.
-		mintRatioRat = new(big.Rat).SetFloat64(mintRatio)
-	}
+	mintRatioRat := encoding.LegacyDecToRat(mintRatio)
 	mintedAmountRat := new(big.Rat).Mul(
 		new(big.Rat).SetInt(settledUpokt.Amount.BigInt()),
 		mintRatioRat,
@@ -96,3 +92,15 @@ func computeSettlementBreakdown(
 
 	return mintedCoin.String(), overservicingLossCoin.String(), deflationLossCoin.String()
 }
+
+// formatMintRatio returns the shortest decimal representation of the given mint
+// ratio (e.g. "0.975" or "1"), i.e. without the trailing zeros of math.LegacyDec#String.
+// It keeps the mint_ratio event attribute in the format it had when mint_ratio was a float64.
+func formatMintRatio(mintRatio math.LegacyDec) string {
+	mintRatioStr := mintRatio.String()
+	if !strings.Contains(mintRatioStr, ".") {
+		return mintRatioStr
+	}
+
+	return strings.TrimSuffix(strings.TrimRight(mintRatioStr, "0"), ".")
+}
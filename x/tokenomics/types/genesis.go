@@ -1,8 +1,11 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		SettlementResultList: []ClaimSettlementResult{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -11,6 +14,23 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Ensure settlement results are unique with respect to a given session and supplier operator address.
+	settlementResultPrimaryKeyMap := make(map[string]struct{})
+	for _, settlementResult := range gs.SettlementResultList {
+		if settlementResult.Claim.GetSessionHeader() == nil {
+			return fmt.Errorf("settlement result claim session header cannot be nil")
+		}
+
+		primaryKey := string(SettlementResultPrimaryKey(
+			settlementResult.GetSessionEndHeight(),
+			settlementResult.GetSessionId(),
+			settlementResult.GetSupplierOperatorAddr(),
+		))
+		if _, ok := settlementResultPrimaryKeyMap[primaryKey]; ok {
+			return fmt.Errorf("duplicated primaryKey for settlement result")
+		}
+		settlementResultPrimaryKeyMap[primaryKey] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.ValidateBasic()
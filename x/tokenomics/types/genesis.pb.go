@@ -28,6 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// settlement_result_list is the settlement history, i.e. the results of the
+	// settled claims retained for settlement_history_retention_blocks blocks.
+	SettlementResultList []ClaimSettlementResult `protobuf:"bytes,2,rep,name=settlement_result_list,json=settlementResultList,proto3" json:"settlement_result_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -66,6 +69,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetSettlementResultList() []ClaimSettlementResult {
+	if m != nil {
+		return m.SettlementResultList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "pocket.tokenomics.GenesisState")
 }
@@ -73,21 +83,25 @@ func init() {
 func init() { proto.RegisterFile("pocket/tokenomics/genesis.proto", fileDescriptor_792d101b41fb113f) }
 
 var fileDescriptor_792d101b41fb113f = []byte{
-	// 223 bytes of a gzipped FileDescriptorProto
+	// 287 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0xc8, 0x4f, 0xce,
 	0x4e, 0x2d, 0xd1, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0xcb, 0xcf, 0xcd, 0x4c, 0x2e, 0xd6, 0x4f, 0x4f,
 	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x28, 0xd0,
 	0x43, 0x28, 0x90, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x55, 0x52, 0x22,
 	0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0xc3, 0x34, 0xbc, 0x20, 0xb1,
-	0x28, 0x31, 0x17, 0x6a, 0xb6, 0x92, 0x0f, 0x17, 0x8f, 0x3b, 0xc4, 0xb2, 0xe0, 0x92, 0xc4, 0x92,
-	0x54, 0x21, 0x1b, 0x2e, 0x36, 0x88, 0xbc, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa4, 0x1e,
-	0x86, 0xe5, 0x7a, 0x01, 0x60, 0x05, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41,
-	0x8b, 0x31, 0x08, 0xaa, 0xc7, 0x29, 0xf0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x6f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x2f, 0xc8, 0xcf, 0x2e, 0xd1, 0xcd, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0x06, 0x73, 0x8a,
-	0xf2, 0x73, 0x72, 0xf4, 0x2b, 0x90, 0xdd, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76,
-	0xa7, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x10, 0x7f, 0x38, 0x1a, 0x26, 0x01, 0x00, 0x00,
+	0x28, 0x31, 0x17, 0x6a, 0xb6, 0x94, 0x2c, 0xa6, 0x7c, 0x49, 0x65, 0x41, 0x2a, 0x54, 0x5a, 0x69,
+	0x13, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x31, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x36, 0x5c, 0x6c,
+	0x10, 0xfd, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x92, 0x7a, 0x18, 0x8e, 0xd3, 0x0b, 0x00,
+	0x2b, 0x70, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x3d,
+	0x42, 0x29, 0x5c, 0x62, 0xc5, 0xa9, 0x25, 0x25, 0x39, 0xa9, 0xb9, 0xa9, 0x79, 0x25, 0xf1, 0x45,
+	0xa9, 0xc5, 0xa5, 0x39, 0x25, 0xf1, 0x39, 0x99, 0xc5, 0x25, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
+	0x46, 0x1a, 0x58, 0x4c, 0x73, 0xce, 0x49, 0xcc, 0xcc, 0x0d, 0x86, 0xeb, 0x0a, 0x02, 0x6b, 0x72,
+	0x62, 0x01, 0x19, 0x1e, 0x24, 0x52, 0x8c, 0x26, 0xee, 0x93, 0x59, 0x5c, 0xe2, 0x14, 0x78, 0xe2,
+	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x37, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
+	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa7, 0x67,
+	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0xe4, 0x67, 0x97, 0xe8, 0xe6, 0xa5,
+	0x96, 0x94, 0xe7, 0x17, 0x65, 0x83, 0x39, 0x45, 0xf9, 0x39, 0x39, 0xfa, 0x15, 0x18, 0xa1, 0x91,
+	0xc4, 0x06, 0x0e, 0x0e, 0x63, 0xc0, 0x00, 0x92, 0x18, 0x5c, 0x80, 0xac, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +124,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SettlementResultList) > 0 {
+		for iNdEx := len(m.SettlementResultList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementResultList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -142,6 +170,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SettlementResultList) > 0 {
+		for _, e := range m.SettlementResultList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -213,6 +247,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementResultList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementResultList = append(m.SettlementResultList, ClaimSettlementResult{})
+			if err := m.SettlementResultList[len(m.SettlementResultList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/pokt-network/poktroll/cmd/pocketd/cmd"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 )
 
@@ -14,6 +17,29 @@ func init() {
 }
 
 func TestGenesisState_Validate(t *testing.T) {
+	supplierOperatorAddr := sample.AccAddressBech32()
+	sessionHeader := &sessiontypes.SessionHeader{
+		ApplicationAddress:      sample.AccAddressBech32(),
+		ServiceId:               "svc1",
+		SessionId:               "session_1",
+		SessionStartBlockHeight: 1,
+		SessionEndBlockHeight:   10,
+	}
+	settlementResult := tokenomicstypes.ClaimSettlementResult{
+		Claim: prooftypes.Claim{
+			SupplierOperatorAddress: supplierOperatorAddr,
+			SessionHeader:           sessionHeader,
+			RootHash:                []byte("root_hash"),
+		},
+	}
+	otherSupplierSettlementResult := tokenomicstypes.ClaimSettlementResult{
+		Claim: prooftypes.Claim{
+			SupplierOperatorAddress: sample.AccAddressBech32(),
+			SessionHeader:           sessionHeader,
+			RootHash:                []byte("root_hash"),
+		},
+	}
+
 	tests := []struct {
 		desc     string
 		genState *tokenomicstypes.GenesisState
@@ -28,10 +54,35 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &tokenomicstypes.GenesisState{
 				Params: tokenomicstypes.DefaultParams(),
+				SettlementResultList: []tokenomicstypes.ClaimSettlementResult{
+					settlementResult,
+					otherSupplierSettlementResult,
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			isValid: true,
 		},
+		{
+			desc: "duplicated settlement result",
+			genState: &tokenomicstypes.GenesisState{
+				Params: tokenomicstypes.DefaultParams(),
+				SettlementResultList: []tokenomicstypes.ClaimSettlementResult{
+					settlementResult,
+					settlementResult,
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "settlement result without session header",
+			genState: &tokenomicstypes.GenesisState{
+				Params: tokenomicstypes.DefaultParams(),
+				SettlementResultList: []tokenomicstypes.ClaimSettlementResult{
+					{Claim: prooftypes.Claim{SupplierOperatorAddress: supplierOperatorAddr}},
+				},
+			},
+			isValid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, test := range tests {
@@ -0,0 +1,57 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// SettlementResultPrimaryKeyPrefix is the prefix to retrieve the entire ClaimSettlementResult object (the primary store).
+	SettlementResultPrimaryKeyPrefix = "SettlementResult/primary_key/"
+
+	// SettlementResultSupplierOperatorAddressPrefix is the key to retrieve a ClaimSettlementResult's Primary Key from the supplier index
+	SettlementResultSupplierOperatorAddressPrefix = "SettlementResult/supplier/"
+
+	// SettlementResultApplicationAddressPrefix is the key to retrieve a ClaimSettlementResult's Primary Key from the application index
+	SettlementResultApplicationAddressPrefix = "SettlementResult/application/"
+
+	// SettlementResultServiceIdPrefix is the key to retrieve a ClaimSettlementResult's Primary Key from the service index
+	SettlementResultServiceIdPrefix = "SettlementResult/service/"
+)
+
+// SettlementResultPrimaryKey returns the primary store key used to retrieve a
+// ClaimSettlementResult by creating a composite key of the sessionEndHeight,
+// sessionId and supplierOperatorAddr.
+//
+// The big endian encoded session end height comes first so that the primary store
+// is ordered by session end height, which is what pruning relies on.
+func SettlementResultPrimaryKey(sessionEndHeight int64, sessionId, supplierOperatorAddr string) []byte {
+	// Every supplier can only have one claim, and therefore one settlement result, per session.
+	return KeyComposite(
+		SettlementResultSessionEndHeightKey(sessionEndHeight),
+		[]byte(sessionId),
+		[]byte(supplierOperatorAddr),
+	)
+}
+
+// SettlementResultSessionEndHeightKey returns the primary store key prefix used to
+// iterate through settlement results given a session end height.
+func SettlementResultSessionEndHeightKey(sessionEndHeight int64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(sessionEndHeight))
+
+	return heightBz
+}
+
+// SettlementResultIndexKey returns the secondary index key of the settlement result
+// with the given primary key, for the given indexed value (e.g. a supplier operator
+// address, an application address or a service ID).
+func SettlementResultIndexKey(indexedValue string, primaryKey []byte) []byte {
+	return KeyComposite([]byte(indexedValue), primaryKey)
+}
+
+// SettlementResultIndexKeyPrefix returns the secondary index key prefix used to
+// iterate through the settlement results matching the given indexed value.
+//
+// It includes the trailing key delimiter so that, for example, the settlement
+// results of service "svc1" do not include the ones of service "svc10".
+func SettlementResultIndexKeyPrefix(indexedValue string) []byte {
+	return SettlementResultIndexKey(indexedValue, []byte{})
+}
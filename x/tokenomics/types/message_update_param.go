@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,8 +19,8 @@ func NewMsgUpdateParam(authority string, name string, asTypeAny any) (*MsgUpdate
 		asTypeIface = &MsgUpdateParam_AsMintEqualsBurnClaimDistribution{AsMintEqualsBurnClaimDistribution: &asType}
 	case string:
 		asTypeIface = &MsgUpdateParam_AsString{AsString: asType}
-	case float64:
-		asTypeIface = &MsgUpdateParam_AsFloat{AsFloat: asType}
+	case math.LegacyDec:
+		asTypeIface = &MsgUpdateParam_AsDec{AsDec: asType.String()}
 	case uint64:
 		asTypeIface = &MsgUpdateParam_AsUint64{AsUint64: asType}
 	default:
@@ -65,25 +66,52 @@ func (msg *MsgUpdateParam) ValidateBasic() error {
 		}
 		return ValidateDaoRewardAddress(msg.GetAsString())
 	case ParamGlobalInflationPerClaim:
-		if err := genericParamTypeIs[*MsgUpdateParam_AsFloat](msg); err != nil {
+		if err := genericParamTypeIs[*MsgUpdateParam_AsDec](msg); err != nil {
 			return err
 		}
-		return ValidateGlobalInflationPerClaim(msg.GetAsFloat())
+		globalInflationPerClaim, err := msg.GetAsLegacyDec()
+		if err != nil {
+			return err
+		}
+		return ValidateGlobalInflationPerClaim(globalInflationPerClaim)
 	case ParamMintRatio:
-		if err := genericParamTypeIs[*MsgUpdateParam_AsFloat](msg); err != nil {
+		if err := genericParamTypeIs[*MsgUpdateParam_AsDec](msg); err != nil {
+			return err
+		}
+		mintRatio, err := msg.GetAsLegacyDec()
+		if err != nil {
 			return err
 		}
-		return ValidateMintRatio(msg.GetAsFloat())
+		return ValidateMintRatio(mintRatio)
 	case ParamOverservicingBonusMultiplier:
 		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
 			return err
 		}
 		return ValidateOverservicingBonusMultiplier(msg.GetAsUint64())
+	case ParamSettlementHistoryRetentionBlocks:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateSettlementHistoryRetentionBlocks(msg.GetAsUint64())
 	default:
 		return ErrTokenomicsParamNameInvalid.Wrapf("unsupported param %q", msg.Name)
 	}
 }
 
+// GetAsLegacyDec returns the decimal param value of the message, parsed from its
+// as_dec string representation.
+func (msg *MsgUpdateParam) GetAsLegacyDec() (math.LegacyDec, error) {
+	asDec, err := math.LegacyNewDecFromStr(msg.GetAsDec())
+	if err != nil {
+		return math.LegacyDec{}, ErrTokenomicsParamInvalid.Wrapf(
+			"invalid decimal value %q for param %q: %s",
+			msg.GetAsDec(), msg.Name, err,
+		)
+	}
+
+	return asDec, nil
+}
+
 // genericParamTypeIs checks if the parameter type is T, returning an error if not.
 func genericParamTypeIs[T any](msg *MsgUpdateParam) error {
 	if _, ok := msg.AsType.(T); !ok {
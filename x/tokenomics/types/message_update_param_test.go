@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pokt-network/poktroll/testutil/sample"
@@ -10,11 +11,11 @@ import (
 
 func TestMsgUpdateParam_ValidateBasic(t *testing.T) {
 	validMintAllocationPercentages := MintAllocationPercentages{
-		Dao:         0.1,
-		Proposer:    0.1,
-		Supplier:    0.1,
-		SourceOwner: 0.1,
-		Application: 0.6,
+		Dao:         math.LegacyMustNewDecFromStr("0.1"),
+		Proposer:    math.LegacyMustNewDecFromStr("0.1"),
+		Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+		SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+		Application: math.LegacyMustNewDecFromStr("0.6"),
 	}
 
 	tests := []struct {
@@ -53,11 +54,11 @@ func TestMsgUpdateParam_ValidateBasic(t *testing.T) {
 				Name:      "mint_allocation_percentages",
 				AsType: &MsgUpdateParam_AsMintAllocationPercentages{
 					AsMintAllocationPercentages: &MintAllocationPercentages{
-						Dao:         0,
-						Proposer:    0,
-						Supplier:    0,
-						SourceOwner: 0,
-						Application: 0,
+						Dao:         math.LegacyZeroDec(),
+						Proposer:    math.LegacyZeroDec(),
+						Supplier:    math.LegacyZeroDec(),
+						SourceOwner: math.LegacyZeroDec(),
+						Application: math.LegacyZeroDec(),
 					},
 				},
 			},
@@ -68,8 +69,8 @@ func TestMsgUpdateParam_ValidateBasic(t *testing.T) {
 			msg: MsgUpdateParam{
 				Authority: sample.AccAddressBech32(),
 				Name:      ParamGlobalInflationPerClaim,
-				AsType: &MsgUpdateParam_AsFloat{
-					AsFloat: -0.1,
+				AsType: &MsgUpdateParam_AsDec{
+					AsDec: "-0.1",
 				},
 			},
 			expectedErr: ErrTokenomicsParamInvalid,
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pokt-network/poktroll/cmd/pocketd/cmd"
@@ -49,11 +50,11 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 				Authority: sample.AccAddressBech32(),
 				Params: tokenomicstypes.Params{
 					MintAllocationPercentages: tokenomicstypes.MintAllocationPercentages{
-						Dao:         0.1,
-						Proposer:    0.1,
-						Supplier:    0.1,
-						SourceOwner: 0.1,
-						Application: 0.1,
+						Dao:         math.LegacyMustNewDecFromStr("0.1"),
+						Proposer:    math.LegacyMustNewDecFromStr("0.1"),
+						Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+						SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+						Application: math.LegacyMustNewDecFromStr("0.1"),
 					},
 				},
 			},
@@ -65,11 +66,11 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 				Authority: sample.AccAddressBech32(),
 				Params: tokenomicstypes.Params{
 					MintEqualsBurnClaimDistribution: tokenomicstypes.MintEqualsBurnClaimDistribution{
-						Dao:         0.1,
-						Proposer:    0.1,
-						Supplier:    0.1,
-						SourceOwner: 0.1,
-						Application: 0.1,
+						Dao:         math.LegacyMustNewDecFromStr("0.1"),
+						Proposer:    math.LegacyMustNewDecFromStr("0.1"),
+						Supplier:    math.LegacyMustNewDecFromStr("0.1"),
+						SourceOwner: math.LegacyMustNewDecFromStr("0.1"),
+						Application: math.LegacyMustNewDecFromStr("0.1"),
 					},
 				},
 			},
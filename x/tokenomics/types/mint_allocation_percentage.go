@@ -1,23 +1,59 @@
 package types
 
-import "math"
-
-const basisPointsTotal = 10000 // 100% = 10000 basis points
-
-// Sum returns the sum of all mint allocation percentages.
-// It uses basis points internally for exact arithmetic validation.
-// TODO_IMPROVE(@red-0ne): Use string % representations and big.Rat in the future.
-func (m *MintAllocationPercentages) Sum() float64 {
-	// Convert each percentage to basis points for exact integer arithmetic
-	daoBP := int64(math.Round(m.Dao * float64(basisPointsTotal)))
-	proposerBP := int64(math.Round(m.Proposer * float64(basisPointsTotal)))
-	supplierBP := int64(math.Round(m.Supplier * float64(basisPointsTotal)))
-	sourceOwnerBP := int64(math.Round(m.SourceOwner * float64(basisPointsTotal)))
-	applicationBP := int64(math.Round(m.Application * float64(basisPointsTotal)))
-
-	// Sum basis points
-	sumBP := daoBP + proposerBP + supplierBP + sourceOwnerBP + applicationBP
-
-	// Convert back to percentage
-	return float64(sumBP) / float64(basisPointsTotal)
+import "cosmossdk.io/math"
+
+// Sum returns the exact sum of all mint allocation percentages.
+// Unset (i.e. nil) percentages count as 0.
+func (m *MintAllocationPercentages) Sum() math.LegacyDec {
+	return sumLegacyDecs(m.Dao, m.Proposer, m.Supplier, m.SourceOwner, m.Application)
+}
+
+// NormalizeSum scales the mint allocation percentages so that they sum to exactly 1.
+// It returns their sum before scaling, and whether they were scaled.
+// See normalizeLegacyDecShares.
+func (m *MintAllocationPercentages) NormalizeSum() (prevSum math.LegacyDec, isNormalized bool) {
+	prevSum = m.Sum()
+	return prevSum, normalizeLegacyDecShares(&m.Dao, &m.Proposer, &m.Supplier, &m.SourceOwner, &m.Application)
+}
+
+// sumLegacyDecs returns the exact sum of the given decimals, counting unset
+// (i.e. nil) decimals as 0.
+func sumLegacyDecs(decs ...math.LegacyDec) math.LegacyDec {
+	sum := math.LegacyZeroDec()
+	for _, dec := range decs {
+		if dec.IsNil() {
+			continue
+		}
+		sum = sum.Add(dec)
+	}
+
+	return sum
+}
+
+// normalizeLegacyDecShares scales the given shares so that they sum to exactly 1,
+// and returns whether they were scaled.
+// Every share but the DAO's is divided by the sum, truncated, and the DAO share
+// receives the remainder, as the DAO does for the minted tokens.
+// Shares which are unset, negative, or sum to 0 or to exactly 1 are left untouched.
+func normalizeLegacyDecShares(daoShare *math.LegacyDec, otherShares ...*math.LegacyDec) bool {
+	sum := math.LegacyZeroDec()
+	for _, share := range append([]*math.LegacyDec{daoShare}, otherShares...) {
+		if share.IsNil() || share.IsNegative() {
+			return false
+		}
+		sum = sum.Add(*share)
+	}
+
+	if !sum.IsPositive() || sum.Equal(math.LegacyOneDec()) {
+		return false
+	}
+
+	remainder := math.LegacyOneDec()
+	for _, share := range otherShares {
+		*share = share.QuoTruncate(sum)
+		remainder = remainder.Sub(*share)
+	}
+	*daoShare = remainder
+
+	return true
 }
@@ -1,21 +1,18 @@
 package types
 
-import "math"
+import "cosmossdk.io/math"
 
-// Sum returns the sum of all mint equals burn claim distribution percentages.
-// It uses basis points internally for exact arithmetic validation.
-// TODO_IMPROVE(@red-0ne): Use string % representations and big.Rat in the future.
-func (m *MintEqualsBurnClaimDistribution) Sum() float64 {
-	// Convert each percentage to basis points for exact integer arithmetic
-	daoBP := int64(math.Round(m.Dao * float64(basisPointsTotal)))
-	proposerBP := int64(math.Round(m.Proposer * float64(basisPointsTotal)))
-	supplierBP := int64(math.Round(m.Supplier * float64(basisPointsTotal)))
-	sourceOwnerBP := int64(math.Round(m.SourceOwner * float64(basisPointsTotal)))
-	applicationBP := int64(math.Round(m.Application * float64(basisPointsTotal)))
-
-	// Sum basis points
-	sumBP := daoBP + proposerBP + supplierBP + sourceOwnerBP + applicationBP
+// Sum returns the exact sum of all mint equals burn claim distribution percentages.
+// Unset (i.e. nil) percentages count as 0.
+func (m *MintEqualsBurnClaimDistribution) Sum() math.LegacyDec {
+	return sumLegacyDecs(m.Dao, m.Proposer, m.Supplier, m.SourceOwner, m.Application)
+}
 
-	// Convert back to percentage
-	return float64(sumBP) / float64(basisPointsTotal)
+// NormalizeSum scales the mint equals burn claim distribution percentages so that
+// they sum to exactly 1.
+// It returns their sum before scaling, and whether they were scaled.
+// See normalizeLegacyDecShares.
+func (m *MintEqualsBurnClaimDistribution) NormalizeSum() (prevSum math.LegacyDec, isNormalized bool) {
+	prevSum = m.Sum()
+	return prevSum, normalizeLegacyDecShares(&m.Dao, &m.Proposer, &m.Supplier, &m.SourceOwner, &m.Application)
 }
@@ -1,10 +1,10 @@
 package types
 
 import (
-	"math"
 	"math/big"
 
 	cosmoslog "cosmossdk.io/log"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -26,29 +26,29 @@ var (
 	// GlobalInflation TLM Params
 	KeyGlobalInflationPerClaim     = []byte("GlobalInflationPerClaim")
 	ParamGlobalInflationPerClaim   = "global_inflation_per_claim"
-	DefaultGlobalInflationPerClaim = float64(0.1)
+	DefaultGlobalInflationPerClaim = math.LegacyMustNewDecFromStr("0.1")
 
 	// TODO_CONSIDERATION: Consider renaming this to GlobalInflationPerClaimDistribution
 	// GlobalInflation Supporting TLM Params
 	KeyMintAllocationPercentages     = []byte("MintAllocationPercentages")
 	ParamMintAllocationPercentages   = "mint_allocation_percentages"
 	DefaultMintAllocationPercentages = MintAllocationPercentages{
-		Dao:         0.1,
-		Proposer:    0.05,
-		Supplier:    0.7,
-		SourceOwner: 0.15,
-		Application: 0.0,
+		Dao:         math.LegacyMustNewDecFromStr("0.1"),
+		Proposer:    math.LegacyMustNewDecFromStr("0.05"),
+		Supplier:    math.LegacyMustNewDecFromStr("0.7"),
+		SourceOwner: math.LegacyMustNewDecFromStr("0.15"),
+		Application: math.LegacyZeroDec(),
 	}
 
 	// MintEqualsBurn Supporting TLM Params
 	KeyMintEqualsBurnClaimDistribution     = []byte("MintEqualsBurnClaimDistribution")
 	ParamMintEqualsBurnClaimDistribution   = "mint_equals_burn_claim_distribution"
 	DefaultMintEqualsBurnClaimDistribution = MintEqualsBurnClaimDistribution{
-		Dao:         0.1,
-		Proposer:    0.05,
-		Supplier:    0.7,
-		SourceOwner: 0.15,
-		Application: 0.0,
+		Dao:         math.LegacyMustNewDecFromStr("0.1"),
+		Proposer:    math.LegacyMustNewDecFromStr("0.05"),
+		Supplier:    math.LegacyMustNewDecFromStr("0.7"),
+		SourceOwner: math.LegacyMustNewDecFromStr("0.15"),
+		Application: math.LegacyZeroDec(),
 	}
 
 	// PIP-41: MintRatio for deflationary mint mechanism
@@ -56,7 +56,7 @@ var (
 	// A value of 0.975 means 97.5% of burned tokens are minted, 2.5% permanently removed
 	KeyMintRatio     = []byte("MintRatio")
 	ParamMintRatio   = "mint_ratio"
-	DefaultMintRatio = float64(1.0) // Default: no deflation (mint equals burn)
+	DefaultMintRatio = math.LegacyOneDec() // Default: no deflation (mint equals burn)
 
 	// Settlement budget redistribution: overservicing_bonus_multiplier bounds how far
 	// above its guaranteed floor a supplier's settlement may be raised from unused budget.
@@ -66,6 +66,13 @@ var (
 	ParamOverservicingBonusMultiplier   = "overservicing_bonus_multiplier"
 	DefaultOverservicingBonusMultiplier = uint64(1)
 
+	// Settlement history: settlement_history_retention_blocks is the number of blocks,
+	// counted from a claim's session end height, for which its settlement result is
+	// retained and queryable. 0 disables the settlement history altogether.
+	KeySettlementHistoryRetentionBlocks     = []byte("SettlementHistoryRetentionBlocks")
+	ParamSettlementHistoryRetentionBlocks   = "settlement_history_retention_blocks"
+	DefaultSettlementHistoryRetentionBlocks = uint64(10_000)
+
 	_ paramtypes.ParamSet = (*Params)(nil)
 )
 
@@ -90,18 +97,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	daoRewardAddress string,
 	mintAllocationPercentages MintAllocationPercentages,
-	globalInflationPerClaim float64,
+	globalInflationPerClaim math.LegacyDec,
 	mintEqualsBurnClaimDistribution MintEqualsBurnClaimDistribution,
-	mintRatio float64,
+	mintRatio math.LegacyDec,
 	overservicingBonusMultiplier uint64,
+	settlementHistoryRetentionBlocks uint64,
 ) Params {
 	return Params{
-		DaoRewardAddress:                daoRewardAddress,
-		MintAllocationPercentages:       mintAllocationPercentages,
-		GlobalInflationPerClaim:         globalInflationPerClaim,
-		MintEqualsBurnClaimDistribution: mintEqualsBurnClaimDistribution,
-		MintRatio:                       mintRatio,
-		OverservicingBonusMultiplier:    overservicingBonusMultiplier,
+		DaoRewardAddress:                 daoRewardAddress,
+		MintAllocationPercentages:        mintAllocationPercentages,
+		GlobalInflationPerClaim:          globalInflationPerClaim,
+		MintEqualsBurnClaimDistribution:  mintEqualsBurnClaimDistribution,
+		MintRatio:                        mintRatio,
+		OverservicingBonusMultiplier:     overservicingBonusMultiplier,
+		SettlementHistoryRetentionBlocks: settlementHistoryRetentionBlocks,
 	}
 }
 
@@ -114,6 +123,7 @@ func DefaultParams() Params {
 		DefaultMintEqualsBurnClaimDistribution,
 		DefaultMintRatio,
 		DefaultOverservicingBonusMultiplier,
+		DefaultSettlementHistoryRetentionBlocks,
 	)
 }
 
@@ -150,6 +160,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.OverservicingBonusMultiplier,
 			ValidateOverservicingBonusMultiplier,
 		),
+		paramtypes.NewParamSetPair(
+			KeySettlementHistoryRetentionBlocks,
+			&p.SettlementHistoryRetentionBlocks,
+			ValidateSettlementHistoryRetentionBlocks,
+		),
 	}
 }
 
@@ -179,9 +194,13 @@ func (params *Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateSettlementHistoryRetentionBlocks(params.SettlementHistoryRetentionBlocks); err != nil {
+		return err
+	}
+
 	// If MintEqualsBurnClaimDistribution is zero-valued (e.g., because Ignite CLI couldn't parse it),
 	// set it to the default value
-	if params.MintEqualsBurnClaimDistribution.Sum() == 0 {
+	if params.MintEqualsBurnClaimDistribution.Sum().IsZero() {
 		params.MintEqualsBurnClaimDistribution = DefaultMintEqualsBurnClaimDistribution
 	}
 
@@ -242,19 +261,13 @@ func (params *Params) ValidateBasic() error {
 //   - The distribution is governance-controlled. The DAO setting a 100%-to-supplier
 //     distribution is a policy choice with a review process behind it, not corrupt state.
 //
-// Computed over big.Rat, NOT float64, per the repo rule that float64 params are converted
-// with encoding.Float64ToRat before any arithmetic. Float64ToRat goes through the decimal
-// string, so 0.975 is exactly 39/40 rather than the nearest binary double — the comparison
-// against 1 is then exact instead of resting on the rounding of a product.
+// Computed over big.Rat, NOT math.LegacyDec: LegacyDec.Mul rounds the product to 18
+// decimal places, which could round a product just below 1 up to exactly 1. The decimal
+// params convert to big.Rat losslessly (0.975 is exactly 39/40), so the comparison
+// against 1 is exact instead of resting on the rounding of a product.
 func (params *Params) CheckAntiCollusionInvariant() error {
-	mintRatioRat, err := encoding.Float64ToRat(params.MintRatio)
-	if err != nil {
-		return ErrTokenomicsParamInvalid.Wrapf("invalid mint_ratio: %s", err)
-	}
-	supplierShareRat, err := encoding.Float64ToRat(params.MintEqualsBurnClaimDistribution.Supplier)
-	if err != nil {
-		return ErrTokenomicsParamInvalid.Wrapf("invalid mint_equals_burn_claim_distribution.supplier: %s", err)
-	}
+	mintRatioRat := encoding.LegacyDecToRat(params.MintRatio)
+	supplierShareRat := encoding.LegacyDecToRat(params.MintEqualsBurnClaimDistribution.Supplier)
 
 	roundTripFactor := new(big.Rat).Mul(mintRatioRat, supplierShareRat)
 	if roundTripFactor.Cmp(bigRatOne) >= 0 {
@@ -277,8 +290,8 @@ func (params *Params) LogAntiCollusionInvariantViolation(logger cosmoslog.Logger
 		logger.Warn(
 			"tokenomics params violate the anti-collusion invariant; applying them anyway",
 			"warning", err.Error(),
-			"mint_ratio", params.MintRatio,
-			"mint_equals_burn_claim_distribution.supplier", params.MintEqualsBurnClaimDistribution.Supplier,
+			"mint_ratio", params.MintRatio.String(),
+			"mint_equals_burn_claim_distribution.supplier", params.MintEqualsBurnClaimDistribution.Supplier.String(),
 		)
 	}
 }
@@ -309,12 +322,13 @@ func ValidateMintApplication(mintApplication any) error {
 }
 
 func validateParamValueGTEZero(value any, actorName string) error {
-	valueFloat, ok := value.(float64)
+	valueDec, ok := value.(math.LegacyDec)
 	if !ok {
 		return ErrTokenomicsParamInvalid.Wrapf("invalid parameter type: %T", value)
 	}
-	if valueFloat < 0 {
-		return ErrTokenomicsParamInvalid.Wrapf("mint allocation to %s must be greater than or equal to 0: got %f", actorName, valueFloat)
+	// An unset (i.e. nil) allocation counts as 0.
+	if !valueDec.IsNil() && valueDec.IsNegative() {
+		return ErrTokenomicsParamInvalid.Wrapf("mint allocation to %s must be greater than or equal to 0: got %s", actorName, valueDec)
 	}
 	return nil
 }
@@ -354,10 +368,9 @@ func ValidateMintAllocationPercentages(mintAllocationPercentagesAny any) error {
 
 // ValidateMintAllocationSum validates that the sum of all actor mint allocation percentages is exactly 1.
 func ValidateMintAllocationSum(mintAllocationPercentage MintAllocationPercentages) error {
-	const epsilon = 1e-10 // Small epsilon value for floating-point comparison
 	sum := mintAllocationPercentage.Sum()
-	if math.Abs(sum-1) > epsilon {
-		return ErrTokenomicsParamInvalid.Wrapf("mint allocation percentages do not add to 1.0: got %f instead. This is greater than the acceptable epsilon of %f", sum, epsilon)
+	if !sum.Equal(math.LegacyOneDec()) {
+		return ErrTokenomicsParamInvalid.Wrapf("mint allocation percentages do not add to 1.0: got %s", sum)
 	}
 
 	return nil
@@ -379,13 +392,17 @@ func ValidateDaoRewardAddress(daoRewardAddress any) error {
 
 // ValidateGlobalInflationPerClaim validates the GlobalInflationPerClaim param.
 func ValidateGlobalInflationPerClaim(GlobalInflationPerClaimAny any) error {
-	GlobalInflationPerClaim, ok := GlobalInflationPerClaimAny.(float64)
+	GlobalInflationPerClaim, ok := GlobalInflationPerClaimAny.(math.LegacyDec)
 	if !ok {
 		return ErrTokenomicsParamInvalid.Wrapf("invalid parameter type: %T", GlobalInflationPerClaimAny)
 	}
 
-	if GlobalInflationPerClaim < 0 {
-		return ErrTokenomicsParamInvalid.Wrapf("GlobalInflationPerClaim must be greater than or equal to 0: %f", GlobalInflationPerClaim)
+	if GlobalInflationPerClaim.IsNil() {
+		return ErrTokenomicsParamInvalid.Wrap("GlobalInflationPerClaim must be set")
+	}
+
+	if GlobalInflationPerClaim.IsNegative() {
+		return ErrTokenomicsParamInvalid.Wrapf("GlobalInflationPerClaim must be greater than or equal to 0: %s", GlobalInflationPerClaim)
 	}
 
 	return nil
@@ -422,10 +439,9 @@ func ValidateMintEqualsBurnClaimDistribution(mintEqualsBurnClaimDistributionAny
 	}
 
 	// Validate sum equals 1
-	const epsilon = 1e-10 // Small epsilon value for floating-point comparison
 	sum := mintEqualsBurnClaimDistribution.Sum()
-	if math.Abs(sum-1) > epsilon {
-		return ErrTokenomicsParamInvalid.Wrapf("mint equals burn claim distribution percentages do not add to 1.0: got %f", sum)
+	if !sum.Equal(math.LegacyOneDec()) {
+		return ErrTokenomicsParamInvalid.Wrapf("mint equals burn claim distribution percentages do not add to 1.0: got %s", sum)
 	}
 
 	return nil
@@ -436,11 +452,15 @@ func ValidateMintEqualsBurnClaimDistribution(mintEqualsBurnClaimDistributionAny
 // - 0 is exclusive (must mint something)
 // - 1 is inclusive (can mint 100% = no deflation)
 func ValidateMintRatio(mintRatioAny any) error {
-	mintRatio, ok := mintRatioAny.(float64)
+	mintRatio, ok := mintRatioAny.(math.LegacyDec)
 	if !ok {
 		return ErrTokenomicsParamInvalid.Wrapf("invalid parameter type: %T", mintRatioAny)
 	}
 
+	if mintRatio.IsNil() {
+		return ErrTokenomicsParamInvalid.Wrap("mint_ratio must be set")
+	}
+
 	// DEV_NOTE: The upper bound of 1 is LOAD-BEARING beyond its own semantics.
 	// CheckAntiCollusionInvariant is only a reporting-level check (a warning, not a
 	// rejection) precisely because this bound plus the "shares sum to 1" rule make
@@ -448,8 +468,8 @@ func ValidateMintRatio(mintRatioAny any) error {
 	// be break-even. Raising this bound to support a net-inflationary regime makes
 	// collusion genuinely profitable and REQUIRES re-escalating that check to a hard
 	// rejection on the MsgUpdateParam(s) path. See Params.CheckAntiCollusionInvariant.
-	if mintRatio <= 0 || mintRatio > 1 {
-		return ErrTokenomicsParamInvalid.Wrapf("mint_ratio must be in range (0, 1]: got %f", mintRatio)
+	if !mintRatio.IsPositive() || mintRatio.GT(math.LegacyOneDec()) {
+		return ErrTokenomicsParamInvalid.Wrapf("mint_ratio must be in range (0, 1]: got %s", mintRatio)
 	}
 
 	return nil
@@ -481,3 +501,13 @@ func ValidateOverservicingBonusMultiplier(overservicingBonusMultiplierAny any) e
 
 	return nil
 }
+
+// ValidateSettlementHistoryRetentionBlocks validates the SettlementHistoryRetentionBlocks param.
+// Any value is valid, 0 disabling the settlement history.
+func ValidateSettlementHistoryRetentionBlocks(settlementHistoryRetentionBlocksAny any) error {
+	if _, ok := settlementHistoryRetentionBlocksAny.(uint64); !ok {
+		return ErrTokenomicsParamInvalid.Wrapf("invalid parameter type: %T", settlementHistoryRetentionBlocksAny)
+	}
+
+	return nil
+}
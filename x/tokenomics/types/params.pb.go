@@ -4,7 +4,7 @@
 package types
 
 import (
-	encoding_binary "encoding/binary"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -35,7 +35,7 @@ type Params struct {
 	MintAllocationPercentages MintAllocationPercentages `protobuf:"bytes,1,opt,name=mint_allocation_percentages,json=mintAllocationPercentages,proto3" json:"mint_allocation_percentages" yaml:"mint_allocation_percentages"`
 	// global_inflation_per_claim is the percentage of a claim's claimable uPOKT amount to be minted on settlement.
 	// GlobalMintTLM: Only used by the GlobalMintTLM at the end of claim settlement.
-	GlobalInflationPerClaim float64 `protobuf:"fixed64,7,opt,name=global_inflation_per_claim,json=globalInflationPerClaim,proto3" json:"global_inflation_per_claim" yaml:"global_inflation_per_claim"`
+	GlobalInflationPerClaim cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=global_inflation_per_claim,json=globalInflationPerClaim,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_inflation_per_claim" yaml:"global_inflation_per_claim"`
 	// mint_equals_burn_claim_distribution controls how the settlement amount is distributed
 	// when global inflation is disabled (global_inflation_per_claim = 0).
 	// MintEqualsBurnTLM: Only used by the MintEqualsBurnTLM at the end of claim settlement.
@@ -44,7 +44,7 @@ type Params struct {
 	// PIP-41: A value of 0.975 means 97.5% of burned tokens are minted, 2.5% permanently removed.
 	// MintEqualsBurnTLM: Only used by the MintEqualsBurnTLM at the end of claim settlement.
 	// Default: 1.0 (no deflation - mint equals burn for backward compatibility)
-	MintRatio float64 `protobuf:"fixed64,9,opt,name=mint_ratio,json=mintRatio,proto3" json:"mint_ratio" yaml:"mint_ratio"`
+	MintRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=mint_ratio,json=mintRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"mint_ratio" yaml:"mint_ratio"`
 	// overservicing_bonus_multiplier bounds how far above its guaranteed floor
 	// (per-session budget / actual number of claiming suppliers) a single supplier's
 	// settlement may be raised by redistributing budget unused by idle/light suppliers.
@@ -60,6 +60,11 @@ type Params struct {
 	// then opened by governance.
 	// TokenLogicModules: Only used during claim settlement (ensureClaimAmountLimits).
 	OverservicingBonusMultiplier uint64 `protobuf:"varint,10,opt,name=overservicing_bonus_multiplier,json=overservicingBonusMultiplier,proto3" json:"overservicing_bonus_multiplier" yaml:"overservicing_bonus_multiplier"`
+	// settlement_history_retention_blocks is the number of blocks, counted from a claim's
+	// session end height, for which its settlement result is kept in the settlement history index.
+	// Older settlement results are pruned at the end of every block.
+	// 0 disables the settlement history: settlement results are neither indexed nor queryable.
+	SettlementHistoryRetentionBlocks uint64 `protobuf:"varint,11,opt,name=settlement_history_retention_blocks,json=settlementHistoryRetentionBlocks,proto3" json:"settlement_history_retention_blocks" yaml:"settlement_history_retention_blocks"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -105,13 +110,6 @@ func (m *Params) GetMintAllocationPercentages() MintAllocationPercentages {
 	return MintAllocationPercentages{}
 }
 
-func (m *Params) GetGlobalInflationPerClaim() float64 {
-	if m != nil {
-		return m.GlobalInflationPerClaim
-	}
-	return 0
-}
-
 func (m *Params) GetMintEqualsBurnClaimDistribution() MintEqualsBurnClaimDistribution {
 	if m != nil {
 		return m.MintEqualsBurnClaimDistribution
@@ -119,16 +117,16 @@ func (m *Params) GetMintEqualsBurnClaimDistribution() MintEqualsBurnClaimDistrib
 	return MintEqualsBurnClaimDistribution{}
 }
 
-func (m *Params) GetMintRatio() float64 {
+func (m *Params) GetOverservicingBonusMultiplier() uint64 {
 	if m != nil {
-		return m.MintRatio
+		return m.OverservicingBonusMultiplier
 	}
 	return 0
 }
 
-func (m *Params) GetOverservicingBonusMultiplier() uint64 {
+func (m *Params) GetSettlementHistoryRetentionBlocks() uint64 {
 	if m != nil {
-		return m.OverservicingBonusMultiplier
+		return m.SettlementHistoryRetentionBlocks
 	}
 	return 0
 }
@@ -139,15 +137,15 @@ func (m *Params) GetOverservicingBonusMultiplier() uint64 {
 // TODO_DISTANT_FUTURE: Remove this once global inflation is disabled in perpetuity.
 type MintAllocationPercentages struct {
 	// dao - % of newley minted tokens sent to the DAO reward address.
-	Dao float64 `protobuf:"fixed64,1,opt,name=dao,proto3" json:"dao" yaml:"dao"`
+	Dao cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=dao,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dao" yaml:"dao"`
 	// proposer - % of newley minted tokens sent to the block proposer (i.e. validator0 account address.
-	Proposer float64 `protobuf:"fixed64,2,opt,name=proposer,proto3" json:"proposer" yaml:"proposer"`
+	Proposer cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=proposer,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proposer" yaml:"proposer"`
 	// supplier - % of newley minted tokens sent to the block supplier account address.
-	Supplier float64 `protobuf:"fixed64,3,opt,name=supplier,proto3" json:"supplier" yaml:"supplier"`
+	Supplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=supplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"supplier" yaml:"supplier"`
 	// source_owner - % of newley minted tokens sent to the service source owner account address.
-	SourceOwner float64 `protobuf:"fixed64,4,opt,name=source_owner,json=sourceOwner,proto3" json:"source_owner" yaml:"source_owner"`
+	SourceOwner cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=source_owner,json=sourceOwner,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"source_owner" yaml:"source_owner"`
 	// application - % of newley minted tokens sent to the application account address.
-	Application float64 `protobuf:"fixed64,5,opt,name=application,proto3" json:"application" yaml:"application"`
+	Application cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=application,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"application" yaml:"application"`
 }
 
 func (m *MintAllocationPercentages) Reset()         { *m = MintAllocationPercentages{} }
@@ -179,58 +177,23 @@ func (m *MintAllocationPercentages) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MintAllocationPercentages proto.InternalMessageInfo
 
-func (m *MintAllocationPercentages) GetDao() float64 {
-	if m != nil {
-		return m.Dao
-	}
-	return 0
-}
-
-func (m *MintAllocationPercentages) GetProposer() float64 {
-	if m != nil {
-		return m.Proposer
-	}
-	return 0
-}
-
-func (m *MintAllocationPercentages) GetSupplier() float64 {
-	if m != nil {
-		return m.Supplier
-	}
-	return 0
-}
-
-func (m *MintAllocationPercentages) GetSourceOwner() float64 {
-	if m != nil {
-		return m.SourceOwner
-	}
-	return 0
-}
-
-func (m *MintAllocationPercentages) GetApplication() float64 {
-	if m != nil {
-		return m.Application
-	}
-	return 0
-}
-
 // MintEqualsBurnClaimDistribution captures the distribution of claimable tokens.
 // The sum of all tokens being burnt from the application's stake must equal 1.
 // GlobalMintEqualsBurnTLM: Only used by the GlobalMintEqualsBurnTLM at the end of claim settlement.
 type MintEqualsBurnClaimDistribution struct {
 	// dao - % of claimable tokens sent to the DAO reward address.
-	Dao float64 `protobuf:"fixed64,1,opt,name=dao,proto3" json:"dao" yaml:"dao"`
+	Dao cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=dao,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dao" yaml:"dao"`
 	// TODO_TECHDEBT: Rename "proposer" to "validators" to reflect the work done in #1753.
 	// This will span all references to the term "proposer" across documentation, functions, protobufs, variables, tooling, etc..
 	//
 	// proposer - % of claimable tokens sent to the block proposer (i.e. validator0) account address.
-	Proposer float64 `protobuf:"fixed64,2,opt,name=proposer,proto3" json:"proposer" yaml:"proposer"`
+	Proposer cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=proposer,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proposer" yaml:"proposer"`
 	// supplier - % of claimable tokens sent to the block supplier account address.
-	Supplier float64 `protobuf:"fixed64,3,opt,name=supplier,proto3" json:"supplier" yaml:"supplier"`
+	Supplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=supplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"supplier" yaml:"supplier"`
 	// source_owner - % of claimable tokens sent to the service source owner account address.
-	SourceOwner float64 `protobuf:"fixed64,4,opt,name=source_owner,json=sourceOwner,proto3" json:"source_owner" yaml:"source_owner"`
+	SourceOwner cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=source_owner,json=sourceOwner,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"source_owner" yaml:"source_owner"`
 	// application - % of claimable tokens sent to the application account address.
-	Application float64 `protobuf:"fixed64,5,opt,name=application,proto3" json:"application" yaml:"application"`
+	Application cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=application,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"application" yaml:"application"`
 }
 
 func (m *MintEqualsBurnClaimDistribution) Reset()         { *m = MintEqualsBurnClaimDistribution{} }
@@ -262,41 +225,6 @@ func (m *MintEqualsBurnClaimDistribution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MintEqualsBurnClaimDistribution proto.InternalMessageInfo
 
-func (m *MintEqualsBurnClaimDistribution) GetDao() float64 {
-	if m != nil {
-		return m.Dao
-	}
-	return 0
-}
-
-func (m *MintEqualsBurnClaimDistribution) GetProposer() float64 {
-	if m != nil {
-		return m.Proposer
-	}
-	return 0
-}
-
-func (m *MintEqualsBurnClaimDistribution) GetSupplier() float64 {
-	if m != nil {
-		return m.Supplier
-	}
-	return 0
-}
-
-func (m *MintEqualsBurnClaimDistribution) GetSourceOwner() float64 {
-	if m != nil {
-		return m.SourceOwner
-	}
-	return 0
-}
-
-func (m *MintEqualsBurnClaimDistribution) GetApplication() float64 {
-	if m != nil {
-		return m.Application
-	}
-	return 0
-}
-
 func init() {
 	proto.RegisterType((*Params)(nil), "pocket.tokenomics.Params")
 	proto.RegisterType((*MintAllocationPercentages)(nil), "pocket.tokenomics.MintAllocationPercentages")
@@ -306,52 +234,60 @@ func init() {
 func init() { proto.RegisterFile("pocket/tokenomics/params.proto", fileDescriptor_577bb6b98de8f6d1) }
 
 var fileDescriptor_577bb6b98de8f6d1 = []byte{
-	// 718 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xbd, 0x6f, 0xd3, 0x4c,
-	0x18, 0xcf, 0xb5, 0x7d, 0xfb, 0x71, 0x7d, 0xa5, 0xf7, 0xad, 0x29, 0xc2, 0x09, 0xc8, 0x17, 0xae,
-	0xaa, 0x1a, 0x55, 0x34, 0x91, 0xda, 0xad, 0x4c, 0x75, 0x41, 0xa8, 0xa0, 0x8a, 0xca, 0x88, 0x01,
-	0x16, 0xeb, 0xe2, 0x1c, 0xc1, 0x8a, 0xed, 0x33, 0x67, 0xbb, 0xa5, 0x1b, 0x33, 0x13, 0xcc, 0x2c,
-	0x8c, 0x8c, 0x0c, 0x48, 0xfc, 0x0b, 0x1d, 0x2b, 0x58, 0x8a, 0x90, 0x4e, 0x28, 0x1d, 0x40, 0x1e,
-	0xfd, 0x17, 0x20, 0xdf, 0xe5, 0xc3, 0x55, 0x49, 0x8a, 0x98, 0x59, 0xa2, 0x7b, 0x7e, 0x1f, 0xcf,
-	0x73, 0xf9, 0xe9, 0x1e, 0x19, 0x1a, 0x21, 0x73, 0x3a, 0x34, 0x6e, 0xc4, 0xac, 0x43, 0x03, 0xe6,
-	0xbb, 0x4e, 0xd4, 0x08, 0x09, 0x27, 0x7e, 0x54, 0x0f, 0x39, 0x8b, 0x99, 0xb6, 0xa0, 0xf8, 0xfa,
-	0x90, 0xaf, 0x2c, 0x10, 0xdf, 0x0d, 0x58, 0x43, 0xfe, 0x2a, 0x55, 0x65, 0xb1, 0xcd, 0xda, 0x4c,
-	0x1e, 0x1b, 0xf9, 0xa9, 0x87, 0x96, 0x1d, 0x16, 0xf9, 0x2c, 0xb2, 0x15, 0xa1, 0x0a, 0x45, 0xe1,
-	0x37, 0x33, 0x70, 0x7a, 0x4f, 0xce, 0xd1, 0x0e, 0xa1, 0xd6, 0x22, 0xcc, 0xe6, 0xf4, 0x80, 0xf0,
-	0x96, 0x4d, 0x5a, 0x2d, 0x4e, 0xa3, 0x48, 0x9f, 0xae, 0x82, 0xda, 0x9c, 0x79, 0x2f, 0x15, 0xe8,
-	0x17, 0x6c, 0x26, 0x50, 0xf9, 0x90, 0xf8, 0xde, 0x26, 0x3e, 0xcf, 0xe1, 0x4f, 0x1f, 0xd6, 0x16,
-	0x7b, 0xb3, 0xb6, 0x14, 0xf4, 0x20, 0xe6, 0x6e, 0xd0, 0xb6, 0xfe, 0x6f, 0x11, 0x66, 0x49, 0x6d,
-	0x0f, 0xd7, 0x3e, 0x02, 0x78, 0xd5, 0x77, 0x83, 0xd8, 0x26, 0x9e, 0xc7, 0x1c, 0x12, 0xbb, 0x2c,
-	0xb0, 0x43, 0xca, 0x1d, 0x1a, 0xc4, 0xa4, 0x4d, 0x23, 0x1d, 0x54, 0x41, 0x6d, 0x7e, 0xfd, 0x46,
-	0xfd, 0x5c, 0x06, 0xf5, 0x5d, 0x37, 0x88, 0xb7, 0x06, 0xa6, 0xbd, 0xa1, 0xc7, 0xdc, 0x39, 0x12,
-	0xa8, 0x94, 0x0a, 0x34, 0xae, 0x71, 0x26, 0x10, 0x56, 0xf7, 0x1f, 0x23, 0xc2, 0x56, 0xd9, 0x1f,
-	0x35, 0x45, 0x7b, 0x01, 0x60, 0xa5, 0xed, 0xb1, 0x26, 0xf1, 0x6c, 0x37, 0x78, 0xe2, 0x0d, 0xcc,
-	0xb6, 0xe3, 0x11, 0xd7, 0xd7, 0x67, 0xaa, 0xa0, 0x06, 0xcc, 0xed, 0x54, 0xa0, 0x31, 0xaa, 0x4c,
-	0xa0, 0xeb, 0xea, 0x16, 0xa3, 0x35, 0xd8, 0xba, 0xa2, 0xc8, 0x9d, 0x3e, 0xb7, 0x47, 0xf9, 0x76,
-	0xce, 0x68, 0x5f, 0x01, 0x5c, 0x92, 0xd7, 0xa7, 0xcf, 0x12, 0xe2, 0x45, 0x76, 0x33, 0xe1, 0x81,
-	0x32, 0xd9, 0x2d, 0x37, 0x8a, 0xb9, 0xdb, 0x4c, 0x72, 0xbd, 0x3e, 0x2b, 0x43, 0x5c, 0x1f, 0x11,
-	0xe2, 0x6d, 0x69, 0x36, 0x13, 0x1e, 0xc8, 0xae, 0xb7, 0x0a, 0x4e, 0xf3, 0x51, 0x2f, 0xca, 0xdf,
-	0x19, 0x93, 0x09, 0xb4, 0x5a, 0x88, 0x74, 0xbc, 0x18, 0x5b, 0xc8, 0x1f, 0x3f, 0x5b, 0x33, 0x21,
-	0x94, 0x8d, 0x78, 0xfe, 0xa7, 0xf5, 0x39, 0x99, 0xe7, 0x52, 0x2a, 0x50, 0x01, 0xcd, 0x04, 0x5a,
-	0x28, 0x8c, 0x94, 0x18, 0xb6, 0xe6, 0xf2, 0xc2, 0xca, 0xcf, 0xda, 0x6b, 0x00, 0x0d, 0xb6, 0x4f,
-	0x79, 0x44, 0xf9, 0xbe, 0xeb, 0xb8, 0x41, 0xdb, 0x6e, 0xb2, 0x20, 0x89, 0x6c, 0x3f, 0xf1, 0x62,
-	0x37, 0xf4, 0x5c, 0xca, 0x75, 0x58, 0x05, 0xb5, 0x29, 0xf9, 0xcc, 0x2f, 0x50, 0x66, 0x02, 0x2d,
-	0xab, 0x61, 0xe3, 0x75, 0xd8, 0xba, 0x76, 0x46, 0x60, 0xe6, 0xfc, 0xee, 0x80, 0xde, 0x5c, 0xfa,
-	0xf1, 0x16, 0x81, 0x97, 0xdf, 0xdf, 0xaf, 0x56, 0x7a, 0x8b, 0xff, 0xbc, 0xb8, 0xfa, 0x6a, 0x25,
-	0xf1, 0xe7, 0x09, 0x58, 0x1e, 0xf9, 0xc2, 0xb5, 0x15, 0x38, 0xd9, 0x22, 0x4c, 0x2e, 0x07, 0x30,
-	0x2f, 0xa7, 0x02, 0xe5, 0x65, 0x26, 0x10, 0x1c, 0xac, 0x24, 0xb6, 0x72, 0x48, 0xbb, 0x09, 0x67,
-	0x43, 0xce, 0x42, 0x16, 0x51, 0xae, 0x4f, 0x48, 0x35, 0x4a, 0x05, 0x1a, 0x60, 0x99, 0x40, 0xff,
-	0x29, 0x4b, 0x1f, 0xc1, 0xd6, 0x80, 0xcc, 0xcd, 0x51, 0x12, 0xaa, 0x94, 0x26, 0x87, 0xe6, 0x3e,
-	0x36, 0x34, 0xf7, 0x11, 0x6c, 0x0d, 0x48, 0xed, 0x2e, 0xfc, 0x37, 0x62, 0x09, 0x77, 0xa8, 0xcd,
-	0x0e, 0x02, 0xca, 0xf5, 0x29, 0xd9, 0x60, 0x25, 0x15, 0xe8, 0x0c, 0x9e, 0x09, 0x74, 0xa9, 0xd7,
-	0xa4, 0x80, 0x62, 0x6b, 0x5e, 0x95, 0xf7, 0xf3, 0x4a, 0xbb, 0x03, 0xe7, 0x49, 0xde, 0x56, 0x05,
-	0xa1, 0xff, 0x23, 0x5b, 0x2d, 0xa7, 0x02, 0x15, 0xe1, 0x4c, 0x20, 0x4d, 0x75, 0x2a, 0x80, 0xd8,
-	0x2a, 0x4a, 0xf0, 0x97, 0x09, 0x88, 0x2e, 0x78, 0xf2, 0x7f, 0xb3, 0xfd, 0xb3, 0x6c, 0xcd, 0x87,
-	0xef, 0xba, 0x06, 0x38, 0xea, 0x1a, 0xe0, 0xb8, 0x6b, 0x80, 0x93, 0xae, 0x01, 0xbe, 0x75, 0x0d,
-	0xf0, 0xea, 0xd4, 0x28, 0x1d, 0x9f, 0x1a, 0xa5, 0x93, 0x53, 0xa3, 0xf4, 0x78, 0xa3, 0xed, 0xc6,
-	0x4f, 0x93, 0x66, 0xdd, 0x61, 0x7e, 0x23, 0x64, 0x9d, 0x78, 0x2d, 0xa0, 0xf1, 0x01, 0xe3, 0x1d,
-	0x59, 0x70, 0xe6, 0x79, 0x67, 0x37, 0x21, 0x3e, 0x0c, 0x69, 0xd4, 0x9c, 0x96, 0x5f, 0xab, 0x8d,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0x7a, 0xef, 0x84, 0x26, 0x07, 0x00, 0x00,
+	// 835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x88, 0x55, 0xba, 0x3b, 0x29, 0x22, 0x31, 0x95, 0x70, 0x52, 0xe4, 0x09, 0x8e, 0x90,
+	0xa2, 0x8a, 0xee, 0x4a, 0xed, 0xad, 0xb7, 0x9a, 0x80, 0x68, 0xa0, 0x22, 0x32, 0x14, 0x15, 0x2e,
+	0xd6, 0xac, 0x3d, 0x38, 0xa3, 0xb5, 0x67, 0xcc, 0xcc, 0xb8, 0xe9, 0x8a, 0x7f, 0xc0, 0x09, 0xfe,
+	0x01, 0x07, 0x0e, 0x1c, 0x8b, 0x84, 0xc4, 0x5f, 0xe8, 0x09, 0x55, 0x88, 0x43, 0x05, 0x92, 0x85,
+	0x36, 0x07, 0x50, 0x8e, 0xfe, 0x05, 0xc8, 0x33, 0xde, 0x5d, 0x27, 0xdb, 0xdd, 0xba, 0xf7, 0x5c,
+	0x56, 0xf6, 0xfb, 0xbe, 0xef, 0x7d, 0xcf, 0xef, 0xcd, 0x3c, 0x2d, 0x74, 0x32, 0x1e, 0x8e, 0x88,
+	0x1a, 0x28, 0x3e, 0x22, 0x8c, 0xa7, 0x34, 0x94, 0x83, 0x0c, 0x0b, 0x9c, 0xca, 0x7e, 0x26, 0xb8,
+	0xe2, 0xd6, 0x96, 0xc1, 0xfb, 0x73, 0x7c, 0x67, 0x0b, 0xa7, 0x94, 0xf1, 0x81, 0xfe, 0x35, 0xac,
+	0x9d, 0x6b, 0x31, 0x8f, 0xb9, 0x7e, 0x1c, 0x54, 0x4f, 0x75, 0x74, 0x3b, 0xe4, 0x32, 0xe5, 0x32,
+	0x30, 0x80, 0x79, 0x31, 0x90, 0xfb, 0xa4, 0x07, 0xd7, 0x8f, 0xb4, 0x8f, 0x35, 0x86, 0x56, 0x84,
+	0x79, 0x20, 0xc8, 0x09, 0x16, 0x51, 0x80, 0xa3, 0x48, 0x10, 0x29, 0xed, 0xf5, 0x5d, 0xb0, 0xdf,
+	0xf3, 0x3e, 0x3e, 0x2b, 0xd0, 0x0b, 0xd0, 0xb2, 0x40, 0xdb, 0x63, 0x9c, 0x26, 0x77, 0xdc, 0x45,
+	0xcc, 0xfd, 0xe3, 0xd7, 0x9b, 0xd7, 0x6a, 0xaf, 0xbb, 0x26, 0xf4, 0x99, 0x12, 0x94, 0xc5, 0xfe,
+	0x66, 0x84, 0xb9, 0xaf, 0xb9, 0x75, 0xdc, 0xfa, 0x0d, 0xc0, 0xeb, 0x29, 0x65, 0x2a, 0xc0, 0x49,
+	0xc2, 0x43, 0xac, 0x28, 0x67, 0x41, 0x46, 0x44, 0x48, 0x98, 0xc2, 0x31, 0x91, 0x36, 0xd8, 0x05,
+	0xfb, 0x1b, 0xb7, 0xde, 0xeb, 0x2f, 0xf4, 0xa0, 0x7f, 0x9f, 0x32, 0x75, 0x77, 0x26, 0x3a, 0x9a,
+	0x6b, 0xbc, 0x7b, 0x4f, 0x0b, 0xb4, 0x76, 0x56, 0xa0, 0x55, 0x89, 0xcb, 0x02, 0xb9, 0xa6, 0xfe,
+	0x15, 0x24, 0xd7, 0xdf, 0x4e, 0x97, 0xb9, 0x58, 0xbf, 0x00, 0xb8, 0x13, 0x27, 0x7c, 0x88, 0x93,
+	0x80, 0xb2, 0xaf, 0x93, 0x99, 0x38, 0x08, 0x13, 0x4c, 0x53, 0xfb, 0xaa, 0xee, 0x9e, 0xaa, 0x4a,
+	0xf9, 0xab, 0x40, 0xd7, 0x4d, 0x3b, 0x64, 0x34, 0xea, 0x53, 0x3e, 0x48, 0xb1, 0x3a, 0xee, 0x7f,
+	0x42, 0x62, 0x1c, 0x8e, 0x0f, 0x48, 0x78, 0x56, 0xa0, 0x15, 0x89, 0xca, 0x02, 0xbd, 0x63, 0x0a,
+	0x5d, 0xce, 0xa9, 0x1a, 0x0e, 0xeb, 0x86, 0x1f, 0x90, 0xd0, 0x7f, 0xcb, 0x50, 0xef, 0x4d, 0x99,
+	0x47, 0x44, 0xbc, 0x5f, 0xf1, 0xac, 0xbf, 0x01, 0xdc, 0xd3, 0xdf, 0x4b, 0xbe, 0xc9, 0x71, 0x22,
+	0x83, 0x61, 0x2e, 0x98, 0x49, 0x11, 0x44, 0x54, 0x2a, 0x41, 0x87, 0x79, 0xc5, 0xb7, 0xbb, 0xba,
+	0xeb, 0xb7, 0x96, 0x74, 0xfd, 0x03, 0x2d, 0xf6, 0x72, 0xc1, 0x74, 0xd6, 0x83, 0x86, 0xd2, 0xfb,
+	0xb2, 0xee, 0x7d, 0x1b, 0x9b, 0xb2, 0x40, 0x37, 0x1a, 0x33, 0x58, 0x4d, 0x76, 0x7d, 0x94, 0xae,
+	0xf6, 0xb6, 0x24, 0x84, 0x3a, 0x91, 0xa8, 0x3e, 0xda, 0x7e, 0x5d, 0x0f, 0xe0, 0xf3, 0x76, 0x03,
+	0x68, 0x08, 0xcb, 0x02, 0x6d, 0x35, 0xaa, 0xd2, 0xb1, 0x8b, 0x0d, 0xee, 0x55, 0x90, 0x5f, 0x21,
+	0xd6, 0x0f, 0x00, 0x3a, 0xfc, 0x11, 0x11, 0x92, 0x88, 0x47, 0x34, 0xa4, 0x2c, 0x0e, 0x86, 0x9c,
+	0xe5, 0x32, 0x48, 0xf3, 0x44, 0xd1, 0x2c, 0xa1, 0x44, 0xd8, 0x70, 0x17, 0xec, 0x77, 0xf4, 0x45,
+	0x7a, 0x09, 0xb3, 0x2c, 0xd0, 0xbb, 0xc6, 0x7a, 0x35, 0xcf, 0xf5, 0xdf, 0x3e, 0x47, 0xf0, 0x2a,
+	0xfc, 0xfe, 0x0c, 0xb6, 0x7e, 0x02, 0x70, 0x4f, 0x12, 0xa5, 0x12, 0x92, 0x12, 0xa6, 0x82, 0x63,
+	0x2a, 0x15, 0x17, 0xe3, 0x40, 0x10, 0x45, 0x98, 0x3e, 0x39, 0xc3, 0x84, 0x87, 0x23, 0x69, 0x6f,
+	0xe8, 0xc2, 0x1e, 0x54, 0xe3, 0x6a, 0x41, 0x9f, 0x8f, 0xab, 0x05, 0xd9, 0xf5, 0x77, 0xe7, 0xac,
+	0x8f, 0x0c, 0xc9, 0x9f, 0x72, 0x3c, 0x4d, 0xb9, 0xb3, 0xf7, 0xdf, 0x8f, 0x08, 0x7c, 0xf7, 0xef,
+	0x93, 0x1b, 0x3b, 0xf5, 0x06, 0x7c, 0xdc, 0xdc, 0x81, 0x66, 0x37, 0x1d, 0x76, 0xba, 0x57, 0x36,
+	0xbb, 0x87, 0x9d, 0x6e, 0x6f, 0x13, 0xba, 0xbf, 0x77, 0xe0, 0xf6, 0xd2, 0x6b, 0x6f, 0x3d, 0x84,
+	0xaf, 0x45, 0x98, 0xd7, 0x6b, 0xeb, 0xc3, 0x76, 0x73, 0xaf, 0x14, 0x65, 0x81, 0xe0, 0x6c, 0x95,
+	0x5d, 0x9c, 0x74, 0x45, 0xb0, 0x12, 0xd8, 0xcd, 0x04, 0xcf, 0xb8, 0x24, 0xc2, 0xbe, 0xa2, 0xd3,
+	0x1f, 0xb5, 0x4b, 0x3f, 0x93, 0x95, 0x05, 0x7a, 0xc3, 0x78, 0x4c, 0x23, 0x17, 0x8d, 0x66, 0xd4,
+	0xca, 0x4d, 0xe6, 0x99, 0x39, 0x3a, 0xdd, 0x57, 0x72, 0x9b, 0xca, 0xe6, 0x6e, 0xd3, 0xc8, 0x82,
+	0xdb, 0x14, 0xb0, 0xbe, 0x85, 0x57, 0x25, 0xcf, 0x45, 0x48, 0x02, 0x7e, 0xc2, 0x88, 0xb0, 0x7b,
+	0xda, 0xf1, 0x61, 0x3b, 0xc7, 0x73, 0xd2, 0xb2, 0x40, 0x6f, 0xd6, 0xae, 0x8d, 0xe8, 0x45, 0xe7,
+	0x0d, 0x03, 0x7e, 0x5a, 0x61, 0xd6, 0x63, 0xb8, 0x81, 0xab, 0x3a, 0xcc, 0x30, 0xf5, 0x45, 0xe9,
+	0x79, 0x5f, 0xb4, 0xf3, 0x6e, 0x2a, 0xcb, 0x02, 0x59, 0xc6, 0xba, 0x11, 0x5c, 0x70, 0x6e, 0x60,
+	0x87, 0x9d, 0x2e, 0xd8, 0x5c, 0x77, 0xff, 0xec, 0x40, 0xf4, 0x92, 0x8d, 0x76, 0x79, 0xac, 0x2e,
+	0x8f, 0xd5, 0x2b, 0x1e, 0x2b, 0xef, 0xc1, 0xcf, 0x13, 0x07, 0x3c, 0x9d, 0x38, 0xe0, 0xd9, 0xc4,
+	0x01, 0xcf, 0x27, 0x0e, 0xf8, 0x67, 0xe2, 0x80, 0xef, 0x4f, 0x9d, 0xb5, 0x67, 0xa7, 0xce, 0xda,
+	0xf3, 0x53, 0x67, 0xed, 0xab, 0xdb, 0x31, 0x55, 0xc7, 0xf9, 0xb0, 0x1f, 0xf2, 0x74, 0x90, 0xf1,
+	0x91, 0xba, 0xc9, 0x88, 0x3a, 0xe1, 0x62, 0xa4, 0x5f, 0x04, 0x4f, 0x92, 0xf3, 0xbb, 0x50, 0x8d,
+	0x33, 0x22, 0x87, 0xeb, 0xfa, 0x8f, 0xdb, 0xed, 0xff, 0x07, 0x00, 0x3f, 0x94, 0xf9, 0xb6, 0x31,
+	0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -379,18 +315,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MintAllocationPercentages.Equal(&that1.MintAllocationPercentages) {
 		return false
 	}
-	if this.GlobalInflationPerClaim != that1.GlobalInflationPerClaim {
+	if !this.GlobalInflationPerClaim.Equal(that1.GlobalInflationPerClaim) {
 		return false
 	}
 	if !this.MintEqualsBurnClaimDistribution.Equal(&that1.MintEqualsBurnClaimDistribution) {
 		return false
 	}
-	if this.MintRatio != that1.MintRatio {
+	if !this.MintRatio.Equal(that1.MintRatio) {
 		return false
 	}
 	if this.OverservicingBonusMultiplier != that1.OverservicingBonusMultiplier {
 		return false
 	}
+	if this.SettlementHistoryRetentionBlocks != that1.SettlementHistoryRetentionBlocks {
+		return false
+	}
 	return true
 }
 func (this *MintAllocationPercentages) Equal(that interface{}) bool {
@@ -412,19 +351,19 @@ func (this *MintAllocationPercentages) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Dao != that1.Dao {
+	if !this.Dao.Equal(that1.Dao) {
 		return false
 	}
-	if this.Proposer != that1.Proposer {
+	if !this.Proposer.Equal(that1.Proposer) {
 		return false
 	}
-	if this.Supplier != that1.Supplier {
+	if !this.Supplier.Equal(that1.Supplier) {
 		return false
 	}
-	if this.SourceOwner != that1.SourceOwner {
+	if !this.SourceOwner.Equal(that1.SourceOwner) {
 		return false
 	}
-	if this.Application != that1.Application {
+	if !this.Application.Equal(that1.Application) {
 		return false
 	}
 	return true
@@ -448,19 +387,19 @@ func (this *MintEqualsBurnClaimDistribution) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Dao != that1.Dao {
+	if !this.Dao.Equal(that1.Dao) {
 		return false
 	}
-	if this.Proposer != that1.Proposer {
+	if !this.Proposer.Equal(that1.Proposer) {
 		return false
 	}
-	if this.Supplier != that1.Supplier {
+	if !this.Supplier.Equal(that1.Supplier) {
 		return false
 	}
-	if this.SourceOwner != that1.SourceOwner {
+	if !this.SourceOwner.Equal(that1.SourceOwner) {
 		return false
 	}
-	if this.Application != that1.Application {
+	if !this.Application.Equal(that1.Application) {
 		return false
 	}
 	return true
@@ -485,17 +424,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MintRatio.Size()
+		i -= size
+		if _, err := m.MintRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.GlobalInflationPerClaim.Size()
+		i -= size
+		if _, err := m.GlobalInflationPerClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.SettlementHistoryRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SettlementHistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.OverservicingBonusMultiplier != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.OverservicingBonusMultiplier))
 		i--
 		dAtA[i] = 0x50
 	}
-	if m.MintRatio != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MintRatio))))
-		i--
-		dAtA[i] = 0x49
-	}
 	{
 		size, err := m.MintEqualsBurnClaimDistribution.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -506,12 +464,6 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x42
-	if m.GlobalInflationPerClaim != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GlobalInflationPerClaim))))
-		i--
-		dAtA[i] = 0x39
-	}
 	if len(m.DaoRewardAddress) > 0 {
 		i -= len(m.DaoRewardAddress)
 		copy(dAtA[i:], m.DaoRewardAddress)
@@ -552,36 +504,56 @@ func (m *MintAllocationPercentages) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
-	if m.Application != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Application))))
-		i--
-		dAtA[i] = 0x29
+	{
+		size := m.Application.Size()
+		i -= size
+		if _, err := m.Application.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.SourceOwner != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SourceOwner))))
-		i--
-		dAtA[i] = 0x21
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SourceOwner.Size()
+		i -= size
+		if _, err := m.SourceOwner.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.Supplier != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Supplier))))
-		i--
-		dAtA[i] = 0x19
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Supplier.Size()
+		i -= size
+		if _, err := m.Supplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.Proposer != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Proposer))))
-		i--
-		dAtA[i] = 0x11
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Proposer.Size()
+		i -= size
+		if _, err := m.Proposer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.Dao != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Dao))))
-		i--
-		dAtA[i] = 0x9
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Dao.Size()
+		i -= size
+		if _, err := m.Dao.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x32
 	return len(dAtA) - i, nil
 }
 
@@ -605,36 +577,56 @@ func (m *MintEqualsBurnClaimDistribution) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
-	if m.Application != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Application))))
-		i--
-		dAtA[i] = 0x29
+	{
+		size := m.Application.Size()
+		i -= size
+		if _, err := m.Application.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.SourceOwner != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SourceOwner))))
-		i--
-		dAtA[i] = 0x21
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SourceOwner.Size()
+		i -= size
+		if _, err := m.SourceOwner.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.Supplier != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Supplier))))
-		i--
-		dAtA[i] = 0x19
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Supplier.Size()
+		i -= size
+		if _, err := m.Supplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.Proposer != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Proposer))))
-		i--
-		dAtA[i] = 0x11
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Proposer.Size()
+		i -= size
+		if _, err := m.Proposer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.Dao != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Dao))))
-		i--
-		dAtA[i] = 0x9
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Dao.Size()
+		i -= size
+		if _, err := m.Dao.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x32
 	return len(dAtA) - i, nil
 }
 
@@ -661,17 +653,18 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
-	if m.GlobalInflationPerClaim != 0 {
-		n += 9
-	}
 	l = m.MintEqualsBurnClaimDistribution.Size()
 	n += 1 + l + sovParams(uint64(l))
-	if m.MintRatio != 0 {
-		n += 9
-	}
 	if m.OverservicingBonusMultiplier != 0 {
 		n += 1 + sovParams(uint64(m.OverservicingBonusMultiplier))
 	}
+	if m.SettlementHistoryRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.SettlementHistoryRetentionBlocks))
+	}
+	l = m.GlobalInflationPerClaim.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MintRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -681,21 +674,16 @@ func (m *MintAllocationPercentages) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.Dao != 0 {
-		n += 9
-	}
-	if m.Proposer != 0 {
-		n += 9
-	}
-	if m.Supplier != 0 {
-		n += 9
-	}
-	if m.SourceOwner != 0 {
-		n += 9
-	}
-	if m.Application != 0 {
-		n += 9
-	}
+	l = m.Dao.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Proposer.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Supplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SourceOwner.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Application.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -705,21 +693,16 @@ func (m *MintEqualsBurnClaimDistribution) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.Dao != 0 {
-		n += 9
-	}
-	if m.Proposer != 0 {
-		n += 9
-	}
-	if m.Supplier != 0 {
-		n += 9
-	}
-	if m.SourceOwner != 0 {
-		n += 9
-	}
-	if m.Application != 0 {
-		n += 9
-	}
+	l = m.Dao.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Proposer.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Supplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SourceOwner.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Application.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -823,17 +806,6 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DaoRewardAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalInflationPerClaim", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.GlobalInflationPerClaim = float64(math.Float64frombits(v))
 		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MintEqualsBurnClaimDistribution", wireType)
@@ -867,17 +839,6 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRatio", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.MintRatio = float64(math.Float64frombits(v))
 		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OverservicingBonusMultiplier", wireType)
@@ -897,38 +858,125 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHistoryRetentionBlocks", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
+			m.SettlementHistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementHistoryRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalInflationPerClaim", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MintAllocationPercentages) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalInflationPerClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintAllocationPercentages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -947,61 +995,176 @@ func (m *MintAllocationPercentages) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MintAllocationPercentages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 1 {
+		case 6:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Dao = float64(math.Float64frombits(v))
-		case 2:
-			if wireType != 1 {
+			if err := m.Dao.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Proposer = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
+			if err := m.Proposer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Supplier = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SourceOwner", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.SourceOwner = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
+			if err := m.SourceOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Application = float64(math.Float64frombits(v))
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1052,61 +1215,176 @@ func (m *MintEqualsBurnClaimDistribution) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MintEqualsBurnClaimDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 1 {
+		case 6:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Dao = float64(math.Float64frombits(v))
-		case 2:
-			if wireType != 1 {
+			if err := m.Dao.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Proposer = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
+			if err := m.Proposer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Supplier = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SourceOwner", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.SourceOwner = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
+			if err := m.SourceOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Application = float64(math.Float64frombits(v))
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
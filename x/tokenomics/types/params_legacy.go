@@ -0,0 +1,194 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	cosmosmath "cosmossdk.io/math"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/pokt-network/poktroll/pkg/encoding"
+)
+
+// TODO_DELETE(@olshansk): Remove this file once every network has been upgraded
+// past the release migrating the tokenomics float64 params to decimals.
+
+// Legacy (i.e. float64) field numbers of the decimal tokenomics params.
+// They are reserved in params.proto and MUST NOT be reused.
+const (
+	legacyParamsMintAllocationPercentagesFieldNum       = 1
+	legacyParamsGlobalInflationPerClaimFieldNum         = 7
+	legacyParamsMintEqualsBurnClaimDistributionFieldNum = 8
+	legacyParamsMintRatioFieldNum                       = 9
+
+	legacyDistributionDaoFieldNum         = 1
+	legacyDistributionProposerFieldNum    = 2
+	legacyDistributionSupplierFieldNum    = 3
+	legacyDistributionSourceOwnerFieldNum = 4
+	legacyDistributionApplicationFieldNum = 5
+)
+
+// legacyDistribution holds the float64 percentages shared by the legacy
+// MintAllocationPercentages and MintEqualsBurnClaimDistribution messages.
+type legacyDistribution struct {
+	Dao         float64
+	Proposer    float64
+	Supplier    float64
+	SourceOwner float64
+	Application float64
+}
+
+// LegacyFloat64Params holds the float64 values of the decimal tokenomics params,
+// as they were encoded before being migrated to decimals.
+// An absent field decodes to 0, like the proto3 double it used to be.
+type LegacyFloat64Params struct {
+	MintAllocationPercentages       legacyDistribution
+	GlobalInflationPerClaim         float64
+	MintEqualsBurnClaimDistribution legacyDistribution
+	MintRatio                       float64
+}
+
+// DecodeLegacyFloat64Params decodes the legacy float64 values of the decimal
+// tokenomics params from the given protobuf encoded Params.
+// Fields which are not legacy float64 params are skipped.
+func DecodeLegacyFloat64Params(paramsBz []byte) (LegacyFloat64Params, error) {
+	var legacyParams LegacyFloat64Params
+	err := rangeProtoFields(paramsBz, func(fieldNum protowire.Number, fieldType protowire.Type, valueBz []byte) error {
+		var err error
+		switch {
+		case fieldNum == legacyParamsMintAllocationPercentagesFieldNum && fieldType == protowire.BytesType:
+			legacyParams.MintAllocationPercentages, err = decodeLegacyDistribution(valueBz)
+		case fieldNum == legacyParamsGlobalInflationPerClaimFieldNum && fieldType == protowire.Fixed64Type:
+			legacyParams.GlobalInflationPerClaim, err = decodeLegacyDouble(valueBz)
+		case fieldNum == legacyParamsMintEqualsBurnClaimDistributionFieldNum && fieldType == protowire.BytesType:
+			legacyParams.MintEqualsBurnClaimDistribution, err = decodeLegacyDistribution(valueBz)
+		case fieldNum == legacyParamsMintRatioFieldNum && fieldType == protowire.Fixed64Type:
+			legacyParams.MintRatio, err = decodeLegacyDouble(valueBz)
+		}
+		return err
+	})
+	if err != nil {
+		return LegacyFloat64Params{}, ErrTokenomicsParamInvalid.Wrapf("unable to decode legacy float64 params: %v", err)
+	}
+
+	return legacyParams, nil
+}
+
+// FillUnsetDecParams sets every unset (i.e. nil) decimal param of the given
+// params to its legacy float64 value, converted with encoding.Float64ToLegacyDec.
+// Decimal params which are already set are left untouched, so that filling
+// already migrated params is a no-op.
+func (lp LegacyFloat64Params) FillUnsetDecParams(params *Params) error {
+	mintAllocationPercentages := &params.MintAllocationPercentages
+	mintEqualsBurnClaimDistribution := &params.MintEqualsBurnClaimDistribution
+
+	decParams := []struct {
+		dec         *cosmosmath.LegacyDec
+		legacyFloat float64
+	}{
+		{&mintAllocationPercentages.Dao, lp.MintAllocationPercentages.Dao},
+		{&mintAllocationPercentages.Proposer, lp.MintAllocationPercentages.Proposer},
+		{&mintAllocationPercentages.Supplier, lp.MintAllocationPercentages.Supplier},
+		{&mintAllocationPercentages.SourceOwner, lp.MintAllocationPercentages.SourceOwner},
+		{&mintAllocationPercentages.Application, lp.MintAllocationPercentages.Application},
+		{&params.GlobalInflationPerClaim, lp.GlobalInflationPerClaim},
+		{&mintEqualsBurnClaimDistribution.Dao, lp.MintEqualsBurnClaimDistribution.Dao},
+		{&mintEqualsBurnClaimDistribution.Proposer, lp.MintEqualsBurnClaimDistribution.Proposer},
+		{&mintEqualsBurnClaimDistribution.Supplier, lp.MintEqualsBurnClaimDistribution.Supplier},
+		{&mintEqualsBurnClaimDistribution.SourceOwner, lp.MintEqualsBurnClaimDistribution.SourceOwner},
+		{&mintEqualsBurnClaimDistribution.Application, lp.MintEqualsBurnClaimDistribution.Application},
+		{&params.MintRatio, lp.MintRatio},
+	}
+	for _, decParam := range decParams {
+		if !decParam.dec.IsNil() {
+			continue
+		}
+
+		dec, err := encoding.Float64ToLegacyDec(decParam.legacyFloat)
+		if err != nil {
+			return ErrTokenomicsParamInvalid.Wrapf("%v", err)
+		}
+		*decParam.dec = dec
+	}
+
+	return nil
+}
+
+// decodeLegacyDistribution decodes the legacy float64 percentages of a
+// MintAllocationPercentages or MintEqualsBurnClaimDistribution message.
+func decodeLegacyDistribution(distributionBz []byte) (legacyDistribution, error) {
+	var distribution legacyDistribution
+	err := rangeProtoFields(distributionBz, func(fieldNum protowire.Number, fieldType protowire.Type, valueBz []byte) error {
+		if fieldType != protowire.Fixed64Type {
+			return nil
+		}
+
+		var percentage *float64
+		switch fieldNum {
+		case legacyDistributionDaoFieldNum:
+			percentage = &distribution.Dao
+		case legacyDistributionProposerFieldNum:
+			percentage = &distribution.Proposer
+		case legacyDistributionSupplierFieldNum:
+			percentage = &distribution.Supplier
+		case legacyDistributionSourceOwnerFieldNum:
+			percentage = &distribution.SourceOwner
+		case legacyDistributionApplicationFieldNum:
+			percentage = &distribution.Application
+		default:
+			return nil
+		}
+
+		var err error
+		*percentage, err = decodeLegacyDouble(valueBz)
+		return err
+	})
+	return distribution, err
+}
+
+// decodeLegacyDouble decodes the value of a proto double field.
+func decodeLegacyDouble(valueBz []byte) (float64, error) {
+	bits, n := protowire.ConsumeFixed64(valueBz)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+
+	return math.Float64frombits(bits), nil
+}
+
+// rangeProtoFields calls fn with the number, wire type and encoded value of every
+// field of the given protobuf encoded message, in encoding order.
+// The value of a length-delimited field is passed without its length prefix.
+func rangeProtoFields(
+	messageBz []byte,
+	fn func(fieldNum protowire.Number, fieldType protowire.Type, valueBz []byte) error,
+) error {
+	for len(messageBz) > 0 {
+		fieldNum, fieldType, tagLen := protowire.ConsumeTag(messageBz)
+		if tagLen < 0 {
+			return protowire.ParseError(tagLen)
+		}
+		messageBz = messageBz[tagLen:]
+
+		valueLen := protowire.ConsumeFieldValue(fieldNum, fieldType, messageBz)
+		if valueLen < 0 {
+			return fmt.Errorf("field %d: %w", fieldNum, protowire.ParseError(valueLen))
+		}
+		valueBz := messageBz[:valueLen]
+		messageBz = messageBz[valueLen:]
+
+		if fieldType == protowire.BytesType {
+			var n int
+			valueBz, n = protowire.ConsumeBytes(valueBz)
+			if n < 0 {
+				return fmt.Errorf("field %d: %w", fieldNum, protowire.ParseError(n))
+			}
+		}
+
+		if err := fn(fieldNum, fieldType, valueBz); err != nil {
+			return fmt.Errorf("field %d: %w", fieldNum, err)
+		}
+	}
+
+	return nil
+}
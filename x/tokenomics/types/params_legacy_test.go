@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+func TestLegacyFloat64Params_MigrateToDecimals(t *testing.T) {
+	// Params as encoded before their percentages and ratios were migrated to decimals.
+	legacyParamsBz := appendLegacyDistribution(nil, 1, [5]float64{0.1, 0.05, 0.7, 0.15, 0})
+	legacyParamsBz = protowire.AppendTag(legacyParamsBz, 6, protowire.BytesType)
+	legacyParamsBz = protowire.AppendString(legacyParamsBz, tokenomicstypes.DefaultDaoRewardAddress)
+	legacyParamsBz = appendLegacyDouble(legacyParamsBz, 7, 0.1)
+	legacyParamsBz = appendLegacyDistribution(legacyParamsBz, 8, [5]float64{0.1, 0.14, 0.6, 0.16, 0})
+	legacyParamsBz = appendLegacyDouble(legacyParamsBz, 9, 0.975)
+	legacyParamsBz = protowire.AppendTag(legacyParamsBz, 10, protowire.VarintType)
+	legacyParamsBz = protowire.AppendVarint(legacyParamsBz, 2)
+
+	// The legacy fields are reserved: the decimal params are unset.
+	var params tokenomicstypes.Params
+	require.NoError(t, params.Unmarshal(legacyParamsBz))
+	require.True(t, params.MintRatio.IsNil())
+	require.True(t, params.GlobalInflationPerClaim.IsNil())
+	require.Equal(t, tokenomicstypes.DefaultDaoRewardAddress, params.DaoRewardAddress)
+	require.Equal(t, uint64(2), params.OverservicingBonusMultiplier)
+
+	legacyParams, err := tokenomicstypes.DecodeLegacyFloat64Params(legacyParamsBz)
+	require.NoError(t, err)
+	require.NoError(t, legacyParams.FillUnsetDecParams(&params))
+	require.NoError(t, params.ValidateBasic())
+
+	// Every decimal is the shortest decimal representation of its former float64.
+	expectedMintAllocationPercentages := tokenomicstypes.MintAllocationPercentages{
+		Dao:         cosmosmath.LegacyMustNewDecFromStr("0.1"),
+		Proposer:    cosmosmath.LegacyMustNewDecFromStr("0.05"),
+		Supplier:    cosmosmath.LegacyMustNewDecFromStr("0.7"),
+		SourceOwner: cosmosmath.LegacyMustNewDecFromStr("0.15"),
+		Application: cosmosmath.LegacyZeroDec(),
+	}
+	require.Truef(t, expectedMintAllocationPercentages.Equal(params.MintAllocationPercentages),
+		"expected %s, got %s", expectedMintAllocationPercentages.String(), params.MintAllocationPercentages.String())
+
+	expectedMintEqualsBurnClaimDistribution := tokenomicstypes.MintEqualsBurnClaimDistribution{
+		Dao:         cosmosmath.LegacyMustNewDecFromStr("0.1"),
+		Proposer:    cosmosmath.LegacyMustNewDecFromStr("0.14"),
+		Supplier:    cosmosmath.LegacyMustNewDecFromStr("0.6"),
+		SourceOwner: cosmosmath.LegacyMustNewDecFromStr("0.16"),
+		Application: cosmosmath.LegacyZeroDec(),
+	}
+	require.Truef(t, expectedMintEqualsBurnClaimDistribution.Equal(params.MintEqualsBurnClaimDistribution),
+		"expected %s, got %s", expectedMintEqualsBurnClaimDistribution.String(), params.MintEqualsBurnClaimDistribution.String())
+
+	require.Equal(t, "0.100000000000000000", params.GlobalInflationPerClaim.String())
+	require.Equal(t, "0.975000000000000000", params.MintRatio.String())
+
+	// Migrating already migrated params is a no-op.
+	migratedParamsBz, err := params.Marshal()
+	require.NoError(t, err)
+
+	var remigratedParams tokenomicstypes.Params
+	require.NoError(t, remigratedParams.Unmarshal(migratedParamsBz))
+	legacyParams, err = tokenomicstypes.DecodeLegacyFloat64Params(migratedParamsBz)
+	require.NoError(t, err)
+	require.NoError(t, legacyParams.FillUnsetDecParams(&remigratedParams))
+	require.True(t, params.Equal(remigratedParams))
+}
+
+func TestDecodeLegacyFloat64Params_Invalid(t *testing.T) {
+	// A double field truncated after its tag.
+	truncatedParamsBz := protowire.AppendTag(nil, 9, protowire.Fixed64Type)
+
+	_, err := tokenomicstypes.DecodeLegacyFloat64Params(truncatedParamsBz)
+	require.ErrorIs(t, err, tokenomicstypes.ErrTokenomicsParamInvalid)
+}
+
+// appendLegacyDistribution appends a legacy MintAllocationPercentages or
+// MintEqualsBurnClaimDistribution message with the given float64 percentages.
+// A 0 percentage is omitted, like a proto3 double.
+func appendLegacyDistribution(bz []byte, fieldNum protowire.Number, percentages [5]float64) []byte {
+	var distributionBz []byte
+	for i, percentage := range percentages {
+		if percentage != 0 {
+			distributionBz = appendLegacyDouble(distributionBz, protowire.Number(i+1), percentage)
+		}
+	}
+
+	bz = protowire.AppendTag(bz, fieldNum, protowire.BytesType)
+	return protowire.AppendBytes(bz, distributionBz)
+}
+
+// appendLegacyDouble appends a proto double field with the given value.
+func appendLegacyDouble(bz []byte, fieldNum protowire.Number, value float64) []byte {
+	bz = protowire.AppendTag(bz, fieldNum, protowire.Fixed64Type)
+	return protowire.AppendFixed64(bz, math.Float64bits(value))
+}
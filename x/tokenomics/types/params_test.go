@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
@@ -44,20 +45,20 @@ func TestParams_ValidateOverservicingBonusMultiplier(t *testing.T) {
 func TestParams_AntiCollusionInvariant(t *testing.T) {
 	tests := []struct {
 		name        string
-		mintRatio   float64
-		supplier    float64
+		mintRatio   math.LegacyDec
+		supplier    math.LegacyDec
 		expectValid bool
 	}{
 		{
 			name:        "default params satisfy the invariant",
-			mintRatio:   tokenomicstypes.DefaultMintRatio, // 1.0
-			supplier:    0.7,                              // DefaultMintEqualsBurnClaimDistribution.Supplier
+			mintRatio:   tokenomicstypes.DefaultMintRatio,                                // 1.0
+			supplier:    tokenomicstypes.DefaultMintEqualsBurnClaimDistribution.Supplier, // 0.7
 			expectValid: true,
 		},
 		{
 			name:        "mainnet-like: 0.975 x 0.79 = 0.770 is safe",
-			mintRatio:   0.975,
-			supplier:    0.79,
+			mintRatio:   math.LegacyMustNewDecFromStr("0.975"),
+			supplier:    math.LegacyMustNewDecFromStr("0.79"),
 			expectValid: true,
 		},
 		{
@@ -68,8 +69,8 @@ func TestParams_AntiCollusionInvariant(t *testing.T) {
 			// 1, the product can never EXCEED 1 — self-dealing is never profitable, only
 			// break-even, which is why this is reported rather than rejected.
 			name:        "product exactly 1 is reported (break-even self-dealing)",
-			mintRatio:   1.0,
-			supplier:    1.0,
+			mintRatio:   math.LegacyOneDec(),
+			supplier:    math.LegacyOneDec(),
 			expectValid: false,
 		},
 	}
@@ -81,7 +82,7 @@ func TestParams_AntiCollusionInvariant(t *testing.T) {
 			// Keep the distribution summing to 1 so only the invariant (not the sum check) is exercised.
 			params.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
 				Supplier: tc.supplier,
-				Dao:      1 - tc.supplier,
+				Dao:      math.LegacyOneDec().Sub(tc.supplier),
 			}
 
 			// The invariant is reporting-only: a violating param set MUST still validate,
@@ -118,22 +119,22 @@ func TestParams_AntiCollusionInvariant(t *testing.T) {
 // hard rejection on the MsgUpdateParam(s) path (and ONLY there — never in ValidateBasic,
 // which genesis validation & upgrade handlers run inside consensus).
 func TestParams_MintRatioUpperBoundGuardsAntiCollusion(t *testing.T) {
-	require.NoError(t, tokenomicstypes.ValidateMintRatio(1.0),
+	require.NoError(t, tokenomicstypes.ValidateMintRatio(math.LegacyOneDec()),
 		"mint_ratio == 1 (the neutral mint == burn regime) must remain valid")
 
-	for _, mintRatio := range []float64{1.0000001, 1.3, 2.0} {
-		require.Errorf(t, tokenomicstypes.ValidateMintRatio(mintRatio),
-			"mint_ratio %f > 1 must be rejected; the anti-collusion invariant is only a warning because this bound holds", mintRatio)
+	for _, mintRatio := range []string{"1.000000000000000001", "1.0000001", "1.3", "2.0"} {
+		require.Errorf(t, tokenomicstypes.ValidateMintRatio(math.LegacyMustNewDecFromStr(mintRatio)),
+			"mint_ratio %s > 1 must be rejected; the anti-collusion invariant is only a warning because this bound holds", mintRatio)
 	}
 
 	// Demonstrate the consequence the bound is protecting against: a mainnet-shaped
 	// distribution paired with a net-inflationary mint_ratio is a PROFITABLE round trip,
 	// not merely break-even.
 	params := tokenomicstypes.DefaultParams()
-	params.MintRatio = 1.3
+	params.MintRatio = math.LegacyMustNewDecFromStr("1.3")
 	params.MintEqualsBurnClaimDistribution = tokenomicstypes.MintEqualsBurnClaimDistribution{
-		Supplier: 0.79,
-		Dao:      0.21,
+		Supplier: math.LegacyMustNewDecFromStr("0.79"),
+		Dao:      math.LegacyMustNewDecFromStr("0.21"),
 	}
 	require.ErrorContains(t, params.CheckAntiCollusionInvariant(), "anti-collusion invariant violated",
 		"an above-1 mint_ratio with an ordinary supplier share makes self-dealing profitable")
@@ -146,3 +147,15 @@ func TestParams_DefaultOverservicingBonusMultiplier(t *testing.T) {
 	require.Equal(t, uint64(1), tokenomicstypes.DefaultOverservicingBonusMultiplier)
 	require.Equal(t, uint64(1), tokenomicstypes.DefaultParams().OverservicingBonusMultiplier)
 }
+
+// TestParams_ValidateSettlementHistoryRetentionBlocks verifies the retention param accepts
+// any uint64 (0 disabling the settlement history) and rejects non-uint64 types.
+func TestParams_ValidateSettlementHistoryRetentionBlocks(t *testing.T) {
+	for _, retentionBlocks := range []uint64{0, 1, tokenomicstypes.DefaultSettlementHistoryRetentionBlocks} {
+		require.NoError(t, tokenomicstypes.ValidateSettlementHistoryRetentionBlocks(retentionBlocks),
+			"retentionBlocks=%d should be valid", retentionBlocks)
+	}
+
+	require.Error(t, tokenomicstypes.ValidateSettlementHistoryRetentionBlocks(int64(1)),
+		"non-uint64 type must be rejected")
+}
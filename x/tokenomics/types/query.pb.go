@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -106,35 +107,221 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+type QueryAllSettlementResultsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Types that are valid to be assigned to Filter:
+	//	*QueryAllSettlementResultsRequest_SupplierOperatorAddress
+	//	*QueryAllSettlementResultsRequest_ApplicationAddress
+	//	*QueryAllSettlementResultsRequest_ServiceId
+	//	*QueryAllSettlementResultsRequest_SessionEndHeight
+	Filter isQueryAllSettlementResultsRequest_Filter `protobuf_oneof:"filter"`
+}
+
+func (m *QueryAllSettlementResultsRequest) Reset()         { *m = QueryAllSettlementResultsRequest{} }
+func (m *QueryAllSettlementResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSettlementResultsRequest) ProtoMessage()    {}
+func (*QueryAllSettlementResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3afac728df27ca5, []int{2}
+}
+func (m *QueryAllSettlementResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSettlementResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QueryAllSettlementResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSettlementResultsRequest.Merge(m, src)
+}
+func (m *QueryAllSettlementResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSettlementResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSettlementResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSettlementResultsRequest proto.InternalMessageInfo
+
+type isQueryAllSettlementResultsRequest_Filter interface {
+	isQueryAllSettlementResultsRequest_Filter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type QueryAllSettlementResultsRequest_SupplierOperatorAddress struct {
+	SupplierOperatorAddress string `protobuf:"bytes,2,opt,name=supplier_operator_address,json=supplierOperatorAddress,proto3,oneof" json:"supplier_operator_address,omitempty"`
+}
+type QueryAllSettlementResultsRequest_ApplicationAddress struct {
+	ApplicationAddress string `protobuf:"bytes,3,opt,name=application_address,json=applicationAddress,proto3,oneof" json:"application_address,omitempty"`
+}
+type QueryAllSettlementResultsRequest_ServiceId struct {
+	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3,oneof" json:"service_id,omitempty"`
+}
+type QueryAllSettlementResultsRequest_SessionEndHeight struct {
+	SessionEndHeight uint64 `protobuf:"varint,5,opt,name=session_end_height,json=sessionEndHeight,proto3,oneof" json:"session_end_height,omitempty"`
+}
+
+func (*QueryAllSettlementResultsRequest_SupplierOperatorAddress) isQueryAllSettlementResultsRequest_Filter() {
+}
+func (*QueryAllSettlementResultsRequest_ApplicationAddress) isQueryAllSettlementResultsRequest_Filter() {
+}
+func (*QueryAllSettlementResultsRequest_ServiceId) isQueryAllSettlementResultsRequest_Filter() {}
+func (*QueryAllSettlementResultsRequest_SessionEndHeight) isQueryAllSettlementResultsRequest_Filter() {
+}
+
+func (m *QueryAllSettlementResultsRequest) GetFilter() isQueryAllSettlementResultsRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *QueryAllSettlementResultsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllSettlementResultsRequest) GetSupplierOperatorAddress() string {
+	if x, ok := m.GetFilter().(*QueryAllSettlementResultsRequest_SupplierOperatorAddress); ok {
+		return x.SupplierOperatorAddress
+	}
+	return ""
+}
+
+func (m *QueryAllSettlementResultsRequest) GetApplicationAddress() string {
+	if x, ok := m.GetFilter().(*QueryAllSettlementResultsRequest_ApplicationAddress); ok {
+		return x.ApplicationAddress
+	}
+	return ""
+}
+
+func (m *QueryAllSettlementResultsRequest) GetServiceId() string {
+	if x, ok := m.GetFilter().(*QueryAllSettlementResultsRequest_ServiceId); ok {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (m *QueryAllSettlementResultsRequest) GetSessionEndHeight() uint64 {
+	if x, ok := m.GetFilter().(*QueryAllSettlementResultsRequest_SessionEndHeight); ok {
+		return x.SessionEndHeight
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*QueryAllSettlementResultsRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*QueryAllSettlementResultsRequest_SupplierOperatorAddress)(nil),
+		(*QueryAllSettlementResultsRequest_ApplicationAddress)(nil),
+		(*QueryAllSettlementResultsRequest_ServiceId)(nil),
+		(*QueryAllSettlementResultsRequest_SessionEndHeight)(nil),
+	}
+}
+
+type QueryAllSettlementResultsResponse struct {
+	SettlementResults []ClaimSettlementResult `protobuf:"bytes,1,rep,name=settlement_results,json=settlementResults,proto3" json:"settlement_results"`
+	Pagination        *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllSettlementResultsResponse) Reset()         { *m = QueryAllSettlementResultsResponse{} }
+func (m *QueryAllSettlementResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSettlementResultsResponse) ProtoMessage()    {}
+func (*QueryAllSettlementResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3afac728df27ca5, []int{3}
+}
+func (m *QueryAllSettlementResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSettlementResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QueryAllSettlementResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSettlementResultsResponse.Merge(m, src)
+}
+func (m *QueryAllSettlementResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSettlementResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSettlementResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSettlementResultsResponse proto.InternalMessageInfo
+
+func (m *QueryAllSettlementResultsResponse) GetSettlementResults() []ClaimSettlementResult {
+	if m != nil {
+		return m.SettlementResults
+	}
+	return nil
+}
+
+func (m *QueryAllSettlementResultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "pocket.tokenomics.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "pocket.tokenomics.QueryParamsResponse")
+	proto.RegisterType((*QueryAllSettlementResultsRequest)(nil), "pocket.tokenomics.QueryAllSettlementResultsRequest")
+	proto.RegisterType((*QueryAllSettlementResultsResponse)(nil), "pocket.tokenomics.QueryAllSettlementResultsResponse")
 }
 
 func init() { proto.RegisterFile("pocket/tokenomics/query.proto", fileDescriptor_f3afac728df27ca5) }
 
 var fileDescriptor_f3afac728df27ca5 = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x50, 0x3f, 0x4b, 0x33, 0x31,
-	0x18, 0xbf, 0xbc, 0xf0, 0x16, 0x8c, 0x53, 0x63, 0x07, 0x5b, 0x34, 0x4a, 0x41, 0x29, 0x05, 0x2f,
-	0xd2, 0xae, 0x4e, 0xfd, 0x04, 0xb6, 0x6e, 0x2e, 0x92, 0x1e, 0xe1, 0x3c, 0xee, 0x2e, 0x4f, 0x7a,
-	0xc9, 0xa1, 0x5d, 0xdd, 0xdc, 0x84, 0x7e, 0x09, 0x47, 0x3f, 0x46, 0xc7, 0x82, 0x4b, 0x27, 0x91,
-	0x3b, 0xc1, 0xaf, 0x21, 0x4d, 0x0e, 0x54, 0x4e, 0x71, 0x09, 0xcf, 0xf3, 0xfb, 0xc7, 0x2f, 0x0f,
-	0xde, 0x57, 0x10, 0xc4, 0xc2, 0x30, 0x03, 0xb1, 0x90, 0x90, 0x46, 0x81, 0x66, 0xb3, 0x5c, 0x64,
-	0x73, 0x5f, 0x65, 0x60, 0x80, 0x34, 0x1d, 0xed, 0x7f, 0xd2, 0x9d, 0x26, 0x4f, 0x23, 0x09, 0xcc,
-	0xbe, 0x4e, 0xd5, 0x69, 0x85, 0x10, 0x82, 0x1d, 0xd9, 0x66, 0xaa, 0xd0, 0xbd, 0x10, 0x20, 0x4c,
-	0x04, 0xe3, 0x2a, 0x62, 0x5c, 0x4a, 0x30, 0xdc, 0x44, 0x20, 0x75, 0xc5, 0xb6, 0x03, 0xd0, 0x29,
-	0xe8, 0x2b, 0x67, 0x73, 0x4b, 0x45, 0xd1, 0x7a, 0x27, 0xc5, 0x33, 0x9e, 0x56, 0x7c, 0xb7, 0x85,
-	0xc9, 0x78, 0xd3, 0xf1, 0xdc, 0x82, 0x13, 0x31, 0xcb, 0x85, 0x36, 0xdd, 0x0b, 0xbc, 0xf3, 0x0d,
-	0xd5, 0x0a, 0xa4, 0x16, 0xe4, 0x0c, 0x37, 0x9c, 0x79, 0x17, 0x1d, 0xa2, 0xde, 0xf6, 0xa0, 0xed,
-	0xd7, 0xbe, 0xe4, 0x3b, 0xcb, 0x68, 0x6b, 0xf9, 0x72, 0xe0, 0x3d, 0xbe, 0x3f, 0xf5, 0xd1, 0xa4,
-	0xf2, 0x0c, 0x16, 0x08, 0xff, 0xb7, 0xa9, 0xe4, 0x1e, 0xe1, 0x86, 0xd3, 0x91, 0xa3, 0x1f, 0x22,
-	0xea, 0x85, 0x3a, 0xc7, 0x7f, 0xc9, 0x5c, 0xc3, 0xee, 0xe9, 0xdd, 0xf3, 0xdb, 0xe2, 0x5f, 0x9f,
-	0xf4, 0x98, 0x82, 0xd8, 0x9c, 0x48, 0x61, 0x6e, 0x20, 0x8b, 0xed, 0x92, 0x41, 0x92, 0xd4, 0xcf,
-	0x30, 0x1a, 0x2f, 0x0b, 0x8a, 0x56, 0x05, 0x45, 0xeb, 0x82, 0xa2, 0xd7, 0x82, 0xa2, 0x87, 0x92,
-	0x7a, 0xab, 0x92, 0x7a, 0xeb, 0x92, 0x7a, 0x97, 0xc3, 0x30, 0x32, 0xd7, 0xf9, 0xd4, 0x0f, 0x20,
-	0xfd, 0x25, 0xf1, 0xf6, 0x6b, 0xa6, 0x99, 0x2b, 0xa1, 0xa7, 0x0d, 0x7b, 0xda, 0xe1, 0x47, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7c, 0x29, 0xf6, 0x09, 0x10, 0x02, 0x00, 0x00,
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0x13, 0x31,
+	0x18, 0x8d, 0xd3, 0x36, 0xa2, 0xee, 0x42, 0xdd, 0x4a, 0xa4, 0x11, 0x5c, 0x43, 0x24, 0x4a, 0x54,
+	0x89, 0x3b, 0xda, 0xc0, 0x44, 0x97, 0x06, 0x01, 0x65, 0xa2, 0xbd, 0x6e, 0x48, 0xe8, 0xe4, 0xdc,
+	0x7d, 0x5c, 0xad, 0xdc, 0x9d, 0xaf, 0xb6, 0x53, 0xe8, 0xca, 0xc6, 0x86, 0xc4, 0x3f, 0xc1, 0xc8,
+	0xc4, 0xc8, 0x5c, 0x89, 0xa5, 0x12, 0x0c, 0x9d, 0x10, 0x4a, 0x91, 0xf8, 0x37, 0xd0, 0xd9, 0x0e,
+	0xfd, 0x71, 0x69, 0x2b, 0x96, 0xe8, 0xec, 0xf7, 0xbd, 0xf7, 0x3d, 0x7f, 0xcf, 0x31, 0xbe, 0x95,
+	0xf3, 0xb0, 0x0f, 0xca, 0x53, 0xbc, 0x0f, 0x19, 0x4f, 0x59, 0x28, 0xbd, 0xdd, 0x01, 0x88, 0x7d,
+	0x37, 0x17, 0x5c, 0x71, 0x32, 0x6b, 0x60, 0xf7, 0x04, 0x6e, 0xcc, 0xd2, 0x94, 0x65, 0xdc, 0xd3,
+	0xbf, 0xa6, 0xaa, 0x31, 0x1f, 0xf3, 0x98, 0xeb, 0x4f, 0xaf, 0xf8, 0xb2, 0xbb, 0x37, 0x63, 0xce,
+	0xe3, 0x04, 0x3c, 0x9a, 0x33, 0x8f, 0x66, 0x19, 0x57, 0x54, 0x31, 0x9e, 0x49, 0x8b, 0x2e, 0x84,
+	0x5c, 0xa6, 0x5c, 0x06, 0x86, 0x66, 0x16, 0x16, 0x5a, 0x36, 0x2b, 0xaf, 0x47, 0x25, 0x18, 0x37,
+	0xde, 0xde, 0x4a, 0x0f, 0x14, 0x5d, 0xf1, 0x72, 0x1a, 0xb3, 0x4c, 0xeb, 0xd8, 0x5a, 0xa7, 0xec,
+	0x3f, 0xa7, 0x82, 0xa6, 0x23, 0xad, 0x31, 0xe7, 0x53, 0xfb, 0x39, 0x58, 0xb8, 0x35, 0x8f, 0xc9,
+	0x56, 0xd1, 0x60, 0x53, 0x73, 0x7c, 0xd8, 0x1d, 0x80, 0x54, 0xad, 0x6d, 0x3c, 0x77, 0x66, 0x57,
+	0xe6, 0x3c, 0x93, 0x40, 0xd6, 0x70, 0xcd, 0x68, 0xd7, 0x51, 0x13, 0xb5, 0x67, 0x56, 0x17, 0xdc,
+	0xd2, 0x74, 0x5c, 0x43, 0xe9, 0x4e, 0x1f, 0xfc, 0x5c, 0xac, 0x7c, 0xfa, 0xf3, 0x79, 0x19, 0xf9,
+	0x96, 0xd3, 0xfa, 0x52, 0xc5, 0x4d, 0xad, 0xba, 0x9e, 0x24, 0xdb, 0xa0, 0x54, 0x02, 0x29, 0x64,
+	0xca, 0x07, 0x39, 0x48, 0xd4, 0xa8, 0x33, 0x79, 0x8a, 0xf1, 0xc9, 0x11, 0x6d, 0x9b, 0x25, 0xd7,
+	0x4e, 0xa7, 0x98, 0x87, 0x6b, 0xd2, 0xb1, 0xf3, 0x70, 0x37, 0x69, 0x0c, 0x96, 0xeb, 0x9f, 0x62,
+	0x92, 0x35, 0xbc, 0x20, 0x07, 0x79, 0x9e, 0x30, 0x10, 0x01, 0xcf, 0x41, 0x50, 0xc5, 0x45, 0x40,
+	0xa3, 0x48, 0x80, 0x94, 0xf5, 0x6a, 0x13, 0xb5, 0xa7, 0x37, 0x2a, 0xfe, 0x8d, 0x51, 0xc9, 0x0b,
+	0x5b, 0xb1, 0x6e, 0x0a, 0xc8, 0x0a, 0x9e, 0xa3, 0x05, 0x12, 0x6a, 0xb1, 0x7f, 0xbc, 0x09, 0xcb,
+	0x23, 0xa7, 0xc0, 0x11, 0x65, 0x11, 0x63, 0x09, 0x62, 0x8f, 0x85, 0x10, 0xb0, 0xa8, 0x3e, 0x69,
+	0x2b, 0xa7, 0xed, 0xde, 0xf3, 0x88, 0xb8, 0x98, 0x48, 0x90, 0xb2, 0xd0, 0x83, 0x2c, 0x0a, 0x76,
+	0x80, 0xc5, 0x3b, 0xaa, 0x3e, 0xd5, 0x44, 0xed, 0xc9, 0x8d, 0x8a, 0x7f, 0xdd, 0x62, 0x4f, 0xb2,
+	0x68, 0x43, 0x23, 0xdd, 0x6b, 0xb8, 0xf6, 0x9a, 0x25, 0x0a, 0x44, 0xeb, 0x1b, 0xc2, 0xb7, 0x2f,
+	0x19, 0x9c, 0x0d, 0xe7, 0x55, 0xa1, 0x3f, 0x02, 0x03, 0x61, 0xd0, 0x3a, 0x6a, 0x4e, 0xb4, 0x67,
+	0x56, 0xdb, 0x63, 0x82, 0x7a, 0x9c, 0x50, 0x96, 0x9e, 0x97, 0xeb, 0x4e, 0x16, 0xb9, 0xf9, 0xb3,
+	0xf2, 0x7c, 0x1b, 0xf2, 0xec, 0x4c, 0x30, 0x55, 0x1d, 0xcc, 0xdd, 0x2b, 0x83, 0x31, 0xde, 0x4e,
+	0x27, 0xb3, 0xfa, 0xa3, 0x8a, 0xa7, 0xf4, 0x69, 0xc8, 0x7b, 0x84, 0x6b, 0xe6, 0xba, 0x90, 0x3b,
+	0x63, 0x0c, 0x96, 0xef, 0x65, 0x63, 0xe9, 0xaa, 0x32, 0xd3, 0xaf, 0x75, 0xff, 0xdd, 0xf7, 0xdf,
+	0x1f, 0xab, 0xcb, 0xa4, 0xed, 0xe5, 0xbc, 0xaf, 0xee, 0x65, 0xa0, 0xde, 0x70, 0xd1, 0xd7, 0x0b,
+	0xc1, 0x93, 0xa4, 0xfc, 0x67, 0x21, 0x5f, 0x11, 0x9e, 0x1f, 0x37, 0x5e, 0xd2, 0xb9, 0xa8, 0xe5,
+	0x25, 0xb7, 0xb8, 0xf1, 0xe0, 0xff, 0x48, 0xd6, 0xf5, 0x23, 0xed, 0xfa, 0x21, 0xe9, 0x5c, 0xed,
+	0xba, 0x94, 0x74, 0x77, 0xeb, 0x60, 0xe8, 0xa0, 0xc3, 0xa1, 0x83, 0x8e, 0x86, 0x0e, 0xfa, 0x35,
+	0x74, 0xd0, 0x87, 0x63, 0xa7, 0x72, 0x78, 0xec, 0x54, 0x8e, 0x8e, 0x9d, 0xca, 0xcb, 0x4e, 0xcc,
+	0xd4, 0xce, 0xa0, 0xe7, 0x86, 0x3c, 0xbd, 0x40, 0xfc, 0x6d, 0xe9, 0x85, 0xe8, 0xd5, 0xf4, 0x13,
+	0xd1, 0xf9, 0x3b, 0x00, 0x35, 0x22, 0x8f, 0xe4, 0x23, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -151,6 +338,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of the settlement results retained in the settlement history.
+	AllSettlementResults(ctx context.Context, in *QueryAllSettlementResultsRequest, opts ...grpc.CallOption) (*QueryAllSettlementResultsResponse, error)
 }
 
 type queryClient struct {
@@ -170,10 +359,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AllSettlementResults(ctx context.Context, in *QueryAllSettlementResultsRequest, opts ...grpc.CallOption) (*QueryAllSettlementResultsResponse, error) {
+	out := new(QueryAllSettlementResultsResponse)
+	err := c.cc.Invoke(ctx, "/pocket.tokenomics.Query/AllSettlementResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of the settlement results retained in the settlement history.
+	AllSettlementResults(context.Context, *QueryAllSettlementResultsRequest) (*QueryAllSettlementResultsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -183,6 +383,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) AllSettlementResults(ctx context.Context, req *QueryAllSettlementResultsRequest) (*QueryAllSettlementResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSettlementResults not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -206,6 +409,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllSettlementResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSettlementResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSettlementResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.tokenomics.Query/AllSettlementResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSettlementResults(ctx, req.(*QueryAllSettlementResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pocket.tokenomics.Query",
@@ -215,6 +436,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "AllSettlementResults",
+			Handler:    _Query_AllSettlementResults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pocket/tokenomics/query.proto",
@@ -276,6 +501,153 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllSettlementResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSettlementResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSettlementResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Filter != nil {
+		{
+			size := m.Filter.Size()
+			i -= size
+			if _, err := m.Filter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSettlementResultsRequest_SupplierOperatorAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSettlementResultsRequest_SupplierOperatorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.SupplierOperatorAddress)
+	copy(dAtA[i:], m.SupplierOperatorAddress)
+	i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplierOperatorAddress)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *QueryAllSettlementResultsRequest_ApplicationAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSettlementResultsRequest_ApplicationAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ApplicationAddress)
+	copy(dAtA[i:], m.ApplicationAddress)
+	i = encodeVarintQuery(dAtA, i, uint64(len(m.ApplicationAddress)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *QueryAllSettlementResultsRequest_ServiceId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSettlementResultsRequest_ServiceId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ServiceId)
+	copy(dAtA[i:], m.ServiceId)
+	i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *QueryAllSettlementResultsRequest_SessionEndHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSettlementResultsRequest_SessionEndHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintQuery(dAtA, i, uint64(m.SessionEndHeight))
+	i--
+	dAtA[i] = 0x28
+	return len(dAtA) - i, nil
+}
+func (m *QueryAllSettlementResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSettlementResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSettlementResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SettlementResults) > 0 {
+		for iNdEx := len(m.SettlementResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -307,6 +679,80 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllSettlementResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Filter != nil {
+		n += m.Filter.Size()
+	}
+	return n
+}
+
+func (m *QueryAllSettlementResultsRequest_SupplierOperatorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SupplierOperatorAddress)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+func (m *QueryAllSettlementResultsRequest_ApplicationAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ApplicationAddress)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+func (m *QueryAllSettlementResultsRequest_ServiceId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+func (m *QueryAllSettlementResultsRequest_SessionEndHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovQuery(uint64(m.SessionEndHeight))
+	return n
+}
+func (m *QueryAllSettlementResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SettlementResults) > 0 {
+		for _, e := range m.SettlementResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -446,6 +892,328 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllSettlementResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSettlementResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSettlementResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = &QueryAllSettlementResultsRequest_SupplierOperatorAddress{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = &QueryAllSettlementResultsRequest_ApplicationAddress{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = &QueryAllSettlementResultsRequest_ServiceId{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filter = &QueryAllSettlementResultsRequest_SessionEndHeight{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSettlementResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSettlementResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSettlementResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementResults = append(m.SettlementResults, ClaimSettlementResult{})
+			if err := m.SettlementResults[len(m.SettlementResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
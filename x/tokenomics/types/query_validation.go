@@ -0,0 +1,32 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// NOTE: Please note that these messages are not of type `sdk.Msg`, and are therefore not a message/request
+// that will be signable or invoke a state transition. However, following a similar `ValidateBasic` pattern
+// allows us to localize & reuse validation logic.
+
+// ValidateBasic performs basic (non-state-dependant) validation on a QueryAllSettlementResultsRequest.
+func (query *QueryAllSettlementResultsRequest) ValidateBasic() error {
+	switch filter := query.Filter.(type) {
+	case *QueryAllSettlementResultsRequest_SupplierOperatorAddress:
+		if _, err := sdk.AccAddressFromBech32(filter.SupplierOperatorAddress); err != nil {
+			return ErrTokenomicsSupplierOperatorAddressInvalid.Wrapf("invalid supplier operator address for settlement results being retrieved %s; (%v)", filter.SupplierOperatorAddress, err)
+		}
+
+	case *QueryAllSettlementResultsRequest_ApplicationAddress:
+		if _, err := sdk.AccAddressFromBech32(filter.ApplicationAddress); err != nil {
+			return ErrTokenomicsApplicationAddressInvalid.Wrapf("invalid application address for settlement results being retrieved %s; (%v)", filter.ApplicationAddress, err)
+		}
+
+	case *QueryAllSettlementResultsRequest_ServiceId:
+		if filter.ServiceId == "" {
+			return ErrTokenomicsInvalidQueryRequest.Wrap("invalid empty service ID for settlement results being retrieved")
+		}
+
+	case *QueryAllSettlementResultsRequest_SessionEndHeight:
+		// No validation needed for session end height.
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -289,7 +290,7 @@ func TestNewEventClaimSettled_RewardDistributionDetailed(t *testing.T) {
 
 	claimeduPOKT := cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 1000)
 	settledUpokt := cosmostypes.NewInt64Coin(pocket.DenomuPOKT, 1000)
-	event := NewEventClaimSettled(10, 5, 50, 100, 0, &claimeduPOKT, result, &settledUpokt, 0.975, "pokt1supplier-owner")
+	event := NewEventClaimSettled(10, 5, 50, 100, 0, &claimeduPOKT, result, &settledUpokt, math.LegacyMustNewDecFromStr("0.975"), "pokt1supplier-owner")
 
 	// Verify the legacy field is still populated (merges by address).
 	require.Len(t, event.RewardDistribution, 2)
@@ -327,7 +328,7 @@ func TestNewEventClaimSettled_SettledUpoktAndMintRatio(t *testing.T) {
 		name                      string
 		claimedAmount             int64
 		settledAmount             int64
-		mintRatio                 float64
+		mintRatio                 math.LegacyDec
 		expectedSettled           string
 		expectedMintRatio         string
 		expectedMinted            string
@@ -338,7 +339,7 @@ func TestNewEventClaimSettled_SettledUpoktAndMintRatio(t *testing.T) {
 			name:                      "no overservicing, no deflation",
 			claimedAmount:             1000,
 			settledAmount:             1000,
-			mintRatio:                 1.0,
+			mintRatio:                 math.LegacyOneDec(),
 			expectedSettled:           "1000upokt",
 			expectedMintRatio:         "1",
 			expectedMinted:            "1000upokt",
@@ -349,7 +350,7 @@ func TestNewEventClaimSettled_SettledUpoktAndMintRatio(t *testing.T) {
 			name:                      "no overservicing, with deflation (PIP-41)",
 			claimedAmount:             1000,
 			settledAmount:             1000,
-			mintRatio:                 0.975,
+			mintRatio:                 math.LegacyMustNewDecFromStr("0.975"),
 			expectedSettled:           "1000upokt",
 			expectedMintRatio:         "0.975",
 			expectedMinted:            "975upokt",
@@ -360,7 +361,7 @@ func TestNewEventClaimSettled_SettledUpoktAndMintRatio(t *testing.T) {
 			name:                      "overserviced, with deflation",
 			claimedAmount:             1000,
 			settledAmount:             500,
-			mintRatio:                 0.975,
+			mintRatio:                 math.LegacyMustNewDecFromStr("0.975"),
 			expectedSettled:           "500upokt",
 			expectedMintRatio:         "0.975",
 			expectedMinted:            "487upokt",
@@ -371,7 +372,7 @@ func TestNewEventClaimSettled_SettledUpoktAndMintRatio(t *testing.T) {
 			name:                      "zero settlement",
 			claimedAmount:             1000,
 			settledAmount:             0,
-			mintRatio:                 0.975,
+			mintRatio:                 math.LegacyMustNewDecFromStr("0.975"),
 			expectedSettled:           "0upokt",
 			expectedMintRatio:         "0.975",
 			expectedMinted:            "0upokt",
@@ -5,7 +5,6 @@ package types
 
 import (
 	context "context"
-	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -125,9 +124,9 @@ type MsgUpdateParam struct {
 	// Types that are valid to be assigned to AsType:
 	//	*MsgUpdateParam_AsMintAllocationPercentages
 	//	*MsgUpdateParam_AsString
-	//	*MsgUpdateParam_AsFloat
 	//	*MsgUpdateParam_AsMintEqualsBurnClaimDistribution
 	//	*MsgUpdateParam_AsUint64
+	//	*MsgUpdateParam_AsDec
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"as_type"`
 }
 
@@ -172,21 +171,21 @@ type MsgUpdateParam_AsMintAllocationPercentages struct {
 type MsgUpdateParam_AsString struct {
 	AsString string `protobuf:"bytes,4,opt,name=as_string,json=asString,proto3,oneof" json:"as_string"`
 }
-type MsgUpdateParam_AsFloat struct {
-	AsFloat float64 `protobuf:"fixed64,5,opt,name=as_float,json=asFloat,proto3,oneof" json:"as_float"`
-}
 type MsgUpdateParam_AsMintEqualsBurnClaimDistribution struct {
 	AsMintEqualsBurnClaimDistribution *MintEqualsBurnClaimDistribution `protobuf:"bytes,6,opt,name=as_mint_equals_burn_claim_distribution,json=asMintEqualsBurnClaimDistribution,proto3,oneof" json:"as_mint_equals_burn_claim_distribution" yaml:"as_mint_equals_burn_claim_distribution"`
 }
 type MsgUpdateParam_AsUint64 struct {
 	AsUint64 uint64 `protobuf:"varint,7,opt,name=as_uint64,json=asUint64,proto3,oneof" json:"as_uint64"`
 }
+type MsgUpdateParam_AsDec struct {
+	AsDec string `protobuf:"bytes,8,opt,name=as_dec,json=asDec,proto3,oneof" json:"as_dec"`
+}
 
 func (*MsgUpdateParam_AsMintAllocationPercentages) isMsgUpdateParam_AsType()       {}
 func (*MsgUpdateParam_AsString) isMsgUpdateParam_AsType()                          {}
-func (*MsgUpdateParam_AsFloat) isMsgUpdateParam_AsType()                           {}
 func (*MsgUpdateParam_AsMintEqualsBurnClaimDistribution) isMsgUpdateParam_AsType() {}
 func (*MsgUpdateParam_AsUint64) isMsgUpdateParam_AsType()                          {}
+func (*MsgUpdateParam_AsDec) isMsgUpdateParam_AsType()                             {}
 
 func (m *MsgUpdateParam) GetAsType() isMsgUpdateParam_AsType {
 	if m != nil {
@@ -223,13 +222,6 @@ func (m *MsgUpdateParam) GetAsString() string {
 	return ""
 }
 
-func (m *MsgUpdateParam) GetAsFloat() float64 {
-	if x, ok := m.GetAsType().(*MsgUpdateParam_AsFloat); ok {
-		return x.AsFloat
-	}
-	return 0
-}
-
 func (m *MsgUpdateParam) GetAsMintEqualsBurnClaimDistribution() *MintEqualsBurnClaimDistribution {
 	if x, ok := m.GetAsType().(*MsgUpdateParam_AsMintEqualsBurnClaimDistribution); ok {
 		return x.AsMintEqualsBurnClaimDistribution
@@ -244,14 +236,21 @@ func (m *MsgUpdateParam) GetAsUint64() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateParam) GetAsDec() string {
+	if x, ok := m.GetAsType().(*MsgUpdateParam_AsDec); ok {
+		return x.AsDec
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgUpdateParam) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MsgUpdateParam_AsMintAllocationPercentages)(nil),
 		(*MsgUpdateParam_AsString)(nil),
-		(*MsgUpdateParam_AsFloat)(nil),
 		(*MsgUpdateParam_AsMintEqualsBurnClaimDistribution)(nil),
 		(*MsgUpdateParam_AsUint64)(nil),
+		(*MsgUpdateParam_AsDec)(nil),
 	}
 }
 
@@ -298,48 +297,48 @@ func init() {
 func init() { proto.RegisterFile("pocket/tokenomics/tx.proto", fileDescriptor_df88dc3fd9e72965) }
 
 var fileDescriptor_df88dc3fd9e72965 = []byte{
-	// 653 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbd, 0x4f, 0xdc, 0x4a,
-	0x10, 0xf7, 0xc2, 0xf1, 0x71, 0x0b, 0x8f, 0xf7, 0xb0, 0xd0, 0xc3, 0xdc, 0x93, 0xec, 0xc3, 0x4f,
-	0x89, 0x8e, 0x13, 0x9c, 0x15, 0x88, 0x28, 0x50, 0x1a, 0x9c, 0x0f, 0xa1, 0x44, 0x48, 0xc4, 0x11,
-	0x4d, 0x90, 0x62, 0xed, 0xf9, 0x36, 0xc6, 0x3a, 0x7b, 0xd7, 0xf1, 0xae, 0x13, 0xe8, 0xa2, 0x94,
-	0xa9, 0xf2, 0x67, 0x50, 0x52, 0xa4, 0x4f, 0x4a, 0xd2, 0xa1, 0x54, 0x54, 0x56, 0x74, 0x44, 0x42,
-	0xba, 0x92, 0xbf, 0x20, 0xf2, 0xda, 0x70, 0x98, 0xaf, 0x8b, 0xd2, 0xd8, 0xb3, 0x33, 0xf3, 0x9b,
-	0xf9, 0xcd, 0x4f, 0x3b, 0x0b, 0x2b, 0x21, 0x75, 0xda, 0x98, 0x1b, 0x9c, 0xb6, 0x31, 0xa1, 0x81,
-	0xe7, 0x30, 0x83, 0xef, 0x34, 0xc2, 0x88, 0x72, 0x2a, 0x4f, 0x66, 0xb1, 0x46, 0x2f, 0x56, 0x99,
-	0x44, 0x81, 0x47, 0xa8, 0x21, 0xbe, 0x59, 0x56, 0x65, 0xda, 0xa1, 0x2c, 0xa0, 0xcc, 0x08, 0x98,
-	0x6b, 0xbc, 0xbd, 0x97, 0xfe, 0xf2, 0xc0, 0x4c, 0x16, 0xb0, 0xc5, 0xc9, 0xc8, 0x0e, 0x79, 0x68,
-	0xca, 0xa5, 0x2e, 0xcd, 0xfc, 0xa9, 0x95, 0x7b, 0xd5, 0xab, 0x5c, 0x42, 0x14, 0xa1, 0x20, 0x47,
-	0xe9, 0x5f, 0x00, 0xfc, 0x7b, 0x9d, 0xb9, 0x9b, 0x61, 0x0b, 0x71, 0xbc, 0x21, 0x22, 0xf2, 0x32,
-	0x2c, 0xa3, 0x98, 0x6f, 0xd3, 0xc8, 0xe3, 0xbb, 0x0a, 0xa8, 0x82, 0x5a, 0xd9, 0x54, 0xbe, 0x7f,
-	0x5e, 0x98, 0xca, 0xdb, 0xad, 0xb6, 0x5a, 0x11, 0x66, 0xec, 0x05, 0x8f, 0x3c, 0xe2, 0x5a, 0xbd,
-	0x54, 0xf9, 0x01, 0x1c, 0xce, 0x6a, 0x2b, 0x03, 0x55, 0x50, 0x1b, 0x5b, 0x9c, 0x69, 0x5c, 0x19,
-	0xb6, 0x91, 0xb5, 0x30, 0xcb, 0x07, 0x89, 0x26, 0xed, 0x9d, 0xec, 0xd7, 0x81, 0x95, 0x63, 0x56,
-	0x96, 0x3f, 0x9c, 0xec, 0xd7, 0x7b, 0xd5, 0x3e, 0x9e, 0xec, 0xd7, 0xff, 0xcf, 0xc9, 0xef, 0x5c,
-	0xa4, 0x7f, 0x89, 0xad, 0xae, 0xc1, 0xe9, 0x4b, 0x2e, 0x0b, 0xb3, 0x90, 0x12, 0x86, 0x9f, 0x96,
-	0x46, 0xc1, 0x3f, 0x03, 0xfa, 0xde, 0x10, 0x9c, 0x28, 0x66, 0xfc, 0xf1, 0x84, 0x32, 0x2c, 0x11,
-	0x14, 0x60, 0x31, 0x5f, 0xd9, 0x12, 0xb6, 0xfc, 0x15, 0x40, 0x15, 0x31, 0x3b, 0xf0, 0x08, 0xb7,
-	0x91, 0xef, 0x53, 0x07, 0x71, 0x8f, 0x12, 0x3b, 0xc4, 0x91, 0x83, 0x09, 0x47, 0x2e, 0x66, 0xca,
-	0xa0, 0x90, 0x63, 0xfe, 0x1a, 0x39, 0xd6, 0x3d, 0xc2, 0x57, 0xcf, 0x41, 0x1b, 0x3d, 0x8c, 0xf9,
-	0xac, 0x9b, 0x68, 0x7d, 0xea, 0x9e, 0x26, 0xda, 0x9d, 0x5d, 0x14, 0xf8, 0x2b, 0xfa, 0xed, 0x79,
-	0xfa, 0x9a, 0x64, 0xfd, 0x87, 0xd8, 0x8d, 0xbd, 0xe4, 0x79, 0x58, 0x46, 0xcc, 0x66, 0x62, 0x5c,
-	0xa5, 0x24, 0xe4, 0xf8, 0xab, 0x9b, 0x68, 0x3d, 0xe7, 0x9a, 0x64, 0x8d, 0xa2, 0x5c, 0x0f, 0x79,
-	0x0e, 0x8e, 0x22, 0x66, 0xbf, 0xf6, 0x29, 0xe2, 0xca, 0x50, 0x15, 0xd4, 0x80, 0x39, 0xde, 0x4d,
-	0xb4, 0x73, 0xdf, 0x9a, 0x64, 0x8d, 0x20, 0xf6, 0x24, 0x35, 0xe5, 0x9f, 0x00, 0xde, 0x3d, 0xe3,
-	0x86, 0xdf, 0xc4, 0xc8, 0x67, 0x76, 0x33, 0x8e, 0x88, 0xed, 0xf8, 0xc8, 0x0b, 0xec, 0x96, 0x97,
-	0x56, 0x6f, 0xc6, 0x29, 0x1d, 0x65, 0x58, 0x68, 0xb4, 0x78, 0x83, 0x46, 0x8f, 0x05, 0xd8, 0x8c,
-	0x23, 0xf2, 0x30, 0x85, 0x3e, 0xba, 0x80, 0x34, 0xb7, 0xba, 0x89, 0xf6, 0x9b, 0x5d, 0x4e, 0x13,
-	0x6d, 0xa1, 0xa8, 0xd8, 0xed, 0xf9, 0xa9, 0x72, 0xb3, 0x99, 0x72, 0xb7, 0x30, 0xc8, 0xf5, 0x8b,
-	0x3d, 0xc2, 0x97, 0xef, 0x2b, 0x23, 0x55, 0x50, 0x2b, 0x9d, 0xeb, 0x97, 0x39, 0x33, 0xfd, 0x36,
-	0x85, 0xbd, 0x32, 0x51, 0xbc, 0xe8, 0x66, 0x19, 0x8e, 0x20, 0x66, 0xf3, 0xdd, 0x10, 0xeb, 0x2a,
-	0xfc, 0xb7, 0x78, 0x53, 0x8b, 0x57, 0x79, 0xf1, 0x1b, 0x80, 0x83, 0xeb, 0xcc, 0x95, 0x5f, 0xc1,
-	0xf1, 0xc2, 0xc6, 0xea, 0xd7, 0xc9, 0x56, 0x5c, 0x8a, 0x4a, 0xbd, 0x7f, 0xce, 0x59, 0x37, 0x79,
-	0x0b, 0x8e, 0x5d, 0x5c, 0x97, 0xd9, 0xbe, 0xd0, 0xca, 0x5c, 0xdf, 0x94, 0xb3, 0xe2, 0x95, 0xa1,
-	0xf7, 0xe9, 0xde, 0x9b, 0xcf, 0x0f, 0x3a, 0x2a, 0x38, 0xec, 0xa8, 0xe0, 0xa8, 0xa3, 0x82, 0x1f,
-	0x1d, 0x15, 0x7c, 0x3a, 0x56, 0xa5, 0xc3, 0x63, 0x55, 0x3a, 0x3a, 0x56, 0xa5, 0x97, 0x4b, 0xae,
-	0xc7, 0xb7, 0xe3, 0x66, 0xc3, 0xa1, 0x81, 0x11, 0xd2, 0x36, 0x5f, 0x20, 0x98, 0xbf, 0xa3, 0x51,
-	0x5b, 0x1c, 0x22, 0xea, 0xfb, 0xc5, 0x47, 0x21, 0x55, 0x8f, 0x35, 0x87, 0xc5, 0x9b, 0xb6, 0xf4,
-	0x2b, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x69, 0xd2, 0xe4, 0x81, 0x05, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xee, 0xc0, 0xee, 0xc2, 0x0e, 0x8a, 0xd0, 0x10, 0x29, 0x6b, 0xd2, 0x42, 0x8d, 0x06, 0x37,
+	0xec, 0x36, 0x80, 0xe1, 0x40, 0xbc, 0x50, 0x31, 0x21, 0x1a, 0x12, 0xac, 0xe1, 0x22, 0x89, 0xcd,
+	0x6c, 0x77, 0x52, 0x9a, 0x6d, 0x3b, 0xb5, 0x33, 0x55, 0xb8, 0x19, 0x8f, 0x9e, 0xfc, 0x33, 0x3c,
+	0x72, 0x20, 0x5e, 0xf5, 0x88, 0x37, 0xc2, 0x89, 0x53, 0x63, 0x16, 0x13, 0x92, 0x3d, 0xf2, 0x17,
+	0x98, 0x4e, 0x0b, 0xbb, 0xe5, 0xd7, 0x1a, 0x2f, 0xed, 0xcc, 0x7b, 0xdf, 0x37, 0xdf, 0x7b, 0x5f,
+	0xde, 0x0c, 0xac, 0x04, 0xc4, 0x6a, 0x61, 0xa6, 0x31, 0xd2, 0xc2, 0x3e, 0xf1, 0x1c, 0x8b, 0x6a,
+	0x6c, 0xa7, 0x1e, 0x84, 0x84, 0x11, 0x71, 0x3c, 0xcd, 0xd5, 0xbb, 0xb9, 0xca, 0x38, 0xf2, 0x1c,
+	0x9f, 0x68, 0xfc, 0x9b, 0xa2, 0x2a, 0x93, 0x16, 0xa1, 0x1e, 0xa1, 0x9a, 0x47, 0x6d, 0xed, 0xc3,
+	0x7c, 0xf2, 0xcb, 0x12, 0x53, 0x69, 0xc2, 0xe4, 0x3b, 0x2d, 0xdd, 0x64, 0xa9, 0x09, 0x9b, 0xd8,
+	0x24, 0x8d, 0x27, 0xab, 0x2c, 0x2a, 0x5f, 0xad, 0x25, 0x40, 0x21, 0xf2, 0x32, 0x96, 0xfa, 0x03,
+	0xc0, 0x7b, 0xeb, 0xd4, 0xde, 0x0c, 0x9a, 0x88, 0xe1, 0x0d, 0x9e, 0x11, 0x97, 0x60, 0x19, 0x45,
+	0x6c, 0x9b, 0x84, 0x0e, 0xdb, 0x95, 0xc0, 0x34, 0x98, 0x2d, 0xeb, 0xd2, 0xd1, 0x7e, 0x6d, 0x22,
+	0x93, 0x5b, 0x69, 0x36, 0x43, 0x4c, 0xe9, 0x1b, 0x16, 0x3a, 0xbe, 0x6d, 0x74, 0xa1, 0xe2, 0x33,
+	0x58, 0x4a, 0xcf, 0x96, 0x06, 0xa6, 0xc1, 0xec, 0xc8, 0xc2, 0x54, 0xfd, 0x4a, 0xb3, 0xf5, 0x54,
+	0x42, 0x2f, 0x1f, 0xc4, 0x8a, 0xf0, 0xed, 0x74, 0xaf, 0x0a, 0x8c, 0x8c, 0xb3, 0xbc, 0xf4, 0xf9,
+	0x74, 0xaf, 0xda, 0x3d, 0xed, 0xcb, 0xe9, 0x5e, 0xf5, 0x61, 0x56, 0xfc, 0x4e, 0x6f, 0xf9, 0x97,
+	0xaa, 0x55, 0x15, 0x38, 0x79, 0x29, 0x64, 0x60, 0x1a, 0x10, 0x9f, 0xe2, 0x97, 0x85, 0x61, 0x30,
+	0x36, 0xa0, 0x7e, 0x2f, 0xc2, 0xd1, 0x3c, 0xe2, 0xbf, 0x3b, 0x14, 0x61, 0xc1, 0x47, 0x1e, 0xe6,
+	0xfd, 0x95, 0x0d, 0xbe, 0x16, 0x7f, 0x02, 0x28, 0x23, 0x6a, 0x7a, 0x8e, 0xcf, 0x4c, 0xe4, 0xba,
+	0xc4, 0x42, 0xcc, 0x21, 0xbe, 0x19, 0xe0, 0xd0, 0xc2, 0x3e, 0x43, 0x36, 0xa6, 0xd2, 0x20, 0xb7,
+	0x63, 0xee, 0x1a, 0x3b, 0xd6, 0x1d, 0x9f, 0xad, 0x5c, 0x90, 0x36, 0xba, 0x1c, 0xfd, 0x55, 0x27,
+	0x56, 0xfa, 0x9c, 0x7b, 0x16, 0x2b, 0x8f, 0x76, 0x91, 0xe7, 0x2e, 0xab, 0xb7, 0xe3, 0xd4, 0x35,
+	0xc1, 0x78, 0x80, 0xe8, 0x8d, 0x5a, 0xe2, 0x1c, 0x2c, 0x23, 0x6a, 0x52, 0xde, 0xae, 0x54, 0xe0,
+	0x76, 0xdc, 0xed, 0xc4, 0x4a, 0x37, 0xb8, 0x26, 0x18, 0xc3, 0x28, 0xf3, 0x43, 0xfc, 0x03, 0xe0,
+	0xe3, 0x73, 0x41, 0xfc, 0x3e, 0x42, 0x2e, 0x35, 0x1b, 0x51, 0xe8, 0x9b, 0x96, 0x8b, 0x1c, 0xcf,
+	0x6c, 0x3a, 0x09, 0xa5, 0x11, 0x25, 0x1a, 0x52, 0x89, 0x37, 0xbe, 0x70, 0x43, 0xe3, 0x2f, 0x38,
+	0x59, 0x8f, 0x42, 0xff, 0x79, 0x42, 0x5d, 0xed, 0x61, 0xea, 0x5b, 0x9d, 0x58, 0xf9, 0x47, 0x95,
+	0xb3, 0x58, 0xa9, 0xe5, 0x6d, 0xb8, 0x1d, 0x9f, 0xd8, 0x31, 0x83, 0x68, 0x9f, 0x0a, 0x32, 0x53,
+	0x22, 0xc7, 0x67, 0x4b, 0x4f, 0xa5, 0xa1, 0x69, 0x30, 0x5b, 0xb8, 0x30, 0x25, 0x0d, 0xa6, 0xa6,
+	0x6c, 0xf2, 0xb5, 0x38, 0x0f, 0x4b, 0x88, 0x9a, 0x4d, 0x6c, 0x49, 0xc3, 0xe9, 0x38, 0x75, 0x62,
+	0x25, 0x8b, 0x1c, 0xed, 0xd7, 0x60, 0x36, 0x58, 0xab, 0xd8, 0x5a, 0x13, 0x8c, 0x22, 0xa2, 0xab,
+	0xd8, 0x5a, 0x1e, 0xcd, 0x0f, 0xbc, 0x5e, 0x86, 0x43, 0x88, 0x9a, 0x6c, 0x37, 0x48, 0x06, 0xb7,
+	0x38, 0x56, 0x52, 0x65, 0x78, 0x3f, 0x3f, 0xb7, 0xf9, 0xc1, 0x5e, 0xf8, 0x05, 0xe0, 0xe0, 0x3a,
+	0xb5, 0xc5, 0x77, 0xf0, 0x4e, 0xee, 0xfe, 0xaa, 0xd7, 0xf9, 0x9d, 0xbf, 0x22, 0x95, 0x6a, 0x7f,
+	0xcc, 0xb9, 0x9a, 0xb8, 0x05, 0x47, 0x7a, 0x2f, 0xcf, 0x4c, 0x5f, 0x6a, 0xe5, 0x49, 0x5f, 0xc8,
+	0xf9, 0xe1, 0x95, 0xe2, 0xa7, 0xe4, 0x15, 0xd0, 0x5f, 0x1f, 0xb4, 0x65, 0x70, 0xd8, 0x96, 0xc1,
+	0x71, 0x5b, 0x06, 0xbf, 0xdb, 0x32, 0xf8, 0x7a, 0x22, 0x0b, 0x87, 0x27, 0xb2, 0x70, 0x7c, 0x22,
+	0x0b, 0x6f, 0x17, 0x6d, 0x87, 0x6d, 0x47, 0x8d, 0xba, 0x45, 0x3c, 0x2d, 0x20, 0x2d, 0x56, 0xf3,
+	0x31, 0xfb, 0x48, 0xc2, 0x16, 0xdf, 0x84, 0xc4, 0x75, 0xf3, 0x4f, 0x44, 0xe2, 0x21, 0x6d, 0x94,
+	0xf8, 0x0b, 0xb7, 0xf8, 0x77, 0x00, 0x09, 0xd4, 0xff, 0xa9, 0x8f, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -607,19 +606,6 @@ func (m *MsgUpdateParam_AsString) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x22
 	return len(dAtA) - i, nil
 }
-func (m *MsgUpdateParam_AsFloat) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParam_AsFloat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 8
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AsFloat))))
-	i--
-	dAtA[i] = 0x29
-	return len(dAtA) - i, nil
-}
 func (m *MsgUpdateParam_AsMintEqualsBurnClaimDistribution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -653,6 +639,20 @@ func (m *MsgUpdateParam_AsUint64) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x38
 	return len(dAtA) - i, nil
 }
+func (m *MsgUpdateParam_AsDec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParam_AsDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.AsDec)
+	copy(dAtA[i:], m.AsDec)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.AsDec)))
+	i--
+	dAtA[i] = 0x42
+	return len(dAtA) - i, nil
+}
 func (m *MsgUpdateParamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -753,15 +753,6 @@ func (m *MsgUpdateParam_AsString) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
-func (m *MsgUpdateParam_AsFloat) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 9
-	return n
-}
 func (m *MsgUpdateParam_AsMintEqualsBurnClaimDistribution) Size() (n int) {
 	if m == nil {
 		return 0
@@ -783,6 +774,16 @@ func (m *MsgUpdateParam_AsUint64) Size() (n int) {
 	n += 1 + sovTx(uint64(m.AsUint64))
 	return n
 }
+func (m *MsgUpdateParam_AsDec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AsDec)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
 func (m *MsgUpdateParamResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1123,17 +1124,6 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 			}
 			m.AsType = &MsgUpdateParam_AsString{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AsFloat", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.AsType = &MsgUpdateParam_AsFloat{float64(math.Float64frombits(v))}
 		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AsMintEqualsBurnClaimDistribution", wireType)
@@ -1189,6 +1179,38 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AsType = &MsgUpdateParam_AsUint64{v}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsDec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsType = &MsgUpdateParam_AsDec{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])